@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName = "/xion.globalfee.v1.Query/Params"
+	Query_Params_FullMethodName      = "/xion.globalfee.v1.Query/Params"
+	Query_DynamicFee_FullMethodName  = "/xion.globalfee.v1.Query/DynamicFee"
+	Query_RequiredFee_FullMethodName = "/xion.globalfee.v1.Query/RequiredFee"
 )
 
 // QueryClient is the client API for Query service.
@@ -30,6 +32,11 @@ const (
 type QueryClient interface {
 	// Params queries the parameters of the module
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DynamicFee queries the current dynamic minimum gas prices
+	DynamicFee(ctx context.Context, in *QueryDynamicFeeRequest, opts ...grpc.CallOption) (*QueryDynamicFeeResponse, error)
+	// RequiredFee computes the global minimum fee of a transaction, which does
+	// not need to be signed
+	RequiredFee(ctx context.Context, in *QueryRequiredFeeRequest, opts ...grpc.CallOption) (*QueryRequiredFeeResponse, error)
 }
 
 type queryClient struct {
@@ -50,6 +57,26 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) DynamicFee(ctx context.Context, in *QueryDynamicFeeRequest, opts ...grpc.CallOption) (*QueryDynamicFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDynamicFeeResponse)
+	err := c.cc.Invoke(ctx, Query_DynamicFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RequiredFee(ctx context.Context, in *QueryRequiredFeeRequest, opts ...grpc.CallOption) (*QueryRequiredFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRequiredFeeResponse)
+	err := c.cc.Invoke(ctx, Query_RequiredFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -58,6 +85,11 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 type QueryServer interface {
 	// Params queries the parameters of the module
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DynamicFee queries the current dynamic minimum gas prices
+	DynamicFee(context.Context, *QueryDynamicFeeRequest) (*QueryDynamicFeeResponse, error)
+	// RequiredFee computes the global minimum fee of a transaction, which does
+	// not need to be signed
+	RequiredFee(context.Context, *QueryRequiredFeeRequest) (*QueryRequiredFeeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -71,6 +103,12 @@ type UnimplementedQueryServer struct{}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) DynamicFee(context.Context, *QueryDynamicFeeRequest) (*QueryDynamicFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamicFee not implemented")
+}
+func (UnimplementedQueryServer) RequiredFee(context.Context, *QueryRequiredFeeRequest) (*QueryRequiredFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequiredFee not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -110,6 +148,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DynamicFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDynamicFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DynamicFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DynamicFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DynamicFee(ctx, req.(*QueryDynamicFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RequiredFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequiredFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequiredFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RequiredFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequiredFee(ctx, req.(*QueryRequiredFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,6 +195,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "DynamicFee",
+			Handler:    _Query_DynamicFee_Handler,
+		},
+		{
+			MethodName: "RequiredFee",
+			Handler:    _Query_RequiredFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xion/globalfee/v1/query.proto",
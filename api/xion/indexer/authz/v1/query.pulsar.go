@@ -7,41 +7,39 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
 var (
-	md_QueryGrantsRequest              protoreflect.MessageDescriptor
-	fd_QueryGrantsRequest_granter      protoreflect.FieldDescriptor
-	fd_QueryGrantsRequest_grantee      protoreflect.FieldDescriptor
-	fd_QueryGrantsRequest_msg_type_url protoreflect.FieldDescriptor
-	fd_QueryGrantsRequest_pagination   protoreflect.FieldDescriptor
+	md_ExpirationFilter                 protoreflect.MessageDescriptor
+	fd_ExpirationFilter_status          protoreflect.FieldDescriptor
+	fd_ExpirationFilter_expiring_before protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_xion_indexer_authz_v1_query_proto_init()
-	md_QueryGrantsRequest = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGrantsRequest")
-	fd_QueryGrantsRequest_granter = md_QueryGrantsRequest.Fields().ByName("granter")
-	fd_QueryGrantsRequest_grantee = md_QueryGrantsRequest.Fields().ByName("grantee")
-	fd_QueryGrantsRequest_msg_type_url = md_QueryGrantsRequest.Fields().ByName("msg_type_url")
-	fd_QueryGrantsRequest_pagination = md_QueryGrantsRequest.Fields().ByName("pagination")
+	md_ExpirationFilter = File_xion_indexer_authz_v1_query_proto.Messages().ByName("ExpirationFilter")
+	fd_ExpirationFilter_status = md_ExpirationFilter.Fields().ByName("status")
+	fd_ExpirationFilter_expiring_before = md_ExpirationFilter.Fields().ByName("expiring_before")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGrantsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_ExpirationFilter)(nil)
 
-type fastReflection_QueryGrantsRequest QueryGrantsRequest
+type fastReflection_ExpirationFilter ExpirationFilter
 
-func (x *QueryGrantsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGrantsRequest)(x)
+func (x *ExpirationFilter) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExpirationFilter)(x)
 }
 
-func (x *QueryGrantsRequest) slowProtoReflect() protoreflect.Message {
+func (x *ExpirationFilter) slowProtoReflect() protoreflect.Message {
 	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -53,43 +51,43 @@ func (x *QueryGrantsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGrantsRequest_messageType fastReflection_QueryGrantsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGrantsRequest_messageType{}
+var _fastReflection_ExpirationFilter_messageType fastReflection_ExpirationFilter_messageType
+var _ protoreflect.MessageType = fastReflection_ExpirationFilter_messageType{}
 
-type fastReflection_QueryGrantsRequest_messageType struct{}
+type fastReflection_ExpirationFilter_messageType struct{}
 
-func (x fastReflection_QueryGrantsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGrantsRequest)(nil)
+func (x fastReflection_ExpirationFilter_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExpirationFilter)(nil)
 }
-func (x fastReflection_QueryGrantsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGrantsRequest)
+func (x fastReflection_ExpirationFilter_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExpirationFilter)
 }
-func (x fastReflection_QueryGrantsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGrantsRequest
+func (x fastReflection_ExpirationFilter_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExpirationFilter
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGrantsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGrantsRequest
+func (x *fastReflection_ExpirationFilter) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExpirationFilter
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGrantsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGrantsRequest_messageType
+func (x *fastReflection_ExpirationFilter) Type() protoreflect.MessageType {
+	return _fastReflection_ExpirationFilter_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGrantsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGrantsRequest)
+func (x *fastReflection_ExpirationFilter) New() protoreflect.Message {
+	return new(fastReflection_ExpirationFilter)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGrantsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGrantsRequest)(x)
+func (x *fastReflection_ExpirationFilter) Interface() protoreflect.ProtoMessage {
+	return (*ExpirationFilter)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -97,28 +95,1786 @@ func (x *fastReflection_QueryGrantsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGrantsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Granter != "" {
-		value := protoreflect.ValueOfString(x.Granter)
-		if !f(fd_QueryGrantsRequest_granter, value) {
+func (x *fastReflection_ExpirationFilter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_ExpirationFilter_status, value) {
 			return
 		}
 	}
-	if x.Grantee != "" {
-		value := protoreflect.ValueOfString(x.Grantee)
-		if !f(fd_QueryGrantsRequest_grantee, value) {
+	if x.ExpiringBefore != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiringBefore.ProtoReflect())
+		if !f(fd_ExpirationFilter_expiring_before, value) {
 			return
 		}
 	}
-	if x.MsgTypeUrl != "" {
-		value := protoreflect.ValueOfString(x.MsgTypeUrl)
-		if !f(fd_QueryGrantsRequest_msg_type_url, value) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExpirationFilter) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.ExpirationFilter.status":
+		return x.Status != 0
+	case "xion.indexer.authz.v1.ExpirationFilter.expiring_before":
+		return x.ExpiringBefore != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.ExpirationFilter"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.ExpirationFilter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExpirationFilter) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.ExpirationFilter.status":
+		x.Status = 0
+	case "xion.indexer.authz.v1.ExpirationFilter.expiring_before":
+		x.ExpiringBefore = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.ExpirationFilter"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.ExpirationFilter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExpirationFilter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.indexer.authz.v1.ExpirationFilter.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "xion.indexer.authz.v1.ExpirationFilter.expiring_before":
+		value := x.ExpiringBefore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.ExpirationFilter"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.ExpirationFilter does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExpirationFilter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.ExpirationFilter.status":
+		x.Status = (ExpirationStatus)(value.Enum())
+	case "xion.indexer.authz.v1.ExpirationFilter.expiring_before":
+		x.ExpiringBefore = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.ExpirationFilter"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.ExpirationFilter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExpirationFilter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.ExpirationFilter.expiring_before":
+		if x.ExpiringBefore == nil {
+			x.ExpiringBefore = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiringBefore.ProtoReflect())
+	case "xion.indexer.authz.v1.ExpirationFilter.status":
+		panic(fmt.Errorf("field status of message xion.indexer.authz.v1.ExpirationFilter is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.ExpirationFilter"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.ExpirationFilter does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExpirationFilter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.ExpirationFilter.status":
+		return protoreflect.ValueOfEnum(0)
+	case "xion.indexer.authz.v1.ExpirationFilter.expiring_before":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.ExpirationFilter"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.ExpirationFilter does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExpirationFilter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.ExpirationFilter", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExpirationFilter) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExpirationFilter) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExpirationFilter) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExpirationFilter) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExpirationFilter)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.ExpiringBefore != nil {
+			l = options.Size(x.ExpiringBefore)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExpirationFilter)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiringBefore != nil {
+			encoded, err := options.Marshal(x.ExpiringBefore)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExpirationFilter)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExpirationFilter: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExpirationFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ExpirationStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiringBefore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiringBefore == nil {
+					x.ExpiringBefore = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiringBefore); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGrantsRequest              protoreflect.MessageDescriptor
+	fd_QueryGrantsRequest_granter      protoreflect.FieldDescriptor
+	fd_QueryGrantsRequest_grantee      protoreflect.FieldDescriptor
+	fd_QueryGrantsRequest_msg_type_url protoreflect.FieldDescriptor
+	fd_QueryGrantsRequest_pagination   protoreflect.FieldDescriptor
+	fd_QueryGrantsRequest_expiration   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_indexer_authz_v1_query_proto_init()
+	md_QueryGrantsRequest = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGrantsRequest")
+	fd_QueryGrantsRequest_granter = md_QueryGrantsRequest.Fields().ByName("granter")
+	fd_QueryGrantsRequest_grantee = md_QueryGrantsRequest.Fields().ByName("grantee")
+	fd_QueryGrantsRequest_msg_type_url = md_QueryGrantsRequest.Fields().ByName("msg_type_url")
+	fd_QueryGrantsRequest_pagination = md_QueryGrantsRequest.Fields().ByName("pagination")
+	fd_QueryGrantsRequest_expiration = md_QueryGrantsRequest.Fields().ByName("expiration")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGrantsRequest)(nil)
+
+type fastReflection_QueryGrantsRequest QueryGrantsRequest
+
+func (x *QueryGrantsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGrantsRequest)(x)
+}
+
+func (x *QueryGrantsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGrantsRequest_messageType fastReflection_QueryGrantsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGrantsRequest_messageType{}
+
+type fastReflection_QueryGrantsRequest_messageType struct{}
+
+func (x fastReflection_QueryGrantsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGrantsRequest)(nil)
+}
+func (x fastReflection_QueryGrantsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantsRequest)
+}
+func (x fastReflection_QueryGrantsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGrantsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGrantsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGrantsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGrantsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGrantsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGrantsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGrantsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Granter != "" {
+		value := protoreflect.ValueOfString(x.Granter)
+		if !f(fd_QueryGrantsRequest_granter, value) {
+			return
+		}
+	}
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_QueryGrantsRequest_grantee, value) {
+			return
+		}
+	}
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_QueryGrantsRequest_msg_type_url, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGrantsRequest_pagination, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_QueryGrantsRequest_expiration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGrantsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
+		return x.Granter != ""
+	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
+		return x.Grantee != ""
+	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+		return x.Pagination != nil
+	case "xion.indexer.authz.v1.QueryGrantsRequest.expiration":
+		return x.Expiration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
+		x.Granter = ""
+	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
+		x.Grantee = ""
+	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+		x.Pagination = nil
+	case "xion.indexer.authz.v1.QueryGrantsRequest.expiration":
+		x.Expiration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGrantsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
+		value := x.Granter
+		return protoreflect.ValueOfString(value)
+	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryGrantsRequest.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
+		x.Granter = value.Interface().(string)
+	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
+		x.Grantee = value.Interface().(string)
+	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "xion.indexer.authz.v1.QueryGrantsRequest.expiration":
+		x.Expiration = value.Message().Interface().(*ExpirationFilter)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryGrantsRequest.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(ExpirationFilter)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
+		panic(fmt.Errorf("field granter of message xion.indexer.authz.v1.QueryGrantsRequest is not mutable"))
+	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
+		panic(fmt.Errorf("field grantee of message xion.indexer.authz.v1.QueryGrantsRequest is not mutable"))
+	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message xion.indexer.authz.v1.QueryGrantsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGrantsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
+		return protoreflect.ValueOfString("")
+	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
+		return protoreflect.ValueOfString("")
+	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryGrantsRequest.expiration":
+		m := new(ExpirationFilter)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGrantsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGrantsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGrantsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGrantsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGrantsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Granter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Grantee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Granter) > 0 {
+			i -= len(x.Granter)
+			copy(dAtA[i:], x.Granter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Granter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &ExpirationFilter{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryGrantsResponse_1_list)(nil)
+
+type _QueryGrantsResponse_1_list struct {
+	list *[]*v1beta11.Grant
+}
+
+func (x *_QueryGrantsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGrantsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGrantsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Grant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGrantsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Grant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGrantsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Grant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGrantsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGrantsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Grant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGrantsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryGrantsResponse            protoreflect.MessageDescriptor
+	fd_QueryGrantsResponse_grants     protoreflect.FieldDescriptor
+	fd_QueryGrantsResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_indexer_authz_v1_query_proto_init()
+	md_QueryGrantsResponse = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGrantsResponse")
+	fd_QueryGrantsResponse_grants = md_QueryGrantsResponse.Fields().ByName("grants")
+	fd_QueryGrantsResponse_pagination = md_QueryGrantsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGrantsResponse)(nil)
+
+type fastReflection_QueryGrantsResponse QueryGrantsResponse
+
+func (x *QueryGrantsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGrantsResponse)(x)
+}
+
+func (x *QueryGrantsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGrantsResponse_messageType fastReflection_QueryGrantsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGrantsResponse_messageType{}
+
+type fastReflection_QueryGrantsResponse_messageType struct{}
+
+func (x fastReflection_QueryGrantsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGrantsResponse)(nil)
+}
+func (x fastReflection_QueryGrantsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantsResponse)
+}
+func (x fastReflection_QueryGrantsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGrantsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGrantsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGrantsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGrantsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGrantsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGrantsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGrantsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Grants) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGrantsResponse_1_list{list: &x.Grants})
+		if !f(fd_QueryGrantsResponse_grants, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGrantsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGrantsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
+		return len(x.Grants) != 0
+	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
+		x.Grants = nil
+	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGrantsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
+		if len(x.Grants) == 0 {
+			return protoreflect.ValueOfList(&_QueryGrantsResponse_1_list{})
+		}
+		listValue := &_QueryGrantsResponse_1_list{list: &x.Grants}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
+		lv := value.List()
+		clv := lv.(*_QueryGrantsResponse_1_list)
+		x.Grants = *clv.list
+	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
+		if x.Grants == nil {
+			x.Grants = []*v1beta11.Grant{}
+		}
+		value := &_QueryGrantsResponse_1_list{list: &x.Grants}
+		return protoreflect.ValueOfList(value)
+	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGrantsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
+		list := []*v1beta11.Grant{}
+		return protoreflect.ValueOfList(&_QueryGrantsResponse_1_list{list: &list})
+	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGrantsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGrantsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGrantsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGrantsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGrantsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGrantsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Grants) > 0 {
+			for _, e := range x.Grants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Grants) > 0 {
+			for iNdEx := len(x.Grants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Grants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grants = append(x.Grants, &v1beta11.Grant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Grants[len(x.Grants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGranterGrantsRequest            protoreflect.MessageDescriptor
+	fd_QueryGranterGrantsRequest_granter    protoreflect.FieldDescriptor
+	fd_QueryGranterGrantsRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryGranterGrantsRequest_expiration protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_indexer_authz_v1_query_proto_init()
+	md_QueryGranterGrantsRequest = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGranterGrantsRequest")
+	fd_QueryGranterGrantsRequest_granter = md_QueryGranterGrantsRequest.Fields().ByName("granter")
+	fd_QueryGranterGrantsRequest_pagination = md_QueryGranterGrantsRequest.Fields().ByName("pagination")
+	fd_QueryGranterGrantsRequest_expiration = md_QueryGranterGrantsRequest.Fields().ByName("expiration")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGranterGrantsRequest)(nil)
+
+type fastReflection_QueryGranterGrantsRequest QueryGranterGrantsRequest
+
+func (x *QueryGranterGrantsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGranterGrantsRequest)(x)
+}
+
+func (x *QueryGranterGrantsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGranterGrantsRequest_messageType fastReflection_QueryGranterGrantsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGranterGrantsRequest_messageType{}
+
+type fastReflection_QueryGranterGrantsRequest_messageType struct{}
+
+func (x fastReflection_QueryGranterGrantsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGranterGrantsRequest)(nil)
+}
+func (x fastReflection_QueryGranterGrantsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGranterGrantsRequest)
+}
+func (x fastReflection_QueryGranterGrantsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGranterGrantsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGranterGrantsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGranterGrantsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGranterGrantsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGranterGrantsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGranterGrantsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGranterGrantsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGranterGrantsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGranterGrantsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGranterGrantsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Granter != "" {
+		value := protoreflect.ValueOfString(x.Granter)
+		if !f(fd_QueryGranterGrantsRequest_granter, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGranterGrantsRequest_pagination, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGrantsRequest_pagination, value) {
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_QueryGranterGrantsRequest_expiration, value) {
 			return
 		}
 	}
@@ -135,21 +1891,19 @@ func (x *fastReflection_QueryGrantsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGrantsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGranterGrantsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
 		return x.Granter != ""
-	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
-		return x.Grantee != ""
-	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
-		return x.MsgTypeUrl != ""
-	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
 		return x.Pagination != nil
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.expiration":
+		return x.Expiration != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -159,21 +1913,19 @@ func (x *fastReflection_QueryGrantsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGrantsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGranterGrantsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
 		x.Granter = ""
-	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
-		x.Grantee = ""
-	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
-		x.MsgTypeUrl = ""
-	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
 		x.Pagination = nil
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.expiration":
+		x.Expiration = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -183,25 +1935,22 @@ func (x *fastReflection_QueryGrantsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGrantsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranterGrantsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
 		value := x.Granter
 		return protoreflect.ValueOfString(value)
-	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
-		value := x.Grantee
-		return protoreflect.ValueOfString(value)
-	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
-		value := x.MsgTypeUrl
-		return protoreflect.ValueOfString(value)
-	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -215,21 +1964,19 @@ func (x *fastReflection_QueryGrantsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGrantsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGranterGrantsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
 		x.Granter = value.Interface().(string)
-	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
-		x.Grantee = value.Interface().(string)
-	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
-		x.MsgTypeUrl = value.Interface().(string)
-	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.expiration":
+		x.Expiration = value.Message().Interface().(*ExpirationFilter)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -243,56 +1990,56 @@ func (x *fastReflection_QueryGrantsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGrantsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranterGrantsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
-		panic(fmt.Errorf("field granter of message xion.indexer.authz.v1.QueryGrantsRequest is not mutable"))
-	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
-		panic(fmt.Errorf("field grantee of message xion.indexer.authz.v1.QueryGrantsRequest is not mutable"))
-	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
-		panic(fmt.Errorf("field msg_type_url of message xion.indexer.authz.v1.QueryGrantsRequest is not mutable"))
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(ExpirationFilter)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
+		panic(fmt.Errorf("field granter of message xion.indexer.authz.v1.QueryGranterGrantsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGrantsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranterGrantsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsRequest.granter":
-		return protoreflect.ValueOfString("")
-	case "xion.indexer.authz.v1.QueryGrantsRequest.grantee":
-		return protoreflect.ValueOfString("")
-	case "xion.indexer.authz.v1.QueryGrantsRequest.msg_type_url":
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
 		return protoreflect.ValueOfString("")
-	case "xion.indexer.authz.v1.QueryGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.expiration":
+		m := new(ExpirationFilter)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGrantsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGranterGrantsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGrantsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGranterGrantsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -300,7 +2047,7 @@ func (x *fastReflection_QueryGrantsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGrantsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGranterGrantsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -311,7 +2058,7 @@ func (x *fastReflection_QueryGrantsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGrantsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGranterGrantsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -323,7 +2070,7 @@ func (x *fastReflection_QueryGrantsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGrantsRequest) IsValid() bool {
+func (x *fastReflection_QueryGranterGrantsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -333,9 +2080,9 @@ func (x *fastReflection_QueryGrantsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGrantsRequest)
+		x := input.Message.Interface().(*QueryGranterGrantsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -351,18 +2098,14 @@ func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Grantee)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MsgTypeUrl)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -373,7 +2116,7 @@ func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGrantsRequest)
+		x := input.Message.Interface().(*QueryGranterGrantsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -392,8 +2135,8 @@ func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -404,19 +2147,19 @@ func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.MsgTypeUrl) > 0 {
-			i -= len(x.MsgTypeUrl)
-			copy(dAtA[i:], x.MsgTypeUrl)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
-			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Grantee) > 0 {
-			i -= len(x.Grantee)
-			copy(dAtA[i:], x.Grantee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -438,7 +2181,7 @@ func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGrantsRequest)
+		x := input.Message.Interface().(*QueryGranterGrantsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -470,10 +2213,10 @@ func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranterGrantsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranterGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -510,9 +2253,9 @@ func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -522,59 +2265,31 @@ func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Grantee = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -601,10 +2316,10 @@ func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
+				if x.Expiration == nil {
+					x.Expiration = &ExpirationFilter{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -643,80 +2358,80 @@ func (x *fastReflection_QueryGrantsRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_QueryGrantsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryGranterGrantsResponse_1_list)(nil)
 
-type _QueryGrantsResponse_1_list struct {
-	list *[]*v1beta11.Grant
+type _QueryGranterGrantsResponse_1_list struct {
+	list *[]*v1beta11.GrantAuthorization
 }
 
-func (x *_QueryGrantsResponse_1_list) Len() int {
+func (x *_QueryGranterGrantsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryGrantsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryGranterGrantsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryGrantsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryGranterGrantsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Grant)
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.GrantAuthorization)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryGrantsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryGranterGrantsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Grant)
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.GrantAuthorization)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryGrantsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta11.Grant)
+func (x *_QueryGranterGrantsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.GrantAuthorization)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGrantsResponse_1_list) Truncate(n int) {
+func (x *_QueryGranterGrantsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryGrantsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta11.Grant)
+func (x *_QueryGranterGrantsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.GrantAuthorization)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGrantsResponse_1_list) IsValid() bool {
+func (x *_QueryGranterGrantsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryGrantsResponse            protoreflect.MessageDescriptor
-	fd_QueryGrantsResponse_grants     protoreflect.FieldDescriptor
-	fd_QueryGrantsResponse_pagination protoreflect.FieldDescriptor
+	md_QueryGranterGrantsResponse            protoreflect.MessageDescriptor
+	fd_QueryGranterGrantsResponse_grants     protoreflect.FieldDescriptor
+	fd_QueryGranterGrantsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_xion_indexer_authz_v1_query_proto_init()
-	md_QueryGrantsResponse = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGrantsResponse")
-	fd_QueryGrantsResponse_grants = md_QueryGrantsResponse.Fields().ByName("grants")
-	fd_QueryGrantsResponse_pagination = md_QueryGrantsResponse.Fields().ByName("pagination")
+	md_QueryGranterGrantsResponse = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGranterGrantsResponse")
+	fd_QueryGranterGrantsResponse_grants = md_QueryGranterGrantsResponse.Fields().ByName("grants")
+	fd_QueryGranterGrantsResponse_pagination = md_QueryGranterGrantsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGrantsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGranterGrantsResponse)(nil)
 
-type fastReflection_QueryGrantsResponse QueryGrantsResponse
+type fastReflection_QueryGranterGrantsResponse QueryGranterGrantsResponse
 
-func (x *QueryGrantsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGrantsResponse)(x)
+func (x *QueryGranterGrantsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGranterGrantsResponse)(x)
 }
-
-func (x *QueryGrantsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[1]
+
+func (x *QueryGranterGrantsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,43 +2442,43 @@ func (x *QueryGrantsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGrantsResponse_messageType fastReflection_QueryGrantsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGrantsResponse_messageType{}
+var _fastReflection_QueryGranterGrantsResponse_messageType fastReflection_QueryGranterGrantsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGranterGrantsResponse_messageType{}
 
-type fastReflection_QueryGrantsResponse_messageType struct{}
+type fastReflection_QueryGranterGrantsResponse_messageType struct{}
 
-func (x fastReflection_QueryGrantsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGrantsResponse)(nil)
+func (x fastReflection_QueryGranterGrantsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGranterGrantsResponse)(nil)
 }
-func (x fastReflection_QueryGrantsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGrantsResponse)
+func (x fastReflection_QueryGranterGrantsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGranterGrantsResponse)
 }
-func (x fastReflection_QueryGrantsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGrantsResponse
+func (x fastReflection_QueryGranterGrantsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGranterGrantsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGrantsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGrantsResponse
+func (x *fastReflection_QueryGranterGrantsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGranterGrantsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGrantsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGrantsResponse_messageType
+func (x *fastReflection_QueryGranterGrantsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGranterGrantsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGrantsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGrantsResponse)
+func (x *fastReflection_QueryGranterGrantsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGranterGrantsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGrantsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGrantsResponse)(x)
+func (x *fastReflection_QueryGranterGrantsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGranterGrantsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -771,16 +2486,16 @@ func (x *fastReflection_QueryGrantsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGrantsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryGranterGrantsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Grants) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGrantsResponse_1_list{list: &x.Grants})
-		if !f(fd_QueryGrantsResponse_grants, value) {
+		value := protoreflect.ValueOfList(&_QueryGranterGrantsResponse_1_list{list: &x.Grants})
+		if !f(fd_QueryGranterGrantsResponse_grants, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGrantsResponse_pagination, value) {
+		if !f(fd_QueryGranterGrantsResponse_pagination, value) {
 			return
 		}
 	}
@@ -797,17 +2512,17 @@ func (x *fastReflection_QueryGrantsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGrantsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGranterGrantsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
 		return len(x.Grants) != 0
-	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -817,17 +2532,17 @@ func (x *fastReflection_QueryGrantsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGrantsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGranterGrantsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
 		x.Grants = nil
-	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -837,22 +2552,22 @@ func (x *fastReflection_QueryGrantsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGrantsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranterGrantsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
 		if len(x.Grants) == 0 {
-			return protoreflect.ValueOfList(&_QueryGrantsResponse_1_list{})
+			return protoreflect.ValueOfList(&_QueryGranterGrantsResponse_1_list{})
 		}
-		listValue := &_QueryGrantsResponse_1_list{list: &x.Grants}
+		listValue := &_QueryGranterGrantsResponse_1_list{list: &x.Grants}
 		return protoreflect.ValueOfList(listValue)
-	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -866,19 +2581,19 @@ func (x *fastReflection_QueryGrantsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGrantsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGranterGrantsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
 		lv := value.List()
-		clv := lv.(*_QueryGrantsResponse_1_list)
+		clv := lv.(*_QueryGranterGrantsResponse_1_list)
 		x.Grants = *clv.list
-	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -892,53 +2607,53 @@ func (x *fastReflection_QueryGrantsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGrantsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranterGrantsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
 		if x.Grants == nil {
-			x.Grants = []*v1beta11.Grant{}
+			x.Grants = []*v1beta11.GrantAuthorization{}
 		}
-		value := &_QueryGrantsResponse_1_list{list: &x.Grants}
+		value := &_QueryGranterGrantsResponse_1_list{list: &x.Grants}
 		return protoreflect.ValueOfList(value)
-	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGrantsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranterGrantsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGrantsResponse.grants":
-		list := []*v1beta11.Grant{}
-		return protoreflect.ValueOfList(&_QueryGrantsResponse_1_list{list: &list})
-	case "xion.indexer.authz.v1.QueryGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
+		list := []*v1beta11.GrantAuthorization{}
+		return protoreflect.ValueOfList(&_QueryGranterGrantsResponse_1_list{list: &list})
+	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGrantsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGranterGrantsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGrantsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGranterGrantsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -946,7 +2661,7 @@ func (x *fastReflection_QueryGrantsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGrantsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGranterGrantsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -957,7 +2672,7 @@ func (x *fastReflection_QueryGrantsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGrantsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGranterGrantsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -969,7 +2684,7 @@ func (x *fastReflection_QueryGrantsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGrantsResponse) IsValid() bool {
+func (x *fastReflection_QueryGranterGrantsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -979,9 +2694,9 @@ func (x *fastReflection_QueryGrantsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGrantsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGranterGrantsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGrantsResponse)
+		x := input.Message.Interface().(*QueryGranterGrantsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1013,7 +2728,7 @@ func (x *fastReflection_QueryGrantsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGrantsResponse)
+		x := input.Message.Interface().(*QueryGranterGrantsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1073,7 +2788,7 @@ func (x *fastReflection_QueryGrantsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGrantsResponse)
+		x := input.Message.Interface().(*QueryGranterGrantsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1105,10 +2820,10 @@ func (x *fastReflection_QueryGrantsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranterGrantsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranterGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1140,7 +2855,7 @@ func (x *fastReflection_QueryGrantsResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Grants = append(x.Grants, &v1beta11.Grant{})
+				x.Grants = append(x.Grants, &v1beta11.GrantAuthorization{})
 				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Grants[len(x.Grants)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
@@ -1217,28 +2932,30 @@ func (x *fastReflection_QueryGrantsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryGranterGrantsRequest            protoreflect.MessageDescriptor
-	fd_QueryGranterGrantsRequest_granter    protoreflect.FieldDescriptor
-	fd_QueryGranterGrantsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryGranteeGrantsRequest            protoreflect.MessageDescriptor
+	fd_QueryGranteeGrantsRequest_grantee    protoreflect.FieldDescriptor
+	fd_QueryGranteeGrantsRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryGranteeGrantsRequest_expiration protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_xion_indexer_authz_v1_query_proto_init()
-	md_QueryGranterGrantsRequest = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGranterGrantsRequest")
-	fd_QueryGranterGrantsRequest_granter = md_QueryGranterGrantsRequest.Fields().ByName("granter")
-	fd_QueryGranterGrantsRequest_pagination = md_QueryGranterGrantsRequest.Fields().ByName("pagination")
+	md_QueryGranteeGrantsRequest = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGranteeGrantsRequest")
+	fd_QueryGranteeGrantsRequest_grantee = md_QueryGranteeGrantsRequest.Fields().ByName("grantee")
+	fd_QueryGranteeGrantsRequest_pagination = md_QueryGranteeGrantsRequest.Fields().ByName("pagination")
+	fd_QueryGranteeGrantsRequest_expiration = md_QueryGranteeGrantsRequest.Fields().ByName("expiration")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGranterGrantsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGranteeGrantsRequest)(nil)
 
-type fastReflection_QueryGranterGrantsRequest QueryGranterGrantsRequest
+type fastReflection_QueryGranteeGrantsRequest QueryGranteeGrantsRequest
 
-func (x *QueryGranterGrantsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGranterGrantsRequest)(x)
+func (x *QueryGranteeGrantsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGranteeGrantsRequest)(x)
 }
 
-func (x *QueryGranterGrantsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[2]
+func (x *QueryGranteeGrantsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,43 +2966,43 @@ func (x *QueryGranterGrantsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGranterGrantsRequest_messageType fastReflection_QueryGranterGrantsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGranterGrantsRequest_messageType{}
+var _fastReflection_QueryGranteeGrantsRequest_messageType fastReflection_QueryGranteeGrantsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGranteeGrantsRequest_messageType{}
 
-type fastReflection_QueryGranterGrantsRequest_messageType struct{}
+type fastReflection_QueryGranteeGrantsRequest_messageType struct{}
 
-func (x fastReflection_QueryGranterGrantsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGranterGrantsRequest)(nil)
+func (x fastReflection_QueryGranteeGrantsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGranteeGrantsRequest)(nil)
 }
-func (x fastReflection_QueryGranterGrantsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGranterGrantsRequest)
+func (x fastReflection_QueryGranteeGrantsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGranteeGrantsRequest)
 }
-func (x fastReflection_QueryGranterGrantsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGranterGrantsRequest
+func (x fastReflection_QueryGranteeGrantsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGranteeGrantsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGranterGrantsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGranterGrantsRequest
+func (x *fastReflection_QueryGranteeGrantsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGranteeGrantsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGranterGrantsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGranterGrantsRequest_messageType
+func (x *fastReflection_QueryGranteeGrantsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGranteeGrantsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGranterGrantsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGranterGrantsRequest)
+func (x *fastReflection_QueryGranteeGrantsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGranteeGrantsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGranterGrantsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGranterGrantsRequest)(x)
+func (x *fastReflection_QueryGranteeGrantsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGranteeGrantsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1293,16 +3010,22 @@ func (x *fastReflection_QueryGranterGrantsRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGranterGrantsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Granter != "" {
-		value := protoreflect.ValueOfString(x.Granter)
-		if !f(fd_QueryGranterGrantsRequest_granter, value) {
+func (x *fastReflection_QueryGranteeGrantsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_QueryGranteeGrantsRequest_grantee, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGranterGrantsRequest_pagination, value) {
+		if !f(fd_QueryGranteeGrantsRequest_pagination, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_QueryGranteeGrantsRequest_expiration, value) {
 			return
 		}
 	}
@@ -1319,17 +3042,19 @@ func (x *fastReflection_QueryGranterGrantsRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGranterGrantsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGranteeGrantsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
-		return x.Granter != ""
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
+		return x.Grantee != ""
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
 		return x.Pagination != nil
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.expiration":
+		return x.Expiration != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1339,17 +3064,19 @@ func (x *fastReflection_QueryGranterGrantsRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranterGrantsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGranteeGrantsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
-		x.Granter = ""
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
+		x.Grantee = ""
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
 		x.Pagination = nil
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.expiration":
+		x.Expiration = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1359,19 +3086,22 @@ func (x *fastReflection_QueryGranterGrantsRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGranterGrantsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranteeGrantsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
-		value := x.Granter
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
+		value := x.Grantee
 		return protoreflect.ValueOfString(value)
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1385,17 +3115,19 @@ func (x *fastReflection_QueryGranterGrantsRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranterGrantsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGranteeGrantsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
-		x.Granter = value.Interface().(string)
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
+		x.Grantee = value.Interface().(string)
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.expiration":
+		x.Expiration = value.Message().Interface().(*ExpirationFilter)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1409,48 +3141,56 @@ func (x *fastReflection_QueryGranterGrantsRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranterGrantsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranteeGrantsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
-		panic(fmt.Errorf("field granter of message xion.indexer.authz.v1.QueryGranterGrantsRequest is not mutable"))
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(ExpirationFilter)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
+		panic(fmt.Errorf("field grantee of message xion.indexer.authz.v1.QueryGranteeGrantsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGranterGrantsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranteeGrantsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.granter":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
 		return protoreflect.ValueOfString("")
-	case "xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.expiration":
+		m := new(ExpirationFilter)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGranterGrantsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGranteeGrantsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGranterGrantsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGranteeGrantsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1458,7 +3198,7 @@ func (x *fastReflection_QueryGranterGrantsRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGranterGrantsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGranteeGrantsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1469,7 +3209,7 @@ func (x *fastReflection_QueryGranterGrantsRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranterGrantsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGranteeGrantsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1481,7 +3221,7 @@ func (x *fastReflection_QueryGranterGrantsRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGranterGrantsRequest) IsValid() bool {
+func (x *fastReflection_QueryGranteeGrantsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1491,9 +3231,9 @@ func (x *fastReflection_QueryGranterGrantsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGranteeGrantsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGranterGrantsRequest)
+		x := input.Message.Interface().(*QueryGranteeGrantsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1505,7 +3245,7 @@ func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		l = len(x.Granter)
+		l = len(x.Grantee)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1513,6 +3253,10 @@ func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Me
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1523,7 +3267,7 @@ func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGranterGrantsRequest)
+		x := input.Message.Interface().(*QueryGranteeGrantsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1542,6 +3286,20 @@ func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
@@ -1556,10 +3314,10 @@ func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Me
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Granter) > 0 {
-			i -= len(x.Granter)
-			copy(dAtA[i:], x.Granter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1574,7 +3332,7 @@ func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGranterGrantsRequest)
+		x := input.Message.Interface().(*QueryGranteeGrantsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1606,15 +3364,15 @@ func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranterGrantsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranteeGrantsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranterGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranteeGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1642,7 +3400,7 @@ func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Granter = string(dAtA[iNdEx:postIndex])
+				x.Grantee = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -1680,6 +3438,42 @@ func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Me
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &ExpirationFilter{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1715,80 +3509,80 @@ func (x *fastReflection_QueryGranterGrantsRequest) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_QueryGranterGrantsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryGranteeGrantsResponse_1_list)(nil)
 
-type _QueryGranterGrantsResponse_1_list struct {
+type _QueryGranteeGrantsResponse_1_list struct {
 	list *[]*v1beta11.GrantAuthorization
 }
 
-func (x *_QueryGranterGrantsResponse_1_list) Len() int {
+func (x *_QueryGranteeGrantsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryGranterGrantsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryGranteeGrantsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryGranterGrantsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryGranteeGrantsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta11.GrantAuthorization)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryGranterGrantsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryGranteeGrantsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta11.GrantAuthorization)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryGranterGrantsResponse_1_list) AppendMutable() protoreflect.Value {
+func (x *_QueryGranteeGrantsResponse_1_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta11.GrantAuthorization)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGranterGrantsResponse_1_list) Truncate(n int) {
+func (x *_QueryGranteeGrantsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryGranterGrantsResponse_1_list) NewElement() protoreflect.Value {
+func (x *_QueryGranteeGrantsResponse_1_list) NewElement() protoreflect.Value {
 	v := new(v1beta11.GrantAuthorization)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGranterGrantsResponse_1_list) IsValid() bool {
+func (x *_QueryGranteeGrantsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryGranterGrantsResponse            protoreflect.MessageDescriptor
-	fd_QueryGranterGrantsResponse_grants     protoreflect.FieldDescriptor
-	fd_QueryGranterGrantsResponse_pagination protoreflect.FieldDescriptor
+	md_QueryGranteeGrantsResponse            protoreflect.MessageDescriptor
+	fd_QueryGranteeGrantsResponse_grants     protoreflect.FieldDescriptor
+	fd_QueryGranteeGrantsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_xion_indexer_authz_v1_query_proto_init()
-	md_QueryGranterGrantsResponse = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGranterGrantsResponse")
-	fd_QueryGranterGrantsResponse_grants = md_QueryGranterGrantsResponse.Fields().ByName("grants")
-	fd_QueryGranterGrantsResponse_pagination = md_QueryGranterGrantsResponse.Fields().ByName("pagination")
+	md_QueryGranteeGrantsResponse = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGranteeGrantsResponse")
+	fd_QueryGranteeGrantsResponse_grants = md_QueryGranteeGrantsResponse.Fields().ByName("grants")
+	fd_QueryGranteeGrantsResponse_pagination = md_QueryGranteeGrantsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGranterGrantsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGranteeGrantsResponse)(nil)
 
-type fastReflection_QueryGranterGrantsResponse QueryGranterGrantsResponse
+type fastReflection_QueryGranteeGrantsResponse QueryGranteeGrantsResponse
 
-func (x *QueryGranterGrantsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGranterGrantsResponse)(x)
+func (x *QueryGranteeGrantsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGranteeGrantsResponse)(x)
 }
 
-func (x *QueryGranterGrantsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[3]
+func (x *QueryGranteeGrantsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,43 +3593,43 @@ func (x *QueryGranterGrantsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGranterGrantsResponse_messageType fastReflection_QueryGranterGrantsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGranterGrantsResponse_messageType{}
+var _fastReflection_QueryGranteeGrantsResponse_messageType fastReflection_QueryGranteeGrantsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGranteeGrantsResponse_messageType{}
 
-type fastReflection_QueryGranterGrantsResponse_messageType struct{}
+type fastReflection_QueryGranteeGrantsResponse_messageType struct{}
 
-func (x fastReflection_QueryGranterGrantsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGranterGrantsResponse)(nil)
+func (x fastReflection_QueryGranteeGrantsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGranteeGrantsResponse)(nil)
 }
-func (x fastReflection_QueryGranterGrantsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGranterGrantsResponse)
+func (x fastReflection_QueryGranteeGrantsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGranteeGrantsResponse)
 }
-func (x fastReflection_QueryGranterGrantsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGranterGrantsResponse
+func (x fastReflection_QueryGranteeGrantsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGranteeGrantsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGranterGrantsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGranterGrantsResponse
+func (x *fastReflection_QueryGranteeGrantsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGranteeGrantsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGranterGrantsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGranterGrantsResponse_messageType
+func (x *fastReflection_QueryGranteeGrantsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGranteeGrantsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGranterGrantsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGranterGrantsResponse)
+func (x *fastReflection_QueryGranteeGrantsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGranteeGrantsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGranterGrantsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGranterGrantsResponse)(x)
+func (x *fastReflection_QueryGranteeGrantsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGranteeGrantsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1843,16 +3637,16 @@ func (x *fastReflection_QueryGranterGrantsResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGranterGrantsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryGranteeGrantsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Grants) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGranterGrantsResponse_1_list{list: &x.Grants})
-		if !f(fd_QueryGranterGrantsResponse_grants, value) {
+		value := protoreflect.ValueOfList(&_QueryGranteeGrantsResponse_1_list{list: &x.Grants})
+		if !f(fd_QueryGranteeGrantsResponse_grants, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGranterGrantsResponse_pagination, value) {
+		if !f(fd_QueryGranteeGrantsResponse_pagination, value) {
 			return
 		}
 	}
@@ -1869,17 +3663,17 @@ func (x *fastReflection_QueryGranterGrantsResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGranterGrantsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGranteeGrantsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
 		return len(x.Grants) != 0
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1889,17 +3683,17 @@ func (x *fastReflection_QueryGranterGrantsResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranterGrantsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGranteeGrantsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
 		x.Grants = nil
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1909,22 +3703,22 @@ func (x *fastReflection_QueryGranterGrantsResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGranterGrantsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranteeGrantsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
 		if len(x.Grants) == 0 {
-			return protoreflect.ValueOfList(&_QueryGranterGrantsResponse_1_list{})
+			return protoreflect.ValueOfList(&_QueryGranteeGrantsResponse_1_list{})
 		}
-		listValue := &_QueryGranterGrantsResponse_1_list{list: &x.Grants}
+		listValue := &_QueryGranteeGrantsResponse_1_list{list: &x.Grants}
 		return protoreflect.ValueOfList(listValue)
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1938,19 +3732,19 @@ func (x *fastReflection_QueryGranterGrantsResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranterGrantsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGranteeGrantsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
 		lv := value.List()
-		clv := lv.(*_QueryGranterGrantsResponse_1_list)
+		clv := lv.(*_QueryGranteeGrantsResponse_1_list)
 		x.Grants = *clv.list
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1964,53 +3758,53 @@ func (x *fastReflection_QueryGranterGrantsResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranterGrantsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranteeGrantsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
 		if x.Grants == nil {
 			x.Grants = []*v1beta11.GrantAuthorization{}
 		}
-		value := &_QueryGranterGrantsResponse_1_list{list: &x.Grants}
+		value := &_QueryGranteeGrantsResponse_1_list{list: &x.Grants}
 		return protoreflect.ValueOfList(value)
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGranterGrantsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGranteeGrantsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
 		list := []*v1beta11.GrantAuthorization{}
-		return protoreflect.ValueOfList(&_QueryGranterGrantsResponse_1_list{list: &list})
-	case "xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination":
+		return protoreflect.ValueOfList(&_QueryGranteeGrantsResponse_1_list{list: &list})
+	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranterGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranterGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGranterGrantsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGranteeGrantsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGranterGrantsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGranteeGrantsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2018,7 +3812,7 @@ func (x *fastReflection_QueryGranterGrantsResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGranterGrantsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGranteeGrantsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2029,7 +3823,7 @@ func (x *fastReflection_QueryGranterGrantsResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranterGrantsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGranteeGrantsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2041,7 +3835,7 @@ func (x *fastReflection_QueryGranterGrantsResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGranterGrantsResponse) IsValid() bool {
+func (x *fastReflection_QueryGranteeGrantsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2051,9 +3845,9 @@ func (x *fastReflection_QueryGranterGrantsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGranterGrantsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGranteeGrantsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGranterGrantsResponse)
+		x := input.Message.Interface().(*QueryGranteeGrantsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2085,7 +3879,7 @@ func (x *fastReflection_QueryGranterGrantsResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGranterGrantsResponse)
+		x := input.Message.Interface().(*QueryGranteeGrantsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2145,7 +3939,7 @@ func (x *fastReflection_QueryGranterGrantsResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGranterGrantsResponse)
+		x := input.Message.Interface().(*QueryGranteeGrantsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2177,10 +3971,10 @@ func (x *fastReflection_QueryGranterGrantsResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranterGrantsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranteeGrantsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranterGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranteeGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2289,28 +4083,32 @@ func (x *fastReflection_QueryGranterGrantsResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryGranteeGrantsRequest            protoreflect.MessageDescriptor
-	fd_QueryGranteeGrantsRequest_grantee    protoreflect.FieldDescriptor
-	fd_QueryGranteeGrantsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryExpiringGrantsRequest                 protoreflect.MessageDescriptor
+	fd_QueryExpiringGrantsRequest_expiring_before protoreflect.FieldDescriptor
+	fd_QueryExpiringGrantsRequest_granter         protoreflect.FieldDescriptor
+	fd_QueryExpiringGrantsRequest_active_only     protoreflect.FieldDescriptor
+	fd_QueryExpiringGrantsRequest_pagination      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_xion_indexer_authz_v1_query_proto_init()
-	md_QueryGranteeGrantsRequest = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGranteeGrantsRequest")
-	fd_QueryGranteeGrantsRequest_grantee = md_QueryGranteeGrantsRequest.Fields().ByName("grantee")
-	fd_QueryGranteeGrantsRequest_pagination = md_QueryGranteeGrantsRequest.Fields().ByName("pagination")
+	md_QueryExpiringGrantsRequest = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryExpiringGrantsRequest")
+	fd_QueryExpiringGrantsRequest_expiring_before = md_QueryExpiringGrantsRequest.Fields().ByName("expiring_before")
+	fd_QueryExpiringGrantsRequest_granter = md_QueryExpiringGrantsRequest.Fields().ByName("granter")
+	fd_QueryExpiringGrantsRequest_active_only = md_QueryExpiringGrantsRequest.Fields().ByName("active_only")
+	fd_QueryExpiringGrantsRequest_pagination = md_QueryExpiringGrantsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGranteeGrantsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryExpiringGrantsRequest)(nil)
 
-type fastReflection_QueryGranteeGrantsRequest QueryGranteeGrantsRequest
+type fastReflection_QueryExpiringGrantsRequest QueryExpiringGrantsRequest
 
-func (x *QueryGranteeGrantsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGranteeGrantsRequest)(x)
+func (x *QueryExpiringGrantsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryExpiringGrantsRequest)(x)
 }
 
-func (x *QueryGranteeGrantsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[4]
+func (x *QueryExpiringGrantsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2321,43 +4119,43 @@ func (x *QueryGranteeGrantsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGranteeGrantsRequest_messageType fastReflection_QueryGranteeGrantsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGranteeGrantsRequest_messageType{}
+var _fastReflection_QueryExpiringGrantsRequest_messageType fastReflection_QueryExpiringGrantsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryExpiringGrantsRequest_messageType{}
 
-type fastReflection_QueryGranteeGrantsRequest_messageType struct{}
+type fastReflection_QueryExpiringGrantsRequest_messageType struct{}
 
-func (x fastReflection_QueryGranteeGrantsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGranteeGrantsRequest)(nil)
+func (x fastReflection_QueryExpiringGrantsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryExpiringGrantsRequest)(nil)
 }
-func (x fastReflection_QueryGranteeGrantsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGranteeGrantsRequest)
+func (x fastReflection_QueryExpiringGrantsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryExpiringGrantsRequest)
 }
-func (x fastReflection_QueryGranteeGrantsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGranteeGrantsRequest
+func (x fastReflection_QueryExpiringGrantsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExpiringGrantsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGranteeGrantsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGranteeGrantsRequest
+func (x *fastReflection_QueryExpiringGrantsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExpiringGrantsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGranteeGrantsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGranteeGrantsRequest_messageType
+func (x *fastReflection_QueryExpiringGrantsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryExpiringGrantsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGranteeGrantsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGranteeGrantsRequest)
+func (x *fastReflection_QueryExpiringGrantsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryExpiringGrantsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGranteeGrantsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGranteeGrantsRequest)(x)
+func (x *fastReflection_QueryExpiringGrantsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryExpiringGrantsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2365,16 +4163,28 @@ func (x *fastReflection_QueryGranteeGrantsRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGranteeGrantsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Grantee != "" {
-		value := protoreflect.ValueOfString(x.Grantee)
-		if !f(fd_QueryGranteeGrantsRequest_grantee, value) {
+func (x *fastReflection_QueryExpiringGrantsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExpiringBefore != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiringBefore.ProtoReflect())
+		if !f(fd_QueryExpiringGrantsRequest_expiring_before, value) {
+			return
+		}
+	}
+	if x.Granter != "" {
+		value := protoreflect.ValueOfString(x.Granter)
+		if !f(fd_QueryExpiringGrantsRequest_granter, value) {
+			return
+		}
+	}
+	if x.ActiveOnly != false {
+		value := protoreflect.ValueOfBool(x.ActiveOnly)
+		if !f(fd_QueryExpiringGrantsRequest_active_only, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGranteeGrantsRequest_pagination, value) {
+		if !f(fd_QueryExpiringGrantsRequest_pagination, value) {
 			return
 		}
 	}
@@ -2391,17 +4201,21 @@ func (x *fastReflection_QueryGranteeGrantsRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGranteeGrantsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryExpiringGrantsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
-		return x.Grantee != ""
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.expiring_before":
+		return x.ExpiringBefore != nil
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.granter":
+		return x.Granter != ""
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.active_only":
+		return x.ActiveOnly != false
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2411,17 +4225,21 @@ func (x *fastReflection_QueryGranteeGrantsRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranteeGrantsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryExpiringGrantsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
-		x.Grantee = ""
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.expiring_before":
+		x.ExpiringBefore = nil
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.granter":
+		x.Granter = ""
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.active_only":
+		x.ActiveOnly = false
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2431,19 +4249,25 @@ func (x *fastReflection_QueryGranteeGrantsRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGranteeGrantsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExpiringGrantsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
-		value := x.Grantee
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.expiring_before":
+		value := x.ExpiringBefore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.granter":
+		value := x.Granter
 		return protoreflect.ValueOfString(value)
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.active_only":
+		value := x.ActiveOnly
+		return protoreflect.ValueOfBool(value)
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2457,17 +4281,21 @@ func (x *fastReflection_QueryGranteeGrantsRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranteeGrantsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryExpiringGrantsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
-		x.Grantee = value.Interface().(string)
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.expiring_before":
+		x.ExpiringBefore = value.Message().Interface().(*timestamppb.Timestamp)
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.granter":
+		x.Granter = value.Interface().(string)
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.active_only":
+		x.ActiveOnly = value.Bool()
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2481,48 +4309,60 @@ func (x *fastReflection_QueryGranteeGrantsRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranteeGrantsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExpiringGrantsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.expiring_before":
+		if x.ExpiringBefore == nil {
+			x.ExpiringBefore = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiringBefore.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
-		panic(fmt.Errorf("field grantee of message xion.indexer.authz.v1.QueryGranteeGrantsRequest is not mutable"))
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.granter":
+		panic(fmt.Errorf("field granter of message xion.indexer.authz.v1.QueryExpiringGrantsRequest is not mutable"))
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.active_only":
+		panic(fmt.Errorf("field active_only of message xion.indexer.authz.v1.QueryExpiringGrantsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGranteeGrantsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExpiringGrantsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.grantee":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.expiring_before":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.granter":
 		return protoreflect.ValueOfString("")
-	case "xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.active_only":
+		return protoreflect.ValueOfBool(false)
+	case "xion.indexer.authz.v1.QueryExpiringGrantsRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGranteeGrantsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryExpiringGrantsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGranteeGrantsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryExpiringGrantsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2530,7 +4370,7 @@ func (x *fastReflection_QueryGranteeGrantsRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGranteeGrantsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryExpiringGrantsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2541,7 +4381,7 @@ func (x *fastReflection_QueryGranteeGrantsRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranteeGrantsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryExpiringGrantsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2553,7 +4393,7 @@ func (x *fastReflection_QueryGranteeGrantsRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGranteeGrantsRequest) IsValid() bool {
+func (x *fastReflection_QueryExpiringGrantsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2563,9 +4403,9 @@ func (x *fastReflection_QueryGranteeGrantsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGranteeGrantsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryExpiringGrantsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGranteeGrantsRequest)
+		x := input.Message.Interface().(*QueryExpiringGrantsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2577,10 +4417,17 @@ func (x *fastReflection_QueryGranteeGrantsRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		l = len(x.Grantee)
+		if x.ExpiringBefore != nil {
+			l = options.Size(x.ExpiringBefore)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Granter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ActiveOnly {
+			n += 2
+		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -2595,7 +4442,7 @@ func (x *fastReflection_QueryGranteeGrantsRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGranteeGrantsRequest)
+		x := input.Message.Interface().(*QueryExpiringGrantsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2626,12 +4473,36 @@ func (x *fastReflection_QueryGranteeGrantsRequest) ProtoMethods() *protoiface.Me
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x22
+		}
+		if x.ActiveOnly {
+			i--
+			if x.ActiveOnly {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Granter) > 0 {
+			i -= len(x.Granter)
+			copy(dAtA[i:], x.Granter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
+			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Grantee) > 0 {
-			i -= len(x.Grantee)
-			copy(dAtA[i:], x.Grantee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+		if x.ExpiringBefore != nil {
+			encoded, err := options.Marshal(x.ExpiringBefore)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2646,7 +4517,7 @@ func (x *fastReflection_QueryGranteeGrantsRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGranteeGrantsRequest)
+		x := input.Message.Interface().(*QueryExpiringGrantsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2678,15 +4549,51 @@ func (x *fastReflection_QueryGranteeGrantsRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranteeGrantsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExpiringGrantsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranteeGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExpiringGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiringBefore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiringBefore == nil {
+					x.ExpiringBefore = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiringBefore); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2714,9 +4621,29 @@ func (x *fastReflection_QueryGranteeGrantsRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Grantee = string(dAtA[iNdEx:postIndex])
+				x.Granter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ActiveOnly = bool(v != 0)
+			case 4:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
@@ -2787,80 +4714,80 @@ func (x *fastReflection_QueryGranteeGrantsRequest) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_QueryGranteeGrantsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryExpiringGrantsResponse_1_list)(nil)
 
-type _QueryGranteeGrantsResponse_1_list struct {
+type _QueryExpiringGrantsResponse_1_list struct {
 	list *[]*v1beta11.GrantAuthorization
 }
 
-func (x *_QueryGranteeGrantsResponse_1_list) Len() int {
+func (x *_QueryExpiringGrantsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryGranteeGrantsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryExpiringGrantsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryGranteeGrantsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryExpiringGrantsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta11.GrantAuthorization)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryGranteeGrantsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryExpiringGrantsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta11.GrantAuthorization)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryGranteeGrantsResponse_1_list) AppendMutable() protoreflect.Value {
+func (x *_QueryExpiringGrantsResponse_1_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta11.GrantAuthorization)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGranteeGrantsResponse_1_list) Truncate(n int) {
+func (x *_QueryExpiringGrantsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryGranteeGrantsResponse_1_list) NewElement() protoreflect.Value {
+func (x *_QueryExpiringGrantsResponse_1_list) NewElement() protoreflect.Value {
 	v := new(v1beta11.GrantAuthorization)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGranteeGrantsResponse_1_list) IsValid() bool {
+func (x *_QueryExpiringGrantsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryGranteeGrantsResponse            protoreflect.MessageDescriptor
-	fd_QueryGranteeGrantsResponse_grants     protoreflect.FieldDescriptor
-	fd_QueryGranteeGrantsResponse_pagination protoreflect.FieldDescriptor
+	md_QueryExpiringGrantsResponse            protoreflect.MessageDescriptor
+	fd_QueryExpiringGrantsResponse_grants     protoreflect.FieldDescriptor
+	fd_QueryExpiringGrantsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_xion_indexer_authz_v1_query_proto_init()
-	md_QueryGranteeGrantsResponse = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryGranteeGrantsResponse")
-	fd_QueryGranteeGrantsResponse_grants = md_QueryGranteeGrantsResponse.Fields().ByName("grants")
-	fd_QueryGranteeGrantsResponse_pagination = md_QueryGranteeGrantsResponse.Fields().ByName("pagination")
+	md_QueryExpiringGrantsResponse = File_xion_indexer_authz_v1_query_proto.Messages().ByName("QueryExpiringGrantsResponse")
+	fd_QueryExpiringGrantsResponse_grants = md_QueryExpiringGrantsResponse.Fields().ByName("grants")
+	fd_QueryExpiringGrantsResponse_pagination = md_QueryExpiringGrantsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGranteeGrantsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryExpiringGrantsResponse)(nil)
 
-type fastReflection_QueryGranteeGrantsResponse QueryGranteeGrantsResponse
+type fastReflection_QueryExpiringGrantsResponse QueryExpiringGrantsResponse
 
-func (x *QueryGranteeGrantsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGranteeGrantsResponse)(x)
+func (x *QueryExpiringGrantsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryExpiringGrantsResponse)(x)
 }
 
-func (x *QueryGranteeGrantsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[5]
+func (x *QueryExpiringGrantsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2871,43 +4798,43 @@ func (x *QueryGranteeGrantsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGranteeGrantsResponse_messageType fastReflection_QueryGranteeGrantsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGranteeGrantsResponse_messageType{}
+var _fastReflection_QueryExpiringGrantsResponse_messageType fastReflection_QueryExpiringGrantsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryExpiringGrantsResponse_messageType{}
 
-type fastReflection_QueryGranteeGrantsResponse_messageType struct{}
+type fastReflection_QueryExpiringGrantsResponse_messageType struct{}
 
-func (x fastReflection_QueryGranteeGrantsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGranteeGrantsResponse)(nil)
+func (x fastReflection_QueryExpiringGrantsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryExpiringGrantsResponse)(nil)
 }
-func (x fastReflection_QueryGranteeGrantsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGranteeGrantsResponse)
+func (x fastReflection_QueryExpiringGrantsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryExpiringGrantsResponse)
 }
-func (x fastReflection_QueryGranteeGrantsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGranteeGrantsResponse
+func (x fastReflection_QueryExpiringGrantsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExpiringGrantsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGranteeGrantsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGranteeGrantsResponse
+func (x *fastReflection_QueryExpiringGrantsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExpiringGrantsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGranteeGrantsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGranteeGrantsResponse_messageType
+func (x *fastReflection_QueryExpiringGrantsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryExpiringGrantsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGranteeGrantsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGranteeGrantsResponse)
+func (x *fastReflection_QueryExpiringGrantsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryExpiringGrantsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGranteeGrantsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGranteeGrantsResponse)(x)
+func (x *fastReflection_QueryExpiringGrantsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryExpiringGrantsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2915,16 +4842,16 @@ func (x *fastReflection_QueryGranteeGrantsResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGranteeGrantsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryExpiringGrantsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Grants) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGranteeGrantsResponse_1_list{list: &x.Grants})
-		if !f(fd_QueryGranteeGrantsResponse_grants, value) {
+		value := protoreflect.ValueOfList(&_QueryExpiringGrantsResponse_1_list{list: &x.Grants})
+		if !f(fd_QueryExpiringGrantsResponse_grants, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGranteeGrantsResponse_pagination, value) {
+		if !f(fd_QueryExpiringGrantsResponse_pagination, value) {
 			return
 		}
 	}
@@ -2941,17 +4868,17 @@ func (x *fastReflection_QueryGranteeGrantsResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGranteeGrantsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryExpiringGrantsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.grants":
 		return len(x.Grants) != 0
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2961,17 +4888,17 @@ func (x *fastReflection_QueryGranteeGrantsResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranteeGrantsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryExpiringGrantsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.grants":
 		x.Grants = nil
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2981,22 +4908,22 @@ func (x *fastReflection_QueryGranteeGrantsResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGranteeGrantsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExpiringGrantsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.grants":
 		if len(x.Grants) == 0 {
-			return protoreflect.ValueOfList(&_QueryGranteeGrantsResponse_1_list{})
+			return protoreflect.ValueOfList(&_QueryExpiringGrantsResponse_1_list{})
 		}
-		listValue := &_QueryGranteeGrantsResponse_1_list{list: &x.Grants}
+		listValue := &_QueryExpiringGrantsResponse_1_list{list: &x.Grants}
 		return protoreflect.ValueOfList(listValue)
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3010,19 +4937,19 @@ func (x *fastReflection_QueryGranteeGrantsResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranteeGrantsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryExpiringGrantsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.grants":
 		lv := value.List()
-		clv := lv.(*_QueryGranteeGrantsResponse_1_list)
+		clv := lv.(*_QueryExpiringGrantsResponse_1_list)
 		x.Grants = *clv.list
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3036,53 +4963,53 @@ func (x *fastReflection_QueryGranteeGrantsResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranteeGrantsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExpiringGrantsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.grants":
 		if x.Grants == nil {
 			x.Grants = []*v1beta11.GrantAuthorization{}
 		}
-		value := &_QueryGranteeGrantsResponse_1_list{list: &x.Grants}
+		value := &_QueryExpiringGrantsResponse_1_list{list: &x.Grants}
 		return protoreflect.ValueOfList(value)
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGranteeGrantsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExpiringGrantsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants":
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.grants":
 		list := []*v1beta11.GrantAuthorization{}
-		return protoreflect.ValueOfList(&_QueryGranteeGrantsResponse_1_list{list: &list})
-	case "xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination":
+		return protoreflect.ValueOfList(&_QueryExpiringGrantsResponse_1_list{list: &list})
+	case "xion.indexer.authz.v1.QueryExpiringGrantsResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryGranteeGrantsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.authz.v1.QueryExpiringGrantsResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryGranteeGrantsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.authz.v1.QueryExpiringGrantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGranteeGrantsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryExpiringGrantsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryGranteeGrantsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.authz.v1.QueryExpiringGrantsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3090,7 +5017,7 @@ func (x *fastReflection_QueryGranteeGrantsResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGranteeGrantsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryExpiringGrantsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3101,7 +5028,7 @@ func (x *fastReflection_QueryGranteeGrantsResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGranteeGrantsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryExpiringGrantsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3113,7 +5040,7 @@ func (x *fastReflection_QueryGranteeGrantsResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGranteeGrantsResponse) IsValid() bool {
+func (x *fastReflection_QueryExpiringGrantsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3123,9 +5050,9 @@ func (x *fastReflection_QueryGranteeGrantsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGranteeGrantsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryExpiringGrantsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGranteeGrantsResponse)
+		x := input.Message.Interface().(*QueryExpiringGrantsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3157,7 +5084,7 @@ func (x *fastReflection_QueryGranteeGrantsResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGranteeGrantsResponse)
+		x := input.Message.Interface().(*QueryExpiringGrantsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3217,7 +5144,7 @@ func (x *fastReflection_QueryGranteeGrantsResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGranteeGrantsResponse)
+		x := input.Message.Interface().(*QueryExpiringGrantsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3249,10 +5176,10 @@ func (x *fastReflection_QueryGranteeGrantsResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranteeGrantsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExpiringGrantsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGranteeGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExpiringGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3375,6 +5302,106 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ExpirationStatus selects grants by whether they have expired at the time of
+// the latest indexed block.
+type ExpirationStatus int32
+
+const (
+	// EXPIRATION_STATUS_UNSPECIFIED matches all grants.
+	ExpirationStatus_EXPIRATION_STATUS_UNSPECIFIED ExpirationStatus = 0
+	// EXPIRATION_STATUS_ACTIVE matches grants that have not expired.
+	ExpirationStatus_EXPIRATION_STATUS_ACTIVE ExpirationStatus = 1
+	// EXPIRATION_STATUS_EXPIRED matches grants that have expired.
+	ExpirationStatus_EXPIRATION_STATUS_EXPIRED ExpirationStatus = 2
+)
+
+// Enum value maps for ExpirationStatus.
+var (
+	ExpirationStatus_name = map[int32]string{
+		0: "EXPIRATION_STATUS_UNSPECIFIED",
+		1: "EXPIRATION_STATUS_ACTIVE",
+		2: "EXPIRATION_STATUS_EXPIRED",
+	}
+	ExpirationStatus_value = map[string]int32{
+		"EXPIRATION_STATUS_UNSPECIFIED": 0,
+		"EXPIRATION_STATUS_ACTIVE":      1,
+		"EXPIRATION_STATUS_EXPIRED":     2,
+	}
+)
+
+func (x ExpirationStatus) Enum() *ExpirationStatus {
+	p := new(ExpirationStatus)
+	*p = x
+	return p
+}
+
+func (x ExpirationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExpirationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_xion_indexer_authz_v1_query_proto_enumTypes[0].Descriptor()
+}
+
+func (ExpirationStatus) Type() protoreflect.EnumType {
+	return &file_xion_indexer_authz_v1_query_proto_enumTypes[0]
+}
+
+func (x ExpirationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExpirationStatus.Descriptor instead.
+func (ExpirationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// ExpirationFilter narrows the grants returned by their expiration.
+type ExpirationFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status selects grants by whether they have expired.
+	Status ExpirationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=xion.indexer.authz.v1.ExpirationStatus" json:"status,omitempty"`
+	// expiring_before, if set, only matches grants that expire before this time.
+	ExpiringBefore *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiring_before,json=expiringBefore,proto3" json:"expiring_before,omitempty"`
+}
+
+func (x *ExpirationFilter) Reset() {
+	*x = ExpirationFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpirationFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpirationFilter) ProtoMessage() {}
+
+// Deprecated: Use ExpirationFilter.ProtoReflect.Descriptor instead.
+func (*ExpirationFilter) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExpirationFilter) GetStatus() ExpirationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExpirationStatus_EXPIRATION_STATUS_UNSPECIFIED
+}
+
+func (x *ExpirationFilter) GetExpiringBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiringBefore
+	}
+	return nil
+}
+
 // QueryGrantsRequest is the request type for the Query/Grants RPC method.
 type QueryGrantsRequest struct {
 	state         protoimpl.MessageState
@@ -3390,12 +5417,14 @@ type QueryGrantsRequest struct {
 	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 	// pagination defines pagination for the request.
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiration narrows the grants returned by their expiration.
+	Expiration *ExpirationFilter `protobuf:"bytes,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
 func (x *QueryGrantsRequest) Reset() {
 	*x = QueryGrantsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[0]
+		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3409,7 +5438,7 @@ func (*QueryGrantsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGrantsRequest.ProtoReflect.Descriptor instead.
 func (*QueryGrantsRequest) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{0}
+	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *QueryGrantsRequest) GetGranter() string {
@@ -3440,6 +5469,13 @@ func (x *QueryGrantsRequest) GetPagination() *v1beta1.PageRequest {
 	return nil
 }
 
+func (x *QueryGrantsRequest) GetExpiration() *ExpirationFilter {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
 // QueryGrantsResponse is the response type for the Query/Authorizations RPC
 // method.
 type QueryGrantsResponse struct {
@@ -3456,7 +5492,7 @@ type QueryGrantsResponse struct {
 func (x *QueryGrantsResponse) Reset() {
 	*x = QueryGrantsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[1]
+		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3470,7 +5506,7 @@ func (*QueryGrantsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGrantsResponse.ProtoReflect.Descriptor instead.
 func (*QueryGrantsResponse) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{1}
+	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *QueryGrantsResponse) GetGrants() []*v1beta11.Grant {
@@ -3498,12 +5534,14 @@ type QueryGranterGrantsRequest struct {
 	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
 	// pagination defines pagination for the request.
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiration narrows the grants returned by their expiration.
+	Expiration *ExpirationFilter `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
 func (x *QueryGranterGrantsRequest) Reset() {
 	*x = QueryGranterGrantsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[2]
+		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3517,7 +5555,7 @@ func (*QueryGranterGrantsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGranterGrantsRequest.ProtoReflect.Descriptor instead.
 func (*QueryGranterGrantsRequest) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{2}
+	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *QueryGranterGrantsRequest) GetGranter() string {
@@ -3534,6 +5572,13 @@ func (x *QueryGranterGrantsRequest) GetPagination() *v1beta1.PageRequest {
 	return nil
 }
 
+func (x *QueryGranterGrantsRequest) GetExpiration() *ExpirationFilter {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
 // QueryGranterGrantsResponse is the response type for the Query/GranterGrants
 // RPC method.
 type QueryGranterGrantsResponse struct {
@@ -3550,7 +5595,7 @@ type QueryGranterGrantsResponse struct {
 func (x *QueryGranterGrantsResponse) Reset() {
 	*x = QueryGranterGrantsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[3]
+		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3564,7 +5609,7 @@ func (*QueryGranterGrantsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGranterGrantsResponse.ProtoReflect.Descriptor instead.
 func (*QueryGranterGrantsResponse) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{3}
+	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *QueryGranterGrantsResponse) GetGrants() []*v1beta11.GrantAuthorization {
@@ -3592,12 +5637,14 @@ type QueryGranteeGrantsRequest struct {
 	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// pagination defines pagination for the request.
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiration narrows the grants returned by their expiration.
+	Expiration *ExpirationFilter `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
 func (x *QueryGranteeGrantsRequest) Reset() {
 	*x = QueryGranteeGrantsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[4]
+		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3611,7 +5658,7 @@ func (*QueryGranteeGrantsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGranteeGrantsRequest.ProtoReflect.Descriptor instead.
 func (*QueryGranteeGrantsRequest) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{4}
+	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *QueryGranteeGrantsRequest) GetGrantee() string {
@@ -3628,6 +5675,13 @@ func (x *QueryGranteeGrantsRequest) GetPagination() *v1beta1.PageRequest {
 	return nil
 }
 
+func (x *QueryGranteeGrantsRequest) GetExpiration() *ExpirationFilter {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
 // QueryGranteeGrantsResponse is the response type for the Query/GranteeGrants
 // RPC method.
 type QueryGranteeGrantsResponse struct {
@@ -3644,7 +5698,7 @@ type QueryGranteeGrantsResponse struct {
 func (x *QueryGranteeGrantsResponse) Reset() {
 	*x = QueryGranteeGrantsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[5]
+		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3658,7 +5712,7 @@ func (*QueryGranteeGrantsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGranteeGrantsResponse.ProtoReflect.Descriptor instead.
 func (*QueryGranteeGrantsResponse) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *QueryGranteeGrantsResponse) GetGrants() []*v1beta11.GrantAuthorization {
@@ -3675,6 +5729,118 @@ func (x *QueryGranteeGrantsResponse) GetPagination() *v1beta1.PageResponse {
 	return nil
 }
 
+// QueryExpiringGrantsRequest is the request type for the Query/ExpiringGrants
+// RPC method.
+type QueryExpiringGrantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// expiring_before is the time the grants expire before.
+	ExpiringBefore *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expiring_before,json=expiringBefore,proto3" json:"expiring_before,omitempty"`
+	// granter, if set, only matches grants granted by this address.
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	// active_only excludes the grants that have already expired.
+	ActiveOnly bool `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	// pagination defines pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryExpiringGrantsRequest) Reset() {
+	*x = QueryExpiringGrantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryExpiringGrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExpiringGrantsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryExpiringGrantsRequest.ProtoReflect.Descriptor instead.
+func (*QueryExpiringGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryExpiringGrantsRequest) GetExpiringBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiringBefore
+	}
+	return nil
+}
+
+func (x *QueryExpiringGrantsRequest) GetGranter() string {
+	if x != nil {
+		return x.Granter
+	}
+	return ""
+}
+
+func (x *QueryExpiringGrantsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *QueryExpiringGrantsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryExpiringGrantsResponse is the response type for the
+// Query/ExpiringGrants RPC method.
+type QueryExpiringGrantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// grants is a list of grants ordered by expiration.
+	Grants []*v1beta11.GrantAuthorization `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	// pagination defines pagination for the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryExpiringGrantsResponse) Reset() {
+	*x = QueryExpiringGrantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_indexer_authz_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryExpiringGrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExpiringGrantsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryExpiringGrantsResponse.ProtoReflect.Descriptor instead.
+func (*QueryExpiringGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_authz_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryExpiringGrantsResponse) GetGrants() []*v1beta11.GrantAuthorization {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+func (x *QueryExpiringGrantsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 var File_xion_indexer_authz_v1_query_proto protoreflect.FileDescriptor
 
 var file_xion_indexer_authz_v1_query_proto_rawDesc = []byte{
@@ -3690,117 +5856,190 @@ var file_xion_indexer_authz_v1_query_proto_rawDesc = []byte{
 	0x68, 0x7a, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xe6, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e,
-	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3f, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d,
+	0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0xb5, 0x02,
+	0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07,
-	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0c,
+	0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x46,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x69, 0x6f,
+	0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe6, 0x01, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x69, 0x6f, 0x6e,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe6,
+	0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
 	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65,
-	0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55,
-	0x72, 0x6c, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52,
-	0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x97, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65,
-	0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
-	0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x1a, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x67, 0x72, 0x61,
-	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
+	0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78,
+	0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x88, 0x02, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa8, 0x01, 0x0a,
+	0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x47,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x72, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c,
+	0x0a, 0x18, 0x45, 0x58, 0x50, 0x49, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x45, 0x58, 0x50, 0x49, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02, 0x32, 0x93, 0x05, 0x0a, 0x05,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x06, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x12, 0x29, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x78, 0x69,
+	0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12,
+	0x1d, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0xad,
+	0x01, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x12, 0x30, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f,
+	0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x75, 0x74,
+	0x68, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x72, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x12, 0xad,
+	0x01, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x12, 0x30, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
 	0x61, 0x6e, 0x74, 0x65, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67,
-	0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa7,
-	0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x47,
-	0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
-	0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12,
-	0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xf0, 0x03, 0x0a, 0x05, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x06, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x2e,
-	0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x78,
-	0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x75, 0x74, 0x68,
-	0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0xad, 0x01, 0x0a, 0x0d,
-	0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x2e,
-	0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74,
-	0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x31, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61,
-	0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x78, 0x69, 0x6f,
-	0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2f,
-	0x76, 0x31, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
-	0x72, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x12, 0xad, 0x01, 0x0a, 0x0d,
-	0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x2e,
-	0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74,
-	0x65, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x31, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61,
-	0x6e, 0x74, 0x65, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x78, 0x69, 0x6f,
-	0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2f,
-	0x76, 0x31, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
-	0x65, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x7d, 0x42, 0xdc, 0x01, 0x0a, 0x19,
-	0x63, 0x6f, 0x6d, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x6e, 0x74, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x78,
-	0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75,
-	0x74, 0x68, 0x7a, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x58, 0x49, 0x41, 0xaa, 0x02, 0x15, 0x58, 0x69,
-	0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x7a,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x58, 0x69, 0x6f, 0x6e, 0x5c, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x58, 0x69,
-	0x6f, 0x6e, 0x5c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x7a,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x18, 0x58, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x3a,
-	0x3a, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f,
+	0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x75, 0x74,
+	0x68, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x65, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x7d, 0x12, 0xa0,
+	0x01, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x31, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
+	0x12, 0x1f, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f,
+	0x61, 0x75, 0x74, 0x68, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x42, 0xdc, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x42,
+	0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x6e, 0x74, 0x2d,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x78, 0x69,
+	0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a,
+	0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x58, 0x49,
+	0x41, 0xaa, 0x02, 0x15, 0x58, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x58, 0x69, 0x6f, 0x6e,
+	0x5c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x21, 0x58, 0x69, 0x6f, 0x6e, 0x5c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x5c, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x58, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x3a, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3815,40 +6054,57 @@ func file_xion_indexer_authz_v1_query_proto_rawDescGZIP() []byte {
 	return file_xion_indexer_authz_v1_query_proto_rawDescData
 }
 
-var file_xion_indexer_authz_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_xion_indexer_authz_v1_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_xion_indexer_authz_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_xion_indexer_authz_v1_query_proto_goTypes = []interface{}{
-	(*QueryGrantsRequest)(nil),          // 0: xion.indexer.authz.v1.QueryGrantsRequest
-	(*QueryGrantsResponse)(nil),         // 1: xion.indexer.authz.v1.QueryGrantsResponse
-	(*QueryGranterGrantsRequest)(nil),   // 2: xion.indexer.authz.v1.QueryGranterGrantsRequest
-	(*QueryGranterGrantsResponse)(nil),  // 3: xion.indexer.authz.v1.QueryGranterGrantsResponse
-	(*QueryGranteeGrantsRequest)(nil),   // 4: xion.indexer.authz.v1.QueryGranteeGrantsRequest
-	(*QueryGranteeGrantsResponse)(nil),  // 5: xion.indexer.authz.v1.QueryGranteeGrantsResponse
-	(*v1beta1.PageRequest)(nil),         // 6: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta11.Grant)(nil),              // 7: cosmos.authz.v1beta1.Grant
-	(*v1beta1.PageResponse)(nil),        // 8: cosmos.base.query.v1beta1.PageResponse
-	(*v1beta11.GrantAuthorization)(nil), // 9: cosmos.authz.v1beta1.GrantAuthorization
+	(ExpirationStatus)(0),               // 0: xion.indexer.authz.v1.ExpirationStatus
+	(*ExpirationFilter)(nil),            // 1: xion.indexer.authz.v1.ExpirationFilter
+	(*QueryGrantsRequest)(nil),          // 2: xion.indexer.authz.v1.QueryGrantsRequest
+	(*QueryGrantsResponse)(nil),         // 3: xion.indexer.authz.v1.QueryGrantsResponse
+	(*QueryGranterGrantsRequest)(nil),   // 4: xion.indexer.authz.v1.QueryGranterGrantsRequest
+	(*QueryGranterGrantsResponse)(nil),  // 5: xion.indexer.authz.v1.QueryGranterGrantsResponse
+	(*QueryGranteeGrantsRequest)(nil),   // 6: xion.indexer.authz.v1.QueryGranteeGrantsRequest
+	(*QueryGranteeGrantsResponse)(nil),  // 7: xion.indexer.authz.v1.QueryGranteeGrantsResponse
+	(*QueryExpiringGrantsRequest)(nil),  // 8: xion.indexer.authz.v1.QueryExpiringGrantsRequest
+	(*QueryExpiringGrantsResponse)(nil), // 9: xion.indexer.authz.v1.QueryExpiringGrantsResponse
+	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
+	(*v1beta1.PageRequest)(nil),         // 11: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta11.Grant)(nil),              // 12: cosmos.authz.v1beta1.Grant
+	(*v1beta1.PageResponse)(nil),        // 13: cosmos.base.query.v1beta1.PageResponse
+	(*v1beta11.GrantAuthorization)(nil), // 14: cosmos.authz.v1beta1.GrantAuthorization
 }
 var file_xion_indexer_authz_v1_query_proto_depIdxs = []int32{
-	6,  // 0: xion.indexer.authz.v1.QueryGrantsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	7,  // 1: xion.indexer.authz.v1.QueryGrantsResponse.grants:type_name -> cosmos.authz.v1beta1.Grant
-	8,  // 2: xion.indexer.authz.v1.QueryGrantsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	6,  // 3: xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	9,  // 4: xion.indexer.authz.v1.QueryGranterGrantsResponse.grants:type_name -> cosmos.authz.v1beta1.GrantAuthorization
-	8,  // 5: xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	6,  // 6: xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	9,  // 7: xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants:type_name -> cosmos.authz.v1beta1.GrantAuthorization
-	8,  // 8: xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	0,  // 9: xion.indexer.authz.v1.Query.Grants:input_type -> xion.indexer.authz.v1.QueryGrantsRequest
-	2,  // 10: xion.indexer.authz.v1.Query.GranterGrants:input_type -> xion.indexer.authz.v1.QueryGranterGrantsRequest
-	4,  // 11: xion.indexer.authz.v1.Query.GranteeGrants:input_type -> xion.indexer.authz.v1.QueryGranteeGrantsRequest
-	1,  // 12: xion.indexer.authz.v1.Query.Grants:output_type -> xion.indexer.authz.v1.QueryGrantsResponse
-	3,  // 13: xion.indexer.authz.v1.Query.GranterGrants:output_type -> xion.indexer.authz.v1.QueryGranterGrantsResponse
-	5,  // 14: xion.indexer.authz.v1.Query.GranteeGrants:output_type -> xion.indexer.authz.v1.QueryGranteeGrantsResponse
-	12, // [12:15] is the sub-list for method output_type
-	9,  // [9:12] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	0,  // 0: xion.indexer.authz.v1.ExpirationFilter.status:type_name -> xion.indexer.authz.v1.ExpirationStatus
+	10, // 1: xion.indexer.authz.v1.ExpirationFilter.expiring_before:type_name -> google.protobuf.Timestamp
+	11, // 2: xion.indexer.authz.v1.QueryGrantsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	1,  // 3: xion.indexer.authz.v1.QueryGrantsRequest.expiration:type_name -> xion.indexer.authz.v1.ExpirationFilter
+	12, // 4: xion.indexer.authz.v1.QueryGrantsResponse.grants:type_name -> cosmos.authz.v1beta1.Grant
+	13, // 5: xion.indexer.authz.v1.QueryGrantsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	11, // 6: xion.indexer.authz.v1.QueryGranterGrantsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	1,  // 7: xion.indexer.authz.v1.QueryGranterGrantsRequest.expiration:type_name -> xion.indexer.authz.v1.ExpirationFilter
+	14, // 8: xion.indexer.authz.v1.QueryGranterGrantsResponse.grants:type_name -> cosmos.authz.v1beta1.GrantAuthorization
+	13, // 9: xion.indexer.authz.v1.QueryGranterGrantsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	11, // 10: xion.indexer.authz.v1.QueryGranteeGrantsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	1,  // 11: xion.indexer.authz.v1.QueryGranteeGrantsRequest.expiration:type_name -> xion.indexer.authz.v1.ExpirationFilter
+	14, // 12: xion.indexer.authz.v1.QueryGranteeGrantsResponse.grants:type_name -> cosmos.authz.v1beta1.GrantAuthorization
+	13, // 13: xion.indexer.authz.v1.QueryGranteeGrantsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	10, // 14: xion.indexer.authz.v1.QueryExpiringGrantsRequest.expiring_before:type_name -> google.protobuf.Timestamp
+	11, // 15: xion.indexer.authz.v1.QueryExpiringGrantsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	14, // 16: xion.indexer.authz.v1.QueryExpiringGrantsResponse.grants:type_name -> cosmos.authz.v1beta1.GrantAuthorization
+	13, // 17: xion.indexer.authz.v1.QueryExpiringGrantsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	2,  // 18: xion.indexer.authz.v1.Query.Grants:input_type -> xion.indexer.authz.v1.QueryGrantsRequest
+	4,  // 19: xion.indexer.authz.v1.Query.GranterGrants:input_type -> xion.indexer.authz.v1.QueryGranterGrantsRequest
+	6,  // 20: xion.indexer.authz.v1.Query.GranteeGrants:input_type -> xion.indexer.authz.v1.QueryGranteeGrantsRequest
+	8,  // 21: xion.indexer.authz.v1.Query.ExpiringGrants:input_type -> xion.indexer.authz.v1.QueryExpiringGrantsRequest
+	3,  // 22: xion.indexer.authz.v1.Query.Grants:output_type -> xion.indexer.authz.v1.QueryGrantsResponse
+	5,  // 23: xion.indexer.authz.v1.Query.GranterGrants:output_type -> xion.indexer.authz.v1.QueryGranterGrantsResponse
+	7,  // 24: xion.indexer.authz.v1.Query.GranteeGrants:output_type -> xion.indexer.authz.v1.QueryGranteeGrantsResponse
+	9,  // 25: xion.indexer.authz.v1.Query.ExpiringGrants:output_type -> xion.indexer.authz.v1.QueryExpiringGrantsResponse
+	22, // [22:26] is the sub-list for method output_type
+	18, // [18:22] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_xion_indexer_authz_v1_query_proto_init() }
@@ -3858,7 +6114,7 @@ func file_xion_indexer_authz_v1_query_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_xion_indexer_authz_v1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGrantsRequest); i {
+			switch v := v.(*ExpirationFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3870,7 +6126,7 @@ func file_xion_indexer_authz_v1_query_proto_init() {
 			}
 		}
 		file_xion_indexer_authz_v1_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGrantsResponse); i {
+			switch v := v.(*QueryGrantsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3882,7 +6138,7 @@ func file_xion_indexer_authz_v1_query_proto_init() {
 			}
 		}
 		file_xion_indexer_authz_v1_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGranterGrantsRequest); i {
+			switch v := v.(*QueryGrantsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3894,7 +6150,7 @@ func file_xion_indexer_authz_v1_query_proto_init() {
 			}
 		}
 		file_xion_indexer_authz_v1_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGranterGrantsResponse); i {
+			switch v := v.(*QueryGranterGrantsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3906,7 +6162,7 @@ func file_xion_indexer_authz_v1_query_proto_init() {
 			}
 		}
 		file_xion_indexer_authz_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGranteeGrantsRequest); i {
+			switch v := v.(*QueryGranterGrantsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3918,6 +6174,18 @@ func file_xion_indexer_authz_v1_query_proto_init() {
 			}
 		}
 		file_xion_indexer_authz_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGranteeGrantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xion_indexer_authz_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryGranteeGrantsResponse); i {
 			case 0:
 				return &v.state
@@ -3929,19 +6197,44 @@ func file_xion_indexer_authz_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_xion_indexer_authz_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryExpiringGrantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xion_indexer_authz_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryExpiringGrantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_xion_indexer_authz_v1_query_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_xion_indexer_authz_v1_query_proto_goTypes,
 		DependencyIndexes: file_xion_indexer_authz_v1_query_proto_depIdxs,
+		EnumInfos:         file_xion_indexer_authz_v1_query_proto_enumTypes,
 		MessageInfos:      file_xion_indexer_authz_v1_query_proto_msgTypes,
 	}.Build()
 	File_xion_indexer_authz_v1_query_proto = out.File
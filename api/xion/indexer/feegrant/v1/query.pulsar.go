@@ -3,41 +3,44 @@ package feegrantv1
 
 import (
 	v1beta11 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	v1beta12 "cosmossdk.io/api/cosmos/base/v1beta1"
 	v1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
 var (
-	md_QueryAllowanceRequest         protoreflect.MessageDescriptor
-	fd_QueryAllowanceRequest_granter protoreflect.FieldDescriptor
-	fd_QueryAllowanceRequest_grantee protoreflect.FieldDescriptor
+	md_ExpirationFilter                 protoreflect.MessageDescriptor
+	fd_ExpirationFilter_status          protoreflect.FieldDescriptor
+	fd_ExpirationFilter_expiring_before protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_xion_indexer_feegrant_v1_query_proto_init()
-	md_QueryAllowanceRequest = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowanceRequest")
-	fd_QueryAllowanceRequest_granter = md_QueryAllowanceRequest.Fields().ByName("granter")
-	fd_QueryAllowanceRequest_grantee = md_QueryAllowanceRequest.Fields().ByName("grantee")
+	md_ExpirationFilter = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("ExpirationFilter")
+	fd_ExpirationFilter_status = md_ExpirationFilter.Fields().ByName("status")
+	fd_ExpirationFilter_expiring_before = md_ExpirationFilter.Fields().ByName("expiring_before")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllowanceRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_ExpirationFilter)(nil)
 
-type fastReflection_QueryAllowanceRequest QueryAllowanceRequest
+type fastReflection_ExpirationFilter ExpirationFilter
 
-func (x *QueryAllowanceRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllowanceRequest)(x)
+func (x *ExpirationFilter) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExpirationFilter)(x)
 }
 
-func (x *QueryAllowanceRequest) slowProtoReflect() protoreflect.Message {
+func (x *ExpirationFilter) slowProtoReflect() protoreflect.Message {
 	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -49,43 +52,43 @@ func (x *QueryAllowanceRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllowanceRequest_messageType fastReflection_QueryAllowanceRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllowanceRequest_messageType{}
+var _fastReflection_ExpirationFilter_messageType fastReflection_ExpirationFilter_messageType
+var _ protoreflect.MessageType = fastReflection_ExpirationFilter_messageType{}
 
-type fastReflection_QueryAllowanceRequest_messageType struct{}
+type fastReflection_ExpirationFilter_messageType struct{}
 
-func (x fastReflection_QueryAllowanceRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllowanceRequest)(nil)
+func (x fastReflection_ExpirationFilter_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExpirationFilter)(nil)
 }
-func (x fastReflection_QueryAllowanceRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowanceRequest)
+func (x fastReflection_ExpirationFilter_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExpirationFilter)
 }
-func (x fastReflection_QueryAllowanceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowanceRequest
+func (x fastReflection_ExpirationFilter_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExpirationFilter
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllowanceRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowanceRequest
+func (x *fastReflection_ExpirationFilter) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExpirationFilter
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllowanceRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllowanceRequest_messageType
+func (x *fastReflection_ExpirationFilter) Type() protoreflect.MessageType {
+	return _fastReflection_ExpirationFilter_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllowanceRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowanceRequest)
+func (x *fastReflection_ExpirationFilter) New() protoreflect.Message {
+	return new(fastReflection_ExpirationFilter)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllowanceRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllowanceRequest)(x)
+func (x *fastReflection_ExpirationFilter) Interface() protoreflect.ProtoMessage {
+	return (*ExpirationFilter)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -93,16 +96,16 @@ func (x *fastReflection_QueryAllowanceRequest) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllowanceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Granter != "" {
-		value := protoreflect.ValueOfString(x.Granter)
-		if !f(fd_QueryAllowanceRequest_granter, value) {
+func (x *fastReflection_ExpirationFilter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_ExpirationFilter_status, value) {
 			return
 		}
 	}
-	if x.Grantee != "" {
-		value := protoreflect.ValueOfString(x.Grantee)
-		if !f(fd_QueryAllowanceRequest_grantee, value) {
+	if x.ExpiringBefore != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiringBefore.ProtoReflect())
+		if !f(fd_ExpirationFilter_expiring_before, value) {
 			return
 		}
 	}
@@ -119,17 +122,17 @@ func (x *fastReflection_QueryAllowanceRequest) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllowanceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ExpirationFilter) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
-		return x.Granter != ""
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
-		return x.Grantee != ""
+	case "xion.indexer.feegrant.v1.ExpirationFilter.status":
+		return x.Status != 0
+	case "xion.indexer.feegrant.v1.ExpirationFilter.expiring_before":
+		return x.ExpiringBefore != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.ExpirationFilter"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.ExpirationFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -139,17 +142,17 @@ func (x *fastReflection_QueryAllowanceRequest) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowanceRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ExpirationFilter) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
-		x.Granter = ""
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
-		x.Grantee = ""
+	case "xion.indexer.feegrant.v1.ExpirationFilter.status":
+		x.Status = 0
+	case "xion.indexer.feegrant.v1.ExpirationFilter.expiring_before":
+		x.ExpiringBefore = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.ExpirationFilter"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.ExpirationFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -159,19 +162,19 @@ func (x *fastReflection_QueryAllowanceRequest) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllowanceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExpirationFilter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
-		value := x.Granter
-		return protoreflect.ValueOfString(value)
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
-		value := x.Grantee
-		return protoreflect.ValueOfString(value)
+	case "xion.indexer.feegrant.v1.ExpirationFilter.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "xion.indexer.feegrant.v1.ExpirationFilter.expiring_before":
+		value := x.ExpiringBefore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.ExpirationFilter"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.ExpirationFilter does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -185,17 +188,17 @@ func (x *fastReflection_QueryAllowanceRequest) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowanceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ExpirationFilter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
-		x.Granter = value.Interface().(string)
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
-		x.Grantee = value.Interface().(string)
+	case "xion.indexer.feegrant.v1.ExpirationFilter.status":
+		x.Status = (ExpirationStatus)(value.Enum())
+	case "xion.indexer.feegrant.v1.ExpirationFilter.expiring_before":
+		x.ExpiringBefore = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.ExpirationFilter"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.ExpirationFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -209,44 +212,48 @@ func (x *fastReflection_QueryAllowanceRequest) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowanceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExpirationFilter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
-		panic(fmt.Errorf("field granter of message xion.indexer.feegrant.v1.QueryAllowanceRequest is not mutable"))
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
-		panic(fmt.Errorf("field grantee of message xion.indexer.feegrant.v1.QueryAllowanceRequest is not mutable"))
+	case "xion.indexer.feegrant.v1.ExpirationFilter.expiring_before":
+		if x.ExpiringBefore == nil {
+			x.ExpiringBefore = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiringBefore.ProtoReflect())
+	case "xion.indexer.feegrant.v1.ExpirationFilter.status":
+		panic(fmt.Errorf("field status of message xion.indexer.feegrant.v1.ExpirationFilter is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.ExpirationFilter"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.ExpirationFilter does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllowanceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExpirationFilter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
-		return protoreflect.ValueOfString("")
-	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
-		return protoreflect.ValueOfString("")
+	case "xion.indexer.feegrant.v1.ExpirationFilter.status":
+		return protoreflect.ValueOfEnum(0)
+	case "xion.indexer.feegrant.v1.ExpirationFilter.expiring_before":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.ExpirationFilter"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.ExpirationFilter does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllowanceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ExpirationFilter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowanceRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.ExpirationFilter", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -254,7 +261,7 @@ func (x *fastReflection_QueryAllowanceRequest) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllowanceRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ExpirationFilter) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -265,7 +272,7 @@ func (x *fastReflection_QueryAllowanceRequest) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowanceRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ExpirationFilter) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -277,7 +284,7 @@ func (x *fastReflection_QueryAllowanceRequest) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllowanceRequest) IsValid() bool {
+func (x *fastReflection_ExpirationFilter) IsValid() bool {
 	return x != nil
 }
 
@@ -287,9 +294,9 @@ func (x *fastReflection_QueryAllowanceRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllowanceRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ExpirationFilter) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllowanceRequest)
+		x := input.Message.Interface().(*ExpirationFilter)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -301,12 +308,11 @@ func (x *fastReflection_QueryAllowanceRequest) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.Granter)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
 		}
-		l = len(x.Grantee)
-		if l > 0 {
+		if x.ExpiringBefore != nil {
+			l = options.Size(x.ExpiringBefore)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -319,7 +325,7 @@ func (x *fastReflection_QueryAllowanceRequest) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowanceRequest)
+		x := input.Message.Interface().(*ExpirationFilter)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -338,19 +344,24 @@ func (x *fastReflection_QueryAllowanceRequest) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Grantee) > 0 {
-			i -= len(x.Grantee)
-			copy(dAtA[i:], x.Grantee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+		if x.ExpiringBefore != nil {
+			encoded, err := options.Marshal(x.ExpiringBefore)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Granter) > 0 {
-			i -= len(x.Granter)
-			copy(dAtA[i:], x.Granter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -363,7 +374,7 @@ func (x *fastReflection_QueryAllowanceRequest) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowanceRequest)
+		x := input.Message.Interface().(*ExpirationFilter)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -395,17 +406,17 @@ func (x *fastReflection_QueryAllowanceRequest) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExpirationFilter: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExpirationFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 				}
-				var stringLen uint64
+				x.Status = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -415,29 +426,16 @@ func (x *fastReflection_QueryAllowanceRequest) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Status |= ExpirationStatus(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Granter = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiringBefore", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -447,23 +445,27 @@ func (x *fastReflection_QueryAllowanceRequest) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Grantee = string(dAtA[iNdEx:postIndex])
+				if x.ExpiringBefore == nil {
+					x.ExpiringBefore = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiringBefore); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -501,25 +503,27 @@ func (x *fastReflection_QueryAllowanceRequest) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_QueryAllowanceResponse           protoreflect.MessageDescriptor
-	fd_QueryAllowanceResponse_allowance protoreflect.FieldDescriptor
+	md_QueryAllowanceRequest         protoreflect.MessageDescriptor
+	fd_QueryAllowanceRequest_granter protoreflect.FieldDescriptor
+	fd_QueryAllowanceRequest_grantee protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_xion_indexer_feegrant_v1_query_proto_init()
-	md_QueryAllowanceResponse = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowanceResponse")
-	fd_QueryAllowanceResponse_allowance = md_QueryAllowanceResponse.Fields().ByName("allowance")
+	md_QueryAllowanceRequest = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowanceRequest")
+	fd_QueryAllowanceRequest_granter = md_QueryAllowanceRequest.Fields().ByName("granter")
+	fd_QueryAllowanceRequest_grantee = md_QueryAllowanceRequest.Fields().ByName("grantee")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllowanceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAllowanceRequest)(nil)
 
-type fastReflection_QueryAllowanceResponse QueryAllowanceResponse
+type fastReflection_QueryAllowanceRequest QueryAllowanceRequest
 
-func (x *QueryAllowanceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllowanceResponse)(x)
+func (x *QueryAllowanceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllowanceRequest)(x)
 }
 
-func (x *QueryAllowanceResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryAllowanceRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -531,43 +535,43 @@ func (x *QueryAllowanceResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllowanceResponse_messageType fastReflection_QueryAllowanceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllowanceResponse_messageType{}
+var _fastReflection_QueryAllowanceRequest_messageType fastReflection_QueryAllowanceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllowanceRequest_messageType{}
 
-type fastReflection_QueryAllowanceResponse_messageType struct{}
+type fastReflection_QueryAllowanceRequest_messageType struct{}
 
-func (x fastReflection_QueryAllowanceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllowanceResponse)(nil)
+func (x fastReflection_QueryAllowanceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllowanceRequest)(nil)
 }
-func (x fastReflection_QueryAllowanceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowanceResponse)
+func (x fastReflection_QueryAllowanceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowanceRequest)
 }
-func (x fastReflection_QueryAllowanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowanceResponse
+func (x fastReflection_QueryAllowanceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowanceRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllowanceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowanceResponse
+func (x *fastReflection_QueryAllowanceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowanceRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllowanceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllowanceResponse_messageType
+func (x *fastReflection_QueryAllowanceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllowanceRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllowanceResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowanceResponse)
+func (x *fastReflection_QueryAllowanceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowanceRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllowanceResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllowanceResponse)(x)
+func (x *fastReflection_QueryAllowanceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllowanceRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -575,10 +579,16 @@ func (x *fastReflection_QueryAllowanceResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllowanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Allowance != nil {
-		value := protoreflect.ValueOfMessage(x.Allowance.ProtoReflect())
-		if !f(fd_QueryAllowanceResponse_allowance, value) {
+func (x *fastReflection_QueryAllowanceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Granter != "" {
+		value := protoreflect.ValueOfString(x.Granter)
+		if !f(fd_QueryAllowanceRequest_granter, value) {
+			return
+		}
+	}
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_QueryAllowanceRequest_grantee, value) {
 			return
 		}
 	}
@@ -595,15 +605,17 @@ func (x *fastReflection_QueryAllowanceResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllowanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAllowanceRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
-		return x.Allowance != nil
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
+		return x.Granter != ""
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
+		return x.Grantee != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -613,15 +625,17 @@ func (x *fastReflection_QueryAllowanceResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAllowanceRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
-		x.Allowance = nil
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
+		x.Granter = ""
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
+		x.Grantee = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -631,16 +645,19 @@ func (x *fastReflection_QueryAllowanceResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllowanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowanceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
-		value := x.Allowance
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
+		value := x.Granter
+		return protoreflect.ValueOfString(value)
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -654,15 +671,17 @@ func (x *fastReflection_QueryAllowanceResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAllowanceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
-		x.Allowance = value.Message().Interface().(*v1beta1.Grant)
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
+		x.Granter = value.Interface().(string)
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
+		x.Grantee = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -676,44 +695,44 @@ func (x *fastReflection_QueryAllowanceResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowanceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
-		if x.Allowance == nil {
-			x.Allowance = new(v1beta1.Grant)
-		}
-		return protoreflect.ValueOfMessage(x.Allowance.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
+		panic(fmt.Errorf("field granter of message xion.indexer.feegrant.v1.QueryAllowanceRequest is not mutable"))
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
+		panic(fmt.Errorf("field grantee of message xion.indexer.feegrant.v1.QueryAllowanceRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllowanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowanceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
-		m := new(v1beta1.Grant)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.granter":
+		return protoreflect.ValueOfString("")
+	case "xion.indexer.feegrant.v1.QueryAllowanceRequest.grantee":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllowanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAllowanceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowanceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowanceRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -721,7 +740,7 @@ func (x *fastReflection_QueryAllowanceResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllowanceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAllowanceRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -732,7 +751,7 @@ func (x *fastReflection_QueryAllowanceResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowanceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAllowanceRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -744,7 +763,7 @@ func (x *fastReflection_QueryAllowanceResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllowanceResponse) IsValid() bool {
+func (x *fastReflection_QueryAllowanceRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -754,9 +773,9 @@ func (x *fastReflection_QueryAllowanceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllowanceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAllowanceRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllowanceResponse)
+		x := input.Message.Interface().(*QueryAllowanceRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -768,8 +787,12 @@ func (x *fastReflection_QueryAllowanceResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.Allowance != nil {
-			l = options.Size(x.Allowance)
+		l = len(x.Granter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Grantee)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -782,7 +805,7 @@ func (x *fastReflection_QueryAllowanceResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowanceResponse)
+		x := input.Message.Interface().(*QueryAllowanceRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -801,17 +824,17 @@ func (x *fastReflection_QueryAllowanceResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Allowance != nil {
-			encoded, err := options.Marshal(x.Allowance)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Granter) > 0 {
+			i -= len(x.Granter)
+			copy(dAtA[i:], x.Granter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -826,7 +849,7 @@ func (x *fastReflection_QueryAllowanceResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowanceResponse)
+		x := input.Message.Interface().(*QueryAllowanceRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -858,17 +881,17 @@ func (x *fastReflection_QueryAllowanceResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -878,27 +901,55 @@ func (x *fastReflection_QueryAllowanceResponse) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Allowance == nil {
-					x.Allowance = &v1beta1.Grant{}
+				x.Granter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allowance); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -936,27 +987,25 @@ func (x *fastReflection_QueryAllowanceResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryAllowancesRequest            protoreflect.MessageDescriptor
-	fd_QueryAllowancesRequest_grantee    protoreflect.FieldDescriptor
-	fd_QueryAllowancesRequest_pagination protoreflect.FieldDescriptor
+	md_QueryAllowanceResponse           protoreflect.MessageDescriptor
+	fd_QueryAllowanceResponse_allowance protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_xion_indexer_feegrant_v1_query_proto_init()
-	md_QueryAllowancesRequest = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowancesRequest")
-	fd_QueryAllowancesRequest_grantee = md_QueryAllowancesRequest.Fields().ByName("grantee")
-	fd_QueryAllowancesRequest_pagination = md_QueryAllowancesRequest.Fields().ByName("pagination")
+	md_QueryAllowanceResponse = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowanceResponse")
+	fd_QueryAllowanceResponse_allowance = md_QueryAllowanceResponse.Fields().ByName("allowance")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllowancesRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAllowanceResponse)(nil)
 
-type fastReflection_QueryAllowancesRequest QueryAllowancesRequest
+type fastReflection_QueryAllowanceResponse QueryAllowanceResponse
 
-func (x *QueryAllowancesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllowancesRequest)(x)
+func (x *QueryAllowanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllowanceResponse)(x)
 }
 
-func (x *QueryAllowancesRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryAllowanceResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -968,43 +1017,43 @@ func (x *QueryAllowancesRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllowancesRequest_messageType fastReflection_QueryAllowancesRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllowancesRequest_messageType{}
+var _fastReflection_QueryAllowanceResponse_messageType fastReflection_QueryAllowanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllowanceResponse_messageType{}
 
-type fastReflection_QueryAllowancesRequest_messageType struct{}
+type fastReflection_QueryAllowanceResponse_messageType struct{}
 
-func (x fastReflection_QueryAllowancesRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllowancesRequest)(nil)
+func (x fastReflection_QueryAllowanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllowanceResponse)(nil)
 }
-func (x fastReflection_QueryAllowancesRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowancesRequest)
+func (x fastReflection_QueryAllowanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowanceResponse)
 }
-func (x fastReflection_QueryAllowancesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowancesRequest
+func (x fastReflection_QueryAllowanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowanceResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllowancesRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowancesRequest
+func (x *fastReflection_QueryAllowanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowanceResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllowancesRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllowancesRequest_messageType
+func (x *fastReflection_QueryAllowanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllowanceResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllowancesRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowancesRequest)
+func (x *fastReflection_QueryAllowanceResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowanceResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllowancesRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllowancesRequest)(x)
+func (x *fastReflection_QueryAllowanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllowanceResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1012,16 +1061,10 @@ func (x *fastReflection_QueryAllowancesRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllowancesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Grantee != "" {
-		value := protoreflect.ValueOfString(x.Grantee)
-		if !f(fd_QueryAllowancesRequest_grantee, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllowancesRequest_pagination, value) {
+func (x *fastReflection_QueryAllowanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Allowance != nil {
+		value := protoreflect.ValueOfMessage(x.Allowance.ProtoReflect())
+		if !f(fd_QueryAllowanceResponse_allowance, value) {
 			return
 		}
 	}
@@ -1038,17 +1081,15 @@ func (x *fastReflection_QueryAllowancesRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllowancesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAllowanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
-		return x.Grantee != ""
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
-		return x.Pagination != nil
+	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
+		return x.Allowance != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1058,17 +1099,15 @@ func (x *fastReflection_QueryAllowancesRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAllowanceResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
-		x.Grantee = ""
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
-		x.Pagination = nil
+	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
+		x.Allowance = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1078,19 +1117,16 @@ func (x *fastReflection_QueryAllowancesRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllowancesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
-		value := x.Grantee
-		return protoreflect.ValueOfString(value)
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
-		value := x.Pagination
+	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
+		value := x.Allowance
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1104,17 +1140,15 @@ func (x *fastReflection_QueryAllowancesRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAllowanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
-		x.Grantee = value.Interface().(string)
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
+		x.Allowance = value.Message().Interface().(*v1beta1.Grant)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1128,48 +1162,44 @@ func (x *fastReflection_QueryAllowancesRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta11.PageRequest)
+	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
+		if x.Allowance == nil {
+			x.Allowance = new(v1beta1.Grant)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
-		panic(fmt.Errorf("field grantee of message xion.indexer.feegrant.v1.QueryAllowancesRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Allowance.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllowancesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
-		return protoreflect.ValueOfString("")
-	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
-		m := new(v1beta11.PageRequest)
+	case "xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance":
+		m := new(v1beta1.Grant)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowanceResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowanceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllowancesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAllowanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowancesRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowanceResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1177,7 +1207,7 @@ func (x *fastReflection_QueryAllowancesRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllowancesRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAllowanceResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1188,7 +1218,7 @@ func (x *fastReflection_QueryAllowancesRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAllowanceResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1200,7 +1230,7 @@ func (x *fastReflection_QueryAllowancesRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllowancesRequest) IsValid() bool {
+func (x *fastReflection_QueryAllowanceResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1210,9 +1240,9 @@ func (x *fastReflection_QueryAllowancesRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllowancesRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAllowanceResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllowancesRequest)
+		x := input.Message.Interface().(*QueryAllowanceResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1224,12 +1254,8 @@ func (x *fastReflection_QueryAllowancesRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.Grantee)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Allowance != nil {
+			l = options.Size(x.Allowance)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1242,7 +1268,7 @@ func (x *fastReflection_QueryAllowancesRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowancesRequest)
+		x := input.Message.Interface().(*QueryAllowanceResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1261,8 +1287,8 @@ func (x *fastReflection_QueryAllowancesRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Allowance != nil {
+			encoded, err := options.Marshal(x.Allowance)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1273,13 +1299,6 @@ func (x *fastReflection_QueryAllowancesRequest) ProtoMethods() *protoiface.Metho
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Grantee) > 0 {
-			i -= len(x.Grantee)
-			copy(dAtA[i:], x.Grantee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -1293,7 +1312,7 @@ func (x *fastReflection_QueryAllowancesRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowancesRequest)
+		x := input.Message.Interface().(*QueryAllowanceResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1325,47 +1344,15 @@ func (x *fastReflection_QueryAllowancesRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Grantee = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1392,10 +1379,10 @@ func (x *fastReflection_QueryAllowancesRequest) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta11.PageRequest{}
+				if x.Allowance == nil {
+					x.Allowance = &v1beta1.Grant{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allowance); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1434,79 +1421,30 @@ func (x *fastReflection_QueryAllowancesRequest) ProtoMethods() *protoiface.Metho
 	}
 }
 
-var _ protoreflect.List = (*_QueryAllowancesResponse_1_list)(nil)
-
-type _QueryAllowancesResponse_1_list struct {
-	list *[]*v1beta1.Grant
-}
-
-func (x *_QueryAllowancesResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryAllowancesResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryAllowancesResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Grant)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryAllowancesResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Grant)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryAllowancesResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Grant)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllowancesResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryAllowancesResponse_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Grant)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllowancesResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryAllowancesResponse            protoreflect.MessageDescriptor
-	fd_QueryAllowancesResponse_allowances protoreflect.FieldDescriptor
-	fd_QueryAllowancesResponse_pagination protoreflect.FieldDescriptor
+	md_QueryAllowancesRequest            protoreflect.MessageDescriptor
+	fd_QueryAllowancesRequest_grantee    protoreflect.FieldDescriptor
+	fd_QueryAllowancesRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryAllowancesRequest_expiration protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_xion_indexer_feegrant_v1_query_proto_init()
-	md_QueryAllowancesResponse = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowancesResponse")
-	fd_QueryAllowancesResponse_allowances = md_QueryAllowancesResponse.Fields().ByName("allowances")
-	fd_QueryAllowancesResponse_pagination = md_QueryAllowancesResponse.Fields().ByName("pagination")
+	md_QueryAllowancesRequest = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowancesRequest")
+	fd_QueryAllowancesRequest_grantee = md_QueryAllowancesRequest.Fields().ByName("grantee")
+	fd_QueryAllowancesRequest_pagination = md_QueryAllowancesRequest.Fields().ByName("pagination")
+	fd_QueryAllowancesRequest_expiration = md_QueryAllowancesRequest.Fields().ByName("expiration")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllowancesResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAllowancesRequest)(nil)
 
-type fastReflection_QueryAllowancesResponse QueryAllowancesResponse
+type fastReflection_QueryAllowancesRequest QueryAllowancesRequest
 
-func (x *QueryAllowancesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllowancesResponse)(x)
+func (x *QueryAllowancesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesRequest)(x)
 }
 
-func (x *QueryAllowancesResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryAllowancesRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1518,43 +1456,43 @@ func (x *QueryAllowancesResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllowancesResponse_messageType fastReflection_QueryAllowancesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllowancesResponse_messageType{}
+var _fastReflection_QueryAllowancesRequest_messageType fastReflection_QueryAllowancesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllowancesRequest_messageType{}
 
-type fastReflection_QueryAllowancesResponse_messageType struct{}
+type fastReflection_QueryAllowancesRequest_messageType struct{}
 
-func (x fastReflection_QueryAllowancesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllowancesResponse)(nil)
+func (x fastReflection_QueryAllowancesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesRequest)(nil)
 }
-func (x fastReflection_QueryAllowancesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowancesResponse)
+func (x fastReflection_QueryAllowancesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesRequest)
 }
-func (x fastReflection_QueryAllowancesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowancesResponse
+func (x fastReflection_QueryAllowancesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllowancesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowancesResponse
+func (x *fastReflection_QueryAllowancesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllowancesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllowancesResponse_messageType
+func (x *fastReflection_QueryAllowancesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllowancesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllowancesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowancesResponse)
+func (x *fastReflection_QueryAllowancesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllowancesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllowancesResponse)(x)
+func (x *fastReflection_QueryAllowancesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllowancesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1562,16 +1500,22 @@ func (x *fastReflection_QueryAllowancesResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllowancesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Allowances) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAllowancesResponse_1_list{list: &x.Allowances})
-		if !f(fd_QueryAllowancesResponse_allowances, value) {
+func (x *fastReflection_QueryAllowancesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_QueryAllowancesRequest_grantee, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllowancesResponse_pagination, value) {
+		if !f(fd_QueryAllowancesRequest_pagination, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_QueryAllowancesRequest_expiration, value) {
 			return
 		}
 	}
@@ -1588,17 +1532,19 @@ func (x *fastReflection_QueryAllowancesResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllowancesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAllowancesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
-		return len(x.Allowances) != 0
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
+		return x.Grantee != ""
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
 		return x.Pagination != nil
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.expiration":
+		return x.Expiration != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1608,17 +1554,19 @@ func (x *fastReflection_QueryAllowancesResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAllowancesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
-		x.Allowances = nil
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
+		x.Grantee = ""
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
 		x.Pagination = nil
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.expiration":
+		x.Expiration = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1628,22 +1576,22 @@ func (x *fastReflection_QueryAllowancesResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllowancesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowancesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
-		if len(x.Allowances) == 0 {
-			return protoreflect.ValueOfList(&_QueryAllowancesResponse_1_list{})
-		}
-		listValue := &_QueryAllowancesResponse_1_list{list: &x.Allowances}
-		return protoreflect.ValueOfList(listValue)
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1657,19 +1605,19 @@ func (x *fastReflection_QueryAllowancesResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAllowancesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
-		lv := value.List()
-		clv := lv.(*_QueryAllowancesResponse_1_list)
-		x.Allowances = *clv.list
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
+		x.Grantee = value.Interface().(string)
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.expiration":
+		x.Expiration = value.Message().Interface().(*ExpirationFilter)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1683,53 +1631,56 @@ func (x *fastReflection_QueryAllowancesResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowancesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
-		if x.Allowances == nil {
-			x.Allowances = []*v1beta1.Grant{}
-		}
-		value := &_QueryAllowancesResponse_1_list{list: &x.Allowances}
-		return protoreflect.ValueOfList(value)
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
 		if x.Pagination == nil {
-			x.Pagination = new(v1beta11.PageResponse)
+			x.Pagination = new(v1beta11.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(ExpirationFilter)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
+		panic(fmt.Errorf("field grantee of message xion.indexer.feegrant.v1.QueryAllowancesRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllowancesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowancesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
-		list := []*v1beta1.Grant{}
-		return protoreflect.ValueOfList(&_QueryAllowancesResponse_1_list{list: &list})
-	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
-		m := new(v1beta11.PageResponse)
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.grantee":
+		return protoreflect.ValueOfString("")
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination":
+		m := new(v1beta11.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryAllowancesRequest.expiration":
+		m := new(ExpirationFilter)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllowancesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAllowancesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowancesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowancesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1737,7 +1688,7 @@ func (x *fastReflection_QueryAllowancesResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllowancesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAllowancesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1748,7 +1699,7 @@ func (x *fastReflection_QueryAllowancesResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAllowancesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1760,7 +1711,7 @@ func (x *fastReflection_QueryAllowancesResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllowancesResponse) IsValid() bool {
+func (x *fastReflection_QueryAllowancesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1770,9 +1721,9 @@ func (x *fastReflection_QueryAllowancesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAllowancesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllowancesResponse)
+		x := input.Message.Interface().(*QueryAllowancesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1784,16 +1735,18 @@ func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if len(x.Allowances) > 0 {
-			for _, e := range x.Allowances {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Grantee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1804,7 +1757,7 @@ func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowancesResponse)
+		x := input.Message.Interface().(*QueryAllowancesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1823,6 +1776,20 @@ func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
@@ -1837,21 +1804,12 @@ func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Meth
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Allowances) > 0 {
-			for iNdEx := len(x.Allowances) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Allowances[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1864,7 +1822,7 @@ func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowancesResponse)
+		x := input.Message.Interface().(*QueryAllowancesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1896,15 +1854,47 @@ func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1931,14 +1921,16 @@ func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Allowances = append(x.Allowances, &v1beta1.Grant{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allowances[len(x.Allowances)-1]); err != nil {
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1965,10 +1957,10 @@ func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta11.PageResponse{}
+				if x.Expiration == nil {
+					x.Expiration = &ExpirationFilter{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2007,28 +1999,79 @@ func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var _ protoreflect.List = (*_QueryAllowancesResponse_1_list)(nil)
+
+type _QueryAllowancesResponse_1_list struct {
+	list *[]*v1beta1.Grant
+}
+
+func (x *_QueryAllowancesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllowancesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAllowancesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Grant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllowancesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Grant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllowancesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Grant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllowancesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllowancesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Grant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllowancesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryAllowancesByGranterRequest            protoreflect.MessageDescriptor
-	fd_QueryAllowancesByGranterRequest_granter    protoreflect.FieldDescriptor
-	fd_QueryAllowancesByGranterRequest_pagination protoreflect.FieldDescriptor
+	md_QueryAllowancesResponse            protoreflect.MessageDescriptor
+	fd_QueryAllowancesResponse_allowances protoreflect.FieldDescriptor
+	fd_QueryAllowancesResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_xion_indexer_feegrant_v1_query_proto_init()
-	md_QueryAllowancesByGranterRequest = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowancesByGranterRequest")
-	fd_QueryAllowancesByGranterRequest_granter = md_QueryAllowancesByGranterRequest.Fields().ByName("granter")
-	fd_QueryAllowancesByGranterRequest_pagination = md_QueryAllowancesByGranterRequest.Fields().ByName("pagination")
+	md_QueryAllowancesResponse = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowancesResponse")
+	fd_QueryAllowancesResponse_allowances = md_QueryAllowancesResponse.Fields().ByName("allowances")
+	fd_QueryAllowancesResponse_pagination = md_QueryAllowancesResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllowancesByGranterRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAllowancesResponse)(nil)
 
-type fastReflection_QueryAllowancesByGranterRequest QueryAllowancesByGranterRequest
+type fastReflection_QueryAllowancesResponse QueryAllowancesResponse
 
-func (x *QueryAllowancesByGranterRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllowancesByGranterRequest)(x)
+func (x *QueryAllowancesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesResponse)(x)
 }
 
-func (x *QueryAllowancesByGranterRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryAllowancesResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2040,43 +2083,43 @@ func (x *QueryAllowancesByGranterRequest) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllowancesByGranterRequest_messageType fastReflection_QueryAllowancesByGranterRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllowancesByGranterRequest_messageType{}
+var _fastReflection_QueryAllowancesResponse_messageType fastReflection_QueryAllowancesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllowancesResponse_messageType{}
 
-type fastReflection_QueryAllowancesByGranterRequest_messageType struct{}
+type fastReflection_QueryAllowancesResponse_messageType struct{}
 
-func (x fastReflection_QueryAllowancesByGranterRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllowancesByGranterRequest)(nil)
+func (x fastReflection_QueryAllowancesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesResponse)(nil)
 }
-func (x fastReflection_QueryAllowancesByGranterRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowancesByGranterRequest)
+func (x fastReflection_QueryAllowancesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesResponse)
 }
-func (x fastReflection_QueryAllowancesByGranterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowancesByGranterRequest
+func (x fastReflection_QueryAllowancesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllowancesByGranterRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowancesByGranterRequest
+func (x *fastReflection_QueryAllowancesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllowancesByGranterRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllowancesByGranterRequest_messageType
+func (x *fastReflection_QueryAllowancesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllowancesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllowancesByGranterRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowancesByGranterRequest)
+func (x *fastReflection_QueryAllowancesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllowancesByGranterRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllowancesByGranterRequest)(x)
+func (x *fastReflection_QueryAllowancesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllowancesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2084,16 +2127,16 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllowancesByGranterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Granter != "" {
-		value := protoreflect.ValueOfString(x.Granter)
-		if !f(fd_QueryAllowancesByGranterRequest_granter, value) {
+func (x *fastReflection_QueryAllowancesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Allowances) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllowancesResponse_1_list{list: &x.Allowances})
+		if !f(fd_QueryAllowancesResponse_allowances, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllowancesByGranterRequest_pagination, value) {
+		if !f(fd_QueryAllowancesResponse_pagination, value) {
 			return
 		}
 	}
@@ -2110,17 +2153,17 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllowancesByGranterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAllowancesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
-		return x.Granter != ""
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
+		return len(x.Allowances) != 0
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2130,17 +2173,17 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesByGranterRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAllowancesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
-		x.Granter = ""
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
+		x.Allowances = nil
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2150,19 +2193,22 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllowancesByGranterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowancesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
-		value := x.Granter
-		return protoreflect.ValueOfString(value)
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
+		if len(x.Allowances) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllowancesResponse_1_list{})
+		}
+		listValue := &_QueryAllowancesResponse_1_list{list: &x.Allowances}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2176,17 +2222,19 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesByGranterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAllowancesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
-		x.Granter = value.Interface().(string)
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
+		lv := value.List()
+		clv := lv.(*_QueryAllowancesResponse_1_list)
+		x.Allowances = *clv.list
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2200,48 +2248,53 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesByGranterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowancesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
+		if x.Allowances == nil {
+			x.Allowances = []*v1beta1.Grant{}
+		}
+		value := &_QueryAllowancesResponse_1_list{list: &x.Allowances}
+		return protoreflect.ValueOfList(value)
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
 		if x.Pagination == nil {
-			x.Pagination = new(v1beta11.PageRequest)
+			x.Pagination = new(v1beta11.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
-		panic(fmt.Errorf("field granter of message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllowancesByGranterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowancesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
-		return protoreflect.ValueOfString("")
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
-		m := new(v1beta11.PageRequest)
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances":
+		list := []*v1beta1.Grant{}
+		return protoreflect.ValueOfList(&_QueryAllowancesResponse_1_list{list: &list})
+	case "xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination":
+		m := new(v1beta11.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesResponse"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllowancesByGranterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAllowancesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowancesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2249,7 +2302,7 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllowancesByGranterRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAllowancesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2260,7 +2313,7 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesByGranterRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAllowancesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2272,7 +2325,7 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllowancesByGranterRequest) IsValid() bool {
+func (x *fastReflection_QueryAllowancesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2282,9 +2335,9 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllowancesByGranterRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllowancesByGranterRequest)
+		x := input.Message.Interface().(*QueryAllowancesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2296,9 +2349,11 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
-		l = len(x.Granter)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Allowances) > 0 {
+			for _, e := range x.Allowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -2314,7 +2369,7 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowancesByGranterRequest)
+		x := input.Message.Interface().(*QueryAllowancesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2347,12 +2402,21 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) ProtoMethods() *protoif
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Granter) > 0 {
-			i -= len(x.Granter)
-			copy(dAtA[i:], x.Granter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.Allowances) > 0 {
+			for iNdEx := len(x.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Allowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2365,7 +2429,7 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowancesByGranterRequest)
+		x := input.Message.Interface().(*QueryAllowancesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2397,17 +2461,17 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2417,23 +2481,25 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Granter = string(dAtA[iNdEx:postIndex])
+				x.Allowances = append(x.Allowances, &v1beta1.Grant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allowances[len(x.Allowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -2465,7 +2531,7 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) ProtoMethods() *protoif
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if x.Pagination == nil {
-					x.Pagination = &v1beta11.PageRequest{}
+					x.Pagination = &v1beta11.PageResponse{}
 				}
 				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
@@ -2506,127 +2572,78 @@ func (x *fastReflection_QueryAllowancesByGranterRequest) ProtoMethods() *protoif
 	}
 }
 
-var _ protoreflect.List = (*_QueryAllowancesByGranterResponse_1_list)(nil)
+var (
+	md_QueryAllowancesByGranterRequest            protoreflect.MessageDescriptor
+	fd_QueryAllowancesByGranterRequest_granter    protoreflect.FieldDescriptor
+	fd_QueryAllowancesByGranterRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryAllowancesByGranterRequest_expiration protoreflect.FieldDescriptor
+)
 
-type _QueryAllowancesByGranterResponse_1_list struct {
-	list *[]*v1beta1.Grant
+func init() {
+	file_xion_indexer_feegrant_v1_query_proto_init()
+	md_QueryAllowancesByGranterRequest = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowancesByGranterRequest")
+	fd_QueryAllowancesByGranterRequest_granter = md_QueryAllowancesByGranterRequest.Fields().ByName("granter")
+	fd_QueryAllowancesByGranterRequest_pagination = md_QueryAllowancesByGranterRequest.Fields().ByName("pagination")
+	fd_QueryAllowancesByGranterRequest_expiration = md_QueryAllowancesByGranterRequest.Fields().ByName("expiration")
 }
 
-func (x *_QueryAllowancesByGranterResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_QueryAllowancesByGranterRequest)(nil)
 
-func (x *_QueryAllowancesByGranterResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
+type fastReflection_QueryAllowancesByGranterRequest QueryAllowancesByGranterRequest
 
-func (x *_QueryAllowancesByGranterResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Grant)
-	(*x.list)[i] = concreteValue
+func (x *QueryAllowancesByGranterRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesByGranterRequest)(x)
 }
 
-func (x *_QueryAllowancesByGranterResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Grant)
-	*x.list = append(*x.list, concreteValue)
+func (x *QueryAllowancesByGranterRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_QueryAllowancesByGranterResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Grant)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
+var _fastReflection_QueryAllowancesByGranterRequest_messageType fastReflection_QueryAllowancesByGranterRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllowancesByGranterRequest_messageType{}
 
-func (x *_QueryAllowancesByGranterResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_QueryAllowancesByGranterRequest_messageType struct{}
 
-func (x *_QueryAllowancesByGranterResponse_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Grant)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x fastReflection_QueryAllowancesByGranterRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesByGranterRequest)(nil)
 }
-
-func (x *_QueryAllowancesByGranterResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryAllowancesByGranterResponse            protoreflect.MessageDescriptor
-	fd_QueryAllowancesByGranterResponse_allowances protoreflect.FieldDescriptor
-	fd_QueryAllowancesByGranterResponse_pagination protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_xion_indexer_feegrant_v1_query_proto_init()
-	md_QueryAllowancesByGranterResponse = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowancesByGranterResponse")
-	fd_QueryAllowancesByGranterResponse_allowances = md_QueryAllowancesByGranterResponse.Fields().ByName("allowances")
-	fd_QueryAllowancesByGranterResponse_pagination = md_QueryAllowancesByGranterResponse.Fields().ByName("pagination")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryAllowancesByGranterResponse)(nil)
-
-type fastReflection_QueryAllowancesByGranterResponse QueryAllowancesByGranterResponse
-
-func (x *QueryAllowancesByGranterResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllowancesByGranterResponse)(x)
-}
-
-func (x *QueryAllowancesByGranterResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_QueryAllowancesByGranterResponse_messageType fastReflection_QueryAllowancesByGranterResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllowancesByGranterResponse_messageType{}
-
-type fastReflection_QueryAllowancesByGranterResponse_messageType struct{}
-
-func (x fastReflection_QueryAllowancesByGranterResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllowancesByGranterResponse)(nil)
-}
-func (x fastReflection_QueryAllowancesByGranterResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowancesByGranterResponse)
+func (x fastReflection_QueryAllowancesByGranterRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesByGranterRequest)
 }
-func (x fastReflection_QueryAllowancesByGranterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowancesByGranterResponse
+func (x fastReflection_QueryAllowancesByGranterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesByGranterRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllowancesByGranterResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllowancesByGranterResponse
+func (x *fastReflection_QueryAllowancesByGranterRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesByGranterRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllowancesByGranterResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllowancesByGranterResponse_messageType
+func (x *fastReflection_QueryAllowancesByGranterRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllowancesByGranterRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllowancesByGranterResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAllowancesByGranterResponse)
+func (x *fastReflection_QueryAllowancesByGranterRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesByGranterRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllowancesByGranterResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllowancesByGranterResponse)(x)
+func (x *fastReflection_QueryAllowancesByGranterRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllowancesByGranterRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2634,16 +2651,22 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllowancesByGranterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Allowances) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAllowancesByGranterResponse_1_list{list: &x.Allowances})
-		if !f(fd_QueryAllowancesByGranterResponse_allowances, value) {
+func (x *fastReflection_QueryAllowancesByGranterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Granter != "" {
+		value := protoreflect.ValueOfString(x.Granter)
+		if !f(fd_QueryAllowancesByGranterRequest_granter, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllowancesByGranterResponse_pagination, value) {
+		if !f(fd_QueryAllowancesByGranterRequest_pagination, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_QueryAllowancesByGranterRequest_expiration, value) {
 			return
 		}
 	}
@@ -2660,17 +2683,19 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllowancesByGranterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAllowancesByGranterRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
-		return len(x.Allowances) != 0
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
+		return x.Granter != ""
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
 		return x.Pagination != nil
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.expiration":
+		return x.Expiration != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2680,17 +2705,19 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesByGranterResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAllowancesByGranterRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
-		x.Allowances = nil
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
+		x.Granter = ""
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
 		x.Pagination = nil
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.expiration":
+		x.Expiration = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2700,22 +2727,22 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllowancesByGranterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowancesByGranterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
-		if len(x.Allowances) == 0 {
-			return protoreflect.ValueOfList(&_QueryAllowancesByGranterResponse_1_list{})
-		}
-		listValue := &_QueryAllowancesByGranterResponse_1_list{list: &x.Allowances}
-		return protoreflect.ValueOfList(listValue)
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
+		value := x.Granter
+		return protoreflect.ValueOfString(value)
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2729,19 +2756,19 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesByGranterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAllowancesByGranterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
-		lv := value.List()
-		clv := lv.(*_QueryAllowancesByGranterResponse_1_list)
-		x.Allowances = *clv.list
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
+		x.Granter = value.Interface().(string)
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.expiration":
+		x.Expiration = value.Message().Interface().(*ExpirationFilter)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2755,53 +2782,56 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesByGranterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowancesByGranterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
-		if x.Allowances == nil {
-			x.Allowances = []*v1beta1.Grant{}
-		}
-		value := &_QueryAllowancesByGranterResponse_1_list{list: &x.Allowances}
-		return protoreflect.ValueOfList(value)
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
 		if x.Pagination == nil {
-			x.Pagination = new(v1beta11.PageResponse)
+			x.Pagination = new(v1beta11.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(ExpirationFilter)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
+		panic(fmt.Errorf("field granter of message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllowancesByGranterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowancesByGranterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
-		list := []*v1beta1.Grant{}
-		return protoreflect.ValueOfList(&_QueryAllowancesByGranterResponse_1_list{list: &list})
-	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
-		m := new(v1beta11.PageResponse)
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.granter":
+		return protoreflect.ValueOfString("")
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination":
+		m := new(v1beta11.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.expiration":
+		m := new(ExpirationFilter)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest"))
 		}
-		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllowancesByGranterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAllowancesByGranterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2809,7 +2839,7 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllowancesByGranterResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAllowancesByGranterRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2820,7 +2850,7 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllowancesByGranterResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAllowancesByGranterRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2832,7 +2862,7 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllowancesByGranterResponse) IsValid() bool {
+func (x *fastReflection_QueryAllowancesByGranterRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2842,9 +2872,9 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllowancesByGranterResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAllowancesByGranterRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllowancesByGranterResponse)
+		x := input.Message.Interface().(*QueryAllowancesByGranterRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2856,16 +2886,18 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		if len(x.Allowances) > 0 {
-			for _, e := range x.Allowances {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Granter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2876,7 +2908,7 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowancesByGranterResponse)
+		x := input.Message.Interface().(*QueryAllowancesByGranterRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2895,6 +2927,20 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
@@ -2909,21 +2955,12 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) ProtoMethods() *protoi
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Allowances) > 0 {
-			for iNdEx := len(x.Allowances) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Allowances[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Granter) > 0 {
+			i -= len(x.Granter)
+			copy(dAtA[i:], x.Granter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2936,7 +2973,7 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllowancesByGranterResponse)
+		x := input.Message.Interface().(*QueryAllowancesByGranterRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2968,17 +3005,17 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2988,25 +3025,23 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Allowances = append(x.Allowances, &v1beta1.Grant{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allowances[len(x.Allowances)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Granter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -3038,12 +3073,48 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) ProtoMethods() *protoi
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if x.Pagination == nil {
-					x.Pagination = &v1beta11.PageResponse{}
+					x.Pagination = &v1beta11.PageRequest{}
 				}
 				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &ExpirationFilter{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3063,37 +3134,4991 @@ func (x *fastReflection_QueryAllowancesByGranterResponse) ProtoMethods() *protoi
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAllowancesByGranterResponse_1_list)(nil)
+
+type _QueryAllowancesByGranterResponse_1_list struct {
+	list *[]*v1beta1.Grant
+}
+
+func (x *_QueryAllowancesByGranterResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllowancesByGranterResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAllowancesByGranterResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Grant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllowancesByGranterResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Grant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllowancesByGranterResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Grant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllowancesByGranterResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllowancesByGranterResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Grant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllowancesByGranterResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAllowancesByGranterResponse            protoreflect.MessageDescriptor
+	fd_QueryAllowancesByGranterResponse_allowances protoreflect.FieldDescriptor
+	fd_QueryAllowancesByGranterResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_indexer_feegrant_v1_query_proto_init()
+	md_QueryAllowancesByGranterResponse = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryAllowancesByGranterResponse")
+	fd_QueryAllowancesByGranterResponse_allowances = md_QueryAllowancesByGranterResponse.Fields().ByName("allowances")
+	fd_QueryAllowancesByGranterResponse_pagination = md_QueryAllowancesByGranterResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllowancesByGranterResponse)(nil)
+
+type fastReflection_QueryAllowancesByGranterResponse QueryAllowancesByGranterResponse
+
+func (x *QueryAllowancesByGranterResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesByGranterResponse)(x)
+}
+
+func (x *QueryAllowancesByGranterResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllowancesByGranterResponse_messageType fastReflection_QueryAllowancesByGranterResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllowancesByGranterResponse_messageType{}
+
+type fastReflection_QueryAllowancesByGranterResponse_messageType struct{}
+
+func (x fastReflection_QueryAllowancesByGranterResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesByGranterResponse)(nil)
+}
+func (x fastReflection_QueryAllowancesByGranterResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesByGranterResponse)
+}
+func (x fastReflection_QueryAllowancesByGranterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesByGranterResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllowancesByGranterResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesByGranterResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllowancesByGranterResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllowancesByGranterResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllowancesByGranterResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesByGranterResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllowancesByGranterResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllowancesByGranterResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllowancesByGranterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Allowances) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllowancesByGranterResponse_1_list{list: &x.Allowances})
+		if !f(fd_QueryAllowancesByGranterResponse_allowances, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllowancesByGranterResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllowancesByGranterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
+		return len(x.Allowances) != 0
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesByGranterResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
+		x.Allowances = nil
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllowancesByGranterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
+		if len(x.Allowances) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllowancesByGranterResponse_1_list{})
+		}
+		listValue := &_QueryAllowancesByGranterResponse_1_list{list: &x.Allowances}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesByGranterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
+		lv := value.List()
+		clv := lv.(*_QueryAllowancesByGranterResponse_1_list)
+		x.Allowances = *clv.list
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesByGranterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
+		if x.Allowances == nil {
+			x.Allowances = []*v1beta1.Grant{}
+		}
+		value := &_QueryAllowancesByGranterResponse_1_list{list: &x.Allowances}
+		return protoreflect.ValueOfList(value)
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllowancesByGranterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances":
+		list := []*v1beta1.Grant{}
+		return protoreflect.ValueOfList(&_QueryAllowancesByGranterResponse_1_list{list: &list})
+	case "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination":
+		m := new(v1beta11.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllowancesByGranterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllowancesByGranterResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesByGranterResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllowancesByGranterResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllowancesByGranterResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllowancesByGranterResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Allowances) > 0 {
+			for _, e := range x.Allowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllowancesByGranterResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Allowances) > 0 {
+			for iNdEx := len(x.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Allowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllowancesByGranterResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allowances = append(x.Allowances, &v1beta1.Grant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allowances[len(x.Allowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GrantSpend_3_list)(nil)
+
+type _GrantSpend_3_list struct {
+	list *[]*v1beta12.Coin
+}
+
+func (x *_GrantSpend_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GrantSpend_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GrantSpend_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta12.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GrantSpend_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta12.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GrantSpend_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta12.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GrantSpend_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GrantSpend_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta12.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GrantSpend_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GrantSpend             protoreflect.MessageDescriptor
+	fd_GrantSpend_granter     protoreflect.FieldDescriptor
+	fd_GrantSpend_grantee     protoreflect.FieldDescriptor
+	fd_GrantSpend_spent       protoreflect.FieldDescriptor
+	fd_GrantSpend_tx_count    protoreflect.FieldDescriptor
+	fd_GrantSpend_last_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_indexer_feegrant_v1_query_proto_init()
+	md_GrantSpend = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("GrantSpend")
+	fd_GrantSpend_granter = md_GrantSpend.Fields().ByName("granter")
+	fd_GrantSpend_grantee = md_GrantSpend.Fields().ByName("grantee")
+	fd_GrantSpend_spent = md_GrantSpend.Fields().ByName("spent")
+	fd_GrantSpend_tx_count = md_GrantSpend.Fields().ByName("tx_count")
+	fd_GrantSpend_last_height = md_GrantSpend.Fields().ByName("last_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_GrantSpend)(nil)
+
+type fastReflection_GrantSpend GrantSpend
+
+func (x *GrantSpend) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GrantSpend)(x)
+}
+
+func (x *GrantSpend) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GrantSpend_messageType fastReflection_GrantSpend_messageType
+var _ protoreflect.MessageType = fastReflection_GrantSpend_messageType{}
+
+type fastReflection_GrantSpend_messageType struct{}
+
+func (x fastReflection_GrantSpend_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GrantSpend)(nil)
+}
+func (x fastReflection_GrantSpend_messageType) New() protoreflect.Message {
+	return new(fastReflection_GrantSpend)
+}
+func (x fastReflection_GrantSpend_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GrantSpend
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GrantSpend) Descriptor() protoreflect.MessageDescriptor {
+	return md_GrantSpend
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GrantSpend) Type() protoreflect.MessageType {
+	return _fastReflection_GrantSpend_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GrantSpend) New() protoreflect.Message {
+	return new(fastReflection_GrantSpend)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GrantSpend) Interface() protoreflect.ProtoMessage {
+	return (*GrantSpend)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GrantSpend) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Granter != "" {
+		value := protoreflect.ValueOfString(x.Granter)
+		if !f(fd_GrantSpend_granter, value) {
+			return
+		}
+	}
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_GrantSpend_grantee, value) {
+			return
+		}
+	}
+	if len(x.Spent) != 0 {
+		value := protoreflect.ValueOfList(&_GrantSpend_3_list{list: &x.Spent})
+		if !f(fd_GrantSpend_spent, value) {
+			return
+		}
+	}
+	if x.TxCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TxCount)
+		if !f(fd_GrantSpend_tx_count, value) {
+			return
+		}
+	}
+	if x.LastHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastHeight)
+		if !f(fd_GrantSpend_last_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GrantSpend) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.GrantSpend.granter":
+		return x.Granter != ""
+	case "xion.indexer.feegrant.v1.GrantSpend.grantee":
+		return x.Grantee != ""
+	case "xion.indexer.feegrant.v1.GrantSpend.spent":
+		return len(x.Spent) != 0
+	case "xion.indexer.feegrant.v1.GrantSpend.tx_count":
+		return x.TxCount != uint64(0)
+	case "xion.indexer.feegrant.v1.GrantSpend.last_height":
+		return x.LastHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.GrantSpend"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.GrantSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GrantSpend) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.GrantSpend.granter":
+		x.Granter = ""
+	case "xion.indexer.feegrant.v1.GrantSpend.grantee":
+		x.Grantee = ""
+	case "xion.indexer.feegrant.v1.GrantSpend.spent":
+		x.Spent = nil
+	case "xion.indexer.feegrant.v1.GrantSpend.tx_count":
+		x.TxCount = uint64(0)
+	case "xion.indexer.feegrant.v1.GrantSpend.last_height":
+		x.LastHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.GrantSpend"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.GrantSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GrantSpend) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.indexer.feegrant.v1.GrantSpend.granter":
+		value := x.Granter
+		return protoreflect.ValueOfString(value)
+	case "xion.indexer.feegrant.v1.GrantSpend.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	case "xion.indexer.feegrant.v1.GrantSpend.spent":
+		if len(x.Spent) == 0 {
+			return protoreflect.ValueOfList(&_GrantSpend_3_list{})
+		}
+		listValue := &_GrantSpend_3_list{list: &x.Spent}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.indexer.feegrant.v1.GrantSpend.tx_count":
+		value := x.TxCount
+		return protoreflect.ValueOfUint64(value)
+	case "xion.indexer.feegrant.v1.GrantSpend.last_height":
+		value := x.LastHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.GrantSpend"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.GrantSpend does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GrantSpend) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.GrantSpend.granter":
+		x.Granter = value.Interface().(string)
+	case "xion.indexer.feegrant.v1.GrantSpend.grantee":
+		x.Grantee = value.Interface().(string)
+	case "xion.indexer.feegrant.v1.GrantSpend.spent":
+		lv := value.List()
+		clv := lv.(*_GrantSpend_3_list)
+		x.Spent = *clv.list
+	case "xion.indexer.feegrant.v1.GrantSpend.tx_count":
+		x.TxCount = value.Uint()
+	case "xion.indexer.feegrant.v1.GrantSpend.last_height":
+		x.LastHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.GrantSpend"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.GrantSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GrantSpend) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.GrantSpend.spent":
+		if x.Spent == nil {
+			x.Spent = []*v1beta12.Coin{}
+		}
+		value := &_GrantSpend_3_list{list: &x.Spent}
+		return protoreflect.ValueOfList(value)
+	case "xion.indexer.feegrant.v1.GrantSpend.granter":
+		panic(fmt.Errorf("field granter of message xion.indexer.feegrant.v1.GrantSpend is not mutable"))
+	case "xion.indexer.feegrant.v1.GrantSpend.grantee":
+		panic(fmt.Errorf("field grantee of message xion.indexer.feegrant.v1.GrantSpend is not mutable"))
+	case "xion.indexer.feegrant.v1.GrantSpend.tx_count":
+		panic(fmt.Errorf("field tx_count of message xion.indexer.feegrant.v1.GrantSpend is not mutable"))
+	case "xion.indexer.feegrant.v1.GrantSpend.last_height":
+		panic(fmt.Errorf("field last_height of message xion.indexer.feegrant.v1.GrantSpend is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.GrantSpend"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.GrantSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GrantSpend) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.GrantSpend.granter":
+		return protoreflect.ValueOfString("")
+	case "xion.indexer.feegrant.v1.GrantSpend.grantee":
+		return protoreflect.ValueOfString("")
+	case "xion.indexer.feegrant.v1.GrantSpend.spent":
+		list := []*v1beta12.Coin{}
+		return protoreflect.ValueOfList(&_GrantSpend_3_list{list: &list})
+	case "xion.indexer.feegrant.v1.GrantSpend.tx_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "xion.indexer.feegrant.v1.GrantSpend.last_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.GrantSpend"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.GrantSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GrantSpend) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.GrantSpend", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GrantSpend) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GrantSpend) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GrantSpend) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GrantSpend) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GrantSpend)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Granter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Grantee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Spent) > 0 {
+			for _, e := range x.Spent {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TxCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxCount))
+		}
+		if x.LastHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GrantSpend)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TxCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Spent) > 0 {
+			for iNdEx := len(x.Spent) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Spent[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Granter) > 0 {
+			i -= len(x.Granter)
+			copy(dAtA[i:], x.Granter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GrantSpend)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GrantSpend: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GrantSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Granter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Spent = append(x.Spent, &v1beta12.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spent[len(x.Spent)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+				}
+				x.TxCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TxCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+				}
+				x.LastHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGrantSpendRequest         protoreflect.MessageDescriptor
+	fd_QueryGrantSpendRequest_granter protoreflect.FieldDescriptor
+	fd_QueryGrantSpendRequest_grantee protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_indexer_feegrant_v1_query_proto_init()
+	md_QueryGrantSpendRequest = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryGrantSpendRequest")
+	fd_QueryGrantSpendRequest_granter = md_QueryGrantSpendRequest.Fields().ByName("granter")
+	fd_QueryGrantSpendRequest_grantee = md_QueryGrantSpendRequest.Fields().ByName("grantee")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGrantSpendRequest)(nil)
+
+type fastReflection_QueryGrantSpendRequest QueryGrantSpendRequest
+
+func (x *QueryGrantSpendRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGrantSpendRequest)(x)
+}
+
+func (x *QueryGrantSpendRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGrantSpendRequest_messageType fastReflection_QueryGrantSpendRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGrantSpendRequest_messageType{}
+
+type fastReflection_QueryGrantSpendRequest_messageType struct{}
+
+func (x fastReflection_QueryGrantSpendRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGrantSpendRequest)(nil)
+}
+func (x fastReflection_QueryGrantSpendRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantSpendRequest)
+}
+func (x fastReflection_QueryGrantSpendRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantSpendRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGrantSpendRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantSpendRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGrantSpendRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGrantSpendRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGrantSpendRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantSpendRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGrantSpendRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGrantSpendRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGrantSpendRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Granter != "" {
+		value := protoreflect.ValueOfString(x.Granter)
+		if !f(fd_QueryGrantSpendRequest_granter, value) {
+			return
+		}
+	}
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_QueryGrantSpendRequest_grantee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGrantSpendRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.granter":
+		return x.Granter != ""
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.grantee":
+		return x.Grantee != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.granter":
+		x.Granter = ""
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.grantee":
+		x.Grantee = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGrantSpendRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.granter":
+		value := x.Granter
+		return protoreflect.ValueOfString(value)
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.granter":
+		x.Granter = value.Interface().(string)
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.grantee":
+		x.Grantee = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.granter":
+		panic(fmt.Errorf("field granter of message xion.indexer.feegrant.v1.QueryGrantSpendRequest is not mutable"))
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.grantee":
+		panic(fmt.Errorf("field grantee of message xion.indexer.feegrant.v1.QueryGrantSpendRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGrantSpendRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.granter":
+		return protoreflect.ValueOfString("")
+	case "xion.indexer.feegrant.v1.QueryGrantSpendRequest.grantee":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGrantSpendRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryGrantSpendRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGrantSpendRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGrantSpendRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGrantSpendRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGrantSpendRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Granter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Grantee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantSpendRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Granter) > 0 {
+			i -= len(x.Granter)
+			copy(dAtA[i:], x.Granter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantSpendRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantSpendRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantSpendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Granter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGrantSpendResponse       protoreflect.MessageDescriptor
+	fd_QueryGrantSpendResponse_spend protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_indexer_feegrant_v1_query_proto_init()
+	md_QueryGrantSpendResponse = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryGrantSpendResponse")
+	fd_QueryGrantSpendResponse_spend = md_QueryGrantSpendResponse.Fields().ByName("spend")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGrantSpendResponse)(nil)
+
+type fastReflection_QueryGrantSpendResponse QueryGrantSpendResponse
+
+func (x *QueryGrantSpendResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGrantSpendResponse)(x)
+}
+
+func (x *QueryGrantSpendResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGrantSpendResponse_messageType fastReflection_QueryGrantSpendResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGrantSpendResponse_messageType{}
+
+type fastReflection_QueryGrantSpendResponse_messageType struct{}
+
+func (x fastReflection_QueryGrantSpendResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGrantSpendResponse)(nil)
+}
+func (x fastReflection_QueryGrantSpendResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantSpendResponse)
+}
+func (x fastReflection_QueryGrantSpendResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantSpendResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGrantSpendResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantSpendResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGrantSpendResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGrantSpendResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGrantSpendResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantSpendResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGrantSpendResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGrantSpendResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGrantSpendResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Spend != nil {
+		value := protoreflect.ValueOfMessage(x.Spend.ProtoReflect())
+		if !f(fd_QueryGrantSpendResponse_spend, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGrantSpendResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendResponse.spend":
+		return x.Spend != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendResponse.spend":
+		x.Spend = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGrantSpendResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendResponse.spend":
+		value := x.Spend
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendResponse.spend":
+		x.Spend = value.Message().Interface().(*GrantSpend)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendResponse.spend":
+		if x.Spend == nil {
+			x.Spend = new(GrantSpend)
+		}
+		return protoreflect.ValueOfMessage(x.Spend.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGrantSpendResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendResponse.spend":
+		m := new(GrantSpend)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGrantSpendResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryGrantSpendResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGrantSpendResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGrantSpendResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGrantSpendResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGrantSpendResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Spend != nil {
+			l = options.Size(x.Spend)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantSpendResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Spend != nil {
+			encoded, err := options.Marshal(x.Spend)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantSpendResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantSpendResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Spend == nil {
+					x.Spend = &GrantSpend{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spend); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGrantSpendsByGranterRequest            protoreflect.MessageDescriptor
+	fd_QueryGrantSpendsByGranterRequest_granter    protoreflect.FieldDescriptor
+	fd_QueryGrantSpendsByGranterRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_indexer_feegrant_v1_query_proto_init()
+	md_QueryGrantSpendsByGranterRequest = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryGrantSpendsByGranterRequest")
+	fd_QueryGrantSpendsByGranterRequest_granter = md_QueryGrantSpendsByGranterRequest.Fields().ByName("granter")
+	fd_QueryGrantSpendsByGranterRequest_pagination = md_QueryGrantSpendsByGranterRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGrantSpendsByGranterRequest)(nil)
+
+type fastReflection_QueryGrantSpendsByGranterRequest QueryGrantSpendsByGranterRequest
+
+func (x *QueryGrantSpendsByGranterRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGrantSpendsByGranterRequest)(x)
+}
+
+func (x *QueryGrantSpendsByGranterRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGrantSpendsByGranterRequest_messageType fastReflection_QueryGrantSpendsByGranterRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGrantSpendsByGranterRequest_messageType{}
+
+type fastReflection_QueryGrantSpendsByGranterRequest_messageType struct{}
+
+func (x fastReflection_QueryGrantSpendsByGranterRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGrantSpendsByGranterRequest)(nil)
+}
+func (x fastReflection_QueryGrantSpendsByGranterRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantSpendsByGranterRequest)
+}
+func (x fastReflection_QueryGrantSpendsByGranterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantSpendsByGranterRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantSpendsByGranterRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGrantSpendsByGranterRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantSpendsByGranterRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGrantSpendsByGranterRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Granter != "" {
+		value := protoreflect.ValueOfString(x.Granter)
+		if !f(fd_QueryGrantSpendsByGranterRequest_granter, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGrantSpendsByGranterRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.granter":
+		return x.Granter != ""
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.granter":
+		x.Granter = ""
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.granter":
+		value := x.Granter
+		return protoreflect.ValueOfString(value)
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.granter":
+		x.Granter = value.Interface().(string)
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.granter":
+		panic(fmt.Errorf("field granter of message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.granter":
+		return protoreflect.ValueOfString("")
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.pagination":
+		m := new(v1beta11.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGrantSpendsByGranterRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGrantSpendsByGranterRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Granter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantSpendsByGranterRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Granter) > 0 {
+			i -= len(x.Granter)
+			copy(dAtA[i:], x.Granter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantSpendsByGranterRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantSpendsByGranterRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantSpendsByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Granter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryGrantSpendsByGranterResponse_1_list)(nil)
+
+type _QueryGrantSpendsByGranterResponse_1_list struct {
+	list *[]*GrantSpend
+}
+
+func (x *_QueryGrantSpendsByGranterResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGrantSpendsByGranterResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGrantSpendsByGranterResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GrantSpend)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGrantSpendsByGranterResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GrantSpend)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGrantSpendsByGranterResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(GrantSpend)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGrantSpendsByGranterResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGrantSpendsByGranterResponse_1_list) NewElement() protoreflect.Value {
+	v := new(GrantSpend)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGrantSpendsByGranterResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryGrantSpendsByGranterResponse            protoreflect.MessageDescriptor
+	fd_QueryGrantSpendsByGranterResponse_spends     protoreflect.FieldDescriptor
+	fd_QueryGrantSpendsByGranterResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_indexer_feegrant_v1_query_proto_init()
+	md_QueryGrantSpendsByGranterResponse = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryGrantSpendsByGranterResponse")
+	fd_QueryGrantSpendsByGranterResponse_spends = md_QueryGrantSpendsByGranterResponse.Fields().ByName("spends")
+	fd_QueryGrantSpendsByGranterResponse_pagination = md_QueryGrantSpendsByGranterResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGrantSpendsByGranterResponse)(nil)
+
+type fastReflection_QueryGrantSpendsByGranterResponse QueryGrantSpendsByGranterResponse
+
+func (x *QueryGrantSpendsByGranterResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGrantSpendsByGranterResponse)(x)
+}
+
+func (x *QueryGrantSpendsByGranterResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGrantSpendsByGranterResponse_messageType fastReflection_QueryGrantSpendsByGranterResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGrantSpendsByGranterResponse_messageType{}
+
+type fastReflection_QueryGrantSpendsByGranterResponse_messageType struct{}
+
+func (x fastReflection_QueryGrantSpendsByGranterResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGrantSpendsByGranterResponse)(nil)
+}
+func (x fastReflection_QueryGrantSpendsByGranterResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantSpendsByGranterResponse)
+}
+func (x fastReflection_QueryGrantSpendsByGranterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantSpendsByGranterResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGrantSpendsByGranterResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGrantSpendsByGranterResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGrantSpendsByGranterResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGrantSpendsByGranterResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Spends) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGrantSpendsByGranterResponse_1_list{list: &x.Spends})
+		if !f(fd_QueryGrantSpendsByGranterResponse_spends, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGrantSpendsByGranterResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.spends":
+		return len(x.Spends) != 0
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.spends":
+		x.Spends = nil
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.spends":
+		if len(x.Spends) == 0 {
+			return protoreflect.ValueOfList(&_QueryGrantSpendsByGranterResponse_1_list{})
+		}
+		listValue := &_QueryGrantSpendsByGranterResponse_1_list{list: &x.Spends}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.spends":
+		lv := value.List()
+		clv := lv.(*_QueryGrantSpendsByGranterResponse_1_list)
+		x.Spends = *clv.list
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.spends":
+		if x.Spends == nil {
+			x.Spends = []*GrantSpend{}
+		}
+		value := &_QueryGrantSpendsByGranterResponse_1_list{list: &x.Spends}
+		return protoreflect.ValueOfList(value)
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.spends":
+		list := []*GrantSpend{}
+		return protoreflect.ValueOfList(&_QueryGrantSpendsByGranterResponse_1_list{list: &list})
+	case "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.pagination":
+		m := new(v1beta11.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGrantSpendsByGranterResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGrantSpendsByGranterResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Spends) > 0 {
+			for _, e := range x.Spends {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantSpendsByGranterResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Spends) > 0 {
+			for iNdEx := len(x.Spends) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Spends[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGrantSpendsByGranterResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantSpendsByGranterResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGrantSpendsByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spends", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Spends = append(x.Spends, &GrantSpend{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spends[len(x.Spends)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryExpiringAllowancesRequest                 protoreflect.MessageDescriptor
+	fd_QueryExpiringAllowancesRequest_expiring_before protoreflect.FieldDescriptor
+	fd_QueryExpiringAllowancesRequest_granter         protoreflect.FieldDescriptor
+	fd_QueryExpiringAllowancesRequest_active_only     protoreflect.FieldDescriptor
+	fd_QueryExpiringAllowancesRequest_pagination      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_indexer_feegrant_v1_query_proto_init()
+	md_QueryExpiringAllowancesRequest = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryExpiringAllowancesRequest")
+	fd_QueryExpiringAllowancesRequest_expiring_before = md_QueryExpiringAllowancesRequest.Fields().ByName("expiring_before")
+	fd_QueryExpiringAllowancesRequest_granter = md_QueryExpiringAllowancesRequest.Fields().ByName("granter")
+	fd_QueryExpiringAllowancesRequest_active_only = md_QueryExpiringAllowancesRequest.Fields().ByName("active_only")
+	fd_QueryExpiringAllowancesRequest_pagination = md_QueryExpiringAllowancesRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryExpiringAllowancesRequest)(nil)
+
+type fastReflection_QueryExpiringAllowancesRequest QueryExpiringAllowancesRequest
+
+func (x *QueryExpiringAllowancesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryExpiringAllowancesRequest)(x)
+}
+
+func (x *QueryExpiringAllowancesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryExpiringAllowancesRequest_messageType fastReflection_QueryExpiringAllowancesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryExpiringAllowancesRequest_messageType{}
+
+type fastReflection_QueryExpiringAllowancesRequest_messageType struct{}
+
+func (x fastReflection_QueryExpiringAllowancesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryExpiringAllowancesRequest)(nil)
+}
+func (x fastReflection_QueryExpiringAllowancesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryExpiringAllowancesRequest)
+}
+func (x fastReflection_QueryExpiringAllowancesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExpiringAllowancesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryExpiringAllowancesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExpiringAllowancesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryExpiringAllowancesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryExpiringAllowancesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryExpiringAllowancesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryExpiringAllowancesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryExpiringAllowancesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryExpiringAllowancesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryExpiringAllowancesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExpiringBefore != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiringBefore.ProtoReflect())
+		if !f(fd_QueryExpiringAllowancesRequest_expiring_before, value) {
+			return
+		}
+	}
+	if x.Granter != "" {
+		value := protoreflect.ValueOfString(x.Granter)
+		if !f(fd_QueryExpiringAllowancesRequest_granter, value) {
+			return
+		}
+	}
+	if x.ActiveOnly != false {
+		value := protoreflect.ValueOfBool(x.ActiveOnly)
+		if !f(fd_QueryExpiringAllowancesRequest_active_only, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryExpiringAllowancesRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryExpiringAllowancesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.expiring_before":
+		return x.ExpiringBefore != nil
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.granter":
+		return x.Granter != ""
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.active_only":
+		return x.ActiveOnly != false
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryExpiringAllowancesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.expiring_before":
+		x.ExpiringBefore = nil
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.granter":
+		x.Granter = ""
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.active_only":
+		x.ActiveOnly = false
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryExpiringAllowancesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.expiring_before":
+		value := x.ExpiringBefore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.granter":
+		value := x.Granter
+		return protoreflect.ValueOfString(value)
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.active_only":
+		value := x.ActiveOnly
+		return protoreflect.ValueOfBool(value)
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryExpiringAllowancesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.expiring_before":
+		x.ExpiringBefore = value.Message().Interface().(*timestamppb.Timestamp)
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.granter":
+		x.Granter = value.Interface().(string)
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.active_only":
+		x.ActiveOnly = value.Bool()
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryExpiringAllowancesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.expiring_before":
+		if x.ExpiringBefore == nil {
+			x.ExpiringBefore = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiringBefore.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.granter":
+		panic(fmt.Errorf("field granter of message xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest is not mutable"))
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.active_only":
+		panic(fmt.Errorf("field active_only of message xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryExpiringAllowancesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.expiring_before":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.granter":
+		return protoreflect.ValueOfString("")
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.active_only":
+		return protoreflect.ValueOfBool(false)
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.pagination":
+		m := new(v1beta11.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryExpiringAllowancesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryExpiringAllowancesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryExpiringAllowancesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryExpiringAllowancesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryExpiringAllowancesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryExpiringAllowancesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ExpiringBefore != nil {
+			l = options.Size(x.ExpiringBefore)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Granter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActiveOnly {
+			n += 2
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryExpiringAllowancesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ActiveOnly {
+			i--
+			if x.ActiveOnly {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Granter) > 0 {
+			i -= len(x.Granter)
+			copy(dAtA[i:], x.Granter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ExpiringBefore != nil {
+			encoded, err := options.Marshal(x.ExpiringBefore)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryExpiringAllowancesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExpiringAllowancesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExpiringAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiringBefore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiringBefore == nil {
+					x.ExpiringBefore = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiringBefore); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Granter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ActiveOnly = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryExpiringAllowancesResponse_1_list)(nil)
+
+type _QueryExpiringAllowancesResponse_1_list struct {
+	list *[]*v1beta1.Grant
+}
+
+func (x *_QueryExpiringAllowancesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryExpiringAllowancesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryExpiringAllowancesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Grant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryExpiringAllowancesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Grant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryExpiringAllowancesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Grant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryExpiringAllowancesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryExpiringAllowancesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Grant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryExpiringAllowancesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryExpiringAllowancesResponse            protoreflect.MessageDescriptor
+	fd_QueryExpiringAllowancesResponse_allowances protoreflect.FieldDescriptor
+	fd_QueryExpiringAllowancesResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_indexer_feegrant_v1_query_proto_init()
+	md_QueryExpiringAllowancesResponse = File_xion_indexer_feegrant_v1_query_proto.Messages().ByName("QueryExpiringAllowancesResponse")
+	fd_QueryExpiringAllowancesResponse_allowances = md_QueryExpiringAllowancesResponse.Fields().ByName("allowances")
+	fd_QueryExpiringAllowancesResponse_pagination = md_QueryExpiringAllowancesResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryExpiringAllowancesResponse)(nil)
+
+type fastReflection_QueryExpiringAllowancesResponse QueryExpiringAllowancesResponse
+
+func (x *QueryExpiringAllowancesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryExpiringAllowancesResponse)(x)
+}
+
+func (x *QueryExpiringAllowancesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryExpiringAllowancesResponse_messageType fastReflection_QueryExpiringAllowancesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryExpiringAllowancesResponse_messageType{}
+
+type fastReflection_QueryExpiringAllowancesResponse_messageType struct{}
+
+func (x fastReflection_QueryExpiringAllowancesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryExpiringAllowancesResponse)(nil)
+}
+func (x fastReflection_QueryExpiringAllowancesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryExpiringAllowancesResponse)
+}
+func (x fastReflection_QueryExpiringAllowancesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExpiringAllowancesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryExpiringAllowancesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExpiringAllowancesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryExpiringAllowancesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryExpiringAllowancesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryExpiringAllowancesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryExpiringAllowancesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryExpiringAllowancesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryExpiringAllowancesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryExpiringAllowancesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Allowances) != 0 {
+		value := protoreflect.ValueOfList(&_QueryExpiringAllowancesResponse_1_list{list: &x.Allowances})
+		if !f(fd_QueryExpiringAllowancesResponse_allowances, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryExpiringAllowancesResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryExpiringAllowancesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.allowances":
+		return len(x.Allowances) != 0
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryExpiringAllowancesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.allowances":
+		x.Allowances = nil
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryExpiringAllowancesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.allowances":
+		if len(x.Allowances) == 0 {
+			return protoreflect.ValueOfList(&_QueryExpiringAllowancesResponse_1_list{})
+		}
+		listValue := &_QueryExpiringAllowancesResponse_1_list{list: &x.Allowances}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryExpiringAllowancesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.allowances":
+		lv := value.List()
+		clv := lv.(*_QueryExpiringAllowancesResponse_1_list)
+		x.Allowances = *clv.list
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryExpiringAllowancesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.allowances":
+		if x.Allowances == nil {
+			x.Allowances = []*v1beta1.Grant{}
+		}
+		value := &_QueryExpiringAllowancesResponse_1_list{list: &x.Allowances}
+		return protoreflect.ValueOfList(value)
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryExpiringAllowancesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.allowances":
+		list := []*v1beta1.Grant{}
+		return protoreflect.ValueOfList(&_QueryExpiringAllowancesResponse_1_list{list: &list})
+	case "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.pagination":
+		m := new(v1beta11.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryExpiringAllowancesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryExpiringAllowancesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryExpiringAllowancesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryExpiringAllowancesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryExpiringAllowancesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryExpiringAllowancesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Allowances) > 0 {
+			for _, e := range x.Allowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryExpiringAllowancesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Allowances) > 0 {
+			for iNdEx := len(x.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Allowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryExpiringAllowancesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExpiringAllowancesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExpiringAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allowances = append(x.Allowances, &v1beta1.Grant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allowances[len(x.Allowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: xion/indexer/feegrant/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExpirationStatus selects allowances by whether they have expired at the
+// time of the latest indexed block.
+type ExpirationStatus int32
+
+const (
+	// EXPIRATION_STATUS_UNSPECIFIED matches all allowances.
+	ExpirationStatus_EXPIRATION_STATUS_UNSPECIFIED ExpirationStatus = 0
+	// EXPIRATION_STATUS_ACTIVE matches allowances that have not expired.
+	ExpirationStatus_EXPIRATION_STATUS_ACTIVE ExpirationStatus = 1
+	// EXPIRATION_STATUS_EXPIRED matches allowances that have expired.
+	ExpirationStatus_EXPIRATION_STATUS_EXPIRED ExpirationStatus = 2
+)
+
+// Enum value maps for ExpirationStatus.
+var (
+	ExpirationStatus_name = map[int32]string{
+		0: "EXPIRATION_STATUS_UNSPECIFIED",
+		1: "EXPIRATION_STATUS_ACTIVE",
+		2: "EXPIRATION_STATUS_EXPIRED",
+	}
+	ExpirationStatus_value = map[string]int32{
+		"EXPIRATION_STATUS_UNSPECIFIED": 0,
+		"EXPIRATION_STATUS_ACTIVE":      1,
+		"EXPIRATION_STATUS_EXPIRED":     2,
+	}
+)
+
+func (x ExpirationStatus) Enum() *ExpirationStatus {
+	p := new(ExpirationStatus)
+	*p = x
+	return p
+}
+
+func (x ExpirationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExpirationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_xion_indexer_feegrant_v1_query_proto_enumTypes[0].Descriptor()
+}
+
+func (ExpirationStatus) Type() protoreflect.EnumType {
+	return &file_xion_indexer_feegrant_v1_query_proto_enumTypes[0]
+}
+
+func (x ExpirationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExpirationStatus.Descriptor instead.
+func (ExpirationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// ExpirationFilter narrows the allowances returned by their expiration.
+type ExpirationFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status selects allowances by whether they have expired.
+	Status ExpirationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=xion.indexer.feegrant.v1.ExpirationStatus" json:"status,omitempty"`
+	// expiring_before, if set, only matches allowances that expire before this
+	// time.
+	ExpiringBefore *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiring_before,json=expiringBefore,proto3" json:"expiring_before,omitempty"`
+}
+
+func (x *ExpirationFilter) Reset() {
+	*x = ExpirationFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpirationFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpirationFilter) ProtoMessage() {}
+
+// Deprecated: Use ExpirationFilter.ProtoReflect.Descriptor instead.
+func (*ExpirationFilter) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExpirationFilter) GetStatus() ExpirationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExpirationStatus_EXPIRATION_STATUS_UNSPECIFIED
+}
+
+func (x *ExpirationFilter) GetExpiringBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiringBefore
+	}
+	return nil
+}
+
+// QueryAllowanceRequest is the request type for the Query/Allowance RPC method.
+type QueryAllowanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another
+	// user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (x *QueryAllowanceRequest) Reset() {
+	*x = QueryAllowanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllowanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllowanceRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllowanceRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllowanceRequest) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryAllowanceRequest) GetGranter() string {
+	if x != nil {
+		return x.Granter
+	}
+	return ""
+}
+
+func (x *QueryAllowanceRequest) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+// QueryAllowanceResponse is the response type for the Query/Allowance RPC
+// method.
+type QueryAllowanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// allowance is a allowance granted for grantee by granter.
+	Allowance *v1beta1.Grant `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+}
+
+func (x *QueryAllowanceResponse) Reset() {
+	*x = QueryAllowanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllowanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllowanceResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllowanceResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllowanceResponse) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryAllowanceResponse) GetAllowance() *v1beta1.Grant {
+	if x != nil {
+		return x.Allowance
+	}
+	return nil
+}
+
+// QueryAllowancesRequest is the request type for the Query/Allowances RPC
+// method.
+type QueryAllowancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// grantee is the address of the user being granted fee allowances
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// pagination defines pagination for the request.
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiration narrows the allowances returned by their expiration.
+	Expiration *ExpirationFilter `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *QueryAllowancesRequest) Reset() {
+	*x = QueryAllowancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllowancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllowancesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllowancesRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllowancesRequest) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryAllowancesRequest) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *QueryAllowancesRequest) GetPagination() *v1beta11.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryAllowancesRequest) GetExpiration() *ExpirationFilter {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+// QueryAllowancesResponse is the response type for the Query/Allowances RPC
+// method.
+type QueryAllowancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// allowances are allowances granted for grantee by granter.
+	Allowances []*v1beta1.Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+	// pagination defines pagination for the response.
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllowancesResponse) Reset() {
+	*x = QueryAllowancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllowancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllowancesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllowancesResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllowancesResponse) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryAllowancesResponse) GetAllowances() []*v1beta1.Grant {
+	if x != nil {
+		return x.Allowances
+	}
+	return nil
+}
+
+func (x *QueryAllowancesResponse) GetPagination() *v1beta11.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryAllowancesByGranterRequest is the request type for the
+// Query/AllowancesByGranter RPC method.
+type QueryAllowancesByGranterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// granter is the address of the user granting fee allowances
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// pagination defines pagination for the request.
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiration narrows the allowances returned by their expiration.
+	Expiration *ExpirationFilter `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *QueryAllowancesByGranterRequest) Reset() {
+	*x = QueryAllowancesByGranterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllowancesByGranterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllowancesByGranterRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllowancesByGranterRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllowancesByGranterRequest) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryAllowancesByGranterRequest) GetGranter() string {
+	if x != nil {
+		return x.Granter
+	}
+	return ""
+}
+
+func (x *QueryAllowancesByGranterRequest) GetPagination() *v1beta11.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryAllowancesByGranterRequest) GetExpiration() *ExpirationFilter {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+// QueryAllowancesByGranterResponse is the response type for the
+// Query/AllowancesByGranter RPC method.
+type QueryAllowancesByGranterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// allowances that have been issued by the granter.
+	Allowances []*v1beta1.Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+	// pagination defines pagination for the response.
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllowancesByGranterResponse) Reset() {
+	*x = QueryAllowancesByGranterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllowancesByGranterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllowancesByGranterResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllowancesByGranterResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllowancesByGranterResponse) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryAllowancesByGranterResponse) GetAllowances() []*v1beta1.Grant {
+	if x != nil {
+		return x.Allowances
+	}
+	return nil
+}
+
+func (x *QueryAllowancesByGranterResponse) GetPagination() *v1beta11.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// GrantSpend is the cumulative fee spend of a (granter, grantee) pair, across
+// every allowance granted between them.
+type GrantSpend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// granter is the address paying the fees.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address whose fees were paid.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// spent is the total of the fees paid.
+	Spent []*v1beta12.Coin `protobuf:"bytes,3,rep,name=spent,proto3" json:"spent,omitempty"`
+	// tx_count is the number of transactions paid for.
+	TxCount uint64 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// last_height is the height of the last transaction paid for.
+	LastHeight int64 `protobuf:"varint,5,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (x *GrantSpend) Reset() {
+	*x = GrantSpend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantSpend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantSpend) ProtoMessage() {}
+
+// Deprecated: Use GrantSpend.ProtoReflect.Descriptor instead.
+func (*GrantSpend) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GrantSpend) GetGranter() string {
+	if x != nil {
+		return x.Granter
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return ""
+}
+
+func (x *GrantSpend) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
 	}
+	return ""
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: xion/indexer/feegrant/v1/query.proto
+func (x *GrantSpend) GetSpent() []*v1beta12.Coin {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *GrantSpend) GetTxCount() uint64 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
 
-// QueryAllowanceRequest is the request type for the Query/Allowance RPC method.
-type QueryAllowanceRequest struct {
+func (x *GrantSpend) GetLastHeight() int64 {
+	if x != nil {
+		return x.LastHeight
+	}
+	return 0
+}
+
+// QueryGrantSpendRequest is the request type for the Query/GrantSpend RPC
+// method.
+type QueryGrantSpendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3105,260 +8130,278 @@ type QueryAllowanceRequest struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
-func (x *QueryAllowanceRequest) Reset() {
-	*x = QueryAllowanceRequest{}
+func (x *QueryGrantSpendRequest) Reset() {
+	*x = QueryGrantSpendRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[0]
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllowanceRequest) String() string {
+func (x *QueryGrantSpendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllowanceRequest) ProtoMessage() {}
+func (*QueryGrantSpendRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAllowanceRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllowanceRequest) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryGrantSpendRequest.ProtoReflect.Descriptor instead.
+func (*QueryGrantSpendRequest) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *QueryAllowanceRequest) GetGranter() string {
+func (x *QueryGrantSpendRequest) GetGranter() string {
 	if x != nil {
 		return x.Granter
 	}
 	return ""
 }
 
-func (x *QueryAllowanceRequest) GetGrantee() string {
+func (x *QueryGrantSpendRequest) GetGrantee() string {
 	if x != nil {
 		return x.Grantee
 	}
 	return ""
 }
 
-// QueryAllowanceResponse is the response type for the Query/Allowance RPC
+// QueryGrantSpendResponse is the response type for the Query/GrantSpend RPC
 // method.
-type QueryAllowanceResponse struct {
+type QueryGrantSpendResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// allowance is a allowance granted for grantee by granter.
-	Allowance *v1beta1.Grant `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// spend is the fee spend of the grant, nil if no fees were paid.
+	Spend *GrantSpend `protobuf:"bytes,1,opt,name=spend,proto3" json:"spend,omitempty"`
 }
 
-func (x *QueryAllowanceResponse) Reset() {
-	*x = QueryAllowanceResponse{}
+func (x *QueryGrantSpendResponse) Reset() {
+	*x = QueryGrantSpendResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[1]
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllowanceResponse) String() string {
+func (x *QueryGrantSpendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllowanceResponse) ProtoMessage() {}
+func (*QueryGrantSpendResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllowanceResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllowanceResponse) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryGrantSpendResponse.ProtoReflect.Descriptor instead.
+func (*QueryGrantSpendResponse) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *QueryAllowanceResponse) GetAllowance() *v1beta1.Grant {
+func (x *QueryGrantSpendResponse) GetSpend() *GrantSpend {
 	if x != nil {
-		return x.Allowance
+		return x.Spend
 	}
 	return nil
 }
 
-// QueryAllowancesRequest is the request type for the Query/Allowances RPC
-// method.
-type QueryAllowancesRequest struct {
+// QueryGrantSpendsByGranterRequest is the request type for the
+// Query/GrantSpendsByGranter RPC method.
+type QueryGrantSpendsByGranterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// grantee is the address of the user being granted fee allowances
-	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// granter is the address of the user granting fee allowances
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
 	// pagination defines pagination for the request.
 	Pagination *v1beta11.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllowancesRequest) Reset() {
-	*x = QueryAllowancesRequest{}
+func (x *QueryGrantSpendsByGranterRequest) Reset() {
+	*x = QueryGrantSpendsByGranterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[2]
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllowancesRequest) String() string {
+func (x *QueryGrantSpendsByGranterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllowancesRequest) ProtoMessage() {}
+func (*QueryGrantSpendsByGranterRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAllowancesRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllowancesRequest) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryGrantSpendsByGranterRequest.ProtoReflect.Descriptor instead.
+func (*QueryGrantSpendsByGranterRequest) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryAllowancesRequest) GetGrantee() string {
+func (x *QueryGrantSpendsByGranterRequest) GetGranter() string {
 	if x != nil {
-		return x.Grantee
+		return x.Granter
 	}
 	return ""
 }
 
-func (x *QueryAllowancesRequest) GetPagination() *v1beta11.PageRequest {
+func (x *QueryGrantSpendsByGranterRequest) GetPagination() *v1beta11.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryAllowancesResponse is the response type for the Query/Allowances RPC
-// method.
-type QueryAllowancesResponse struct {
+// QueryGrantSpendsByGranterResponse is the response type for the
+// Query/GrantSpendsByGranter RPC method.
+type QueryGrantSpendsByGranterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// allowances are allowances granted for grantee by granter.
-	Allowances []*v1beta1.Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+	// spends are the fee spends of the grants issued by the granter.
+	Spends []*GrantSpend `protobuf:"bytes,1,rep,name=spends,proto3" json:"spends,omitempty"`
 	// pagination defines pagination for the response.
 	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllowancesResponse) Reset() {
-	*x = QueryAllowancesResponse{}
+func (x *QueryGrantSpendsByGranterResponse) Reset() {
+	*x = QueryGrantSpendsByGranterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[3]
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllowancesResponse) String() string {
+func (x *QueryGrantSpendsByGranterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllowancesResponse) ProtoMessage() {}
+func (*QueryGrantSpendsByGranterResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllowancesResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllowancesResponse) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryGrantSpendsByGranterResponse.ProtoReflect.Descriptor instead.
+func (*QueryGrantSpendsByGranterResponse) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *QueryAllowancesResponse) GetAllowances() []*v1beta1.Grant {
+func (x *QueryGrantSpendsByGranterResponse) GetSpends() []*GrantSpend {
 	if x != nil {
-		return x.Allowances
+		return x.Spends
 	}
 	return nil
 }
 
-func (x *QueryAllowancesResponse) GetPagination() *v1beta11.PageResponse {
+func (x *QueryGrantSpendsByGranterResponse) GetPagination() *v1beta11.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryAllowancesByGranterRequest is the request type for the
-// Query/AllowancesByGranter RPC method.
-type QueryAllowancesByGranterRequest struct {
+// QueryExpiringAllowancesRequest is the request type for the
+// Query/ExpiringAllowances RPC method.
+type QueryExpiringAllowancesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// granter is the address of the user granting fee allowances
-	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// expiring_before is the time the allowances expire before.
+	ExpiringBefore *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expiring_before,json=expiringBefore,proto3" json:"expiring_before,omitempty"`
+	// granter, if set, only matches allowances granted by this address.
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	// active_only excludes the allowances that have already expired.
+	ActiveOnly bool `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
 	// pagination defines pagination for the request.
-	Pagination *v1beta11.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllowancesByGranterRequest) Reset() {
-	*x = QueryAllowancesByGranterRequest{}
+func (x *QueryExpiringAllowancesRequest) Reset() {
+	*x = QueryExpiringAllowancesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[4]
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllowancesByGranterRequest) String() string {
+func (x *QueryExpiringAllowancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllowancesByGranterRequest) ProtoMessage() {}
+func (*QueryExpiringAllowancesRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAllowancesByGranterRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllowancesByGranterRequest) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryExpiringAllowancesRequest.ProtoReflect.Descriptor instead.
+func (*QueryExpiringAllowancesRequest) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueryAllowancesByGranterRequest) GetGranter() string {
+func (x *QueryExpiringAllowancesRequest) GetExpiringBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiringBefore
+	}
+	return nil
+}
+
+func (x *QueryExpiringAllowancesRequest) GetGranter() string {
 	if x != nil {
 		return x.Granter
 	}
 	return ""
 }
 
-func (x *QueryAllowancesByGranterRequest) GetPagination() *v1beta11.PageRequest {
+func (x *QueryExpiringAllowancesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *QueryExpiringAllowancesRequest) GetPagination() *v1beta11.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryAllowancesByGranterResponse is the response type for the
-// Query/AllowancesByGranter RPC method.
-type QueryAllowancesByGranterResponse struct {
+// QueryExpiringAllowancesResponse is the response type for the
+// Query/ExpiringAllowances RPC method.
+type QueryExpiringAllowancesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// allowances that have been issued by the granter.
+	// allowances are the grants ordered by the expiration of their allowance.
 	Allowances []*v1beta1.Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
 	// pagination defines pagination for the response.
 	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllowancesByGranterResponse) Reset() {
-	*x = QueryAllowancesByGranterResponse{}
+func (x *QueryExpiringAllowancesResponse) Reset() {
+	*x = QueryExpiringAllowancesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[5]
+		mi := &file_xion_indexer_feegrant_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllowancesByGranterResponse) String() string {
+func (x *QueryExpiringAllowancesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllowancesByGranterResponse) ProtoMessage() {}
+func (*QueryExpiringAllowancesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllowancesByGranterResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllowancesByGranterResponse) Descriptor() ([]byte, []int) {
-	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryExpiringAllowancesResponse.ProtoReflect.Descriptor instead.
+func (*QueryExpiringAllowancesResponse) Descriptor() ([]byte, []int) {
+	return file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryAllowancesByGranterResponse) GetAllowances() []*v1beta1.Grant {
+func (x *QueryExpiringAllowancesResponse) GetAllowances() []*v1beta1.Grant {
 	if x != nil {
 		return x.Allowances
 	}
 	return nil
 }
 
-func (x *QueryAllowancesByGranterResponse) GetPagination() *v1beta11.PageResponse {
+func (x *QueryExpiringAllowancesResponse) GetPagination() *v1beta11.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
@@ -3377,115 +8420,263 @@ var file_xion_indexer_feegrant_v1_query_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7f, 0x0a,
-	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72,
-	0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x22, 0x56,
-	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72,
-	0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa2, 0x01,
-	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0a, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x9d, 0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f,
-	0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x78, 0x69, 0x6f, 0x6e,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d, 0x0a,
+	0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x7f, 0x0a, 0x15,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x22, 0x56, 0x0a,
+	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa2,
+	0x01, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0a,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xef, 0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0a,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
 	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f,
-	0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x32, 0xa8, 0x04, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0xaf, 0x01, 0x0a, 0x09, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x78, 0x69, 0x6f, 0x6e,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x93, 0x02, 0x0a, 0x0a, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x61, 0x0a, 0x05, 0x73, 0x70,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c,
+	0x61, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x22, 0x55, 0x0a, 0x17,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x05, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x42, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x42, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x78, 0x69, 0x6f,
+	0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x52, 0x06, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x8c, 0x02, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xaa, 0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x72, 0x0a,
+	0x10, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x58, 0x50, 0x49, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58, 0x50, 0x49, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x49, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x02, 0x32, 0xd6, 0x08, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0xaf, 0x01, 0x0a, 0x09,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x78, 0x69, 0x6f, 0x6e,
 	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e,
 	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
-	0x72, 0x7d, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x7d, 0x12, 0xa9, 0x01, 0x0a,
-	0x0a, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x78, 0x69,
-	0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72,
-	0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f,
-	0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
-	0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65,
-	0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c,
-	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b,
-	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x7d, 0x12, 0xc0, 0x01, 0x0a, 0x13, 0x41, 0x6c, 0x6c,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x78, 0x69, 0x6f,
+	0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x72, 0x7d, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x7d, 0x12, 0xa9, 0x01,
+	0x0a, 0x0a, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x78,
+	0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65,
+	0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x78, 0x69, 0x6f, 0x6e,
+	0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f,
+	0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x7d, 0x12, 0xc0, 0x01, 0x0a, 0x13, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x72, 0x12, 0x39, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x78,
+	0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c,
 	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72,
-	0x12, 0x39, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x61,
-	0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x78, 0x69,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c,
+	0x12, 0x2a, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f,
+	0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x64, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x12, 0xae, 0x01, 0x0a,
+	0x0a, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x30, 0x2e, 0x78, 0x69,
 	0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72,
-	0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f,
-	0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12,
-	0x2a, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x66,
-	0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x73, 0x73, 0x75, 0x65,
-	0x64, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x42, 0xf1, 0x01, 0x0a, 0x1c,
-	0x63, 0x6f, 0x6d, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x6e, 0x74, 0x2d, 0x6c, 0x61, 0x62,
-	0x73, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f,
+	0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f,
 	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x58, 0x49, 0x46, 0xaa, 0x02, 0x18, 0x58, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x18, 0x58, 0x69, 0x6f, 0x6e, 0x5c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x5c, 0x46,
-	0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x58, 0x69, 0x6f,
-	0x6e, 0x5c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x5c, 0x46, 0x65, 0x65, 0x67, 0x72, 0x61,
-	0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x1b, 0x58, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x72, 0x7d, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x7d, 0x12, 0xc2, 0x01,
+	0x0a, 0x14, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x42, 0x79, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x3a, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x73, 0x42, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x42, 0x79,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x72, 0x7d, 0x12, 0xb5, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x38, 0x2e, 0x78, 0x69, 0x6f, 0x6e,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0xf1, 0x01, 0x0a, 0x1c, 0x63,
+	0x6f, 0x6d, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x6e, 0x74, 0x2d, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2f,
+	0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x58, 0x49, 0x46, 0xaa, 0x02, 0x18, 0x58, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x18, 0x58, 0x69, 0x6f, 0x6e, 0x5c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x5c, 0x46, 0x65,
+	0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x58, 0x69, 0x6f, 0x6e,
+	0x5c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x5c, 0x46, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x1b, 0x58, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x3a, 0x3a, 0x46, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3500,37 +8691,68 @@ func file_xion_indexer_feegrant_v1_query_proto_rawDescGZIP() []byte {
 	return file_xion_indexer_feegrant_v1_query_proto_rawDescData
 }
 
-var file_xion_indexer_feegrant_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_xion_indexer_feegrant_v1_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_xion_indexer_feegrant_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_xion_indexer_feegrant_v1_query_proto_goTypes = []interface{}{
-	(*QueryAllowanceRequest)(nil),            // 0: xion.indexer.feegrant.v1.QueryAllowanceRequest
-	(*QueryAllowanceResponse)(nil),           // 1: xion.indexer.feegrant.v1.QueryAllowanceResponse
-	(*QueryAllowancesRequest)(nil),           // 2: xion.indexer.feegrant.v1.QueryAllowancesRequest
-	(*QueryAllowancesResponse)(nil),          // 3: xion.indexer.feegrant.v1.QueryAllowancesResponse
-	(*QueryAllowancesByGranterRequest)(nil),  // 4: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest
-	(*QueryAllowancesByGranterResponse)(nil), // 5: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse
-	(*v1beta1.Grant)(nil),                    // 6: cosmos.feegrant.v1beta1.Grant
-	(*v1beta11.PageRequest)(nil),             // 7: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta11.PageResponse)(nil),            // 8: cosmos.base.query.v1beta1.PageResponse
+	(ExpirationStatus)(0),                     // 0: xion.indexer.feegrant.v1.ExpirationStatus
+	(*ExpirationFilter)(nil),                  // 1: xion.indexer.feegrant.v1.ExpirationFilter
+	(*QueryAllowanceRequest)(nil),             // 2: xion.indexer.feegrant.v1.QueryAllowanceRequest
+	(*QueryAllowanceResponse)(nil),            // 3: xion.indexer.feegrant.v1.QueryAllowanceResponse
+	(*QueryAllowancesRequest)(nil),            // 4: xion.indexer.feegrant.v1.QueryAllowancesRequest
+	(*QueryAllowancesResponse)(nil),           // 5: xion.indexer.feegrant.v1.QueryAllowancesResponse
+	(*QueryAllowancesByGranterRequest)(nil),   // 6: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest
+	(*QueryAllowancesByGranterResponse)(nil),  // 7: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse
+	(*GrantSpend)(nil),                        // 8: xion.indexer.feegrant.v1.GrantSpend
+	(*QueryGrantSpendRequest)(nil),            // 9: xion.indexer.feegrant.v1.QueryGrantSpendRequest
+	(*QueryGrantSpendResponse)(nil),           // 10: xion.indexer.feegrant.v1.QueryGrantSpendResponse
+	(*QueryGrantSpendsByGranterRequest)(nil),  // 11: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest
+	(*QueryGrantSpendsByGranterResponse)(nil), // 12: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse
+	(*QueryExpiringAllowancesRequest)(nil),    // 13: xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest
+	(*QueryExpiringAllowancesResponse)(nil),   // 14: xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse
+	(*timestamppb.Timestamp)(nil),             // 15: google.protobuf.Timestamp
+	(*v1beta1.Grant)(nil),                     // 16: cosmos.feegrant.v1beta1.Grant
+	(*v1beta11.PageRequest)(nil),              // 17: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta11.PageResponse)(nil),             // 18: cosmos.base.query.v1beta1.PageResponse
+	(*v1beta12.Coin)(nil),                     // 19: cosmos.base.v1beta1.Coin
 }
 var file_xion_indexer_feegrant_v1_query_proto_depIdxs = []int32{
-	6,  // 0: xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance:type_name -> cosmos.feegrant.v1beta1.Grant
-	7,  // 1: xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	6,  // 2: xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances:type_name -> cosmos.feegrant.v1beta1.Grant
-	8,  // 3: xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	7,  // 4: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	6,  // 5: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances:type_name -> cosmos.feegrant.v1beta1.Grant
-	8,  // 6: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	0,  // 7: xion.indexer.feegrant.v1.Query.Allowance:input_type -> xion.indexer.feegrant.v1.QueryAllowanceRequest
-	2,  // 8: xion.indexer.feegrant.v1.Query.Allowances:input_type -> xion.indexer.feegrant.v1.QueryAllowancesRequest
-	4,  // 9: xion.indexer.feegrant.v1.Query.AllowancesByGranter:input_type -> xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest
-	1,  // 10: xion.indexer.feegrant.v1.Query.Allowance:output_type -> xion.indexer.feegrant.v1.QueryAllowanceResponse
-	3,  // 11: xion.indexer.feegrant.v1.Query.Allowances:output_type -> xion.indexer.feegrant.v1.QueryAllowancesResponse
-	5,  // 12: xion.indexer.feegrant.v1.Query.AllowancesByGranter:output_type -> xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse
-	10, // [10:13] is the sub-list for method output_type
-	7,  // [7:10] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	0,  // 0: xion.indexer.feegrant.v1.ExpirationFilter.status:type_name -> xion.indexer.feegrant.v1.ExpirationStatus
+	15, // 1: xion.indexer.feegrant.v1.ExpirationFilter.expiring_before:type_name -> google.protobuf.Timestamp
+	16, // 2: xion.indexer.feegrant.v1.QueryAllowanceResponse.allowance:type_name -> cosmos.feegrant.v1beta1.Grant
+	17, // 3: xion.indexer.feegrant.v1.QueryAllowancesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	1,  // 4: xion.indexer.feegrant.v1.QueryAllowancesRequest.expiration:type_name -> xion.indexer.feegrant.v1.ExpirationFilter
+	16, // 5: xion.indexer.feegrant.v1.QueryAllowancesResponse.allowances:type_name -> cosmos.feegrant.v1beta1.Grant
+	18, // 6: xion.indexer.feegrant.v1.QueryAllowancesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	17, // 7: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	1,  // 8: xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest.expiration:type_name -> xion.indexer.feegrant.v1.ExpirationFilter
+	16, // 9: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.allowances:type_name -> cosmos.feegrant.v1beta1.Grant
+	18, // 10: xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	19, // 11: xion.indexer.feegrant.v1.GrantSpend.spent:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 12: xion.indexer.feegrant.v1.QueryGrantSpendResponse.spend:type_name -> xion.indexer.feegrant.v1.GrantSpend
+	17, // 13: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	8,  // 14: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.spends:type_name -> xion.indexer.feegrant.v1.GrantSpend
+	18, // 15: xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	15, // 16: xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.expiring_before:type_name -> google.protobuf.Timestamp
+	17, // 17: xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	16, // 18: xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.allowances:type_name -> cosmos.feegrant.v1beta1.Grant
+	18, // 19: xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	2,  // 20: xion.indexer.feegrant.v1.Query.Allowance:input_type -> xion.indexer.feegrant.v1.QueryAllowanceRequest
+	4,  // 21: xion.indexer.feegrant.v1.Query.Allowances:input_type -> xion.indexer.feegrant.v1.QueryAllowancesRequest
+	6,  // 22: xion.indexer.feegrant.v1.Query.AllowancesByGranter:input_type -> xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest
+	9,  // 23: xion.indexer.feegrant.v1.Query.GrantSpend:input_type -> xion.indexer.feegrant.v1.QueryGrantSpendRequest
+	11, // 24: xion.indexer.feegrant.v1.Query.GrantSpendsByGranter:input_type -> xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest
+	13, // 25: xion.indexer.feegrant.v1.Query.ExpiringAllowances:input_type -> xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest
+	3,  // 26: xion.indexer.feegrant.v1.Query.Allowance:output_type -> xion.indexer.feegrant.v1.QueryAllowanceResponse
+	5,  // 27: xion.indexer.feegrant.v1.Query.Allowances:output_type -> xion.indexer.feegrant.v1.QueryAllowancesResponse
+	7,  // 28: xion.indexer.feegrant.v1.Query.AllowancesByGranter:output_type -> xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse
+	10, // 29: xion.indexer.feegrant.v1.Query.GrantSpend:output_type -> xion.indexer.feegrant.v1.QueryGrantSpendResponse
+	12, // 30: xion.indexer.feegrant.v1.Query.GrantSpendsByGranter:output_type -> xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse
+	14, // 31: xion.indexer.feegrant.v1.Query.ExpiringAllowances:output_type -> xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse
+	26, // [26:32] is the sub-list for method output_type
+	20, // [20:26] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_xion_indexer_feegrant_v1_query_proto_init() }
@@ -3540,7 +8762,7 @@ func file_xion_indexer_feegrant_v1_query_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_xion_indexer_feegrant_v1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllowanceRequest); i {
+			switch v := v.(*ExpirationFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3552,7 +8774,7 @@ func file_xion_indexer_feegrant_v1_query_proto_init() {
 			}
 		}
 		file_xion_indexer_feegrant_v1_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllowanceResponse); i {
+			switch v := v.(*QueryAllowanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3564,7 +8786,7 @@ func file_xion_indexer_feegrant_v1_query_proto_init() {
 			}
 		}
 		file_xion_indexer_feegrant_v1_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllowancesRequest); i {
+			switch v := v.(*QueryAllowanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3576,7 +8798,7 @@ func file_xion_indexer_feegrant_v1_query_proto_init() {
 			}
 		}
 		file_xion_indexer_feegrant_v1_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllowancesResponse); i {
+			switch v := v.(*QueryAllowancesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3588,7 +8810,7 @@ func file_xion_indexer_feegrant_v1_query_proto_init() {
 			}
 		}
 		file_xion_indexer_feegrant_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllowancesByGranterRequest); i {
+			switch v := v.(*QueryAllowancesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3600,6 +8822,18 @@ func file_xion_indexer_feegrant_v1_query_proto_init() {
 			}
 		}
 		file_xion_indexer_feegrant_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllowancesByGranterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xion_indexer_feegrant_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryAllowancesByGranterResponse); i {
 			case 0:
 				return &v.state
@@ -3611,19 +8845,104 @@ func file_xion_indexer_feegrant_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_xion_indexer_feegrant_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantSpend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xion_indexer_feegrant_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGrantSpendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xion_indexer_feegrant_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGrantSpendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xion_indexer_feegrant_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGrantSpendsByGranterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xion_indexer_feegrant_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGrantSpendsByGranterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xion_indexer_feegrant_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryExpiringAllowancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xion_indexer_feegrant_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryExpiringAllowancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_xion_indexer_feegrant_v1_query_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_xion_indexer_feegrant_v1_query_proto_goTypes,
 		DependencyIndexes: file_xion_indexer_feegrant_v1_query_proto_depIdxs,
+		EnumInfos:         file_xion_indexer_feegrant_v1_query_proto_enumTypes,
 		MessageInfos:      file_xion_indexer_feegrant_v1_query_proto_msgTypes,
 	}.Build()
 	File_xion_indexer_feegrant_v1_query_proto = out.File
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Allowance_FullMethodName           = "/xion.indexer.feegrant.v1.Query/Allowance"
-	Query_Allowances_FullMethodName          = "/xion.indexer.feegrant.v1.Query/Allowances"
-	Query_AllowancesByGranter_FullMethodName = "/xion.indexer.feegrant.v1.Query/AllowancesByGranter"
+	Query_Allowance_FullMethodName            = "/xion.indexer.feegrant.v1.Query/Allowance"
+	Query_Allowances_FullMethodName           = "/xion.indexer.feegrant.v1.Query/Allowances"
+	Query_AllowancesByGranter_FullMethodName  = "/xion.indexer.feegrant.v1.Query/AllowancesByGranter"
+	Query_GrantSpend_FullMethodName           = "/xion.indexer.feegrant.v1.Query/GrantSpend"
+	Query_GrantSpendsByGranter_FullMethodName = "/xion.indexer.feegrant.v1.Query/GrantSpendsByGranter"
+	Query_ExpiringAllowances_FullMethodName   = "/xion.indexer.feegrant.v1.Query/ExpiringAllowances"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +39,14 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// GrantSpend returns the fees the granter paid for the grantee.
+	GrantSpend(ctx context.Context, in *QueryGrantSpendRequest, opts ...grpc.CallOption) (*QueryGrantSpendResponse, error)
+	// GrantSpendsByGranter returns the fees paid by an address for each of its
+	// grantees.
+	GrantSpendsByGranter(ctx context.Context, in *QueryGrantSpendsByGranterRequest, opts ...grpc.CallOption) (*QueryGrantSpendsByGranterResponse, error)
+	// ExpiringAllowances returns the grants whose allowance expires before a
+	// time, ordered by expiration.
+	ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +87,36 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) GrantSpend(ctx context.Context, in *QueryGrantSpendRequest, opts ...grpc.CallOption) (*QueryGrantSpendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGrantSpendResponse)
+	err := c.cc.Invoke(ctx, Query_GrantSpend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GrantSpendsByGranter(ctx context.Context, in *QueryGrantSpendsByGranterRequest, opts ...grpc.CallOption) (*QueryGrantSpendsByGranterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGrantSpendsByGranterResponse)
+	err := c.cc.Invoke(ctx, Query_GrantSpendsByGranter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryExpiringAllowancesResponse)
+	err := c.cc.Invoke(ctx, Query_ExpiringAllowances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -88,6 +129,14 @@ type QueryServer interface {
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// GrantSpend returns the fees the granter paid for the grantee.
+	GrantSpend(context.Context, *QueryGrantSpendRequest) (*QueryGrantSpendResponse, error)
+	// GrantSpendsByGranter returns the fees paid by an address for each of its
+	// grantees.
+	GrantSpendsByGranter(context.Context, *QueryGrantSpendsByGranterRequest) (*QueryGrantSpendsByGranterResponse, error)
+	// ExpiringAllowances returns the grants whose allowance expires before a
+	// time, ordered by expiration.
+	ExpiringAllowances(context.Context, *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +156,15 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) GrantSpend(context.Context, *QueryGrantSpendRequest) (*QueryGrantSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSpend not implemented")
+}
+func (UnimplementedQueryServer) GrantSpendsByGranter(context.Context, *QueryGrantSpendsByGranterRequest) (*QueryGrantSpendsByGranterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSpendsByGranter not implemented")
+}
+func (UnimplementedQueryServer) ExpiringAllowances(context.Context, *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringAllowances not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +240,60 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GrantSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GrantSpend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantSpend(ctx, req.(*QueryGrantSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GrantSpendsByGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantSpendsByGranterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantSpendsByGranter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GrantSpendsByGranter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantSpendsByGranter(ctx, req.(*QueryGrantSpendsByGranterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpiringAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExpiringAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringAllowances(ctx, req.(*QueryExpiringAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +313,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "GrantSpend",
+			Handler:    _Query_GrantSpend_Handler,
+		},
+		{
+			MethodName: "GrantSpendsByGranter",
+			Handler:    _Query_GrantSpendsByGranter_Handler,
+		},
+		{
+			MethodName: "ExpiringAllowances",
+			Handler:    _Query_ExpiringAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xion/indexer/feegrant/v1/query.proto",
@@ -4,6 +4,7 @@ package xionv1
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -65,10 +66,328 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*PaymentSchedule
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PaymentSchedule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PaymentSchedule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(PaymentSchedule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(PaymentSchedule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*Escrow
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Escrow)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Escrow)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(Escrow)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(Escrow)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*AttestationTrustAnchor
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AttestationTrustAnchor)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AttestationTrustAnchor)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(AttestationTrustAnchor)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(AttestationTrustAnchor)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*WebAuthNCredentialState
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WebAuthNCredentialState)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WebAuthNCredentialState)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(WebAuthNCredentialState)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(WebAuthNCredentialState)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*RelyingPartyProfile
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RelyingPartyProfile)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RelyingPartyProfile)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(RelyingPartyProfile)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(RelyingPartyProfile)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*MintEpoch
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintEpoch)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintEpoch)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(MintEpoch)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(MintEpoch)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                     protoreflect.MessageDescriptor
-	fd_GenesisState_platform_percentage protoreflect.FieldDescriptor
-	fd_GenesisState_platform_minimums   protoreflect.FieldDescriptor
+	md_GenesisState                           protoreflect.MessageDescriptor
+	fd_GenesisState_platform_percentage       protoreflect.FieldDescriptor
+	fd_GenesisState_platform_minimums         protoreflect.FieldDescriptor
+	fd_GenesisState_platform_fee_split        protoreflect.FieldDescriptor
+	fd_GenesisState_platform_fee_enforcement  protoreflect.FieldDescriptor
+	fd_GenesisState_payment_schedules         protoreflect.FieldDescriptor
+	fd_GenesisState_next_payment_schedule_id  protoreflect.FieldDescriptor
+	fd_GenesisState_escrows                   protoreflect.FieldDescriptor
+	fd_GenesisState_next_escrow_id            protoreflect.FieldDescriptor
+	fd_GenesisState_attestation_trust_anchors protoreflect.FieldDescriptor
+	fd_GenesisState_webauthn_credentials      protoreflect.FieldDescriptor
+	fd_GenesisState_relying_party_profiles    protoreflect.FieldDescriptor
+	fd_GenesisState_emissions_schedule        protoreflect.FieldDescriptor
+	fd_GenesisState_mint_totals               protoreflect.FieldDescriptor
+	fd_GenesisState_mint_epochs               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -76,6 +395,18 @@ func init() {
 	md_GenesisState = File_xion_v1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_platform_percentage = md_GenesisState.Fields().ByName("platform_percentage")
 	fd_GenesisState_platform_minimums = md_GenesisState.Fields().ByName("platform_minimums")
+	fd_GenesisState_platform_fee_split = md_GenesisState.Fields().ByName("platform_fee_split")
+	fd_GenesisState_platform_fee_enforcement = md_GenesisState.Fields().ByName("platform_fee_enforcement")
+	fd_GenesisState_payment_schedules = md_GenesisState.Fields().ByName("payment_schedules")
+	fd_GenesisState_next_payment_schedule_id = md_GenesisState.Fields().ByName("next_payment_schedule_id")
+	fd_GenesisState_escrows = md_GenesisState.Fields().ByName("escrows")
+	fd_GenesisState_next_escrow_id = md_GenesisState.Fields().ByName("next_escrow_id")
+	fd_GenesisState_attestation_trust_anchors = md_GenesisState.Fields().ByName("attestation_trust_anchors")
+	fd_GenesisState_webauthn_credentials = md_GenesisState.Fields().ByName("webauthn_credentials")
+	fd_GenesisState_relying_party_profiles = md_GenesisState.Fields().ByName("relying_party_profiles")
+	fd_GenesisState_emissions_schedule = md_GenesisState.Fields().ByName("emissions_schedule")
+	fd_GenesisState_mint_totals = md_GenesisState.Fields().ByName("mint_totals")
+	fd_GenesisState_mint_epochs = md_GenesisState.Fields().ByName("mint_epochs")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -155,6 +486,78 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.PlatformFeeSplit != nil {
+		value := protoreflect.ValueOfMessage(x.PlatformFeeSplit.ProtoReflect())
+		if !f(fd_GenesisState_platform_fee_split, value) {
+			return
+		}
+	}
+	if x.PlatformFeeEnforcement != nil {
+		value := protoreflect.ValueOfMessage(x.PlatformFeeEnforcement.ProtoReflect())
+		if !f(fd_GenesisState_platform_fee_enforcement, value) {
+			return
+		}
+	}
+	if len(x.PaymentSchedules) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.PaymentSchedules})
+		if !f(fd_GenesisState_payment_schedules, value) {
+			return
+		}
+	}
+	if x.NextPaymentScheduleId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextPaymentScheduleId)
+		if !f(fd_GenesisState_next_payment_schedule_id, value) {
+			return
+		}
+	}
+	if len(x.Escrows) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.Escrows})
+		if !f(fd_GenesisState_escrows, value) {
+			return
+		}
+	}
+	if x.NextEscrowId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextEscrowId)
+		if !f(fd_GenesisState_next_escrow_id, value) {
+			return
+		}
+	}
+	if len(x.AttestationTrustAnchors) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.AttestationTrustAnchors})
+		if !f(fd_GenesisState_attestation_trust_anchors, value) {
+			return
+		}
+	}
+	if len(x.WebauthnCredentials) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.WebauthnCredentials})
+		if !f(fd_GenesisState_webauthn_credentials, value) {
+			return
+		}
+	}
+	if len(x.RelyingPartyProfiles) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.RelyingPartyProfiles})
+		if !f(fd_GenesisState_relying_party_profiles, value) {
+			return
+		}
+	}
+	if x.EmissionsSchedule != nil {
+		value := protoreflect.ValueOfMessage(x.EmissionsSchedule.ProtoReflect())
+		if !f(fd_GenesisState_emissions_schedule, value) {
+			return
+		}
+	}
+	if x.MintTotals != nil {
+		value := protoreflect.ValueOfMessage(x.MintTotals.ProtoReflect())
+		if !f(fd_GenesisState_mint_totals, value) {
+			return
+		}
+	}
+	if len(x.MintEpochs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.MintEpochs})
+		if !f(fd_GenesisState_mint_epochs, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -174,11 +577,1960 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.PlatformPercentage != uint32(0)
 	case "xion.v1.GenesisState.platform_minimums":
 		return len(x.PlatformMinimums) != 0
+	case "xion.v1.GenesisState.platform_fee_split":
+		return x.PlatformFeeSplit != nil
+	case "xion.v1.GenesisState.platform_fee_enforcement":
+		return x.PlatformFeeEnforcement != nil
+	case "xion.v1.GenesisState.payment_schedules":
+		return len(x.PaymentSchedules) != 0
+	case "xion.v1.GenesisState.next_payment_schedule_id":
+		return x.NextPaymentScheduleId != uint64(0)
+	case "xion.v1.GenesisState.escrows":
+		return len(x.Escrows) != 0
+	case "xion.v1.GenesisState.next_escrow_id":
+		return x.NextEscrowId != uint64(0)
+	case "xion.v1.GenesisState.attestation_trust_anchors":
+		return len(x.AttestationTrustAnchors) != 0
+	case "xion.v1.GenesisState.webauthn_credentials":
+		return len(x.WebauthnCredentials) != 0
+	case "xion.v1.GenesisState.relying_party_profiles":
+		return len(x.RelyingPartyProfiles) != 0
+	case "xion.v1.GenesisState.emissions_schedule":
+		return x.EmissionsSchedule != nil
+	case "xion.v1.GenesisState.mint_totals":
+		return x.MintTotals != nil
+	case "xion.v1.GenesisState.mint_epochs":
+		return len(x.MintEpochs) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.GenesisState"))
 		}
-		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.v1.GenesisState.platform_percentage":
+		x.PlatformPercentage = uint32(0)
+	case "xion.v1.GenesisState.platform_minimums":
+		x.PlatformMinimums = nil
+	case "xion.v1.GenesisState.platform_fee_split":
+		x.PlatformFeeSplit = nil
+	case "xion.v1.GenesisState.platform_fee_enforcement":
+		x.PlatformFeeEnforcement = nil
+	case "xion.v1.GenesisState.payment_schedules":
+		x.PaymentSchedules = nil
+	case "xion.v1.GenesisState.next_payment_schedule_id":
+		x.NextPaymentScheduleId = uint64(0)
+	case "xion.v1.GenesisState.escrows":
+		x.Escrows = nil
+	case "xion.v1.GenesisState.next_escrow_id":
+		x.NextEscrowId = uint64(0)
+	case "xion.v1.GenesisState.attestation_trust_anchors":
+		x.AttestationTrustAnchors = nil
+	case "xion.v1.GenesisState.webauthn_credentials":
+		x.WebauthnCredentials = nil
+	case "xion.v1.GenesisState.relying_party_profiles":
+		x.RelyingPartyProfiles = nil
+	case "xion.v1.GenesisState.emissions_schedule":
+		x.EmissionsSchedule = nil
+	case "xion.v1.GenesisState.mint_totals":
+		x.MintTotals = nil
+	case "xion.v1.GenesisState.mint_epochs":
+		x.MintEpochs = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.v1.GenesisState.platform_percentage":
+		value := x.PlatformPercentage
+		return protoreflect.ValueOfUint32(value)
+	case "xion.v1.GenesisState.platform_minimums":
+		if len(x.PlatformMinimums) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.PlatformMinimums}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.v1.GenesisState.platform_fee_split":
+		value := x.PlatformFeeSplit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.v1.GenesisState.platform_fee_enforcement":
+		value := x.PlatformFeeEnforcement
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.v1.GenesisState.payment_schedules":
+		if len(x.PaymentSchedules) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.PaymentSchedules}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.v1.GenesisState.next_payment_schedule_id":
+		value := x.NextPaymentScheduleId
+		return protoreflect.ValueOfUint64(value)
+	case "xion.v1.GenesisState.escrows":
+		if len(x.Escrows) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.Escrows}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.v1.GenesisState.next_escrow_id":
+		value := x.NextEscrowId
+		return protoreflect.ValueOfUint64(value)
+	case "xion.v1.GenesisState.attestation_trust_anchors":
+		if len(x.AttestationTrustAnchors) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.AttestationTrustAnchors}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.v1.GenesisState.webauthn_credentials":
+		if len(x.WebauthnCredentials) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.WebauthnCredentials}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.v1.GenesisState.relying_party_profiles":
+		if len(x.RelyingPartyProfiles) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.RelyingPartyProfiles}
+		return protoreflect.ValueOfList(listValue)
+	case "xion.v1.GenesisState.emissions_schedule":
+		value := x.EmissionsSchedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.v1.GenesisState.mint_totals":
+		value := x.MintTotals
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "xion.v1.GenesisState.mint_epochs":
+		if len(x.MintEpochs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.MintEpochs}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.v1.GenesisState.platform_percentage":
+		x.PlatformPercentage = uint32(value.Uint())
+	case "xion.v1.GenesisState.platform_minimums":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.PlatformMinimums = *clv.list
+	case "xion.v1.GenesisState.platform_fee_split":
+		x.PlatformFeeSplit = value.Message().Interface().(*PlatformFeeSplit)
+	case "xion.v1.GenesisState.platform_fee_enforcement":
+		x.PlatformFeeEnforcement = value.Message().Interface().(*PlatformFeeEnforcement)
+	case "xion.v1.GenesisState.payment_schedules":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.PaymentSchedules = *clv.list
+	case "xion.v1.GenesisState.next_payment_schedule_id":
+		x.NextPaymentScheduleId = value.Uint()
+	case "xion.v1.GenesisState.escrows":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.Escrows = *clv.list
+	case "xion.v1.GenesisState.next_escrow_id":
+		x.NextEscrowId = value.Uint()
+	case "xion.v1.GenesisState.attestation_trust_anchors":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.AttestationTrustAnchors = *clv.list
+	case "xion.v1.GenesisState.webauthn_credentials":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.WebauthnCredentials = *clv.list
+	case "xion.v1.GenesisState.relying_party_profiles":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.RelyingPartyProfiles = *clv.list
+	case "xion.v1.GenesisState.emissions_schedule":
+		x.EmissionsSchedule = value.Message().Interface().(*EmissionsSchedule)
+	case "xion.v1.GenesisState.mint_totals":
+		x.MintTotals = value.Message().Interface().(*MintTotals)
+	case "xion.v1.GenesisState.mint_epochs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.MintEpochs = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.v1.GenesisState.platform_minimums":
+		if x.PlatformMinimums == nil {
+			x.PlatformMinimums = []*v1beta1.Coin{}
+		}
+		value := &_GenesisState_2_list{list: &x.PlatformMinimums}
+		return protoreflect.ValueOfList(value)
+	case "xion.v1.GenesisState.platform_fee_split":
+		if x.PlatformFeeSplit == nil {
+			x.PlatformFeeSplit = new(PlatformFeeSplit)
+		}
+		return protoreflect.ValueOfMessage(x.PlatformFeeSplit.ProtoReflect())
+	case "xion.v1.GenesisState.platform_fee_enforcement":
+		if x.PlatformFeeEnforcement == nil {
+			x.PlatformFeeEnforcement = new(PlatformFeeEnforcement)
+		}
+		return protoreflect.ValueOfMessage(x.PlatformFeeEnforcement.ProtoReflect())
+	case "xion.v1.GenesisState.payment_schedules":
+		if x.PaymentSchedules == nil {
+			x.PaymentSchedules = []*PaymentSchedule{}
+		}
+		value := &_GenesisState_5_list{list: &x.PaymentSchedules}
+		return protoreflect.ValueOfList(value)
+	case "xion.v1.GenesisState.escrows":
+		if x.Escrows == nil {
+			x.Escrows = []*Escrow{}
+		}
+		value := &_GenesisState_7_list{list: &x.Escrows}
+		return protoreflect.ValueOfList(value)
+	case "xion.v1.GenesisState.attestation_trust_anchors":
+		if x.AttestationTrustAnchors == nil {
+			x.AttestationTrustAnchors = []*AttestationTrustAnchor{}
+		}
+		value := &_GenesisState_9_list{list: &x.AttestationTrustAnchors}
+		return protoreflect.ValueOfList(value)
+	case "xion.v1.GenesisState.webauthn_credentials":
+		if x.WebauthnCredentials == nil {
+			x.WebauthnCredentials = []*WebAuthNCredentialState{}
+		}
+		value := &_GenesisState_10_list{list: &x.WebauthnCredentials}
+		return protoreflect.ValueOfList(value)
+	case "xion.v1.GenesisState.relying_party_profiles":
+		if x.RelyingPartyProfiles == nil {
+			x.RelyingPartyProfiles = []*RelyingPartyProfile{}
+		}
+		value := &_GenesisState_11_list{list: &x.RelyingPartyProfiles}
+		return protoreflect.ValueOfList(value)
+	case "xion.v1.GenesisState.emissions_schedule":
+		if x.EmissionsSchedule == nil {
+			x.EmissionsSchedule = new(EmissionsSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.EmissionsSchedule.ProtoReflect())
+	case "xion.v1.GenesisState.mint_totals":
+		if x.MintTotals == nil {
+			x.MintTotals = new(MintTotals)
+		}
+		return protoreflect.ValueOfMessage(x.MintTotals.ProtoReflect())
+	case "xion.v1.GenesisState.mint_epochs":
+		if x.MintEpochs == nil {
+			x.MintEpochs = []*MintEpoch{}
+		}
+		value := &_GenesisState_14_list{list: &x.MintEpochs}
+		return protoreflect.ValueOfList(value)
+	case "xion.v1.GenesisState.platform_percentage":
+		panic(fmt.Errorf("field platform_percentage of message xion.v1.GenesisState is not mutable"))
+	case "xion.v1.GenesisState.next_payment_schedule_id":
+		panic(fmt.Errorf("field next_payment_schedule_id of message xion.v1.GenesisState is not mutable"))
+	case "xion.v1.GenesisState.next_escrow_id":
+		panic(fmt.Errorf("field next_escrow_id of message xion.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.v1.GenesisState.platform_percentage":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "xion.v1.GenesisState.platform_minimums":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "xion.v1.GenesisState.platform_fee_split":
+		m := new(PlatformFeeSplit)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.v1.GenesisState.platform_fee_enforcement":
+		m := new(PlatformFeeEnforcement)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.v1.GenesisState.payment_schedules":
+		list := []*PaymentSchedule{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "xion.v1.GenesisState.next_payment_schedule_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "xion.v1.GenesisState.escrows":
+		list := []*Escrow{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "xion.v1.GenesisState.next_escrow_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "xion.v1.GenesisState.attestation_trust_anchors":
+		list := []*AttestationTrustAnchor{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "xion.v1.GenesisState.webauthn_credentials":
+		list := []*WebAuthNCredentialState{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "xion.v1.GenesisState.relying_party_profiles":
+		list := []*RelyingPartyProfile{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "xion.v1.GenesisState.emissions_schedule":
+		m := new(EmissionsSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.v1.GenesisState.mint_totals":
+		m := new(MintTotals)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "xion.v1.GenesisState.mint_epochs":
+		list := []*MintEpoch{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PlatformPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.PlatformPercentage))
+		}
+		if len(x.PlatformMinimums) > 0 {
+			for _, e := range x.PlatformMinimums {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PlatformFeeSplit != nil {
+			l = options.Size(x.PlatformFeeSplit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PlatformFeeEnforcement != nil {
+			l = options.Size(x.PlatformFeeEnforcement)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PaymentSchedules) > 0 {
+			for _, e := range x.PaymentSchedules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextPaymentScheduleId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextPaymentScheduleId))
+		}
+		if len(x.Escrows) > 0 {
+			for _, e := range x.Escrows {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextEscrowId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextEscrowId))
+		}
+		if len(x.AttestationTrustAnchors) > 0 {
+			for _, e := range x.AttestationTrustAnchors {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.WebauthnCredentials) > 0 {
+			for _, e := range x.WebauthnCredentials {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RelyingPartyProfiles) > 0 {
+			for _, e := range x.RelyingPartyProfiles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EmissionsSchedule != nil {
+			l = options.Size(x.EmissionsSchedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MintTotals != nil {
+			l = options.Size(x.MintTotals)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MintEpochs) > 0 {
+			for _, e := range x.MintEpochs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MintEpochs) > 0 {
+			for iNdEx := len(x.MintEpochs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintEpochs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.MintTotals != nil {
+			encoded, err := options.Marshal(x.MintTotals)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.EmissionsSchedule != nil {
+			encoded, err := options.Marshal(x.EmissionsSchedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.RelyingPartyProfiles) > 0 {
+			for iNdEx := len(x.RelyingPartyProfiles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RelyingPartyProfiles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.WebauthnCredentials) > 0 {
+			for iNdEx := len(x.WebauthnCredentials) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WebauthnCredentials[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.AttestationTrustAnchors) > 0 {
+			for iNdEx := len(x.AttestationTrustAnchors) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AttestationTrustAnchors[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.NextEscrowId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextEscrowId))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Escrows) > 0 {
+			for iNdEx := len(x.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Escrows[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.NextPaymentScheduleId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextPaymentScheduleId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.PaymentSchedules) > 0 {
+			for iNdEx := len(x.PaymentSchedules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PaymentSchedules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.PlatformFeeEnforcement != nil {
+			encoded, err := options.Marshal(x.PlatformFeeEnforcement)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.PlatformFeeSplit != nil {
+			encoded, err := options.Marshal(x.PlatformFeeSplit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.PlatformMinimums) > 0 {
+			for iNdEx := len(x.PlatformMinimums) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PlatformMinimums[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.PlatformPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PlatformPercentage))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PlatformPercentage", wireType)
+				}
+				x.PlatformPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PlatformPercentage |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PlatformMinimums", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PlatformMinimums = append(x.PlatformMinimums, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PlatformMinimums[len(x.PlatformMinimums)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PlatformFeeSplit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PlatformFeeSplit == nil {
+					x.PlatformFeeSplit = &PlatformFeeSplit{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PlatformFeeSplit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PlatformFeeEnforcement", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PlatformFeeEnforcement == nil {
+					x.PlatformFeeEnforcement = &PlatformFeeEnforcement{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PlatformFeeEnforcement); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaymentSchedules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PaymentSchedules = append(x.PaymentSchedules, &PaymentSchedule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PaymentSchedules[len(x.PaymentSchedules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextPaymentScheduleId", wireType)
+				}
+				x.NextPaymentScheduleId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextPaymentScheduleId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Escrows = append(x.Escrows, &Escrow{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrows[len(x.Escrows)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextEscrowId", wireType)
+				}
+				x.NextEscrowId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextEscrowId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttestationTrustAnchors", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AttestationTrustAnchors = append(x.AttestationTrustAnchors, &AttestationTrustAnchor{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AttestationTrustAnchors[len(x.AttestationTrustAnchors)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WebauthnCredentials", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WebauthnCredentials = append(x.WebauthnCredentials, &WebAuthNCredentialState{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WebauthnCredentials[len(x.WebauthnCredentials)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelyingPartyProfiles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RelyingPartyProfiles = append(x.RelyingPartyProfiles, &RelyingPartyProfile{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RelyingPartyProfiles[len(x.RelyingPartyProfiles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmissionsSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EmissionsSchedule == nil {
+					x.EmissionsSchedule = &EmissionsSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmissionsSchedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintTotals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MintTotals == nil {
+					x.MintTotals = &MintTotals{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintTotals); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintEpochs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintEpochs = append(x.MintEpochs, &MintEpoch{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintEpochs[len(x.MintEpochs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PlatformFeeSplit                    protoreflect.MessageDescriptor
+	fd_PlatformFeeSplit_community_pool_bps protoreflect.FieldDescriptor
+	fd_PlatformFeeSplit_burn_bps           protoreflect.FieldDescriptor
+	fd_PlatformFeeSplit_treasury_address   protoreflect.FieldDescriptor
+	fd_PlatformFeeSplit_treasury_bps       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_v1_genesis_proto_init()
+	md_PlatformFeeSplit = File_xion_v1_genesis_proto.Messages().ByName("PlatformFeeSplit")
+	fd_PlatformFeeSplit_community_pool_bps = md_PlatformFeeSplit.Fields().ByName("community_pool_bps")
+	fd_PlatformFeeSplit_burn_bps = md_PlatformFeeSplit.Fields().ByName("burn_bps")
+	fd_PlatformFeeSplit_treasury_address = md_PlatformFeeSplit.Fields().ByName("treasury_address")
+	fd_PlatformFeeSplit_treasury_bps = md_PlatformFeeSplit.Fields().ByName("treasury_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_PlatformFeeSplit)(nil)
+
+type fastReflection_PlatformFeeSplit PlatformFeeSplit
+
+func (x *PlatformFeeSplit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PlatformFeeSplit)(x)
+}
+
+func (x *PlatformFeeSplit) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_v1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PlatformFeeSplit_messageType fastReflection_PlatformFeeSplit_messageType
+var _ protoreflect.MessageType = fastReflection_PlatformFeeSplit_messageType{}
+
+type fastReflection_PlatformFeeSplit_messageType struct{}
+
+func (x fastReflection_PlatformFeeSplit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PlatformFeeSplit)(nil)
+}
+func (x fastReflection_PlatformFeeSplit_messageType) New() protoreflect.Message {
+	return new(fastReflection_PlatformFeeSplit)
+}
+func (x fastReflection_PlatformFeeSplit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PlatformFeeSplit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PlatformFeeSplit) Descriptor() protoreflect.MessageDescriptor {
+	return md_PlatformFeeSplit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PlatformFeeSplit) Type() protoreflect.MessageType {
+	return _fastReflection_PlatformFeeSplit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PlatformFeeSplit) New() protoreflect.Message {
+	return new(fastReflection_PlatformFeeSplit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PlatformFeeSplit) Interface() protoreflect.ProtoMessage {
+	return (*PlatformFeeSplit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PlatformFeeSplit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CommunityPoolBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.CommunityPoolBps)
+		if !f(fd_PlatformFeeSplit_community_pool_bps, value) {
+			return
+		}
+	}
+	if x.BurnBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.BurnBps)
+		if !f(fd_PlatformFeeSplit_burn_bps, value) {
+			return
+		}
+	}
+	if x.TreasuryAddress != "" {
+		value := protoreflect.ValueOfString(x.TreasuryAddress)
+		if !f(fd_PlatformFeeSplit_treasury_address, value) {
+			return
+		}
+	}
+	if x.TreasuryBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TreasuryBps)
+		if !f(fd_PlatformFeeSplit_treasury_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PlatformFeeSplit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.v1.PlatformFeeSplit.community_pool_bps":
+		return x.CommunityPoolBps != uint32(0)
+	case "xion.v1.PlatformFeeSplit.burn_bps":
+		return x.BurnBps != uint32(0)
+	case "xion.v1.PlatformFeeSplit.treasury_address":
+		return x.TreasuryAddress != ""
+	case "xion.v1.PlatformFeeSplit.treasury_bps":
+		return x.TreasuryBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeSplit"))
+		}
+		panic(fmt.Errorf("message xion.v1.PlatformFeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlatformFeeSplit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "xion.v1.PlatformFeeSplit.community_pool_bps":
+		x.CommunityPoolBps = uint32(0)
+	case "xion.v1.PlatformFeeSplit.burn_bps":
+		x.BurnBps = uint32(0)
+	case "xion.v1.PlatformFeeSplit.treasury_address":
+		x.TreasuryAddress = ""
+	case "xion.v1.PlatformFeeSplit.treasury_bps":
+		x.TreasuryBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeSplit"))
+		}
+		panic(fmt.Errorf("message xion.v1.PlatformFeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PlatformFeeSplit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "xion.v1.PlatformFeeSplit.community_pool_bps":
+		value := x.CommunityPoolBps
+		return protoreflect.ValueOfUint32(value)
+	case "xion.v1.PlatformFeeSplit.burn_bps":
+		value := x.BurnBps
+		return protoreflect.ValueOfUint32(value)
+	case "xion.v1.PlatformFeeSplit.treasury_address":
+		value := x.TreasuryAddress
+		return protoreflect.ValueOfString(value)
+	case "xion.v1.PlatformFeeSplit.treasury_bps":
+		value := x.TreasuryBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeSplit"))
+		}
+		panic(fmt.Errorf("message xion.v1.PlatformFeeSplit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlatformFeeSplit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "xion.v1.PlatformFeeSplit.community_pool_bps":
+		x.CommunityPoolBps = uint32(value.Uint())
+	case "xion.v1.PlatformFeeSplit.burn_bps":
+		x.BurnBps = uint32(value.Uint())
+	case "xion.v1.PlatformFeeSplit.treasury_address":
+		x.TreasuryAddress = value.Interface().(string)
+	case "xion.v1.PlatformFeeSplit.treasury_bps":
+		x.TreasuryBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeSplit"))
+		}
+		panic(fmt.Errorf("message xion.v1.PlatformFeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlatformFeeSplit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.v1.PlatformFeeSplit.community_pool_bps":
+		panic(fmt.Errorf("field community_pool_bps of message xion.v1.PlatformFeeSplit is not mutable"))
+	case "xion.v1.PlatformFeeSplit.burn_bps":
+		panic(fmt.Errorf("field burn_bps of message xion.v1.PlatformFeeSplit is not mutable"))
+	case "xion.v1.PlatformFeeSplit.treasury_address":
+		panic(fmt.Errorf("field treasury_address of message xion.v1.PlatformFeeSplit is not mutable"))
+	case "xion.v1.PlatformFeeSplit.treasury_bps":
+		panic(fmt.Errorf("field treasury_bps of message xion.v1.PlatformFeeSplit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeSplit"))
+		}
+		panic(fmt.Errorf("message xion.v1.PlatformFeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PlatformFeeSplit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "xion.v1.PlatformFeeSplit.community_pool_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "xion.v1.PlatformFeeSplit.burn_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "xion.v1.PlatformFeeSplit.treasury_address":
+		return protoreflect.ValueOfString("")
+	case "xion.v1.PlatformFeeSplit.treasury_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeSplit"))
+		}
+		panic(fmt.Errorf("message xion.v1.PlatformFeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PlatformFeeSplit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in xion.v1.PlatformFeeSplit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PlatformFeeSplit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlatformFeeSplit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PlatformFeeSplit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PlatformFeeSplit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PlatformFeeSplit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CommunityPoolBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommunityPoolBps))
+		}
+		if x.BurnBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.BurnBps))
+		}
+		l = len(x.TreasuryAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TreasuryBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TreasuryBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PlatformFeeSplit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TreasuryBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TreasuryBps))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.TreasuryAddress) > 0 {
+			i -= len(x.TreasuryAddress)
+			copy(dAtA[i:], x.TreasuryAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TreasuryAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BurnBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BurnBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.CommunityPoolBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommunityPoolBps))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PlatformFeeSplit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PlatformFeeSplit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PlatformFeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolBps", wireType)
+				}
+				x.CommunityPoolBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommunityPoolBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnBps", wireType)
+				}
+				x.BurnBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BurnBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TreasuryBps", wireType)
+				}
+				x.TreasuryBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TreasuryBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PlatformFeeEnforcement_3_list)(nil)
+
+type _PlatformFeeEnforcement_3_list struct {
+	list *[]string
+}
+
+func (x *_PlatformFeeEnforcement_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PlatformFeeEnforcement_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PlatformFeeEnforcement_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PlatformFeeEnforcement_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PlatformFeeEnforcement_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PlatformFeeEnforcement at list field ExemptAddresses as it is not of Message kind"))
+}
+
+func (x *_PlatformFeeEnforcement_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PlatformFeeEnforcement_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PlatformFeeEnforcement_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PlatformFeeEnforcement                  protoreflect.MessageDescriptor
+	fd_PlatformFeeEnforcement_ibc_transfers    protoreflect.FieldDescriptor
+	fd_PlatformFeeEnforcement_wasm_bank_sends  protoreflect.FieldDescriptor
+	fd_PlatformFeeEnforcement_exempt_addresses protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_xion_v1_genesis_proto_init()
+	md_PlatformFeeEnforcement = File_xion_v1_genesis_proto.Messages().ByName("PlatformFeeEnforcement")
+	fd_PlatformFeeEnforcement_ibc_transfers = md_PlatformFeeEnforcement.Fields().ByName("ibc_transfers")
+	fd_PlatformFeeEnforcement_wasm_bank_sends = md_PlatformFeeEnforcement.Fields().ByName("wasm_bank_sends")
+	fd_PlatformFeeEnforcement_exempt_addresses = md_PlatformFeeEnforcement.Fields().ByName("exempt_addresses")
+}
+
+var _ protoreflect.Message = (*fastReflection_PlatformFeeEnforcement)(nil)
+
+type fastReflection_PlatformFeeEnforcement PlatformFeeEnforcement
+
+func (x *PlatformFeeEnforcement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PlatformFeeEnforcement)(x)
+}
+
+func (x *PlatformFeeEnforcement) slowProtoReflect() protoreflect.Message {
+	mi := &file_xion_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PlatformFeeEnforcement_messageType fastReflection_PlatformFeeEnforcement_messageType
+var _ protoreflect.MessageType = fastReflection_PlatformFeeEnforcement_messageType{}
+
+type fastReflection_PlatformFeeEnforcement_messageType struct{}
+
+func (x fastReflection_PlatformFeeEnforcement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PlatformFeeEnforcement)(nil)
+}
+func (x fastReflection_PlatformFeeEnforcement_messageType) New() protoreflect.Message {
+	return new(fastReflection_PlatformFeeEnforcement)
+}
+func (x fastReflection_PlatformFeeEnforcement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PlatformFeeEnforcement
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PlatformFeeEnforcement) Descriptor() protoreflect.MessageDescriptor {
+	return md_PlatformFeeEnforcement
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PlatformFeeEnforcement) Type() protoreflect.MessageType {
+	return _fastReflection_PlatformFeeEnforcement_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PlatformFeeEnforcement) New() protoreflect.Message {
+	return new(fastReflection_PlatformFeeEnforcement)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PlatformFeeEnforcement) Interface() protoreflect.ProtoMessage {
+	return (*PlatformFeeEnforcement)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PlatformFeeEnforcement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IbcTransfers != false {
+		value := protoreflect.ValueOfBool(x.IbcTransfers)
+		if !f(fd_PlatformFeeEnforcement_ibc_transfers, value) {
+			return
+		}
+	}
+	if x.WasmBankSends != false {
+		value := protoreflect.ValueOfBool(x.WasmBankSends)
+		if !f(fd_PlatformFeeEnforcement_wasm_bank_sends, value) {
+			return
+		}
+	}
+	if len(x.ExemptAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_PlatformFeeEnforcement_3_list{list: &x.ExemptAddresses})
+		if !f(fd_PlatformFeeEnforcement_exempt_addresses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PlatformFeeEnforcement) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "xion.v1.PlatformFeeEnforcement.ibc_transfers":
+		return x.IbcTransfers != false
+	case "xion.v1.PlatformFeeEnforcement.wasm_bank_sends":
+		return x.WasmBankSends != false
+	case "xion.v1.PlatformFeeEnforcement.exempt_addresses":
+		return len(x.ExemptAddresses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeEnforcement"))
+		}
+		panic(fmt.Errorf("message xion.v1.PlatformFeeEnforcement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -188,17 +2540,19 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PlatformFeeEnforcement) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "xion.v1.GenesisState.platform_percentage":
-		x.PlatformPercentage = uint32(0)
-	case "xion.v1.GenesisState.platform_minimums":
-		x.PlatformMinimums = nil
+	case "xion.v1.PlatformFeeEnforcement.ibc_transfers":
+		x.IbcTransfers = false
+	case "xion.v1.PlatformFeeEnforcement.wasm_bank_sends":
+		x.WasmBankSends = false
+	case "xion.v1.PlatformFeeEnforcement.exempt_addresses":
+		x.ExemptAddresses = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeEnforcement"))
 		}
-		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.v1.PlatformFeeEnforcement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -208,22 +2562,25 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PlatformFeeEnforcement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "xion.v1.GenesisState.platform_percentage":
-		value := x.PlatformPercentage
-		return protoreflect.ValueOfUint32(value)
-	case "xion.v1.GenesisState.platform_minimums":
-		if len(x.PlatformMinimums) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+	case "xion.v1.PlatformFeeEnforcement.ibc_transfers":
+		value := x.IbcTransfers
+		return protoreflect.ValueOfBool(value)
+	case "xion.v1.PlatformFeeEnforcement.wasm_bank_sends":
+		value := x.WasmBankSends
+		return protoreflect.ValueOfBool(value)
+	case "xion.v1.PlatformFeeEnforcement.exempt_addresses":
+		if len(x.ExemptAddresses) == 0 {
+			return protoreflect.ValueOfList(&_PlatformFeeEnforcement_3_list{})
 		}
-		listValue := &_GenesisState_2_list{list: &x.PlatformMinimums}
+		listValue := &_PlatformFeeEnforcement_3_list{list: &x.ExemptAddresses}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeEnforcement"))
 		}
-		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message xion.v1.PlatformFeeEnforcement does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -237,19 +2594,21 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PlatformFeeEnforcement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "xion.v1.GenesisState.platform_percentage":
-		x.PlatformPercentage = uint32(value.Uint())
-	case "xion.v1.GenesisState.platform_minimums":
+	case "xion.v1.PlatformFeeEnforcement.ibc_transfers":
+		x.IbcTransfers = value.Bool()
+	case "xion.v1.PlatformFeeEnforcement.wasm_bank_sends":
+		x.WasmBankSends = value.Bool()
+	case "xion.v1.PlatformFeeEnforcement.exempt_addresses":
 		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.PlatformMinimums = *clv.list
+		clv := lv.(*_PlatformFeeEnforcement_3_list)
+		x.ExemptAddresses = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeEnforcement"))
 		}
-		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.v1.PlatformFeeEnforcement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -263,49 +2622,53 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PlatformFeeEnforcement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.v1.GenesisState.platform_minimums":
-		if x.PlatformMinimums == nil {
-			x.PlatformMinimums = []*v1beta1.Coin{}
+	case "xion.v1.PlatformFeeEnforcement.exempt_addresses":
+		if x.ExemptAddresses == nil {
+			x.ExemptAddresses = []string{}
 		}
-		value := &_GenesisState_2_list{list: &x.PlatformMinimums}
+		value := &_PlatformFeeEnforcement_3_list{list: &x.ExemptAddresses}
 		return protoreflect.ValueOfList(value)
-	case "xion.v1.GenesisState.platform_percentage":
-		panic(fmt.Errorf("field platform_percentage of message xion.v1.GenesisState is not mutable"))
+	case "xion.v1.PlatformFeeEnforcement.ibc_transfers":
+		panic(fmt.Errorf("field ibc_transfers of message xion.v1.PlatformFeeEnforcement is not mutable"))
+	case "xion.v1.PlatformFeeEnforcement.wasm_bank_sends":
+		panic(fmt.Errorf("field wasm_bank_sends of message xion.v1.PlatformFeeEnforcement is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeEnforcement"))
 		}
-		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.v1.PlatformFeeEnforcement does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PlatformFeeEnforcement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "xion.v1.GenesisState.platform_percentage":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "xion.v1.GenesisState.platform_minimums":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "xion.v1.PlatformFeeEnforcement.ibc_transfers":
+		return protoreflect.ValueOfBool(false)
+	case "xion.v1.PlatformFeeEnforcement.wasm_bank_sends":
+		return protoreflect.ValueOfBool(false)
+	case "xion.v1.PlatformFeeEnforcement.exempt_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PlatformFeeEnforcement_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: xion.v1.PlatformFeeEnforcement"))
 		}
-		panic(fmt.Errorf("message xion.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message xion.v1.PlatformFeeEnforcement does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PlatformFeeEnforcement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in xion.v1.GenesisState", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in xion.v1.PlatformFeeEnforcement", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -313,7 +2676,7 @@ func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PlatformFeeEnforcement) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -324,7 +2687,7 @@ func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PlatformFeeEnforcement) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -336,7 +2699,7 @@ func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
+func (x *fastReflection_PlatformFeeEnforcement) IsValid() bool {
 	return x != nil
 }
 
@@ -346,9 +2709,9 @@ func (x *fastReflection_GenesisState) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PlatformFeeEnforcement) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*PlatformFeeEnforcement)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -360,12 +2723,15 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.PlatformPercentage != 0 {
-			n += 1 + runtime.Sov(uint64(x.PlatformPercentage))
+		if x.IbcTransfers {
+			n += 2
 		}
-		if len(x.PlatformMinimums) > 0 {
-			for _, e := range x.PlatformMinimums {
-				l = options.Size(e)
+		if x.WasmBankSends {
+			n += 2
+		}
+		if len(x.ExemptAddresses) > 0 {
+			for _, s := range x.ExemptAddresses {
+				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -379,7 +2745,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*PlatformFeeEnforcement)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -398,24 +2764,32 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.PlatformMinimums) > 0 {
-			for iNdEx := len(x.PlatformMinimums) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PlatformMinimums[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.ExemptAddresses) > 0 {
+			for iNdEx := len(x.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ExemptAddresses[iNdEx])
+				copy(dAtA[i:], x.ExemptAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExemptAddresses[iNdEx])))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x1a
 			}
 		}
-		if x.PlatformPercentage != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PlatformPercentage))
+		if x.WasmBankSends {
+			i--
+			if x.WasmBankSends {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.IbcTransfers {
+			i--
+			if x.IbcTransfers {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
 			i--
 			dAtA[i] = 0x8
 		}
@@ -430,7 +2804,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*PlatformFeeEnforcement)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -462,17 +2836,17 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PlatformFeeEnforcement: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PlatformFeeEnforcement: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PlatformPercentage", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcTransfers", wireType)
 				}
-				x.PlatformPercentage = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -482,16 +2856,37 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PlatformPercentage |= uint32(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				x.IbcTransfers = bool(v != 0)
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WasmBankSends", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.WasmBankSends = bool(v != 0)
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PlatformMinimums", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -501,25 +2896,23 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PlatformMinimums = append(x.PlatformMinimums, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PlatformMinimums[len(x.PlatformMinimums)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.ExemptAddresses = append(x.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -579,6 +2972,30 @@ type GenesisState struct {
 	PlatformPercentage uint32 `protobuf:"varint,1,opt,name=platform_percentage,json=platformPercentage,proto3" json:"platform_percentage,omitempty"`
 	// Minimum amounts required for platform operations
 	PlatformMinimums []*v1beta1.Coin `protobuf:"bytes,2,rep,name=platform_minimums,json=platformMinimums,proto3" json:"platform_minimums,omitempty"`
+	// How collected platform fees are divided between destinations
+	PlatformFeeSplit *PlatformFeeSplit `protobuf:"bytes,3,opt,name=platform_fee_split,json=platformFeeSplit,proto3" json:"platform_fee_split,omitempty"`
+	// Which additional value transfer paths are charged the platform fee
+	PlatformFeeEnforcement *PlatformFeeEnforcement `protobuf:"bytes,4,opt,name=platform_fee_enforcement,json=platformFeeEnforcement,proto3" json:"platform_fee_enforcement,omitempty"`
+	// All registered payment schedules
+	PaymentSchedules []*PaymentSchedule `protobuf:"bytes,5,rep,name=payment_schedules,json=paymentSchedules,proto3" json:"payment_schedules,omitempty"`
+	// The id assigned to the next payment schedule
+	NextPaymentScheduleId uint64 `protobuf:"varint,6,opt,name=next_payment_schedule_id,json=nextPaymentScheduleId,proto3" json:"next_payment_schedule_id,omitempty"`
+	// All open escrows
+	Escrows []*Escrow `protobuf:"bytes,7,rep,name=escrows,proto3" json:"escrows,omitempty"`
+	// The id assigned to the next escrow
+	NextEscrowId uint64 `protobuf:"varint,8,opt,name=next_escrow_id,json=nextEscrowId,proto3" json:"next_escrow_id,omitempty"`
+	// Trusted authenticator models for attested WebAuthn registrations
+	AttestationTrustAnchors []*AttestationTrustAnchor `protobuf:"bytes,9,rep,name=attestation_trust_anchors,json=attestationTrustAnchors,proto3" json:"attestation_trust_anchors,omitempty"`
+	// Tracked WebAuthn credential states
+	WebauthnCredentials []*WebAuthNCredentialState `protobuf:"bytes,10,rep,name=webauthn_credentials,json=webauthnCredentials,proto3" json:"webauthn_credentials,omitempty"`
+	// Registered WebAuthn relying party profiles
+	RelyingPartyProfiles []*RelyingPartyProfile `protobuf:"bytes,11,rep,name=relying_party_profiles,json=relyingPartyProfiles,proto3" json:"relying_party_profiles,omitempty"`
+	// The emissions schedule driving x/mint
+	EmissionsSchedule *EmissionsSchedule `protobuf:"bytes,12,opt,name=emissions_schedule,json=emissionsSchedule,proto3" json:"emissions_schedule,omitempty"`
+	// The amounts minted and burned by the mint function
+	MintTotals *MintTotals `protobuf:"bytes,13,opt,name=mint_totals,json=mintTotals,proto3" json:"mint_totals,omitempty"`
+	// The recent mint epochs
+	MintEpochs []*MintEpoch `protobuf:"bytes,14,rep,name=mint_epochs,json=mintEpochs,proto3" json:"mint_epochs,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -615,6 +3032,213 @@ func (x *GenesisState) GetPlatformMinimums() []*v1beta1.Coin {
 	return nil
 }
 
+func (x *GenesisState) GetPlatformFeeSplit() *PlatformFeeSplit {
+	if x != nil {
+		return x.PlatformFeeSplit
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPlatformFeeEnforcement() *PlatformFeeEnforcement {
+	if x != nil {
+		return x.PlatformFeeEnforcement
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPaymentSchedules() []*PaymentSchedule {
+	if x != nil {
+		return x.PaymentSchedules
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextPaymentScheduleId() uint64 {
+	if x != nil {
+		return x.NextPaymentScheduleId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetEscrows() []*Escrow {
+	if x != nil {
+		return x.Escrows
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextEscrowId() uint64 {
+	if x != nil {
+		return x.NextEscrowId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetAttestationTrustAnchors() []*AttestationTrustAnchor {
+	if x != nil {
+		return x.AttestationTrustAnchors
+	}
+	return nil
+}
+
+func (x *GenesisState) GetWebauthnCredentials() []*WebAuthNCredentialState {
+	if x != nil {
+		return x.WebauthnCredentials
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRelyingPartyProfiles() []*RelyingPartyProfile {
+	if x != nil {
+		return x.RelyingPartyProfiles
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEmissionsSchedule() *EmissionsSchedule {
+	if x != nil {
+		return x.EmissionsSchedule
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintTotals() *MintTotals {
+	if x != nil {
+		return x.MintTotals
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintEpochs() []*MintEpoch {
+	if x != nil {
+		return x.MintEpochs
+	}
+	return nil
+}
+
+// PlatformFeeSplit defines how collected platform fees are routed. Each share
+// is expressed in basis points of the collected fee; whatever is not assigned
+// to a destination remains with the fee collector.
+type PlatformFeeSplit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Share of platform fees sent to the community pool
+	CommunityPoolBps uint32 `protobuf:"varint,1,opt,name=community_pool_bps,json=communityPoolBps,proto3" json:"community_pool_bps,omitempty"`
+	// Share of platform fees that is burned
+	BurnBps uint32 `protobuf:"varint,2,opt,name=burn_bps,json=burnBps,proto3" json:"burn_bps,omitempty"`
+	// The address receiving the treasury share
+	TreasuryAddress string `protobuf:"bytes,3,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+	// Share of platform fees sent to the treasury address
+	TreasuryBps uint32 `protobuf:"varint,4,opt,name=treasury_bps,json=treasuryBps,proto3" json:"treasury_bps,omitempty"`
+}
+
+func (x *PlatformFeeSplit) Reset() {
+	*x = PlatformFeeSplit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlatformFeeSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformFeeSplit) ProtoMessage() {}
+
+// Deprecated: Use PlatformFeeSplit.ProtoReflect.Descriptor instead.
+func (*PlatformFeeSplit) Descriptor() ([]byte, []int) {
+	return file_xion_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlatformFeeSplit) GetCommunityPoolBps() uint32 {
+	if x != nil {
+		return x.CommunityPoolBps
+	}
+	return 0
+}
+
+func (x *PlatformFeeSplit) GetBurnBps() uint32 {
+	if x != nil {
+		return x.BurnBps
+	}
+	return 0
+}
+
+func (x *PlatformFeeSplit) GetTreasuryAddress() string {
+	if x != nil {
+		return x.TreasuryAddress
+	}
+	return ""
+}
+
+func (x *PlatformFeeSplit) GetTreasuryBps() uint32 {
+	if x != nil {
+		return x.TreasuryBps
+	}
+	return 0
+}
+
+// PlatformFeeEnforcement extends the platform fee beyond xion.v1.MsgSend and
+// MsgMultiSend to other value transfer paths.
+type PlatformFeeEnforcement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Charge the platform fee on outgoing ICS-20 transfers
+	IbcTransfers bool `protobuf:"varint,1,opt,name=ibc_transfers,json=ibcTransfers,proto3" json:"ibc_transfers,omitempty"`
+	// Charge the platform fee on CosmWasm BankMsg::Send
+	WasmBankSends bool `protobuf:"varint,2,opt,name=wasm_bank_sends,json=wasmBankSends,proto3" json:"wasm_bank_sends,omitempty"`
+	// Senders that are never charged on these paths, such as relayers. Module
+	// accounts are always exempt.
+	ExemptAddresses []string `protobuf:"bytes,3,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
+}
+
+func (x *PlatformFeeEnforcement) Reset() {
+	*x = PlatformFeeEnforcement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xion_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlatformFeeEnforcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformFeeEnforcement) ProtoMessage() {}
+
+// Deprecated: Use PlatformFeeEnforcement.ProtoReflect.Descriptor instead.
+func (*PlatformFeeEnforcement) Descriptor() ([]byte, []int) {
+	return file_xion_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlatformFeeEnforcement) GetIbcTransfers() bool {
+	if x != nil {
+		return x.IbcTransfers
+	}
+	return false
+}
+
+func (x *PlatformFeeEnforcement) GetWasmBankSends() bool {
+	if x != nil {
+		return x.WasmBankSends
+	}
+	return false
+}
+
+func (x *PlatformFeeEnforcement) GetExemptAddresses() []string {
+	if x != nil {
+		return x.ExemptAddresses
+	}
+	return nil
+}
+
 var File_xion_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_xion_v1_genesis_proto_rawDesc = []byte{
@@ -623,31 +3247,152 @@ var file_xion_v1_genesis_proto_rawDesc = []byte{
 	0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0xb3, 0x01, 0x0a, 0x11, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x6b, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1b, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x73, 0x22,
-	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x10, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x73, 0x42, 0x87,
-	0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x6e, 0x74,
-	0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x78,
-	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x78, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x58, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x58, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x07,
-	0x58, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x58, 0x69, 0x6f, 0x6e, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08,
-	0x58, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x14, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x0d, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0xb3, 0x01, 0x0a, 0x11, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x6b, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1b, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x73, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x10,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x73,
+	0x12, 0x8a, 0x01, 0x0a, 0x12, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde,
+	0x1f, 0x1c, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde,
+	0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x22, 0x52, 0x10, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0xa8, 0x01,
+	0x0a, 0x18, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x65,
+	0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x46, 0x65, 0x65, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x4d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x22, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f,
+	0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x52, 0x16, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x65, 0x65, 0x45, 0x6e, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x86, 0x01, 0x0a, 0x11, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x3f,
+	0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x52,
+	0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x18, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x56, 0x0a, 0x07, 0x65, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x78, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x42, 0x2b, 0xc8, 0xde,
+	0x1f, 0x00, 0xea, 0xde, 0x1f, 0x11, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x52, 0x07, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74,
+	0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x49, 0x64, 0x12, 0xac, 0x01, 0x0a, 0x19, 0x61, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x42, 0x4f, 0xc8,
+	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x23, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x20, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x22, 0x52, 0x17,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x14, 0x77, 0x65, 0x62, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x45, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde,
+	0x1f, 0x1e, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x52,
+	0x13, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x12, 0x9d, 0x01, 0x0a, 0x16, 0x72, 0x65, 0x6c, 0x79, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x20, 0x72, 0x65, 0x6c,
+	0x79, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f,
+	0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x52, 0x14,
+	0x72, 0x65, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x6c, 0x0a, 0x12, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x21, 0xc8, 0xde,
+	0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x52,
+	0x11, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x42, 0x1a, 0xc8, 0xde,
+	0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x74,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x22, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x73, 0x12, 0x68, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x78, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x33, 0xc8,
+	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x15, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x12,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x22, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xc3,
+	0x01, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x65, 0x65, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x70,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x75, 0x72, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x43, 0x0a, 0x10,
+	0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x0f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x5f, 0x62, 0x70,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x79, 0x42, 0x70, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x46, 0x65, 0x65, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x62, 0x63, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x62, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x62, 0x61, 0x6e,
+	0x6b, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77,
+	0x61, 0x73, 0x6d, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x10,
+	0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x0f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x42, 0x87, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x78, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75,
+	0x72, 0x6e, 0x74, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x78, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x78, 0x69, 0x6f, 0x6e, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x58, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x58, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x07, 0x58, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x58, 0x69, 0x6f,
+	0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x08, 0x58, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -662,18 +3407,38 @@ func file_xion_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_xion_v1_genesis_proto_rawDescData
 }
 
-var file_xion_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_xion_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_xion_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: xion.v1.GenesisState
-	(*v1beta1.Coin)(nil), // 1: cosmos.base.v1beta1.Coin
+	(*GenesisState)(nil),            // 0: xion.v1.GenesisState
+	(*PlatformFeeSplit)(nil),        // 1: xion.v1.PlatformFeeSplit
+	(*PlatformFeeEnforcement)(nil),  // 2: xion.v1.PlatformFeeEnforcement
+	(*v1beta1.Coin)(nil),            // 3: cosmos.base.v1beta1.Coin
+	(*PaymentSchedule)(nil),         // 4: xion.v1.PaymentSchedule
+	(*Escrow)(nil),                  // 5: xion.v1.Escrow
+	(*AttestationTrustAnchor)(nil),  // 6: xion.v1.AttestationTrustAnchor
+	(*WebAuthNCredentialState)(nil), // 7: xion.v1.WebAuthNCredentialState
+	(*RelyingPartyProfile)(nil),     // 8: xion.v1.RelyingPartyProfile
+	(*EmissionsSchedule)(nil),       // 9: xion.v1.EmissionsSchedule
+	(*MintTotals)(nil),              // 10: xion.v1.MintTotals
+	(*MintEpoch)(nil),               // 11: xion.v1.MintEpoch
 }
 var file_xion_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: xion.v1.GenesisState.platform_minimums:type_name -> cosmos.base.v1beta1.Coin
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3,  // 0: xion.v1.GenesisState.platform_minimums:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 1: xion.v1.GenesisState.platform_fee_split:type_name -> xion.v1.PlatformFeeSplit
+	2,  // 2: xion.v1.GenesisState.platform_fee_enforcement:type_name -> xion.v1.PlatformFeeEnforcement
+	4,  // 3: xion.v1.GenesisState.payment_schedules:type_name -> xion.v1.PaymentSchedule
+	5,  // 4: xion.v1.GenesisState.escrows:type_name -> xion.v1.Escrow
+	6,  // 5: xion.v1.GenesisState.attestation_trust_anchors:type_name -> xion.v1.AttestationTrustAnchor
+	7,  // 6: xion.v1.GenesisState.webauthn_credentials:type_name -> xion.v1.WebAuthNCredentialState
+	8,  // 7: xion.v1.GenesisState.relying_party_profiles:type_name -> xion.v1.RelyingPartyProfile
+	9,  // 8: xion.v1.GenesisState.emissions_schedule:type_name -> xion.v1.EmissionsSchedule
+	10, // 9: xion.v1.GenesisState.mint_totals:type_name -> xion.v1.MintTotals
+	11, // 10: xion.v1.GenesisState.mint_epochs:type_name -> xion.v1.MintEpoch
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_xion_v1_genesis_proto_init() }
@@ -681,6 +3446,10 @@ func file_xion_v1_genesis_proto_init() {
 	if File_xion_v1_genesis_proto != nil {
 		return
 	}
+	file_xion_v1_payment_schedule_proto_init()
+	file_xion_v1_escrow_proto_init()
+	file_xion_v1_webauthn_proto_init()
+	file_xion_v1_emissions_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_xion_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -694,6 +3463,30 @@ func file_xion_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_xion_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlatformFeeSplit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xion_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlatformFeeEnforcement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -701,7 +3494,7 @@ func file_xion_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_xion_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,10 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_WebAuthNVerifyRegister_FullMethodName     = "/xion.v1.Query/WebAuthNVerifyRegister"
-	Query_WebAuthNVerifyAuthenticate_FullMethodName = "/xion.v1.Query/WebAuthNVerifyAuthenticate"
-	Query_PlatformPercentage_FullMethodName         = "/xion.v1.Query/PlatformPercentage"
-	Query_PlatformMinimum_FullMethodName            = "/xion.v1.Query/PlatformMinimum"
+	Query_WebAuthNVerifyRegister_FullMethodName      = "/xion.v1.Query/WebAuthNVerifyRegister"
+	Query_WebAuthNVerifyAuthenticate_FullMethodName  = "/xion.v1.Query/WebAuthNVerifyAuthenticate"
+	Query_AttestationTrustAnchors_FullMethodName     = "/xion.v1.Query/AttestationTrustAnchors"
+	Query_WebAuthNCredential_FullMethodName          = "/xion.v1.Query/WebAuthNCredential"
+	Query_WebAuthNCredentialsByOwner_FullMethodName  = "/xion.v1.Query/WebAuthNCredentialsByOwner"
+	Query_RelyingPartyProfile_FullMethodName         = "/xion.v1.Query/RelyingPartyProfile"
+	Query_PlatformPercentage_FullMethodName          = "/xion.v1.Query/PlatformPercentage"
+	Query_PlatformMinimum_FullMethodName             = "/xion.v1.Query/PlatformMinimum"
+	Query_PlatformFeeSplit_FullMethodName            = "/xion.v1.Query/PlatformFeeSplit"
+	Query_PlatformFeeEnforcement_FullMethodName      = "/xion.v1.Query/PlatformFeeEnforcement"
+	Query_PaymentSchedule_FullMethodName             = "/xion.v1.Query/PaymentSchedule"
+	Query_PaymentSchedulesBySender_FullMethodName    = "/xion.v1.Query/PaymentSchedulesBySender"
+	Query_PaymentSchedulesByRecipient_FullMethodName = "/xion.v1.Query/PaymentSchedulesByRecipient"
+	Query_Escrow_FullMethodName                      = "/xion.v1.Query/Escrow"
+	Query_EscrowsBySender_FullMethodName             = "/xion.v1.Query/EscrowsBySender"
+	Query_EscrowsByRecipient_FullMethodName          = "/xion.v1.Query/EscrowsByRecipient"
+	Query_EmissionsSchedule_FullMethodName           = "/xion.v1.Query/EmissionsSchedule"
+	Query_ProjectedSupply_FullMethodName             = "/xion.v1.Query/ProjectedSupply"
+	Query_MintTotals_FullMethodName                  = "/xion.v1.Query/MintTotals"
+	Query_MintEpochs_FullMethodName                  = "/xion.v1.Query/MintEpochs"
+	Query_SimulateSend_FullMethodName                = "/xion.v1.Query/SimulateSend"
 )
 
 // QueryClient is the client API for Query service.
@@ -35,10 +52,54 @@ type QueryClient interface {
 	WebAuthNVerifyRegister(ctx context.Context, in *QueryWebAuthNVerifyRegisterRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyRegisterResponse, error)
 	// WebAuthNVerifyAuthenticate verifies a WebAuthN authentication
 	WebAuthNVerifyAuthenticate(ctx context.Context, in *QueryWebAuthNVerifyAuthenticateRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyAuthenticateResponse, error)
+	// AttestationTrustAnchors queries the trusted authenticator models used for
+	// attested WebAuthn registrations
+	AttestationTrustAnchors(ctx context.Context, in *QueryAttestationTrustAnchorsRequest, opts ...grpc.CallOption) (*QueryAttestationTrustAnchorsResponse, error)
+	// WebAuthNCredential queries the tracked state of a WebAuthn credential
+	WebAuthNCredential(ctx context.Context, in *QueryWebAuthNCredentialRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialResponse, error)
+	// WebAuthNCredentialsByOwner queries the tracked WebAuthn credentials of an
+	// account
+	WebAuthNCredentialsByOwner(ctx context.Context, in *QueryWebAuthNCredentialsByOwnerRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialsByOwnerResponse, error)
+	// RelyingPartyProfile queries the WebAuthn relying party profile of a
+	// contract
+	RelyingPartyProfile(ctx context.Context, in *QueryRelyingPartyProfileRequest, opts ...grpc.CallOption) (*QueryRelyingPartyProfileResponse, error)
 	// PlatformPercentage queries the platform percentage fee
 	PlatformPercentage(ctx context.Context, in *QueryPlatformPercentageRequest, opts ...grpc.CallOption) (*QueryPlatformPercentageResponse, error)
 	// PlatformMinimum queries the platform minimum fees
 	PlatformMinimum(ctx context.Context, in *QueryPlatformMinimumRequest, opts ...grpc.CallOption) (*QueryPlatformMinimumResponse, error)
+	// PlatformFeeSplit queries how collected platform fees are distributed
+	PlatformFeeSplit(ctx context.Context, in *QueryPlatformFeeSplitRequest, opts ...grpc.CallOption) (*QueryPlatformFeeSplitResponse, error)
+	// PlatformFeeEnforcement queries which value transfer paths are charged the
+	// platform fee
+	PlatformFeeEnforcement(ctx context.Context, in *QueryPlatformFeeEnforcementRequest, opts ...grpc.CallOption) (*QueryPlatformFeeEnforcementResponse, error)
+	// PaymentSchedule queries a payment schedule by id
+	PaymentSchedule(ctx context.Context, in *QueryPaymentScheduleRequest, opts ...grpc.CallOption) (*QueryPaymentScheduleResponse, error)
+	// PaymentSchedulesBySender queries the payment schedules paid by an account
+	PaymentSchedulesBySender(ctx context.Context, in *QueryPaymentSchedulesBySenderRequest, opts ...grpc.CallOption) (*QueryPaymentSchedulesBySenderResponse, error)
+	// PaymentSchedulesByRecipient queries the payment schedules paying an
+	// account
+	PaymentSchedulesByRecipient(ctx context.Context, in *QueryPaymentSchedulesByRecipientRequest, opts ...grpc.CallOption) (*QueryPaymentSchedulesByRecipientResponse, error)
+	// Escrow queries an escrow by id
+	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
+	// EscrowsBySender queries the escrows funded by an account
+	EscrowsBySender(ctx context.Context, in *QueryEscrowsBySenderRequest, opts ...grpc.CallOption) (*QueryEscrowsBySenderResponse, error)
+	// EscrowsByRecipient queries the escrows claimable by an account
+	EscrowsByRecipient(ctx context.Context, in *QueryEscrowsByRecipientRequest, opts ...grpc.CallOption) (*QueryEscrowsByRecipientResponse, error)
+	// EmissionsSchedule queries the emissions schedule driving x/mint
+	EmissionsSchedule(ctx context.Context, in *QueryEmissionsScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionsScheduleResponse, error)
+	// ProjectedSupply previews the mint denom supply at a future height under
+	// the emissions schedule, or the staking inflation curve while there is no
+	// schedule
+	ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error)
+	// MintTotals queries the total amounts minted and burned by the mint
+	// function
+	MintTotals(ctx context.Context, in *QueryMintTotalsRequest, opts ...grpc.CallOption) (*QueryMintTotalsResponse, error)
+	// MintEpochs queries the amounts minted and burned in recent epochs, most
+	// recent first
+	MintEpochs(ctx context.Context, in *QueryMintEpochsRequest, opts ...grpc.CallOption) (*QueryMintEpochsResponse, error)
+	// SimulateSend previews the platform fee, the net amounts received and the
+	// platform minimum check for a send or multi-send
+	SimulateSend(ctx context.Context, in *QuerySimulateSendRequest, opts ...grpc.CallOption) (*QuerySimulateSendResponse, error)
 }
 
 type queryClient struct {
@@ -69,6 +130,46 @@ func (c *queryClient) WebAuthNVerifyAuthenticate(ctx context.Context, in *QueryW
 	return out, nil
 }
 
+func (c *queryClient) AttestationTrustAnchors(ctx context.Context, in *QueryAttestationTrustAnchorsRequest, opts ...grpc.CallOption) (*QueryAttestationTrustAnchorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAttestationTrustAnchorsResponse)
+	err := c.cc.Invoke(ctx, Query_AttestationTrustAnchors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WebAuthNCredential(ctx context.Context, in *QueryWebAuthNCredentialRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryWebAuthNCredentialResponse)
+	err := c.cc.Invoke(ctx, Query_WebAuthNCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WebAuthNCredentialsByOwner(ctx context.Context, in *QueryWebAuthNCredentialsByOwnerRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialsByOwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryWebAuthNCredentialsByOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_WebAuthNCredentialsByOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelyingPartyProfile(ctx context.Context, in *QueryRelyingPartyProfileRequest, opts ...grpc.CallOption) (*QueryRelyingPartyProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRelyingPartyProfileResponse)
+	err := c.cc.Invoke(ctx, Query_RelyingPartyProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) PlatformPercentage(ctx context.Context, in *QueryPlatformPercentageRequest, opts ...grpc.CallOption) (*QueryPlatformPercentageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryPlatformPercentageResponse)
@@ -89,6 +190,136 @@ func (c *queryClient) PlatformMinimum(ctx context.Context, in *QueryPlatformMini
 	return out, nil
 }
 
+func (c *queryClient) PlatformFeeSplit(ctx context.Context, in *QueryPlatformFeeSplitRequest, opts ...grpc.CallOption) (*QueryPlatformFeeSplitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPlatformFeeSplitResponse)
+	err := c.cc.Invoke(ctx, Query_PlatformFeeSplit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlatformFeeEnforcement(ctx context.Context, in *QueryPlatformFeeEnforcementRequest, opts ...grpc.CallOption) (*QueryPlatformFeeEnforcementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPlatformFeeEnforcementResponse)
+	err := c.cc.Invoke(ctx, Query_PlatformFeeEnforcement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentSchedule(ctx context.Context, in *QueryPaymentScheduleRequest, opts ...grpc.CallOption) (*QueryPaymentScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_PaymentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentSchedulesBySender(ctx context.Context, in *QueryPaymentSchedulesBySenderRequest, opts ...grpc.CallOption) (*QueryPaymentSchedulesBySenderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPaymentSchedulesBySenderResponse)
+	err := c.cc.Invoke(ctx, Query_PaymentSchedulesBySender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentSchedulesByRecipient(ctx context.Context, in *QueryPaymentSchedulesByRecipientRequest, opts ...grpc.CallOption) (*QueryPaymentSchedulesByRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPaymentSchedulesByRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_PaymentSchedulesByRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEscrowResponse)
+	err := c.cc.Invoke(ctx, Query_Escrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowsBySender(ctx context.Context, in *QueryEscrowsBySenderRequest, opts ...grpc.CallOption) (*QueryEscrowsBySenderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEscrowsBySenderResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowsBySender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowsByRecipient(ctx context.Context, in *QueryEscrowsByRecipientRequest, opts ...grpc.CallOption) (*QueryEscrowsByRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEscrowsByRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowsByRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionsSchedule(ctx context.Context, in *QueryEmissionsScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionsScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEmissionsScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_EmissionsSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProjectedSupplyResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectedSupply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintTotals(ctx context.Context, in *QueryMintTotalsRequest, opts ...grpc.CallOption) (*QueryMintTotalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMintTotalsResponse)
+	err := c.cc.Invoke(ctx, Query_MintTotals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintEpochs(ctx context.Context, in *QueryMintEpochsRequest, opts ...grpc.CallOption) (*QueryMintEpochsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMintEpochsResponse)
+	err := c.cc.Invoke(ctx, Query_MintEpochs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateSend(ctx context.Context, in *QuerySimulateSendRequest, opts ...grpc.CallOption) (*QuerySimulateSendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySimulateSendResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -99,10 +330,54 @@ type QueryServer interface {
 	WebAuthNVerifyRegister(context.Context, *QueryWebAuthNVerifyRegisterRequest) (*QueryWebAuthNVerifyRegisterResponse, error)
 	// WebAuthNVerifyAuthenticate verifies a WebAuthN authentication
 	WebAuthNVerifyAuthenticate(context.Context, *QueryWebAuthNVerifyAuthenticateRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error)
+	// AttestationTrustAnchors queries the trusted authenticator models used for
+	// attested WebAuthn registrations
+	AttestationTrustAnchors(context.Context, *QueryAttestationTrustAnchorsRequest) (*QueryAttestationTrustAnchorsResponse, error)
+	// WebAuthNCredential queries the tracked state of a WebAuthn credential
+	WebAuthNCredential(context.Context, *QueryWebAuthNCredentialRequest) (*QueryWebAuthNCredentialResponse, error)
+	// WebAuthNCredentialsByOwner queries the tracked WebAuthn credentials of an
+	// account
+	WebAuthNCredentialsByOwner(context.Context, *QueryWebAuthNCredentialsByOwnerRequest) (*QueryWebAuthNCredentialsByOwnerResponse, error)
+	// RelyingPartyProfile queries the WebAuthn relying party profile of a
+	// contract
+	RelyingPartyProfile(context.Context, *QueryRelyingPartyProfileRequest) (*QueryRelyingPartyProfileResponse, error)
 	// PlatformPercentage queries the platform percentage fee
 	PlatformPercentage(context.Context, *QueryPlatformPercentageRequest) (*QueryPlatformPercentageResponse, error)
 	// PlatformMinimum queries the platform minimum fees
 	PlatformMinimum(context.Context, *QueryPlatformMinimumRequest) (*QueryPlatformMinimumResponse, error)
+	// PlatformFeeSplit queries how collected platform fees are distributed
+	PlatformFeeSplit(context.Context, *QueryPlatformFeeSplitRequest) (*QueryPlatformFeeSplitResponse, error)
+	// PlatformFeeEnforcement queries which value transfer paths are charged the
+	// platform fee
+	PlatformFeeEnforcement(context.Context, *QueryPlatformFeeEnforcementRequest) (*QueryPlatformFeeEnforcementResponse, error)
+	// PaymentSchedule queries a payment schedule by id
+	PaymentSchedule(context.Context, *QueryPaymentScheduleRequest) (*QueryPaymentScheduleResponse, error)
+	// PaymentSchedulesBySender queries the payment schedules paid by an account
+	PaymentSchedulesBySender(context.Context, *QueryPaymentSchedulesBySenderRequest) (*QueryPaymentSchedulesBySenderResponse, error)
+	// PaymentSchedulesByRecipient queries the payment schedules paying an
+	// account
+	PaymentSchedulesByRecipient(context.Context, *QueryPaymentSchedulesByRecipientRequest) (*QueryPaymentSchedulesByRecipientResponse, error)
+	// Escrow queries an escrow by id
+	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
+	// EscrowsBySender queries the escrows funded by an account
+	EscrowsBySender(context.Context, *QueryEscrowsBySenderRequest) (*QueryEscrowsBySenderResponse, error)
+	// EscrowsByRecipient queries the escrows claimable by an account
+	EscrowsByRecipient(context.Context, *QueryEscrowsByRecipientRequest) (*QueryEscrowsByRecipientResponse, error)
+	// EmissionsSchedule queries the emissions schedule driving x/mint
+	EmissionsSchedule(context.Context, *QueryEmissionsScheduleRequest) (*QueryEmissionsScheduleResponse, error)
+	// ProjectedSupply previews the mint denom supply at a future height under
+	// the emissions schedule, or the staking inflation curve while there is no
+	// schedule
+	ProjectedSupply(context.Context, *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error)
+	// MintTotals queries the total amounts minted and burned by the mint
+	// function
+	MintTotals(context.Context, *QueryMintTotalsRequest) (*QueryMintTotalsResponse, error)
+	// MintEpochs queries the amounts minted and burned in recent epochs, most
+	// recent first
+	MintEpochs(context.Context, *QueryMintEpochsRequest) (*QueryMintEpochsResponse, error)
+	// SimulateSend previews the platform fee, the net amounts received and the
+	// platform minimum check for a send or multi-send
+	SimulateSend(context.Context, *QuerySimulateSendRequest) (*QuerySimulateSendResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -119,12 +394,63 @@ func (UnimplementedQueryServer) WebAuthNVerifyRegister(context.Context, *QueryWe
 func (UnimplementedQueryServer) WebAuthNVerifyAuthenticate(context.Context, *QueryWebAuthNVerifyAuthenticateRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNVerifyAuthenticate not implemented")
 }
+func (UnimplementedQueryServer) AttestationTrustAnchors(context.Context, *QueryAttestationTrustAnchorsRequest) (*QueryAttestationTrustAnchorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationTrustAnchors not implemented")
+}
+func (UnimplementedQueryServer) WebAuthNCredential(context.Context, *QueryWebAuthNCredentialRequest) (*QueryWebAuthNCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNCredential not implemented")
+}
+func (UnimplementedQueryServer) WebAuthNCredentialsByOwner(context.Context, *QueryWebAuthNCredentialsByOwnerRequest) (*QueryWebAuthNCredentialsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNCredentialsByOwner not implemented")
+}
+func (UnimplementedQueryServer) RelyingPartyProfile(context.Context, *QueryRelyingPartyProfileRequest) (*QueryRelyingPartyProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelyingPartyProfile not implemented")
+}
 func (UnimplementedQueryServer) PlatformPercentage(context.Context, *QueryPlatformPercentageRequest) (*QueryPlatformPercentageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlatformPercentage not implemented")
 }
 func (UnimplementedQueryServer) PlatformMinimum(context.Context, *QueryPlatformMinimumRequest) (*QueryPlatformMinimumResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlatformMinimum not implemented")
 }
+func (UnimplementedQueryServer) PlatformFeeSplit(context.Context, *QueryPlatformFeeSplitRequest) (*QueryPlatformFeeSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformFeeSplit not implemented")
+}
+func (UnimplementedQueryServer) PlatformFeeEnforcement(context.Context, *QueryPlatformFeeEnforcementRequest) (*QueryPlatformFeeEnforcementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformFeeEnforcement not implemented")
+}
+func (UnimplementedQueryServer) PaymentSchedule(context.Context, *QueryPaymentScheduleRequest) (*QueryPaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentSchedule not implemented")
+}
+func (UnimplementedQueryServer) PaymentSchedulesBySender(context.Context, *QueryPaymentSchedulesBySenderRequest) (*QueryPaymentSchedulesBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentSchedulesBySender not implemented")
+}
+func (UnimplementedQueryServer) PaymentSchedulesByRecipient(context.Context, *QueryPaymentSchedulesByRecipientRequest) (*QueryPaymentSchedulesByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentSchedulesByRecipient not implemented")
+}
+func (UnimplementedQueryServer) Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+}
+func (UnimplementedQueryServer) EscrowsBySender(context.Context, *QueryEscrowsBySenderRequest) (*QueryEscrowsBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowsBySender not implemented")
+}
+func (UnimplementedQueryServer) EscrowsByRecipient(context.Context, *QueryEscrowsByRecipientRequest) (*QueryEscrowsByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowsByRecipient not implemented")
+}
+func (UnimplementedQueryServer) EmissionsSchedule(context.Context, *QueryEmissionsScheduleRequest) (*QueryEmissionsScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionsSchedule not implemented")
+}
+func (UnimplementedQueryServer) ProjectedSupply(context.Context, *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedSupply not implemented")
+}
+func (UnimplementedQueryServer) MintTotals(context.Context, *QueryMintTotalsRequest) (*QueryMintTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintTotals not implemented")
+}
+func (UnimplementedQueryServer) MintEpochs(context.Context, *QueryMintEpochsRequest) (*QueryMintEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintEpochs not implemented")
+}
+func (UnimplementedQueryServer) SimulateSend(context.Context, *QuerySimulateSendRequest) (*QuerySimulateSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSend not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +508,78 @@ func _Query_WebAuthNVerifyAuthenticate_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttestationTrustAnchors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationTrustAnchorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationTrustAnchors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AttestationTrustAnchors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationTrustAnchors(ctx, req.(*QueryAttestationTrustAnchorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WebAuthNCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WebAuthNCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNCredential(ctx, req.(*QueryWebAuthNCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WebAuthNCredentialsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNCredentialsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNCredentialsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WebAuthNCredentialsByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNCredentialsByOwner(ctx, req.(*QueryWebAuthNCredentialsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelyingPartyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelyingPartyProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelyingPartyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RelyingPartyProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelyingPartyProfile(ctx, req.(*QueryRelyingPartyProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_PlatformPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPlatformPercentageRequest)
 	if err := dec(in); err != nil {
@@ -218,6 +616,240 @@ func _Query_PlatformMinimum_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PlatformFeeSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlatformFeeSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlatformFeeSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PlatformFeeSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlatformFeeSplit(ctx, req.(*QueryPlatformFeeSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlatformFeeEnforcement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlatformFeeEnforcementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlatformFeeEnforcement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PlatformFeeEnforcement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlatformFeeEnforcement(ctx, req.(*QueryPlatformFeeEnforcementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentSchedule(ctx, req.(*QueryPaymentScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentSchedulesBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentSchedulesBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentSchedulesBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PaymentSchedulesBySender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentSchedulesBySender(ctx, req.(*QueryPaymentSchedulesBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentSchedulesByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentSchedulesByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentSchedulesByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PaymentSchedulesByRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentSchedulesByRecipient(ctx, req.(*QueryPaymentSchedulesByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Escrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowsBySender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowsBySender(ctx, req.(*QueryEscrowsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowsByRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowsByRecipient(ctx, req.(*QueryEscrowsByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionsSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionsScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionsSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EmissionsSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionsSchedule(ctx, req.(*QueryEmissionsScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectedSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedSupply(ctx, req.(*QueryProjectedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintTotals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintTotals(ctx, req.(*QueryMintTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintEpochs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintEpochs(ctx, req.(*QueryMintEpochsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSend(ctx, req.(*QuerySimulateSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +865,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WebAuthNVerifyAuthenticate",
 			Handler:    _Query_WebAuthNVerifyAuthenticate_Handler,
 		},
+		{
+			MethodName: "AttestationTrustAnchors",
+			Handler:    _Query_AttestationTrustAnchors_Handler,
+		},
+		{
+			MethodName: "WebAuthNCredential",
+			Handler:    _Query_WebAuthNCredential_Handler,
+		},
+		{
+			MethodName: "WebAuthNCredentialsByOwner",
+			Handler:    _Query_WebAuthNCredentialsByOwner_Handler,
+		},
+		{
+			MethodName: "RelyingPartyProfile",
+			Handler:    _Query_RelyingPartyProfile_Handler,
+		},
 		{
 			MethodName: "PlatformPercentage",
 			Handler:    _Query_PlatformPercentage_Handler,
@@ -241,6 +889,58 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlatformMinimum",
 			Handler:    _Query_PlatformMinimum_Handler,
 		},
+		{
+			MethodName: "PlatformFeeSplit",
+			Handler:    _Query_PlatformFeeSplit_Handler,
+		},
+		{
+			MethodName: "PlatformFeeEnforcement",
+			Handler:    _Query_PlatformFeeEnforcement_Handler,
+		},
+		{
+			MethodName: "PaymentSchedule",
+			Handler:    _Query_PaymentSchedule_Handler,
+		},
+		{
+			MethodName: "PaymentSchedulesBySender",
+			Handler:    _Query_PaymentSchedulesBySender_Handler,
+		},
+		{
+			MethodName: "PaymentSchedulesByRecipient",
+			Handler:    _Query_PaymentSchedulesByRecipient_Handler,
+		},
+		{
+			MethodName: "Escrow",
+			Handler:    _Query_Escrow_Handler,
+		},
+		{
+			MethodName: "EscrowsBySender",
+			Handler:    _Query_EscrowsBySender_Handler,
+		},
+		{
+			MethodName: "EscrowsByRecipient",
+			Handler:    _Query_EscrowsByRecipient_Handler,
+		},
+		{
+			MethodName: "EmissionsSchedule",
+			Handler:    _Query_EmissionsSchedule_Handler,
+		},
+		{
+			MethodName: "ProjectedSupply",
+			Handler:    _Query_ProjectedSupply_Handler,
+		},
+		{
+			MethodName: "MintTotals",
+			Handler:    _Query_MintTotals_Handler,
+		},
+		{
+			MethodName: "MintEpochs",
+			Handler:    _Query_MintEpochs_Handler,
+		},
+		{
+			MethodName: "SimulateSend",
+			Handler:    _Query_SimulateSend_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xion/v1/query.proto",
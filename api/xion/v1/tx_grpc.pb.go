@@ -19,10 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Send_FullMethodName                  = "/xion.v1.Msg/Send"
-	Msg_MultiSend_FullMethodName             = "/xion.v1.Msg/MultiSend"
-	Msg_SetPlatformPercentage_FullMethodName = "/xion.v1.Msg/SetPlatformPercentage"
-	Msg_SetPlatformMinimum_FullMethodName    = "/xion.v1.Msg/SetPlatformMinimum"
+	Msg_Send_FullMethodName                       = "/xion.v1.Msg/Send"
+	Msg_MultiSend_FullMethodName                  = "/xion.v1.Msg/MultiSend"
+	Msg_SetPlatformPercentage_FullMethodName      = "/xion.v1.Msg/SetPlatformPercentage"
+	Msg_SetPlatformMinimum_FullMethodName         = "/xion.v1.Msg/SetPlatformMinimum"
+	Msg_SetPlatformFeeSplit_FullMethodName        = "/xion.v1.Msg/SetPlatformFeeSplit"
+	Msg_SetPlatformFeeEnforcement_FullMethodName  = "/xion.v1.Msg/SetPlatformFeeEnforcement"
+	Msg_CreatePaymentSchedule_FullMethodName      = "/xion.v1.Msg/CreatePaymentSchedule"
+	Msg_CancelPaymentSchedule_FullMethodName      = "/xion.v1.Msg/CancelPaymentSchedule"
+	Msg_PausePaymentSchedule_FullMethodName       = "/xion.v1.Msg/PausePaymentSchedule"
+	Msg_ResumePaymentSchedule_FullMethodName      = "/xion.v1.Msg/ResumePaymentSchedule"
+	Msg_SendEscrow_FullMethodName                 = "/xion.v1.Msg/SendEscrow"
+	Msg_ClaimEscrow_FullMethodName                = "/xion.v1.Msg/ClaimEscrow"
+	Msg_RefundEscrow_FullMethodName               = "/xion.v1.Msg/RefundEscrow"
+	Msg_SetAttestationTrustAnchors_FullMethodName = "/xion.v1.Msg/SetAttestationTrustAnchors"
+	Msg_VerifyWebAuthNAssertion_FullMethodName    = "/xion.v1.Msg/VerifyWebAuthNAssertion"
+	Msg_SetRelyingPartyProfile_FullMethodName     = "/xion.v1.Msg/SetRelyingPartyProfile"
+	Msg_RemoveRelyingPartyProfile_FullMethodName  = "/xion.v1.Msg/RemoveRelyingPartyProfile"
+	Msg_SetEmissionsSchedule_FullMethodName       = "/xion.v1.Msg/SetEmissionsSchedule"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,6 +57,41 @@ type MsgClient interface {
 	// SetPlatformMinimum defines the method for updating the platform
 	// percentage fee
 	SetPlatformMinimum(ctx context.Context, in *MsgSetPlatformMinimum, opts ...grpc.CallOption) (*MsgSetPlatformMinimumResponse, error)
+	// SetPlatformFeeSplit defines the method for updating how collected
+	// platform fees are distributed
+	SetPlatformFeeSplit(ctx context.Context, in *MsgSetPlatformFeeSplit, opts ...grpc.CallOption) (*MsgSetPlatformFeeSplitResponse, error)
+	// SetPlatformFeeEnforcement defines the method for updating which value
+	// transfer paths are charged the platform fee
+	SetPlatformFeeEnforcement(ctx context.Context, in *MsgSetPlatformFeeEnforcement, opts ...grpc.CallOption) (*MsgSetPlatformFeeEnforcementResponse, error)
+	// CreatePaymentSchedule registers a recurring payment from the sender
+	CreatePaymentSchedule(ctx context.Context, in *MsgCreatePaymentSchedule, opts ...grpc.CallOption) (*MsgCreatePaymentScheduleResponse, error)
+	// CancelPaymentSchedule permanently stops a payment schedule
+	CancelPaymentSchedule(ctx context.Context, in *MsgCancelPaymentSchedule, opts ...grpc.CallOption) (*MsgCancelPaymentScheduleResponse, error)
+	// PausePaymentSchedule stops payments until the schedule is resumed
+	PausePaymentSchedule(ctx context.Context, in *MsgPausePaymentSchedule, opts ...grpc.CallOption) (*MsgPausePaymentScheduleResponse, error)
+	// ResumePaymentSchedule restarts payments of a paused schedule
+	ResumePaymentSchedule(ctx context.Context, in *MsgResumePaymentSchedule, opts ...grpc.CallOption) (*MsgResumePaymentScheduleResponse, error)
+	// SendEscrow locks funds until they are claimed or the escrow expires.
+	SendEscrow(ctx context.Context, in *MsgSendEscrow, opts ...grpc.CallOption) (*MsgSendEscrowResponse, error)
+	// ClaimEscrow pays an escrow, less the platform fee, to the claimer.
+	ClaimEscrow(ctx context.Context, in *MsgClaimEscrow, opts ...grpc.CallOption) (*MsgClaimEscrowResponse, error)
+	// RefundEscrow returns an escrow to its sender.
+	RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error)
+	// SetAttestationTrustAnchors replaces the trusted authenticator models used
+	// for attested WebAuthn registrations
+	SetAttestationTrustAnchors(ctx context.Context, in *MsgSetAttestationTrustAnchors, opts ...grpc.CallOption) (*MsgSetAttestationTrustAnchorsResponse, error)
+	// VerifyWebAuthNAssertion verifies a WebAuthn assertion and records the
+	// credential's signature counter and backup flags, rejecting assertions
+	// from cloned authenticators
+	VerifyWebAuthNAssertion(ctx context.Context, in *MsgVerifyWebAuthNAssertion, opts ...grpc.CallOption) (*MsgVerifyWebAuthNAssertionResponse, error)
+	// SetRelyingPartyProfile registers or replaces the WebAuthn relying party
+	// profile of a contract
+	SetRelyingPartyProfile(ctx context.Context, in *MsgSetRelyingPartyProfile, opts ...grpc.CallOption) (*MsgSetRelyingPartyProfileResponse, error)
+	// RemoveRelyingPartyProfile removes the WebAuthn relying party profile of a
+	// contract
+	RemoveRelyingPartyProfile(ctx context.Context, in *MsgRemoveRelyingPartyProfile, opts ...grpc.CallOption) (*MsgRemoveRelyingPartyProfileResponse, error)
+	// SetEmissionsSchedule replaces the emissions schedule driving x/mint
+	SetEmissionsSchedule(ctx context.Context, in *MsgSetEmissionsSchedule, opts ...grpc.CallOption) (*MsgSetEmissionsScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -93,6 +142,146 @@ func (c *msgClient) SetPlatformMinimum(ctx context.Context, in *MsgSetPlatformMi
 	return out, nil
 }
 
+func (c *msgClient) SetPlatformFeeSplit(ctx context.Context, in *MsgSetPlatformFeeSplit, opts ...grpc.CallOption) (*MsgSetPlatformFeeSplitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetPlatformFeeSplitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPlatformFeeSplit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPlatformFeeEnforcement(ctx context.Context, in *MsgSetPlatformFeeEnforcement, opts ...grpc.CallOption) (*MsgSetPlatformFeeEnforcementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetPlatformFeeEnforcementResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPlatformFeeEnforcement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePaymentSchedule(ctx context.Context, in *MsgCreatePaymentSchedule, opts ...grpc.CallOption) (*MsgCreatePaymentScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreatePaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_CreatePaymentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPaymentSchedule(ctx context.Context, in *MsgCancelPaymentSchedule, opts ...grpc.CallOption) (*MsgCancelPaymentScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelPaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelPaymentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PausePaymentSchedule(ctx context.Context, in *MsgPausePaymentSchedule, opts ...grpc.CallOption) (*MsgPausePaymentScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgPausePaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_PausePaymentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumePaymentSchedule(ctx context.Context, in *MsgResumePaymentSchedule, opts ...grpc.CallOption) (*MsgResumePaymentScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgResumePaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_ResumePaymentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendEscrow(ctx context.Context, in *MsgSendEscrow, opts ...grpc.CallOption) (*MsgSendEscrowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSendEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_SendEscrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimEscrow(ctx context.Context, in *MsgClaimEscrow, opts ...grpc.CallOption) (*MsgClaimEscrowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimEscrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRefundEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_RefundEscrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAttestationTrustAnchors(ctx context.Context, in *MsgSetAttestationTrustAnchors, opts ...grpc.CallOption) (*MsgSetAttestationTrustAnchorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetAttestationTrustAnchorsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAttestationTrustAnchors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VerifyWebAuthNAssertion(ctx context.Context, in *MsgVerifyWebAuthNAssertion, opts ...grpc.CallOption) (*MsgVerifyWebAuthNAssertionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgVerifyWebAuthNAssertionResponse)
+	err := c.cc.Invoke(ctx, Msg_VerifyWebAuthNAssertion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRelyingPartyProfile(ctx context.Context, in *MsgSetRelyingPartyProfile, opts ...grpc.CallOption) (*MsgSetRelyingPartyProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetRelyingPartyProfileResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRelyingPartyProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRelyingPartyProfile(ctx context.Context, in *MsgRemoveRelyingPartyProfile, opts ...grpc.CallOption) (*MsgRemoveRelyingPartyProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveRelyingPartyProfileResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveRelyingPartyProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetEmissionsSchedule(ctx context.Context, in *MsgSetEmissionsSchedule, opts ...grpc.CallOption) (*MsgSetEmissionsScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetEmissionsScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_SetEmissionsSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -111,6 +300,41 @@ type MsgServer interface {
 	// SetPlatformMinimum defines the method for updating the platform
 	// percentage fee
 	SetPlatformMinimum(context.Context, *MsgSetPlatformMinimum) (*MsgSetPlatformMinimumResponse, error)
+	// SetPlatformFeeSplit defines the method for updating how collected
+	// platform fees are distributed
+	SetPlatformFeeSplit(context.Context, *MsgSetPlatformFeeSplit) (*MsgSetPlatformFeeSplitResponse, error)
+	// SetPlatformFeeEnforcement defines the method for updating which value
+	// transfer paths are charged the platform fee
+	SetPlatformFeeEnforcement(context.Context, *MsgSetPlatformFeeEnforcement) (*MsgSetPlatformFeeEnforcementResponse, error)
+	// CreatePaymentSchedule registers a recurring payment from the sender
+	CreatePaymentSchedule(context.Context, *MsgCreatePaymentSchedule) (*MsgCreatePaymentScheduleResponse, error)
+	// CancelPaymentSchedule permanently stops a payment schedule
+	CancelPaymentSchedule(context.Context, *MsgCancelPaymentSchedule) (*MsgCancelPaymentScheduleResponse, error)
+	// PausePaymentSchedule stops payments until the schedule is resumed
+	PausePaymentSchedule(context.Context, *MsgPausePaymentSchedule) (*MsgPausePaymentScheduleResponse, error)
+	// ResumePaymentSchedule restarts payments of a paused schedule
+	ResumePaymentSchedule(context.Context, *MsgResumePaymentSchedule) (*MsgResumePaymentScheduleResponse, error)
+	// SendEscrow locks funds until they are claimed or the escrow expires.
+	SendEscrow(context.Context, *MsgSendEscrow) (*MsgSendEscrowResponse, error)
+	// ClaimEscrow pays an escrow, less the platform fee, to the claimer.
+	ClaimEscrow(context.Context, *MsgClaimEscrow) (*MsgClaimEscrowResponse, error)
+	// RefundEscrow returns an escrow to its sender.
+	RefundEscrow(context.Context, *MsgRefundEscrow) (*MsgRefundEscrowResponse, error)
+	// SetAttestationTrustAnchors replaces the trusted authenticator models used
+	// for attested WebAuthn registrations
+	SetAttestationTrustAnchors(context.Context, *MsgSetAttestationTrustAnchors) (*MsgSetAttestationTrustAnchorsResponse, error)
+	// VerifyWebAuthNAssertion verifies a WebAuthn assertion and records the
+	// credential's signature counter and backup flags, rejecting assertions
+	// from cloned authenticators
+	VerifyWebAuthNAssertion(context.Context, *MsgVerifyWebAuthNAssertion) (*MsgVerifyWebAuthNAssertionResponse, error)
+	// SetRelyingPartyProfile registers or replaces the WebAuthn relying party
+	// profile of a contract
+	SetRelyingPartyProfile(context.Context, *MsgSetRelyingPartyProfile) (*MsgSetRelyingPartyProfileResponse, error)
+	// RemoveRelyingPartyProfile removes the WebAuthn relying party profile of a
+	// contract
+	RemoveRelyingPartyProfile(context.Context, *MsgRemoveRelyingPartyProfile) (*MsgRemoveRelyingPartyProfileResponse, error)
+	// SetEmissionsSchedule replaces the emissions schedule driving x/mint
+	SetEmissionsSchedule(context.Context, *MsgSetEmissionsSchedule) (*MsgSetEmissionsScheduleResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -133,6 +357,48 @@ func (UnimplementedMsgServer) SetPlatformPercentage(context.Context, *MsgSetPlat
 func (UnimplementedMsgServer) SetPlatformMinimum(context.Context, *MsgSetPlatformMinimum) (*MsgSetPlatformMinimumResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformMinimum not implemented")
 }
+func (UnimplementedMsgServer) SetPlatformFeeSplit(context.Context, *MsgSetPlatformFeeSplit) (*MsgSetPlatformFeeSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformFeeSplit not implemented")
+}
+func (UnimplementedMsgServer) SetPlatformFeeEnforcement(context.Context, *MsgSetPlatformFeeEnforcement) (*MsgSetPlatformFeeEnforcementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformFeeEnforcement not implemented")
+}
+func (UnimplementedMsgServer) CreatePaymentSchedule(context.Context, *MsgCreatePaymentSchedule) (*MsgCreatePaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentSchedule not implemented")
+}
+func (UnimplementedMsgServer) CancelPaymentSchedule(context.Context, *MsgCancelPaymentSchedule) (*MsgCancelPaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPaymentSchedule not implemented")
+}
+func (UnimplementedMsgServer) PausePaymentSchedule(context.Context, *MsgPausePaymentSchedule) (*MsgPausePaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausePaymentSchedule not implemented")
+}
+func (UnimplementedMsgServer) ResumePaymentSchedule(context.Context, *MsgResumePaymentSchedule) (*MsgResumePaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePaymentSchedule not implemented")
+}
+func (UnimplementedMsgServer) SendEscrow(context.Context, *MsgSendEscrow) (*MsgSendEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEscrow not implemented")
+}
+func (UnimplementedMsgServer) ClaimEscrow(context.Context, *MsgClaimEscrow) (*MsgClaimEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimEscrow not implemented")
+}
+func (UnimplementedMsgServer) RefundEscrow(context.Context, *MsgRefundEscrow) (*MsgRefundEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundEscrow not implemented")
+}
+func (UnimplementedMsgServer) SetAttestationTrustAnchors(context.Context, *MsgSetAttestationTrustAnchors) (*MsgSetAttestationTrustAnchorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttestationTrustAnchors not implemented")
+}
+func (UnimplementedMsgServer) VerifyWebAuthNAssertion(context.Context, *MsgVerifyWebAuthNAssertion) (*MsgVerifyWebAuthNAssertionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyWebAuthNAssertion not implemented")
+}
+func (UnimplementedMsgServer) SetRelyingPartyProfile(context.Context, *MsgSetRelyingPartyProfile) (*MsgSetRelyingPartyProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRelyingPartyProfile not implemented")
+}
+func (UnimplementedMsgServer) RemoveRelyingPartyProfile(context.Context, *MsgRemoveRelyingPartyProfile) (*MsgRemoveRelyingPartyProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRelyingPartyProfile not implemented")
+}
+func (UnimplementedMsgServer) SetEmissionsSchedule(context.Context, *MsgSetEmissionsSchedule) (*MsgSetEmissionsScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmissionsSchedule not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -226,6 +492,258 @@ func _Msg_SetPlatformMinimum_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetPlatformFeeSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPlatformFeeSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPlatformFeeSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPlatformFeeSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPlatformFeeSplit(ctx, req.(*MsgSetPlatformFeeSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPlatformFeeEnforcement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPlatformFeeEnforcement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPlatformFeeEnforcement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPlatformFeeEnforcement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPlatformFeeEnforcement(ctx, req.(*MsgSetPlatformFeeEnforcement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePaymentSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreatePaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePaymentSchedule(ctx, req.(*MsgCreatePaymentSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPaymentSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelPaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPaymentSchedule(ctx, req.(*MsgCancelPaymentSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PausePaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPausePaymentSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PausePaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PausePaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PausePaymentSchedule(ctx, req.(*MsgPausePaymentSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumePaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumePaymentSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumePaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResumePaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumePaymentSchedule(ctx, req.(*MsgResumePaymentSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendEscrow(ctx, req.(*MsgSendEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimEscrow(ctx, req.(*MsgClaimEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RefundEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundEscrow(ctx, req.(*MsgRefundEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAttestationTrustAnchors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttestationTrustAnchors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttestationTrustAnchors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAttestationTrustAnchors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttestationTrustAnchors(ctx, req.(*MsgSetAttestationTrustAnchors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VerifyWebAuthNAssertion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyWebAuthNAssertion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyWebAuthNAssertion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VerifyWebAuthNAssertion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyWebAuthNAssertion(ctx, req.(*MsgVerifyWebAuthNAssertion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRelyingPartyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRelyingPartyProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRelyingPartyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRelyingPartyProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRelyingPartyProfile(ctx, req.(*MsgSetRelyingPartyProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRelyingPartyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRelyingPartyProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRelyingPartyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveRelyingPartyProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRelyingPartyProfile(ctx, req.(*MsgRemoveRelyingPartyProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEmissionsSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEmissionsSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEmissionsSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetEmissionsSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEmissionsSchedule(ctx, req.(*MsgSetEmissionsSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -249,6 +767,62 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPlatformMinimum",
 			Handler:    _Msg_SetPlatformMinimum_Handler,
 		},
+		{
+			MethodName: "SetPlatformFeeSplit",
+			Handler:    _Msg_SetPlatformFeeSplit_Handler,
+		},
+		{
+			MethodName: "SetPlatformFeeEnforcement",
+			Handler:    _Msg_SetPlatformFeeEnforcement_Handler,
+		},
+		{
+			MethodName: "CreatePaymentSchedule",
+			Handler:    _Msg_CreatePaymentSchedule_Handler,
+		},
+		{
+			MethodName: "CancelPaymentSchedule",
+			Handler:    _Msg_CancelPaymentSchedule_Handler,
+		},
+		{
+			MethodName: "PausePaymentSchedule",
+			Handler:    _Msg_PausePaymentSchedule_Handler,
+		},
+		{
+			MethodName: "ResumePaymentSchedule",
+			Handler:    _Msg_ResumePaymentSchedule_Handler,
+		},
+		{
+			MethodName: "SendEscrow",
+			Handler:    _Msg_SendEscrow_Handler,
+		},
+		{
+			MethodName: "ClaimEscrow",
+			Handler:    _Msg_ClaimEscrow_Handler,
+		},
+		{
+			MethodName: "RefundEscrow",
+			Handler:    _Msg_RefundEscrow_Handler,
+		},
+		{
+			MethodName: "SetAttestationTrustAnchors",
+			Handler:    _Msg_SetAttestationTrustAnchors_Handler,
+		},
+		{
+			MethodName: "VerifyWebAuthNAssertion",
+			Handler:    _Msg_VerifyWebAuthNAssertion_Handler,
+		},
+		{
+			MethodName: "SetRelyingPartyProfile",
+			Handler:    _Msg_SetRelyingPartyProfile_Handler,
+		},
+		{
+			MethodName: "RemoveRelyingPartyProfile",
+			Handler:    _Msg_RemoveRelyingPartyProfile_Handler,
+		},
+		{
+			MethodName: "SetEmissionsSchedule",
+			Handler:    _Msg_SetEmissionsSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xion/v1/tx.proto",
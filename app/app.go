@@ -28,6 +28,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	dbm "github.com/cosmos/cosmos-db"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 	packetforward "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward"
 	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/keeper"
@@ -155,11 +156,13 @@ import (
 	dkimkeeper "github.com/burnt-labs/xion/x/dkim/keeper"
 	dkimtypes "github.com/burnt-labs/xion/x/dkim/types"
 	"github.com/burnt-labs/xion/x/globalfee"
+	globalfeekeeper "github.com/burnt-labs/xion/x/globalfee/keeper"
 	"github.com/burnt-labs/xion/x/jwk"
 	jwkkeeper "github.com/burnt-labs/xion/x/jwk/keeper"
 	jwktypes "github.com/burnt-labs/xion/x/jwk/types"
 	"github.com/burnt-labs/xion/x/xion"
 	xionkeeper "github.com/burnt-labs/xion/x/xion/keeper"
+	xionmiddleware "github.com/burnt-labs/xion/x/xion/middleware"
 	xiontypes "github.com/burnt-labs/xion/x/xion/types"
 	zk "github.com/burnt-labs/xion/x/zk"
 	zkkeeper "github.com/burnt-labs/xion/x/zk/keeper"
@@ -285,6 +288,7 @@ type WasmApp struct {
 	TokenFactoryKeeper tokenfactorykeeper.Keeper
 	DkimKeeper         dkimkeeper.Keeper
 	ZkKeeper           zkkeeper.Keeper
+	GlobalFeeKeeper    globalfeekeeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -456,10 +460,12 @@ func NewWasmApp(
 		app.BankKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		mintkeeper.WithMintFn(xionkeeper.StakedInflationMintFn(
+		// XionKeeper is constructed later; the mint function only reads the
+		// emissions schedule from it once blocks are produced.
+		mintkeeper.WithMintFn(xionkeeper.ScheduledEmissionsMintFn(
 			authtypes.FeeCollectorName,
 			minttypes.DefaultInflationCalculationFn,
-			app.BankKeeper, app.AccountKeeper, app.StakingKeeper)))
+			app.BankKeeper, app.AccountKeeper, app.StakingKeeper, &app.XionKeeper)))
 
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec,
@@ -600,6 +606,12 @@ func NewWasmApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
+	app.GlobalFeeKeeper = globalfeekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[globalfee.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	app.ZkKeeper = zkkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[zktypes.StoreKey]),
@@ -710,6 +722,9 @@ func NewWasmApp(
 	tokenFactoryOpts := bindings.RegisterCustomPlugins(app.BankKeeper, &app.TokenFactoryKeeper)
 	wasmOpts = append(owasm.RegisterStargateQueries(*app.GRPCQueryRouter(), appCodec), wasmOpts...)
 	wasmOpts = append(wasmOpts, tokenFactoryOpts...)
+	// XionKeeper is constructed after the wasm keeper, so the decorator holds a
+	// reference that is resolved when messages are dispatched
+	wasmOpts = append(wasmOpts, wasmkeeper.WithMessageHandlerDecorator(xionmiddleware.NewWasmMessageHandlerDecorator(&app.XionKeeper)))
 
 	// instantiate the Wasm VM with the chosen parameters
 	// we need to create this double wasm dir because the wasmd Keeper appends an extra `wasm/` to the value you give it
@@ -784,9 +799,13 @@ func NewWasmApp(
 		app.GetSubspace(xiontypes.ModuleName),
 		app.BankKeeper,
 		app.AccountKeeper,
+		app.DistrKeeper,
+		dkimkeeper.NewQuerier(app.DkimKeeper),
 		app.ContractKeeper,
 		app.WasmKeeper,
 		app.AbstractAccountKeeper,
+		mintkeeper.NewQueryServerImpl(app.MintKeeper),
+		app.StakingKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -818,13 +837,16 @@ func NewWasmApp(
 	// Wire the transfer keeper's ICS4 wrapper through the callbacks middleware so that
 	// SendPacket validation (e.g. src_callback schema checks) is consistent with the
 	// timeout/ack path. Without this, the send path bypasses callbacks validation.
-	app.TransferKeeper.WithICS4Wrapper(cbStack)
+	// The platform fee wrapper charges outgoing transfers before they reach the callbacks middleware.
+	app.TransferKeeper.WithICS4Wrapper(xionmiddleware.NewICS4Wrapper(cbStack, app.XionKeeper))
 	transferStack = packetforward.NewIBCMiddleware(
 		cbStack,
 		app.PacketForwardKeeper,
 		10,
 		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 	)
+	// marks relayed packet callbacks so packet forwards and their retries are exempt from the platform fee
+	transferStack = xionmiddleware.NewIBCMiddleware(transferStack)
 
 	// Create static IBC router, add app routes, then set and seal it
 	ibcRouter := porttypes.NewRouter().
@@ -891,7 +913,7 @@ func NewWasmApp(
 		// non sdk modules
 		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
 		jwk.NewAppModule(appCodec, app.JwkKeeper, app.GetSubspace(jwktypes.ModuleName)),
-		globalfee.NewAppModule(app.GetSubspace(globalfee.ModuleName)),
+		globalfee.NewAppModule(app.GetSubspace(globalfee.ModuleName), app.GlobalFeeKeeper),
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
 		aa.NewAppModule(app.AbstractAccountKeeper),
 		xion.NewAppModule(app.XionKeeper),
@@ -1037,12 +1059,28 @@ func NewWasmApp(
 	indexerConfig := indexer.NewConfigFromOptions(appOpts)
 	services := []storetypes.ABCIListener{}
 	if indexerConfig.Enabled {
+		if err = app.indexerService.Configure(indexerConfig, app.WasmKeeper, abstractAccountCodes{app.AbstractAccountKeeper}); err != nil {
+			// Log the error but don't panic - indexer is not consensus-critical
+			app.Logger().Error("Failed to configure indexer", "error", err)
+		}
+		exporter, err := indexer.NewExporter(indexerConfig, homePath)
+		if err != nil {
+			// Log the error but don't panic - indexer is not consensus-critical
+			app.Logger().Error("Failed to open indexer exporter", "error", err)
+		} else if exporter != nil {
+			app.indexerService.SetExporter(exporter)
+		}
 		// Add listeners to commitmultistore
 		// otherwise the ABCILister attached to the streammanager
 		// will receive block information but empty []ChangeSet
 		listenKeys := []storetypes.StoreKey{
 			keys[feegrant.StoreKey],
 			keys[authzkeeper.StoreKey],
+			// abstract account authenticators
+			keys[wasmtypes.StoreKey],
+		}
+		if indexerConfig.BankHolders {
+			listenKeys = append(listenKeys, keys[banktypes.StoreKey])
 		}
 		app.CommitMultiStore().AddListeners(listenKeys)
 		services = append(services, app.indexerService)
@@ -1139,6 +1177,19 @@ func NewWasmApp(
 		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
 			tmos.Exit(fmt.Sprintf("failed to initialize pinned codes %s", err))
 		}
+
+		// Rebuild the index from the live stores if it is not at the committed height
+		if indexerConfig.Enabled {
+			if err := app.indexerService.CatchUp(ctx, app.LastBlockHeight(), indexer.RebuildSources{
+				Authz:    app.AuthzKeeper,
+				FeeGrant: app.FeeGrantKeeper,
+				Wasm:     app.WasmKeeper,
+				Bank:     app.BankKeeper,
+			}); err != nil {
+				// Log the error but don't panic - indexer is not consensus-critical
+				app.Logger().Error("Failed to catch up indexer", "error", err)
+			}
+		}
 	}
 	return app
 }
@@ -1150,7 +1201,7 @@ func (app *WasmApp) setAnteHandler(txConfig client.TxConfig, nodeConfig wasmtype
 				AccountKeeper:   app.AccountKeeper,
 				BankKeeper:      app.BankKeeper,
 				SignModeHandler: txConfig.SignModeHandler(),
-				FeegrantKeeper:  app.FeeGrantKeeper,
+				FeegrantKeeper:  xionmiddleware.NewFeegrantKeeper(app.FeeGrantKeeper),
 				SigGasConsumer:  aa.SigVerificationGasConsumer,
 			},
 
@@ -1158,7 +1209,7 @@ func (app *WasmApp) setAnteHandler(txConfig client.TxConfig, nodeConfig wasmtype
 			IBCKeeper:             app.IBCKeeper,
 			NodeConfig:            &nodeConfig,
 			TXCounterStoreService: runtime.NewKVStoreService(txCounterStoreKey),
-			GlobalFeeSubspace:     app.GetSubspace(globalfee.ModuleName),
+			GlobalFeeKeeper:       &app.GlobalFeeKeeper,
 			StakingKeeper:         app.StakingKeeper,
 			CircuitKeeper:         &app.CircuitKeeper,
 		},
@@ -1301,6 +1352,7 @@ func (app *WasmApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 
 	// Register indexer service routes
 	app.indexerService.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	app.indexerService.RegisterWebsocketRoutes(apiSvr.Router, apiConfig.EnableUnsafeCORS)
 
 	// register swagger API from root so that other applications can override easily
 	if err := RegisterSwaggerAPI(clientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
@@ -1308,6 +1360,14 @@ func (app *WasmApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 	}
 }
 
+// RegisterGRPCServerWithSkipCheckHeader implements the
+// Application.RegisterGRPCServerWithSkipCheckHeader method, it also
+// registers the indexer streaming services the query router cannot serve.
+func (app *WasmApp) RegisterGRPCServerWithSkipCheckHeader(server gogogrpc.Server, skipCheckHeader bool) {
+	app.BaseApp.RegisterGRPCServerWithSkipCheckHeader(server, skipCheckHeader)
+	app.indexerService.RegisterStreamServices(server)
+}
+
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *WasmApp) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.interfaceRegistry)
@@ -1348,6 +1408,20 @@ func (app *WasmApp) IndexerService() *indexer.StreamService {
 	return app.indexerService
 }
 
+// abstractAccountCodes reports the code ids abstract accounts can be
+// registered with to the indexer.
+type abstractAccountCodes struct {
+	keeper aakeeper.Keeper
+}
+
+func (a abstractAccountCodes) IsAbstractAccountCode(ctx context.Context, codeID uint64) bool {
+	params, err := a.keeper.GetParams(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return false
+	}
+	return params.IsAllowed(codeID)
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -1,20 +1,15 @@
 package e2e_ibc
 
 import (
-	"crypto/sha256"
-	"encoding/json"
 	"fmt"
-	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
 
 	"cosmossdk.io/math"
 	"github.com/burnt-labs/xion/e2e_tests/testlib"
-	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
-	paramsutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	interchaintest "github.com/cosmos/interchaintest/v10"
 	"github.com/cosmos/interchaintest/v10/chain/cosmos"
@@ -130,53 +125,16 @@ func TestIBCMinFeeMultiDenom(t *testing.T) {
 	require.True(t, xionUserBalNew.Equal(amount), "got: %d, wanted: %d", xionUserBalNew, expectedBal)
 
 	// step 3: upgrade minimum through governance
-	rawValueBz, err := formatJSON(dstIbcDenom)
-	require.NoError(t, err)
-
-	paramChangeJSON := paramsutils.ParamChangeProposalJSON{
-		Title:       "add token to globalfee",
-		Description: ".",
-		Changes: paramsutils.ParamChangesJSON{
-			paramsutils.ParamChangeJSON{
-				Subspace: "globalfee",
-				Key:      "MinimumGasPricesParam",
-				Value:    rawValueBz,
-			},
-		},
-		Deposit: "10000000uxion",
-	}
-
-	content, err := json.Marshal(paramChangeJSON)
-	require.NoError(t, err)
-
-	hash := sha256.Sum256(content)
-	proposalFilename := fmt.Sprintf("%x.json", hash)
-	err = chain.GetNode().WriteFile(ctx, content, proposalFilename)
-	require.NoError(t, err)
-
-	proposalPath := filepath.Join(chain.GetNode().HomeDir(), proposalFilename)
-
-	command := []string{
-		"gov", "submit-legacy-proposal",
-		"param-change",
-		proposalPath,
-		"--gas",
-		"2500000",
-		"--chain-id",
-		chain.Config().ChainID,
-	}
-
-	txHash, err := testlib.ExecTx(t, ctx, chain.GetNode(), xionUser.KeyName(), command...)
-	require.NoError(t, err)
-	t.Logf("Submitted governance proposal with tx Hash: %s", txHash)
-
-	txRes, err := chain.GetTransaction(txHash)
+	minGasPrices := types.NewDecCoins(
+		types.NewDecCoinFromDec(dstIbcDenom, math.LegacyMustNewDecFromStr("0.024")),
+		types.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.025")),
+	)
+	prop := testlib.GlobalFeeMinGasPricesProposal(t, ctx, chain, minGasPrices, "add token to globalfee", "add token to globalfee", "10000000uxion")
+	paramChangeTx, err := chain.SubmitProposal(ctx, xionUser.KeyName(), prop)
 	require.NoError(t, err)
+	t.Logf("Submitted governance proposal with tx Hash: %s", paramChangeTx.TxHash)
 
-	evtSubmitProp := "submit_proposal"
-	paramProposalIDRaw, ok := txProposal(txRes.Events, evtSubmitProp, "proposal_id")
-	require.True(t, ok)
-	paramProposalID, err := strconv.Atoi(paramProposalIDRaw)
+	paramProposalID, err := strconv.Atoi(paramChangeTx.ProposalID)
 	require.NoError(t, err)
 
 	require.Eventuallyf(t, func() bool {
@@ -251,28 +209,3 @@ func TestIBCMinFeeMultiDenom(t *testing.T) {
 	)
 	require.NoError(t, err)
 }
-
-// formatJSON formats the IBC denom for the governance proposal
-func formatJSON(ibcDenom string) (json.RawMessage, error) {
-	// Note: Cosmos SDK requires denominations to be sorted lexicographically
-	// IBC denoms (ibc/...) come before native denoms (uxion) alphabetically
-	value := []map[string]string{
-		{"denom": ibcDenom, "amount": "0.024"},
-		{"denom": "uxion", "amount": "0.025"},
-	}
-	return json.Marshal(value)
-}
-
-// txProposal extracts a value from transaction events
-func txProposal(events []abcitypes.Event, eventType, key string) (string, bool) {
-	for _, event := range events {
-		if event.Type == eventType {
-			for _, attr := range event.Attributes {
-				if attr.Key == key {
-					return attr.Value, true
-				}
-			}
-		}
-	}
-	return "", false
-}
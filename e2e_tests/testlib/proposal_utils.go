@@ -2,9 +2,12 @@ package testlib
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
+	globalfeetypes "github.com/burnt-labs/xion/x/globalfee/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/interchaintest/v10/chain/cosmos"
 	"github.com/cosmos/interchaintest/v10/ibc"
@@ -96,3 +99,34 @@ func SubmitAndPassProposal(
 	err = testutil.WaitForBlocks(ctx, int(height+4), chain)
 	return err
 }
+
+// GlobalFeeMinGasPricesProposal builds a governance proposal that sets the
+// globalfee minimum gas prices and keeps the other globalfee params.
+func GlobalFeeMinGasPricesProposal(
+	t *testing.T,
+	ctx context.Context,
+	chain *cosmos.CosmosChain,
+	minGasPrices sdk.DecCoins,
+	title, summary, deposit string,
+) cosmos.TxProposalv1 {
+	output, _, err := chain.GetNode().ExecQuery(ctx, "globalfee", "params")
+	require.NoError(t, err)
+
+	cdc := chain.Config().EncodingConfig.Codec
+	var params globalfeetypes.Params
+	require.NoError(t, cdc.UnmarshalJSON(output, &params))
+	params.MinimumGasPrices = minGasPrices.Sort()
+
+	msg, err := cdc.MarshalInterfaceJSON(&globalfeetypes.MsgUpdateParams{
+		Authority: GetModuleAddress(t, chain, ctx, "gov"),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	return cosmos.TxProposalv1{
+		Messages: []json.RawMessage{msg},
+		Deposit:  deposit,
+		Title:    title,
+		Summary:  summary,
+	}
+}
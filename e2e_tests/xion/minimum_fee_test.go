@@ -2,12 +2,9 @@ package e2e_xion
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
-	"path/filepath"
 	"reflect"
 	"strconv"
 	"testing"
@@ -20,9 +17,6 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
-	abcitypes "github.com/cometbft/cometbft/abci/types"
-	paramsutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
-
 	"github.com/cosmos/cosmos-sdk/types"
 	ibctest "github.com/cosmos/interchaintest/v10"
 
@@ -402,53 +396,16 @@ func testMultiDenomFee(t *testing.T, xion *cosmos.CosmosChain, assert assertionF
 	require.Equal(t, balance, math.NewInt(10))
 
 	// step 3: upgrade minimum through governance
-	rawValueBz, err := formatJSON(tfDenom)
-	require.NoError(t, err)
-
-	paramChangeJSON := paramsutils.ParamChangeProposalJSON{
-		Title:       "add token to globalfee",
-		Description: ".",
-		Changes: paramsutils.ParamChangesJSON{
-			paramsutils.ParamChangeJSON{
-				Subspace: "globalfee",
-				Key:      "MinimumGasPricesParam",
-				Value:    rawValueBz,
-			},
-		},
-		Deposit: "10000000uxion",
-	}
-
-	content, err := json.Marshal(paramChangeJSON)
-	require.NoError(t, err)
-
-	hash := sha256.Sum256(content)
-	proposalFilename := fmt.Sprintf("%x.json", hash)
-	err = xion.GetNode().WriteFile(ctx, content, proposalFilename)
-	require.NoError(t, err)
-
-	proposalPath := filepath.Join(xion.GetNode().HomeDir(), proposalFilename)
-
-	command := []string{
-		"gov", "submit-legacy-proposal",
-		"param-change",
-		proposalPath,
-		"--gas",
-		"2500000",
-		"--chain-id",
-		xion.Config().ChainID,
-	}
-
-	txHash, err := testlib.ExecTx(t, ctx, xion.GetNode(), xionUser.KeyName(), command...)
+	minGasPrices := types.NewDecCoins(
+		types.NewDecCoinFromDec(tfDenom, math.LegacyMustNewDecFromStr("0.005")),
+		types.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.025")),
+	)
+	prop := testlib.GlobalFeeMinGasPricesProposal(t, ctx, xion, minGasPrices, "add token to globalfee", "add token to globalfee", "10000000uxion")
+	paramChangeTx, err = xion.SubmitProposal(ctx, xionUser.KeyName(), prop)
 	require.NoError(t, err)
-	t.Logf("Platform percentage change proposal submitted with ID %s in transaction %s", paramChangeTx.ProposalID, paramChangeTx.TxHash)
+	t.Logf("Globalfee params change proposal submitted with ID %s in transaction %s", paramChangeTx.ProposalID, paramChangeTx.TxHash)
 
-	txRes, err := xion.GetTransaction(txHash)
-	require.NoError(t, err)
-
-	evtSubmitProp := "submit_proposal"
-	paramProposalIDRaw, ok := txProposal(txRes.Events, evtSubmitProp, "proposal_id")
-	require.True(t, ok)
-	paramProposalID, err := strconv.Atoi(paramProposalIDRaw)
+	paramProposalID, err := strconv.Atoi(paramChangeTx.ProposalID)
 	require.NoError(t, err)
 
 	require.Eventuallyf(t, func() bool {
@@ -488,46 +445,6 @@ func testMultiDenomFee(t *testing.T, xion *cosmos.CosmosChain, assert assertionF
 	assert(t, ctx, xion, xionUser, recipientKeyAddress, fundAmount)
 }
 
-func txProposal(events []abcitypes.Event, eventType, attrKey string) (string, bool) {
-	for _, event := range events {
-		if event.Type != eventType {
-			continue
-		}
-		for _, attr := range event.Attributes {
-			if attr.Key == attrKey {
-				return attr.Value, true
-			}
-
-			// tendermint < v0.37-alpha returns base64 encoded strings in events.
-			key, err := base64.StdEncoding.DecodeString(attr.Key)
-			if err != nil {
-				continue
-			}
-			if string(key) == attrKey {
-				value, err := base64.StdEncoding.DecodeString(attr.Value)
-				if err != nil {
-					continue
-				}
-				return string(value), true
-			}
-		}
-	}
-	return "", false
-}
-
-type DenomAmount struct {
-	Denom  string `json:"denom"`
-	Amount string `json:"amount"`
-}
-
-func formatJSON(tfDenom string) ([]byte, error) {
-	data := []DenomAmount{
-		{Denom: tfDenom, Amount: "0.005000000000000000"},
-		{Denom: "uxion", Amount: "0.025000000000000000"},
-	}
-	return json.Marshal(data)
-}
-
 // TestXionPlatformMinDirect verifies that MsgSetPlatformMinimum
 // can be submitted as a direct CLI transaction (not just through governance).
 // This addresses the vulnerability reported in security report #52897.
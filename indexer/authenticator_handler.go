@@ -0,0 +1,306 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"cosmossdk.io/collections"
+	core "cosmossdk.io/collections/corecompat"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	indexerauthenticator "github.com/burnt-labs/xion/indexer/authenticator"
+)
+
+var (
+	AuthenticatorsPrefix               = collections.NewPrefix(0)
+	AuthenticatorIdentifierIndexPrefix = collections.NewPrefix(1)
+)
+
+// Authenticator variants of the abstract account contract that are indexed.
+const (
+	AuthenticatorTypeSecp256K1 = "Secp256K1"
+	AuthenticatorTypeEd25519   = "Ed25519"
+	AuthenticatorTypeSecp256R1 = "Secp256R1"
+	AuthenticatorTypeEthWallet = "EthWallet"
+	AuthenticatorTypeJwt       = "Jwt"
+	AuthenticatorTypePasskey   = "Passkey"
+)
+
+// AccountAuthenticatorsNamespace is the cw-storage-plus namespace of the
+// abstract account contract's Map<u8, Authenticator>.
+var AccountAuthenticatorsNamespace = []byte("authenticators")
+
+type authenticatorIndexes struct {
+	Identifier *indexes.Multi[collections.Pair[string, string], collections.Pair[sdk.AccAddress, uint32], indexerauthenticator.AccountAuthenticator]
+}
+
+func (a authenticatorIndexes) IndexesList() []collections.Index[collections.Pair[sdk.AccAddress, uint32], indexerauthenticator.AccountAuthenticator] {
+	return []collections.Index[collections.Pair[sdk.AccAddress, uint32], indexerauthenticator.AccountAuthenticator]{a.Identifier}
+}
+
+// AbstractAccountCodes reports whether a wasm code id is registered for
+// abstract accounts.
+type AbstractAccountCodes interface {
+	IsAbstractAccountCode(ctx context.Context, codeID uint64) bool
+}
+
+type AuthenticatorHandler struct {
+	kvStoreService core.KVStoreService
+	cdc            codec.Codec
+	addrCodec      address.Codec
+	Schema         collections.Schema
+	// key: (account, authenticator id)
+	// value: authenticator
+	// secondary index: (type, identifier)
+	Authenticators *collections.IndexedMap[collections.Pair[sdk.AccAddress, uint32], indexerauthenticator.AccountAuthenticator, authenticatorIndexes]
+
+	contractInfo WasmContractInfoKeeper
+	accountCodes AbstractAccountCodes
+}
+
+func newAuthenticatorIndexes(sb *collections.SchemaBuilder) authenticatorIndexes {
+	return authenticatorIndexes{
+		Identifier: indexes.NewMulti(
+			sb,
+			AuthenticatorIdentifierIndexPrefix,
+			"authenticator_by_identifier",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint32Key),
+			func(_ collections.Pair[sdk.AccAddress, uint32], value indexerauthenticator.AccountAuthenticator) (collections.Pair[string, string], error) {
+				return collections.Join(value.AuthenticatorType, value.Identifier), nil
+			},
+		),
+	}
+}
+
+func NewAuthenticatorHandler(kvStoreService core.KVStoreService, cdc codec.Codec, addrCodec address.Codec) (*AuthenticatorHandler, error) {
+	sb := collections.NewSchemaBuilder(kvStoreService)
+
+	authenticators := collections.NewIndexedMap(
+		sb,
+		AuthenticatorsPrefix,
+		"authenticators", // name of the collection
+		collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint32Key),
+		codec.CollValue[indexerauthenticator.AccountAuthenticator](cdc),
+		newAuthenticatorIndexes(sb),
+	)
+
+	schema, err := sb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthenticatorHandler{
+		Schema:         schema,
+		kvStoreService: kvStoreService,
+		cdc:            cdc,
+		addrCodec:      addrCodec,
+		Authenticators: authenticators,
+	}, nil
+}
+
+// Configure sets how the code id of a contract is looked up and which code
+// ids are abstract accounts. Until it is configured no authenticator is
+// indexed.
+func (ah *AuthenticatorHandler) Configure(contractInfo WasmContractInfoKeeper, accountCodes AbstractAccountCodes) {
+	ah.contractInfo = contractInfo
+	ah.accountCodes = accountCodes
+}
+
+// IsAccountCode returns true if contracts of codeID are abstract accounts,
+// only their state is indexed.
+func (ah *AuthenticatorHandler) IsAccountCode(ctx context.Context, codeID uint64) bool {
+	return ah.accountCodes != nil && ah.accountCodes.IsAbstractAccountCode(ctx, codeID)
+}
+
+// IsAccount returns true if contract is an abstract account. Other contracts
+// can write the same keys and must not be indexed.
+func (ah *AuthenticatorHandler) IsAccount(ctx context.Context, contract sdk.AccAddress) bool {
+	if ah.contractInfo == nil {
+		return false
+	}
+	info := ah.contractInfo.GetContractInfo(ctx, contract)
+	return info != nil && ah.IsAccountCode(ctx, info.CodeID)
+}
+
+// SetAuthenticator indexes the authenticator stored by account at id, as the
+// JSON value written by the contract. Variants that are not indexed are
+// ignored, values that cannot be decoded return ErrInvalidAuthenticator.
+func (ah *AuthenticatorHandler) SetAuthenticator(ctx context.Context, account sdk.AccAddress, id uint32, value []byte) error {
+	authenticatorType, identifier, audience, ok, err := ParseAuthenticator(value)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidAuthenticator, err)
+	}
+	if !ok {
+		return nil
+	}
+	accountStr, err := ah.addrCodec.BytesToString(account)
+	if err != nil {
+		return err
+	}
+	return ah.Authenticators.Set(ctx, collections.Join(account, id), indexerauthenticator.AccountAuthenticator{
+		Account:           accountStr,
+		Id:                id,
+		AuthenticatorType: authenticatorType,
+		Identifier:        identifier,
+		Audience:          audience,
+	})
+}
+
+// RemoveAuthenticator removes the authenticator stored by account at id.
+func (ah *AuthenticatorHandler) RemoveAuthenticator(ctx context.Context, account sdk.AccAddress, id uint32) error {
+	key := collections.Join(account, id)
+	if has, err := ah.Authenticators.Has(ctx, key); err != nil {
+		return err
+	} else if !has {
+		// Graceful handling: removing an authenticator that was never indexed is a no-op
+		return nil
+	}
+	return ah.Authenticators.Remove(ctx, key)
+}
+
+func (ah *AuthenticatorHandler) HandleUpdate(ctx context.Context, pair *storetypes.StoreKVPair) error {
+	account, id, ok := ParseAuthenticatorStoreKey(pair.Key)
+	if !ok || !ah.IsAccount(ctx, account) {
+		return nil
+	}
+	if pair.Delete {
+		return ah.RemoveAuthenticator(ctx, account, id)
+	}
+	return ah.SetAuthenticator(ctx, account, id, pair.Value)
+}
+
+// ParseAuthenticatorStoreKey splits the account and authenticator id from a
+// wasm store key, if it is a key of the abstract account contract's
+// authenticators map.
+func ParseAuthenticatorStoreKey(key []byte) (account sdk.AccAddress, id uint32, ok bool) {
+	// key is of format:
+	// 0x03<contract address><contract key>
+	if !bytes.HasPrefix(key, wasmtypes.ContractStorePrefix) {
+		return nil, 0, false
+	}
+	key = key[len(wasmtypes.ContractStorePrefix):]
+	if len(key) <= wasmtypes.ContractAddrLen {
+		return nil, 0, false
+	}
+	account = sdk.AccAddress(key[:wasmtypes.ContractAddrLen])
+
+	id, ok = ParseAuthenticatorContractKey(key[wasmtypes.ContractAddrLen:])
+	if !ok {
+		return nil, 0, false
+	}
+	return account, id, true
+}
+
+// ParseAuthenticatorContractKey returns the authenticator id of a contract
+// storage key, if it is a key of the authenticators map.
+func ParseAuthenticatorContractKey(key []byte) (id uint32, ok bool) {
+	// key is of format:
+	// <0x00><namespace len><namespace><u8 id>
+	prefix := append([]byte{0, byte(len(AccountAuthenticatorsNamespace))}, AccountAuthenticatorsNamespace...)
+	if !bytes.HasPrefix(key, prefix) || len(key) != len(prefix)+1 {
+		return 0, false
+	}
+	return uint32(key[len(prefix)]), true
+}
+
+// ParseAuthenticator decodes the JSON of an abstract account authenticator
+// into the identifier it is indexed by. ok is false for variants that are not
+// indexed.
+// This is pure business logic that can be fully unit tested
+func ParseAuthenticator(value []byte) (authenticatorType, identifier, audience string, ok bool, err error) {
+	var variants map[string]json.RawMessage
+	if err := json.Unmarshal(value, &variants); err != nil {
+		return "", "", "", false, err
+	}
+	if len(variants) != 1 {
+		return "", "", "", false, fmt.Errorf("expected a single authenticator variant, got %d", len(variants))
+	}
+
+	for authenticatorType, raw := range variants {
+		if !isIndexedAuthenticatorType(authenticatorType) {
+			return "", "", "", false, nil
+		}
+
+		var fields struct {
+			Pubkey  []byte `json:"pubkey"`
+			Address string `json:"address"`
+			Sub     string `json:"sub"`
+			Aud     string `json:"aud"`
+			Passkey []byte `json:"passkey"`
+		}
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return "", "", "", false, err
+		}
+
+		switch authenticatorType {
+		case AuthenticatorTypeSecp256K1, AuthenticatorTypeEd25519, AuthenticatorTypeSecp256R1:
+			identifier = base64.StdEncoding.EncodeToString(fields.Pubkey)
+		case AuthenticatorTypeEthWallet:
+			identifier = strings.ToLower(fields.Address)
+		case AuthenticatorTypeJwt:
+			identifier, audience = fields.Sub, fields.Aud
+		case AuthenticatorTypePasskey:
+			// the passkey is the JSON of the WebAuthn credential
+			var credential struct {
+				ID []byte `json:"id"`
+			}
+			if err := json.Unmarshal(fields.Passkey, &credential); err != nil {
+				return "", "", "", false, err
+			}
+			identifier = base64.RawURLEncoding.EncodeToString(credential.ID)
+		}
+		if identifier == "" {
+			return "", "", "", false, fmt.Errorf("%s authenticator without identifier", authenticatorType)
+		}
+		return authenticatorType, identifier, audience, true, nil
+	}
+	return "", "", "", false, nil
+}
+
+// NormalizeAuthenticatorIdentifier returns identifier in the encoding the
+// authenticators of authenticatorType are indexed by.
+// This is pure business logic that can be fully unit tested
+func NormalizeAuthenticatorIdentifier(authenticatorType, identifier string) (string, error) {
+	switch authenticatorType {
+	case AuthenticatorTypeSecp256K1, AuthenticatorTypeEd25519, AuthenticatorTypeSecp256R1:
+		pubkey, err := base64.StdEncoding.DecodeString(identifier)
+		if err != nil {
+			return "", fmt.Errorf("invalid base64 public key: %w", err)
+		}
+		return base64.StdEncoding.EncodeToString(pubkey), nil
+	case AuthenticatorTypeEthWallet:
+		return strings.ToLower(identifier), nil
+	case AuthenticatorTypeJwt:
+		return identifier, nil
+	case AuthenticatorTypePasskey:
+		credentialID, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(identifier, "="))
+		if err != nil {
+			return "", fmt.Errorf("invalid base64url credential id: %w", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(credentialID), nil
+	default:
+		return "", fmt.Errorf("unknown authenticator type %q", authenticatorType)
+	}
+}
+
+func isIndexedAuthenticatorType(authenticatorType string) bool {
+	switch authenticatorType {
+	case AuthenticatorTypeSecp256K1, AuthenticatorTypeEd25519, AuthenticatorTypeSecp256R1,
+		AuthenticatorTypeEthWallet, AuthenticatorTypeJwt, AuthenticatorTypePasskey:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,104 @@
+package indexer
+
+import (
+	"context"
+	"log/slog"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	indexerauthenticator "github.com/burnt-labs/xion/indexer/authenticator"
+)
+
+type authenticatorQuerier struct {
+	authenticatorHandler *AuthenticatorHandler
+	cdc                  codec.Codec
+	addrCodec            address.Codec
+}
+
+var _ indexerauthenticator.QueryServer = &authenticatorQuerier{}
+
+func NewAuthenticatorQuerier(handler *AuthenticatorHandler, cdc codec.Codec, addrCodec address.Codec) indexerauthenticator.QueryServer {
+	return &authenticatorQuerier{handler, cdc, addrCodec}
+}
+
+// multiIndexCollection exposes a Multi index to query.CollectionPaginate
+// through MultiIterateRaw.
+type multiIndexCollection[ReferenceKey, PrimaryKey, Value any] struct {
+	multi *indexes.Multi[ReferenceKey, PrimaryKey, Value]
+}
+
+func (m multiIndexCollection[ReferenceKey, PrimaryKey, Value]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[collections.Pair[ReferenceKey, PrimaryKey], collections.NoValue], error) {
+	return MultiIterateRaw(ctx, m.multi, start, end, order)
+}
+
+func (m multiIndexCollection[ReferenceKey, PrimaryKey, Value]) KeyCodec() collcodec.KeyCodec[collections.Pair[ReferenceKey, PrimaryKey]] {
+	return m.multi.KeyCodec()
+}
+
+func (aq *authenticatorQuerier) AccountsByAuthenticator(ctx context.Context, req *indexerauthenticator.QueryAccountsByAuthenticatorRequest) (*indexerauthenticator.QueryAccountsByAuthenticatorResponse, error) {
+	identifier, err := NormalizeAuthenticatorIdentifier(req.AuthenticatorType, req.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticators, pageRes, err := query.CollectionPaginate(
+		ctx,
+		multiIndexCollection[collections.Pair[string, string], collections.Pair[sdk.AccAddress, uint32], indexerauthenticator.AccountAuthenticator]{
+			multi: aq.authenticatorHandler.Authenticators.Indexes.Identifier,
+		},
+		req.Pagination,
+		// key is ((type, identifier), (account, id))
+		// value is empty because index only stores keys in a KeySet
+		func(key collections.Pair[collections.Pair[string, string], collections.Pair[sdk.AccAddress, uint32]], _ collections.NoValue) (*indexerauthenticator.AccountAuthenticator, error) {
+			authenticator, err := aq.authenticatorHandler.Authenticators.Get(ctx, key.K2())
+			if err != nil {
+				return nil, err
+			}
+			return &authenticator, nil
+		},
+		query.WithCollectionPaginationPairPrefix[collections.Pair[string, string], collections.Pair[sdk.AccAddress, uint32]](
+			collections.Join(req.AuthenticatorType, identifier),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("authenticator_querier", "authenticators", len(authenticators))
+	return &indexerauthenticator.QueryAccountsByAuthenticatorResponse{
+		Authenticators: authenticators,
+		Pagination:     pageRes,
+	}, nil
+}
+
+func (aq *authenticatorQuerier) AccountAuthenticators(ctx context.Context, req *indexerauthenticator.QueryAccountAuthenticatorsRequest) (*indexerauthenticator.QueryAccountAuthenticatorsResponse, error) {
+	account, err := aq.addrCodec.StringToBytes(req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticators, pageRes, err := query.CollectionPaginate(
+		ctx,
+		aq.authenticatorHandler.Authenticators,
+		req.Pagination,
+		// iterate over the original key space (account, id)
+		func(_ collections.Pair[sdk.AccAddress, uint32], authenticator indexerauthenticator.AccountAuthenticator) (*indexerauthenticator.AccountAuthenticator, error) {
+			return &authenticator, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint32](sdk.AccAddress(account)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("authenticator_querier", "authenticators", len(authenticators))
+	return &indexerauthenticator.QueryAccountAuthenticatorsResponse{
+		Authenticators: authenticators,
+		Pagination:     pageRes,
+	}, nil
+}
@@ -0,0 +1,212 @@
+package indexer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	indexerauthenticator "github.com/burnt-labs/xion/indexer/authenticator"
+)
+
+func authenticatorStoreKey(account sdk.AccAddress, id byte) []byte {
+	key := append([]byte{}, wasmtypes.ContractStorePrefix...)
+	key = append(key, account...)
+	key = append(key, 0, byte(len(AccountAuthenticatorsNamespace)))
+	key = append(key, AccountAuthenticatorsNamespace...)
+	return append(key, id)
+}
+
+// mockAbstractAccountCodes are the code ids of abstract accounts.
+type mockAbstractAccountCodes map[uint64]struct{}
+
+func (m mockAbstractAccountCodes) IsAbstractAccountCode(_ context.Context, codeID uint64) bool {
+	_, ok := m[codeID]
+	return ok
+}
+
+func TestParseAuthenticatorStoreKey(t *testing.T) {
+	account := sdk.AccAddress(make([]byte, wasmtypes.ContractAddrLen))
+	account[0] = 1
+
+	gotAccount, id, ok := ParseAuthenticatorStoreKey(authenticatorStoreKey(account, 3))
+	require.True(t, ok)
+	require.Equal(t, account, gotAccount)
+	require.Equal(t, uint32(3), id)
+
+	for name, key := range map[string][]byte{
+		"other prefix":    append([]byte{0x02}, authenticatorStoreKey(account, 3)[1:]...),
+		"other namespace": append(append(append([]byte{0x03}, account...), 0, 5), []byte("admin")...),
+		"too short":       append([]byte{0x03}, account[:10]...),
+		"longer id":       append(authenticatorStoreKey(account, 3), 0),
+	} {
+		_, _, ok := ParseAuthenticatorStoreKey(key)
+		require.False(t, ok, name)
+	}
+}
+
+func TestParseAuthenticator(t *testing.T) {
+	pubkey := []byte{2, 1, 2, 3}
+	credential, err := json.Marshal(map[string]any{"id": []byte{0xfb, 0xff}, "publicKey": []byte{1}})
+	require.NoError(t, err)
+	passkey, err := json.Marshal(map[string]any{"Passkey": map[string]any{"url": "https://xion.burnt.com", "passkey": credential}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		value      string
+		typ        string
+		identifier string
+		audience   string
+		ok         bool
+		err        bool
+	}{
+		{"secp256k1", `{"Secp256K1":{"pubkey":"` + base64.StdEncoding.EncodeToString(pubkey) + `"}}`, AuthenticatorTypeSecp256K1, base64.StdEncoding.EncodeToString(pubkey), "", true, false},
+		{"eth wallet", `{"EthWallet":{"address":"0xAbC0"}}`, AuthenticatorTypeEthWallet, "0xabc0", "", true, false},
+		{"jwt", `{"Jwt":{"aud":"project","sub":"user-1"}}`, AuthenticatorTypeJwt, "user-1", "project", true, false},
+		{"passkey", string(passkey), AuthenticatorTypePasskey, "-_8", "", true, false},
+		{"not indexed", `{"ZKEmail":{"email_salt":"salt"}}`, "", "", "", false, false},
+		{"missing identifier", `{"Jwt":{"aud":"project"}}`, "", "", "", false, true},
+		{"two variants", `{"Jwt":{"sub":"a"},"EthWallet":{"address":"0x"}}`, "", "", "", false, true},
+		{"not json", `"admin"`, "", "", "", false, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			typ, identifier, audience, ok, err := ParseAuthenticator([]byte(tc.value))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.typ, typ)
+			require.Equal(t, tc.identifier, identifier)
+			require.Equal(t, tc.audience, audience)
+		})
+	}
+}
+
+func TestNormalizeAuthenticatorIdentifier(t *testing.T) {
+	identifier, err := NormalizeAuthenticatorIdentifier(AuthenticatorTypeEthWallet, "0xABC")
+	require.NoError(t, err)
+	require.Equal(t, "0xabc", identifier)
+
+	identifier, err = NormalizeAuthenticatorIdentifier(AuthenticatorTypePasskey, "-_8=")
+	require.NoError(t, err)
+	require.Equal(t, "-_8", identifier)
+
+	_, err = NormalizeAuthenticatorIdentifier(AuthenticatorTypeSecp256K1, "not base64!")
+	require.Error(t, err)
+	_, err = NormalizeAuthenticatorIdentifier("ZKEmail", "salt")
+	require.Error(t, err)
+}
+
+func TestAuthenticatorIndex(t *testing.T) {
+	memDB, cdc, addrCodec := setupTest(t)
+	ctx := context.Background()
+	service := NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger())
+	querier := service.AuthenticatorQuerier()
+
+	account1 := sdk.AccAddress(make([]byte, wasmtypes.ContractAddrLen))
+	account1[0] = 1
+	account2 := sdk.AccAddress(make([]byte, wasmtypes.ContractAddrLen))
+	account2[0] = 2
+	other := sdk.AccAddress(make([]byte, wasmtypes.ContractAddrLen))
+	other[0] = 3
+	account1Str, _ := addrCodec.BytesToString(account1)
+	account2Str, _ := addrCodec.BytesToString(account2)
+	pubkey := base64.StdEncoding.EncodeToString([]byte{2, 1, 2, 3})
+
+	// accounts 1 and 2 are abstract accounts, other is another contract
+	require.NoError(t, service.Configure(Config{},
+		mockContractInfoKeeper{string(account1): 1, string(account2): 1, string(other): 2},
+		mockAbstractAccountCodes{1: {}}))
+
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: wasmtypes.StoreKey, Key: authenticatorStoreKey(account1, 0), Value: []byte(`{"Jwt":{"aud":"project","sub":"user-1"}}`)},
+		{StoreKey: wasmtypes.StoreKey, Key: authenticatorStoreKey(account1, 1), Value: []byte(`{"Secp256K1":{"pubkey":"` + pubkey + `"}}`)},
+		{StoreKey: wasmtypes.StoreKey, Key: authenticatorStoreKey(account2, 0), Value: []byte(`{"Jwt":{"aud":"other","sub":"user-1"}}`)},
+		// unrelated contract state and undecodable values are skipped
+		{StoreKey: wasmtypes.StoreKey, Key: append(append([]byte{0x03}, account2...), []byte("config")...), Value: []byte(`{}`)},
+		{StoreKey: wasmtypes.StoreKey, Key: authenticatorStoreKey(account2, 1), Value: []byte(`not json`)},
+		// other contracts cannot add entries to the index
+		{StoreKey: wasmtypes.StoreKey, Key: authenticatorStoreKey(other, 0), Value: []byte(`{"Jwt":{"aud":"project","sub":"user-1"}}`)},
+		{StoreKey: wasmtypes.StoreKey, Key: authenticatorStoreKey(other, 1), Value: []byte(`{"Secp256K1":{"pubkey":"` + pubkey + `"}}`)},
+	}
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, changeSet))
+
+	// undecodable values do not diverge the index
+	require.False(t, service.health.diverged.Load())
+
+	byJwt, err := querier.AccountsByAuthenticator(ctx, &indexerauthenticator.QueryAccountsByAuthenticatorRequest{
+		AuthenticatorType: AuthenticatorTypeJwt,
+		Identifier:        "user-1",
+		Pagination:        &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, byJwt.Authenticators, 1)
+	require.Equal(t, uint64(2), byJwt.Pagination.Total)
+	require.Equal(t, account1Str, byJwt.Authenticators[0].Account)
+	require.Equal(t, "project", byJwt.Authenticators[0].Audience)
+
+	byJwt, err = querier.AccountsByAuthenticator(ctx, &indexerauthenticator.QueryAccountsByAuthenticatorRequest{
+		AuthenticatorType: AuthenticatorTypeJwt,
+		Identifier:        "user-1",
+		Pagination:        &query.PageRequest{Key: byJwt.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, byJwt.Authenticators, 1)
+	require.Equal(t, account2Str, byJwt.Authenticators[0].Account)
+
+	byKey, err := querier.AccountsByAuthenticator(ctx, &indexerauthenticator.QueryAccountsByAuthenticatorRequest{
+		AuthenticatorType: AuthenticatorTypeSecp256K1,
+		Identifier:        pubkey,
+	})
+	require.NoError(t, err)
+	require.Len(t, byKey.Authenticators, 1)
+	require.Equal(t, uint32(1), byKey.Authenticators[0].Id)
+
+	_, err = querier.AccountsByAuthenticator(ctx, &indexerauthenticator.QueryAccountsByAuthenticatorRequest{AuthenticatorType: "Unknown", Identifier: "x"})
+	require.Error(t, err)
+
+	byAccount, err := querier.AccountAuthenticators(ctx, &indexerauthenticator.QueryAccountAuthenticatorsRequest{Account: account1Str})
+	require.NoError(t, err)
+	require.Len(t, byAccount.Authenticators, 2)
+	require.Equal(t, AuthenticatorTypeJwt, byAccount.Authenticators[0].AuthenticatorType)
+	require.Equal(t, AuthenticatorTypeSecp256K1, byAccount.Authenticators[1].AuthenticatorType)
+
+	_, err = querier.AccountAuthenticators(ctx, &indexerauthenticator.QueryAccountAuthenticatorsRequest{Account: "invalid"})
+	require.Error(t, err)
+
+	// removing an authenticator drops it from both indexes
+	changeSet = []*storetypes.StoreKVPair{
+		{StoreKey: wasmtypes.StoreKey, Key: authenticatorStoreKey(account1, 0), Delete: true},
+		{StoreKey: wasmtypes.StoreKey, Key: authenticatorStoreKey(account1, 7), Delete: true},
+	}
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, changeSet))
+
+	byJwt, err = querier.AccountsByAuthenticator(ctx, &indexerauthenticator.QueryAccountsByAuthenticatorRequest{
+		AuthenticatorType: AuthenticatorTypeJwt,
+		Identifier:        "user-1",
+	})
+	require.NoError(t, err)
+	require.Len(t, byJwt.Authenticators, 1)
+	require.Equal(t, account2Str, byJwt.Authenticators[0].Account)
+
+	byAccount, err = querier.AccountAuthenticators(ctx, &indexerauthenticator.QueryAccountAuthenticatorsRequest{Account: account1Str})
+	require.NoError(t, err)
+	require.Len(t, byAccount.Authenticators, 1)
+}
@@ -9,21 +9,26 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +36,94 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExpirationStatus selects grants by whether they have expired at the time of
+// the latest indexed block.
+type ExpirationStatus int32
+
+const (
+	// EXPIRATION_STATUS_UNSPECIFIED matches all grants.
+	ExpirationStatus_EXPIRATION_STATUS_UNSPECIFIED ExpirationStatus = 0
+	// EXPIRATION_STATUS_ACTIVE matches grants that have not expired.
+	ExpirationStatus_EXPIRATION_STATUS_ACTIVE ExpirationStatus = 1
+	// EXPIRATION_STATUS_EXPIRED matches grants that have expired.
+	ExpirationStatus_EXPIRATION_STATUS_EXPIRED ExpirationStatus = 2
+)
+
+var ExpirationStatus_name = map[int32]string{
+	0: "EXPIRATION_STATUS_UNSPECIFIED",
+	1: "EXPIRATION_STATUS_ACTIVE",
+	2: "EXPIRATION_STATUS_EXPIRED",
+}
+
+var ExpirationStatus_value = map[string]int32{
+	"EXPIRATION_STATUS_UNSPECIFIED": 0,
+	"EXPIRATION_STATUS_ACTIVE":      1,
+	"EXPIRATION_STATUS_EXPIRED":     2,
+}
+
+func (x ExpirationStatus) String() string {
+	return proto.EnumName(ExpirationStatus_name, int32(x))
+}
+
+func (ExpirationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6b523d5b0b40fbb4, []int{0}
+}
+
+// ExpirationFilter narrows the grants returned by their expiration.
+type ExpirationFilter struct {
+	// status selects grants by whether they have expired.
+	Status ExpirationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=xion.indexer.authz.v1.ExpirationStatus" json:"status,omitempty"`
+	// expiring_before, if set, only matches grants that expire before this time.
+	ExpiringBefore *time.Time `protobuf:"bytes,2,opt,name=expiring_before,json=expiringBefore,proto3,stdtime" json:"expiring_before,omitempty"`
+}
+
+func (m *ExpirationFilter) Reset()         { *m = ExpirationFilter{} }
+func (m *ExpirationFilter) String() string { return proto.CompactTextString(m) }
+func (*ExpirationFilter) ProtoMessage()    {}
+func (*ExpirationFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b523d5b0b40fbb4, []int{0}
+}
+func (m *ExpirationFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpirationFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpirationFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpirationFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpirationFilter.Merge(m, src)
+}
+func (m *ExpirationFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpirationFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpirationFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpirationFilter proto.InternalMessageInfo
+
+func (m *ExpirationFilter) GetStatus() ExpirationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ExpirationStatus_EXPIRATION_STATUS_UNSPECIFIED
+}
+
+func (m *ExpirationFilter) GetExpiringBefore() *time.Time {
+	if m != nil {
+		return m.ExpiringBefore
+	}
+	return nil
+}
+
 // QueryGrantsRequest is the request type for the Query/Grants RPC method.
 type QueryGrantsRequest struct {
 	// granter is the address of the user granting an authorization
@@ -42,13 +135,15 @@ type QueryGrantsRequest struct {
 	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 	// pagination defines pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiration narrows the grants returned by their expiration.
+	Expiration ExpirationFilter `protobuf:"bytes,5,opt,name=expiration,proto3" json:"expiration"`
 }
 
 func (m *QueryGrantsRequest) Reset()         { *m = QueryGrantsRequest{} }
 func (m *QueryGrantsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGrantsRequest) ProtoMessage()    {}
 func (*QueryGrantsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b523d5b0b40fbb4, []int{0}
+	return fileDescriptor_6b523d5b0b40fbb4, []int{1}
 }
 func (m *QueryGrantsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,6 +200,13 @@ func (m *QueryGrantsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGrantsRequest) GetExpiration() ExpirationFilter {
+	if m != nil {
+		return m.Expiration
+	}
+	return ExpirationFilter{}
+}
+
 // QueryGrantsResponse is the response type for the Query/Authorizations RPC
 // method.
 type QueryGrantsResponse struct {
@@ -118,7 +220,7 @@ func (m *QueryGrantsResponse) Reset()         { *m = QueryGrantsResponse{} }
 func (m *QueryGrantsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGrantsResponse) ProtoMessage()    {}
 func (*QueryGrantsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b523d5b0b40fbb4, []int{1}
+	return fileDescriptor_6b523d5b0b40fbb4, []int{2}
 }
 func (m *QueryGrantsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,13 +270,15 @@ type QueryGranterGrantsRequest struct {
 	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
 	// pagination defines pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiration narrows the grants returned by their expiration.
+	Expiration ExpirationFilter `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration"`
 }
 
 func (m *QueryGranterGrantsRequest) Reset()         { *m = QueryGranterGrantsRequest{} }
 func (m *QueryGranterGrantsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGranterGrantsRequest) ProtoMessage()    {}
 func (*QueryGranterGrantsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b523d5b0b40fbb4, []int{2}
+	return fileDescriptor_6b523d5b0b40fbb4, []int{3}
 }
 func (m *QueryGranterGrantsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,6 +321,13 @@ func (m *QueryGranterGrantsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGranterGrantsRequest) GetExpiration() ExpirationFilter {
+	if m != nil {
+		return m.Expiration
+	}
+	return ExpirationFilter{}
+}
+
 // QueryGranterGrantsResponse is the response type for the Query/GranterGrants
 // RPC method.
 type QueryGranterGrantsResponse struct {
@@ -230,7 +341,7 @@ func (m *QueryGranterGrantsResponse) Reset()         { *m = QueryGranterGrantsRe
 func (m *QueryGranterGrantsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGranterGrantsResponse) ProtoMessage()    {}
 func (*QueryGranterGrantsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b523d5b0b40fbb4, []int{3}
+	return fileDescriptor_6b523d5b0b40fbb4, []int{4}
 }
 func (m *QueryGranterGrantsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,13 +391,15 @@ type QueryGranteeGrantsRequest struct {
 	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// pagination defines pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiration narrows the grants returned by their expiration.
+	Expiration ExpirationFilter `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration"`
 }
 
 func (m *QueryGranteeGrantsRequest) Reset()         { *m = QueryGranteeGrantsRequest{} }
 func (m *QueryGranteeGrantsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGranteeGrantsRequest) ProtoMessage()    {}
 func (*QueryGranteeGrantsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b523d5b0b40fbb4, []int{4}
+	return fileDescriptor_6b523d5b0b40fbb4, []int{5}
 }
 func (m *QueryGranteeGrantsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,6 +442,13 @@ func (m *QueryGranteeGrantsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGranteeGrantsRequest) GetExpiration() ExpirationFilter {
+	if m != nil {
+		return m.Expiration
+	}
+	return ExpirationFilter{}
+}
+
 // QueryGranteeGrantsResponse is the response type for the Query/GranteeGrants
 // RPC method.
 type QueryGranteeGrantsResponse struct {
@@ -342,7 +462,7 @@ func (m *QueryGranteeGrantsResponse) Reset()         { *m = QueryGranteeGrantsRe
 func (m *QueryGranteeGrantsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGranteeGrantsResponse) ProtoMessage()    {}
 func (*QueryGranteeGrantsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b523d5b0b40fbb4, []int{5}
+	return fileDescriptor_6b523d5b0b40fbb4, []int{6}
 }
 func (m *QueryGranteeGrantsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,54 +505,206 @@ func (m *QueryGranteeGrantsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryExpiringGrantsRequest is the request type for the Query/ExpiringGrants
+// RPC method.
+type QueryExpiringGrantsRequest struct {
+	// expiring_before is the time the grants expire before.
+	ExpiringBefore *time.Time `protobuf:"bytes,1,opt,name=expiring_before,json=expiringBefore,proto3,stdtime" json:"expiring_before,omitempty"`
+	// granter, if set, only matches grants granted by this address.
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	// active_only excludes the grants that have already expired.
+	ActiveOnly bool `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	// pagination defines pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringGrantsRequest) Reset()         { *m = QueryExpiringGrantsRequest{} }
+func (m *QueryExpiringGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringGrantsRequest) ProtoMessage()    {}
+func (*QueryExpiringGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b523d5b0b40fbb4, []int{7}
+}
+func (m *QueryExpiringGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringGrantsRequest.Merge(m, src)
+}
+func (m *QueryExpiringGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryExpiringGrantsRequest) GetExpiringBefore() *time.Time {
+	if m != nil {
+		return m.ExpiringBefore
+	}
+	return nil
+}
+
+func (m *QueryExpiringGrantsRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryExpiringGrantsRequest) GetActiveOnly() bool {
+	if m != nil {
+		return m.ActiveOnly
+	}
+	return false
+}
+
+func (m *QueryExpiringGrantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExpiringGrantsResponse is the response type for the
+// Query/ExpiringGrants RPC method.
+type QueryExpiringGrantsResponse struct {
+	// grants is a list of grants ordered by expiration.
+	Grants []*authz.GrantAuthorization `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	// pagination defines pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringGrantsResponse) Reset()         { *m = QueryExpiringGrantsResponse{} }
+func (m *QueryExpiringGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringGrantsResponse) ProtoMessage()    {}
+func (*QueryExpiringGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b523d5b0b40fbb4, []int{8}
+}
+func (m *QueryExpiringGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringGrantsResponse.Merge(m, src)
+}
+func (m *QueryExpiringGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryExpiringGrantsResponse) GetGrants() []*authz.GrantAuthorization {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *QueryExpiringGrantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("xion.indexer.authz.v1.ExpirationStatus", ExpirationStatus_name, ExpirationStatus_value)
+	proto.RegisterType((*ExpirationFilter)(nil), "xion.indexer.authz.v1.ExpirationFilter")
 	proto.RegisterType((*QueryGrantsRequest)(nil), "xion.indexer.authz.v1.QueryGrantsRequest")
 	proto.RegisterType((*QueryGrantsResponse)(nil), "xion.indexer.authz.v1.QueryGrantsResponse")
 	proto.RegisterType((*QueryGranterGrantsRequest)(nil), "xion.indexer.authz.v1.QueryGranterGrantsRequest")
 	proto.RegisterType((*QueryGranterGrantsResponse)(nil), "xion.indexer.authz.v1.QueryGranterGrantsResponse")
 	proto.RegisterType((*QueryGranteeGrantsRequest)(nil), "xion.indexer.authz.v1.QueryGranteeGrantsRequest")
 	proto.RegisterType((*QueryGranteeGrantsResponse)(nil), "xion.indexer.authz.v1.QueryGranteeGrantsResponse")
+	proto.RegisterType((*QueryExpiringGrantsRequest)(nil), "xion.indexer.authz.v1.QueryExpiringGrantsRequest")
+	proto.RegisterType((*QueryExpiringGrantsResponse)(nil), "xion.indexer.authz.v1.QueryExpiringGrantsResponse")
 }
 
 func init() { proto.RegisterFile("xion/indexer/authz/v1/query.proto", fileDescriptor_6b523d5b0b40fbb4) }
 
 var fileDescriptor_6b523d5b0b40fbb4 = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xe3, 0x04, 0x82, 0x70, 0x61, 0x31, 0x20, 0x5d, 0x03, 0x1c, 0x21, 0x12, 0x10, 0x2a,
-	0xf5, 0xcc, 0xa5, 0x03, 0x2b, 0xcd, 0xd0, 0xae, 0x10, 0x60, 0x61, 0x89, 0x7c, 0xcd, 0xd3, 0xe5,
-	0xa4, 0xc4, 0xbe, 0xda, 0xbe, 0xa8, 0x29, 0xea, 0xc2, 0xc0, 0x8c, 0xd4, 0x81, 0x8f, 0xc0, 0xc4,
-	0xc6, 0x87, 0x60, 0xac, 0x60, 0x61, 0x44, 0x09, 0x62, 0xe6, 0x23, 0xa0, 0xd8, 0x8e, 0xd2, 0x94,
-	0xa3, 0x4d, 0xa9, 0x2a, 0xb1, 0xc5, 0xb9, 0xff, 0xff, 0xbd, 0xdf, 0xfb, 0xfb, 0xec, 0xc3, 0x77,
-	0x77, 0x12, 0xc1, 0x69, 0xc2, 0x3b, 0xb0, 0x03, 0x92, 0xb2, 0x4c, 0x77, 0x77, 0xe9, 0x20, 0xa4,
-	0xdb, 0x19, 0xc8, 0x61, 0x90, 0x4a, 0xa1, 0x05, 0xb9, 0x31, 0x91, 0x04, 0x4e, 0x12, 0x18, 0x49,
-	0x30, 0x08, 0x2b, 0xb7, 0x62, 0x21, 0xe2, 0x1e, 0x50, 0x96, 0x26, 0x94, 0x71, 0x2e, 0x34, 0xd3,
-	0x89, 0xe0, 0xca, 0x9a, 0x2a, 0x2b, 0x5b, 0x42, 0xf5, 0x85, 0xa2, 0x11, 0x53, 0x60, 0xab, 0xd1,
-	0x41, 0x18, 0x81, 0x66, 0x21, 0x4d, 0x59, 0x9c, 0x70, 0x23, 0x76, 0xda, 0xaa, 0xd3, 0x4e, 0xbb,
-	0x5b, 0x99, 0x6d, 0x64, 0x15, 0xcb, 0x56, 0xd1, 0x36, 0x2b, 0x6a, 0x17, 0xf6, 0x51, 0xed, 0x27,
-	0xc2, 0xe4, 0xd9, 0xa4, 0xfe, 0xa6, 0x64, 0x5c, 0xab, 0x16, 0x6c, 0x67, 0xa0, 0x34, 0x69, 0xe0,
-	0x4b, 0xf1, 0xe4, 0x0f, 0x90, 0x1e, 0xaa, 0xa2, 0xfa, 0xe5, 0xa6, 0xf7, 0xe5, 0xd3, 0xea, 0x75,
-	0xe7, 0x5c, 0xef, 0x74, 0x24, 0x28, 0xf5, 0x5c, 0xcb, 0x84, 0xc7, 0xad, 0xa9, 0x70, 0xe6, 0x01,
-	0xaf, 0xb8, 0x98, 0x07, 0x48, 0x15, 0x5f, 0xe9, 0xab, 0xb8, 0xad, 0x87, 0x29, 0xb4, 0x33, 0xd9,
-	0xf3, 0x4a, 0x13, 0x63, 0x0b, 0xf7, 0x55, 0xfc, 0x62, 0x98, 0xc2, 0x4b, 0xd9, 0x23, 0x1b, 0x18,
-	0xcf, 0x26, 0xf6, 0x2e, 0x54, 0x51, 0x7d, 0xa9, 0x71, 0x3f, 0x70, 0x55, 0x27, 0xf1, 0x04, 0x36,
-	0x6c, 0x37, 0x77, 0xf0, 0x94, 0xc5, 0xe0, 0xa6, 0x68, 0x1d, 0x72, 0xd6, 0xf6, 0x11, 0xbe, 0x36,
-	0x37, 0xa8, 0x4a, 0x05, 0x57, 0x40, 0xd6, 0x70, 0xd9, 0xc0, 0x28, 0x0f, 0x55, 0x4b, 0xf5, 0xa5,
-	0xc6, 0xcd, 0x69, 0xed, 0xe9, 0x4e, 0xd9, 0xb2, 0xc6, 0xd5, 0x72, 0x52, 0xb2, 0x39, 0x07, 0x55,
-	0x34, 0x50, 0x0f, 0x4e, 0x84, 0xb2, 0x1d, 0xe7, 0xa8, 0xde, 0x23, 0xbc, 0x3c, 0xa3, 0x02, 0x79,
-	0xf6, 0x5d, 0xd8, 0xc8, 0x41, 0xfb, 0x97, 0xbc, 0x3e, 0x20, 0x5c, 0xc9, 0x23, 0x73, 0xb1, 0x3d,
-	0x39, 0x12, 0x5b, 0xfd, 0x98, 0xd8, 0xd6, 0x33, 0xdd, 0x15, 0x32, 0xd9, 0x35, 0x85, 0xcf, 0x3d,
-	0x43, 0xf8, 0x4b, 0x86, 0xb0, 0x68, 0x86, 0x70, 0x5e, 0x19, 0xc2, 0x7f, 0x9b, 0x61, 0xe3, 0x57,
-	0x09, 0x5f, 0x34, 0xa4, 0xe4, 0x2d, 0xc2, 0x65, 0xcb, 0x49, 0x1e, 0x06, 0xb9, 0x57, 0x57, 0xf0,
-	0xe7, 0x7d, 0x51, 0x59, 0x59, 0x44, 0x6a, 0xfb, 0xd6, 0xee, 0xbd, 0xf9, 0xfa, 0x63, 0xbf, 0x78,
-	0x87, 0xdc, 0xa6, 0xf9, 0x97, 0xa7, 0x9b, 0xed, 0x23, 0xc2, 0x57, 0xe7, 0xde, 0x3d, 0xf2, 0xe8,
-	0xc4, 0x26, 0x47, 0x0e, 0x50, 0x25, 0x3c, 0x85, 0xc3, 0xd1, 0x3d, 0x36, 0x74, 0x21, 0xa1, 0xc7,
-	0xd2, 0x51, 0x77, 0xdc, 0xe8, 0x6b, 0xf7, 0x63, 0xef, 0x10, 0x2f, 0x2c, 0xce, 0x0b, 0xa7, 0xe6,
-	0x85, 0xb3, 0xf0, 0xc2, 0x94, 0x17, 0xf6, 0x9a, 0xcd, 0xcf, 0x23, 0x1f, 0x1d, 0x8c, 0x7c, 0xf4,
-	0x7d, 0xe4, 0xa3, 0x77, 0x63, 0xbf, 0x70, 0x30, 0xf6, 0x0b, 0xdf, 0xc6, 0x7e, 0xe1, 0x55, 0x3d,
-	0x4e, 0x74, 0x37, 0x8b, 0x82, 0x2d, 0xd1, 0xa7, 0x51, 0x26, 0xb9, 0x5e, 0xed, 0xb1, 0x48, 0xe5,
-	0xd4, 0x8f, 0xca, 0xe6, 0x23, 0xb2, 0xf6, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x20, 0x20, 0xd0,
-	0x07, 0x07, 0x00, 0x00,
+	// 840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x4e, 0x63, 0xca, 0x04, 0x82, 0x35, 0x14, 0xc9, 0x71, 0x1b, 0xdb, 0xb1, 0x04,
+	0x35, 0x91, 0xba, 0x83, 0xdd, 0x03, 0x47, 0xb0, 0x5b, 0xbb, 0xf2, 0xa1, 0x6d, 0x58, 0x3b, 0x08,
+	0x71, 0xb1, 0x76, 0x93, 0xd7, 0xcd, 0x4a, 0xf6, 0xce, 0x76, 0x67, 0xd6, 0x8a, 0x8b, 0x7a, 0xe1,
+	0x80, 0x38, 0x56, 0xca, 0x17, 0x40, 0x5c, 0xe0, 0xc2, 0x0d, 0xbe, 0x43, 0x8e, 0x11, 0x5c, 0x38,
+	0x05, 0x94, 0x20, 0x3e, 0x07, 0xda, 0x99, 0xd9, 0xd8, 0x4e, 0x36, 0x89, 0x83, 0x41, 0x4a, 0x6f,
+	0xde, 0xd9, 0xff, 0x7b, 0xf3, 0x7b, 0xef, 0xbf, 0xf3, 0xc6, 0x78, 0x6d, 0xd7, 0x65, 0x1e, 0x75,
+	0xbd, 0x6d, 0xd8, 0x85, 0x80, 0x5a, 0xa1, 0xd8, 0x79, 0x41, 0x87, 0x55, 0xfa, 0x3c, 0x84, 0x60,
+	0x64, 0xf8, 0x01, 0x13, 0x8c, 0xbc, 0x17, 0x49, 0x0c, 0x2d, 0x31, 0xa4, 0xc4, 0x18, 0x56, 0xf3,
+	0x77, 0x1c, 0xc6, 0x9c, 0x3e, 0x50, 0xcb, 0x77, 0xa9, 0xe5, 0x79, 0x4c, 0x58, 0xc2, 0x65, 0x1e,
+	0x57, 0x41, 0xf9, 0xf5, 0x2d, 0xc6, 0x07, 0x8c, 0x53, 0xdb, 0xe2, 0xa0, 0xb2, 0xd1, 0x61, 0xd5,
+	0x06, 0x61, 0x55, 0xa9, 0x6f, 0x39, 0xae, 0x27, 0xc5, 0x5a, 0x5b, 0xd2, 0xda, 0x78, 0x77, 0x25,
+	0x53, 0x1b, 0x29, 0xc5, 0x8a, 0x52, 0xf4, 0xe4, 0x13, 0x55, 0x0f, 0xfa, 0xd5, 0x2d, 0x87, 0x39,
+	0x4c, 0xad, 0x47, 0xbf, 0xf4, 0x6a, 0x51, 0xc3, 0xc9, 0x27, 0x3b, 0x7c, 0x46, 0x85, 0x3b, 0x00,
+	0x2e, 0xac, 0x81, 0xaf, 0x04, 0xe5, 0xef, 0x11, 0xce, 0x36, 0x77, 0x7d, 0x37, 0x90, 0x20, 0x2d,
+	0xb7, 0x2f, 0x20, 0x20, 0x9f, 0xe0, 0x0c, 0x17, 0x96, 0x08, 0x79, 0x0e, 0x95, 0x50, 0x65, 0xb9,
+	0x76, 0xd7, 0x48, 0x2c, 0xdd, 0x18, 0x07, 0x76, 0xa4, 0xdc, 0xd4, 0x61, 0xe4, 0x31, 0x7e, 0x07,
+	0xa2, 0x77, 0xae, 0xe7, 0xf4, 0x6c, 0x78, 0xc6, 0x02, 0xc8, 0xa5, 0x4b, 0xa8, 0xb2, 0x54, 0xcb,
+	0x1b, 0x0a, 0xc8, 0x88, 0x81, 0x8c, 0x6e, 0x0c, 0xd4, 0xb8, 0xb9, 0x7f, 0x58, 0x44, 0xaf, 0xfe,
+	0x28, 0x22, 0x73, 0x39, 0x0e, 0x6e, 0xc8, 0xd8, 0xf2, 0x2f, 0x69, 0x4c, 0x3e, 0x8b, 0x7a, 0xf7,
+	0x28, 0xb0, 0x3c, 0xc1, 0x4d, 0x78, 0x1e, 0x02, 0x17, 0xa4, 0x86, 0xdf, 0x70, 0xa2, 0x05, 0x08,
+	0x24, 0xe7, 0x9b, 0x8d, 0xdc, 0xaf, 0x3f, 0xdf, 0xbb, 0xa5, 0xbb, 0x52, 0xdf, 0xde, 0x0e, 0x80,
+	0xf3, 0x8e, 0x88, 0xf2, 0x98, 0xb1, 0x70, 0x1c, 0xa3, 0x88, 0x66, 0x88, 0x01, 0x52, 0xc2, 0x6f,
+	0x0d, 0xb8, 0xd3, 0x13, 0x23, 0x1f, 0x7a, 0x61, 0xd0, 0xcf, 0x2d, 0x44, 0x81, 0x26, 0x1e, 0x70,
+	0xa7, 0x3b, 0xf2, 0x61, 0x33, 0xe8, 0x93, 0x16, 0xc6, 0x63, 0x37, 0x73, 0x37, 0x64, 0xa9, 0x1f,
+	0x18, 0x3a, 0x6b, 0x64, 0xbd, 0xa1, 0x3e, 0x24, 0xed, 0xa9, 0xb1, 0x61, 0x39, 0xa0, 0xab, 0x30,
+	0x27, 0x22, 0xc9, 0x63, 0x8c, 0xe1, 0xa4, 0xa7, 0xb9, 0x45, 0x99, 0xe7, 0xf2, 0xe6, 0x2b, 0xd7,
+	0x1a, 0x37, 0xf6, 0x0f, 0x8b, 0x29, 0x73, 0x22, 0x41, 0x79, 0x0f, 0xe1, 0x77, 0xa7, 0xfa, 0xc6,
+	0x7d, 0xe6, 0x71, 0x20, 0xf7, 0x71, 0x46, 0xd6, 0x16, 0xf9, 0xbb, 0x50, 0x59, 0xaa, 0xdd, 0x8e,
+	0x51, 0xe3, 0xe4, 0x8a, 0x52, 0x46, 0x99, 0x5a, 0x4a, 0x1e, 0x4d, 0xd5, 0x98, 0xd6, 0x6c, 0x97,
+	0xd5, 0xa8, 0x76, 0x9c, 0x2c, 0xb2, 0xfc, 0x37, 0xc2, 0x2b, 0x63, 0x2a, 0x08, 0xe6, 0x37, 0xb5,
+	0x95, 0x80, 0x36, 0x7f, 0xfb, 0x17, 0xe6, 0x6d, 0xff, 0x0f, 0x08, 0xe7, 0x93, 0x0a, 0xd5, 0x2e,
+	0x7c, 0x7a, 0xca, 0x85, 0xca, 0x05, 0x2e, 0xd4, 0x43, 0xb1, 0xc3, 0x02, 0xf7, 0x85, 0x4c, 0xfc,
+	0xbf, 0x5b, 0x02, 0xe7, 0x58, 0x02, 0xb3, 0x5a, 0x02, 0xaf, 0x89, 0x25, 0x70, 0x7d, 0x2d, 0xf9,
+	0x36, 0xad, 0x49, 0x9b, 0x7a, 0x16, 0x4e, 0x7b, 0x92, 0x30, 0x61, 0xd1, 0xbf, 0x9f, 0xb0, 0x93,
+	0xa7, 0x2e, 0x3d, 0xeb, 0xa9, 0x2b, 0xe2, 0x25, 0x6b, 0x4b, 0xb8, 0x43, 0xe8, 0x31, 0xaf, 0x3f,
+	0x92, 0xde, 0xdc, 0x34, 0xb1, 0x5a, 0x7a, 0xea, 0xf5, 0x47, 0xff, 0xd5, 0x54, 0x2c, 0xff, 0x88,
+	0xf0, 0xed, 0xc4, 0x56, 0x5c, 0x3b, 0xd7, 0xd6, 0x03, 0x9c, 0x3d, 0x7d, 0x29, 0x92, 0x35, 0xbc,
+	0xda, 0xfc, 0x62, 0xa3, 0x6d, 0xd6, 0xbb, 0xed, 0xa7, 0x4f, 0x7a, 0x9d, 0x6e, 0xbd, 0xbb, 0xd9,
+	0xe9, 0x6d, 0x3e, 0xe9, 0x6c, 0x34, 0x1f, 0xb4, 0x5b, 0xed, 0xe6, 0xc3, 0x6c, 0x8a, 0xdc, 0xc1,
+	0xb9, 0xb3, 0x92, 0xfa, 0x83, 0x6e, 0xfb, 0xf3, 0x66, 0x16, 0x91, 0x55, 0xbc, 0x72, 0xf6, 0xad,
+	0x5c, 0x69, 0x3e, 0xcc, 0xa6, 0x6b, 0x7b, 0x8b, 0x78, 0x51, 0xb6, 0x87, 0x7c, 0x83, 0x70, 0x46,
+	0xf5, 0x86, 0x7c, 0x78, 0xce, 0x19, 0x39, 0x7b, 0x8d, 0xe6, 0xd7, 0x67, 0x91, 0xaa, 0x5a, 0xcb,
+	0xef, 0x7f, 0xfd, 0xdb, 0x5f, 0x7b, 0xe9, 0x22, 0x59, 0xa5, 0xc9, 0xff, 0x97, 0x74, 0x3f, 0x7f,
+	0x42, 0xf8, 0xed, 0xa9, 0xa1, 0x47, 0x3e, 0xba, 0x74, 0x93, 0x53, 0x17, 0x41, 0xbe, 0x7a, 0x85,
+	0x08, 0x4d, 0xf7, 0xb1, 0xa4, 0xab, 0x12, 0x7a, 0x21, 0x1d, 0xd5, 0x1f, 0x30, 0xfd, 0x4a, 0xff,
+	0x78, 0x39, 0xc1, 0x0b, 0xb3, 0xf3, 0xc2, 0x95, 0x79, 0x61, 0x1e, 0x5e, 0x88, 0x79, 0xe1, 0x25,
+	0xf9, 0x0e, 0xe1, 0xe5, 0xe9, 0xc3, 0x40, 0x2e, 0xdc, 0x3e, 0x71, 0x86, 0xe4, 0x6b, 0x57, 0x09,
+	0xd1, 0xc8, 0x77, 0x25, 0xf2, 0x1a, 0x29, 0x9e, 0x83, 0x7c, 0x32, 0x57, 0x1a, 0xfb, 0x47, 0x05,
+	0x74, 0x70, 0x54, 0x40, 0x7f, 0x1e, 0x15, 0xd0, 0xab, 0xe3, 0x42, 0xea, 0xe0, 0xb8, 0x90, 0xfa,
+	0xfd, 0xb8, 0x90, 0xfa, 0xb2, 0xe2, 0xb8, 0x62, 0x27, 0xb4, 0x8d, 0x2d, 0x36, 0xa0, 0x76, 0x18,
+	0x78, 0xe2, 0x5e, 0xdf, 0xb2, 0x79, 0x42, 0x3e, 0x3b, 0x23, 0x67, 0xd8, 0xfd, 0x7f, 0x06, 0x00,
+	0x7c, 0xaf, 0x3d, 0x08, 0x9d, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -457,6 +729,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// ExpiringGrants returns a list of `GrantAuthorization` that expire before a
+	// time, ordered by expiration.
+	ExpiringGrants(ctx context.Context, in *QueryExpiringGrantsRequest, opts ...grpc.CallOption) (*QueryExpiringGrantsResponse, error)
 }
 
 type queryClient struct {
@@ -494,6 +769,15 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) ExpiringGrants(ctx context.Context, in *QueryExpiringGrantsRequest, opts ...grpc.CallOption) (*QueryExpiringGrantsResponse, error) {
+	out := new(QueryExpiringGrantsResponse)
+	err := c.cc.Invoke(ctx, "/xion.indexer.authz.v1.Query/ExpiringGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns list of `Authorization`, granted to the grantee by the granter.
@@ -506,6 +790,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// ExpiringGrants returns a list of `GrantAuthorization` that expire before a
+	// time, ordered by expiration.
+	ExpiringGrants(context.Context, *QueryExpiringGrantsRequest) (*QueryExpiringGrantsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -521,6 +808,9 @@ func (*UnimplementedQueryServer) GranterGrants(ctx context.Context, req *QueryGr
 func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (*UnimplementedQueryServer) ExpiringGrants(ctx context.Context, req *QueryExpiringGrantsRequest) (*QueryExpiringGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringGrants not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -580,6 +870,24 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExpiringGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.indexer.authz.v1.Query/ExpiringGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringGrants(ctx, req.(*QueryExpiringGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "xion.indexer.authz.v1.Query",
@@ -597,11 +905,53 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "ExpiringGrants",
+			Handler:    _Query_ExpiringGrants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xion/indexer/authz/v1/query.proto",
 }
 
+func (m *ExpirationFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpirationFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpirationFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiringBefore != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiringBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiringBefore):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -622,6 +972,16 @@ func (m *QueryGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -727,6 +1087,16 @@ func (m *QueryGranterGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -818,6 +1188,16 @@ func (m *QueryGranteeGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -889,54 +1269,183 @@ func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryExpiringGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGrantsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryExpiringGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGrantsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryExpiringGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Grants) > 0 {
-		for _, e := range m.Grants {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActiveOnly {
+		i--
+		if m.ActiveOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ExpiringBefore != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiringBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiringBefore):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintQuery(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExpirationFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.ExpiringBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiringBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Expiration.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -958,6 +1467,8 @@ func (m *QueryGranterGrantsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = m.Expiration.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -994,6 +1505,8 @@ func (m *QueryGranteeGrantsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = m.Expiration.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1013,16 +1526,379 @@ func (m *QueryGranteeGrantsResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryExpiringGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiringBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiringBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActiveOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExpirationFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpirationFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpirationFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ExpirationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiringBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiringBefore == nil {
+				m.ExpiringBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiringBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1045,17 +1921,17 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGrantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1065,29 +1941,31 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Grants = append(m.Grants, &authz.Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1097,27 +1975,81 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGranterGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGranterGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1145,9 +2077,9 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1183,6 +2115,39 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1204,7 +2169,7 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1227,10 +2192,10 @@ func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGrantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranterGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranterGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1262,7 +2227,7 @@ func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, &authz.Grant{})
+			m.Grants = append(m.Grants, &authz.GrantAuthorization{})
 			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1324,7 +2289,7 @@ func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1347,15 +2312,15 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranterGrantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranteeGrantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranterGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranteeGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1383,7 +2348,7 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1421,6 +2386,39 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1442,7 +2440,7 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1465,10 +2463,10 @@ func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranterGrantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranteeGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranterGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranteeGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1562,7 +2560,7 @@ func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExpiringGrantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1585,15 +2583,51 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranteeGrantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpiringGrantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranteeGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpiringGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiringBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiringBefore == nil {
+				m.ExpiringBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiringBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1621,9 +2655,29 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveOnly = bool(v != 0)
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1680,7 +2734,7 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExpiringGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1703,10 +2757,10 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranteeGrantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpiringGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranteeGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpiringGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
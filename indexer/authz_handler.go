@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"context"
+	"time"
 	"unsafe"
 
 	"cosmossdk.io/collections"
@@ -12,15 +13,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
+
+	indexerstream "github.com/burnt-labs/xion/indexer/stream"
 )
 
 var (
 	AuthzPrefix             = collections.NewPrefix(0)
 	AuthzGranteeIndexPrefix = collections.NewPrefix(1)
+	AuthzExpirationPrefix   = collections.NewPrefix(2)
 )
 
 type authzIndexes struct {
-	Grantee *indexes.Multi[sdk.AccAddress, collections.Triple[sdk.AccAddress, sdk.AccAddress, string], authz.Grant]
+	Grantee    *indexes.Multi[sdk.AccAddress, collections.Triple[sdk.AccAddress, sdk.AccAddress, string], authz.Grant]
+	Expiration *ExpirationIndex[collections.Triple[sdk.AccAddress, sdk.AccAddress, string], authz.Grant]
 }
 
 type AuthzHandler struct {
@@ -29,8 +34,11 @@ type AuthzHandler struct {
 	Schema         collections.Schema
 	// key: (granter, grantee)
 	// value: authorization
-	// secondary index: grantee
+	// secondary indexes: grantee, expiration
 	Authorizations *collections.IndexedMap[collections.Triple[sdk.AccAddress, sdk.AccAddress, string], authz.Grant, authzIndexes]
+
+	clock    blockClock
+	listener GrantChangeListener
 }
 
 func newAuthzIndexes(sb *collections.SchemaBuilder) authzIndexes {
@@ -49,8 +57,19 @@ func newAuthzIndexes(sb *collections.SchemaBuilder) authzIndexes {
 		},
 	)
 
+	indexByExpiration := NewExpirationIndex(
+		sb,
+		AuthzExpirationPrefix,
+		"authz_by_expiration",
+		collections.TripleKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey, collections.StringKey),
+		func(_ collections.Triple[sdk.AccAddress, sdk.AccAddress, string], value authz.Grant) *time.Time {
+			return value.Expiration
+		},
+	)
+
 	return authzIndexes{
-		Grantee: indexByGrantee,
+		Grantee:    indexByGrantee,
+		Expiration: indexByExpiration,
 	}
 }
 
@@ -92,17 +111,28 @@ func (ah *AuthzHandler) SetGrant(ctx context.Context, granter, grantee sdk.AccAd
 	return ah.Authorizations.Set(ctx, key, grant)
 }
 
+// SetListener sets the listener of the grant changes applied from the change
+// sets.
+func (ah *AuthzHandler) SetListener(listener GrantChangeListener) {
+	ah.listener = listener
+}
+
 func (ah *AuthzHandler) HandleUpdate(ctx context.Context, pair *storetypes.StoreKVPair) error {
 	granterAddr, granteeAddr, msgType := parseGrantStoreKey(pair.Key)
+	key := collections.Join3(granterAddr, granteeAddr, msgType)
 	if pair.Delete {
-		if has, err := ah.Authorizations.Has(ctx, collections.Join3(granterAddr, granteeAddr, msgType)); err != nil {
+		if has, err := ah.Authorizations.Has(ctx, key); err != nil {
 			return err
 		} else if !has {
 			// Graceful handling: deleting a non-existent grant is a no-op, not an error
 			// This ensures the indexer remains robust during edge cases
 			return nil
 		}
-		return ah.Authorizations.Remove(ctx, collections.Join3(granterAddr, granteeAddr, msgType))
+		if err := ah.Authorizations.Remove(ctx, key); err != nil {
+			return err
+		}
+		ah.grantChanged(ctx, indexerstream.GrantChangeOp_GRANT_CHANGE_OP_DELETE, granterAddr, granteeAddr, msgType, nil)
+		return nil
 	}
 
 	grant := authz.Grant{}
@@ -110,7 +140,63 @@ func (ah *AuthzHandler) HandleUpdate(ctx context.Context, pair *storetypes.Store
 	if err != nil {
 		return err
 	}
-	return ah.SetGrant(ctx, granterAddr, granteeAddr, msgType, grant)
+	op, err := ah.setOp(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := ah.SetGrant(ctx, granterAddr, granteeAddr, msgType, grant); err != nil {
+		return err
+	}
+	ah.grantChanged(ctx, op, granterAddr, granteeAddr, msgType, &grant)
+	return nil
+}
+
+// setOp returns whether setting the grant at key creates or updates it, the
+// index is only read when a listener is set.
+func (ah *AuthzHandler) setOp(ctx context.Context, key collections.Triple[sdk.AccAddress, sdk.AccAddress, string]) (indexerstream.GrantChangeOp, error) {
+	if ah.listener == nil {
+		return indexerstream.GrantChangeOp_GRANT_CHANGE_OP_UNSPECIFIED, nil
+	}
+	has, err := ah.Authorizations.Has(ctx, key)
+	if err != nil {
+		return indexerstream.GrantChangeOp_GRANT_CHANGE_OP_UNSPECIFIED, err
+	}
+	if has {
+		return indexerstream.GrantChangeOp_GRANT_CHANGE_OP_UPDATE, nil
+	}
+	return indexerstream.GrantChangeOp_GRANT_CHANGE_OP_CREATE, nil
+}
+
+func (ah *AuthzHandler) grantChanged(ctx context.Context, op indexerstream.GrantChangeOp, granter, grantee sdk.AccAddress, msgType string, grant *authz.Grant) {
+	if ah.listener != nil {
+		ah.listener.AuthzGrantChanged(ctx, op, granter, grantee, msgType, grant)
+	}
+}
+
+// Now returns the time grant expiration is evaluated at, the time of the
+// latest indexed block.
+func (ah *AuthzHandler) Now() time.Time {
+	return ah.clock.Now()
+}
+
+// PruneExpired records blockTime as the time of the latest indexed block and,
+// if prune is set, removes up to MaxPrunedGrantsPerBlock grants that expired
+// before it.
+func (ah *AuthzHandler) PruneExpired(ctx context.Context, blockTime time.Time, prune bool) error {
+	ah.clock.Set(blockTime)
+	if !prune || blockTime.IsZero() {
+		return nil
+	}
+	expired, err := ah.Authorizations.Indexes.Expiration.Expired(ctx, blockTime, MaxPrunedGrantsPerBlock)
+	if err != nil {
+		return err
+	}
+	for _, key := range expired {
+		if err := ah.Authorizations.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // parseGrantStoreKey - split granter, grantee address and msg type from the authorization key
@@ -3,6 +3,7 @@ package indexer
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/gogo/status"
 	"google.golang.org/grpc/codes"
@@ -178,10 +179,13 @@ func (aq *authzQuerier) Grants(ctx context.Context, req *indexerauthz.QueryGrant
 		opts = append(opts, prefixOpt)
 	}
 
-	grants, pageRes, err := query.CollectionPaginate(
+	grants, pageRes, err := query.CollectionFilteredPaginate(
 		ctx,
 		aq.authzHandler.Authorizations,
 		req.Pagination,
+		func(_ collections.Triple[sdk.AccAddress, sdk.AccAddress, string], grant authz.Grant) (bool, error) {
+			return aq.matchesExpiration(grant.Expiration, req.Expiration), nil
+		},
 		func(primaryKey collections.Triple[sdk.AccAddress, sdk.AccAddress, string], grant authz.Grant) (*authz.Grant, error) {
 			return &grant, nil
 		},
@@ -206,10 +210,13 @@ func (aq *authzQuerier) GranterGrants(ctx context.Context, req *indexerauthz.Que
 	granterAddr := sdk.AccAddress(granter)
 	slog.Debug("authz_querier", "step", "1")
 
-	grants, pageRes, err := query.CollectionPaginate(
+	grants, pageRes, err := query.CollectionFilteredPaginate(
 		ctx,
 		aq.authzHandler.Authorizations,
 		req.Pagination,
+		func(_ collections.Triple[sdk.AccAddress, sdk.AccAddress, string], grant authz.Grant) (bool, error) {
+			return aq.matchesExpiration(grant.Expiration, req.Expiration), nil
+		},
 		func(primaryKey collections.Triple[sdk.AccAddress, sdk.AccAddress, string], grant authz.Grant) (*authz.GrantAuthorization, error) {
 			auth1, err := grant.GetAuthorization()
 			if err != nil {
@@ -248,6 +255,31 @@ func (aq *authzQuerier) GranterGrants(ctx context.Context, req *indexerauthz.Que
 	}, nil
 }
 
+// matchesExpiration returns true if a grant with expiration matches filter at
+// the time of the latest indexed block.
+func (aq *authzQuerier) matchesExpiration(expiration *time.Time, filter indexerauthz.ExpirationFilter) bool {
+	return MatchesExpiration(
+		expiration,
+		filter.Status == indexerauthz.ExpirationStatus_EXPIRATION_STATUS_ACTIVE,
+		filter.Status == indexerauthz.ExpirationStatus_EXPIRATION_STATUS_EXPIRED,
+		filter.ExpiringBefore,
+		aq.authzHandler.Now(),
+	)
+}
+
+// grantMatches returns true if the grant stored at primaryKey matches filter,
+// the grant is only fetched if filter is set.
+func (aq *authzQuerier) grantMatches(ctx context.Context, primaryKey collections.Triple[sdk.AccAddress, sdk.AccAddress, string], filter indexerauthz.ExpirationFilter) (bool, error) {
+	if filter.Status == indexerauthz.ExpirationStatus_EXPIRATION_STATUS_UNSPECIFIED && filter.ExpiringBefore == nil {
+		return true, nil
+	}
+	grant, err := aq.authzHandler.Authorizations.Get(ctx, primaryKey)
+	if err != nil {
+		return false, err
+	}
+	return aq.matchesExpiration(grant.Expiration, filter), nil
+}
+
 func WithCollectionPaginationTriplePrefix[K1, K2, K3 any](prefix K1) func(o *query.CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
 	return func(o *query.CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
 		prefix := collections.TriplePrefix[K1, K2, K3](prefix)
@@ -333,9 +365,19 @@ func (aq *authzQuerier) GranteeGrants(ctx context.Context, req *indexerauthz.Que
 				}
 			}
 		} else if offset > 0 {
-			// Skip offset items
-			for i := uint64(0); i < offset && iter.Valid(); i++ {
-				iter.Next()
+			// Skip offset items matching the expiration filter
+			for skipped := uint64(0); skipped < offset && iter.Valid(); iter.Next() {
+				primaryKey, err := iter.PrimaryKey()
+				if err != nil {
+					return nil, err
+				}
+				matches, err := aq.grantMatches(ctx, primaryKey, req.Expiration)
+				if err != nil {
+					return nil, err
+				}
+				if matches {
+					skipped++
+				}
 			}
 		}
 	}
@@ -352,6 +394,9 @@ func (aq *authzQuerier) GranteeGrants(ctx context.Context, req *indexerauthz.Que
 		if err != nil {
 			return nil, err
 		}
+		if !aq.matchesExpiration(grant.Expiration, req.Expiration) {
+			continue
+		}
 
 		auth, err := grant.GetAuthorization()
 		if err != nil {
@@ -397,7 +442,17 @@ func (aq *authzQuerier) GranteeGrants(ctx context.Context, req *indexerauthz.Que
 	// Count remaining if countTotal is requested
 	if countTotal {
 		for ; iter.Valid(); iter.Next() {
-			total++
+			primaryKey, err := iter.PrimaryKey()
+			if err != nil {
+				return nil, err
+			}
+			matches, err := aq.grantMatches(ctx, primaryKey, req.Expiration)
+			if err != nil {
+				return nil, err
+			}
+			if matches {
+				total++
+			}
 		}
 	}
 
@@ -475,6 +530,9 @@ func (aq *authzQuerier) granteeGrantsWithRawIteration(
 		if err != nil {
 			return nil, err
 		}
+		if !aq.matchesExpiration(grant.Expiration, req.Expiration) {
+			continue
+		}
 
 		auth, err := grant.GetAuthorization()
 		if err != nil {
@@ -525,7 +583,14 @@ func (aq *authzQuerier) granteeGrantsWithRawIteration(
 				return nil, err
 			}
 			// Only count entries for this grantee
-			if key.K1().Equals(granteeAddr) {
+			if !key.K1().Equals(granteeAddr) {
+				continue
+			}
+			matches, err := aq.grantMatches(ctx, key.K2(), req.Expiration)
+			if err != nil {
+				return nil, err
+			}
+			if matches {
 				total++
 			}
 		}
@@ -544,3 +609,51 @@ func (aq *authzQuerier) granteeGrantsWithRawIteration(
 		Pagination: pageRes,
 	}, nil
 }
+
+func (aq *authzQuerier) ExpiringGrants(ctx context.Context, req *indexerauthz.QueryExpiringGrantsRequest) (*indexerauthz.QueryExpiringGrantsResponse, error) {
+	if req.ExpiringBefore == nil {
+		return nil, status.Error(codes.InvalidArgument, "expiring_before is required")
+	}
+	var granterAddr sdk.AccAddress
+	if req.Granter != "" {
+		granter, err := aq.addrCodec.StringToBytes(req.Granter)
+		if err != nil {
+			return nil, err
+		}
+		granterAddr = sdk.AccAddress(granter)
+	}
+
+	expirations := expirationRangeCollection[collections.Triple[sdk.AccAddress, sdk.AccAddress, string], authz.Grant]{
+		index: aq.authzHandler.Authorizations.Indexes.Expiration,
+		until: *req.ExpiringBefore,
+	}
+	if req.ActiveOnly {
+		now := aq.authzHandler.Now()
+		expirations.from = &now
+	}
+
+	grants, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		expirations,
+		req.Pagination,
+		// key is (expiration, (granter, grantee, msgType))
+		func(key collections.Pair[time.Time, collections.Triple[sdk.AccAddress, sdk.AccAddress, string]], _ collections.NoValue) (bool, error) {
+			return granterAddr == nil || key.K2().K1().Equals(granterAddr), nil
+		},
+		func(key collections.Pair[time.Time, collections.Triple[sdk.AccAddress, sdk.AccAddress, string]], _ collections.NoValue) (*authz.GrantAuthorization, error) {
+			grant, err := aq.authzHandler.Authorizations.Get(ctx, key.K2())
+			if err != nil {
+				return nil, err
+			}
+			return TransformGrantToAuthorization(key.K2(), grant, aq.cdc, aq.addrCodec)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("authz_querier", "expiring_grants", len(grants))
+	return &indexerauthz.QueryExpiringGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
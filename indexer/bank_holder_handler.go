@@ -0,0 +1,338 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	core "cosmossdk.io/collections/corecompat"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	BankHoldersPrefix            = collections.NewPrefix(0)
+	BankHolderAddressIndexPrefix = collections.NewPrefix(1)
+	BankHoldersByBalancePrefix   = collections.NewPrefix(2)
+	BankHolderCountsPrefix       = collections.NewPrefix(3)
+	BankDenomMetadataPrefix      = collections.NewPrefix(4)
+	BankHolderHeightPrefix       = collections.NewPrefix(5)
+)
+
+// bankBalanceKeyCodec is the key codec of the bank module's balances,
+// (address, denom).
+var bankBalanceKeyCodec = collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+
+type bankHolderIndexes struct {
+	Address *indexes.ReversePair[string, sdk.AccAddress, math.Int]
+}
+
+func (b bankHolderIndexes) IndexesList() []collections.Index[collections.Pair[string, sdk.AccAddress], math.Int] {
+	return []collections.Index[collections.Pair[string, sdk.AccAddress], math.Int]{b.Address}
+}
+
+type BankHolderHandler struct {
+	kvStoreService core.KVStoreService
+	cdc            codec.Codec
+	Schema         collections.Schema
+	// key: (denom, holder)
+	// value: balance
+	// secondary index: (holder, denom)
+	Holders *collections.IndexedMap[collections.Pair[string, sdk.AccAddress], math.Int, bankHolderIndexes]
+	// key: (denom, (balance, holder)), the holders of a denom ordered by
+	// balance
+	ByBalance collections.KeySet[collections.Pair[string, collections.Pair[math.Int, sdk.AccAddress]]]
+	// key: denom
+	// value: number of holders
+	HolderCounts collections.Map[string, uint64]
+	// key: denom
+	// value: bank metadata of the denom
+	Metadata collections.Map[string, banktypes.Metadata]
+	// Height is the last height the holders were indexed at, it is behind
+	// the checkpoint while the handler was disabled.
+	Height collections.Item[int64]
+
+	enabled       bool
+	denomPrefixes []string
+}
+
+func NewBankHolderHandler(kvStoreService core.KVStoreService, cdc codec.Codec) (*BankHolderHandler, error) {
+	sb := collections.NewSchemaBuilder(kvStoreService)
+
+	holders := collections.NewIndexedMap(
+		sb,
+		BankHoldersPrefix,
+		"bank_holders", // name of the collection
+		collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey),
+		sdk.IntValue,
+		bankHolderIndexes{
+			Address: indexes.NewReversePair[math.Int](
+				sb,
+				BankHolderAddressIndexPrefix,
+				"bank_holders_by_address",
+				collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey),
+			),
+		},
+	)
+	byBalance := collections.NewKeySet(
+		sb,
+		BankHoldersByBalancePrefix,
+		"bank_holders_by_balance",
+		collections.PairKeyCodec(collections.StringKey, collections.PairKeyCodec(BalanceKey, sdk.AccAddressKey)),
+	)
+	holderCounts := collections.NewMap(sb, BankHolderCountsPrefix, "bank_holder_counts", collections.StringKey, collections.Uint64Value)
+	metadata := collections.NewMap(sb, BankDenomMetadataPrefix, "bank_denom_metadata", collections.StringKey, codec.CollValue[banktypes.Metadata](cdc))
+	height := collections.NewItem(sb, BankHolderHeightPrefix, "bank_holder_height", collections.Int64Value)
+
+	schema, err := sb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &BankHolderHandler{
+		Schema:         schema,
+		kvStoreService: kvStoreService,
+		cdc:            cdc,
+		Holders:        holders,
+		ByBalance:      byBalance,
+		HolderCounts:   holderCounts,
+		Metadata:       metadata,
+		Height:         height,
+	}, nil
+}
+
+// Configure sets whether the holders are indexed and of which denoms.
+func (bh *BankHolderHandler) Configure(cfg Config) {
+	bh.enabled = cfg.BankHolders
+	bh.denomPrefixes = cfg.BankHolderDenomPrefixes
+}
+
+// Enabled returns true if the holders are indexed.
+func (bh *BankHolderHandler) Enabled() bool {
+	return bh.enabled
+}
+
+// IsIndexed returns true if the holders of denom are indexed, all denoms are
+// indexed if no denom prefixes are configured.
+func (bh *BankHolderHandler) IsIndexed(denom string) bool {
+	if len(bh.denomPrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range bh.denomPrefixes {
+		if strings.HasPrefix(denom, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetBalance indexes the balance of holder in denom, a zero balance removes
+// the holder.
+func (bh *BankHolderHandler) SetBalance(ctx context.Context, holder sdk.AccAddress, denom string, amount math.Int) error {
+	if !bh.IsIndexed(denom) {
+		return nil
+	}
+	if !amount.IsPositive() {
+		return bh.RemoveBalance(ctx, holder, denom)
+	}
+
+	key := collections.Join(denom, holder)
+	previous, err := bh.Holders.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		if err := bh.addHolderCount(ctx, denom, 1); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		if err := bh.ByBalance.Remove(ctx, collections.Join(denom, collections.Join(previous, holder))); err != nil {
+			return err
+		}
+	}
+
+	if err := bh.ByBalance.Set(ctx, collections.Join(denom, collections.Join(amount, holder))); err != nil {
+		return err
+	}
+	return bh.Holders.Set(ctx, key, amount)
+}
+
+// RemoveBalance removes holder from the holders of denom.
+func (bh *BankHolderHandler) RemoveBalance(ctx context.Context, holder sdk.AccAddress, denom string) error {
+	key := collections.Join(denom, holder)
+	previous, err := bh.Holders.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		// Graceful handling: removing a balance that was never indexed is a no-op
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := bh.ByBalance.Remove(ctx, collections.Join(denom, collections.Join(previous, holder))); err != nil {
+		return err
+	}
+	if err := bh.addHolderCount(ctx, denom, -1); err != nil {
+		return err
+	}
+	return bh.Holders.Remove(ctx, key)
+}
+
+func (bh *BankHolderHandler) addHolderCount(ctx context.Context, denom string, delta int) error {
+	count, err := bh.HolderCounts.Get(ctx, denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if delta < 0 && count <= 1 {
+		return bh.HolderCounts.Remove(ctx, denom)
+	}
+	return bh.HolderCounts.Set(ctx, denom, uint64(int64(count)+int64(delta)))
+}
+
+// SetMetadata indexes the bank metadata of a denom.
+func (bh *BankHolderHandler) SetMetadata(ctx context.Context, metadata banktypes.Metadata) error {
+	if !bh.IsIndexed(metadata.Base) {
+		return nil
+	}
+	return bh.Metadata.Set(ctx, metadata.Base, metadata)
+}
+
+// RemoveMetadata removes the bank metadata of a denom.
+func (bh *BankHolderHandler) RemoveMetadata(ctx context.Context, denom string) error {
+	return bh.Metadata.Remove(ctx, denom)
+}
+
+// Clear removes all the holders and metadata.
+func (bh *BankHolderHandler) Clear(ctx context.Context) error {
+	if err := clearIndexedMap(ctx, bh.Holders); err != nil {
+		return err
+	}
+	if err := bh.ByBalance.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := bh.HolderCounts.Clear(ctx, nil); err != nil {
+		return err
+	}
+	return bh.Metadata.Clear(ctx, nil)
+}
+
+// Behind returns true if the holders are enabled but were not indexed up to
+// height, because the handler was disabled or never built.
+func (bh *BankHolderHandler) Behind(ctx context.Context, height int64) (bool, error) {
+	if !bh.enabled {
+		return false, nil
+	}
+	indexed, err := itemOrZero(ctx, bh.Height)
+	if err != nil {
+		return false, err
+	}
+	return indexed != height, nil
+}
+
+func (bh *BankHolderHandler) HandleUpdate(ctx context.Context, pair *storetypes.StoreKVPair) error {
+	switch {
+	case bytes.HasPrefix(pair.Key, banktypes.BalancesPrefix):
+		holder, denom, err := ParseBalanceStoreKey(pair.Key)
+		if err != nil {
+			return err
+		}
+		if pair.Delete {
+			return bh.RemoveBalance(ctx, holder, denom)
+		}
+		amount, err := banktypes.BalanceValueCodec.Decode(pair.Value)
+		if err != nil {
+			return err
+		}
+		return bh.SetBalance(ctx, holder, denom, amount)
+	case bytes.HasPrefix(pair.Key, banktypes.DenomMetadataPrefix):
+		if pair.Delete {
+			return bh.RemoveMetadata(ctx, string(pair.Key[len(banktypes.DenomMetadataPrefix):]))
+		}
+		var metadata banktypes.Metadata
+		if err := bh.cdc.Unmarshal(pair.Value, &metadata); err != nil {
+			return err
+		}
+		return bh.SetMetadata(ctx, metadata)
+	}
+	return nil
+}
+
+// ParseBalanceStoreKey splits the holder and denom from a key of the bank
+// module's balances.
+func ParseBalanceStoreKey(key []byte) (holder sdk.AccAddress, denom string, err error) {
+	if !bytes.HasPrefix(key, banktypes.BalancesPrefix) {
+		return nil, "", fmt.Errorf("invalid balance key %X", key)
+	}
+	_, pair, err := bankBalanceKeyCodec.Decode(key[len(banktypes.BalancesPrefix):])
+	if err != nil {
+		return nil, "", err
+	}
+	return pair.K1(), pair.K2(), nil
+}
+
+// balanceKeyBytes is the width of an encoded balance.
+const balanceKeyBytes = 32
+
+// BalanceKey encodes non-negative balances of up to 256 bits as fixed width
+// big endian integers, so keys are ordered by balance.
+var BalanceKey collcodec.KeyCodec[math.Int] = balanceKey{}
+
+type balanceKey struct{}
+
+func (balanceKey) Encode(buffer []byte, key math.Int) (int, error) {
+	if key.IsNil() || key.IsNegative() || key.BigIntMut().BitLen() > balanceKeyBytes*8 {
+		return 0, fmt.Errorf("balance %s out of range", key)
+	}
+	key.BigIntMut().FillBytes(buffer[:balanceKeyBytes])
+	return balanceKeyBytes, nil
+}
+
+func (balanceKey) Decode(buffer []byte) (int, math.Int, error) {
+	if len(buffer) < balanceKeyBytes {
+		return 0, math.Int{}, fmt.Errorf("%w: balance key is %d bytes", collcodec.ErrEncoding, len(buffer))
+	}
+	return balanceKeyBytes, math.NewIntFromBigInt(new(big.Int).SetBytes(buffer[:balanceKeyBytes])), nil
+}
+
+func (balanceKey) Size(math.Int) int {
+	return balanceKeyBytes
+}
+
+func (balanceKey) EncodeJSON(value math.Int) ([]byte, error) {
+	return value.MarshalJSON()
+}
+
+func (balanceKey) DecodeJSON(b []byte) (math.Int, error) {
+	var value math.Int
+	err := value.UnmarshalJSON(b)
+	return value, err
+}
+
+func (balanceKey) Stringify(key math.Int) string {
+	return key.String()
+}
+
+func (balanceKey) KeyType() string {
+	return "xion/indexer/balance"
+}
+
+func (k balanceKey) EncodeNonTerminal(buffer []byte, key math.Int) (int, error) {
+	return k.Encode(buffer, key)
+}
+
+func (k balanceKey) DecodeNonTerminal(buffer []byte) (int, math.Int, error) {
+	return k.Decode(buffer)
+}
+
+func (k balanceKey) SizeNonTerminal(key math.Int) int {
+	return k.Size(key)
+}
@@ -0,0 +1,134 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	indexerbank "github.com/burnt-labs/xion/indexer/bank"
+)
+
+type bankHolderQuerier struct {
+	bankHolderHandler *BankHolderHandler
+	cdc               codec.Codec
+	addrCodec         address.Codec
+}
+
+var _ indexerbank.QueryServer = &bankHolderQuerier{}
+
+func NewBankHolderQuerier(handler *BankHolderHandler, cdc codec.Codec, addrCodec address.Codec) indexerbank.QueryServer {
+	return &bankHolderQuerier{handler, cdc, addrCodec}
+}
+
+func (bq *bankHolderQuerier) DenomHolders(ctx context.Context, req *indexerbank.QueryDenomHoldersRequest) (*indexerbank.QueryDenomHoldersResponse, error) {
+	holders, pageRes, err := query.CollectionPaginate(
+		ctx,
+		bq.bankHolderHandler.Holders,
+		req.Pagination,
+		// iterate over the original key space (denom, holder)
+		func(key collections.Pair[string, sdk.AccAddress], amount math.Int) (*indexerbank.Holder, error) {
+			return bq.holder(key.K1(), key.K2(), amount)
+		},
+		query.WithCollectionPaginationPairPrefix[string, sdk.AccAddress](req.Denom),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("bank_holder_querier", "holders", len(holders))
+	return &indexerbank.QueryDenomHoldersResponse{
+		Holders:    holders,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TopHolders returns the holders of a denom by descending balance, the
+// reverse flag of the pagination is ignored.
+func (bq *bankHolderQuerier) TopHolders(ctx context.Context, req *indexerbank.QueryTopHoldersRequest) (*indexerbank.QueryTopHoldersResponse, error) {
+	pagination := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pagination = *req.Pagination
+	}
+	pagination.Reverse = true
+
+	holders, pageRes, err := query.CollectionPaginate(
+		ctx,
+		bq.bankHolderHandler.ByBalance,
+		pagination,
+		// key is (denom, (balance, holder))
+		func(key collections.Pair[string, collections.Pair[math.Int, sdk.AccAddress]], _ collections.NoValue) (*indexerbank.Holder, error) {
+			return bq.holder(key.K1(), key.K2().K2(), key.K2().K1())
+		},
+		query.WithCollectionPaginationPairPrefix[string, collections.Pair[math.Int, sdk.AccAddress]](req.Denom),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("bank_holder_querier", "holders", len(holders))
+	return &indexerbank.QueryTopHoldersResponse{
+		Holders:    holders,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (bq *bankHolderQuerier) HolderCount(ctx context.Context, req *indexerbank.QueryHolderCountRequest) (*indexerbank.QueryHolderCountResponse, error) {
+	count, err := bq.bankHolderHandler.HolderCounts.Get(ctx, req.Denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	return &indexerbank.QueryHolderCountResponse{Count: count}, nil
+}
+
+func (bq *bankHolderQuerier) AddressDenoms(ctx context.Context, req *indexerbank.QueryAddressDenomsRequest) (*indexerbank.QueryAddressDenomsResponse, error) {
+	holder, err := bq.addrCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, pageRes, err := query.CollectionPaginate(
+		ctx,
+		bq.bankHolderHandler.Holders.Indexes.Address,
+		req.Pagination,
+		// key is (holder, denom)
+		// value is empty because index only stores keys in a KeySet
+		func(key collections.Pair[sdk.AccAddress, string], _ collections.NoValue) (*indexerbank.DenomBalance, error) {
+			amount, err := bq.bankHolderHandler.Holders.Get(ctx, collections.Join(key.K2(), key.K1()))
+			if err != nil {
+				return nil, err
+			}
+			balance := &indexerbank.DenomBalance{Denom: key.K2(), Amount: amount}
+			metadata, err := bq.bankHolderHandler.Metadata.Get(ctx, key.K2())
+			switch {
+			case err == nil:
+				balance.Metadata = &metadata
+			case !errors.Is(err, collections.ErrNotFound):
+				return nil, err
+			}
+			return balance, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](sdk.AccAddress(holder)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("bank_holder_querier", "balances", len(balances))
+	return &indexerbank.QueryAddressDenomsResponse{
+		Balances:   balances,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (bq *bankHolderQuerier) holder(denom string, holder sdk.AccAddress, amount math.Int) (*indexerbank.Holder, error) {
+	address, err := bq.addrCodec.BytesToString(holder)
+	if err != nil {
+		return nil, err
+	}
+	return &indexerbank.Holder{Address: address, Denom: denom, Amount: amount}, nil
+}
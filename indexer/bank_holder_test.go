@@ -0,0 +1,214 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	indexerbank "github.com/burnt-labs/xion/indexer/bank"
+)
+
+type mockBankSource struct {
+	balances map[string]sdk.Coins
+	metadata []banktypes.Metadata
+}
+
+func (m mockBankSource) IterateAllBalances(_ context.Context, cb func(sdk.AccAddress, sdk.Coin) bool) {
+	for address, coins := range m.balances {
+		for _, coin := range coins {
+			if cb(sdk.AccAddress(address), coin) {
+				return
+			}
+		}
+	}
+}
+
+func (m mockBankSource) IterateAllDenomMetaData(_ context.Context, cb func(banktypes.Metadata) bool) {
+	for _, metadata := range m.metadata {
+		if cb(metadata) {
+			return
+		}
+	}
+}
+
+// balancePair creates a write of the bank module's balances.
+func balancePair(t *testing.T, holder sdk.AccAddress, denom string, amount int64) *storetypes.StoreKVPair {
+	t.Helper()
+	key, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, bankBalanceKeyCodec, collections.Join(holder, denom))
+	require.NoError(t, err)
+	value, err := banktypes.BalanceValueCodec.Encode(math.NewInt(amount))
+	require.NoError(t, err)
+	return &storetypes.StoreKVPair{
+		StoreKey: banktypes.StoreKey,
+		Key:      key,
+		Value:    value,
+		Delete:   amount == 0,
+	}
+}
+
+func TestBalanceKey(t *testing.T) {
+	amounts := []math.Int{math.ZeroInt(), math.NewInt(9), math.NewInt(10), math.NewInt(256), math.NewIntFromUint64(1 << 63)}
+	var previous []byte
+	for _, amount := range amounts {
+		buffer := make([]byte, BalanceKey.Size(amount))
+		n, err := BalanceKey.Encode(buffer, amount)
+		require.NoError(t, err)
+		require.Equal(t, balanceKeyBytes, n)
+		// encoded balances sort by amount
+		require.Negative(t, bytes.Compare(previous, buffer))
+		previous = buffer
+
+		_, decoded, err := BalanceKey.Decode(buffer)
+		require.NoError(t, err)
+		require.True(t, amount.Equal(decoded))
+	}
+
+	_, err := BalanceKey.Encode(make([]byte, balanceKeyBytes), math.NewInt(-1))
+	require.Error(t, err)
+	_, _, err = BalanceKey.Decode([]byte{1})
+	require.Error(t, err)
+}
+
+func TestBankHolderHandler(t *testing.T) {
+	memDB, cdc, addrCodec := setupTest(t)
+	ctx := context.Background()
+	service := NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger())
+	require.NoError(t, service.Configure(Config{BankHolders: true, BankHolderDenomPrefixes: []string{"factory/"}}, nil, nil))
+	handler := service.BankHolderHandler()
+	querier := service.BankHolderQuerier()
+
+	alice := sdk.AccAddress([]byte("alice_address_______"))
+	bob := sdk.AccAddress([]byte("bob_address_________"))
+	carol := sdk.AccAddress([]byte("carol_address_______"))
+	aliceStr, _ := addrCodec.BytesToString(alice)
+	bobStr, _ := addrCodec.BytesToString(bob)
+	carolStr, _ := addrCodec.BytesToString(carol)
+	denom := "factory/xion1creator/token"
+	metadata := banktypes.Metadata{Base: denom, Display: "token", Symbol: "TKN"}
+	metadataValue, err := cdc.Marshal(&metadata)
+	require.NoError(t, err)
+
+	commit := func(height int64, pairs ...*storetypes.StoreKVPair) {
+		require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: height}, abci.ResponseFinalizeBlock{}))
+		require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, pairs))
+	}
+	holderCount := func() uint64 {
+		res, err := querier.HolderCount(ctx, &indexerbank.QueryHolderCountRequest{Denom: denom})
+		require.NoError(t, err)
+		return res.Count
+	}
+
+	commit(1,
+		balancePair(t, alice, denom, 100),
+		balancePair(t, bob, denom, 300),
+		balancePair(t, carol, denom, 200),
+		balancePair(t, alice, "uxion", 1000),
+		&storetypes.StoreKVPair{
+			StoreKey: banktypes.StoreKey,
+			Key:      append(append([]byte{}, banktypes.DenomMetadataPrefix...), denom...),
+			Value:    metadataValue,
+		},
+	)
+	require.Equal(t, uint64(3), holderCount())
+	height, err := handler.Height.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), height)
+
+	// denoms without a configured prefix are not indexed
+	has, err := handler.Holders.Has(ctx, collections.Join("uxion", alice))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	holders, err := querier.DenomHolders(ctx, &indexerbank.QueryDenomHoldersRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Len(t, holders.Holders, 3)
+
+	top, err := querier.TopHolders(ctx, &indexerbank.QueryTopHoldersRequest{Denom: denom, Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, top.Holders, 2)
+	require.Equal(t, bobStr, top.Holders[0].Address)
+	require.Equal(t, math.NewInt(300), top.Holders[0].Amount)
+	require.Equal(t, carolStr, top.Holders[1].Address)
+
+	denoms, err := querier.AddressDenoms(ctx, &indexerbank.QueryAddressDenomsRequest{Address: aliceStr})
+	require.NoError(t, err)
+	require.Len(t, denoms.Balances, 1)
+	require.Equal(t, denom, denoms.Balances[0].Denom)
+	require.Equal(t, math.NewInt(100), denoms.Balances[0].Amount)
+	require.NotNil(t, denoms.Balances[0].Metadata)
+	require.Equal(t, "TKN", denoms.Balances[0].Metadata.Symbol)
+
+	// a balance update moves the holder, a zero balance removes it
+	commit(2, balancePair(t, alice, denom, 500), balancePair(t, carol, denom, 0))
+	require.Equal(t, uint64(2), holderCount())
+	top, err = querier.TopHolders(ctx, &indexerbank.QueryTopHoldersRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Len(t, top.Holders, 2)
+	require.Equal(t, aliceStr, top.Holders[0].Address)
+	require.Equal(t, bobStr, top.Holders[1].Address)
+
+	// removing an unknown holder is a no-op
+	commit(3, balancePair(t, carol, denom, 0))
+	require.Equal(t, uint64(2), holderCount())
+
+	// a malformed write is skipped
+	commit(4, &storetypes.StoreKVPair{StoreKey: banktypes.StoreKey, Key: []byte{0x02, 0xff}, Value: []byte("invalid")})
+	require.Equal(t, uint64(2), holderCount())
+}
+
+func TestRebuildBankHolders(t *testing.T) {
+	memDB, cdc, addrCodec := setupTest(t)
+	ctx := context.Background()
+	service := NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger())
+	handler := service.BankHolderHandler()
+
+	alice := sdk.AccAddress([]byte("alice_address_______"))
+	bob := sdk.AccAddress([]byte("bob_address_________"))
+	denom := "factory/xion1creator/token"
+	bank := mockBankSource{
+		balances: map[string]sdk.Coins{
+			string(alice): sdk.NewCoins(sdk.NewInt64Coin(denom, 10), sdk.NewInt64Coin("uxion", 5)),
+			string(bob):   sdk.NewCoins(sdk.NewInt64Coin(denom, 20)),
+		},
+		metadata: []banktypes.Metadata{{Base: denom, Symbol: "TKN"}},
+	}
+	sources := mockRebuildSources{}.sources()
+	sources.Bank = bank
+
+	// disabled holders are not rebuilt
+	totals, err := service.Rebuild(ctx, 10, sources)
+	require.NoError(t, err)
+	require.Zero(t, totals.Holders)
+
+	// enabling the holders rebuilds the index even at the checkpoint
+	require.NoError(t, service.Configure(Config{BankHolders: true}, nil, nil))
+	behind, err := handler.Behind(ctx, 10)
+	require.NoError(t, err)
+	require.True(t, behind)
+	require.NoError(t, service.CatchUp(ctx, 10, sources))
+
+	behind, err = handler.Behind(ctx, 10)
+	require.NoError(t, err)
+	require.False(t, behind)
+	count, err := handler.HolderCounts.Get(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	count, err = handler.HolderCounts.Get(ctx, "uxion")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	_, err = handler.Metadata.Get(ctx, denom)
+	require.NoError(t, err)
+}
@@ -0,0 +1,180 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/collections"
+	core "cosmossdk.io/collections/corecompat"
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	CheckpointIndexedHeightPrefix = collections.NewPrefix(0)
+	CheckpointRebuildHeightPrefix = collections.NewPrefix(1)
+)
+
+// Checkpoint records the last height fully applied to the index. It is
+// written in the same batch as the index updates of the height.
+type Checkpoint struct {
+	Schema collections.Schema
+	// IndexedHeight is the last height fully applied to the index.
+	IndexedHeight collections.Item[int64]
+	// RebuildHeight is the app height of the last rebuild.
+	RebuildHeight collections.Item[int64]
+}
+
+func NewCheckpoint(kvStoreService core.KVStoreService) (*Checkpoint, error) {
+	sb := collections.NewSchemaBuilder(kvStoreService)
+
+	indexedHeight := collections.NewItem(sb, CheckpointIndexedHeightPrefix, "indexed_height", collections.Int64Value)
+	rebuildHeight := collections.NewItem(sb, CheckpointRebuildHeightPrefix, "rebuild_height", collections.Int64Value)
+
+	schema, err := sb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Checkpoint{
+		Schema:        schema,
+		IndexedHeight: indexedHeight,
+		RebuildHeight: rebuildHeight,
+	}, nil
+}
+
+// Height returns the last indexed height, 0 if nothing was indexed yet.
+func (c *Checkpoint) Height(ctx context.Context) (int64, error) {
+	return itemOrZero(ctx, c.IndexedHeight)
+}
+
+// LastRebuild returns the app height of the last rebuild, 0 if the index was
+// never rebuilt.
+func (c *Checkpoint) LastRebuild(ctx context.Context) (int64, error) {
+	return itemOrZero(ctx, c.RebuildHeight)
+}
+
+func itemOrZero(ctx context.Context, item collections.Item[int64]) (int64, error) {
+	height, err := item.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// blockStoreKey is the context key of the blockStore the handlers write to.
+type blockStoreKey struct{}
+
+// blockStore buffers the index updates of a block in memory, so they are
+// written to the db in one batch together with the checkpoint.
+type blockStore struct {
+	parent *batchStore
+	cache  *cachekv.Store
+}
+
+func newBlockStore(store db.DB) *blockStore {
+	parent := &batchStore{Store: dbadapter.Store{DB: store}}
+	return &blockStore{
+		parent: parent,
+		cache:  cachekv.NewStore(parent),
+	}
+}
+
+// context returns ctx with the handlers writing to the block store.
+func (bs *blockStore) context(ctx context.Context) context.Context {
+	return context.WithValue(ctx, blockStoreKey{}, bs)
+}
+
+// write writes the buffered updates to the db atomically.
+func (bs *blockStore) write(store db.DB) (err error) {
+	batch := store.NewBatch()
+	defer batch.Close()
+
+	// the cache writes through the store interface, which panics on errors
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to write index batch: %v", r)
+		}
+	}()
+
+	bs.parent.batch = batch
+	bs.cache.Write()
+	return batch.WriteSync()
+}
+
+// batchStore reads from the db and writes to a batch.
+type batchStore struct {
+	dbadapter.Store
+	batch db.Batch
+}
+
+func (s *batchStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+	if err := s.batch.Set(key, value); err != nil {
+		panic(err)
+	}
+}
+
+func (s *batchStore) Delete(key []byte) {
+	if err := s.batch.Delete(key); err != nil {
+		panic(err)
+	}
+}
+
+// coreKVStore adapts a KVStore to the core KVStore the collections use.
+type coreKVStore struct {
+	kvStore storetypes.KVStore
+}
+
+func (s coreKVStore) Get(key []byte) ([]byte, error) {
+	return s.kvStore.Get(key), nil
+}
+
+func (s coreKVStore) Has(key []byte) (bool, error) {
+	return s.kvStore.Has(key), nil
+}
+
+func (s coreKVStore) Set(key, value []byte) error {
+	s.kvStore.Set(key, value)
+	return nil
+}
+
+func (s coreKVStore) Delete(key []byte) error {
+	s.kvStore.Delete(key)
+	return nil
+}
+
+func (s coreKVStore) Iterator(start, end []byte) (db.Iterator, error) {
+	return s.kvStore.Iterator(start, end), nil
+}
+
+func (s coreKVStore) ReverseIterator(start, end []byte) (db.Iterator, error) {
+	return s.kvStore.ReverseIterator(start, end), nil
+}
+
+// kvAccessor opens the store of a handler, the block store when the context
+// carries one and the db otherwise.
+type kvAccessor struct {
+	db     db.DB
+	prefix []byte
+}
+
+func newKVAccessor(store db.DB, storePrefix []byte) *kvAccessor {
+	return &kvAccessor{
+		db:     db.NewPrefixDB(store, storePrefix),
+		prefix: storePrefix,
+	}
+}
+
+func (k *kvAccessor) OpenKVStore(ctx context.Context) core.KVStore {
+	if bs, ok := ctx.Value(blockStoreKey{}).(*blockStore); ok && k.prefix != nil {
+		return coreKVStore{kvStore: prefix.NewStore(bs.cache, k.prefix)}
+	}
+	return k.db
+}
@@ -0,0 +1,199 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	indexerhealth "github.com/burnt-labs/xion/indexer/health"
+)
+
+type mockRebuildSources struct {
+	grants     map[string]authz.Grant
+	allowances []feegrant.Grant
+	contracts  map[string]map[string][]byte
+	codeIDs    map[string]uint64
+}
+
+func (m mockRebuildSources) IterateGrants(_ context.Context, handler func(granterAddr, granteeAddr sdk.AccAddress, grant authz.Grant) bool) {
+	for granter, grant := range m.grants {
+		if handler(sdk.AccAddress(granter), sdk.AccAddress("grantee_address_____"), grant) {
+			return
+		}
+	}
+}
+
+func (m mockRebuildSources) IterateAllFeeAllowances(_ context.Context, cb func(grant feegrant.Grant) bool) error {
+	for _, allowance := range m.allowances {
+		if cb(allowance) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (m mockRebuildSources) IterateContractInfo(_ context.Context, cb func(sdk.AccAddress, wasmtypes.ContractInfo) bool) {
+	for contract := range m.contracts {
+		if cb(sdk.AccAddress(contract), wasmtypes.ContractInfo{CodeID: m.codeIDs[contract]}) {
+			return
+		}
+	}
+}
+
+func (m mockRebuildSources) IterateContractState(_ context.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool) {
+	for key, value := range m.contracts[string(contractAddress)] {
+		if cb([]byte(key), value) {
+			return
+		}
+	}
+}
+
+func (m mockRebuildSources) sources() RebuildSources {
+	return RebuildSources{Authz: m, FeeGrant: m, Wasm: m}
+}
+
+func TestCheckpoint(t *testing.T) {
+	memDB, cdc, addrCodec := setupTest(t)
+	ctx := context.Background()
+	service := NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger())
+	handler := service.AuthzHandler()
+
+	granter := sdk.AccAddress([]byte("granter_address_____"))
+	grantee := sdk.AccAddress([]byte("grantee_address_____"))
+	msgType := "/cosmos.bank.v1beta1.MsgSend"
+	grant, err := authz.NewGrant(time.Now(), authz.NewGenericAuthorization(msgType), nil)
+	require.NoError(t, err)
+	grantValue, err := cdc.Marshal(&grant)
+	require.NoError(t, err)
+
+	health := func() *indexerhealth.QueryHealthResponse {
+		res, err := service.HealthQuerier().Health(ctx, &indexerhealth.QueryHealthRequest{})
+		require.NoError(t, err)
+		return res
+	}
+	errorCount := func(handler string) uint64 {
+		for _, handlerErrors := range health().Errors {
+			if handlerErrors.Handler == handler {
+				return handlerErrors.Count
+			}
+		}
+		t.Fatalf("handler %s is not reported", handler)
+		return 0
+	}
+
+	// the updates of a block are only written on commit, with the checkpoint
+	require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 5}, abci.ResponseFinalizeBlock{}))
+	require.Equal(t, int64(5), health().AppHeight)
+	require.Equal(t, int64(5), health().Lag)
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, []*storetypes.StoreKVPair{{
+		StoreKey: authz.ModuleName,
+		Key:      createGrantStoreKey(granter, grantee, msgType),
+		Value:    grantValue,
+	}}))
+	_, err = handler.GetGrant(ctx, granter, grantee, msgType)
+	require.NoError(t, err)
+	res := health()
+	require.Equal(t, int64(5), res.IndexedHeight)
+	require.Zero(t, res.Lag)
+	require.False(t, res.Diverged)
+	require.Len(t, res.Errors, len(healthHandlers))
+
+	// a failed update stops the checkpoint
+	require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 6}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, []*storetypes.StoreKVPair{{
+		StoreKey: authz.ModuleName,
+		Key:      createGrantStoreKey(granter, grantee, "/cosmos.gov.v1.MsgVote"),
+		Value:    []byte("invalid"),
+	}}))
+	require.Equal(t, uint64(1), errorCount(HealthHandlerAuthz))
+	require.Zero(t, errorCount(HealthHandlerFeeGrant))
+	require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 7}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	res = health()
+	require.True(t, res.Diverged)
+	require.Equal(t, int64(5), res.IndexedHeight)
+	require.Equal(t, int64(7), res.AppHeight)
+	require.Equal(t, int64(2), res.Lag)
+}
+
+func TestCatchUp(t *testing.T) {
+	memDB, cdc, addrCodec := setupTest(t)
+	ctx := context.Background()
+	service := NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger())
+
+	staleGranter := sdk.AccAddress([]byte("stale_granter_______"))
+	granter := sdk.AccAddress([]byte("granter_address_____"))
+	grantee := sdk.AccAddress([]byte("grantee_address_____"))
+	granterStr, _ := addrCodec.BytesToString(granter)
+	granteeStr, _ := addrCodec.BytesToString(grantee)
+	msgType := "/cosmos.bank.v1beta1.MsgSend"
+	grant, err := authz.NewGrant(time.Now(), authz.NewGenericAuthorization(msgType), nil)
+	require.NoError(t, err)
+
+	// the index diverged from the chain
+	require.NoError(t, service.AuthzHandler().SetGrant(ctx, staleGranter, grantee, msgType, grant))
+	require.NoError(t, service.FeeGrantHandler().AddSpend(ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("uxion", 5)), 3))
+
+	allowance, err := feegrant.NewGrant(granter, grantee, &feegrant.BasicAllowance{})
+	require.NoError(t, err)
+	allowance.Granter = granterStr
+	allowance.Grantee = granteeStr
+	contract := sdk.AccAddress([]byte("account_contract____"))
+	otherContract := sdk.AccAddress([]byte("other_contract______"))
+	authenticatorKey := string(authenticatorStoreKey(contract, 1)[len(wasmtypes.ContractStorePrefix)+len(contract):])
+	authenticator := []byte(`{"Secp256K1":{"pubkey":"AxVQ/yaLK1ApMHDF+EG9ElPTZJkr+7tWdj3YXoINXwrx"}}`)
+	sources := mockRebuildSources{
+		grants:     map[string]authz.Grant{string(granter): grant},
+		allowances: []feegrant.Grant{allowance},
+		contracts: map[string]map[string][]byte{
+			string(contract):      {authenticatorKey: authenticator},
+			string(otherContract): {authenticatorKey: authenticator},
+		},
+		codeIDs: map[string]uint64{string(contract): 1, string(otherContract): 2},
+	}
+	require.NoError(t, service.Configure(Config{}, mockContractInfoKeeper{}, mockAbstractAccountCodes{1: {}}))
+
+	require.NoError(t, service.CatchUp(ctx, 10, sources.sources()))
+
+	_, err = service.AuthzHandler().GetGrant(ctx, staleGranter, grantee, msgType)
+	require.Error(t, err)
+	_, err = service.AuthzHandler().GetGrant(ctx, granter, grantee, msgType)
+	require.NoError(t, err)
+	_, err = service.FeeGrantHandler().GetGrant(ctx, granter, grantee)
+	require.NoError(t, err)
+	// spends cannot be rebuilt and are kept
+	_, err = service.FeeGrantHandler().GetSpend(ctx, granter, grantee)
+	require.NoError(t, err)
+	has, err := service.AuthenticatorHandler().Authenticators.Has(ctx, collections.Join(contract, uint32(1)))
+	require.NoError(t, err)
+	require.True(t, has)
+	// only abstract accounts are indexed
+	has, err = service.AuthenticatorHandler().Authenticators.Has(ctx, collections.Join(otherContract, uint32(1)))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	res, err := service.HealthQuerier().Health(ctx, &indexerhealth.QueryHealthRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), res.IndexedHeight)
+	require.Equal(t, int64(10), res.RebuildHeight)
+	require.Zero(t, res.Lag)
+
+	// an index at the app height is not rebuilt
+	require.NoError(t, service.AuthzHandler().SetGrant(ctx, staleGranter, grantee, msgType, grant))
+	require.NoError(t, service.CatchUp(ctx, 10, sources.sources()))
+	_, err = service.AuthzHandler().GetGrant(ctx, staleGranter, grantee, msgType)
+	require.NoError(t, err)
+}
@@ -1,10 +1,13 @@
 package cmd
 
 import (
+	"fmt"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"time"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 
@@ -21,18 +24,33 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	xionapp "github.com/burnt-labs/xion/app"
+	"github.com/burnt-labs/xion/indexer"
+	indexerauthenticator "github.com/burnt-labs/xion/indexer/authenticator"
 	indexerauthz "github.com/burnt-labs/xion/indexer/authz"
+	indexerbank "github.com/burnt-labs/xion/indexer/bank"
 	indexerfeegrant "github.com/burnt-labs/xion/indexer/feegrant"
+	indexerhealth "github.com/burnt-labs/xion/indexer/health"
+	indexerwasm "github.com/burnt-labs/xion/indexer/wasm"
 )
 
-const FlagAppDBBackend = "app-db-backend"
+const (
+	FlagAppDBBackend = "app-db-backend"
+	FlagSender       = "sender"
+	FlagContract     = "contract"
+	FlagEntryPoint   = "entry-point"
+	FlagMinHeight    = "min-height"
+	FlagMaxHeight    = "max-height"
+	FlagGranter      = "granter"
+	FlagActiveOnly   = "active-only"
+)
 
 func Indexer(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "indexer",
 		Short: "indexer",
 		Long: `
-		Indexer support for xion. Indexing Authz and FeeGrant grants and allowances with streaming support.
+		Indexer support for xion. Indexing Authz and FeeGrant grants and allowances, and abstract account
+		authenticators, with streaming support.
 		`,
 
 		Args: cobra.NoArgs,
@@ -46,6 +64,19 @@ func Indexer(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.C
 	cmd.AddCommand(QueryGrantsByGranter())
 	cmd.AddCommand(QueryAllowancesByGrantee())
 	cmd.AddCommand(QueryAllowancesByGranter())
+	cmd.AddCommand(QueryGrantSpend())
+	cmd.AddCommand(QueryGrantSpendsByGranter())
+	cmd.AddCommand(QueryAccountsByAuthenticator())
+	cmd.AddCommand(QueryAccountAuthenticators())
+	cmd.AddCommand(QueryContractExecutions())
+	cmd.AddCommand(QuerySenderExecutions())
+	cmd.AddCommand(QueryExpiringGrants())
+	cmd.AddCommand(QueryExpiringAllowances())
+	cmd.AddCommand(QueryHealth())
+	cmd.AddCommand(QueryDenomHolders())
+	cmd.AddCommand(QueryTopHolders())
+	cmd.AddCommand(QueryHolderCount())
+	cmd.AddCommand(QueryAddressDenoms())
 	return cmd
 }
 
@@ -78,53 +109,18 @@ func ReIndex(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.C
 			app := appCreator(logger, db, nil, serverCtx.Viper)
 			wasmApp := app.(*xionapp.WasmApp)
 
-			authzHandler := wasmApp.IndexerService().AuthzHandler()
-
-			totalAuthz := 0
-			wasmApp.AuthzKeeper.IterateGrants(wasmApp.NewContext(true),
-				func(granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, grant authz.Grant) bool {
-					authorization, err := grant.GetAuthorization()
-					if err != nil {
-						logger.Error("error unpacking authorization", "error", err)
-						return true
-					}
-					msgType := authorization.MsgTypeURL()
-					err = authzHandler.SetGrant(wasmApp.NewContext(true), granterAddr, granteeAddr, msgType, grant)
-					if err != nil {
-						logger.Error("error setting grant", "error", err)
-						return true
-					}
-					totalAuthz++
-					return false
-				})
-
-			feeGrantHandler := wasmApp.IndexerService().FeeGrantHandler()
-			totalFeeGrant := 0
-			addressCodec := wasmApp.AccountKeeper.AddressCodec()
-			if err := wasmApp.FeeGrantKeeper.IterateAllFeeAllowances(wasmApp.NewContext(true), func(grant feegrant.Grant) bool {
-				granter, err := addressCodec.StringToBytes(grant.Granter)
-				if err != nil {
-					logger.Error("error parsing granter", "error", err)
-					return true
-				}
-				grantee, err := addressCodec.StringToBytes(grant.Grantee)
-				if err != nil {
-					logger.Error("error parsing grantee", "error", err)
-					return true
-				}
-
-				err = feeGrantHandler.SetGrant(wasmApp.NewContext(true), granter, grantee, grant)
-				if err != nil {
-					logger.Error("error setting grant", "error", err)
-					return true
-				}
-				totalFeeGrant++
-				return false
-			}); err != nil {
-				logger.Error("error iterating fee allowances", "error", err)
-				return err
-			}
-			slog.Info("totals", "authz grants", totalAuthz, "fee grants", totalFeeGrant)
+			// the index is rebuilt even if it is at the committed height
+			totals, err := wasmApp.IndexerService().Rebuild(wasmApp.NewContext(true), wasmApp.LastBlockHeight(), indexer.RebuildSources{
+				Authz:    wasmApp.AuthzKeeper,
+				FeeGrant: wasmApp.FeeGrantKeeper,
+				Wasm:     wasmApp.WasmKeeper,
+				Bank:     wasmApp.BankKeeper,
+			})
+			if err != nil {
+				logger.Error("error rebuilding index", "error", err)
+				return err
+			}
+			slog.Info("totals", "authz grants", totals.Grants, "fee grants", totals.Allowances, "authenticators", totals.Authenticators, "bank holders", totals.Holders)
 			// close to flush the db
 			err = wasmApp.Close()
 			if err != nil {
@@ -254,7 +250,535 @@ func QueryAllowancesByGranter() *cobra.Command {
 	return cmd
 }
 
+func QueryGrantSpend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-grant-spend [granter] [grantee]",
+		Short: "query fees paid by a fee grant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			granter := args[0]
+			grantee := args[1]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := indexerfeegrant.NewQueryClient(clientCtx)
+			res, err := queryClient.GrantSpend(cmd.Context(), &indexerfeegrant.QueryGrantSpendRequest{
+				Granter: granter,
+				Grantee: grantee,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryGrantSpendsByGranter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-grant-spends-by-granter [granter]",
+		Short: "query fees paid by granter per grantee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			granter := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := indexerfeegrant.NewQueryClient(clientCtx)
+			res, err := queryClient.GrantSpendsByGranter(cmd.Context(), &indexerfeegrant.QueryGrantSpendsByGranterRequest{
+				Granter: granter,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAccountsByAuthenticator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-accounts-by-authenticator [type] [identifier]",
+		Short: "query abstract accounts by authenticator",
+		Long: `Query the abstract accounts using an authenticator. The type is one of Secp256K1, Ed25519,
+Secp256R1, EthWallet, Jwt or Passkey, and the identifier the base64 public key, the hex address,
+the JWT sub or the base64url WebAuthn credential ID.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			authenticatorType := args[0]
+			identifier := args[1]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := indexerauthenticator.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountsByAuthenticator(cmd.Context(), &indexerauthenticator.QueryAccountsByAuthenticatorRequest{
+				AuthenticatorType: authenticatorType,
+				Identifier:        identifier,
+				Pagination:        pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func QueryAccountAuthenticators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-account-authenticators [account]",
+		Short: "query the authenticators of an abstract account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			account := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := indexerauthenticator.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountAuthenticators(cmd.Context(), &indexerauthenticator.QueryAccountAuthenticatorsRequest{
+				Account:    account,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func QueryContractExecutions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-contract-executions [contract]",
+		Short: "query the recorded executions of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contract := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			filter, err := readExecutionFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, _ := cmd.Flags().GetString(FlagSender)
+
+			queryClient := indexerwasm.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractExecutions(cmd.Context(), &indexerwasm.QueryContractExecutionsRequest{
+				Contract:   contract,
+				Sender:     sender,
+				Filter:     filter,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSender, "", "Only return the executions sent by this address")
+	addExecutionFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func QuerySenderExecutions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-sender-executions [sender]",
+		Short: "query the recorded contract executions of a sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sender := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			filter, err := readExecutionFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, _ := cmd.Flags().GetString(FlagContract)
+
+			queryClient := indexerwasm.NewQueryClient(clientCtx)
+			res, err := queryClient.SenderExecutions(cmd.Context(), &indexerwasm.QuerySenderExecutionsRequest{
+				Sender:     sender,
+				Contract:   contract,
+				Filter:     filter,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagContract, "", "Only return the executions of this contract")
+	addExecutionFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func addExecutionFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagEntryPoint, "", "Only return the executions of this entry point")
+	cmd.Flags().Int64(FlagMinHeight, 0, "Only return the executions at or after this height")
+	cmd.Flags().Int64(FlagMaxHeight, 0, "Only return the executions at or before this height")
+}
+
+func readExecutionFilter(cmd *cobra.Command) (indexerwasm.ExecutionFilter, error) {
+	entryPoint, err := cmd.Flags().GetString(FlagEntryPoint)
+	if err != nil {
+		return indexerwasm.ExecutionFilter{}, err
+	}
+	minHeight, err := cmd.Flags().GetInt64(FlagMinHeight)
+	if err != nil {
+		return indexerwasm.ExecutionFilter{}, err
+	}
+	maxHeight, err := cmd.Flags().GetInt64(FlagMaxHeight)
+	if err != nil {
+		return indexerwasm.ExecutionFilter{}, err
+	}
+	return indexerwasm.ExecutionFilter{
+		EntryPoint: entryPoint,
+		MinHeight:  minHeight,
+		MaxHeight:  maxHeight,
+	}, nil
+}
+
+func QueryExpiringGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-expiring-grants [expiring-before]",
+		Short: "query authz grants expiring before a time or within a duration",
+		Long: `query authz grants ordered by expiration that expire before a time,
+given as RFC3339 (2026-01-02T15:04:05Z) or as a duration from now (72h).`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			expiringBefore, err := ParseExpiringBefore(args[0], time.Now())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			granter, _ := cmd.Flags().GetString(FlagGranter)
+			activeOnly, _ := cmd.Flags().GetBool(FlagActiveOnly)
+
+			queryClient := indexerauthz.NewQueryClient(clientCtx)
+			res, err := queryClient.ExpiringGrants(cmd.Context(), &indexerauthz.QueryExpiringGrantsRequest{
+				ExpiringBefore: &expiringBefore,
+				Granter:        granter,
+				ActiveOnly:     activeOnly,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagGranter, "", "Only return the grants of this granter")
+	cmd.Flags().Bool(FlagActiveOnly, false, "Exclude the grants that have already expired")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func QueryExpiringAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-expiring-allowances [expiring-before]",
+		Short: "query fee grant allowances expiring before a time or within a duration",
+		Long: `query fee grant allowances ordered by expiration that expire before a time,
+given as RFC3339 (2026-01-02T15:04:05Z) or as a duration from now (72h).`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			expiringBefore, err := ParseExpiringBefore(args[0], time.Now())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			granter, _ := cmd.Flags().GetString(FlagGranter)
+			activeOnly, _ := cmd.Flags().GetBool(FlagActiveOnly)
+
+			queryClient := indexerfeegrant.NewQueryClient(clientCtx)
+			res, err := queryClient.ExpiringAllowances(cmd.Context(), &indexerfeegrant.QueryExpiringAllowancesRequest{
+				ExpiringBefore: &expiringBefore,
+				Granter:        granter,
+				ActiveOnly:     activeOnly,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagGranter, "", "Only return the allowances of this granter")
+	cmd.Flags().Bool(FlagActiveOnly, false, "Exclude the allowances that have already expired")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+// ParseExpiringBefore parses an RFC3339 time, or a duration added to now.
+func ParseExpiringBefore(value string, now time.Time) (time.Time, error) {
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(duration).UTC(), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected an RFC3339 time or a duration, got %q", value)
+	}
+	return t.UTC(), nil
+}
+
 func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
 }
+
+func QueryHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-health",
+		Short: "query how far the index lags behind the chain and the errors of its handlers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := indexerhealth.NewQueryClient(clientCtx)
+			res, err := queryClient.Health(cmd.Context(), &indexerhealth.QueryHealthRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryDenomHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-denom-holders [denom]",
+		Short: "query the holders of a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := indexerbank.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomHolders(cmd.Context(), &indexerbank.QueryDenomHoldersRequest{
+				Denom:      denom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func QueryTopHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-top-holders [denom]",
+		Short: "query the holders of a denom, largest balance first",
+		Long:  `Query the holders of a denom by descending balance, --limit is the number of top holders returned.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := indexerbank.NewQueryClient(clientCtx)
+			res, err := queryClient.TopHolders(cmd.Context(), &indexerbank.QueryTopHoldersRequest{
+				Denom:      denom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func QueryHolderCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-holder-count [denom]",
+		Short: "query the number of holders of a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := indexerbank.NewQueryClient(clientCtx)
+			res, err := queryClient.HolderCount(cmd.Context(), &indexerbank.QueryHolderCountRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAddressDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-address-denoms [address]",
+		Short: "query the denoms held by an address with their metadata",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := indexerbank.NewQueryClient(clientCtx)
+			res, err := queryClient.AddressDenoms(cmd.Context(), &indexerbank.QueryAddressDenomsRequest{
+				Address:    address,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
@@ -7,7 +7,9 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	dbm "github.com/cosmos/cosmos-db"
@@ -39,7 +41,7 @@ func TestIndexer(t *testing.T) {
 
 	// Count subcommands
 	subCmds := cmd.Commands()
-	require.Len(t, subCmds, 5) // re-index + 4 query commands
+	require.Len(t, subCmds, 18) // re-index + 17 query commands
 
 	// Test running the command (no-op)
 	err := cmd.RunE(cmd, []string{})
@@ -133,6 +135,154 @@ func TestQueryAllowancesByGranter(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestQueryGrantSpend(t *testing.T) {
+	cmd := QueryGrantSpend()
+	require.NotNil(t, cmd)
+	require.Equal(t, "query-grant-spend [granter] [grantee]", cmd.Use)
+	require.Equal(t, "query fees paid by a fee grant", cmd.Short)
+	require.NotNil(t, cmd.RunE)
+
+	// Test that it requires exactly 2 arguments
+	err := cmd.Args(cmd, []string{"addr1"})
+	require.Error(t, err)
+
+	err = cmd.Args(cmd, []string{"addr1", "addr2"})
+	require.NoError(t, err)
+}
+
+func TestQueryGrantSpendsByGranter(t *testing.T) {
+	cmd := QueryGrantSpendsByGranter()
+	require.NotNil(t, cmd)
+	require.Equal(t, "query-grant-spends-by-granter [granter]", cmd.Use)
+	require.Equal(t, "query fees paid by granter per grantee", cmd.Short)
+	require.NotNil(t, cmd.RunE)
+
+	// Test that it requires exactly 1 argument
+	err := cmd.Args(cmd, []string{})
+	require.Error(t, err)
+
+	err = cmd.Args(cmd, []string{"addr1"})
+	require.NoError(t, err)
+}
+
+func TestQueryAccountsByAuthenticator(t *testing.T) {
+	cmd := QueryAccountsByAuthenticator()
+	require.NotNil(t, cmd)
+	require.Equal(t, "query-accounts-by-authenticator [type] [identifier]", cmd.Use)
+	require.Equal(t, "query abstract accounts by authenticator", cmd.Short)
+	require.NotNil(t, cmd.RunE)
+
+	// Test that it requires exactly 2 arguments
+	err := cmd.Args(cmd, []string{"Jwt"})
+	require.Error(t, err)
+
+	err = cmd.Args(cmd, []string{"Jwt", "sub"})
+	require.NoError(t, err)
+}
+
+func TestQueryAccountAuthenticators(t *testing.T) {
+	cmd := QueryAccountAuthenticators()
+	require.NotNil(t, cmd)
+	require.Equal(t, "query-account-authenticators [account]", cmd.Use)
+	require.Equal(t, "query the authenticators of an abstract account", cmd.Short)
+	require.NotNil(t, cmd.RunE)
+
+	// Test that it requires exactly 1 argument
+	err := cmd.Args(cmd, []string{})
+	require.Error(t, err)
+
+	err = cmd.Args(cmd, []string{"addr1"})
+	require.NoError(t, err)
+}
+
+func TestQueryContractExecutions(t *testing.T) {
+	cmd := QueryContractExecutions()
+	require.NotNil(t, cmd)
+	require.Equal(t, "query-contract-executions [contract]", cmd.Use)
+	require.Equal(t, "query the recorded executions of a contract", cmd.Short)
+	require.NotNil(t, cmd.RunE)
+	require.NotNil(t, cmd.Flags().Lookup(FlagSender))
+	require.NotNil(t, cmd.Flags().Lookup(FlagEntryPoint))
+
+	// Test that it requires exactly 1 argument
+	err := cmd.Args(cmd, []string{})
+	require.Error(t, err)
+
+	err = cmd.Args(cmd, []string{"addr1"})
+	require.NoError(t, err)
+}
+
+func TestQuerySenderExecutions(t *testing.T) {
+	cmd := QuerySenderExecutions()
+	require.NotNil(t, cmd)
+	require.Equal(t, "query-sender-executions [sender]", cmd.Use)
+	require.Equal(t, "query the recorded contract executions of a sender", cmd.Short)
+	require.NotNil(t, cmd.RunE)
+	require.NotNil(t, cmd.Flags().Lookup(FlagContract))
+	require.NotNil(t, cmd.Flags().Lookup(FlagMinHeight))
+
+	// Test that it requires exactly 1 argument
+	err := cmd.Args(cmd, []string{})
+	require.Error(t, err)
+
+	err = cmd.Args(cmd, []string{"addr1"})
+	require.NoError(t, err)
+}
+
+func TestQueryExpiringGrants(t *testing.T) {
+	for _, cmd := range []*cobra.Command{QueryExpiringGrants(), QueryExpiringAllowances()} {
+		require.NotNil(t, cmd.RunE)
+		require.NotNil(t, cmd.Flags().Lookup(FlagGranter))
+		require.NotNil(t, cmd.Flags().Lookup(FlagActiveOnly))
+
+		// Test that it requires exactly 1 argument
+		require.Error(t, cmd.Args(cmd, []string{}))
+		require.NoError(t, cmd.Args(cmd, []string{"72h"}))
+	}
+	require.Equal(t, "query-expiring-grants [expiring-before]", QueryExpiringGrants().Use)
+	require.Equal(t, "query-expiring-allowances [expiring-before]", QueryExpiringAllowances().Use)
+}
+
+func TestQueryHealth(t *testing.T) {
+	cmd := QueryHealth()
+	require.NotNil(t, cmd)
+	require.Equal(t, "query-health", cmd.Use)
+	require.NotNil(t, cmd.RunE)
+
+	require.NoError(t, cmd.Args(cmd, []string{}))
+	require.Error(t, cmd.Args(cmd, []string{"extra"}))
+}
+
+func TestQueryBankHolders(t *testing.T) {
+	for _, cmd := range []*cobra.Command{QueryDenomHolders(), QueryTopHolders(), QueryHolderCount(), QueryAddressDenoms()} {
+		require.NotNil(t, cmd.RunE)
+
+		// Test that it requires exactly 1 argument
+		require.Error(t, cmd.Args(cmd, []string{}))
+		require.NoError(t, cmd.Args(cmd, []string{"factory/xion1creator/token"}))
+	}
+	require.Equal(t, "query-denom-holders [denom]", QueryDenomHolders().Use)
+	require.Equal(t, "query-top-holders [denom]", QueryTopHolders().Use)
+	require.Equal(t, "query-holder-count [denom]", QueryHolderCount().Use)
+	require.Equal(t, "query-address-denoms [address]", QueryAddressDenoms().Use)
+	require.NotNil(t, QueryTopHolders().Flags().Lookup(flags.FlagLimit))
+}
+
+func TestParseExpiringBefore(t *testing.T) {
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	expiringBefore, err := ParseExpiringBefore("72h", now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(72*time.Hour), expiringBefore)
+
+	expiringBefore, err = ParseExpiringBefore("2026-02-01T00:00:00+01:00", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 1, 31, 23, 0, 0, 0, time.UTC), expiringBefore)
+
+	_, err = ParseExpiringBefore("next week", now)
+	require.Error(t, err)
+}
+
 func TestOpenDB(t *testing.T) {
 	// Create temporary directory for test
 	tmpDir := t.TempDir()
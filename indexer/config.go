@@ -2,6 +2,8 @@ package indexer
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cast"
 
@@ -11,14 +13,64 @@ import (
 // Config defines the indexer configuration.
 type Config struct {
 	Enabled bool `mapstructure:"enabled" json:"enabled"`
+	// WasmContracts are the addresses of the contracts whose executions are
+	// recorded.
+	WasmContracts []string `mapstructure:"wasm-contracts" json:"wasm_contracts"`
+	// WasmCodeIDs are the code ids of the contracts whose executions are
+	// recorded.
+	WasmCodeIDs []uint64 `mapstructure:"wasm-code-ids" json:"wasm_code_ids"`
+	// WasmRetentionBlocks is the number of blocks contract executions are kept
+	// for, 0 keeps them forever.
+	WasmRetentionBlocks uint64 `mapstructure:"wasm-retention-blocks" json:"wasm_retention_blocks"`
+	// PruneExpiredGrants removes authz grants and fee allowances from the
+	// index once they expire, instead of when the chain deletes them.
+	PruneExpiredGrants bool `mapstructure:"prune-expired-grants" json:"prune_expired_grants"`
+	// ExportSink is the external sink the authz and feegrant changes are
+	// exported to, ExportSinkSQLite or ExportSinkNDJSON. Empty disables the
+	// export.
+	ExportSink string `mapstructure:"export-sink" json:"export_sink"`
+	// ExportPath is the SQLite database file or the NDJSON directory, relative
+	// to the node home. Empty uses a path in the data directory.
+	ExportPath string `mapstructure:"export-path" json:"export_path"`
+	// ExportMaxFileBytes is the size NDJSON files are rotated at, 0 uses
+	// DefaultExportMaxFileBytes.
+	ExportMaxFileBytes uint64 `mapstructure:"export-max-file-bytes" json:"export_max_file_bytes"`
+	// ExportMaxFiles is the number of rotated NDJSON files kept, 0 keeps them
+	// all.
+	ExportMaxFiles uint64 `mapstructure:"export-max-files" json:"export_max_files"`
+	// BankHolders indexes the holders of each denom from the bank balances.
+	BankHolders bool `mapstructure:"bank-holders" json:"bank_holders"`
+	// BankHolderDenomPrefixes limits the indexed holders to the denoms with
+	// one of the prefixes, empty indexes all denoms.
+	BankHolderDenomPrefixes []string `mapstructure:"bank-holder-denom-prefixes" json:"bank_holder_denom_prefixes"`
+	// GrantChangeRetentionBlocks is the number of blocks grant changes are
+	// kept for to resume subscriptions, 0 uses
+	// DefaultGrantChangeRetentionBlocks.
+	GrantChangeRetentionBlocks uint64 `mapstructure:"grant-change-retention-blocks" json:"grant_change_retention_blocks"`
 }
 
 func DefaultConfig() Config {
 	return Config{
-		Enabled: false,
+		Enabled:                    false,
+		WasmContracts:              []string{},
+		WasmCodeIDs:                []uint64{},
+		WasmRetentionBlocks:        0,
+		PruneExpiredGrants:         false,
+		ExportSink:                 "",
+		ExportPath:                 "",
+		ExportMaxFileBytes:         DefaultExportMaxFileBytes,
+		ExportMaxFiles:             0,
+		BankHolders:                false,
+		BankHolderDenomPrefixes:    []string{},
+		GrantChangeRetentionBlocks: DefaultGrantChangeRetentionBlocks,
 	}
 }
 
+// WasmHistoryEnabled returns true if contract executions are recorded.
+func (c Config) WasmHistoryEnabled() bool {
+	return len(c.WasmContracts) > 0 || len(c.WasmCodeIDs) > 0
+}
+
 // DefaultConfigTemplate returns the default TOML snippet for the indexer configuration.
 func DefaultConfigTemplate() string {
 	return ConfigTemplate(DefaultConfig())
@@ -26,15 +78,81 @@ func DefaultConfigTemplate() string {
 
 // ConfigTemplate returns the TOML snippet for the indexer configuration.
 func ConfigTemplate(c Config) string {
+	contracts := make([]string, len(c.WasmContracts))
+	for i, contract := range c.WasmContracts {
+		contracts[i] = strconv.Quote(contract)
+	}
+	denomPrefixes := make([]string, len(c.BankHolderDenomPrefixes))
+	for i, prefix := range c.BankHolderDenomPrefixes {
+		denomPrefixes[i] = strconv.Quote(prefix)
+	}
+	codeIDs := make([]string, len(c.WasmCodeIDs))
+	for i, codeID := range c.WasmCodeIDs {
+		codeIDs[i] = strconv.FormatUint(codeID, 10)
+	}
+
 	return fmt.Sprintf(`
 [indexer]
 enabled = %t
-`, c.Enabled)
+
+# Addresses of the contracts whose executions are recorded.
+wasm-contracts = [%s]
+
+# Code ids of the contracts whose executions are recorded.
+wasm-code-ids = [%s]
+
+# Number of blocks contract executions are kept for, 0 keeps them forever.
+wasm-retention-blocks = %d
+
+# Remove authz grants and fee allowances from the index once they expire.
+prune-expired-grants = %t
+
+# External sink the authz and feegrant changes are exported to: "sqlite",
+# "ndjson" or "" to disable the export.
+export-sink = %q
+
+# SQLite database file or NDJSON directory, relative to the node home.
+# Defaults to data/xion_indexer_export.sqlite or data/xion_indexer_export.
+export-path = %q
+
+# Size in bytes NDJSON files are rotated at.
+export-max-file-bytes = %d
+
+# Number of rotated NDJSON files kept, 0 keeps them all.
+export-max-files = %d
+
+# Index the holders of each denom from the bank balances.
+bank-holders = %t
+
+# Prefixes of the denoms whose holders are indexed, e.g. ["factory/"].
+# Empty indexes all denoms.
+bank-holder-denom-prefixes = [%s]
+
+# Number of blocks grant changes are kept for to resume subscriptions.
+grant-change-retention-blocks = %d
+`, c.Enabled, strings.Join(contracts, ", "), strings.Join(codeIDs, ", "), c.WasmRetentionBlocks, c.PruneExpiredGrants,
+		c.ExportSink, c.ExportPath, c.ExportMaxFileBytes, c.ExportMaxFiles,
+		c.BankHolders, strings.Join(denomPrefixes, ", "), c.GrantChangeRetentionBlocks)
 }
 
 func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 	enabled := cast.ToBool(opts.Get("indexer.enabled"))
+	codeIDs := []uint64{}
+	for _, codeID := range cast.ToUintSlice(opts.Get("indexer.wasm-code-ids")) {
+		codeIDs = append(codeIDs, uint64(codeID))
+	}
 	return Config{
-		Enabled: enabled,
+		Enabled:                    enabled,
+		WasmContracts:              cast.ToStringSlice(opts.Get("indexer.wasm-contracts")),
+		WasmCodeIDs:                codeIDs,
+		WasmRetentionBlocks:        cast.ToUint64(opts.Get("indexer.wasm-retention-blocks")),
+		PruneExpiredGrants:         cast.ToBool(opts.Get("indexer.prune-expired-grants")),
+		ExportSink:                 cast.ToString(opts.Get("indexer.export-sink")),
+		ExportPath:                 cast.ToString(opts.Get("indexer.export-path")),
+		ExportMaxFileBytes:         cast.ToUint64(opts.Get("indexer.export-max-file-bytes")),
+		ExportMaxFiles:             cast.ToUint64(opts.Get("indexer.export-max-files")),
+		BankHolders:                cast.ToBool(opts.Get("indexer.bank-holders")),
+		BankHolderDenomPrefixes:    cast.ToStringSlice(opts.Get("indexer.bank-holder-denom-prefixes")),
+		GrantChangeRetentionBlocks: cast.ToUint64(opts.Get("indexer.grant-change-retention-blocks")),
 	}
 }
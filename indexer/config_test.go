@@ -278,3 +278,110 @@ func TestMultipleConfigInstances(t *testing.T) {
 	require.False(t, config2.Enabled)
 	require.False(t, config3.Enabled)
 }
+
+// TestWasmHistoryConfig verifies the wasm history and grant pruning settings
+// round trip through the template
+func TestWasmHistoryConfig(t *testing.T) {
+	config := indexer.DefaultConfig()
+	require.False(t, config.WasmHistoryEnabled())
+	require.Contains(t, indexer.DefaultConfigTemplate(), "wasm-contracts = []")
+
+	config = indexer.Config{
+		Enabled:             true,
+		WasmContracts:       []string{"xion1abc", "xion1def"},
+		WasmCodeIDs:         []uint64{1, 20},
+		WasmRetentionBlocks: 1000,
+		PruneExpiredGrants:  true,
+		// an empty list reads back as empty, not nil
+		BankHolderDenomPrefixes: []string{},
+	}
+	require.True(t, config.WasmHistoryEnabled())
+
+	template := indexer.ConfigTemplate(config)
+	require.Contains(t, template, `wasm-contracts = ["xion1abc", "xion1def"]`)
+	require.Contains(t, template, "wasm-code-ids = [1, 20]")
+	require.Contains(t, template, "wasm-retention-blocks = 1000")
+	require.Contains(t, template, "prune-expired-grants = true")
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(template)))
+
+	newConfig := indexer.NewConfigFromOptions(v)
+	require.Equal(t, config, newConfig)
+
+	// missing settings record nothing
+	v = viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader("[indexer]\nenabled = true\n")))
+	require.False(t, indexer.NewConfigFromOptions(v).WasmHistoryEnabled())
+}
+
+func TestExportConfig(t *testing.T) {
+	config := indexer.DefaultConfig()
+	require.Empty(t, config.ExportSink)
+	require.Equal(t, indexer.DefaultExportMaxFileBytes, config.ExportMaxFileBytes)
+	require.Contains(t, indexer.DefaultConfigTemplate(), `export-sink = ""`)
+
+	config = indexer.Config{
+		Enabled:                 true,
+		WasmContracts:           []string{},
+		WasmCodeIDs:             []uint64{},
+		ExportSink:              indexer.ExportSinkNDJSON,
+		ExportPath:              "export",
+		ExportMaxFileBytes:      1024,
+		ExportMaxFiles:          5,
+		BankHolderDenomPrefixes: []string{},
+	}
+	template := indexer.ConfigTemplate(config)
+	require.Contains(t, template, `export-sink = "ndjson"`)
+	require.Contains(t, template, `export-path = "export"`)
+	require.Contains(t, template, "export-max-file-bytes = 1024")
+	require.Contains(t, template, "export-max-files = 5")
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(template)))
+	require.Equal(t, config, indexer.NewConfigFromOptions(v))
+}
+
+func TestBankHolderConfig(t *testing.T) {
+	config := indexer.DefaultConfig()
+	require.False(t, config.BankHolders)
+	require.Contains(t, indexer.DefaultConfigTemplate(), "bank-holders = false")
+	require.Contains(t, indexer.DefaultConfigTemplate(), "bank-holder-denom-prefixes = []")
+
+	config = indexer.Config{
+		Enabled:                 true,
+		WasmContracts:           []string{},
+		WasmCodeIDs:             []uint64{},
+		BankHolders:             true,
+		BankHolderDenomPrefixes: []string{"factory/", "ibc/"},
+	}
+	template := indexer.ConfigTemplate(config)
+	require.Contains(t, template, "bank-holders = true")
+	require.Contains(t, template, `bank-holder-denom-prefixes = ["factory/", "ibc/"]`)
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(template)))
+	require.Equal(t, config, indexer.NewConfigFromOptions(v))
+}
+
+func TestGrantChangeConfig(t *testing.T) {
+	config := indexer.DefaultConfig()
+	require.Equal(t, indexer.DefaultGrantChangeRetentionBlocks, config.GrantChangeRetentionBlocks)
+	require.Contains(t, indexer.DefaultConfigTemplate(), "grant-change-retention-blocks = 100000")
+
+	config = indexer.Config{
+		Enabled:                    true,
+		WasmContracts:              []string{},
+		WasmCodeIDs:                []uint64{},
+		BankHolderDenomPrefixes:    []string{},
+		GrantChangeRetentionBlocks: 500,
+	}
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(indexer.ConfigTemplate(config))))
+	require.Equal(t, config, indexer.NewConfigFromOptions(v))
+}
@@ -5,4 +5,8 @@ import "errors"
 var (
 	ErrGrantNotFound     = errors.New("grant not found")
 	ErrAllowanceNotFound = errors.New("allowance not found")
+	// ErrInvalidAuthenticator is returned for authenticator values that
+	// cannot be decoded. They are skipped without diverging the index, the
+	// index holds what the contract wrote.
+	ErrInvalidAuthenticator = errors.New("invalid authenticator")
 )
@@ -0,0 +1,200 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPrunedGrantsPerBlock bounds the number of expired grants a handler
+// removes per block.
+const MaxPrunedGrantsPerBlock = 200
+
+// ExpirationIndex indexes the primary keys of the values that expire, ordered
+// by expiration. Values without an expiration are not referenced.
+type ExpirationIndex[PrimaryKey, Value any] struct {
+	getExpiration func(pk PrimaryKey, value Value) *time.Time
+	refKeys       collections.KeySet[collections.Pair[time.Time, PrimaryKey]]
+}
+
+// NewExpirationIndex instantiates a new ExpirationIndex, getExpirationFunc
+// returns nil for values that do not expire.
+func NewExpirationIndex[PrimaryKey, Value any](
+	schema *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	pkCodec collcodec.KeyCodec[PrimaryKey],
+	getExpirationFunc func(pk PrimaryKey, value Value) *time.Time,
+) *ExpirationIndex[PrimaryKey, Value] {
+	return &ExpirationIndex[PrimaryKey, Value]{
+		getExpiration: getExpirationFunc,
+		refKeys: collections.NewKeySet(
+			schema,
+			prefix,
+			name,
+			collections.PairKeyCodec(sdk.TimeKey, pkCodec),
+			collections.WithKeySetSecondaryIndex(),
+		),
+	}
+}
+
+func (e *ExpirationIndex[PrimaryKey, Value]) Reference(ctx context.Context, pk PrimaryKey, newValue Value, lazyOldValue func() (Value, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := e.unreference(ctx, pk, oldValue); err != nil {
+			return err
+		}
+	case errors.Is(err, collections.ErrNotFound):
+	default:
+		return err
+	}
+	expiration := e.getExpiration(pk, newValue)
+	if expiration == nil {
+		return nil
+	}
+	return e.refKeys.Set(ctx, collections.Join(*expiration, pk))
+}
+
+func (e *ExpirationIndex[PrimaryKey, Value]) Unreference(ctx context.Context, pk PrimaryKey, getValue func() (Value, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return e.unreference(ctx, pk, value)
+}
+
+func (e *ExpirationIndex[PrimaryKey, Value]) unreference(ctx context.Context, pk PrimaryKey, value Value) error {
+	expiration := e.getExpiration(pk, value)
+	if expiration == nil {
+		return nil
+	}
+	return e.refKeys.Remove(ctx, collections.Join(*expiration, pk))
+}
+
+// Iterate iterates over the (expiration, primary key) pairs of the index.
+func (e *ExpirationIndex[PrimaryKey, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[time.Time, PrimaryKey]]) (collections.KeySetIterator[collections.Pair[time.Time, PrimaryKey]], error) {
+	return e.refKeys.Iterate(ctx, ranger)
+}
+
+func (e *ExpirationIndex[PrimaryKey, Value]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[collections.Pair[time.Time, PrimaryKey], collections.NoValue], error) {
+	return e.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+func (e *ExpirationIndex[PrimaryKey, Value]) KeyCodec() collcodec.KeyCodec[collections.Pair[time.Time, PrimaryKey]] {
+	return e.refKeys.KeyCodec()
+}
+
+// Expired returns up to limit primary keys of the values that expired before
+// now, oldest first.
+func (e *ExpirationIndex[PrimaryKey, Value]) Expired(ctx context.Context, now time.Time, limit int) ([]PrimaryKey, error) {
+	ranger := new(collections.Range[collections.Pair[time.Time, PrimaryKey]]).
+		EndExclusive(collections.PairPrefix[time.Time, PrimaryKey](now))
+	iter, err := e.refKeys.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var expired []PrimaryKey
+	for ; iter.Valid() && len(expired) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		expired = append(expired, key.K2())
+	}
+	return expired, nil
+}
+
+// expirationRangeCollection exposes the part of an ExpirationIndex between
+// two expirations to query.CollectionPaginate.
+type expirationRangeCollection[PrimaryKey, Value any] struct {
+	index *ExpirationIndex[PrimaryKey, Value]
+	// from, if set, is the inclusive lower expiration bound
+	from *time.Time
+	// until is the exclusive upper expiration bound
+	until time.Time
+}
+
+func (c expirationRangeCollection[PrimaryKey, Value]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[collections.Pair[time.Time, PrimaryKey], collections.NoValue], error) {
+	until, err := encodeExpirationBound(c.until)
+	if err != nil {
+		return collections.Iterator[collections.Pair[time.Time, PrimaryKey], collections.NoValue]{}, err
+	}
+	if end == nil || bytes.Compare(end, until) > 0 {
+		end = until
+	}
+	if start == nil && c.from != nil {
+		if start, err = encodeExpirationBound(*c.from); err != nil {
+			return collections.Iterator[collections.Pair[time.Time, PrimaryKey], collections.NoValue]{}, err
+		}
+	}
+	if bytes.Compare(start, end) > 0 {
+		start = end
+	}
+	return c.index.IterateRaw(ctx, start, end, order)
+}
+
+func (c expirationRangeCollection[PrimaryKey, Value]) KeyCodec() collcodec.KeyCodec[collections.Pair[time.Time, PrimaryKey]] {
+	return c.index.KeyCodec()
+}
+
+// encodeExpirationBound returns the key prefix of the index entries that
+// expire at t.
+func encodeExpirationBound(t time.Time) ([]byte, error) {
+	buf := make([]byte, sdk.TimeKey.SizeNonTerminal(t))
+	_, err := sdk.TimeKey.EncodeNonTerminal(buf, t)
+	return buf, err
+}
+
+// blockClock holds the time of the latest indexed block, the time grant
+// expiration is evaluated at.
+type blockClock struct {
+	unixNano atomic.Int64
+}
+
+func (c *blockClock) Set(t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	c.unixNano.Store(t.UnixNano())
+}
+
+// Now returns the time of the latest indexed block, or the current time if
+// no block was indexed since the node started.
+func (c *blockClock) Now() time.Time {
+	unixNano := c.unixNano.Load()
+	if unixNano == 0 {
+		return time.Now().UTC()
+	}
+	return time.Unix(0, unixNano).UTC()
+}
+
+// IsExpired returns true if a grant with expiration has expired at now.
+// This is pure business logic that can be fully unit tested
+func IsExpired(expiration *time.Time, now time.Time) bool {
+	return expiration != nil && expiration.Before(now)
+}
+
+// MatchesExpiration returns true if a grant with expiration matches the
+// filter at now: activeOnly and expiredOnly select grants by whether they have
+// expired, expiringBefore, if set, selects grants that expire before it.
+// This is pure business logic that can be fully unit tested
+func MatchesExpiration(expiration *time.Time, activeOnly, expiredOnly bool, expiringBefore *time.Time, now time.Time) bool {
+	expired := IsExpired(expiration, now)
+	if (activeOnly && expired) || (expiredOnly && !expired) {
+		return false
+	}
+	if expiringBefore != nil && (expiration == nil || !expiration.Before(*expiringBefore)) {
+		return false
+	}
+	return true
+}
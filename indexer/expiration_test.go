@@ -0,0 +1,251 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	indexerauthz "github.com/burnt-labs/xion/indexer/authz"
+	indexerfeegrant "github.com/burnt-labs/xion/indexer/feegrant"
+)
+
+func TestMatchesExpiration(t *testing.T) {
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	later := now.Add(48 * time.Hour)
+
+	require.False(t, IsExpired(nil, now))
+	require.False(t, IsExpired(&now, now))
+	require.True(t, IsExpired(&past, now))
+
+	tests := []struct {
+		name           string
+		expiration     *time.Time
+		activeOnly     bool
+		expiredOnly    bool
+		expiringBefore *time.Time
+		matches        bool
+	}{
+		{"no filter", &past, false, false, nil, true},
+		{"no filter no expiration", nil, false, false, nil, true},
+		{"active without expiration", nil, true, false, nil, true},
+		{"active future", &future, true, false, nil, true},
+		{"active past", &past, true, false, nil, false},
+		{"expired past", &past, false, true, nil, true},
+		{"expired future", &future, false, true, nil, false},
+		{"expired without expiration", nil, false, true, nil, false},
+		{"expiring before", &future, false, false, &later, true},
+		{"expiring after", &later, false, false, &future, false},
+		{"expiring without expiration", nil, false, false, &later, false},
+		{"active expiring before", &future, true, false, &later, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.matches, MatchesExpiration(tc.expiration, tc.activeOnly, tc.expiredOnly, tc.expiringBefore, now))
+		})
+	}
+}
+
+func TestAuthzExpiration(t *testing.T) {
+	memDB, cdc, addrCodec := setupTest(t)
+	ctx := context.Background()
+	service := NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger())
+	handler := service.AuthzHandler()
+	querier := service.AuthzQuerier()
+
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	granter1 := sdk.AccAddress([]byte("granter1_address____"))
+	granter2 := sdk.AccAddress([]byte("granter2_address____"))
+	grantee := sdk.AccAddress([]byte("grantee_address_____"))
+	granter1Str, _ := addrCodec.BytesToString(granter1)
+	granteeStr, _ := addrCodec.BytesToString(grantee)
+
+	setGrant := func(granter sdk.AccAddress, msgType string, expiration *time.Time) {
+		grant, err := authz.NewGrant(now.Add(-48*time.Hour), authz.NewGenericAuthorization(msgType), expiration)
+		require.NoError(t, err)
+		require.NoError(t, handler.SetGrant(ctx, granter, grantee, msgType, grant))
+	}
+	expired := now.Add(-time.Hour)
+	soon := now.Add(time.Hour)
+	later := now.Add(24 * time.Hour)
+	setGrant(granter1, "/msg.Expired", &expired)
+	setGrant(granter1, "/msg.Soon", &soon)
+	setGrant(granter2, "/msg.Later", &later)
+	setGrant(granter2, "/msg.Forever", nil)
+
+	// expiration is evaluated at the time of the latest block
+	require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 1, Time: now}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	require.Equal(t, now, handler.Now())
+
+	granteeGrants := func(filter indexerauthz.ExpirationFilter, pagination *query.PageRequest) *indexerauthz.QueryGranteeGrantsResponse {
+		res, err := querier.GranteeGrants(ctx, &indexerauthz.QueryGranteeGrantsRequest{Grantee: granteeStr, Expiration: filter, Pagination: pagination})
+		require.NoError(t, err)
+		return res
+	}
+	require.Len(t, granteeGrants(indexerauthz.ExpirationFilter{}, nil).Grants, 4)
+	require.Len(t, granteeGrants(indexerauthz.ExpirationFilter{Status: indexerauthz.ExpirationStatus_EXPIRATION_STATUS_ACTIVE}, nil).Grants, 3)
+	res := granteeGrants(indexerauthz.ExpirationFilter{Status: indexerauthz.ExpirationStatus_EXPIRATION_STATUS_EXPIRED}, &query.PageRequest{CountTotal: true})
+	require.Len(t, res.Grants, 1)
+	require.Equal(t, uint64(1), res.Pagination.Total)
+	require.Equal(t, expired, *res.Grants[0].Expiration)
+	res = granteeGrants(indexerauthz.ExpirationFilter{Status: indexerauthz.ExpirationStatus_EXPIRATION_STATUS_ACTIVE}, &query.PageRequest{Offset: 1, CountTotal: true})
+	require.Len(t, res.Grants, 2)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	granterGrants, err := querier.GranterGrants(ctx, &indexerauthz.QueryGranterGrantsRequest{
+		Granter:    granter1Str,
+		Expiration: indexerauthz.ExpirationFilter{ExpiringBefore: &later},
+	})
+	require.NoError(t, err)
+	require.Len(t, granterGrants.Grants, 2)
+
+	grants, err := querier.Grants(ctx, &indexerauthz.QueryGrantsRequest{
+		Granter:    granter1Str,
+		Grantee:    granteeStr,
+		Expiration: indexerauthz.ExpirationFilter{Status: indexerauthz.ExpirationStatus_EXPIRATION_STATUS_ACTIVE},
+	})
+	require.NoError(t, err)
+	require.Len(t, grants.Grants, 1)
+	require.Equal(t, soon, *grants.Grants[0].Expiration)
+
+	// expiring grants are ordered by expiration
+	deadline := now.Add(48 * time.Hour)
+	expiring, err := querier.ExpiringGrants(ctx, &indexerauthz.QueryExpiringGrantsRequest{
+		ExpiringBefore: &deadline,
+		Pagination:     &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, expiring.Grants, 2)
+	require.Equal(t, uint64(3), expiring.Pagination.Total)
+	require.Equal(t, expired, *expiring.Grants[0].Expiration)
+	require.Equal(t, soon, *expiring.Grants[1].Expiration)
+
+	expiring, err = querier.ExpiringGrants(ctx, &indexerauthz.QueryExpiringGrantsRequest{
+		ExpiringBefore: &deadline,
+		Pagination:     &query.PageRequest{Key: expiring.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, expiring.Grants, 1)
+	require.Equal(t, later, *expiring.Grants[0].Expiration)
+
+	expiring, err = querier.ExpiringGrants(ctx, &indexerauthz.QueryExpiringGrantsRequest{
+		ExpiringBefore: &deadline,
+		Granter:        granter1Str,
+		ActiveOnly:     true,
+	})
+	require.NoError(t, err)
+	require.Len(t, expiring.Grants, 1)
+	require.Equal(t, soon, *expiring.Grants[0].Expiration)
+
+	_, err = querier.ExpiringGrants(ctx, &indexerauthz.QueryExpiringGrantsRequest{})
+	require.Error(t, err)
+
+	// renewing a grant moves it in the expiration index
+	setGrant(granter1, "/msg.Soon", &later)
+	expiring, err = querier.ExpiringGrants(ctx, &indexerauthz.QueryExpiringGrantsRequest{ExpiringBefore: &later})
+	require.NoError(t, err)
+	require.Len(t, expiring.Grants, 1)
+
+	// pruning removes the grants that expired before the block time
+	service.pruneExpiredGrants = true
+	require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 2, Time: now.Add(2 * time.Hour)}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	_, err = handler.GetGrant(ctx, granter1, grantee, "/msg.Expired")
+	require.Error(t, err)
+	require.Len(t, granteeGrants(indexerauthz.ExpirationFilter{}, nil).Grants, 3)
+}
+
+func TestFeeGrantExpiration(t *testing.T) {
+	memDB, cdc, addrCodec := setupTest(t)
+	ctx := context.Background()
+	service := NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger())
+	handler := service.FeeGrantHandler()
+	querier := service.FeeGrantQuerier()
+
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	granter := sdk.AccAddress([]byte("granter_address_____"))
+	grantee1 := sdk.AccAddress([]byte("grantee1_address____"))
+	grantee2 := sdk.AccAddress([]byte("grantee2_address____"))
+	grantee3 := sdk.AccAddress([]byte("grantee3_address____"))
+	granterStr, _ := addrCodec.BytesToString(granter)
+	grantee1Str, _ := addrCodec.BytesToString(grantee1)
+
+	setAllowance := func(grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) {
+		grant, err := feegrant.NewGrant(granter, grantee, allowance)
+		require.NoError(t, err)
+		require.NoError(t, handler.SetGrant(ctx, granter, grantee, grant))
+	}
+	expired := now.Add(-time.Hour)
+	soon := now.Add(time.Hour)
+	setAllowance(grantee1, &feegrant.BasicAllowance{Expiration: &expired})
+	setAllowance(grantee2, &feegrant.PeriodicAllowance{Basic: feegrant.BasicAllowance{Expiration: &soon}, Period: time.Hour})
+	setAllowance(grantee3, &feegrant.BasicAllowance{})
+	require.NoError(t, handler.AddSpend(ctx, granter, grantee1, sdk.NewCoins(sdk.NewInt64Coin("uxion", 5)), 1))
+
+	require.Equal(t, &soon, AllowanceExpiration(mustGetAllowance(t, handler, granter, grantee2)))
+	require.Nil(t, AllowanceExpiration(mustGetAllowance(t, handler, granter, grantee3)))
+
+	require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 1, Time: now}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+
+	byGranter, err := querier.AllowancesByGranter(ctx, &indexerfeegrant.QueryAllowancesByGranterRequest{
+		Granter:    granterStr,
+		Expiration: indexerfeegrant.ExpirationFilter{Status: indexerfeegrant.ExpirationStatus_EXPIRATION_STATUS_ACTIVE},
+	})
+	require.NoError(t, err)
+	require.Len(t, byGranter.Allowances, 2)
+
+	byGrantee, err := querier.Allowances(ctx, &indexerfeegrant.QueryAllowancesRequest{
+		Grantee:    grantee1Str,
+		Expiration: indexerfeegrant.ExpirationFilter{Status: indexerfeegrant.ExpirationStatus_EXPIRATION_STATUS_EXPIRED},
+	})
+	require.NoError(t, err)
+	require.Len(t, byGrantee.Allowances, 1)
+
+	deadline := now.Add(24 * time.Hour)
+	expiring, err := querier.ExpiringAllowances(ctx, &indexerfeegrant.QueryExpiringAllowancesRequest{
+		ExpiringBefore: &deadline,
+		Granter:        granterStr,
+	})
+	require.NoError(t, err)
+	require.Len(t, expiring.Allowances, 2)
+
+	expiring, err = querier.ExpiringAllowances(ctx, &indexerfeegrant.QueryExpiringAllowancesRequest{
+		ExpiringBefore: &deadline,
+		ActiveOnly:     true,
+	})
+	require.NoError(t, err)
+	require.Len(t, expiring.Allowances, 1)
+
+	// pruning keeps the spend of the removed allowance
+	service.pruneExpiredGrants = true
+	require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 2, Time: now}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	_, err = handler.GetGrant(ctx, granter, grantee1)
+	require.Error(t, err)
+	_, err = handler.GetGrant(ctx, granter, grantee2)
+	require.NoError(t, err)
+	spend, err := handler.GetSpend(ctx, granter, grantee1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), spend.TxCount)
+}
+
+func mustGetAllowance(t *testing.T, handler *FeeGrantHandler, granter, grantee sdk.AccAddress) feegrant.Grant {
+	t.Helper()
+	grant, err := handler.GetGrant(context.Background(), granter, grantee)
+	require.NoError(t, err)
+	return grant
+}
@@ -0,0 +1,220 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+)
+
+// Sinks the changes can be exported to.
+const (
+	ExportSinkSQLite = "sqlite"
+	ExportSinkNDJSON = "ndjson"
+)
+
+// DefaultExportMaxFileBytes is the size NDJSON files are rotated at.
+const DefaultExportMaxFileBytes uint64 = 100 << 20
+
+// Modules of a change.
+const (
+	ExportModuleAuthz    = authz.ModuleName
+	ExportModuleFeeGrant = feegrant.ModuleName
+)
+
+// Operations of a change.
+const (
+	ChangeOpSet    = "set"
+	ChangeOpDelete = "delete"
+	// ChangeOpResync starts a snapshot of all the grants and allowances at
+	// its height, exported when blocks were missed. It is followed by a set
+	// for each of them, consumers drop their state before applying them.
+	ChangeOpResync = "resync"
+)
+
+// Change is a decoded authz grant or fee allowance write of a block.
+type Change struct {
+	Height  int64  `json:"height"`
+	Module  string `json:"module"`
+	Op      string `json:"op"`
+	Granter string `json:"granter"`
+	Grantee string `json:"grantee"`
+	// MsgTypeURL is the message type of an authz grant.
+	MsgTypeURL string     `json:"msg_type_url,omitempty"`
+	Expiration *time.Time `json:"expiration,omitempty"`
+	// Value is the proto JSON of the grant or allowance that was set.
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Exporter pushes the changes of each committed block to an external sink.
+type Exporter interface {
+	// Height returns the last exported height, 0 if nothing was exported.
+	Height(ctx context.Context) (int64, error)
+	// Export writes the changes of the block at height.
+	Export(ctx context.Context, height int64, changes []Change) error
+	// Resync replaces the exported grants and allowances with snapshot, all
+	// of them at height, when the blocks since the exported height were
+	// missed.
+	Resync(ctx context.Context, height int64, snapshot []Change) error
+	Close() error
+}
+
+// NewExporter opens the sink configured by cfg, paths are relative to
+// homeDir. It returns nil if no sink is configured.
+func NewExporter(cfg Config, homeDir string) (Exporter, error) {
+	path := cfg.ExportPath
+	if path != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(homeDir, path)
+	}
+
+	switch cfg.ExportSink {
+	case "":
+		return nil, nil
+	case ExportSinkSQLite:
+		if path == "" {
+			path = filepath.Join(homeDir, "data", "xion_indexer_export.sqlite")
+		}
+		return NewSQLiteExporter(path)
+	case ExportSinkNDJSON:
+		if path == "" {
+			path = filepath.Join(homeDir, "data", "xion_indexer_export")
+		}
+		maxFileBytes := cfg.ExportMaxFileBytes
+		if maxFileBytes == 0 {
+			maxFileBytes = DefaultExportMaxFileBytes
+		}
+		return NewNDJSONExporter(path, maxFileBytes, cfg.ExportMaxFiles)
+	default:
+		return nil, fmt.Errorf("unknown export sink %q", cfg.ExportSink)
+	}
+}
+
+// DecodeChange decodes a write of the authz or feegrant store. ok is false
+// for writes that are not grants or allowances.
+func DecodeChange(cdc codec.Codec, addrCodec address.Codec, height int64, pair *storetypes.StoreKVPair) (change Change, ok bool, err error) {
+	change = Change{Height: height, Module: pair.StoreKey, Op: ChangeOpSet}
+	if pair.Delete {
+		change.Op = ChangeOpDelete
+	}
+
+	var granter, grantee sdk.AccAddress
+	switch pair.StoreKey {
+	case ExportModuleAuthz:
+		if !bytes.HasPrefix(pair.Key, authzkeeper.GrantKey) {
+			return change, false, nil
+		}
+		granter, grantee, change.MsgTypeURL = parseGrantStoreKey(pair.Key)
+		if !pair.Delete {
+			var grant authz.Grant
+			if err := cdc.Unmarshal(pair.Value, &grant); err != nil {
+				return change, false, err
+			}
+			if change.Value, err = cdc.MarshalJSON(&grant); err != nil {
+				return change, false, err
+			}
+			change.Expiration = grant.Expiration
+		}
+	case ExportModuleFeeGrant:
+		if !bytes.HasPrefix(pair.Key, feegrant.FeeAllowanceKeyPrefix) {
+			return change, false, nil
+		}
+		if granter, grantee, err = parseFeeAllowanceStoreKey(pair.Key); err != nil {
+			return change, false, err
+		}
+		if !pair.Delete {
+			var grant feegrant.Grant
+			if err := cdc.Unmarshal(pair.Value, &grant); err != nil {
+				return change, false, err
+			}
+			if change.Value, err = cdc.MarshalJSON(&grant); err != nil {
+				return change, false, err
+			}
+			change.Expiration = AllowanceExpiration(grant)
+		}
+	default:
+		return change, false, nil
+	}
+
+	if err := setChangeAddresses(addrCodec, &change, granter, grantee); err != nil {
+		return change, false, err
+	}
+	return change, true, nil
+}
+
+// ExportSnapshot returns a set change at height for each grant and allowance
+// in the index.
+func ExportSnapshot(ctx context.Context, cdc codec.Codec, addrCodec address.Codec, height int64, authzHandler *AuthzHandler, feeGrantHandler *FeeGrantHandler) ([]Change, error) {
+	var snapshot []Change
+
+	grants, err := authzHandler.Authorizations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer grants.Close()
+	for ; grants.Valid(); grants.Next() {
+		kv, err := grants.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		change := Change{Height: height, Module: ExportModuleAuthz, Op: ChangeOpSet, MsgTypeURL: kv.Key.K3(), Expiration: kv.Value.Expiration}
+		if change.Value, err = cdc.MarshalJSON(&kv.Value); err != nil {
+			return nil, err
+		}
+		if err := setChangeAddresses(addrCodec, &change, kv.Key.K1(), kv.Key.K2()); err != nil {
+			return nil, err
+		}
+		snapshot = append(snapshot, change)
+	}
+
+	allowances, err := feeGrantHandler.FeeAllowances.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer allowances.Close()
+	for ; allowances.Valid(); allowances.Next() {
+		kv, err := allowances.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		change := Change{Height: height, Module: ExportModuleFeeGrant, Op: ChangeOpSet, Expiration: AllowanceExpiration(kv.Value)}
+		if change.Value, err = cdc.MarshalJSON(&kv.Value); err != nil {
+			return nil, err
+		}
+		if err := setChangeAddresses(addrCodec, &change, kv.Key.K1(), kv.Key.K2()); err != nil {
+			return nil, err
+		}
+		snapshot = append(snapshot, change)
+	}
+	return snapshot, nil
+}
+
+func setChangeAddresses(addrCodec address.Codec, change *Change, granter, grantee sdk.AccAddress) (err error) {
+	if change.Granter, err = addrCodec.BytesToString(granter); err != nil {
+		return err
+	}
+	change.Grantee, err = addrCodec.BytesToString(grantee)
+	return err
+}
+
+// parseFeeAllowanceStoreKey parses a fee allowance key, which panics on
+// malformed keys.
+func parseFeeAllowanceStoreKey(key []byte) (granter, grantee sdk.AccAddress, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid fee allowance key %X: %v", key, r)
+		}
+	}()
+	granterBz, granteeBz := feegrant.ParseAddressesFromFeeAllowanceKey(key)
+	return granterBz, granteeBz, nil
+}
@@ -0,0 +1,319 @@
+package indexer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+func readNDJSON(t *testing.T, path string) []Change {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var changes []Change
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var change Change
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &change))
+		changes = append(changes, change)
+	}
+	require.NoError(t, scanner.Err())
+	return changes
+}
+
+func TestDecodeChange(t *testing.T) {
+	_, cdc, addrCodec := setupTest(t)
+
+	granter := sdk.AccAddress([]byte("granter_address_____"))
+	grantee := sdk.AccAddress([]byte("grantee_address_____"))
+	granterStr, _ := addrCodec.BytesToString(granter)
+	granteeStr, _ := addrCodec.BytesToString(grantee)
+	msgType := "/cosmos.bank.v1beta1.MsgSend"
+	expiration := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	grant, err := authz.NewGrant(expiration.Add(-time.Hour), authz.NewGenericAuthorization(msgType), &expiration)
+	require.NoError(t, err)
+	grantValue, err := cdc.Marshal(&grant)
+	require.NoError(t, err)
+
+	change, ok, err := DecodeChange(cdc, addrCodec, 7, &storetypes.StoreKVPair{
+		StoreKey: authz.ModuleName,
+		Key:      createGrantStoreKey(granter, grantee, msgType),
+		Value:    grantValue,
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(7), change.Height)
+	require.Equal(t, ExportModuleAuthz, change.Module)
+	require.Equal(t, ChangeOpSet, change.Op)
+	require.Equal(t, granterStr, change.Granter)
+	require.Equal(t, granteeStr, change.Grantee)
+	require.Equal(t, msgType, change.MsgTypeURL)
+	require.Equal(t, expiration, *change.Expiration)
+	require.Contains(t, string(change.Value), "/cosmos.authz.v1beta1.GenericAuthorization")
+
+	allowance, err := feegrant.NewGrant(granter, grantee, &feegrant.BasicAllowance{Expiration: &expiration})
+	require.NoError(t, err)
+	allowanceValue, err := cdc.Marshal(&allowance)
+	require.NoError(t, err)
+	change, ok, err = DecodeChange(cdc, addrCodec, 7, &storetypes.StoreKVPair{
+		StoreKey: feegrant.ModuleName,
+		Key:      feegrant.FeeAllowanceKey(granter, grantee),
+		Value:    allowanceValue,
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, ExportModuleFeeGrant, change.Module)
+	require.Equal(t, granterStr, change.Granter)
+	require.Equal(t, granteeStr, change.Grantee)
+	require.Equal(t, expiration, *change.Expiration)
+
+	change, ok, err = DecodeChange(cdc, addrCodec, 8, &storetypes.StoreKVPair{
+		StoreKey: feegrant.ModuleName,
+		Key:      feegrant.FeeAllowanceKey(granter, grantee),
+		Delete:   true,
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, ChangeOpDelete, change.Op)
+	require.Nil(t, change.Value)
+
+	// other writes are not exported
+	_, ok, err = DecodeChange(cdc, addrCodec, 8, &storetypes.StoreKVPair{StoreKey: "bank", Key: []byte{1}})
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, ok, err = DecodeChange(cdc, addrCodec, 8, &storetypes.StoreKVPair{StoreKey: feegrant.ModuleName, Key: feegrant.FeeAllowanceQueueKeyPrefix})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// malformed writes are errors
+	_, _, err = DecodeChange(cdc, addrCodec, 8, &storetypes.StoreKVPair{StoreKey: feegrant.ModuleName, Key: []byte{0x00, 0x20}})
+	require.Error(t, err)
+	_, _, err = DecodeChange(cdc, addrCodec, 8, &storetypes.StoreKVPair{
+		StoreKey: authz.ModuleName,
+		Key:      createGrantStoreKey(granter, grantee, msgType),
+		Value:    []byte("invalid"),
+	})
+	require.Error(t, err)
+}
+
+func TestNDJSONExporter(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	exporter, err := NewNDJSONExporter(dir, 200, 2)
+	require.NoError(t, err)
+
+	change := func(height int64) Change {
+		return Change{Height: height, Module: ExportModuleAuthz, Op: ChangeOpDelete, Granter: "xion1granter", Grantee: "xion1grantee", MsgTypeURL: "/msg"}
+	}
+	// blocks without changes write nothing
+	require.NoError(t, exporter.Export(ctx, 1, nil))
+	require.Empty(t, readNDJSON(t, filepath.Join(dir, NDJSONCurrentFile)))
+
+	require.NoError(t, exporter.Export(ctx, 2, []Change{change(2)}))
+	require.Equal(t, []Change{change(2)}, readNDJSON(t, filepath.Join(dir, NDJSONCurrentFile)))
+
+	// the file is rotated after the block that reaches the size
+	require.NoError(t, exporter.Export(ctx, 3, []Change{change(3), change(3)}))
+	files, err := exporter.RotatedFiles()
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "changes-00000000000000000003.ndjson")}, files)
+	require.Equal(t, []Change{change(2), change(3), change(3)}, readNDJSON(t, files[0]))
+	require.Empty(t, readNDJSON(t, filepath.Join(dir, NDJSONCurrentFile)))
+
+	// the oldest rotated files beyond the limit are removed
+	for height := int64(4); height <= 6; height++ {
+		require.NoError(t, exporter.Export(ctx, height, []Change{change(height), change(height), change(height)}))
+	}
+	files, err = exporter.RotatedFiles()
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, "changes-00000000000000000005.ndjson"),
+		filepath.Join(dir, "changes-00000000000000000006.ndjson"),
+	}, files)
+	require.NoError(t, exporter.Close())
+
+	// reopening appends to the current file and keeps the exported height
+	exporter, err = NewNDJSONExporter(dir, 1000, 0)
+	require.NoError(t, err)
+	height, err := exporter.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), height)
+	require.NoError(t, exporter.Export(ctx, 6, []Change{change(6)}))
+	require.NoError(t, exporter.Export(ctx, 7, []Change{change(7)}))
+	require.NoError(t, exporter.Export(ctx, 8, []Change{change(8)}))
+	require.Equal(t, []Change{change(7), change(8)}, readNDJSON(t, filepath.Join(dir, NDJSONCurrentFile)))
+
+	// a resync writes a resync change followed by the snapshot
+	set := Change{Height: 10, Module: ExportModuleFeeGrant, Op: ChangeOpSet, Granter: "xion1granter", Grantee: "xion1grantee", Value: json.RawMessage(`{}`)}
+	require.NoError(t, exporter.Resync(ctx, 10, []Change{set}))
+	require.Equal(t, []Change{change(7), change(8), {Height: 10, Op: ChangeOpResync}, set}, readNDJSON(t, filepath.Join(dir, NDJSONCurrentFile)))
+	height, err = exporter.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+	require.NoError(t, exporter.Close())
+}
+
+func TestSQLiteExporter(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "export", "index.sqlite")
+
+	exporter, err := NewSQLiteExporter(path)
+	require.NoError(t, err)
+
+	expiration := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, exporter.Export(ctx, 5, []Change{
+		{Height: 5, Module: ExportModuleAuthz, Op: ChangeOpSet, Granter: "xion1a", Grantee: "xion1b", MsgTypeURL: "/msg.Send", Expiration: &expiration, Value: json.RawMessage(`{"n":1}`)},
+		{Height: 5, Module: ExportModuleAuthz, Op: ChangeOpSet, Granter: "xion1a", Grantee: "xion1b", MsgTypeURL: "/msg.Vote", Value: json.RawMessage(`{}`)},
+		{Height: 5, Module: ExportModuleFeeGrant, Op: ChangeOpSet, Granter: "xion1a", Grantee: "xion1c", Value: json.RawMessage(`{}`)},
+	}))
+	require.NoError(t, exporter.Export(ctx, 6, []Change{
+		{Height: 6, Module: ExportModuleAuthz, Op: ChangeOpSet, Granter: "xion1a", Grantee: "xion1b", MsgTypeURL: "/msg.Send", Value: json.RawMessage(`{"n":2}`)},
+		{Height: 6, Module: ExportModuleAuthz, Op: ChangeOpDelete, Granter: "xion1a", Grantee: "xion1b", MsgTypeURL: "/msg.Vote"},
+		{Height: 6, Module: ExportModuleFeeGrant, Op: ChangeOpDelete, Granter: "xion1a", Grantee: "xion1c"},
+	}))
+	// blocks that were already exported are skipped
+	require.NoError(t, exporter.Export(ctx, 6, []Change{
+		{Height: 6, Module: ExportModuleFeeGrant, Op: ChangeOpSet, Granter: "xion1a", Grantee: "xion1d", Value: json.RawMessage(`{}`)},
+	}))
+
+	height, err := exporter.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), height)
+
+	count := func(query string) int {
+		var n int
+		require.NoError(t, exporter.DB().QueryRowContext(ctx, query).Scan(&n))
+		return n
+	}
+	require.Equal(t, 6, count(`SELECT COUNT(*) FROM changes`))
+	require.Equal(t, 1, count(`SELECT COUNT(*) FROM authz_grants`))
+	require.Equal(t, 0, count(`SELECT COUNT(*) FROM feegrant_allowances`))
+
+	var grantJSON string
+	var grantHeight int64
+	require.NoError(t, exporter.DB().QueryRowContext(ctx,
+		`SELECT grant_json, height FROM authz_grants WHERE granter = 'xion1a' AND grantee = 'xion1b' AND msg_type_url = '/msg.Send'`,
+	).Scan(&grantJSON, &grantHeight))
+	require.Equal(t, `{"n":2}`, grantJSON)
+	require.Equal(t, int64(6), grantHeight)
+	require.NoError(t, exporter.Close())
+
+	// the export is kept across restarts
+	exporter, err = NewSQLiteExporter(path)
+	require.NoError(t, err)
+	height, err = exporter.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), height)
+
+	// a resync replaces the current grants and allowances with the snapshot
+	require.NoError(t, exporter.Resync(ctx, 9, []Change{
+		{Height: 9, Module: ExportModuleFeeGrant, Op: ChangeOpSet, Granter: "xion1a", Grantee: "xion1e", Value: json.RawMessage(`{}`)},
+	}))
+	height, err = exporter.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), height)
+	require.Equal(t, 8, count(`SELECT COUNT(*) FROM changes`))
+	require.Equal(t, 1, count(`SELECT COUNT(*) FROM changes WHERE op = 'resync'`))
+	require.Equal(t, 0, count(`SELECT COUNT(*) FROM authz_grants`))
+	require.Equal(t, 1, count(`SELECT COUNT(*) FROM feegrant_allowances`))
+	require.NoError(t, exporter.Close())
+}
+
+func TestStreamServiceExport(t *testing.T) {
+	memDB, cdc, addrCodec := setupTest(t)
+	ctx := context.Background()
+	home := t.TempDir()
+	service := NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger())
+
+	exporter, err := NewExporter(Config{}, home)
+	require.NoError(t, err)
+	require.Nil(t, exporter)
+	_, err = NewExporter(Config{ExportSink: "kafka"}, home)
+	require.Error(t, err)
+
+	exporter, err = NewExporter(Config{ExportSink: ExportSinkNDJSON, ExportPath: "export"}, home)
+	require.NoError(t, err)
+	service.SetExporter(exporter)
+
+	granter := sdk.AccAddress([]byte("granter_address_____"))
+	grantee := sdk.AccAddress([]byte("grantee_address_____"))
+	msgType := "/cosmos.bank.v1beta1.MsgSend"
+	grant, err := authz.NewGrant(time.Now(), authz.NewGenericAuthorization(msgType), nil)
+	require.NoError(t, err)
+	grantValue, err := cdc.Marshal(&grant)
+	require.NoError(t, err)
+
+	commit := func(height int64, pairs ...*storetypes.StoreKVPair) {
+		require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: height}, abci.ResponseFinalizeBlock{}))
+		require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, pairs))
+	}
+
+	// the first export after genesis starts with a resync from the index
+	commit(3,
+		&storetypes.StoreKVPair{StoreKey: authz.ModuleName, Key: createGrantStoreKey(granter, grantee, msgType), Value: grantValue},
+		&storetypes.StoreKVPair{StoreKey: feegrant.ModuleName, Key: feegrant.FeeAllowanceKey(granter, grantee), Delete: true},
+		&storetypes.StoreKVPair{StoreKey: "bank", Key: []byte{1}, Value: []byte{1}},
+	)
+	changes := readNDJSON(t, filepath.Join(home, "export", NDJSONCurrentFile))
+	require.Len(t, changes, 2)
+	require.Equal(t, Change{Height: 3, Op: ChangeOpResync}, changes[0])
+	require.Equal(t, int64(3), changes[1].Height)
+	require.Equal(t, ExportModuleAuthz, changes[1].Module)
+	require.Equal(t, ChangeOpSet, changes[1].Op)
+	require.Equal(t, msgType, changes[1].MsgTypeURL)
+
+	// the next block is exported as is
+	commit(4,
+		&storetypes.StoreKVPair{StoreKey: authz.ModuleName, Key: createGrantStoreKey(granter, grantee, msgType), Delete: true},
+		&storetypes.StoreKVPair{StoreKey: feegrant.ModuleName, Key: feegrant.FeeAllowanceKey(granter, grantee), Delete: true},
+	)
+	changes = readNDJSON(t, filepath.Join(home, "export", NDJSONCurrentFile))
+	require.Len(t, changes, 4)
+	require.Equal(t, ExportModuleAuthz, changes[2].Module)
+	require.Equal(t, ChangeOpDelete, changes[2].Op)
+	require.Equal(t, ExportModuleFeeGrant, changes[3].Module)
+	require.Equal(t, ChangeOpDelete, changes[3].Op)
+
+	// a missed block is repaired by a resync on the next one
+	allowance, err := feegrant.NewGrant(granter, grantee, &feegrant.BasicAllowance{})
+	require.NoError(t, err)
+	allowanceValue, err := cdc.Marshal(&allowance)
+	require.NoError(t, err)
+	commit(6,
+		&storetypes.StoreKVPair{StoreKey: feegrant.ModuleName, Key: feegrant.FeeAllowanceKey(granter, grantee), Value: allowanceValue},
+	)
+	changes = readNDJSON(t, filepath.Join(home, "export", NDJSONCurrentFile))
+	require.Len(t, changes, 6)
+	require.Equal(t, Change{Height: 6, Op: ChangeOpResync}, changes[4])
+	require.Equal(t, ExportModuleFeeGrant, changes[5].Module)
+	require.Equal(t, ChangeOpSet, changes[5].Op)
+	granterStr, err := addrCodec.BytesToString(granter)
+	require.NoError(t, err)
+	require.Equal(t, granterStr, changes[5].Granter)
+
+	// a block that could not be fully decoded is resynced as well
+	commit(7,
+		&storetypes.StoreKVPair{StoreKey: authz.ModuleName, Key: createGrantStoreKey(granter, grantee, msgType), Value: []byte("invalid")},
+	)
+	changes = readNDJSON(t, filepath.Join(home, "export", NDJSONCurrentFile))
+	require.Equal(t, Change{Height: 7, Op: ChangeOpResync}, changes[6])
+	require.NoError(t, service.Close())
+}
@@ -8,22 +8,29 @@ import (
 	feegrant "cosmossdk.io/x/feegrant"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +38,95 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExpirationStatus selects allowances by whether they have expired at the
+// time of the latest indexed block.
+type ExpirationStatus int32
+
+const (
+	// EXPIRATION_STATUS_UNSPECIFIED matches all allowances.
+	ExpirationStatus_EXPIRATION_STATUS_UNSPECIFIED ExpirationStatus = 0
+	// EXPIRATION_STATUS_ACTIVE matches allowances that have not expired.
+	ExpirationStatus_EXPIRATION_STATUS_ACTIVE ExpirationStatus = 1
+	// EXPIRATION_STATUS_EXPIRED matches allowances that have expired.
+	ExpirationStatus_EXPIRATION_STATUS_EXPIRED ExpirationStatus = 2
+)
+
+var ExpirationStatus_name = map[int32]string{
+	0: "EXPIRATION_STATUS_UNSPECIFIED",
+	1: "EXPIRATION_STATUS_ACTIVE",
+	2: "EXPIRATION_STATUS_EXPIRED",
+}
+
+var ExpirationStatus_value = map[string]int32{
+	"EXPIRATION_STATUS_UNSPECIFIED": 0,
+	"EXPIRATION_STATUS_ACTIVE":      1,
+	"EXPIRATION_STATUS_EXPIRED":     2,
+}
+
+func (x ExpirationStatus) String() string {
+	return proto.EnumName(ExpirationStatus_name, int32(x))
+}
+
+func (ExpirationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_96bd4b83a1b10523, []int{0}
+}
+
+// ExpirationFilter narrows the allowances returned by their expiration.
+type ExpirationFilter struct {
+	// status selects allowances by whether they have expired.
+	Status ExpirationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=xion.indexer.feegrant.v1.ExpirationStatus" json:"status,omitempty"`
+	// expiring_before, if set, only matches allowances that expire before this
+	// time.
+	ExpiringBefore *time.Time `protobuf:"bytes,2,opt,name=expiring_before,json=expiringBefore,proto3,stdtime" json:"expiring_before,omitempty"`
+}
+
+func (m *ExpirationFilter) Reset()         { *m = ExpirationFilter{} }
+func (m *ExpirationFilter) String() string { return proto.CompactTextString(m) }
+func (*ExpirationFilter) ProtoMessage()    {}
+func (*ExpirationFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96bd4b83a1b10523, []int{0}
+}
+func (m *ExpirationFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpirationFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpirationFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpirationFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpirationFilter.Merge(m, src)
+}
+func (m *ExpirationFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpirationFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpirationFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpirationFilter proto.InternalMessageInfo
+
+func (m *ExpirationFilter) GetStatus() ExpirationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ExpirationStatus_EXPIRATION_STATUS_UNSPECIFIED
+}
+
+func (m *ExpirationFilter) GetExpiringBefore() *time.Time {
+	if m != nil {
+		return m.ExpiringBefore
+	}
+	return nil
+}
+
 // QueryAllowanceRequest is the request type for the Query/Allowance RPC method.
 type QueryAllowanceRequest struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -44,7 +140,7 @@ func (m *QueryAllowanceRequest) Reset()         { *m = QueryAllowanceRequest{} }
 func (m *QueryAllowanceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllowanceRequest) ProtoMessage()    {}
 func (*QueryAllowanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96bd4b83a1b10523, []int{0}
+	return fileDescriptor_96bd4b83a1b10523, []int{1}
 }
 func (m *QueryAllowanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -98,7 +194,7 @@ func (m *QueryAllowanceResponse) Reset()         { *m = QueryAllowanceResponse{}
 func (m *QueryAllowanceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllowanceResponse) ProtoMessage()    {}
 func (*QueryAllowanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96bd4b83a1b10523, []int{1}
+	return fileDescriptor_96bd4b83a1b10523, []int{2}
 }
 func (m *QueryAllowanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -141,13 +237,15 @@ type QueryAllowancesRequest struct {
 	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// pagination defines pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiration narrows the allowances returned by their expiration.
+	Expiration ExpirationFilter `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration"`
 }
 
 func (m *QueryAllowancesRequest) Reset()         { *m = QueryAllowancesRequest{} }
 func (m *QueryAllowancesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllowancesRequest) ProtoMessage()    {}
 func (*QueryAllowancesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96bd4b83a1b10523, []int{2}
+	return fileDescriptor_96bd4b83a1b10523, []int{3}
 }
 func (m *QueryAllowancesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,6 +288,13 @@ func (m *QueryAllowancesRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllowancesRequest) GetExpiration() ExpirationFilter {
+	if m != nil {
+		return m.Expiration
+	}
+	return ExpirationFilter{}
+}
+
 // QueryAllowancesResponse is the response type for the Query/Allowances RPC
 // method.
 type QueryAllowancesResponse struct {
@@ -203,7 +308,7 @@ func (m *QueryAllowancesResponse) Reset()         { *m = QueryAllowancesResponse
 func (m *QueryAllowancesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllowancesResponse) ProtoMessage()    {}
 func (*QueryAllowancesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96bd4b83a1b10523, []int{3}
+	return fileDescriptor_96bd4b83a1b10523, []int{4}
 }
 func (m *QueryAllowancesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,13 +358,15 @@ type QueryAllowancesByGranterRequest struct {
 	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
 	// pagination defines pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiration narrows the allowances returned by their expiration.
+	Expiration ExpirationFilter `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration"`
 }
 
 func (m *QueryAllowancesByGranterRequest) Reset()         { *m = QueryAllowancesByGranterRequest{} }
 func (m *QueryAllowancesByGranterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllowancesByGranterRequest) ProtoMessage()    {}
 func (*QueryAllowancesByGranterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96bd4b83a1b10523, []int{4}
+	return fileDescriptor_96bd4b83a1b10523, []int{5}
 }
 func (m *QueryAllowancesByGranterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,6 +409,13 @@ func (m *QueryAllowancesByGranterRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllowancesByGranterRequest) GetExpiration() ExpirationFilter {
+	if m != nil {
+		return m.Expiration
+	}
+	return ExpirationFilter{}
+}
+
 // QueryAllowancesByGranterResponse is the response type for the
 // Query/AllowancesByGranter RPC method.
 type QueryAllowancesByGranterResponse struct {
@@ -315,7 +429,7 @@ func (m *QueryAllowancesByGranterResponse) Reset()         { *m = QueryAllowance
 func (m *QueryAllowancesByGranterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllowancesByGranterResponse) ProtoMessage()    {}
 func (*QueryAllowancesByGranterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96bd4b83a1b10523, []int{5}
+	return fileDescriptor_96bd4b83a1b10523, []int{6}
 }
 func (m *QueryAllowancesByGranterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,590 +472,2763 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryAllowanceRequest)(nil), "xion.indexer.feegrant.v1.QueryAllowanceRequest")
-	proto.RegisterType((*QueryAllowanceResponse)(nil), "xion.indexer.feegrant.v1.QueryAllowanceResponse")
-	proto.RegisterType((*QueryAllowancesRequest)(nil), "xion.indexer.feegrant.v1.QueryAllowancesRequest")
-	proto.RegisterType((*QueryAllowancesResponse)(nil), "xion.indexer.feegrant.v1.QueryAllowancesResponse")
-	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest")
-	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse")
+// GrantSpend is the cumulative fee spend of a (granter, grantee) pair, across
+// every allowance granted between them.
+type GrantSpend struct {
+	// granter is the address paying the fees.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address whose fees were paid.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// spent is the total of the fees paid.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// tx_count is the number of transactions paid for.
+	TxCount uint64 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// last_height is the height of the last transaction paid for.
+	LastHeight int64 `protobuf:"varint,5,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("xion/indexer/feegrant/v1/query.proto", fileDescriptor_96bd4b83a1b10523)
+func (m *GrantSpend) Reset()         { *m = GrantSpend{} }
+func (m *GrantSpend) String() string { return proto.CompactTextString(m) }
+func (*GrantSpend) ProtoMessage()    {}
+func (*GrantSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96bd4b83a1b10523, []int{7}
 }
-
-var fileDescriptor_96bd4b83a1b10523 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4d, 0x6b, 0xd4, 0x40,
-	0x18, 0xc7, 0x77, 0xea, 0x1b, 0x3b, 0xbd, 0x8d, 0x6f, 0x31, 0x48, 0x5c, 0x82, 0x54, 0xa9, 0x76,
-	0xa6, 0x1b, 0x41, 0xa9, 0x88, 0xd2, 0x05, 0xdd, 0xab, 0xae, 0xe0, 0xc1, 0x8b, 0x4c, 0x76, 0x1f,
-	0x63, 0x60, 0x3b, 0xb3, 0xcd, 0x24, 0xb5, 0x45, 0x8a, 0xe0, 0x27, 0x10, 0xf4, 0xea, 0xc5, 0x93,
-	0xe2, 0xc1, 0x8b, 0x1f, 0xc0, 0xa3, 0xc7, 0xa2, 0x17, 0x8f, 0xb2, 0xeb, 0x07, 0x91, 0xcc, 0xe4,
-	0xcd, 0xed, 0x2e, 0xc6, 0x97, 0x83, 0xb7, 0x4c, 0xe6, 0xf9, 0x3f, 0xf9, 0xfd, 0xff, 0xcf, 0x64,
-	0xf0, 0xd9, 0xed, 0x50, 0x0a, 0x16, 0x8a, 0x01, 0x6c, 0x43, 0xc4, 0x1e, 0x02, 0x04, 0x11, 0x17,
-	0x31, 0xdb, 0x6a, 0xb3, 0xcd, 0x04, 0xa2, 0x1d, 0x3a, 0x8a, 0x64, 0x2c, 0x89, 0x95, 0x56, 0xd1,
-	0xac, 0x8a, 0xe6, 0x55, 0x74, 0xab, 0x6d, 0x2f, 0xf5, 0xa5, 0xda, 0x90, 0xaa, 0xaa, 0xf4, 0x21,
-	0xe6, 0xed, 0xe2, 0x85, 0xe9, 0x60, 0x2f, 0x67, 0x75, 0x3e, 0x57, 0x60, 0x5a, 0x17, 0x95, 0x23,
-	0x1e, 0x84, 0x82, 0xc7, 0xe9, 0x17, 0x4c, 0xed, 0xe9, 0x40, 0xca, 0x60, 0x08, 0x8c, 0x8f, 0x42,
-	0xc6, 0x85, 0x90, 0xb1, 0xde, 0x54, 0xd9, 0xee, 0x29, 0xd3, 0xe9, 0x81, 0x5e, 0x31, 0xb3, 0x30,
-	0x5b, 0xee, 0x53, 0x7c, 0xfc, 0x4e, 0xda, 0x7a, 0x7d, 0x38, 0x94, 0x8f, 0xb9, 0xe8, 0x43, 0x0f,
-	0x36, 0x13, 0x50, 0x31, 0xf1, 0xf0, 0x11, 0x0d, 0x03, 0x91, 0x85, 0x5a, 0xe8, 0x7c, 0xb3, 0x63,
-	0x7d, 0xfe, 0xb0, 0x72, 0x2c, 0xd3, 0xae, 0x0f, 0x06, 0x11, 0x28, 0x75, 0x37, 0x8e, 0x42, 0x11,
-	0xf4, 0xf2, 0xc2, 0x52, 0x03, 0xd6, 0x42, 0x3d, 0x0d, 0xb8, 0xf7, 0xf0, 0x89, 0x69, 0x00, 0x35,
-	0x92, 0x42, 0x01, 0xb9, 0x86, 0x9b, 0x3c, 0x7f, 0xa9, 0x19, 0x16, 0x3d, 0x87, 0x66, 0xcd, 0x2a,
-	0x79, 0xea, 0x44, 0x68, 0x37, 0x5d, 0xf5, 0x4a, 0x81, 0xfb, 0x12, 0x4d, 0x37, 0x56, 0xfb, 0xac,
-	0x41, 0x5d, 0x6b, 0x40, 0x6e, 0x61, 0x5c, 0x86, 0xae, 0xdd, 0x2d, 0x7a, 0x4b, 0x39, 0x4d, 0x3a,
-	0x21, 0x6a, 0x86, 0x9f, 0xf3, 0xdc, 0xe6, 0x41, 0x1e, 0x65, 0xaf, 0xa2, 0x74, 0x5f, 0x23, 0x7c,
-	0x72, 0x1f, 0x56, 0x66, 0xf8, 0x3a, 0xc6, 0x05, 0xbf, 0xb2, 0x50, 0xeb, 0x40, 0x0d, 0xc7, 0x15,
-	0x05, 0xe9, 0xce, 0x60, 0x3c, 0xf7, 0x4b, 0x46, 0xf3, 0xf1, 0x9f, 0x20, 0x5f, 0x21, 0x7c, 0x66,
-	0x0a, 0xb2, 0xb3, 0xd3, 0x35, 0x43, 0xfe, 0x9b, 0xf3, 0xf1, 0xaf, 0x42, 0x7c, 0x87, 0x70, 0x6b,
-	0x3e, 0xdf, 0x7f, 0x96, 0xa6, 0xf7, 0xe6, 0x20, 0x3e, 0xa4, 0x69, 0xc9, 0x7b, 0x84, 0x9b, 0x05,
-	0x32, 0x61, 0x74, 0xde, 0x15, 0x41, 0x67, 0xfe, 0x92, 0xf6, 0x6a, 0x7d, 0x81, 0xc1, 0x70, 0x6f,
-	0x3c, 0xfb, 0xf2, 0xfd, 0xc5, 0xc2, 0x1a, 0xb9, 0xc2, 0xe6, 0xde, 0x59, 0x85, 0x63, 0xf6, 0x24,
-	0x1b, 0xd3, 0x6e, 0xfe, 0x04, 0xbb, 0xe4, 0x2d, 0xc2, 0xb8, 0x0c, 0x99, 0xd4, 0x26, 0xc8, 0xff,
-	0x35, 0xbb, 0xfd, 0x1b, 0x8a, 0x0c, 0xfa, 0xb2, 0x86, 0x5e, 0x25, 0xb4, 0x06, 0xb4, 0xaa, 0xb0,
-	0x7e, 0x44, 0xf8, 0xe8, 0x8c, 0x03, 0x41, 0xd6, 0x6a, 0x23, 0x4c, 0x1f, 0x72, 0xfb, 0xea, 0x9f,
-	0x48, 0x33, 0x1b, 0x9e, 0xb6, 0x71, 0x91, 0x2c, 0xcf, 0xb7, 0x11, 0x2a, 0x95, 0xc0, 0xa0, 0x0c,
-	0xbe, 0x73, 0xf3, 0xd3, 0xd8, 0x41, 0x7b, 0x63, 0x07, 0x7d, 0x1b, 0x3b, 0xe8, 0xf9, 0xc4, 0x69,
-	0xec, 0x4d, 0x9c, 0xc6, 0xd7, 0x89, 0xd3, 0xb8, 0x7f, 0x21, 0x08, 0xe3, 0x47, 0x89, 0x4f, 0xfb,
-	0x72, 0x83, 0xf9, 0x49, 0x24, 0xe2, 0x95, 0x21, 0xf7, 0xd5, 0xec, 0xd6, 0xfe, 0x61, 0x7d, 0xb7,
-	0x5f, 0xfa, 0x11, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x5f, 0xcd, 0x65, 0xaa, 0x06, 0x00, 0x00,
+func (m *GrantSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Allowance returns granted allowance to the grantee by the granter.
-	Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error)
-	// Allowances returns all the grants for the given grantee address.
-	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
-	// AllowancesByGranter returns all the grants given by an address
-	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+func (m *GrantSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *GrantSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantSpend.Merge(m, src)
+}
+func (m *GrantSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantSpend.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_GrantSpend proto.InternalMessageInfo
+
+func (m *GrantSpend) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
 }
 
-func (c *queryClient) Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error) {
-	out := new(QueryAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/xion.indexer.feegrant.v1.Query/Allowance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GrantSpend) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error) {
-	out := new(QueryAllowancesResponse)
-	err := c.cc.Invoke(ctx, "/xion.indexer.feegrant.v1.Query/Allowances", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GrantSpend) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error) {
-	out := new(QueryAllowancesByGranterResponse)
-	err := c.cc.Invoke(ctx, "/xion.indexer.feegrant.v1.Query/AllowancesByGranter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GrantSpend) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Allowance returns granted allowance to the grantee by the granter.
-	Allowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error)
-	// Allowances returns all the grants for the given grantee address.
-	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
-	// AllowancesByGranter returns all the grants given by an address
-	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+func (m *GrantSpend) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryGrantSpendRequest is the request type for the Query/GrantSpend RPC
+// method.
+type QueryGrantSpendRequest struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another
+	// user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Allowance(ctx context.Context, req *QueryAllowanceRequest) (*QueryAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Allowance not implemented")
+func (m *QueryGrantSpendRequest) Reset()         { *m = QueryGrantSpendRequest{} }
+func (m *QueryGrantSpendRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantSpendRequest) ProtoMessage()    {}
+func (*QueryGrantSpendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96bd4b83a1b10523, []int{8}
 }
-func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllowancesRequest) (*QueryAllowancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Allowances not implemented")
+func (m *QueryGrantSpendRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
+func (m *QueryGrantSpendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantSpendRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGrantSpendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantSpendRequest.Merge(m, src)
+}
+func (m *QueryGrantSpendRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantSpendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantSpendRequest.DiscardUnknown(m)
 }
 
-func _Query_Allowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Allowance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.indexer.feegrant.v1.Query/Allowance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Allowance(ctx, req.(*QueryAllowanceRequest))
+var xxx_messageInfo_QueryGrantSpendRequest proto.InternalMessageInfo
+
+func (m *QueryGrantSpendRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Allowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Allowances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.indexer.feegrant.v1.Query/Allowances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Allowances(ctx, req.(*QueryAllowancesRequest))
+func (m *QueryGrantSpendRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowancesByGranterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllowancesByGranter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.indexer.feegrant.v1.Query/AllowancesByGranter",
+// QueryGrantSpendResponse is the response type for the Query/GrantSpend RPC
+// method.
+type QueryGrantSpendResponse struct {
+	// spend is the fee spend of the grant, nil if no fees were paid.
+	Spend *GrantSpend `protobuf:"bytes,1,opt,name=spend,proto3" json:"spend,omitempty"`
+}
+
+func (m *QueryGrantSpendResponse) Reset()         { *m = QueryGrantSpendResponse{} }
+func (m *QueryGrantSpendResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantSpendResponse) ProtoMessage()    {}
+func (*QueryGrantSpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96bd4b83a1b10523, []int{9}
+}
+func (m *QueryGrantSpendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantSpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantSpendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowancesByGranter(ctx, req.(*QueryAllowancesByGranterRequest))
+}
+func (m *QueryGrantSpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantSpendResponse.Merge(m, src)
+}
+func (m *QueryGrantSpendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantSpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantSpendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGrantSpendResponse proto.InternalMessageInfo
+
+func (m *QueryGrantSpendResponse) GetSpend() *GrantSpend {
+	if m != nil {
+		return m.Spend
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "xion.indexer.feegrant.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Allowance",
-			Handler:    _Query_Allowance_Handler,
-		},
-		{
-			MethodName: "Allowances",
-			Handler:    _Query_Allowances_Handler,
-		},
-		{
-			MethodName: "AllowancesByGranter",
-			Handler:    _Query_AllowancesByGranter_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "xion/indexer/feegrant/v1/query.proto",
+// QueryGrantSpendsByGranterRequest is the request type for the
+// Query/GrantSpendsByGranter RPC method.
+type QueryGrantSpendsByGranterRequest struct {
+	// granter is the address of the user granting fee allowances
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// pagination defines pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllowanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGrantSpendsByGranterRequest) Reset()         { *m = QueryGrantSpendsByGranterRequest{} }
+func (m *QueryGrantSpendsByGranterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantSpendsByGranterRequest) ProtoMessage()    {}
+func (*QueryGrantSpendsByGranterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96bd4b83a1b10523, []int{10}
+}
+func (m *QueryGrantSpendsByGranterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantSpendsByGranterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantSpendsByGranterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGrantSpendsByGranterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantSpendsByGranterRequest.Merge(m, src)
+}
+func (m *QueryGrantSpendsByGranterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantSpendsByGranterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantSpendsByGranterRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryGrantSpendsByGranterRequest proto.InternalMessageInfo
+
+func (m *QueryGrantSpendsByGranterRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllowanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGrantSpendsByGranterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGrantSpendsByGranterResponse is the response type for the
+// Query/GrantSpendsByGranter RPC method.
+type QueryGrantSpendsByGranterResponse struct {
+	// spends are the fee spends of the grants issued by the granter.
+	Spends []*GrantSpend `protobuf:"bytes,1,rep,name=spends,proto3" json:"spends,omitempty"`
+	// pagination defines pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		{
-			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGrantSpendsByGranterResponse) Reset()         { *m = QueryGrantSpendsByGranterResponse{} }
+func (m *QueryGrantSpendsByGranterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantSpendsByGranterResponse) ProtoMessage()    {}
+func (*QueryGrantSpendsByGranterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96bd4b83a1b10523, []int{11}
+}
+func (m *QueryGrantSpendsByGranterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantSpendsByGranterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantSpendsByGranterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGrantSpendsByGranterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantSpendsByGranterResponse.Merge(m, src)
+}
+func (m *QueryGrantSpendsByGranterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantSpendsByGranterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantSpendsByGranterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllowancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGrantSpendsByGranterResponse proto.InternalMessageInfo
+
+func (m *QueryGrantSpendsByGranterResponse) GetSpends() []*GrantSpend {
+	if m != nil {
+		return m.Spends
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGrantSpendsByGranterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryExpiringAllowancesRequest is the request type for the
+// Query/ExpiringAllowances RPC method.
+type QueryExpiringAllowancesRequest struct {
+	// expiring_before is the time the allowances expire before.
+	ExpiringBefore *time.Time `protobuf:"bytes,1,opt,name=expiring_before,json=expiringBefore,proto3,stdtime" json:"expiring_before,omitempty"`
+	// granter, if set, only matches allowances granted by this address.
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	// active_only excludes the allowances that have already expired.
+	ActiveOnly bool `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	// pagination defines pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringAllowancesRequest) Reset()         { *m = QueryExpiringAllowancesRequest{} }
+func (m *QueryExpiringAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringAllowancesRequest) ProtoMessage()    {}
+func (*QueryExpiringAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96bd4b83a1b10523, []int{12}
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringAllowancesRequest.Merge(m, src)
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringAllowancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringAllowancesRequest proto.InternalMessageInfo
+
+func (m *QueryExpiringAllowancesRequest) GetExpiringBefore() *time.Time {
+	if m != nil {
+		return m.ExpiringBefore
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllowancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryExpiringAllowancesRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryExpiringAllowancesRequest) GetActiveOnly() bool {
+	if m != nil {
+		return m.ActiveOnly
+	}
+	return false
 }
 
-func (m *QueryAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryExpiringAllowancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.Allowances) > 0 {
-		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+// QueryExpiringAllowancesResponse is the response type for the
+// Query/ExpiringAllowances RPC method.
+type QueryExpiringAllowancesResponse struct {
+	// allowances are the grants ordered by the expiration of their allowance.
+	Allowances []*feegrant.Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+	// pagination defines pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringAllowancesResponse) Reset()         { *m = QueryExpiringAllowancesResponse{} }
+func (m *QueryExpiringAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringAllowancesResponse) ProtoMessage()    {}
+func (*QueryExpiringAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96bd4b83a1b10523, []int{13}
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringAllowancesResponse.Merge(m, src)
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringAllowancesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllowancesByGranterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExpiringAllowancesResponse proto.InternalMessageInfo
+
+func (m *QueryExpiringAllowancesResponse) GetAllowances() []*feegrant.Grant {
+	if m != nil {
+		return m.Allowances
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllowancesByGranterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryExpiringAllowancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllowancesByGranterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func init() {
+	proto.RegisterEnum("xion.indexer.feegrant.v1.ExpirationStatus", ExpirationStatus_name, ExpirationStatus_value)
+	proto.RegisterType((*ExpirationFilter)(nil), "xion.indexer.feegrant.v1.ExpirationFilter")
+	proto.RegisterType((*QueryAllowanceRequest)(nil), "xion.indexer.feegrant.v1.QueryAllowanceRequest")
+	proto.RegisterType((*QueryAllowanceResponse)(nil), "xion.indexer.feegrant.v1.QueryAllowanceResponse")
+	proto.RegisterType((*QueryAllowancesRequest)(nil), "xion.indexer.feegrant.v1.QueryAllowancesRequest")
+	proto.RegisterType((*QueryAllowancesResponse)(nil), "xion.indexer.feegrant.v1.QueryAllowancesResponse")
+	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "xion.indexer.feegrant.v1.QueryAllowancesByGranterRequest")
+	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "xion.indexer.feegrant.v1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*GrantSpend)(nil), "xion.indexer.feegrant.v1.GrantSpend")
+	proto.RegisterType((*QueryGrantSpendRequest)(nil), "xion.indexer.feegrant.v1.QueryGrantSpendRequest")
+	proto.RegisterType((*QueryGrantSpendResponse)(nil), "xion.indexer.feegrant.v1.QueryGrantSpendResponse")
+	proto.RegisterType((*QueryGrantSpendsByGranterRequest)(nil), "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterRequest")
+	proto.RegisterType((*QueryGrantSpendsByGranterResponse)(nil), "xion.indexer.feegrant.v1.QueryGrantSpendsByGranterResponse")
+	proto.RegisterType((*QueryExpiringAllowancesRequest)(nil), "xion.indexer.feegrant.v1.QueryExpiringAllowancesRequest")
+	proto.RegisterType((*QueryExpiringAllowancesResponse)(nil), "xion.indexer.feegrant.v1.QueryExpiringAllowancesResponse")
+}
+
+func init() {
+	proto.RegisterFile("xion/indexer/feegrant/v1/query.proto", fileDescriptor_96bd4b83a1b10523)
+}
+
+var fileDescriptor_96bd4b83a1b10523 = []byte{
+	// 1066 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x38, 0x3f, 0x9a, 0xbc, 0x48, 0xc5, 0x1a, 0x02, 0x75, 0xac, 0xd6, 0x76, 0x57, 0x51,
+	0x31, 0x2e, 0xd9, 0x8d, 0x5d, 0xf1, 0x23, 0x29, 0x02, 0xd9, 0xa9, 0x13, 0x7c, 0xa0, 0x0d, 0x6b,
+	0xa7, 0x42, 0x5c, 0xac, 0xb5, 0x3d, 0xd9, 0xac, 0x70, 0x76, 0xdd, 0x9d, 0x71, 0xb0, 0x85, 0x2a,
+	0x10, 0x67, 0x0e, 0x95, 0x7a, 0xe7, 0x80, 0xc4, 0x81, 0x22, 0xc1, 0x05, 0xee, 0x88, 0x53, 0x8e,
+	0x15, 0x48, 0x88, 0x53, 0x8b, 0x12, 0xc4, 0x95, 0x7f, 0x01, 0xed, 0xec, 0xac, 0xbd, 0xf5, 0x7a,
+	0xc9, 0x36, 0x45, 0x55, 0x4e, 0xde, 0x99, 0x79, 0xdf, 0xf3, 0xf7, 0xbe, 0xf7, 0xe6, 0xbd, 0x81,
+	0xe5, 0xbe, 0x61, 0x99, 0x8a, 0x61, 0xb6, 0x49, 0x9f, 0xd8, 0xca, 0x2e, 0x21, 0xba, 0xad, 0x99,
+	0x4c, 0x39, 0x28, 0x28, 0x77, 0x7a, 0xc4, 0x1e, 0xc8, 0x5d, 0xdb, 0x62, 0x16, 0x4e, 0x3a, 0x56,
+	0xb2, 0xb0, 0x92, 0x3d, 0x2b, 0xf9, 0xa0, 0x90, 0xba, 0xd2, 0xb2, 0xe8, 0xbe, 0x45, 0xfd, 0xc8,
+	0x26, 0x61, 0x5a, 0x61, 0xb8, 0xe1, 0x7a, 0x48, 0xe5, 0x85, 0x5d, 0x53, 0xa3, 0xc4, 0x75, 0x3d,
+	0xb4, 0xec, 0x6a, 0xba, 0x61, 0x6a, 0xcc, 0xf9, 0x07, 0xd7, 0x36, 0xed, 0xb7, 0xf5, 0xac, 0x5a,
+	0x96, 0xe1, 0x9d, 0x2f, 0xea, 0x96, 0x6e, 0xf1, 0x4f, 0xc5, 0xf9, 0x12, 0xbb, 0x17, 0x75, 0xcb,
+	0xd2, 0x3b, 0x44, 0xd1, 0xba, 0x86, 0xa2, 0x99, 0xa6, 0xc5, 0xb8, 0x4b, 0x2a, 0x4e, 0x97, 0x5c,
+	0x9f, 0x0d, 0x17, 0xe6, 0x2e, 0xc4, 0x51, 0x46, 0x00, 0xf9, 0xaa, 0xd9, 0xdb, 0x55, 0x98, 0xb1,
+	0x4f, 0x28, 0xd3, 0xf6, 0xbb, 0xae, 0x81, 0xf4, 0x0d, 0x82, 0x44, 0xa5, 0xdf, 0x35, 0x6c, 0xee,
+	0x71, 0xd3, 0xe8, 0x30, 0x62, 0xe3, 0x32, 0xcc, 0x52, 0xa6, 0xb1, 0x1e, 0x4d, 0xa2, 0x2c, 0xca,
+	0x9d, 0x2f, 0xe6, 0xe5, 0x30, 0x8d, 0xe4, 0x11, 0xb6, 0xc6, 0x11, 0xaa, 0x40, 0xe2, 0xf7, 0xe1,
+	0x05, 0xe2, 0x9c, 0x19, 0xa6, 0xde, 0x68, 0x92, 0x5d, 0xcb, 0x26, 0xc9, 0x78, 0x16, 0xe5, 0x16,
+	0x8a, 0x29, 0xd9, 0xe5, 0x24, 0x7b, 0x9c, 0xe4, 0xba, 0xc7, 0xa9, 0x3c, 0x77, 0xf8, 0x28, 0x83,
+	0xee, 0x3d, 0xce, 0x20, 0xf5, 0xbc, 0x07, 0x2e, 0x73, 0xac, 0xf4, 0x19, 0xbc, 0xf4, 0x81, 0xa3,
+	0x6c, 0xa9, 0xd3, 0xb1, 0x3e, 0xd1, 0xcc, 0x16, 0x51, 0xc9, 0x9d, 0x1e, 0xa1, 0x0c, 0x17, 0xe1,
+	0x1c, 0x27, 0x43, 0x6c, 0x4e, 0x76, 0xbe, 0x9c, 0xfc, 0xf5, 0xc7, 0x95, 0x45, 0x21, 0x42, 0xa9,
+	0xdd, 0xb6, 0x09, 0xa5, 0x35, 0xe6, 0x78, 0x52, 0x3d, 0xc3, 0x11, 0xc6, 0xe5, 0x14, 0x01, 0x43,
+	0xa4, 0xdb, 0xf0, 0xf2, 0x38, 0x01, 0xda, 0xb5, 0x4c, 0x4a, 0xf0, 0xdb, 0x30, 0xaf, 0x79, 0x9b,
+	0x9c, 0xc3, 0x42, 0x31, 0x2d, 0x0b, 0x67, 0x3e, 0xa9, 0x78, 0xaa, 0xe5, 0x2d, 0x67, 0xa5, 0x8e,
+	0x00, 0xd2, 0xdf, 0x68, 0xdc, 0x31, 0x0d, 0x84, 0x46, 0xa2, 0x86, 0x46, 0xf0, 0x26, 0xc0, 0xa8,
+	0xe6, 0x84, 0xe2, 0x57, 0x3c, 0x36, 0x4e, 0xd1, 0xc9, 0x6e, 0xed, 0x7b, 0x7c, 0xb6, 0x35, 0xdd,
+	0x93, 0x52, 0xf5, 0x21, 0xf1, 0x36, 0x00, 0x19, 0xa6, 0x36, 0x39, 0xc5, 0xfd, 0x44, 0x2a, 0x03,
+	0xb7, 0x84, 0xca, 0xd3, 0x87, 0x8f, 0x32, 0x31, 0xd5, 0xe7, 0x43, 0xfa, 0x1a, 0xc1, 0x85, 0x40,
+	0xa0, 0x42, 0xc2, 0x77, 0x00, 0x86, 0x8a, 0x38, 0x45, 0x37, 0x15, 0x41, 0x43, 0x1f, 0x02, 0x6f,
+	0x4d, 0x88, 0xfa, 0x95, 0x13, 0xa3, 0x76, 0xff, 0xdc, 0x1f, 0xb6, 0xf4, 0x0f, 0x82, 0xcc, 0x18,
+	0xc9, 0xf2, 0x60, 0xcb, 0x2d, 0x9b, 0x67, 0xa9, 0xb8, 0xb3, 0x9b, 0x96, 0xef, 0x10, 0x64, 0xc3,
+	0x23, 0x3e, 0x6b, 0xf9, 0xb9, 0x1f, 0x07, 0xe0, 0xee, 0x6b, 0x5d, 0x62, 0xb6, 0x9f, 0xd7, 0xe5,
+	0xc7, 0x1a, 0xcc, 0xd0, 0x2e, 0x31, 0x59, 0x72, 0x8a, 0x87, 0xbe, 0xf4, 0x04, 0x75, 0x8f, 0xf4,
+	0x86, 0x65, 0x98, 0xe5, 0x55, 0x47, 0xe0, 0x07, 0x8f, 0x33, 0x39, 0xdd, 0x60, 0x7b, 0xbd, 0xa6,
+	0xdc, 0xb2, 0xf6, 0x45, 0x47, 0x16, 0x3f, 0x2b, 0xb4, 0xfd, 0xb1, 0xc2, 0x06, 0x5d, 0x42, 0x39,
+	0x80, 0xaa, 0xae, 0x67, 0xbc, 0x04, 0x73, 0xac, 0xdf, 0x68, 0x59, 0x3d, 0x93, 0x25, 0xa7, 0xb3,
+	0x28, 0x37, 0xad, 0x9e, 0x63, 0xfd, 0x0d, 0x67, 0x89, 0x33, 0xb0, 0xd0, 0xd1, 0x28, 0x6b, 0xec,
+	0x11, 0x43, 0xdf, 0x63, 0xc9, 0x99, 0x2c, 0xca, 0x4d, 0xa9, 0xe0, 0x6c, 0xbd, 0xc7, 0x77, 0xa4,
+	0xcf, 0xbd, 0x1e, 0x32, 0x92, 0xe6, 0x79, 0xb7, 0xc7, 0x1d, 0xb8, 0x10, 0x60, 0x20, 0x8a, 0x67,
+	0xdd, 0x15, 0xaf, 0x2d, 0x7a, 0xe3, 0x72, 0x78, 0xb9, 0xfa, 0xc0, 0x2e, 0x44, 0xfa, 0xca, 0xab,
+	0xce, 0xd1, 0xd1, 0x99, 0xba, 0x90, 0xd2, 0x03, 0x04, 0x97, 0xff, 0x83, 0xe0, 0x70, 0x44, 0xcc,
+	0xf2, 0x78, 0xbc, 0xbb, 0x13, 0x4d, 0x03, 0x81, 0xf9, 0xff, 0x6e, 0xcf, 0x97, 0x71, 0x48, 0x73,
+	0xb2, 0x15, 0x31, 0x5c, 0x83, 0x33, 0x67, 0xc2, 0xd8, 0x46, 0xa7, 0x1f, 0xdb, 0xfe, 0xd4, 0xc4,
+	0xa3, 0xa6, 0x26, 0x03, 0x0b, 0x5a, 0x8b, 0x19, 0x07, 0xa4, 0x61, 0x99, 0x9d, 0x01, 0x6f, 0x72,
+	0x73, 0x2a, 0xb8, 0x5b, 0xb7, 0xcc, 0xce, 0x60, 0x2c, 0x77, 0xd3, 0xcf, 0x92, 0xbb, 0x4c, 0xa8,
+	0x1c, 0x67, 0xac, 0xf3, 0xe5, 0x6d, 0x48, 0x8c, 0xbf, 0xb5, 0xf0, 0x65, 0xb8, 0x54, 0xf9, 0x70,
+	0xbb, 0xaa, 0x96, 0xea, 0xd5, 0x5b, 0x37, 0x1b, 0xb5, 0x7a, 0xa9, 0xbe, 0x53, 0x6b, 0xec, 0xdc,
+	0xac, 0x6d, 0x57, 0x36, 0xaa, 0x9b, 0xd5, 0xca, 0x8d, 0x44, 0x0c, 0x5f, 0x84, 0x64, 0xd0, 0xa4,
+	0xb4, 0x51, 0xaf, 0xde, 0xae, 0x24, 0x10, 0xbe, 0x04, 0x4b, 0xc1, 0x53, 0xbe, 0x53, 0xb9, 0x91,
+	0x88, 0x17, 0x7f, 0x9f, 0x83, 0x19, 0x2e, 0x10, 0xfe, 0x01, 0xc1, 0xfc, 0x50, 0x1d, 0xac, 0x84,
+	0x97, 0xef, 0xc4, 0x47, 0x5a, 0x6a, 0x35, 0x3a, 0xc0, 0x0d, 0x5d, 0x7a, 0xf7, 0x8b, 0xdf, 0xfe,
+	0xba, 0x1f, 0x5f, 0xc3, 0x6f, 0x2a, 0xa1, 0x8f, 0xf8, 0xa1, 0xca, 0xca, 0xa7, 0xa2, 0x74, 0xee,
+	0x7a, 0x5f, 0xe4, 0x2e, 0xfe, 0x16, 0x01, 0x8c, 0xf2, 0x89, 0x23, 0x33, 0xf0, 0x6e, 0x42, 0xaa,
+	0xf0, 0x14, 0x08, 0x41, 0xfa, 0x0d, 0x4e, 0x7a, 0x15, 0xcb, 0x11, 0x48, 0x53, 0x1f, 0xd7, 0x9f,
+	0x11, 0xbc, 0x38, 0x61, 0xfc, 0xe2, 0xb5, 0xc8, 0x14, 0xc6, 0x7b, 0x62, 0x6a, 0xfd, 0x34, 0x50,
+	0x11, 0x46, 0x91, 0x87, 0xf1, 0x1a, 0xce, 0x87, 0x87, 0x61, 0x50, 0xda, 0x23, 0xed, 0x91, 0xf0,
+	0xf8, 0x7b, 0xf4, 0xc4, 0x60, 0x3e, 0x49, 0xee, 0xc0, 0xa0, 0x4a, 0x15, 0x9e, 0x02, 0x21, 0x78,
+	0x5e, 0xe7, 0x3c, 0x5f, 0xc7, 0xd7, 0xc2, 0x79, 0xf2, 0x0e, 0x3a, 0xb1, 0x3e, 0x7e, 0x41, 0xb0,
+	0x38, 0xa9, 0x67, 0xe3, 0xf5, 0xc8, 0x44, 0x82, 0xaa, 0x5f, 0x3f, 0x15, 0x56, 0x84, 0x53, 0xe0,
+	0xe1, 0x5c, 0xc5, 0xaf, 0x46, 0x0e, 0x07, 0xff, 0x84, 0x00, 0x07, 0x9b, 0x17, 0x7e, 0xeb, 0x04,
+	0x1a, 0xa1, 0xed, 0x3f, 0xb5, 0x76, 0x0a, 0xa4, 0xa0, 0x9f, 0xe7, 0xf4, 0x97, 0xb1, 0x14, 0x4e,
+	0x7f, 0x38, 0x1c, 0x2a, 0x87, 0x47, 0x69, 0xf4, 0xf0, 0x28, 0x8d, 0xfe, 0x3c, 0x4a, 0xa3, 0x7b,
+	0xc7, 0xe9, 0xd8, 0xc3, 0xe3, 0x74, 0xec, 0x8f, 0xe3, 0x74, 0xec, 0xa3, 0xab, 0xbe, 0x77, 0x53,
+	0xb3, 0x67, 0x9b, 0x6c, 0xa5, 0xa3, 0x35, 0xe9, 0x64, 0x97, 0xcd, 0x59, 0x3e, 0x8b, 0xae, 0xfd,
+	0x3b, 0x00, 0x48, 0x66, 0xe0, 0x17, 0xe9, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Allowance returns granted allowance to the grantee by the granter.
+	Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error)
+	// Allowances returns all the grants for the given grantee address.
+	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
+	// AllowancesByGranter returns all the grants given by an address
+	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// GrantSpend returns the fees the granter paid for the grantee.
+	GrantSpend(ctx context.Context, in *QueryGrantSpendRequest, opts ...grpc.CallOption) (*QueryGrantSpendResponse, error)
+	// GrantSpendsByGranter returns the fees paid by an address for each of its
+	// grantees.
+	GrantSpendsByGranter(ctx context.Context, in *QueryGrantSpendsByGranterRequest, opts ...grpc.CallOption) (*QueryGrantSpendsByGranterResponse, error)
+	// ExpiringAllowances returns the grants whose allowance expires before a
+	// time, ordered by expiration.
+	ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error) {
+	out := new(QueryAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/xion.indexer.feegrant.v1.Query/Allowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error) {
+	out := new(QueryAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/xion.indexer.feegrant.v1.Query/Allowances", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllowancesByGranterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error) {
+	out := new(QueryAllowancesByGranterResponse)
+	err := c.cc.Invoke(ctx, "/xion.indexer.feegrant.v1.Query/AllowancesByGranter", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllowancesByGranterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GrantSpend(ctx context.Context, in *QueryGrantSpendRequest, opts ...grpc.CallOption) (*QueryGrantSpendResponse, error) {
+	out := new(QueryGrantSpendResponse)
+	err := c.cc.Invoke(ctx, "/xion.indexer.feegrant.v1.Query/GrantSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
+func (c *queryClient) GrantSpendsByGranter(ctx context.Context, in *QueryGrantSpendsByGranterRequest, opts ...grpc.CallOption) (*QueryGrantSpendsByGranterResponse, error) {
+	out := new(QueryGrantSpendsByGranterResponse)
+	err := c.cc.Invoke(ctx, "/xion.indexer.feegrant.v1.Query/GrantSpendsByGranter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error) {
+	out := new(QueryExpiringAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/xion.indexer.feegrant.v1.Query/ExpiringAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Allowance returns granted allowance to the grantee by the granter.
+	Allowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error)
+	// Allowances returns all the grants for the given grantee address.
+	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
+	// AllowancesByGranter returns all the grants given by an address
+	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// GrantSpend returns the fees the granter paid for the grantee.
+	GrantSpend(context.Context, *QueryGrantSpendRequest) (*QueryGrantSpendResponse, error)
+	// GrantSpendsByGranter returns the fees paid by an address for each of its
+	// grantees.
+	GrantSpendsByGranter(context.Context, *QueryGrantSpendsByGranterRequest) (*QueryGrantSpendsByGranterResponse, error)
+	// ExpiringAllowances returns the grants whose allowance expires before a
+	// time, ordered by expiration.
+	ExpiringAllowances(context.Context, *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Allowance(ctx context.Context, req *QueryAllowanceRequest) (*QueryAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowance not implemented")
+}
+func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllowancesRequest) (*QueryAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowances not implemented")
+}
+func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
+}
+func (*UnimplementedQueryServer) GrantSpend(ctx context.Context, req *QueryGrantSpendRequest) (*QueryGrantSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSpend not implemented")
+}
+func (*UnimplementedQueryServer) GrantSpendsByGranter(ctx context.Context, req *QueryGrantSpendsByGranterRequest) (*QueryGrantSpendsByGranterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSpendsByGranter not implemented")
+}
+func (*UnimplementedQueryServer) ExpiringAllowances(ctx context.Context, req *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringAllowances not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Allowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.indexer.feegrant.v1.Query/Allowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowance(ctx, req.(*QueryAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.indexer.feegrant.v1.Query/Allowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowances(ctx, req.(*QueryAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowancesByGranterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowancesByGranter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.indexer.feegrant.v1.Query/AllowancesByGranter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowancesByGranter(ctx, req.(*QueryAllowancesByGranterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GrantSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.indexer.feegrant.v1.Query/GrantSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantSpend(ctx, req.(*QueryGrantSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GrantSpendsByGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantSpendsByGranterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantSpendsByGranter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.indexer.feegrant.v1.Query/GrantSpendsByGranter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantSpendsByGranter(ctx, req.(*QueryGrantSpendsByGranterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpiringAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.indexer.feegrant.v1.Query/ExpiringAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringAllowances(ctx, req.(*QueryExpiringAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xion.indexer.feegrant.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			MethodName: "Allowance",
+			Handler:    _Query_Allowance_Handler,
+		},
+		{
+			MethodName: "Allowances",
+			Handler:    _Query_Allowances_Handler,
+		},
+		{
+			MethodName: "AllowancesByGranter",
+			Handler:    _Query_AllowancesByGranter_Handler,
+		},
+		{
+			MethodName: "GrantSpend",
+			Handler:    _Query_GrantSpend_Handler,
+		},
+		{
+			MethodName: "GrantSpendsByGranter",
+			Handler:    _Query_GrantSpendsByGranter_Handler,
+		},
+		{
+			MethodName: "ExpiringAllowances",
+			Handler:    _Query_ExpiringAllowances_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "xion/indexer/feegrant/v1/query.proto",
+}
+
+func (m *ExpirationFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpirationFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpirationFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiringBefore != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiringBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiringBefore):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowancesByGranterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowancesByGranterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowancesByGranterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowancesByGranterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowancesByGranterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantSpendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantSpendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantSpendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantSpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantSpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Spend != nil {
+		{
+			size, err := m.Spend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantSpendsByGranterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantSpendsByGranterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantSpendsByGranterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantSpendsByGranterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantSpendsByGranterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantSpendsByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Spends) > 0 {
+		for iNdEx := len(m.Spends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActiveOnly {
+		i--
+		if m.ActiveOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ExpiringBefore != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiringBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiringBefore):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintQuery(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExpirationFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.ExpiringBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiringBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Expiration.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesByGranterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Expiration.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowancesByGranterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovQuery(uint64(m.TxCount))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func (m *QueryGrantSpendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantSpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Spend != nil {
+		l = m.Spend.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantSpendsByGranterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantSpendsByGranterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Spends) > 0 {
+		for _, e := range m.Spends {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiringBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiringBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActiveOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExpirationFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpirationFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpirationFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ExpirationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiringBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiringBefore == nil {
+				m.ExpiringBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiringBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &feegrant.Grant{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &feegrant.Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &feegrant.Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Allowances) > 0 {
-		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllowancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllowancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Allowances) > 0 {
-		for _, e := range m.Allowances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllowancesByGranterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryAllowancesByGranterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Allowances) > 0 {
-		for _, e := range m.Allowances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGrantSpendRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -964,10 +3251,10 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGrantSpendRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGrantSpendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1055,7 +3342,7 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGrantSpendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1078,15 +3365,15 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGrantSpendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGrantSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1113,10 +3400,10 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &feegrant.Grant{}
+			if m.Spend == nil {
+				m.Spend = &GrantSpend{}
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1141,7 +3428,7 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGrantSpendsByGranterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1164,15 +3451,15 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGrantSpendsByGranterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGrantSpendsByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1200,7 +3487,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1259,7 +3546,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGrantSpendsByGranterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1282,15 +3569,15 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGrantSpendsByGranterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGrantSpendsByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spends", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1317,8 +3604,8 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &feegrant.Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Spends = append(m.Spends, &GrantSpend{})
+			if err := m.Spends[len(m.Spends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1379,7 +3666,7 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExpiringAllowancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1402,13 +3689,49 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpiringAllowancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpiringAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiringBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiringBefore == nil {
+				m.ExpiringBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiringBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
@@ -1440,7 +3763,27 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveOnly = bool(v != 0)
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1497,7 +3840,7 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExpiringAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1520,10 +3863,10 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpiringAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpiringAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
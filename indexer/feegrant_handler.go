@@ -2,24 +2,36 @@ package indexer
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 
 	"cosmossdk.io/collections"
 	core "cosmossdk.io/collections/corecompat"
 	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/feegrant"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	indexerfeegrant "github.com/burnt-labs/xion/indexer/feegrant"
+	indexerstream "github.com/burnt-labs/xion/indexer/stream"
+	xiontypes "github.com/burnt-labs/xion/x/xion/types"
 )
 
 var (
 	FeeGrantsPrefix            = collections.NewPrefix(0)
 	FeeGrantGranteeIndexPrefix = collections.NewPrefix(1)
+	FeeGrantSpendsPrefix       = collections.NewPrefix(2)
+	FeeGrantExpirationPrefix   = collections.NewPrefix(3)
 )
 
 type feegrantIndexes struct {
-	Grantee *indexes.ReversePair[sdk.AccAddress, sdk.AccAddress, feegrant.Grant]
+	Grantee    *indexes.ReversePair[sdk.AccAddress, sdk.AccAddress, feegrant.Grant]
+	Expiration *ExpirationIndex[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 }
 
 type FeeGrantHandler struct {
@@ -28,8 +40,14 @@ type FeeGrantHandler struct {
 	Schema         collections.Schema
 	// key: (granter, grantee)
 	// value: authorization
-	// secondary index: grantee
+	// secondary indexes: grantee, expiration
 	FeeAllowances *collections.IndexedMap[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant, feegrantIndexes]
+	// key: (granter, grantee)
+	// value: cumulative fees paid, kept after the grant is removed
+	Spends collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], indexerfeegrant.GrantSpend]
+
+	clock    blockClock
+	listener GrantChangeListener
 }
 
 func newFeeGrantIndexes(sb *collections.SchemaBuilder) feegrantIndexes {
@@ -40,7 +58,30 @@ func newFeeGrantIndexes(sb *collections.SchemaBuilder) feegrantIndexes {
 			"feegrant_by_grantee",
 			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
 		),
+		Expiration: NewExpirationIndex(
+			sb,
+			FeeGrantExpirationPrefix,
+			"feegrant_by_expiration",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], value feegrant.Grant) *time.Time {
+				return AllowanceExpiration(value)
+			},
+		),
+	}
+}
+
+// AllowanceExpiration returns the expiration of the allowance of grant, or
+// nil if it does not expire or cannot be decoded.
+func AllowanceExpiration(grant feegrant.Grant) *time.Time {
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return nil
+	}
+	expiration, err := allowance.ExpiresAt()
+	if err != nil {
+		return nil
 	}
+	return expiration
 }
 
 func NewFeeGrantHandler(kvStoreService core.KVStoreService, cdc codec.Codec) (*FeeGrantHandler, error) {
@@ -58,6 +99,14 @@ func NewFeeGrantHandler(kvStoreService core.KVStoreService, cdc codec.Codec) (*F
 		feegrantIndexes,
 	)
 
+	spends := collections.NewMap(
+		sb,
+		FeeGrantSpendsPrefix,
+		"feegrant_spend",
+		collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+		codec.CollValue[indexerfeegrant.GrantSpend](cdc),
+	)
+
 	schema, err := sb.Build()
 	if err != nil {
 		return nil, err
@@ -67,6 +116,7 @@ func NewFeeGrantHandler(kvStoreService core.KVStoreService, cdc codec.Codec) (*F
 		Schema:         schema,
 		kvStoreService: kvStoreService,
 		FeeAllowances:  authorizations,
+		Spends:         spends,
 		cdc:            cdc,
 	}, nil
 }
@@ -81,19 +131,30 @@ func (ah *FeeGrantHandler) SetGrant(ctx context.Context, granter, grantee sdk.Ac
 	return ah.FeeAllowances.Set(ctx, key, grant)
 }
 
+// SetListener sets the listener of the allowance changes applied from the
+// change sets.
+func (ah *FeeGrantHandler) SetListener(listener GrantChangeListener) {
+	ah.listener = listener
+}
+
 func (ah *FeeGrantHandler) HandleUpdate(ctx context.Context, pair *storetypes.StoreKVPair) error {
 	granterAddrBz, granteeAddrBz := feegrant.ParseAddressesFromFeeAllowanceKey(pair.Key)
 	granterAddr := sdk.AccAddress(granterAddrBz)
 	granteeAddr := sdk.AccAddress(granteeAddrBz)
+	key := collections.Join(granterAddr, granteeAddr)
 	if pair.Delete {
-		if has, err := ah.FeeAllowances.Has(ctx, collections.Join(granterAddr, granteeAddr)); err != nil {
+		if has, err := ah.FeeAllowances.Has(ctx, key); err != nil {
 			return err
 		} else if !has {
 			// Graceful handling: deleting a non-existent allowance is a no-op, not an error
 			// This ensures the indexer remains robust during edge cases
 			return nil
 		}
-		return ah.FeeAllowances.Remove(ctx, collections.Join(granterAddr, granteeAddr))
+		if err := ah.FeeAllowances.Remove(ctx, key); err != nil {
+			return err
+		}
+		ah.allowanceChanged(ctx, indexerstream.GrantChangeOp_GRANT_CHANGE_OP_DELETE, granterAddr, granteeAddr, nil)
+		return nil
 	}
 
 	feegrant := feegrant.Grant{}
@@ -101,5 +162,158 @@ func (ah *FeeGrantHandler) HandleUpdate(ctx context.Context, pair *storetypes.St
 	if err != nil {
 		return err
 	}
-	return ah.SetGrant(ctx, granterAddr, granteeAddr, feegrant)
+	op, err := ah.setOp(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := ah.SetGrant(ctx, granterAddr, granteeAddr, feegrant); err != nil {
+		return err
+	}
+	ah.allowanceChanged(ctx, op, granterAddr, granteeAddr, &feegrant)
+	return nil
+}
+
+// setOp returns whether setting the allowance at key creates or updates it,
+// the index is only read when a listener is set.
+func (ah *FeeGrantHandler) setOp(ctx context.Context, key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (indexerstream.GrantChangeOp, error) {
+	if ah.listener == nil {
+		return indexerstream.GrantChangeOp_GRANT_CHANGE_OP_UNSPECIFIED, nil
+	}
+	has, err := ah.FeeAllowances.Has(ctx, key)
+	if err != nil {
+		return indexerstream.GrantChangeOp_GRANT_CHANGE_OP_UNSPECIFIED, err
+	}
+	if has {
+		return indexerstream.GrantChangeOp_GRANT_CHANGE_OP_UPDATE, nil
+	}
+	return indexerstream.GrantChangeOp_GRANT_CHANGE_OP_CREATE, nil
+}
+
+func (ah *FeeGrantHandler) allowanceChanged(ctx context.Context, op indexerstream.GrantChangeOp, granter, grantee sdk.AccAddress, grant *feegrant.Grant) {
+	if ah.listener != nil {
+		ah.listener.FeeAllowanceChanged(ctx, op, granter, grantee, grant)
+	}
+}
+
+// Now returns the time allowance expiration is evaluated at, the time of the
+// latest indexed block.
+func (ah *FeeGrantHandler) Now() time.Time {
+	return ah.clock.Now()
+}
+
+// PruneExpired records blockTime as the time of the latest indexed block and,
+// if prune is set, removes up to MaxPrunedGrantsPerBlock allowances that
+// expired before it. The spends of pruned allowances are kept.
+func (ah *FeeGrantHandler) PruneExpired(ctx context.Context, blockTime time.Time, prune bool) error {
+	ah.clock.Set(blockTime)
+	if !prune || blockTime.IsZero() {
+		return nil
+	}
+	expired, err := ah.FeeAllowances.Indexes.Expiration.Expired(ctx, blockTime, MaxPrunedGrantsPerBlock)
+	if err != nil {
+		return err
+	}
+	for _, key := range expired {
+		if err := ah.FeeAllowances.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSpend returns the fees granter paid for grantee.
+func (ah *FeeGrantHandler) GetSpend(ctx context.Context, granter, grantee sdk.AccAddress) (indexerfeegrant.GrantSpend, error) {
+	return ah.Spends.Get(ctx, collections.Join(granter, grantee))
+}
+
+// AddSpend adds a fee granter paid for grantee at height.
+func (ah *FeeGrantHandler) AddSpend(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, height int64) error {
+	key := collections.Join(granter, grantee)
+	spend, err := ah.Spends.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		spend = indexerfeegrant.GrantSpend{
+			Granter: granter.String(),
+			Grantee: grantee.String(),
+		}
+	}
+
+	spend.Spent = spend.Spent.Add(fee...)
+	spend.TxCount++
+	spend.LastHeight = height
+	return ah.Spends.Set(ctx, key, spend)
+}
+
+// HandleTxEvents records the fee paid by a fee grant, if any, in the events
+// of a transaction result.
+func (ah *FeeGrantHandler) HandleTxEvents(ctx context.Context, addrCodec address.Codec, height int64, events []abci.Event) error {
+	granter, grantee, fee, found, err := ParseGrantSpendEvents(events, addrCodec)
+	if err != nil || !found {
+		return err
+	}
+	return ah.AddSpend(ctx, granter, grantee, fee, height)
+}
+
+// ParseGrantSpendEvents returns the grant that paid the fee of a transaction
+// from its events. The fee_allowance_used event of the xion allowances is
+// used when present, otherwise the SDK use_feegrant event together with the
+// fee of the tx event.
+// This is pure business logic that can be fully unit tested
+func ParseGrantSpendEvents(events []abci.Event, addrCodec address.Codec) (
+	granterAddr sdk.AccAddress,
+	granteeAddr sdk.AccAddress,
+	fee sdk.Coins,
+	found bool,
+	err error,
+) {
+	var granter, grantee, feeStr string
+	for _, event := range events {
+		switch event.Type {
+		case xiontypes.EventTypeFeeAllowanceUsed:
+			attrs := eventAttributes(event)
+			if attrs[feegrant.AttributeKeyGranter] == "" || attrs[feegrant.AttributeKeyGrantee] == "" {
+				continue
+			}
+			granter, grantee = attrs[feegrant.AttributeKeyGranter], attrs[feegrant.AttributeKeyGrantee]
+			feeStr, found = attrs[sdk.AttributeKeyFee], true
+		case feegrant.EventTypeUseFeeGrant:
+			if found {
+				continue
+			}
+			attrs := eventAttributes(event)
+			granter, grantee = attrs[feegrant.AttributeKeyGranter], attrs[feegrant.AttributeKeyGrantee]
+		case sdk.EventTypeTx:
+			if value, ok := eventAttributes(event)[sdk.AttributeKeyFee]; ok && !found {
+				feeStr = value
+			}
+		}
+	}
+	if granter == "" || grantee == "" {
+		return nil, nil, nil, false, nil
+	}
+
+	granterBytes, err := addrCodec.StringToBytes(granter)
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+	granteeBytes, err := addrCodec.StringToBytes(grantee)
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+	fee, err = sdk.ParseCoinsNormalized(feeStr)
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+
+	return sdk.AccAddress(granterBytes), sdk.AccAddress(granteeBytes), fee, true, nil
+}
+
+func eventAttributes(event abci.Event) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
 }
@@ -4,6 +4,10 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
+
+	"github.com/gogo/status"
+	"google.golang.org/grpc/codes"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -118,10 +122,13 @@ func (fq *feegrantQuerier) Allowances(ctx context.Context, req *indexerfeegrant.
 	}
 	granteeAddr := sdk.AccAddress(grantee)
 
-	allowances, pageRes, err := query.CollectionPaginate(
+	allowances, pageRes, err := query.CollectionFilteredPaginate(
 		ctx,
 		fq.feegrantHandler.FeeAllowances.Indexes.Grantee,
 		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (bool, error) {
+			return fq.allowanceMatches(ctx, collections.Join(key.K2(), key.K1()), req.Expiration)
+		},
 		// key is the reverse key pair reference
 		// value is empty because index only stores keys in a KeySet
 		// final key in the callback is (grantee, granter)
@@ -151,10 +158,13 @@ func (fq *feegrantQuerier) AllowancesByGranter(ctx context.Context, req *indexer
 	}
 	granterAddr := sdk.AccAddress(granter)
 
-	allowances, pageRes, err := query.CollectionPaginate(
+	allowances, pageRes, err := query.CollectionFilteredPaginate(
 		ctx,
 		fq.feegrantHandler.FeeAllowances,
 		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], grant feegrant.Grant) (bool, error) {
+			return fq.matchesExpiration(AllowanceExpiration(grant), req.Expiration), nil
+		},
 		// iterate over the original key space (granter, grantee)
 		// value is the grant
 		// final key in the callback is (granter, grantee)
@@ -172,3 +182,128 @@ func (fq *feegrantQuerier) AllowancesByGranter(ctx context.Context, req *indexer
 		Pagination: pageRes,
 	}, nil
 }
+
+// matchesExpiration returns true if an allowance with expiration matches
+// filter at the time of the latest indexed block.
+func (fq *feegrantQuerier) matchesExpiration(expiration *time.Time, filter indexerfeegrant.ExpirationFilter) bool {
+	return MatchesExpiration(
+		expiration,
+		filter.Status == indexerfeegrant.ExpirationStatus_EXPIRATION_STATUS_ACTIVE,
+		filter.Status == indexerfeegrant.ExpirationStatus_EXPIRATION_STATUS_EXPIRED,
+		filter.ExpiringBefore,
+		fq.feegrantHandler.Now(),
+	)
+}
+
+// allowanceMatches returns true if the allowance stored at key matches
+// filter, the allowance is only fetched if filter is set.
+func (fq *feegrantQuerier) allowanceMatches(ctx context.Context, key collections.Pair[sdk.AccAddress, sdk.AccAddress], filter indexerfeegrant.ExpirationFilter) (bool, error) {
+	if filter.Status == indexerfeegrant.ExpirationStatus_EXPIRATION_STATUS_UNSPECIFIED && filter.ExpiringBefore == nil {
+		return true, nil
+	}
+	grant, err := fq.feegrantHandler.FeeAllowances.Get(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	return fq.matchesExpiration(AllowanceExpiration(grant), filter), nil
+}
+
+func (fq *feegrantQuerier) GrantSpend(ctx context.Context, req *indexerfeegrant.QueryGrantSpendRequest) (*indexerfeegrant.QueryGrantSpendResponse, error) {
+	granter, err := fq.addrCodec.StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := fq.addrCodec.StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	spend, err := fq.feegrantHandler.GetSpend(ctx, sdk.AccAddress(granter), sdk.AccAddress(grantee))
+	if err != nil {
+		// If no fees were paid, return nil spend but no error
+		if errors.Is(err, collections.ErrNotFound) {
+			return &indexerfeegrant.QueryGrantSpendResponse{
+				Spend: nil,
+			}, nil
+		}
+		return nil, err
+	}
+	return &indexerfeegrant.QueryGrantSpendResponse{
+		Spend: &spend,
+	}, nil
+}
+
+func (fq *feegrantQuerier) GrantSpendsByGranter(ctx context.Context, req *indexerfeegrant.QueryGrantSpendsByGranterRequest) (*indexerfeegrant.QueryGrantSpendsByGranterResponse, error) {
+	granter, err := fq.addrCodec.StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+	granterAddr := sdk.AccAddress(granter)
+
+	spends, pageRes, err := query.CollectionPaginate(
+		ctx,
+		fq.feegrantHandler.Spends,
+		req.Pagination,
+		// iterate over the original key space (granter, grantee)
+		func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], spend indexerfeegrant.GrantSpend) (*indexerfeegrant.GrantSpend, error) {
+			return &spend, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](granterAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("feegrant_querier", "spends", len(spends))
+	return &indexerfeegrant.QueryGrantSpendsByGranterResponse{
+		Spends:     spends,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (fq *feegrantQuerier) ExpiringAllowances(ctx context.Context, req *indexerfeegrant.QueryExpiringAllowancesRequest) (*indexerfeegrant.QueryExpiringAllowancesResponse, error) {
+	if req.ExpiringBefore == nil {
+		return nil, status.Error(codes.InvalidArgument, "expiring_before is required")
+	}
+	var granterAddr sdk.AccAddress
+	if req.Granter != "" {
+		granter, err := fq.addrCodec.StringToBytes(req.Granter)
+		if err != nil {
+			return nil, err
+		}
+		granterAddr = sdk.AccAddress(granter)
+	}
+
+	expirations := expirationRangeCollection[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]{
+		index: fq.feegrantHandler.FeeAllowances.Indexes.Expiration,
+		until: *req.ExpiringBefore,
+	}
+	if req.ActiveOnly {
+		now := fq.feegrantHandler.Now()
+		expirations.from = &now
+	}
+
+	allowances, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		expirations,
+		req.Pagination,
+		// key is (expiration, (granter, grantee))
+		func(key collections.Pair[time.Time, collections.Pair[sdk.AccAddress, sdk.AccAddress]], _ collections.NoValue) (bool, error) {
+			return granterAddr == nil || key.K2().K1().Equals(granterAddr), nil
+		},
+		func(key collections.Pair[time.Time, collections.Pair[sdk.AccAddress, sdk.AccAddress]], _ collections.NoValue) (*feegrant.Grant, error) {
+			grant, err := fq.feegrantHandler.FeeAllowances.Get(ctx, key.K2())
+			if err != nil {
+				return nil, err
+			}
+			return &grant, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("feegrant_querier", "expiring_allowances", len(allowances))
+	return &indexerfeegrant.QueryExpiringAllowancesResponse{
+		Allowances: allowances,
+		Pagination: pageRes,
+	}, nil
+}
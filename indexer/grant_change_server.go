@@ -0,0 +1,171 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	indexerstream "github.com/burnt-labs/xion/indexer/stream"
+)
+
+// GrantChangesWebsocketPath is the route of the websocket bridge of the grant
+// change subscription, it takes the fields of SubscribeGrantChangesRequest
+// as query parameters.
+const GrantChangesWebsocketPath = "/xion/indexer/stream/v1/grant_changes"
+
+// maxCloseReasonBytes is the longest reason a websocket close frame carries.
+const maxCloseReasonBytes = 123
+
+type subscriptionServer struct {
+	grantChanges *GrantChangeLog
+	addrCodec    address.Codec
+}
+
+var _ indexerstream.SubscriptionServer = &subscriptionServer{}
+
+func newSubscriptionServer(grantChanges *GrantChangeLog, addrCodec address.Codec) indexerstream.SubscriptionServer {
+	return &subscriptionServer{grantChanges, addrCodec}
+}
+
+func (s *subscriptionServer) GrantChanges(req *indexerstream.SubscribeGrantChangesRequest, stream indexerstream.Subscription_GrantChangesServer) error {
+	filter, err := NewGrantChangeFilter(s.addrCodec, req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return s.grantChanges.Subscribe(stream.Context(), filter, req.FromHeight, stream.Send)
+}
+
+// NewGrantChangeFilter validates the filter of a subscription request.
+func NewGrantChangeFilter(addrCodec address.Codec, req *indexerstream.SubscribeGrantChangesRequest) (GrantChangeFilter, error) {
+	if req.FromHeight < 0 {
+		return GrantChangeFilter{}, fmt.Errorf("invalid from height %d", req.FromHeight)
+	}
+	switch req.Module {
+	case "", authz.ModuleName, feegrant.ModuleName:
+	default:
+		return GrantChangeFilter{}, fmt.Errorf("invalid module %q, expected %q or %q", req.Module, authz.ModuleName, feegrant.ModuleName)
+	}
+	for _, addr := range []string{req.Granter, req.Grantee} {
+		if addr == "" {
+			continue
+		}
+		if _, err := addrCodec.StringToBytes(addr); err != nil {
+			return GrantChangeFilter{}, fmt.Errorf("invalid address %q: %w", addr, err)
+		}
+	}
+	return GrantChangeFilter{Granter: req.Granter, Grantee: req.Grantee, Module: req.Module}, nil
+}
+
+// RegisterWebsocketRoutes registers the websocket bridge of the grant change
+// subscription. Cross origin clients are only accepted if allowAnyOrigin is
+// set.
+func (ss *StreamService) RegisterWebsocketRoutes(router *mux.Router, allowAnyOrigin bool) {
+	ss.log.Info("registering grant change subscription websocket route")
+	router.Handle(GrantChangesWebsocketPath, NewGrantChangesWebsocketHandler(ss.grantChanges, ss.cdc, ss.addrCodec, allowAnyOrigin, ss.log)).
+		Methods(http.MethodGet)
+}
+
+// NewGrantChangesWebsocketHandler streams the grant changes to a websocket as
+// JSON text messages. The connection is closed with the reason the
+// subscription ended, e.g. the height to resume from.
+func NewGrantChangesWebsocketHandler(grantChanges *GrantChangeLog, cdc codec.JSONCodec, addrCodec address.Codec, allowAnyOrigin bool, logger log.Logger) http.Handler {
+	upgrader := websocket.Upgrader{}
+	if allowAnyOrigin {
+		upgrader.CheckOrigin = func(*http.Request) bool { return true }
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseSubscribeGrantChangesQuery(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		filter, err := NewGrantChangeFilter(addrCodec, req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader replied with the error
+			return
+		}
+		defer conn.Close()
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		// clients only send control messages, reading processes them and
+		// notices when the client leaves
+		go func() {
+			defer cancel()
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		err = grantChanges.Subscribe(ctx, filter, req.FromHeight, func(change *indexerstream.GrantChange) error {
+			bz, err := cdc.MarshalJSON(change)
+			if err != nil {
+				return err
+			}
+			return conn.WriteMessage(websocket.TextMessage, bz)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Debug("grant change subscription ended", "error", err)
+
+		reason := err.Error()
+		if len(reason) > maxCloseReasonBytes {
+			reason = reason[:maxCloseReasonBytes]
+		}
+		_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocketCloseCode(err), reason), time.Now().Add(time.Second))
+	})
+}
+
+func websocketCloseCode(err error) int {
+	switch status.Code(err) {
+	case codes.ResourceExhausted:
+		return websocket.CloseTryAgainLater
+	case codes.OutOfRange:
+		return websocket.ClosePolicyViolation
+	default:
+		return websocket.CloseInternalServerErr
+	}
+}
+
+// ParseSubscribeGrantChangesQuery reads a subscription request from the
+// query parameters of the websocket route.
+func ParseSubscribeGrantChangesQuery(query url.Values) (*indexerstream.SubscribeGrantChangesRequest, error) {
+	req := &indexerstream.SubscribeGrantChangesRequest{
+		Granter: query.Get("granter"),
+		Grantee: query.Get("grantee"),
+		Module:  query.Get("module"),
+	}
+	if fromHeight := query.Get("from_height"); fromHeight != "" {
+		height, err := strconv.ParseInt(fromHeight, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from_height %q: %w", fromHeight, err)
+		}
+		req.FromHeight = height
+	}
+	return req, nil
+}
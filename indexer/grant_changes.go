@@ -0,0 +1,293 @@
+package indexer
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	core "cosmossdk.io/collections/corecompat"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	indexerstream "github.com/burnt-labs/xion/indexer/stream"
+)
+
+var (
+	GrantChangesPrefix            = collections.NewPrefix(0)
+	GrantChangePrunedHeightPrefix = collections.NewPrefix(1)
+)
+
+// DefaultGrantChangeRetentionBlocks is the number of blocks grant changes are
+// kept for to resume subscriptions.
+const DefaultGrantChangeRetentionBlocks uint64 = 100_000
+
+// grantChangeSubscriberBuffer is the number of blocks of changes a
+// subscriber can fall behind before it is dropped.
+const grantChangeSubscriberBuffer = 1024
+
+// GrantChangeListener receives the grant and allowance changes the authz and
+// feegrant handlers apply from the change sets. grant is nil for deletes.
+type GrantChangeListener interface {
+	AuthzGrantChanged(ctx context.Context, op indexerstream.GrantChangeOp, granter, grantee sdk.AccAddress, msgType string, grant *authz.Grant)
+	FeeAllowanceChanged(ctx context.Context, op indexerstream.GrantChangeOp, granter, grantee sdk.AccAddress, grant *feegrant.Grant)
+}
+
+// GrantChangeFilter selects the changes streamed to a subscriber, empty
+// fields match any change.
+type GrantChangeFilter struct {
+	Granter string
+	Grantee string
+	Module  string
+}
+
+// Match returns true if change passes the filter.
+func (f GrantChangeFilter) Match(change *indexerstream.GrantChange) bool {
+	return (f.Granter == "" || f.Granter == change.Granter) &&
+		(f.Grantee == "" || f.Grantee == change.Grantee) &&
+		(f.Module == "" || f.Module == change.Module)
+}
+
+type grantChangeSubscriber struct {
+	changes chan []indexerstream.GrantChange
+}
+
+var _ GrantChangeListener = &GrantChangeLog{}
+
+// GrantChangeLog records the grant changes of each block so subscriptions can
+// resume from a height, and pushes them to the subscribers once the block is
+// written.
+type GrantChangeLog struct {
+	kvStoreService core.KVStoreService
+	addrCodec      address.Codec
+	log            log.Logger
+	Schema         collections.Schema
+	// key: (height, index)
+	// value: change
+	Changes collections.Map[collections.Pair[int64, uint32], indexerstream.GrantChange]
+	// PrunedHeight is the last height whose changes were pruned.
+	PrunedHeight collections.Item[int64]
+
+	retentionBlocks uint64
+
+	// height and pending are the block being indexed
+	height  int64
+	pending []indexerstream.GrantChange
+
+	mu          sync.Mutex
+	subscribers map[*grantChangeSubscriber]struct{}
+}
+
+func NewGrantChangeLog(kvStoreService core.KVStoreService, cdc codec.Codec, addrCodec address.Codec, logger log.Logger) (*GrantChangeLog, error) {
+	sb := collections.NewSchemaBuilder(kvStoreService)
+
+	changes := collections.NewMap(
+		sb,
+		GrantChangesPrefix,
+		"grant_changes", // name of the collection
+		collections.PairKeyCodec(collections.Int64Key, collections.Uint32Key),
+		codec.CollValue[indexerstream.GrantChange](cdc),
+	)
+	prunedHeight := collections.NewItem(sb, GrantChangePrunedHeightPrefix, "grant_change_pruned_height", collections.Int64Value)
+
+	schema, err := sb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GrantChangeLog{
+		Schema:          schema,
+		kvStoreService:  kvStoreService,
+		addrCodec:       addrCodec,
+		log:             logger,
+		Changes:         changes,
+		PrunedHeight:    prunedHeight,
+		retentionBlocks: DefaultGrantChangeRetentionBlocks,
+		subscribers:     map[*grantChangeSubscriber]struct{}{},
+	}, nil
+}
+
+// Configure sets how long the changes are kept for.
+func (gl *GrantChangeLog) Configure(cfg Config) {
+	gl.retentionBlocks = cfg.GrantChangeRetentionBlocks
+	if gl.retentionBlocks == 0 {
+		gl.retentionBlocks = DefaultGrantChangeRetentionBlocks
+	}
+}
+
+// Begin starts recording the changes of the block at height.
+func (gl *GrantChangeLog) Begin(height int64) {
+	gl.height = height
+	gl.pending = nil
+}
+
+func (gl *GrantChangeLog) AuthzGrantChanged(ctx context.Context, op indexerstream.GrantChangeOp, granter, grantee sdk.AccAddress, msgType string, grant *authz.Grant) {
+	gl.record(ctx, authz.ModuleName, op, granter, grantee, func(change *indexerstream.GrantChange) {
+		change.MsgTypeUrl = msgType
+		change.AuthzGrant = grant
+	})
+}
+
+func (gl *GrantChangeLog) FeeAllowanceChanged(ctx context.Context, op indexerstream.GrantChangeOp, granter, grantee sdk.AccAddress, grant *feegrant.Grant) {
+	gl.record(ctx, feegrant.ModuleName, op, granter, grantee, func(change *indexerstream.GrantChange) {
+		change.Allowance = grant
+	})
+}
+
+func (gl *GrantChangeLog) record(ctx context.Context, module string, op indexerstream.GrantChangeOp, granter, grantee sdk.AccAddress, set func(*indexerstream.GrantChange)) {
+	granterStr, err := gl.addrCodec.BytesToString(granter)
+	if err != nil {
+		gl.log.Error("Failed to encode granter of grant change", "height", gl.height, "error", err)
+		return
+	}
+	granteeStr, err := gl.addrCodec.BytesToString(grantee)
+	if err != nil {
+		gl.log.Error("Failed to encode grantee of grant change", "height", gl.height, "error", err)
+		return
+	}
+
+	change := indexerstream.GrantChange{
+		Height:  gl.height,
+		Index:   uint32(len(gl.pending)),
+		Module:  module,
+		Op:      op,
+		Granter: granterStr,
+		Grantee: granteeStr,
+	}
+	set(&change)
+	if err := gl.Changes.Set(ctx, collections.Join(change.Height, change.Index), change); err != nil {
+		gl.log.Error("Failed to record grant change", "height", gl.height, "error", err)
+		return
+	}
+	gl.pending = append(gl.pending, change)
+}
+
+// Prune removes the changes that are older than the retention at height.
+func (gl *GrantChangeLog) Prune(ctx context.Context, height int64) error {
+	if height <= int64(gl.retentionBlocks) {
+		return nil
+	}
+	cutoff := height - int64(gl.retentionBlocks)
+	expired := new(collections.Range[collections.Pair[int64, uint32]]).
+		EndExclusive(collections.PairPrefix[int64, uint32](cutoff))
+	if err := gl.Changes.Clear(ctx, expired); err != nil {
+		return err
+	}
+	return gl.PrunedHeight.Set(ctx, cutoff-1)
+}
+
+// Publish pushes the changes of the block to the subscribers, it is called
+// once the block is written. Subscribers that fell behind are dropped.
+func (gl *GrantChangeLog) Publish() {
+	changes := gl.pending
+	gl.pending = nil
+	if len(changes) == 0 {
+		return
+	}
+
+	gl.mu.Lock()
+	defer gl.mu.Unlock()
+	for sub := range gl.subscribers {
+		select {
+		case sub.changes <- changes:
+		default:
+			close(sub.changes)
+			delete(gl.subscribers, sub)
+		}
+	}
+}
+
+func (gl *GrantChangeLog) subscribe() *grantChangeSubscriber {
+	sub := &grantChangeSubscriber{changes: make(chan []indexerstream.GrantChange, grantChangeSubscriberBuffer)}
+	gl.mu.Lock()
+	gl.subscribers[sub] = struct{}{}
+	gl.mu.Unlock()
+	return sub
+}
+
+func (gl *GrantChangeLog) unsubscribe(sub *grantChangeSubscriber) {
+	gl.mu.Lock()
+	delete(gl.subscribers, sub)
+	gl.mu.Unlock()
+}
+
+// Subscribe sends the changes matching filter until ctx is done. If
+// fromHeight is set the retained changes from that height are replayed
+// first. A subscriber that falls behind gets a ResourceExhausted error with
+// the height to resume from.
+func (gl *GrantChangeLog) Subscribe(ctx context.Context, filter GrantChangeFilter, fromHeight int64, send func(*indexerstream.GrantChange) error) error {
+	// subscribe before the replay so no block is missed in between, the
+	// blocks seen twice are skipped
+	sub := gl.subscribe()
+	defer gl.unsubscribe(sub)
+
+	// next is the position of the first change not seen yet
+	next := collections.Join(fromHeight, uint32(0))
+	deliver := func(change *indexerstream.GrantChange) error {
+		if change.Height < next.K1() || (change.Height == next.K1() && change.Index < next.K2()) {
+			return nil
+		}
+		next = collections.Join(change.Height, change.Index+1)
+		if !filter.Match(change) {
+			return nil
+		}
+		return send(change)
+	}
+
+	if fromHeight > 0 {
+		prunedHeight, err := itemOrZero(ctx, gl.PrunedHeight)
+		if err != nil {
+			return err
+		}
+		if fromHeight <= prunedHeight {
+			return status.Errorf(codes.OutOfRange, "changes up to height %d were pruned", prunedHeight)
+		}
+		if err := gl.replay(ctx, fromHeight, deliver); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case changes, ok := <-sub.changes:
+			if !ok {
+				return status.Errorf(codes.ResourceExhausted, "subscriber fell behind, resume from height %d", next.K1())
+			}
+			for i := range changes {
+				if err := deliver(&changes[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// replay delivers the retained changes from fromHeight.
+func (gl *GrantChangeLog) replay(ctx context.Context, fromHeight int64, deliver func(*indexerstream.GrantChange) error) error {
+	retained := new(collections.Range[collections.Pair[int64, uint32]]).
+		StartInclusive(collections.PairPrefix[int64, uint32](fromHeight))
+	iter, err := gl.Changes.Iterate(ctx, retained)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		change, err := iter.Value()
+		if err != nil {
+			return err
+		}
+		if err := deliver(&change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	indexerstream "github.com/burnt-labs/xion/indexer/stream"
+)
+
+type grantChangeFixture struct {
+	t       *testing.T
+	service *StreamService
+
+	granter, grantee       sdk.AccAddress
+	granterStr, granteeStr string
+	grantValue             []byte
+	allowanceValue         []byte
+}
+
+func newGrantChangeFixture(t *testing.T) *grantChangeFixture {
+	t.Helper()
+	memDB, cdc, addrCodec := setupTest(t)
+	f := &grantChangeFixture{
+		t:       t,
+		service: NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger()),
+		granter: sdk.AccAddress([]byte("granter_address_____")),
+		grantee: sdk.AccAddress([]byte("grantee_address_____")),
+	}
+	f.granterStr, _ = addrCodec.BytesToString(f.granter)
+	f.granteeStr, _ = addrCodec.BytesToString(f.grantee)
+
+	grant, err := authz.NewGrant(time.Now(), authz.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgSend"), nil)
+	require.NoError(t, err)
+	f.grantValue, err = cdc.Marshal(&grant)
+	require.NoError(t, err)
+
+	allowance, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{})
+	require.NoError(t, err)
+	f.allowanceValue, err = cdc.Marshal(&feegrant.Grant{Granter: f.granterStr, Grantee: f.granteeStr, Allowance: allowance})
+	require.NoError(t, err)
+	return f
+}
+
+func (f *grantChangeFixture) commit(height int64, pairs ...*storetypes.StoreKVPair) {
+	ctx := context.Background()
+	require.NoError(f.t, f.service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: height}, abci.ResponseFinalizeBlock{}))
+	require.NoError(f.t, f.service.ListenCommit(ctx, abci.ResponseCommit{}, pairs))
+}
+
+func (f *grantChangeFixture) grantPair(deleted bool) *storetypes.StoreKVPair {
+	return &storetypes.StoreKVPair{
+		StoreKey: authz.ModuleName,
+		Key:      createGrantStoreKey(f.granter, f.grantee, "/cosmos.bank.v1beta1.MsgSend"),
+		Value:    f.grantValue,
+		Delete:   deleted,
+	}
+}
+
+func (f *grantChangeFixture) allowancePair() *storetypes.StoreKVPair {
+	return &storetypes.StoreKVPair{
+		StoreKey: feegrant.ModuleName,
+		Key:      feegrant.FeeAllowanceKey(f.granter, f.grantee),
+		Value:    f.allowanceValue,
+	}
+}
+
+// collect subscribes until n changes are received.
+func collect(t *testing.T, gl *GrantChangeLog, filter GrantChangeFilter, fromHeight int64, n int) []indexerstream.GrantChange {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var changes []indexerstream.GrantChange
+	err := gl.Subscribe(ctx, filter, fromHeight, func(change *indexerstream.GrantChange) error {
+		changes = append(changes, *change)
+		if len(changes) == n {
+			cancel()
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	return changes
+}
+
+func TestGrantChangeLog(t *testing.T) {
+	f := newGrantChangeFixture(t)
+	gl := f.service.GrantChanges()
+
+	f.commit(1, f.grantPair(false), f.allowancePair())
+	f.commit(2, f.grantPair(false))
+	f.commit(3, f.grantPair(true))
+	// deleting a grant that is not indexed is not a change
+	f.commit(4, f.grantPair(true))
+
+	changes := collect(t, gl, GrantChangeFilter{}, 1, 4)
+	require.Len(t, changes, 4)
+	require.Equal(t, int64(1), changes[0].Height)
+	require.Equal(t, uint32(0), changes[0].Index)
+	require.Equal(t, authz.ModuleName, changes[0].Module)
+	require.Equal(t, indexerstream.GrantChangeOp_GRANT_CHANGE_OP_CREATE, changes[0].Op)
+	require.Equal(t, f.granterStr, changes[0].Granter)
+	require.Equal(t, f.granteeStr, changes[0].Grantee)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", changes[0].MsgTypeUrl)
+	require.NotNil(t, changes[0].AuthzGrant)
+	require.Equal(t, uint32(1), changes[1].Index)
+	require.Equal(t, feegrant.ModuleName, changes[1].Module)
+	require.NotNil(t, changes[1].Allowance)
+	require.Equal(t, indexerstream.GrantChangeOp_GRANT_CHANGE_OP_UPDATE, changes[2].Op)
+	require.Equal(t, indexerstream.GrantChangeOp_GRANT_CHANGE_OP_DELETE, changes[3].Op)
+	require.Nil(t, changes[3].AuthzGrant)
+
+	// resume from a height with a filter
+	changes = collect(t, gl, GrantChangeFilter{Module: authz.ModuleName, Grantee: f.granteeStr}, 2, 2)
+	require.Len(t, changes, 2)
+	require.Equal(t, int64(2), changes[0].Height)
+	require.Equal(t, int64(3), changes[1].Height)
+
+	// pruned heights cannot be resumed from
+	f.service.grantChanges.retentionBlocks = 2
+	f.commit(5)
+	pruned, err := gl.PrunedHeight.Get(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), pruned)
+	err = gl.Subscribe(context.Background(), GrantChangeFilter{}, 2, func(*indexerstream.GrantChange) error { return nil })
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+	changes = collect(t, gl, GrantChangeFilter{}, 3, 1)
+	require.Equal(t, int64(3), changes[0].Height)
+}
+
+func TestGrantChangeSubscription(t *testing.T) {
+	f := newGrantChangeFixture(t)
+	gl := f.service.GrantChanges()
+
+	f.commit(1, f.grantPair(false))
+
+	received := make(chan []indexerstream.GrantChange, 1)
+	go func() {
+		received <- collect(t, gl, GrantChangeFilter{}, 1, 3)
+	}()
+	require.Eventually(t, func() bool {
+		gl.mu.Lock()
+		defer gl.mu.Unlock()
+		return len(gl.subscribers) == 1
+	}, time.Second, time.Millisecond)
+
+	// live changes follow the replayed ones without duplicates
+	f.commit(2, f.allowancePair())
+	f.commit(3, f.grantPair(true))
+	changes := <-received
+	require.Len(t, changes, 3)
+	for i, change := range changes {
+		require.Equal(t, int64(i+1), change.Height)
+	}
+
+	// a subscriber that falls behind is dropped with the height to resume from
+	errs := make(chan error, 1)
+	go func() {
+		errs <- gl.Subscribe(context.Background(), GrantChangeFilter{}, 0, func(*indexerstream.GrantChange) error {
+			time.Sleep(time.Millisecond)
+			return nil
+		})
+	}()
+	require.Eventually(t, func() bool {
+		gl.mu.Lock()
+		defer gl.mu.Unlock()
+		return len(gl.subscribers) == 1
+	}, time.Second, time.Millisecond)
+	for i := 0; i <= grantChangeSubscriberBuffer+1; i++ {
+		gl.pending = []indexerstream.GrantChange{{Height: int64(10 + i)}}
+		gl.Publish()
+	}
+	err := <-errs
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestGrantChangeServer(t *testing.T) {
+	_, _, addrCodec := setupTest(t)
+	granter := sdk.AccAddress([]byte("granter_address_____"))
+	granterStr, _ := addrCodec.BytesToString(granter)
+
+	filter, err := NewGrantChangeFilter(addrCodec, &indexerstream.SubscribeGrantChangesRequest{Granter: granterStr, Module: feegrant.ModuleName})
+	require.NoError(t, err)
+	require.Equal(t, GrantChangeFilter{Granter: granterStr, Module: feegrant.ModuleName}, filter)
+	require.True(t, filter.Match(&indexerstream.GrantChange{Granter: granterStr, Module: feegrant.ModuleName}))
+	require.False(t, filter.Match(&indexerstream.GrantChange{Granter: granterStr, Module: authz.ModuleName}))
+
+	_, err = NewGrantChangeFilter(addrCodec, &indexerstream.SubscribeGrantChangesRequest{Module: "bank"})
+	require.Error(t, err)
+	_, err = NewGrantChangeFilter(addrCodec, &indexerstream.SubscribeGrantChangesRequest{Grantee: "invalid"})
+	require.Error(t, err)
+	_, err = NewGrantChangeFilter(addrCodec, &indexerstream.SubscribeGrantChangesRequest{FromHeight: -1})
+	require.Error(t, err)
+
+	req, err := ParseSubscribeGrantChangesQuery(map[string][]string{"granter": {granterStr}, "from_height": {"12"}})
+	require.NoError(t, err)
+	require.Equal(t, granterStr, req.Granter)
+	require.Equal(t, int64(12), req.FromHeight)
+	_, err = ParseSubscribeGrantChangesQuery(map[string][]string{"from_height": {"latest"}})
+	require.Error(t, err)
+}
+
+func TestGrantChangesWebsocket(t *testing.T) {
+	f := newGrantChangeFixture(t)
+	f.commit(1, f.grantPair(false))
+
+	handler := NewGrantChangesWebsocketHandler(f.service.GrantChanges(), f.service.cdc, f.service.addrCodec, false, log.NewNopLogger())
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	// invalid requests are rejected before the upgrade
+	_, res, err := websocket.DefaultDialer.Dial(wsURL+"?module=bank", nil)
+	require.Error(t, err)
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL+"?from_height=1&grantee="+f.granteeStr, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	// replayed change
+	_, msg, err := conn.ReadMessage()
+	require.NoError(t, err)
+	var change map[string]any
+	require.NoError(t, json.Unmarshal(msg, &change))
+	require.Equal(t, "1", change["height"])
+	require.Equal(t, "GRANT_CHANGE_OP_CREATE", change["op"])
+
+	// live change
+	f.commit(2, f.allowancePair())
+	_, msg, err = conn.ReadMessage()
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(msg, &change))
+	require.Equal(t, "2", change["height"])
+	require.Equal(t, feegrant.ModuleName, change["module"])
+}
@@ -0,0 +1,137 @@
+package indexer
+
+import (
+	"context"
+	"sync/atomic"
+
+	"cosmossdk.io/log"
+
+	indexerhealth "github.com/burnt-labs/xion/indexer/health"
+)
+
+// Handlers whose errors are counted by the health query.
+const (
+	HealthHandlerAuthz         = "authz"
+	HealthHandlerFeeGrant      = "feegrant"
+	HealthHandlerAuthenticator = "authenticator"
+	HealthHandlerExpiration    = "expiration"
+	HealthHandlerFeeGrantSpend = "feegrant_spend"
+	HealthHandlerWasmHistory   = "wasm_history"
+	HealthHandlerBank          = "bank"
+	HealthHandlerGrantChanges  = "grant_changes"
+	HealthHandlerStore         = "store"
+	HealthHandlerExport        = "export"
+)
+
+// healthHandlers are the handlers in the order they are reported.
+var healthHandlers = []string{
+	HealthHandlerAuthz,
+	HealthHandlerFeeGrant,
+	HealthHandlerAuthenticator,
+	HealthHandlerExpiration,
+	HealthHandlerFeeGrantSpend,
+	HealthHandlerWasmHistory,
+	HealthHandlerBank,
+	HealthHandlerGrantChanges,
+	HealthHandlerStore,
+	HealthHandlerExport,
+}
+
+// indexerHealth tracks the app height and the errors of the handlers since
+// the node started.
+type indexerHealth struct {
+	appHeight atomic.Int64
+	// diverged is set once a handler of state that a rebuild restores fails,
+	// the checkpoint then stops advancing so the next start rebuilds.
+	diverged atomic.Bool
+	errors   map[string]*atomic.Uint64
+}
+
+func newIndexerHealth() *indexerHealth {
+	h := &indexerHealth{errors: make(map[string]*atomic.Uint64, len(healthHandlers))}
+	for _, handler := range healthHandlers {
+		h.errors[handler] = &atomic.Uint64{}
+	}
+	return h
+}
+
+// logger returns a logger that counts the errors and warnings the handler
+// logs, the safe handlers log and swallow them. If diverges is true they also
+// mark the index as diverged.
+func (h *indexerHealth) logger(base log.Logger, handler string, diverges bool) log.Logger {
+	return healthLogger{Logger: base, health: h, handler: handler, diverges: diverges}
+}
+
+func (h *indexerHealth) record(handler string, diverges bool) {
+	h.errors[handler].Add(1)
+	if diverges {
+		h.diverged.Store(true)
+	}
+}
+
+type healthLogger struct {
+	log.Logger
+	health   *indexerHealth
+	handler  string
+	diverges bool
+}
+
+func (l healthLogger) Warn(msg string, keyVals ...any) {
+	l.health.record(l.handler, l.diverges)
+	l.Logger.Warn(msg, keyVals...)
+}
+
+func (l healthLogger) Error(msg string, keyVals ...any) {
+	l.health.record(l.handler, l.diverges)
+	l.Logger.Error(msg, keyVals...)
+}
+
+func (l healthLogger) With(keyVals ...any) log.Logger {
+	l.Logger = l.Logger.With(keyVals...)
+	return l
+}
+
+var _ indexerhealth.QueryServer = &healthQuerier{}
+
+type healthQuerier struct {
+	checkpoint *Checkpoint
+	health     *indexerHealth
+}
+
+func newHealthQuerier(checkpoint *Checkpoint, health *indexerHealth) indexerhealth.QueryServer {
+	return &healthQuerier{checkpoint, health}
+}
+
+func (hq *healthQuerier) Health(ctx context.Context, _ *indexerhealth.QueryHealthRequest) (*indexerhealth.QueryHealthResponse, error) {
+	indexedHeight, err := hq.checkpoint.Height(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rebuildHeight, err := hq.checkpoint.LastRebuild(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appHeight := hq.health.appHeight.Load()
+	lag := appHeight - indexedHeight
+	if lag < 0 {
+		lag = 0
+	}
+
+	errs := make([]indexerhealth.HandlerErrors, 0, len(healthHandlers))
+	for _, handler := range healthHandlers {
+		errs = append(errs, indexerhealth.HandlerErrors{
+			Handler: handler,
+			Count:   hq.health.errors[handler].Load(),
+		})
+	}
+
+	return &indexerhealth.QueryHealthResponse{
+		IndexedHeight: indexedHeight,
+		AppHeight:     appHeight,
+		Lag:           lag,
+		Diverged:      hq.health.diverged.Load(),
+		RebuildHeight: rebuildHeight,
+		Errors:        errs,
+	}, nil
+}
@@ -0,0 +1,953 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: xion/indexer/health/v1/query.proto
+
+package health
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HandlerErrors is the number of errors a handler logged.
+type HandlerErrors struct {
+	// handler is the name of the handler.
+	Handler string `protobuf:"bytes,1,opt,name=handler,proto3" json:"handler,omitempty"`
+	// count is the number of errors logged since the node started.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *HandlerErrors) Reset()         { *m = HandlerErrors{} }
+func (m *HandlerErrors) String() string { return proto.CompactTextString(m) }
+func (*HandlerErrors) ProtoMessage()    {}
+func (*HandlerErrors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64eeb346fd9fc762, []int{0}
+}
+func (m *HandlerErrors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandlerErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandlerErrors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandlerErrors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandlerErrors.Merge(m, src)
+}
+func (m *HandlerErrors) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandlerErrors) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandlerErrors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandlerErrors proto.InternalMessageInfo
+
+func (m *HandlerErrors) GetHandler() string {
+	if m != nil {
+		return m.Handler
+	}
+	return ""
+}
+
+func (m *HandlerErrors) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// QueryHealthRequest is the request type for the Query/Health RPC method.
+type QueryHealthRequest struct {
+}
+
+func (m *QueryHealthRequest) Reset()         { *m = QueryHealthRequest{} }
+func (m *QueryHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthRequest) ProtoMessage()    {}
+func (*QueryHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64eeb346fd9fc762, []int{1}
+}
+func (m *QueryHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthRequest.Merge(m, src)
+}
+func (m *QueryHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHealthRequest proto.InternalMessageInfo
+
+// QueryHealthResponse is the response type for the Query/Health RPC method.
+type QueryHealthResponse struct {
+	// indexed_height is the last height fully applied to the index.
+	IndexedHeight int64 `protobuf:"varint,1,opt,name=indexed_height,json=indexedHeight,proto3" json:"indexed_height,omitempty"`
+	// app_height is the last height the indexer received from the app.
+	AppHeight int64 `protobuf:"varint,2,opt,name=app_height,json=appHeight,proto3" json:"app_height,omitempty"`
+	// lag is the number of blocks the index is behind the app.
+	Lag int64 `protobuf:"varint,3,opt,name=lag,proto3" json:"lag,omitempty"`
+	// diverged is true once a handler failed, the checkpoint no longer
+	// advances and the index is rebuilt on the next start.
+	Diverged bool `protobuf:"varint,4,opt,name=diverged,proto3" json:"diverged,omitempty"`
+	// rebuild_height is the app height of the last rebuild, 0 if the index
+	// was never rebuilt.
+	RebuildHeight int64 `protobuf:"varint,5,opt,name=rebuild_height,json=rebuildHeight,proto3" json:"rebuild_height,omitempty"`
+	// errors are the error counts of each handler.
+	Errors []HandlerErrors `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors"`
+}
+
+func (m *QueryHealthResponse) Reset()         { *m = QueryHealthResponse{} }
+func (m *QueryHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthResponse) ProtoMessage()    {}
+func (*QueryHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64eeb346fd9fc762, []int{2}
+}
+func (m *QueryHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthResponse.Merge(m, src)
+}
+func (m *QueryHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHealthResponse proto.InternalMessageInfo
+
+func (m *QueryHealthResponse) GetIndexedHeight() int64 {
+	if m != nil {
+		return m.IndexedHeight
+	}
+	return 0
+}
+
+func (m *QueryHealthResponse) GetAppHeight() int64 {
+	if m != nil {
+		return m.AppHeight
+	}
+	return 0
+}
+
+func (m *QueryHealthResponse) GetLag() int64 {
+	if m != nil {
+		return m.Lag
+	}
+	return 0
+}
+
+func (m *QueryHealthResponse) GetDiverged() bool {
+	if m != nil {
+		return m.Diverged
+	}
+	return false
+}
+
+func (m *QueryHealthResponse) GetRebuildHeight() int64 {
+	if m != nil {
+		return m.RebuildHeight
+	}
+	return 0
+}
+
+func (m *QueryHealthResponse) GetErrors() []HandlerErrors {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*HandlerErrors)(nil), "xion.indexer.health.v1.HandlerErrors")
+	proto.RegisterType((*QueryHealthRequest)(nil), "xion.indexer.health.v1.QueryHealthRequest")
+	proto.RegisterType((*QueryHealthResponse)(nil), "xion.indexer.health.v1.QueryHealthResponse")
+}
+
+func init() {
+	proto.RegisterFile("xion/indexer/health/v1/query.proto", fileDescriptor_64eeb346fd9fc762)
+}
+
+var fileDescriptor_64eeb346fd9fc762 = []byte{
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0xab, 0xd3, 0x30,
+	0x1c, 0xc7, 0x9b, 0x75, 0xab, 0xef, 0x45, 0x9e, 0x48, 0x1c, 0x52, 0x8a, 0xd6, 0x52, 0x78, 0x52,
+	0x15, 0x1b, 0xde, 0xf3, 0x0f, 0x10, 0xde, 0x10, 0x76, 0xb5, 0x47, 0x2f, 0x92, 0xae, 0x21, 0x0d,
+	0xd4, 0xa4, 0x4b, 0xd3, 0x31, 0xaf, 0xde, 0xbc, 0x09, 0xfb, 0xa7, 0x76, 0x1c, 0x78, 0xf1, 0x24,
+	0xb2, 0xf9, 0x2f, 0x78, 0x97, 0x26, 0xdd, 0x60, 0xb8, 0x81, 0xb7, 0xdf, 0xef, 0x9b, 0x4f, 0x7e,
+	0xdf, 0x5f, 0xbe, 0x04, 0xc6, 0x4b, 0x2e, 0x05, 0xe6, 0xa2, 0xa0, 0x4b, 0xaa, 0x70, 0x49, 0x49,
+	0xa5, 0x4b, 0xbc, 0xb8, 0xc1, 0xf3, 0x96, 0xaa, 0xcf, 0x69, 0xad, 0xa4, 0x96, 0xe8, 0x71, 0xc7,
+	0xa4, 0x3d, 0x93, 0x5a, 0x26, 0x5d, 0xdc, 0x04, 0x63, 0x26, 0x99, 0x34, 0x08, 0xee, 0x2a, 0x4b,
+	0x07, 0x4f, 0x98, 0x94, 0xac, 0xa2, 0x98, 0xd4, 0x1c, 0x13, 0x21, 0xa4, 0x26, 0x9a, 0x4b, 0xd1,
+	0xd8, 0xd3, 0xf8, 0x2d, 0xbc, 0x9a, 0x12, 0x51, 0x54, 0x54, 0xbd, 0x53, 0x4a, 0xaa, 0x06, 0xf9,
+	0xf0, 0x5e, 0x69, 0x05, 0x1f, 0x44, 0x20, 0xb9, 0xcc, 0xf6, 0x2d, 0x1a, 0xc3, 0xd1, 0x4c, 0xb6,
+	0x42, 0xfb, 0x83, 0x08, 0x24, 0xc3, 0xcc, 0x36, 0xf1, 0x18, 0xa2, 0xf7, 0xdd, 0x6e, 0x53, 0xb3,
+	0x46, 0x46, 0xe7, 0x2d, 0x6d, 0x74, 0xfc, 0x07, 0xc0, 0x47, 0x47, 0x72, 0x53, 0x4b, 0xd1, 0x50,
+	0x74, 0x0d, 0x1f, 0xd8, 0xbd, 0x8b, 0x8f, 0x25, 0xe5, 0xac, 0xd4, 0xc6, 0xc4, 0xcd, 0xae, 0x7a,
+	0x75, 0x6a, 0x44, 0xf4, 0x14, 0x42, 0x52, 0xd7, 0x7b, 0x64, 0x60, 0x90, 0x4b, 0x52, 0xd7, 0xfd,
+	0xf1, 0x43, 0xe8, 0x56, 0x84, 0xf9, 0xae, 0xd1, 0xbb, 0x12, 0x05, 0xf0, 0xa2, 0xe0, 0x0b, 0xaa,
+	0x18, 0x2d, 0xfc, 0x61, 0x04, 0x92, 0x8b, 0xec, 0xd0, 0x77, 0x9e, 0x8a, 0xe6, 0x2d, 0xaf, 0x0e,
+	0x9e, 0x23, 0xeb, 0xd9, 0xab, 0xfd, 0xd0, 0x09, 0xf4, 0xa8, 0x89, 0xc0, 0xf7, 0x22, 0x37, 0xb9,
+	0x7f, 0x7b, 0x9d, 0x9e, 0x8e, 0x39, 0x3d, 0xca, 0xeb, 0x6e, 0xb8, 0xfe, 0xf9, 0xcc, 0xc9, 0xfa,
+	0xab, 0xb7, 0x2b, 0x00, 0x47, 0xe6, 0xdd, 0xe8, 0x2b, 0x80, 0x9e, 0x7d, 0x3c, 0x7a, 0x79, 0x6e,
+	0xd2, 0xbf, 0xc1, 0x05, 0xaf, 0xfe, 0x8b, 0xb5, 0x69, 0xc6, 0xcf, 0xbf, 0x7c, 0xff, 0xbd, 0x1a,
+	0x44, 0x28, 0xc4, 0x67, 0x7e, 0x8d, 0xad, 0xee, 0x26, 0xeb, 0x6d, 0x08, 0x36, 0xdb, 0x10, 0xfc,
+	0xda, 0x86, 0xe0, 0xdb, 0x2e, 0x74, 0x36, 0xbb, 0xd0, 0xf9, 0xb1, 0x0b, 0x9d, 0x0f, 0x2f, 0x18,
+	0xd7, 0x65, 0x9b, 0xa7, 0x33, 0xf9, 0x09, 0xe7, 0xad, 0x12, 0xfa, 0x75, 0x45, 0xf2, 0xe6, 0xd4,
+	0xb8, 0xdc, 0x33, 0x1f, 0xe6, 0xcd, 0xdf, 0x01, 0x00, 0x92, 0x70, 0xe3, 0x8a, 0xa2, 0x02, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Health returns how far the index lags behind the chain and the errors
+	// its handlers logged since the node started.
+	Health(ctx context.Context, in *QueryHealthRequest, opts ...grpc.CallOption) (*QueryHealthResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Health(ctx context.Context, in *QueryHealthRequest, opts ...grpc.CallOption) (*QueryHealthResponse, error) {
+	out := new(QueryHealthResponse)
+	err := c.cc.Invoke(ctx, "/xion.indexer.health.v1.Query/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Health returns how far the index lags behind the chain and the errors
+	// its handlers logged since the node started.
+	Health(context.Context, *QueryHealthRequest) (*QueryHealthResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Health(ctx context.Context, req *QueryHealthRequest) (*QueryHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.indexer.health.v1.Query/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Health(ctx, req.(*QueryHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xion.indexer.health.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Health",
+			Handler:    _Query_Health_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "xion/indexer/health/v1/query.proto",
+}
+
+func (m *HandlerErrors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerErrors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandlerErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Handler) > 0 {
+		i -= len(m.Handler)
+		copy(dAtA[i:], m.Handler)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Handler)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.RebuildHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RebuildHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Diverged {
+		i--
+		if m.Diverged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Lag != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Lag))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IndexedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexedHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HandlerErrors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Handler)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IndexedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.IndexedHeight))
+	}
+	if m.AppHeight != 0 {
+		n += 1 + sovQuery(uint64(m.AppHeight))
+	}
+	if m.Lag != 0 {
+		n += 1 + sovQuery(uint64(m.Lag))
+	}
+	if m.Diverged {
+		n += 2
+	}
+	if m.RebuildHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RebuildHeight))
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HandlerErrors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerErrors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerErrors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedHeight", wireType)
+			}
+			m.IndexedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHeight", wireType)
+			}
+			m.AppHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			}
+			m.Lag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diverged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Diverged = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebuildHeight", wireType)
+			}
+			m.RebuildHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebuildHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, HandlerErrors{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
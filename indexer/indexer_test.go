@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -29,6 +30,7 @@ import (
 
 	indexerauthz "github.com/burnt-labs/xion/indexer/authz"
 	indexerfeegrant "github.com/burnt-labs/xion/indexer/feegrant"
+	xiontypes "github.com/burnt-labs/xion/x/xion/types"
 )
 
 func setupTest(_t *testing.T) (db.DB, codec.Codec, address.Codec) {
@@ -41,6 +43,7 @@ func setupTest(_t *testing.T) (db.DB, codec.Codec, address.Codec) {
 	authz.RegisterInterfaces(interfaceRegistry)
 	banktypes.RegisterInterfaces(interfaceRegistry)
 	feegrant.RegisterInterfaces(interfaceRegistry)
+	wasmtypes.RegisterInterfaces(interfaceRegistry)
 	cdc := codec.NewProtoCodec(interfaceRegistry)
 
 	// Setup address codec
@@ -785,3 +788,163 @@ func TestRegisterServices(t *testing.T) {
 	// This will panic but demonstrates the function is accessible
 	_ = service.RegisterServices(nil)
 }
+
+func TestParseGrantSpendEvents(t *testing.T) {
+	_, _, addrCodec := setupTest(t)
+	granter := sdk.AccAddress([]byte("granter_address_____"))
+	grantee := sdk.AccAddress([]byte("grantee_address_____"))
+	granterStr, _ := addrCodec.BytesToString(granter)
+	granteeStr, _ := addrCodec.BytesToString(grantee)
+
+	event := func(eventType string, attrs ...string) abci.Event {
+		e := abci.Event{Type: eventType}
+		for i := 0; i < len(attrs); i += 2 {
+			e.Attributes = append(e.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+		}
+		return e
+	}
+	useFeeGrant := event(feegrant.EventTypeUseFeeGrant, "granter", granterStr, "grantee", granteeStr)
+	txFee := event(sdk.EventTypeTx, sdk.AttributeKeyFee, "25uxion", sdk.AttributeKeyFeePayer, granterStr)
+
+	testCases := []struct {
+		name     string
+		events   []abci.Event
+		found    bool
+		expected sdk.Coins
+		hasErr   bool
+	}{
+		{
+			name:   "no fee grant",
+			events: []abci.Event{txFee},
+		},
+		{
+			name:     "sdk allowance",
+			events:   []abci.Event{useFeeGrant, txFee},
+			found:    true,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uxion", 25)),
+		},
+		{
+			name: "xion allowance",
+			events: []abci.Event{
+				event(xiontypes.EventTypeFeeAllowanceUsed, "granter", granterStr, "grantee", granteeStr, "fee", "20uxion"),
+				useFeeGrant,
+				txFee,
+			},
+			found:    true,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uxion", 20)),
+		},
+		{
+			name: "xion allowance without parties falls back to sdk events",
+			events: []abci.Event{
+				event(xiontypes.EventTypeFeeAllowanceUsed, "granter", "", "grantee", "", "fee", "20uxion"),
+				useFeeGrant,
+				txFee,
+			},
+			found:    true,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uxion", 25)),
+		},
+		{
+			name:   "invalid granter",
+			events: []abci.Event{event(feegrant.EventTypeUseFeeGrant, "granter", "invalid", "grantee", granteeStr), txFee},
+			hasErr: true,
+		},
+		{
+			name:   "invalid fee",
+			events: []abci.Event{useFeeGrant, event(sdk.EventTypeTx, sdk.AttributeKeyFee, "not coins")},
+			hasErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotGranter, gotGrantee, fee, found, err := ParseGrantSpendEvents(tc.events, addrCodec)
+			if tc.hasErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.found, found)
+			if !tc.found {
+				return
+			}
+			require.Equal(t, granter, gotGranter)
+			require.Equal(t, grantee, gotGrantee)
+			require.Equal(t, tc.expected, fee)
+		})
+	}
+}
+
+func TestFeeGrantSpend(t *testing.T) {
+	memDB, cdc, addrCodec := setupTest(t)
+	ctx := context.Background()
+	service := NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger())
+	querier := service.FeeGrantQuerier()
+
+	granter := sdk.AccAddress([]byte("granter_address_____"))
+	grantee1 := sdk.AccAddress([]byte("grantee1_address____"))
+	grantee2 := sdk.AccAddress([]byte("grantee2_address____"))
+	granterStr, _ := addrCodec.BytesToString(granter)
+	grantee1Str, _ := addrCodec.BytesToString(grantee1)
+	grantee2Str, _ := addrCodec.BytesToString(grantee2)
+
+	txResult := func(grantee, fee string) *abci.ExecTxResult {
+		return &abci.ExecTxResult{Events: []abci.Event{
+			{Type: xiontypes.EventTypeFeeAllowanceUsed, Attributes: []abci.EventAttribute{
+				{Key: "granter", Value: granterStr},
+				{Key: "grantee", Value: grantee},
+				{Key: "fee", Value: fee},
+			}},
+		}}
+	}
+
+	// a failed tx still paid its fee, unrelated txs are ignored
+	err := service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 10}, abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{
+			txResult(grantee1Str, "10uxion"),
+			{Code: 5, Events: txResult(grantee1Str, "15uxion").Events},
+			txResult(grantee2Str, "7uxion"),
+			{},
+			nil,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	err = service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 11}, abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{txResult(grantee1Str, "5uxion,1uusdc")},
+	})
+	require.NoError(t, err)
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+
+	resp, err := querier.GrantSpend(ctx, &indexerfeegrant.QueryGrantSpendRequest{Granter: granterStr, Grantee: grantee1Str})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Spend)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uxion", 30), sdk.NewInt64Coin("uusdc", 1)), resp.Spend.Spent)
+	require.Equal(t, uint64(3), resp.Spend.TxCount)
+	require.Equal(t, int64(11), resp.Spend.LastHeight)
+
+	// no fees paid
+	resp, err = querier.GrantSpend(ctx, &indexerfeegrant.QueryGrantSpendRequest{Granter: grantee1Str, Grantee: granterStr})
+	require.NoError(t, err)
+	require.Nil(t, resp.Spend)
+
+	_, err = querier.GrantSpend(ctx, &indexerfeegrant.QueryGrantSpendRequest{Granter: "invalid", Grantee: grantee1Str})
+	require.Error(t, err)
+
+	byGranter, err := querier.GrantSpendsByGranter(ctx, &indexerfeegrant.QueryGrantSpendsByGranterRequest{
+		Granter:    granterStr,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, byGranter.Spends, 1)
+	require.Equal(t, uint64(2), byGranter.Pagination.Total)
+
+	byGranter, err = querier.GrantSpendsByGranter(ctx, &indexerfeegrant.QueryGrantSpendsByGranterRequest{
+		Granter:    granterStr,
+		Pagination: &query.PageRequest{Key: byGranter.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, byGranter.Spends, 1)
+
+	_, err = querier.GrantSpendsByGranter(ctx, &indexerfeegrant.QueryGrantSpendsByGranterRequest{Granter: "invalid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,205 @@
+package indexer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NDJSONCurrentFile is the file the NDJSON exporter appends to.
+const NDJSONCurrentFile = "changes.ndjson"
+
+// NDJSONHeightFile holds the last height the NDJSON exporter exported.
+const NDJSONHeightFile = "height"
+
+var _ Exporter = &NDJSONExporter{}
+
+// NDJSONExporter appends the changes as newline-delimited JSON to a file in
+// dir. Once the file reaches maxFileBytes it is renamed after the last height
+// it holds, changes-<height>.ndjson, and a new file is started. Blocks at or
+// below the last exported height are skipped.
+type NDJSONExporter struct {
+	dir          string
+	maxFileBytes uint64
+	maxFiles     uint64
+
+	file   *os.File
+	size   uint64
+	height int64
+}
+
+func NewNDJSONExporter(dir string, maxFileBytes, maxFiles uint64) (*NDJSONExporter, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	e := &NDJSONExporter{
+		dir:          dir,
+		maxFileBytes: maxFileBytes,
+		maxFiles:     maxFiles,
+	}
+	if err := e.open(); err != nil {
+		return nil, err
+	}
+	if err := e.readHeight(); err != nil {
+		e.file.Close()
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *NDJSONExporter) readHeight() error {
+	bz, err := os.ReadFile(filepath.Join(e.dir, NDJSONHeightFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	e.height, err = strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+	return err
+}
+
+// writeHeight records height as exported, the file is replaced atomically.
+func (e *NDJSONExporter) writeHeight(height int64) error {
+	path := filepath.Join(e.dir, NDJSONHeightFile)
+	if err := os.WriteFile(path+".tmp", []byte(strconv.FormatInt(height, 10)), 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+	e.height = height
+	return nil
+}
+
+// Height returns the last exported height, 0 if nothing was exported.
+func (e *NDJSONExporter) Height(_ context.Context) (int64, error) {
+	return e.height, nil
+}
+
+func (e *NDJSONExporter) open() error {
+	file, err := os.OpenFile(filepath.Join(e.dir, NDJSONCurrentFile), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	e.file = file
+	e.size = uint64(info.Size())
+	return nil
+}
+
+// Export appends the changes of a block. The changes of a block are never
+// split across files.
+func (e *NDJSONExporter) Export(_ context.Context, height int64, changes []Change) error {
+	if height <= e.height {
+		return nil
+	}
+	if err := e.write(height, changes); err != nil {
+		return err
+	}
+	return e.writeHeight(height)
+}
+
+// Resync appends a resync change followed by snapshot.
+func (e *NDJSONExporter) Resync(_ context.Context, height int64, snapshot []Change) error {
+	if height <= e.height {
+		return nil
+	}
+	changes := append([]Change{{Height: height, Op: ChangeOpResync}}, snapshot...)
+	if err := e.write(height, changes); err != nil {
+		return err
+	}
+	return e.writeHeight(height)
+}
+
+func (e *NDJSONExporter) write(height int64, changes []Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	w := bufio.NewWriter(e.file)
+	var written uint64
+	for _, change := range changes {
+		line, err := json.Marshal(change)
+		if err != nil {
+			return err
+		}
+		line = append(line, '\n')
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+		written += uint64(len(line))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	e.size += written
+
+	if e.size >= e.maxFileBytes {
+		return e.rotate(height)
+	}
+	return nil
+}
+
+// rotate renames the current file after height, starts a new one and removes
+// the oldest rotated files beyond maxFiles.
+func (e *NDJSONExporter) rotate(height int64) error {
+	if err := e.file.Close(); err != nil {
+		return err
+	}
+	rotated := filepath.Join(e.dir, fmt.Sprintf("changes-%020d.ndjson", height))
+	if err := os.Rename(filepath.Join(e.dir, NDJSONCurrentFile), rotated); err != nil {
+		return err
+	}
+	if err := e.open(); err != nil {
+		return err
+	}
+	if e.maxFiles == 0 {
+		return nil
+	}
+
+	files, err := e.RotatedFiles()
+	if err != nil {
+		return err
+	}
+	for uint64(len(files)) > e.maxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// RotatedFiles returns the rotated files, oldest first.
+func (e *NDJSONExporter) RotatedFiles() ([]string, error) {
+	entries, err := os.ReadDir(e.dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, "changes-") || !strings.HasSuffix(name, ".ndjson") {
+			continue
+		}
+		files = append(files, filepath.Join(e.dir, name))
+	}
+	// the heights are zero padded, so names sort by height
+	sort.Strings(files)
+	return files, nil
+}
+
+func (e *NDJSONExporter) Close() error {
+	return e.file.Close()
+}
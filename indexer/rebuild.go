@@ -0,0 +1,242 @@
+package indexer
+
+import (
+	"context"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// AuthzGrantSource iterates the grants of the authz module.
+type AuthzGrantSource interface {
+	IterateGrants(ctx context.Context, handler func(granterAddr, granteeAddr sdk.AccAddress, grant authz.Grant) bool)
+}
+
+// FeeAllowanceSource iterates the allowances of the feegrant module.
+type FeeAllowanceSource interface {
+	IterateAllFeeAllowances(ctx context.Context, cb func(grant feegrant.Grant) bool) error
+}
+
+// WasmContractStateSource iterates the contracts and their state.
+type WasmContractStateSource interface {
+	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, wasmtypes.ContractInfo) bool)
+	IterateContractState(ctx context.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
+}
+
+// BankBalanceSource iterates the balances and denom metadata of the bank
+// module.
+type BankBalanceSource interface {
+	IterateAllBalances(ctx context.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+	IterateAllDenomMetaData(ctx context.Context, cb func(banktypes.Metadata) bool)
+}
+
+// RebuildSources are the live module stores the index is rebuilt from.
+type RebuildSources struct {
+	Authz    AuthzGrantSource
+	FeeGrant FeeAllowanceSource
+	Wasm     WasmContractStateSource
+	// Bank is only read when the bank holders are indexed.
+	Bank BankBalanceSource
+}
+
+// RebuildTotals are the number of entries indexed by a rebuild.
+type RebuildTotals struct {
+	Grants         int
+	Allowances     int
+	Authenticators int
+	Holders        int
+}
+
+// CatchUp rebuilds the index when its checkpoint, or the bank holders when
+// they are indexed, is not at appHeight, the last height committed by the
+// app. ctx must read the committed app state.
+func (ss *StreamService) CatchUp(ctx context.Context, appHeight int64, sources RebuildSources) error {
+	ss.health.appHeight.Store(appHeight)
+
+	indexedHeight, err := ss.checkpoint.Height(ctx)
+	if err != nil {
+		return err
+	}
+	bankBehind, err := ss.bankHolderHandler.Behind(ctx, appHeight)
+	if err != nil {
+		return err
+	}
+	if indexedHeight == appHeight && !bankBehind {
+		return nil
+	}
+
+	ss.log.Info("Index is not at the app height, rebuilding it",
+		"indexed_height", indexedHeight,
+		"app_height", appHeight,
+		"bank_holders_behind", bankBehind)
+	_, err = ss.Rebuild(ctx, appHeight, sources)
+	return err
+}
+
+// Rebuild replaces the grants, allowances, authenticators and, when they are
+// indexed, bank holders of the index with those of the live module stores
+// and sets the checkpoint to appHeight.
+// Fee grant spends and contract executions cannot be rebuilt and are kept.
+// The rebuild is written atomically.
+func (ss *StreamService) Rebuild(ctx context.Context, appHeight int64, sources RebuildSources) (RebuildTotals, error) {
+	var totals RebuildTotals
+
+	bs := newBlockStore(ss.db)
+	ctx = bs.context(ctx)
+
+	if err := clearIndexedMap(ctx, ss.authzHandler.Authorizations); err != nil {
+		return totals, err
+	}
+	if err := clearIndexedMap(ctx, ss.feeGrantHandler.FeeAllowances); err != nil {
+		return totals, err
+	}
+	if err := clearIndexedMap(ctx, ss.authenticatorHandler.Authenticators); err != nil {
+		return totals, err
+	}
+
+	var err error
+	sources.Authz.IterateGrants(ctx, func(granterAddr, granteeAddr sdk.AccAddress, grant authz.Grant) bool {
+		authorization, unpackErr := grant.GetAuthorization()
+		if unpackErr != nil {
+			ss.log.Error("error unpacking authorization", "error", unpackErr)
+			return false
+		}
+		if err = ss.authzHandler.SetGrant(ctx, granterAddr, granteeAddr, authorization.MsgTypeURL(), grant); err != nil {
+			return true
+		}
+		totals.Grants++
+		return false
+	})
+	if err != nil {
+		return totals, err
+	}
+
+	iterErr := sources.FeeGrant.IterateAllFeeAllowances(ctx, func(grant feegrant.Grant) bool {
+		granter, parseErr := ss.addrCodec.StringToBytes(grant.Granter)
+		if parseErr != nil {
+			ss.log.Error("error parsing granter", "error", parseErr)
+			return false
+		}
+		grantee, parseErr := ss.addrCodec.StringToBytes(grant.Grantee)
+		if parseErr != nil {
+			ss.log.Error("error parsing grantee", "error", parseErr)
+			return false
+		}
+		if err = ss.feeGrantHandler.SetGrant(ctx, granter, grantee, grant); err != nil {
+			return true
+		}
+		totals.Allowances++
+		return false
+	})
+	if iterErr != nil {
+		return totals, iterErr
+	}
+	if err != nil {
+		return totals, err
+	}
+
+	sources.Wasm.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, info wasmtypes.ContractInfo) bool {
+		if !ss.authenticatorHandler.IsAccountCode(ctx, info.CodeID) {
+			return false
+		}
+		sources.Wasm.IterateContractState(ctx, contractAddr, func(key, value []byte) bool {
+			id, ok := ParseAuthenticatorContractKey(key)
+			if !ok {
+				return false
+			}
+			if err := ss.authenticatorHandler.SetAuthenticator(ctx, contractAddr, id, value); err != nil {
+				ss.log.Error("error setting authenticator", "account", contractAddr.String(), "error", err)
+				return false
+			}
+			totals.Authenticators++
+			return false
+		})
+		return false
+	})
+
+	if ss.bankHolderHandler.Enabled() && sources.Bank != nil {
+		holders, err := ss.rebuildBankHolders(ctx, appHeight, sources.Bank)
+		if err != nil {
+			return totals, err
+		}
+		totals.Holders = holders
+	}
+
+	if err := ss.checkpoint.RebuildHeight.Set(ctx, appHeight); err != nil {
+		return totals, err
+	}
+	if err := ss.checkpoint.IndexedHeight.Set(ctx, appHeight); err != nil {
+		return totals, err
+	}
+	if err := bs.write(ss.db); err != nil {
+		return totals, err
+	}
+	ss.health.diverged.Store(false)
+
+	ss.log.Info("Rebuilt index",
+		"height", appHeight,
+		"grants", totals.Grants,
+		"allowances", totals.Allowances,
+		"authenticators", totals.Authenticators,
+		"holders", totals.Holders)
+	return totals, nil
+}
+
+// rebuildBankHolders replaces the bank holders and denom metadata with those
+// of the bank module and returns the number of holders indexed.
+func (ss *StreamService) rebuildBankHolders(ctx context.Context, appHeight int64, source BankBalanceSource) (int, error) {
+	bh := ss.bankHolderHandler
+	if err := bh.Clear(ctx); err != nil {
+		return 0, err
+	}
+
+	var (
+		holders int
+		err     error
+	)
+	source.IterateAllBalances(ctx, func(address sdk.AccAddress, coin sdk.Coin) bool {
+		if !bh.IsIndexed(coin.Denom) || !coin.Amount.IsPositive() {
+			return false
+		}
+		if err = bh.SetBalance(ctx, address, coin.Denom, coin.Amount); err != nil {
+			return true
+		}
+		holders++
+		return false
+	})
+	if err != nil {
+		return holders, err
+	}
+	source.IterateAllDenomMetaData(ctx, func(metadata banktypes.Metadata) bool {
+		err = bh.SetMetadata(ctx, metadata)
+		return err != nil
+	})
+	if err != nil {
+		return holders, err
+	}
+	return holders, bh.Height.Set(ctx, appHeight)
+}
+
+// clearIndexedMap removes all the entries of m and their index references.
+func clearIndexedMap[K, V, I any](ctx context.Context, m *collections.IndexedMap[K, V, I]) error {
+	iter, err := m.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := m.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
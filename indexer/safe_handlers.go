@@ -3,6 +3,10 @@ package indexer
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
@@ -11,6 +15,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
+
+	indexerstream "github.com/burnt-labs/xion/indexer/stream"
 )
 
 // SafeAuthzHandlerUpdate wraps the authz handler update with safe error handling
@@ -43,6 +49,7 @@ func SafeAuthzHandlerUpdate(ctx context.Context, ah *AuthzHandler, pair *storety
 				"error", err)
 			return nil // Don't halt on removal errors
 		}
+		ah.grantChanged(ctx, indexerstream.GrantChangeOp_GRANT_CHANGE_OP_DELETE, granterAddr, granteeAddr, msgType, nil)
 
 		return nil
 	}
@@ -60,6 +67,16 @@ func SafeAuthzHandlerUpdate(ctx context.Context, ah *AuthzHandler, pair *storety
 		return nil // Skip corrupted entries
 	}
 
+	op, err := ah.setOp(ctx, collections.Join3(granterAddr, granteeAddr, msgType))
+	if err != nil {
+		logger.Error("Failed to check grant existence",
+			"granter", granterAddr.String(),
+			"grantee", granteeAddr.String(),
+			"msg_type", msgType,
+			"error", err)
+		return nil // Don't halt on read errors
+	}
+
 	// Try to set the grant
 	if err := ah.SetGrant(ctx, granterAddr, granteeAddr, msgType, grant); err != nil {
 		logger.Error("Failed to index authz grant",
@@ -69,6 +86,7 @@ func SafeAuthzHandlerUpdate(ctx context.Context, ah *AuthzHandler, pair *storety
 			"error", err)
 		return nil // Don't halt on write errors
 	}
+	ah.grantChanged(ctx, op, granterAddr, granteeAddr, msgType, &grant)
 
 	return nil
 }
@@ -129,6 +147,7 @@ func SafeFeeGrantHandlerUpdate(ctx context.Context, fh *FeeGrantHandler, pair *s
 				"error", err)
 			return nil // Don't halt on removal errors
 		}
+		fh.allowanceChanged(ctx, indexerstream.GrantChangeOp_GRANT_CHANGE_OP_DELETE, granterAddr, granteeAddr, nil)
 
 		return nil
 	}
@@ -145,6 +164,15 @@ func SafeFeeGrantHandlerUpdate(ctx context.Context, fh *FeeGrantHandler, pair *s
 		return nil // Skip corrupted entries
 	}
 
+	op, err := fh.setOp(ctx, collections.Join(granterAddr, granteeAddr))
+	if err != nil {
+		logger.Error("Failed to check allowance existence",
+			"granter", granterAddr.String(),
+			"grantee", granteeAddr.String(),
+			"error", err)
+		return nil // Don't halt on read errors
+	}
+
 	// Try to set the grant
 	if err := fh.SetGrant(ctx, granterAddr, granteeAddr, grant); err != nil {
 		logger.Error("Failed to index feegrant allowance",
@@ -153,6 +181,76 @@ func SafeFeeGrantHandlerUpdate(ctx context.Context, fh *FeeGrantHandler, pair *s
 			"error", err)
 		return nil // Don't halt on write errors
 	}
+	fh.allowanceChanged(ctx, op, granterAddr, granteeAddr, &grant)
 
 	return nil
 }
+
+// SafeAuthenticatorHandlerUpdate wraps the authenticator handler update with safe error handling
+func SafeAuthenticatorHandlerUpdate(ctx context.Context, ah *AuthenticatorHandler, pair *storetypes.StoreKVPair, logger log.Logger) error {
+	account, id, ok := ParseAuthenticatorStoreKey(pair.Key)
+	if !ok || !ah.IsAccount(ctx, account) {
+		// Not an authenticator of an abstract account
+		return nil
+	}
+
+	if pair.Delete {
+		if err := ah.RemoveAuthenticator(ctx, account, id); err != nil {
+			logger.Error("Failed to remove authenticator from index",
+				"account", account.String(),
+				"id", id,
+				"error", err)
+		}
+		return nil // Don't halt on removal errors
+	}
+
+	if err := ah.SetAuthenticator(ctx, account, id, pair.Value); errors.Is(err, ErrInvalidAuthenticator) {
+		logger.Debug("Skipping undecodable authenticator",
+			"account", account.String(),
+			"id", id,
+			"error", err)
+	} else if err != nil {
+		logger.Warn("Failed to index authenticator, skipping",
+			"key", hex.EncodeToString(pair.Key),
+			"account", account.String(),
+			"id", id,
+			"error", err)
+	}
+	return nil // Skip corrupted entries and write errors
+}
+
+// SafeWasmHistoryHandleBlock wraps the wasm history block handler with safe error handling
+func SafeWasmHistoryHandleBlock(ctx context.Context, wh *WasmHistoryHandler, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock, logger log.Logger) error {
+	if err := wh.HandleBlock(ctx, req, res); err != nil {
+		logger.Error("Failed to record contract executions, skipping block",
+			"height", req.Height,
+			"error", err)
+	}
+	return nil // Don't halt on recording errors
+}
+
+// SafePruneExpiredGrants wraps the expired grant pruning with safe error handling
+func SafePruneExpiredGrants(ctx context.Context, ah *AuthzHandler, fh *FeeGrantHandler, blockTime time.Time, prune bool, logger log.Logger) error {
+	if err := ah.PruneExpired(ctx, blockTime, prune); err != nil {
+		logger.Error("Failed to prune expired authz grants",
+			"block_time", blockTime,
+			"error", err)
+	}
+	if err := fh.PruneExpired(ctx, blockTime, prune); err != nil {
+		logger.Error("Failed to prune expired fee allowances",
+			"block_time", blockTime,
+			"error", err)
+	}
+	return nil // Don't halt on pruning errors
+}
+
+// SafeBankHolderHandlerUpdate wraps the bank holder handler update with safe error handling
+func SafeBankHolderHandlerUpdate(ctx context.Context, bh *BankHolderHandler, pair *storetypes.StoreKVPair, logger log.Logger) error {
+	if err := bh.HandleUpdate(ctx, pair); err != nil {
+		logger.Error("Failed to index bank balance",
+			"key", hex.EncodeToString(pair.Key),
+			"delete", pair.Delete,
+			"error", err)
+	}
+	return nil // Don't halt on corrupted entries or write errors
+}
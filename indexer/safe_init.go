@@ -46,33 +46,79 @@ func NewSafeIndexer(homeDir string, cdc codec.Codec, addrCodec address.Codec, lo
 	}
 
 	// Try to create handlers
-	authzDB := db.NewPrefixDB(storeDB, AuthzStorePrefix)
-	feegrantDb := db.NewPrefixDB(storeDB, FeeGrantStorePrefix)
-
-	authzHandler, err := NewAuthzHandler(&kvAccessor{db: authzDB}, cdc)
+	authzHandler, err := NewAuthzHandler(newKVAccessor(storeDB, AuthzStorePrefix), cdc)
 	if err != nil {
 		logger.Error("Failed to initialize authz handler, running in degraded mode", "error", err)
 		storeDB.Close() // Clean up
 		return NewNoOpStreamService(logger)
 	}
 
-	feeGrantHandler, err := NewFeeGrantHandler(&kvAccessor{db: feegrantDb}, cdc)
+	feeGrantHandler, err := NewFeeGrantHandler(newKVAccessor(storeDB, FeeGrantStorePrefix), cdc)
 	if err != nil {
 		logger.Error("Failed to initialize feegrant handler, running in degraded mode", "error", err)
 		storeDB.Close() // Clean up
 		return NewNoOpStreamService(logger)
 	}
 
+	authenticatorHandler, err := NewAuthenticatorHandler(newKVAccessor(storeDB, AuthenticatorStorePrefix), cdc, addrCodec)
+	if err != nil {
+		logger.Error("Failed to initialize authenticator handler, running in degraded mode", "error", err)
+		storeDB.Close() // Clean up
+		return NewNoOpStreamService(logger)
+	}
+
+	wasmHistoryHandler, err := NewWasmHistoryHandler(newKVAccessor(storeDB, WasmHistoryStorePrefix), cdc, addrCodec)
+	if err != nil {
+		logger.Error("Failed to initialize wasm history handler, running in degraded mode", "error", err)
+		storeDB.Close() // Clean up
+		return NewNoOpStreamService(logger)
+	}
+
+	bankHolderHandler, err := NewBankHolderHandler(newKVAccessor(storeDB, BankHolderStorePrefix), cdc)
+	if err != nil {
+		logger.Error("Failed to initialize bank holder handler, running in degraded mode", "error", err)
+		storeDB.Close() // Clean up
+		return NewNoOpStreamService(logger)
+	}
+
+	checkpoint, err := NewCheckpoint(newKVAccessor(storeDB, CheckpointStorePrefix))
+	if err != nil {
+		logger.Error("Failed to initialize indexer checkpoint, running in degraded mode", "error", err)
+		storeDB.Close() // Clean up
+		return NewNoOpStreamService(logger)
+	}
+	health := newIndexerHealth()
+
+	grantChanges, err := NewGrantChangeLog(newKVAccessor(storeDB, GrantChangeStorePrefix), cdc, addrCodec, health.logger(logger, HealthHandlerGrantChanges, false))
+	if err != nil {
+		logger.Error("Failed to initialize grant change log, running in degraded mode", "error", err)
+		storeDB.Close() // Clean up
+		return NewNoOpStreamService(logger)
+	}
+	authzHandler.SetListener(grantChanges)
+	feeGrantHandler.SetListener(grantChanges)
+
 	logger.Info("Indexer initialized successfully")
 
 	return &StreamService{
-		db:              storeDB,
-		log:             logger,
-		authzHandler:    authzHandler,
-		feeGrantHandler: feeGrantHandler,
-		authzQuerier:    NewAuthzQuerier(authzHandler, cdc, addrCodec),
-		feegrantQuerier: NewFeegrantQuerier(feeGrantHandler, cdc, addrCodec),
-		addrCodec:       addrCodec,
-		cdc:             cdc,
+		db:                   storeDB,
+		log:                  logger,
+		authzHandler:         authzHandler,
+		feeGrantHandler:      feeGrantHandler,
+		authenticatorHandler: authenticatorHandler,
+		wasmHistoryHandler:   wasmHistoryHandler,
+		bankHolderHandler:    bankHolderHandler,
+		authzQuerier:         NewAuthzQuerier(authzHandler, cdc, addrCodec),
+		feegrantQuerier:      NewFeegrantQuerier(feeGrantHandler, cdc, addrCodec),
+		authenticatorQuerier: NewAuthenticatorQuerier(authenticatorHandler, cdc, addrCodec),
+		wasmHistoryQuerier:   NewWasmHistoryQuerier(wasmHistoryHandler, cdc, addrCodec),
+		bankHolderQuerier:    NewBankHolderQuerier(bankHolderHandler, cdc, addrCodec),
+		healthQuerier:        newHealthQuerier(checkpoint, health),
+		subscriptionServer:   newSubscriptionServer(grantChanges, addrCodec),
+		grantChanges:         grantChanges,
+		checkpoint:           checkpoint,
+		health:               health,
+		addrCodec:            addrCodec,
+		cdc:                  cdc,
 	}
 }
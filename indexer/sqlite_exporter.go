@@ -0,0 +1,191 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"time"
+
+	// registers the sqlite3 database/sql driver
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqliteSchema holds the latest state of the grants and allowances, the log
+// of their changes and the last exported height.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS authz_grants (
+	granter      TEXT NOT NULL,
+	grantee      TEXT NOT NULL,
+	msg_type_url TEXT NOT NULL,
+	expiration   TEXT,
+	grant_json   TEXT NOT NULL,
+	height       INTEGER NOT NULL,
+	PRIMARY KEY (granter, grantee, msg_type_url)
+);
+CREATE INDEX IF NOT EXISTS authz_grants_by_grantee ON authz_grants (grantee);
+
+CREATE TABLE IF NOT EXISTS feegrant_allowances (
+	granter        TEXT NOT NULL,
+	grantee        TEXT NOT NULL,
+	expiration     TEXT,
+	allowance_json TEXT NOT NULL,
+	height         INTEGER NOT NULL,
+	PRIMARY KEY (granter, grantee)
+);
+CREATE INDEX IF NOT EXISTS feegrant_allowances_by_grantee ON feegrant_allowances (grantee);
+
+CREATE TABLE IF NOT EXISTS changes (
+	id           INTEGER PRIMARY KEY AUTOINCREMENT,
+	height       INTEGER NOT NULL,
+	module       TEXT NOT NULL,
+	op           TEXT NOT NULL,
+	granter      TEXT NOT NULL,
+	grantee      TEXT NOT NULL,
+	msg_type_url TEXT NOT NULL,
+	expiration   TEXT,
+	value        TEXT
+);
+CREATE INDEX IF NOT EXISTS changes_by_height ON changes (height);
+
+CREATE TABLE IF NOT EXISTS export_state (
+	id     INTEGER PRIMARY KEY CHECK (id = 0),
+	height INTEGER NOT NULL
+);
+`
+
+var _ Exporter = &SQLiteExporter{}
+
+// SQLiteExporter writes the changes to an embedded SQLite database. The
+// changes of a block are written in one transaction, blocks at or below the
+// last exported height are skipped.
+type SQLiteExporter struct {
+	db *sql.DB
+}
+
+func NewSQLiteExporter(path string) (*SQLiteExporter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite3", path+"?_journal_mode=WAL&_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+	// sqlite allows a single writer
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &SQLiteExporter{db: db}, nil
+}
+
+// DB returns the database, to read the export.
+func (e *SQLiteExporter) DB() *sql.DB {
+	return e.db
+}
+
+// Height returns the last exported height, 0 if nothing was exported.
+func (e *SQLiteExporter) Height(ctx context.Context) (int64, error) {
+	var height int64
+	err := e.db.QueryRowContext(ctx, `SELECT height FROM export_state WHERE id = 0`).Scan(&height)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return height, err
+}
+
+func (e *SQLiteExporter) Export(ctx context.Context, height int64, changes []Change) error {
+	return e.write(ctx, height, changes, false)
+}
+
+// Resync replaces the grants and allowances with snapshot and logs a resync
+// change followed by the snapshot.
+func (e *SQLiteExporter) Resync(ctx context.Context, height int64, snapshot []Change) error {
+	return e.write(ctx, height, snapshot, true)
+}
+
+// write writes the changes of the block at height and the exported height in
+// one transaction. reset removes the grants and allowances first.
+func (e *SQLiteExporter) write(ctx context.Context, height int64, changes []Change, reset bool) (err error) {
+	exported, err := e.Height(ctx)
+	if err != nil {
+		return err
+	}
+	if height <= exported {
+		return nil
+	}
+
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if reset {
+		if _, err = tx.ExecContext(ctx, `DELETE FROM authz_grants; DELETE FROM feegrant_allowances`); err != nil {
+			return err
+		}
+		if err = exportSQLiteChange(ctx, tx, Change{Height: height, Op: ChangeOpResync}); err != nil {
+			return err
+		}
+	}
+	for _, change := range changes {
+		if err = exportSQLiteChange(ctx, tx, change); err != nil {
+			return err
+		}
+	}
+	if _, err = tx.ExecContext(ctx,
+		`INSERT INTO export_state (id, height) VALUES (0, ?) ON CONFLICT (id) DO UPDATE SET height = excluded.height`,
+		height); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func exportSQLiteChange(ctx context.Context, tx *sql.Tx, change Change) error {
+	var expiration, value sql.NullString
+	if change.Expiration != nil {
+		expiration = sql.NullString{String: change.Expiration.UTC().Format(time.RFC3339Nano), Valid: true}
+	}
+	if change.Value != nil {
+		value = sql.NullString{String: string(change.Value), Valid: true}
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO changes (height, module, op, granter, grantee, msg_type_url, expiration, value) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		change.Height, change.Module, change.Op, change.Granter, change.Grantee, change.MsgTypeURL, expiration, value); err != nil {
+		return err
+	}
+
+	var err error
+	switch {
+	case change.Module == ExportModuleAuthz && change.Op == ChangeOpSet:
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO authz_grants (granter, grantee, msg_type_url, expiration, grant_json, height) VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT (granter, grantee, msg_type_url) DO UPDATE SET expiration = excluded.expiration, grant_json = excluded.grant_json, height = excluded.height`,
+			change.Granter, change.Grantee, change.MsgTypeURL, expiration, value, change.Height)
+	case change.Module == ExportModuleAuthz:
+		_, err = tx.ExecContext(ctx,
+			`DELETE FROM authz_grants WHERE granter = ? AND grantee = ? AND msg_type_url = ?`,
+			change.Granter, change.Grantee, change.MsgTypeURL)
+	case change.Module == ExportModuleFeeGrant && change.Op == ChangeOpSet:
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO feegrant_allowances (granter, grantee, expiration, allowance_json, height) VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (granter, grantee) DO UPDATE SET expiration = excluded.expiration, allowance_json = excluded.allowance_json, height = excluded.height`,
+			change.Granter, change.Grantee, expiration, value, change.Height)
+	case change.Module == ExportModuleFeeGrant:
+		_, err = tx.ExecContext(ctx,
+			`DELETE FROM feegrant_allowances WHERE granter = ? AND grantee = ?`,
+			change.Granter, change.Grantee)
+	}
+	return err
+}
+
+func (e *SQLiteExporter) Close() error {
+	return e.db.Close()
+}
@@ -5,13 +5,14 @@ import (
 	"context"
 	"path/filepath"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	db "github.com/cosmos/cosmos-db"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
-	"cosmossdk.io/collections/corecompat"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -22,33 +23,52 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	indexerauthenticator "github.com/burnt-labs/xion/indexer/authenticator"
 	indexerauthz "github.com/burnt-labs/xion/indexer/authz"
+	indexerbank "github.com/burnt-labs/xion/indexer/bank"
 	indexerfeegrant "github.com/burnt-labs/xion/indexer/feegrant"
+	indexerhealth "github.com/burnt-labs/xion/indexer/health"
+	indexerstream "github.com/burnt-labs/xion/indexer/stream"
+	indexerwasm "github.com/burnt-labs/xion/indexer/wasm"
 )
 
 var (
-	AuthzStorePrefix    = []byte("authz")
-	FeeGrantStorePrefix = []byte("feegrant")
+	AuthzStorePrefix         = []byte("authz")
+	FeeGrantStorePrefix      = []byte("feegrant")
+	AuthenticatorStorePrefix = []byte("authenticator")
+	WasmHistoryStorePrefix   = []byte("wasm")
+	CheckpointStorePrefix    = []byte("checkpoint")
+	BankHolderStorePrefix    = []byte("bank")
+	GrantChangeStorePrefix   = []byte("grant_changes")
 )
 
 type StreamService struct {
-	db              db.DB
-	log             log.Logger
-	authzHandler    *AuthzHandler
-	feeGrantHandler *FeeGrantHandler
-	authzQuerier    indexerauthz.QueryServer
-	feegrantQuerier indexerfeegrant.QueryServer
-	addrCodec       address.Codec
-	cdc             codec.Codec
-}
-
-type kvAccessor struct {
-	db db.DB
-}
-
-func (k *kvAccessor) OpenKVStore(ctx context.Context) corecompat.KVStore {
-	return k.db
+	db                   db.DB
+	log                  log.Logger
+	authzHandler         *AuthzHandler
+	feeGrantHandler      *FeeGrantHandler
+	authenticatorHandler *AuthenticatorHandler
+	wasmHistoryHandler   *WasmHistoryHandler
+	bankHolderHandler    *BankHolderHandler
+	authzQuerier         indexerauthz.QueryServer
+	feegrantQuerier      indexerfeegrant.QueryServer
+	authenticatorQuerier indexerauthenticator.QueryServer
+	wasmHistoryQuerier   indexerwasm.QueryServer
+	bankHolderQuerier    indexerbank.QueryServer
+	healthQuerier        indexerhealth.QueryServer
+	subscriptionServer   indexerstream.SubscriptionServer
+	grantChanges         *GrantChangeLog
+	checkpoint           *Checkpoint
+	health               *indexerHealth
+	// pending buffers the updates of the block being indexed
+	pending *blockStore
+	// exporter, if set, receives the authz and feegrant changes of each block
+	exporter           Exporter
+	addrCodec          address.Codec
+	cdc                codec.Codec
+	pruneExpiredGrants bool
 }
 
 // New creates a new StreamService with a new PebbleDB instance
@@ -63,26 +83,57 @@ func New(homeDir string, cdc codec.Codec, addrCodec address.Codec, log log.Logge
 
 // NewWithDB creates a new StreamService with an existing db instance
 func NewWithDB(store db.DB, cdc codec.Codec, addrCodec address.Codec, log log.Logger) *StreamService {
-	authzDB := db.NewPrefixDB(store, AuthzStorePrefix)
-	feegrantDb := db.NewPrefixDB(store, FeeGrantStorePrefix)
-
-	authzHandler, err := NewAuthzHandler(&kvAccessor{db: authzDB}, cdc)
+	authzHandler, err := NewAuthzHandler(newKVAccessor(store, AuthzStorePrefix), cdc)
+	if err != nil {
+		panic(err)
+	}
+	feeGrantHandler, err := NewFeeGrantHandler(newKVAccessor(store, FeeGrantStorePrefix), cdc)
+	if err != nil {
+		panic(err)
+	}
+	authenticatorHandler, err := NewAuthenticatorHandler(newKVAccessor(store, AuthenticatorStorePrefix), cdc, addrCodec)
 	if err != nil {
 		panic(err)
 	}
-	feeGrantHandler, err := NewFeeGrantHandler(&kvAccessor{db: feegrantDb}, cdc)
+	wasmHistoryHandler, err := NewWasmHistoryHandler(newKVAccessor(store, WasmHistoryStorePrefix), cdc, addrCodec)
 	if err != nil {
 		panic(err)
 	}
+	bankHolderHandler, err := NewBankHolderHandler(newKVAccessor(store, BankHolderStorePrefix), cdc)
+	if err != nil {
+		panic(err)
+	}
+	checkpoint, err := NewCheckpoint(newKVAccessor(store, CheckpointStorePrefix))
+	if err != nil {
+		panic(err)
+	}
+	health := newIndexerHealth()
+	grantChanges, err := NewGrantChangeLog(newKVAccessor(store, GrantChangeStorePrefix), cdc, addrCodec, health.logger(log, HealthHandlerGrantChanges, false))
+	if err != nil {
+		panic(err)
+	}
+	authzHandler.SetListener(grantChanges)
+	feeGrantHandler.SetListener(grantChanges)
 	return &StreamService{
-		db:              store,
-		log:             log,
-		authzHandler:    authzHandler,
-		feeGrantHandler: feeGrantHandler,
-		authzQuerier:    NewAuthzQuerier(authzHandler, cdc, addrCodec),
-		feegrantQuerier: NewFeegrantQuerier(feeGrantHandler, cdc, addrCodec),
-		addrCodec:       addrCodec,
-		cdc:             cdc,
+		db:                   store,
+		log:                  log,
+		authzHandler:         authzHandler,
+		feeGrantHandler:      feeGrantHandler,
+		authenticatorHandler: authenticatorHandler,
+		wasmHistoryHandler:   wasmHistoryHandler,
+		bankHolderHandler:    bankHolderHandler,
+		authzQuerier:         NewAuthzQuerier(authzHandler, cdc, addrCodec),
+		feegrantQuerier:      NewFeegrantQuerier(feeGrantHandler, cdc, addrCodec),
+		authenticatorQuerier: NewAuthenticatorQuerier(authenticatorHandler, cdc, addrCodec),
+		wasmHistoryQuerier:   NewWasmHistoryQuerier(wasmHistoryHandler, cdc, addrCodec),
+		bankHolderQuerier:    NewBankHolderQuerier(bankHolderHandler, cdc, addrCodec),
+		healthQuerier:        newHealthQuerier(checkpoint, health),
+		subscriptionServer:   newSubscriptionServer(grantChanges, addrCodec),
+		grantChanges:         grantChanges,
+		checkpoint:           checkpoint,
+		health:               health,
+		addrCodec:            addrCodec,
+		cdc:                  cdc,
 	}
 }
 
@@ -94,6 +145,26 @@ func (ss *StreamService) FeeGrantHandler() *FeeGrantHandler {
 	return ss.feeGrantHandler
 }
 
+func (ss *StreamService) AuthenticatorHandler() *AuthenticatorHandler {
+	return ss.authenticatorHandler
+}
+
+func (ss *StreamService) WasmHistoryHandler() *WasmHistoryHandler {
+	return ss.wasmHistoryHandler
+}
+
+func (ss *StreamService) BankHolderHandler() *BankHolderHandler {
+	return ss.bankHolderHandler
+}
+
+func (ss *StreamService) GrantChanges() *GrantChangeLog {
+	return ss.grantChanges
+}
+
+func (ss *StreamService) Checkpoint() *Checkpoint {
+	return ss.checkpoint
+}
+
 func (ss *StreamService) AuthzQuerier() indexerauthz.QueryServer {
 	return ss.authzQuerier
 }
@@ -102,11 +173,58 @@ func (ss *StreamService) FeeGrantQuerier() indexerfeegrant.QueryServer {
 	return ss.feegrantQuerier
 }
 
+func (ss *StreamService) AuthenticatorQuerier() indexerauthenticator.QueryServer {
+	return ss.authenticatorQuerier
+}
+
+func (ss *StreamService) WasmHistoryQuerier() indexerwasm.QueryServer {
+	return ss.wasmHistoryQuerier
+}
+
+func (ss *StreamService) BankHolderQuerier() indexerbank.QueryServer {
+	return ss.bankHolderQuerier
+}
+
+func (ss *StreamService) HealthQuerier() indexerhealth.QueryServer {
+	return ss.healthQuerier
+}
+
+func (ss *StreamService) SubscriptionServer() indexerstream.SubscriptionServer {
+	return ss.subscriptionServer
+}
+
+// Configure applies the indexer config: the contracts whose executions are
+// recorded, whether expired grants are pruned, which bank holders are indexed
+// and how long grant changes are kept. contractInfo resolves the code id of contracts when code ids are
+// configured, and of the contracts whose authenticators are indexed when
+// accountCodes is set. accountCodes are the code ids of abstract accounts.
+func (ss *StreamService) Configure(cfg Config, contractInfo WasmContractInfoKeeper, accountCodes AbstractAccountCodes) error {
+	ss.pruneExpiredGrants = cfg.PruneExpiredGrants
+	ss.authenticatorHandler.Configure(contractInfo, accountCodes)
+	ss.bankHolderHandler.Configure(cfg)
+	ss.grantChanges.Configure(cfg)
+	return ss.wasmHistoryHandler.Configure(cfg, contractInfo)
+}
+
+// SetExporter sets the sink the authz and feegrant changes of each committed
+// block are exported to.
+func (ss *StreamService) SetExporter(exporter Exporter) {
+	ss.exporter = exporter
+}
+
 func (ss *StreamService) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
 	ss.log.Info("registering authz querier grpc gateway routes")
 	_ = indexerauthz.RegisterQueryHandlerClient(context.Background(), mux, indexerauthz.NewQueryClient(clientCtx))
 	ss.log.Info("registering feegrant querier grpc gateway routes")
 	_ = indexerfeegrant.RegisterQueryHandlerClient(context.Background(), mux, indexerfeegrant.NewQueryClient(clientCtx))
+	ss.log.Info("registering authenticator querier grpc gateway routes")
+	_ = indexerauthenticator.RegisterQueryHandlerClient(context.Background(), mux, indexerauthenticator.NewQueryClient(clientCtx))
+	ss.log.Info("registering wasm history querier grpc gateway routes")
+	_ = indexerwasm.RegisterQueryHandlerClient(context.Background(), mux, indexerwasm.NewQueryClient(clientCtx))
+	ss.log.Info("registering bank holder querier grpc gateway routes")
+	_ = indexerbank.RegisterQueryHandlerClient(context.Background(), mux, indexerbank.NewQueryClient(clientCtx))
+	ss.log.Info("registering health querier grpc gateway routes")
+	_ = indexerhealth.RegisterQueryHandlerClient(context.Background(), mux, indexerhealth.NewQueryClient(clientCtx))
 }
 
 func (ss *StreamService) RegisterServices(cfg module.Configurator) error {
@@ -114,17 +232,65 @@ func (ss *StreamService) RegisterServices(cfg module.Configurator) error {
 	indexerauthz.RegisterQueryServer(cfg.QueryServer(), ss.authzQuerier)
 	ss.log.Info("registering feegrant querier services")
 	indexerfeegrant.RegisterQueryServer(cfg.QueryServer(), ss.feegrantQuerier)
+	ss.log.Info("registering authenticator querier services")
+	indexerauthenticator.RegisterQueryServer(cfg.QueryServer(), ss.authenticatorQuerier)
+	ss.log.Info("registering wasm history querier services")
+	indexerwasm.RegisterQueryServer(cfg.QueryServer(), ss.wasmHistoryQuerier)
+	ss.log.Info("registering bank holder querier services")
+	indexerbank.RegisterQueryServer(cfg.QueryServer(), ss.bankHolderQuerier)
+	ss.log.Info("registering health querier services")
+	indexerhealth.RegisterQueryServer(cfg.QueryServer(), ss.healthQuerier)
 	return nil
 }
 
+// RegisterStreamServices registers the streaming services on the gRPC
+// server, the query router only serves unary methods.
+func (ss *StreamService) RegisterStreamServices(server gogogrpc.Server) {
+	ss.log.Info("registering grant change subscription services")
+	indexerstream.RegisterSubscriptionServer(server, ss.subscriptionServer)
+}
+
 func (ss *StreamService) Close() error {
+	if ss.exporter != nil {
+		ss.log.Info("closing indexer exporter")
+		if err := ss.exporter.Close(); err != nil {
+			ss.log.Error("Failed to close indexer exporter", "error", err)
+		}
+	}
 	ss.log.Info("closing xion_indexer.db")
 	return ss.db.Close()
 }
 
 // ListenFinalizeBlock will receive the request and response of a block
-// currently not used because we are indexing mostly by change sets and not transaction events
+// grants are indexed by change sets, the transaction events are only used to
+// account for the fees paid by fee grants and to record the executions of the
+// configured contracts. The updates of the block are buffered until
+// ListenCommit writes them together with the checkpoint.
 func (ss *StreamService) ListenFinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	ss.health.appHeight.Store(req.Height)
+	ss.pending = newBlockStore(ss.db)
+	ctx = ss.pending.context(ctx)
+
+	// grant expiration is evaluated at the block time
+	expirationLog := ss.health.logger(ss.log, HealthHandlerExpiration, true)
+	if err := SafePruneExpiredGrants(ctx, ss.authzHandler, ss.feeGrantHandler, req.Time, ss.pruneExpiredGrants, expirationLog); err != nil {
+		expirationLog.Error("Unexpected error in expired grant pruning", "height", req.Height, "error", err)
+	}
+	// failed txs still pay their fee, their events are those of the ante handler
+	spendLog := ss.health.logger(ss.log, HealthHandlerFeeGrantSpend, false)
+	for _, txResult := range res.TxResults {
+		if txResult == nil {
+			continue
+		}
+		if err := ss.feeGrantHandler.HandleTxEvents(ctx, ss.addrCodec, req.Height, txResult.Events); err != nil {
+			spendLog.Error("Unexpected error in feegrant spend handler", "height", req.Height, "error", err)
+			// Don't return error - continue processing
+		}
+	}
+	wasmLog := ss.health.logger(ss.log, HealthHandlerWasmHistory, false)
+	if err := SafeWasmHistoryHandleBlock(ctx, ss.wasmHistoryHandler, req, res, wasmLog); err != nil {
+		wasmLog.Error("Unexpected error in wasm history handler", "height", req.Height, "error", err)
+	}
 	return nil
 }
 
@@ -132,28 +298,128 @@ func (ss *StreamService) ListenFinalizeBlock(ctx context.Context, req abci.Reque
 // and the change set of the block
 // NOTE: in order to receive change sets, the app must be configured with StoreListeners.
 func (ss *StreamService) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	height := ss.health.appHeight.Load()
+	if ss.pending == nil {
+		ss.pending = newBlockStore(ss.db)
+	}
+	bs := ss.pending
+	ss.pending = nil
+	ctx = bs.context(ctx)
+	ss.grantChanges.Begin(height)
+
+	authzLog := ss.health.logger(ss.log, HealthHandlerAuthz, true)
+	feeGrantLog := ss.health.logger(ss.log, HealthHandlerFeeGrant, true)
+	authenticatorLog := ss.health.logger(ss.log, HealthHandlerAuthenticator, true)
+	bankLog := ss.health.logger(ss.log, HealthHandlerBank, true)
+	exportLog := ss.health.logger(ss.log, HealthHandlerExport, false)
+	var (
+		changes    []Change
+		incomplete bool
+	)
 	for _, pair := range changeSet {
+		if ss.exporter != nil {
+			change, ok, err := DecodeChange(ss.cdc, ss.addrCodec, height, pair)
+			if err != nil {
+				exportLog.Error("Failed to decode change for export", "height", height, "store", pair.StoreKey, "error", err)
+				incomplete = true
+			} else if ok {
+				changes = append(changes, change)
+			}
+		}
+
 		switch pair.StoreKey {
 		case authz.ModuleName:
 			// if the key is a grant index it
 			if bytes.HasPrefix(pair.Key, authzkeeper.GrantKey) {
 				// Use safe handler with logging
-				if err := SafeAuthzHandlerUpdate(ctx, ss.authzHandler, pair, ss.log); err != nil {
+				if err := SafeAuthzHandlerUpdate(ctx, ss.authzHandler, pair, authzLog); err != nil {
 					// Even with safe handlers, log any unexpected errors
-					ss.log.Error("Unexpected error in authz handler", "error", err)
+					authzLog.Error("Unexpected error in authz handler", "error", err)
 					// Don't return error - continue processing
 				}
 			}
 		case feegrant.ModuleName:
 			if bytes.HasPrefix(pair.Key, feegrant.FeeAllowanceKeyPrefix) {
 				// Use safe handler with logging
-				if err := SafeFeeGrantHandlerUpdate(ctx, ss.feeGrantHandler, pair, ss.log); err != nil {
+				if err := SafeFeeGrantHandlerUpdate(ctx, ss.feeGrantHandler, pair, feeGrantLog); err != nil {
 					// Even with safe handlers, log any unexpected errors
-					ss.log.Error("Unexpected error in feegrant handler", "error", err)
+					feeGrantLog.Error("Unexpected error in feegrant handler", "error", err)
+					// Don't return error - continue processing
+				}
+			}
+		case wasmtypes.StoreKey:
+			// abstract account contracts store their authenticators in
+			// contract state
+			if bytes.HasPrefix(pair.Key, wasmtypes.ContractStorePrefix) {
+				if err := SafeAuthenticatorHandlerUpdate(ctx, ss.authenticatorHandler, pair, authenticatorLog); err != nil {
+					authenticatorLog.Error("Unexpected error in authenticator handler", "error", err)
+					// Don't return error - continue processing
+				}
+			}
+		case banktypes.StoreKey:
+			if ss.bankHolderHandler.Enabled() &&
+				(bytes.HasPrefix(pair.Key, banktypes.BalancesPrefix) || bytes.HasPrefix(pair.Key, banktypes.DenomMetadataPrefix)) {
+				if err := SafeBankHolderHandlerUpdate(ctx, ss.bankHolderHandler, pair, bankLog); err != nil {
+					bankLog.Error("Unexpected error in bank holder handler", "error", err)
 					// Don't return error - continue processing
 				}
 			}
 		}
 	}
+
+	grantChangesLog := ss.health.logger(ss.log, HealthHandlerGrantChanges, false)
+	if err := ss.grantChanges.Prune(ctx, height); err != nil {
+		grantChangesLog.Error("Failed to prune grant changes", "height", height, "error", err)
+	}
+
+	// the checkpoint only advances while every block was fully applied, a
+	// diverged index is rebuilt on the next start
+	if height > 0 && !ss.health.diverged.Load() {
+		if err := ss.checkpoint.IndexedHeight.Set(ctx, height); err != nil {
+			ss.health.logger(ss.log, HealthHandlerStore, true).Error("Failed to set index checkpoint", "height", height, "error", err)
+		}
+		if ss.bankHolderHandler.Enabled() {
+			if err := ss.bankHolderHandler.Height.Set(ctx, height); err != nil {
+				bankLog.Error("Failed to set bank holder height", "height", height, "error", err)
+			}
+		}
+	}
+	if err := bs.write(ss.db); err != nil {
+		ss.health.logger(ss.log, HealthHandlerStore, true).Error("Failed to write index updates", "height", height, "error", err)
+	} else {
+		// subscribers only receive the changes once they can be replayed
+		ss.grantChanges.Publish()
+	}
+
+	// the sink is written once the block is in the index
+	if ss.exporter != nil {
+		if err := ss.export(ctx, height, changes, incomplete); err != nil {
+			exportLog.Error("Failed to export changes", "height", height, "error", err)
+		}
+	}
 	return nil
 }
+
+// export exports the changes of the block at height. If blocks were missed
+// since the exported height, because an export failed or the export was off,
+// or the changes are incomplete, the grants and allowances are resynced from
+// the index instead. A failed export is thus repaired by the next block.
+func (ss *StreamService) export(ctx context.Context, height int64, changes []Change, incomplete bool) error {
+	exported, err := ss.exporter.Height(ctx)
+	if err != nil {
+		return err
+	}
+	if height <= exported {
+		return nil
+	}
+	if exported == height-1 && !incomplete {
+		return ss.exporter.Export(ctx, height, changes)
+	}
+
+	ss.log.Info("Resyncing the export from the index", "exported_height", exported, "height", height)
+	snapshot, err := ExportSnapshot(ctx, ss.cdc, ss.addrCodec, height, ss.authzHandler, ss.feeGrantHandler)
+	if err != nil {
+		return err
+	}
+	return ss.exporter.Resync(ctx, height, snapshot)
+}
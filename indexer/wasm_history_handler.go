@@ -0,0 +1,324 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/collections"
+	core "cosmossdk.io/collections/corecompat"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	indexerwasm "github.com/burnt-labs/xion/indexer/wasm"
+)
+
+var (
+	WasmExecutionsPrefix             = collections.NewPrefix(0)
+	WasmExecutionSequencePrefix      = collections.NewPrefix(1)
+	WasmExecutionContractIndexPrefix = collections.NewPrefix(2)
+	WasmExecutionSenderIndexPrefix   = collections.NewPrefix(3)
+)
+
+// msgIndexAttributeKey is the attribute baseapp adds to the events of a
+// message with the index of the message in the transaction.
+const msgIndexAttributeKey = "msg_index"
+
+// WasmContractInfoKeeper looks up the code id of the contracts that are not
+// configured by address.
+type WasmContractInfoKeeper interface {
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
+
+type wasmExecutionIndexes struct {
+	Contract *indexes.Multi[sdk.AccAddress, uint64, indexerwasm.WasmExecution]
+	Sender   *indexes.Multi[sdk.AccAddress, uint64, indexerwasm.WasmExecution]
+}
+
+func (w wasmExecutionIndexes) IndexesList() []collections.Index[uint64, indexerwasm.WasmExecution] {
+	return []collections.Index[uint64, indexerwasm.WasmExecution]{w.Contract, w.Sender}
+}
+
+type WasmHistoryHandler struct {
+	kvStoreService core.KVStoreService
+	cdc            codec.Codec
+	addrCodec      address.Codec
+	txDecoder      sdk.TxDecoder
+	Schema         collections.Schema
+	Sequence       collections.Sequence
+	// key: execution id, increasing with height
+	// value: execution
+	// secondary indexes: contract, sender
+	Executions *collections.IndexedMap[uint64, indexerwasm.WasmExecution, wasmExecutionIndexes]
+
+	contracts       map[string]struct{}
+	codeIDs         map[uint64]struct{}
+	retentionBlocks uint64
+	contractInfo    WasmContractInfoKeeper
+}
+
+func newWasmExecutionIndexes(sb *collections.SchemaBuilder, addrCodec address.Codec) wasmExecutionIndexes {
+	return wasmExecutionIndexes{
+		Contract: indexes.NewMulti(
+			sb,
+			WasmExecutionContractIndexPrefix,
+			"wasm_executions_by_contract",
+			sdk.AccAddressKey,
+			collections.Uint64Key,
+			func(_ uint64, value indexerwasm.WasmExecution) (sdk.AccAddress, error) {
+				return addrCodec.StringToBytes(value.Contract)
+			},
+		),
+		Sender: indexes.NewMulti(
+			sb,
+			WasmExecutionSenderIndexPrefix,
+			"wasm_executions_by_sender",
+			sdk.AccAddressKey,
+			collections.Uint64Key,
+			func(_ uint64, value indexerwasm.WasmExecution) (sdk.AccAddress, error) {
+				return addrCodec.StringToBytes(value.Sender)
+			},
+		),
+	}
+}
+
+func NewWasmHistoryHandler(kvStoreService core.KVStoreService, cdc codec.Codec, addrCodec address.Codec) (*WasmHistoryHandler, error) {
+	sb := collections.NewSchemaBuilder(kvStoreService)
+
+	executions := collections.NewIndexedMap(
+		sb,
+		WasmExecutionsPrefix,
+		"wasm_executions", // name of the collection
+		collections.Uint64Key,
+		codec.CollValue[indexerwasm.WasmExecution](cdc),
+		newWasmExecutionIndexes(sb, addrCodec),
+	)
+	sequence := collections.NewSequence(sb, WasmExecutionSequencePrefix, "wasm_execution_sequence")
+
+	schema, err := sb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &WasmHistoryHandler{
+		Schema:         schema,
+		kvStoreService: kvStoreService,
+		cdc:            cdc,
+		addrCodec:      addrCodec,
+		txDecoder:      authtx.DefaultTxDecoder(cdc),
+		Sequence:       sequence,
+		Executions:     executions,
+		contracts:      map[string]struct{}{},
+		codeIDs:        map[uint64]struct{}{},
+	}, nil
+}
+
+// Configure sets the contracts whose executions are recorded and how long
+// they are kept for. contractInfo may be nil if no code ids are configured.
+func (wh *WasmHistoryHandler) Configure(cfg Config, contractInfo WasmContractInfoKeeper) error {
+	contracts := make(map[string]struct{}, len(cfg.WasmContracts))
+	for _, contract := range cfg.WasmContracts {
+		addr, err := wh.addrCodec.StringToBytes(contract)
+		if err != nil {
+			return fmt.Errorf("invalid wasm contract address %q: %w", contract, err)
+		}
+		contracts[string(addr)] = struct{}{}
+	}
+	codeIDs := make(map[uint64]struct{}, len(cfg.WasmCodeIDs))
+	for _, codeID := range cfg.WasmCodeIDs {
+		codeIDs[codeID] = struct{}{}
+	}
+	if len(codeIDs) > 0 && contractInfo == nil {
+		return fmt.Errorf("wasm code ids configured without a contract info keeper")
+	}
+
+	wh.contracts = contracts
+	wh.codeIDs = codeIDs
+	wh.retentionBlocks = cfg.WasmRetentionBlocks
+	wh.contractInfo = contractInfo
+	return nil
+}
+
+// Enabled returns true if any contract executions are recorded.
+func (wh *WasmHistoryHandler) Enabled() bool {
+	return len(wh.contracts) > 0 || len(wh.codeIDs) > 0
+}
+
+// HandleBlock records the contract executions of the successful transactions
+// of a block and prunes the executions past the retention.
+func (wh *WasmHistoryHandler) HandleBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	if !wh.Enabled() {
+		return nil
+	}
+
+	for i, txBytes := range req.Txs {
+		if i >= len(res.TxResults) {
+			break
+		}
+		txResult := res.TxResults[i]
+		// failed txs have no effect on the contracts
+		if txResult == nil || !txResult.IsOK() {
+			continue
+		}
+		tx, err := wh.txDecoder(txBytes)
+		if err != nil {
+			return err
+		}
+		txHash := fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+
+		for msgIndex, msg := range tx.GetMsgs() {
+			executes, err := executeContractMsgs(msg)
+			if err != nil {
+				return err
+			}
+			for _, execute := range executes {
+				if err := wh.recordExecution(ctx, req.Height, txHash, uint32(msgIndex), execute, txResult.Events); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return wh.Prune(ctx, req.Height)
+}
+
+func (wh *WasmHistoryHandler) recordExecution(ctx context.Context, height int64, txHash string, msgIndex uint32, msg *wasmtypes.MsgExecuteContract, events []abci.Event) error {
+	contract, err := wh.addrCodec.StringToBytes(msg.Contract)
+	if err != nil {
+		return err
+	}
+	if !wh.isRecorded(ctx, contract) {
+		return nil
+	}
+
+	execution := indexerwasm.WasmExecution{
+		Contract:   msg.Contract,
+		Sender:     msg.Sender,
+		Height:     height,
+		TxHash:     txHash,
+		MsgIndex:   msgIndex,
+		EntryPoint: ParseEntryPoint(msg.Msg),
+		Events:     MsgEvents(events, msgIndex),
+	}
+	id, err := wh.Sequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+	return wh.Executions.Set(ctx, id, execution)
+}
+
+func (wh *WasmHistoryHandler) isRecorded(ctx context.Context, contract sdk.AccAddress) bool {
+	if _, ok := wh.contracts[string(contract)]; ok {
+		return true
+	}
+	if len(wh.codeIDs) == 0 {
+		return false
+	}
+	info := wh.contractInfo.GetContractInfo(ctx, contract)
+	if info == nil {
+		return false
+	}
+	_, ok := wh.codeIDs[info.CodeID]
+	return ok
+}
+
+// Prune removes the executions that are older than the retention at height.
+func (wh *WasmHistoryHandler) Prune(ctx context.Context, height int64) error {
+	if wh.retentionBlocks == 0 || height <= int64(wh.retentionBlocks) {
+		return nil
+	}
+	cutoff := height - int64(wh.retentionBlocks)
+
+	// ids increase with height, so the expired executions come first
+	iter, err := wh.Executions.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	var expired []uint64
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		if kv.Value.Height > cutoff {
+			break
+		}
+		expired = append(expired, kv.Key)
+	}
+	iter.Close()
+
+	for _, id := range expired {
+		if err := wh.Executions.Remove(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeContractMsgs returns the contract executions of msg, including the
+// ones it executes through authz.
+func executeContractMsgs(msg sdk.Msg) ([]*wasmtypes.MsgExecuteContract, error) {
+	switch msg := msg.(type) {
+	case *wasmtypes.MsgExecuteContract:
+		return []*wasmtypes.MsgExecuteContract{msg}, nil
+	case *authz.MsgExec:
+		msgs, err := msg.GetMessages()
+		if err != nil {
+			return nil, err
+		}
+		var executes []*wasmtypes.MsgExecuteContract
+		for _, inner := range msgs {
+			innerExecutes, err := executeContractMsgs(inner)
+			if err != nil {
+				return nil, err
+			}
+			executes = append(executes, innerExecutes...)
+		}
+		return executes, nil
+	default:
+		return nil, nil
+	}
+}
+
+// ParseEntryPoint returns the top level key of a contract execute message,
+// or an empty string if it is not a single key JSON object.
+// This is pure business logic that can be fully unit tested
+func ParseEntryPoint(msg []byte) string {
+	var variants map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &variants); err != nil || len(variants) != 1 {
+		return ""
+	}
+	for entryPoint := range variants {
+		return entryPoint
+	}
+	return ""
+}
+
+// MsgEvents returns the events of a transaction that were emitted by the
+// message at msgIndex.
+// This is pure business logic that can be fully unit tested
+func MsgEvents(events []abci.Event, msgIndex uint32) []sdk.StringEvent {
+	index := strconv.FormatUint(uint64(msgIndex), 10)
+	msgEvents := []sdk.StringEvent{}
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			if attr.Key == msgIndexAttributeKey && attr.Value == index {
+				msgEvents = append(msgEvents, sdk.StringifyEvent(event))
+				break
+			}
+		}
+	}
+	return msgEvents
+}
@@ -0,0 +1,154 @@
+package indexer
+
+import (
+	"context"
+	"log/slog"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	indexerwasm "github.com/burnt-labs/xion/indexer/wasm"
+)
+
+type wasmHistoryQuerier struct {
+	wasmHistoryHandler *WasmHistoryHandler
+	cdc                codec.Codec
+	addrCodec          address.Codec
+}
+
+var _ indexerwasm.QueryServer = &wasmHistoryQuerier{}
+
+func NewWasmHistoryQuerier(handler *WasmHistoryHandler, cdc codec.Codec, addrCodec address.Codec) indexerwasm.QueryServer {
+	return &wasmHistoryQuerier{handler, cdc, addrCodec}
+}
+
+// MatchesExecutionFilter returns true if execution is matched by filter.
+// This is pure business logic that can be fully unit tested
+func MatchesExecutionFilter(execution indexerwasm.WasmExecution, filter indexerwasm.ExecutionFilter) bool {
+	if filter.EntryPoint != "" && execution.EntryPoint != filter.EntryPoint {
+		return false
+	}
+	if filter.MinHeight > 0 && execution.Height < filter.MinHeight {
+		return false
+	}
+	if filter.MaxHeight > 0 && execution.Height > filter.MaxHeight {
+		return false
+	}
+	return true
+}
+
+// paginateExecutions pages through the executions of an index prefix that
+// match filter and, if set, counterparty.
+func (wq *wasmHistoryQuerier) paginateExecutions(
+	ctx context.Context,
+	index multiIndexCollection[sdk.AccAddress, uint64, indexerwasm.WasmExecution],
+	prefix sdk.AccAddress,
+	counterparty func(indexerwasm.WasmExecution) string,
+	counterpartyAddr string,
+	filter indexerwasm.ExecutionFilter,
+	pageReq *query.PageRequest,
+) ([]*indexerwasm.WasmExecution, *query.PageResponse, error) {
+	return query.CollectionFilteredPaginate(
+		ctx,
+		index,
+		pageReq,
+		// key is (address, id)
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (bool, error) {
+			execution, err := wq.wasmHistoryHandler.Executions.Get(ctx, key.K2())
+			if err != nil {
+				return false, err
+			}
+			if counterpartyAddr != "" && counterparty(execution) != counterpartyAddr {
+				return false, nil
+			}
+			return MatchesExecutionFilter(execution, filter), nil
+		},
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (*indexerwasm.WasmExecution, error) {
+			execution, err := wq.wasmHistoryHandler.Executions.Get(ctx, key.K2())
+			if err != nil {
+				return nil, err
+			}
+			return &execution, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](prefix),
+	)
+}
+
+// normalizeAddress returns addr in the encoding executions are stored with,
+// or an empty string if addr is empty.
+func (wq *wasmHistoryQuerier) normalizeAddress(addr string) (string, error) {
+	if addr == "" {
+		return "", nil
+	}
+	bz, err := wq.addrCodec.StringToBytes(addr)
+	if err != nil {
+		return "", err
+	}
+	return wq.addrCodec.BytesToString(bz)
+}
+
+func (wq *wasmHistoryQuerier) ContractExecutions(ctx context.Context, req *indexerwasm.QueryContractExecutionsRequest) (*indexerwasm.QueryContractExecutionsResponse, error) {
+	contract, err := wq.addrCodec.StringToBytes(req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := wq.normalizeAddress(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	executions, pageRes, err := wq.paginateExecutions(
+		ctx,
+		multiIndexCollection[sdk.AccAddress, uint64, indexerwasm.WasmExecution]{
+			multi: wq.wasmHistoryHandler.Executions.Indexes.Contract,
+		},
+		contract,
+		func(execution indexerwasm.WasmExecution) string { return execution.Sender },
+		sender,
+		req.Filter,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("wasm_history_querier", "executions", len(executions))
+	return &indexerwasm.QueryContractExecutionsResponse{
+		Executions: executions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (wq *wasmHistoryQuerier) SenderExecutions(ctx context.Context, req *indexerwasm.QuerySenderExecutionsRequest) (*indexerwasm.QuerySenderExecutionsResponse, error) {
+	sender, err := wq.addrCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := wq.normalizeAddress(req.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	executions, pageRes, err := wq.paginateExecutions(
+		ctx,
+		multiIndexCollection[sdk.AccAddress, uint64, indexerwasm.WasmExecution]{
+			multi: wq.wasmHistoryHandler.Executions.Indexes.Sender,
+		},
+		sender,
+		func(execution indexerwasm.WasmExecution) string { return execution.Contract },
+		contract,
+		req.Filter,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("wasm_history_querier", "executions", len(executions))
+	return &indexerwasm.QuerySenderExecutionsResponse{
+		Executions: executions,
+		Pagination: pageRes,
+	}, nil
+}
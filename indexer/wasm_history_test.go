@@ -0,0 +1,243 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	indexerwasm "github.com/burnt-labs/xion/indexer/wasm"
+)
+
+type mockContractInfoKeeper map[string]uint64
+
+func (m mockContractInfoKeeper) GetContractInfo(_ context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	codeID, ok := m[string(contractAddress)]
+	if !ok {
+		return nil
+	}
+	return &wasmtypes.ContractInfo{CodeID: codeID}
+}
+
+func encodeTx(t *testing.T, cdc codec.Codec, msgs ...sdk.Msg) []byte {
+	t.Helper()
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return bz
+}
+
+func msgEvent(eventType string, msgIndex int, attrs ...string) abci.Event {
+	event := abci.Event{Type: eventType}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+	}
+	event.Attributes = append(event.Attributes, abci.EventAttribute{Key: "msg_index", Value: fmt.Sprint(msgIndex)})
+	return event
+}
+
+func TestParseEntryPoint(t *testing.T) {
+	require.Equal(t, "transfer", ParseEntryPoint([]byte(`{"transfer":{"amount":"1"}}`)))
+	require.Equal(t, "increment", ParseEntryPoint([]byte(`{"increment":{}}`)))
+	require.Equal(t, "", ParseEntryPoint([]byte(`{"a":{},"b":{}}`)))
+	require.Equal(t, "", ParseEntryPoint([]byte(`"reset"`)))
+	require.Equal(t, "", ParseEntryPoint([]byte(`not json`)))
+}
+
+func TestMsgEvents(t *testing.T) {
+	events := []abci.Event{
+		{Type: "tx", Attributes: []abci.EventAttribute{{Key: "fee", Value: "1uxion"}}},
+		msgEvent("message", 0, "action", "execute"),
+		msgEvent("wasm", 0, "action", "transfer"),
+		msgEvent("wasm", 1, "action", "burn"),
+	}
+
+	msgEvents := MsgEvents(events, 0)
+	require.Len(t, msgEvents, 2)
+	require.Equal(t, "message", msgEvents[0].Type)
+	require.Equal(t, "wasm", msgEvents[1].Type)
+	require.Equal(t, "transfer", msgEvents[1].Attributes[0].Value)
+
+	require.Len(t, MsgEvents(events, 1), 1)
+	require.Empty(t, MsgEvents(events, 2))
+}
+
+func TestMatchesExecutionFilter(t *testing.T) {
+	execution := indexerwasm.WasmExecution{Height: 10, EntryPoint: "transfer"}
+
+	require.True(t, MatchesExecutionFilter(execution, indexerwasm.ExecutionFilter{}))
+	require.True(t, MatchesExecutionFilter(execution, indexerwasm.ExecutionFilter{EntryPoint: "transfer", MinHeight: 10, MaxHeight: 10}))
+	require.False(t, MatchesExecutionFilter(execution, indexerwasm.ExecutionFilter{EntryPoint: "burn"}))
+	require.False(t, MatchesExecutionFilter(execution, indexerwasm.ExecutionFilter{MinHeight: 11}))
+	require.False(t, MatchesExecutionFilter(execution, indexerwasm.ExecutionFilter{MaxHeight: 9}))
+}
+
+func TestWasmHistoryConfigure(t *testing.T) {
+	memDB, cdc, addrCodec := setupTest(t)
+	service := NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger())
+	handler := service.WasmHistoryHandler()
+
+	require.False(t, handler.Enabled())
+
+	err := service.Configure(Config{WasmContracts: []string{"invalid"}}, nil, nil)
+	require.Error(t, err)
+
+	err = service.Configure(Config{WasmCodeIDs: []uint64{1}}, nil, nil)
+	require.Error(t, err)
+
+	err = service.Configure(Config{WasmCodeIDs: []uint64{1}}, mockContractInfoKeeper{}, nil)
+	require.NoError(t, err)
+	require.True(t, handler.Enabled())
+}
+
+func TestWasmHistory(t *testing.T) {
+	memDB, cdc, addrCodec := setupTest(t)
+	ctx := context.Background()
+	service := NewWithDB(memDB, cdc, addrCodec, log.NewNopLogger())
+	querier := service.WasmHistoryQuerier()
+
+	newAddr := func(b byte) (sdk.AccAddress, string) {
+		addr := sdk.AccAddress(make([]byte, wasmtypes.ContractAddrLen))
+		addr[0] = b
+		addrStr, err := addrCodec.BytesToString(addr)
+		require.NoError(t, err)
+		return addr, addrStr
+	}
+	_, contract1 := newAddr(1)
+	contract2Addr, contract2 := newAddr(2)
+	_, contract3 := newAddr(3)
+	_, sender1 := newAddr(4)
+	sender2Addr, sender2 := newAddr(5)
+
+	// contract1 is recorded by address, contract2 by code id
+	require.NoError(t, service.Configure(Config{
+		WasmContracts:       []string{contract1},
+		WasmCodeIDs:         []uint64{7},
+		WasmRetentionBlocks: 15,
+	}, mockContractInfoKeeper{string(contract2Addr): 7}, nil))
+
+	execute := func(sender, contract, msg string) *wasmtypes.MsgExecuteContract {
+		return &wasmtypes.MsgExecuteContract{Sender: sender, Contract: contract, Msg: []byte(msg)}
+	}
+	grant := authz.NewMsgExec(sender2Addr, []sdk.Msg{execute(sender1, contract2, `{"mint":{}}`)})
+	grant.Grantee = sender2
+
+	tx0 := encodeTx(t, cdc, execute(sender1, contract1, `{"transfer":{}}`), execute(sender1, contract3, `{"transfer":{}}`))
+	tx1 := encodeTx(t, cdc, execute(sender1, contract1, `{"burn":{}}`))
+	tx2 := encodeTx(t, cdc, &grant)
+
+	require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{
+		Height: 10,
+		Txs:    [][]byte{tx0, tx1, tx2},
+	}, abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{msgEvent("wasm", 0, "action", "transfer"), msgEvent("wasm", 1, "action", "transfer")}},
+			// failed txs are not recorded
+			{Code: 5},
+			{Events: []abci.Event{msgEvent("wasm", 0, "action", "mint")}},
+		},
+	}))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+
+	executions, err := querier.ContractExecutions(ctx, &indexerwasm.QueryContractExecutionsRequest{Contract: contract1})
+	require.NoError(t, err)
+	require.Len(t, executions.Executions, 1)
+	execution := executions.Executions[0]
+	require.Equal(t, sender1, execution.Sender)
+	require.Equal(t, int64(10), execution.Height)
+	require.Equal(t, fmt.Sprintf("%X", cmttypes.Tx(tx0).Hash()), execution.TxHash)
+	require.Equal(t, uint32(0), execution.MsgIndex)
+	require.Equal(t, "transfer", execution.EntryPoint)
+	require.Len(t, execution.Events, 1)
+
+	// contracts that are not configured are not recorded
+	executions, err = querier.ContractExecutions(ctx, &indexerwasm.QueryContractExecutionsRequest{Contract: contract3})
+	require.NoError(t, err)
+	require.Empty(t, executions.Executions)
+
+	// executions through authz are recorded with the granter as sender
+	executions, err = querier.ContractExecutions(ctx, &indexerwasm.QueryContractExecutionsRequest{Contract: contract2})
+	require.NoError(t, err)
+	require.Len(t, executions.Executions, 1)
+	require.Equal(t, sender1, executions.Executions[0].Sender)
+	require.Equal(t, "mint", executions.Executions[0].EntryPoint)
+
+	require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{
+		Height: 20,
+		Txs:    [][]byte{encodeTx(t, cdc, execute(sender2, contract1, `{"burn":{}}`))},
+	}, abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{{}},
+	}))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+
+	executions, err = querier.ContractExecutions(ctx, &indexerwasm.QueryContractExecutionsRequest{
+		Contract:   contract1,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, executions.Executions, 1)
+	require.Equal(t, uint64(2), executions.Pagination.Total)
+	require.Equal(t, int64(10), executions.Executions[0].Height)
+
+	executions, err = querier.ContractExecutions(ctx, &indexerwasm.QueryContractExecutionsRequest{
+		Contract:   contract1,
+		Pagination: &query.PageRequest{Key: executions.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, executions.Executions, 1)
+	require.Equal(t, int64(20), executions.Executions[0].Height)
+
+	for name, req := range map[string]*indexerwasm.QueryContractExecutionsRequest{
+		"sender":      {Contract: contract1, Sender: sender2},
+		"entry point": {Contract: contract1, Filter: indexerwasm.ExecutionFilter{EntryPoint: "burn"}},
+		"min height":  {Contract: contract1, Filter: indexerwasm.ExecutionFilter{MinHeight: 11}},
+	} {
+		executions, err = querier.ContractExecutions(ctx, req)
+		require.NoError(t, err, name)
+		require.Len(t, executions.Executions, 1, name)
+		require.Equal(t, int64(20), executions.Executions[0].Height, name)
+	}
+
+	senderExecutions, err := querier.SenderExecutions(ctx, &indexerwasm.QuerySenderExecutionsRequest{Sender: sender1})
+	require.NoError(t, err)
+	require.Len(t, senderExecutions.Executions, 2)
+
+	senderExecutions, err = querier.SenderExecutions(ctx, &indexerwasm.QuerySenderExecutionsRequest{Sender: sender1, Contract: contract2})
+	require.NoError(t, err)
+	require.Len(t, senderExecutions.Executions, 1)
+	require.Equal(t, contract2, senderExecutions.Executions[0].Contract)
+
+	_, err = querier.SenderExecutions(ctx, &indexerwasm.QuerySenderExecutionsRequest{Sender: "invalid"})
+	require.Error(t, err)
+	_, err = querier.ContractExecutions(ctx, &indexerwasm.QueryContractExecutionsRequest{Contract: contract1, Sender: "invalid"})
+	require.Error(t, err)
+
+	// executions at or before height 30 - 15 are pruned
+	require.NoError(t, service.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 30}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+
+	executions, err = querier.ContractExecutions(ctx, &indexerwasm.QueryContractExecutionsRequest{Contract: contract1})
+	require.NoError(t, err)
+	require.Len(t, executions.Executions, 1)
+	require.Equal(t, int64(20), executions.Executions[0].Height)
+
+	senderExecutions, err = querier.SenderExecutions(ctx, &indexerwasm.QuerySenderExecutionsRequest{Sender: sender1})
+	require.NoError(t, err)
+	require.Empty(t, senderExecutions.Executions)
+}
@@ -81,6 +81,8 @@ func init() {
 	// xion queries
 	setWhitelistedQuery("/xion.v1.Query/WebAuthNVerifyRegister", func() proto.Message { return &xiontypes.QueryWebAuthNVerifyRegisterResponse{} })
 	setWhitelistedQuery("/xion.v1.Query/WebAuthNVerifyAuthenticate", func() proto.Message { return &xiontypes.QueryWebAuthNVerifyAuthenticateResponse{} })
+	setWhitelistedQuery("/xion.v1.Query/WebAuthNCredential", func() proto.Message { return &xiontypes.QueryWebAuthNCredentialResponse{} })
+	setWhitelistedQuery("/xion.v1.Query/RelyingPartyProfile", func() proto.Message { return &xiontypes.QueryRelyingPartyProfileResponse{} })
 	setWhitelistedQuery("/xion.jwk.v1.Query/AudienceAll", func() proto.Message { return &jwktypes.QueryAllAudienceResponse{} })
 	setWhitelistedQuery("/xion.jwk.v1.Query/Audience", func() proto.Message { return &jwktypes.QueryGetAudienceResponse{} })
 	setWhitelistedQuery("/xion.jwk.v1.Query/Params", func() proto.Message { return &jwktypes.QueryParamsResponse{} })
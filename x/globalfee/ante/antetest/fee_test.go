@@ -319,14 +319,10 @@ func (s *IntegrationTestSuite) TestGetTxFeeRequired() {
 
 // TestNewFeeDecoratorPanic tests the panic condition in NewFeeDecorator
 func (s *IntegrationTestSuite) TestNewFeeDecoratorPanic() {
-	// Test panic when globalfeeSubspace doesn't have key table
-	s.Run("panic when no key table", func() {
-		// Create a subspace without setting up the key table
-		subspaceWithoutKeyTable := s.app.GetSubspace("non-existent-module")
-
-		// This should panic because HasKeyTable() returns false
+	// Test panic when no global fee param source is given
+	s.Run("panic when no param source", func() {
 		s.Require().Panics(func() {
-			xionfeeante.NewFeeDecorator(subspaceWithoutKeyTable, bondDenom)
+			xionfeeante.NewFeeDecorator(nil, nil, bondDenom)
 		})
 	})
 }
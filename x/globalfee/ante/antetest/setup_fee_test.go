@@ -17,7 +17,6 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	xionapp "github.com/burnt-labs/xion/app"
-	"github.com/burnt-labs/xion/x/globalfee"
 	xionfeeante "github.com/burnt-labs/xion/x/globalfee/ante"
 	globfeetypes "github.com/burnt-labs/xion/x/globalfee/types"
 )
@@ -55,8 +54,7 @@ func noBondDenom(_ sdk.Context) string {
 }
 
 func (s *IntegrationTestSuite) SetupTestGlobalFeeStoreAndMinGasPrice(minGasPrice []sdk.DecCoin, globalFeeParams *globfeetypes.Params, bondDenom func(sdk.Context) string) (xionfeeante.FeeDecorator, sdk.AnteHandler) {
-	subspace := s.app.GetSubspace(globalfee.ModuleName)
-	subspace.SetParamSet(s.ctx, globalFeeParams)
+	s.Require().NoError(s.app.GlobalFeeKeeper.SetParams(s.ctx, *globalFeeParams))
 	s.ctx = s.ctx.WithMinGasPrices(minGasPrice).WithIsCheckTx(true)
 
 	// set staking params
@@ -64,7 +62,7 @@ func (s *IntegrationTestSuite) SetupTestGlobalFeeStoreAndMinGasPrice(minGasPrice
 	stakingParam.BondDenom = testBondDenom
 
 	// build fee decorator
-	feeDecorator := xionfeeante.NewFeeDecorator(subspace, bondDenom)
+	feeDecorator := xionfeeante.NewFeeDecorator(s.app.GlobalFeeKeeper, s.app.GlobalFeeKeeper, bondDenom)
 
 	// chain fee decorator to antehandler
 	antehandler := sdk.ChainAnteDecorators(feeDecorator)
@@ -10,7 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/burnt-labs/xion/x/globalfee"
 	"github.com/burnt-labs/xion/x/globalfee/types"
@@ -30,17 +30,22 @@ import (
 var _ sdk.AnteDecorator = FeeDecorator{}
 
 type FeeDecorator struct {
+	// GlobalMinFeeParamSource provides the globalfee params.
 	GlobalMinFeeParamSource globalfee.ParamSource
-	StakingKeeperBondDenom  func(sdk.Context) string
+	// DynamicFeeSource provides the dynamic minimum gas prices, the static
+	// ones apply if it is nil.
+	DynamicFeeSource       globalfee.DynamicFeeSource
+	StakingKeeperBondDenom func(sdk.Context) string
 }
 
-func NewFeeDecorator(globalfeeSubspace paramtypes.Subspace, stakingKeeperDenom func(sdk.Context) string) FeeDecorator {
-	if !globalfeeSubspace.HasKeyTable() {
-		panic("global fee paramspace was not set up via module")
+func NewFeeDecorator(paramSource globalfee.ParamSource, dynamicFeeSource globalfee.DynamicFeeSource, stakingKeeperDenom func(sdk.Context) string) FeeDecorator {
+	if paramSource == nil {
+		panic("global fee param source is required")
 	}
 
 	return FeeDecorator{
-		GlobalMinFeeParamSource: globalfeeSubspace,
+		GlobalMinFeeParamSource: paramSource,
+		DynamicFeeSource:        dynamicFeeSource,
 		StakingKeeperBondDenom:  stakingKeeperDenom,
 	}
 }
@@ -129,9 +134,9 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 // In case the FeeTx's mode is CheckTx, it returns the combined requirements
 // of local min gas prices and global fees. Otherwise, in DeliverTx, it returns the global fee.
 func (mfd FeeDecorator) GetTxFeeRequired(ctx sdk.Context, tx sdk.FeeTx) (sdk.DecCoins, error) {
-	// Get required global fee min gas prices
+	// Get required global fee min gas prices of the tx
 	// Note that it should never be empty since its default value is set to coin={"StakingBondDenom", 0}
-	globalFees, err := mfd.GetGlobalFee(ctx)
+	globalFees, err := mfd.GetTxGlobalFee(ctx, tx)
 	if err != nil {
 		return sdk.DecCoins{}, err
 	}
@@ -161,16 +166,54 @@ func (mfd FeeDecorator) GetTxFeeRequired(ctx sdk.Context, tx sdk.FeeTx) (sdk.Dec
 
 // GetGlobalFee returns the global fees for a given fee tx's gas
 // (might also return 0denom if globalMinGasPrice is 0)
-// sorted in ascending order.
+// sorted in ascending order. When the dynamic fee is enabled, the dynamic
+// minimum gas prices replace the static ones.
 // Note that ParamStoreKeyMinGasPrices type requires coins sorted.
 func (mfd FeeDecorator) GetGlobalFee(ctx sdk.Context) (sdk.DecCoins, error) {
-	var (
-		globalMinGasPrices sdk.DecCoins
-		err                error
-	)
+	globalMinGasPrices, err := mfd.GetGlobalMinGasPrices(ctx)
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+
+	// global fee is empty set, set global fee to 0uxion
+	if len(globalMinGasPrices) == 0 {
+		globalMinGasPrices, err = mfd.DefaultZeroGlobalFee(ctx)
+		if err != nil {
+			return sdk.DecCoins{}, err
+		}
+	}
 
-	if mfd.GlobalMinFeeParamSource.Has(ctx, types.ParamStoreKeyMinGasPrices) {
-		mfd.GlobalMinFeeParamSource.Get(ctx, types.ParamStoreKeyMinGasPrices, &globalMinGasPrices)
+	return globalMinGasPrices.Sort(), nil
+}
+
+// GetTxGlobalFee returns the global fees of a tx, the global fees adjusted to
+// the fee overrides of its messages, including the ones executed through
+// authz MsgExec.
+func (mfd FeeDecorator) GetTxGlobalFee(ctx sdk.Context, tx sdk.FeeTx) (sdk.DecCoins, error) {
+	globalMinGasPrices, err := mfd.GetGlobalMinGasPrices(ctx)
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+
+	msgs := tx.GetMsgs()
+	msgTypeURLs := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		msgTypeURLs = append(msgTypeURLs, sdk.MsgTypeURL(msg))
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			execMsgTypeURLs, err := types.ExecMsgTypeURLs(execMsg)
+			if err != nil {
+				return sdk.DecCoins{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+			msgTypeURLs = append(msgTypeURLs, execMsgTypeURLs...)
+		}
+	}
+	msgTypeFees, err := mfd.GetMsgTypeFees(ctx)
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+	globalMinGasPrices, err = types.TxMinGasPrices(msgTypeFees, globalMinGasPrices, msgTypeURLs, tx.GetGas())
+	if err != nil {
+		return sdk.DecCoins{}, errorsmod.Wrap(sdkerrors.ErrInsufficientFee, err.Error())
 	}
 
 	// global fee is empty set, set global fee to 0uxion
@@ -184,6 +227,29 @@ func (mfd FeeDecorator) GetGlobalFee(ctx sdk.Context) (sdk.DecCoins, error) {
 	return globalMinGasPrices.Sort(), nil
 }
 
+// GetGlobalMinGasPrices returns the dynamic minimum gas prices if they are
+// enabled, the minimum gas prices param otherwise. They are empty if no
+// prices are set.
+func (mfd FeeDecorator) GetGlobalMinGasPrices(ctx sdk.Context) (sdk.DecCoins, error) {
+	params, err := mfd.GlobalMinFeeParamSource.GetParams(ctx)
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+	globalMinGasPrices := params.MinimumGasPrices
+
+	if mfd.DynamicFeeSource != nil {
+		state, found, err := mfd.DynamicFeeSource.GetDynamicFeeState(ctx)
+		if err != nil {
+			return sdk.DecCoins{}, err
+		}
+		if found {
+			globalMinGasPrices = state.GasPrices
+		}
+	}
+
+	return globalMinGasPrices, nil
+}
+
 // DefaultZeroGlobalFee returns a zero coin with the staking module bond denom
 func (mfd FeeDecorator) DefaultZeroGlobalFee(ctx sdk.Context) ([]sdk.DecCoin, error) {
 	bondDenom := mfd.getBondDenom(ctx)
@@ -210,22 +276,33 @@ func (mfd FeeDecorator) ContainsOnlyBypassMinFeeMsgs(ctx sdk.Context, msgs []sdk
 	return true
 }
 
-func (mfd FeeDecorator) GetBypassMsgTypes(ctx sdk.Context) (res []string) {
-	if mfd.GlobalMinFeeParamSource.Has(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes) {
-		mfd.GlobalMinFeeParamSource.Get(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes, &res)
+// GetBypassMsgTypes returns the bypass msg types, none if the params cannot
+// be read so that the tx pays the fees.
+func (mfd FeeDecorator) GetBypassMsgTypes(ctx sdk.Context) []string {
+	params, err := mfd.GlobalMinFeeParamSource.GetParams(ctx)
+	if err != nil {
+		return nil
 	}
 
-	return
+	return params.BypassMinFeeMsgTypes
+}
+
+func (mfd FeeDecorator) GetMsgTypeFees(ctx sdk.Context) ([]types.MsgTypeFee, error) {
+	params, err := mfd.GlobalMinFeeParamSource.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return params.MsgTypeFees, nil
 }
 
 func (mfd FeeDecorator) GetMaxTotalBypassMinFeeMsgGasUsage(ctx sdk.Context) uint64 {
-	if mfd.GlobalMinFeeParamSource.Has(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage) {
-		var res uint64
-		mfd.GlobalMinFeeParamSource.Get(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &res)
-		return res
+	params, err := mfd.GlobalMinFeeParamSource.GetParams(ctx)
+	if err != nil {
+		return types.DefaultmaxTotalBypassMinFeeMsgGasUsage
 	}
 
-	return types.DefaultmaxTotalBypassMinFeeMsgGasUsage
+	return params.MaxTotalBypassMinFeeMsgGasUsage
 }
 
 // GetMinGasPrice returns a nodes's local minimum gas prices
@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,12 +13,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/burnt-labs/xion/x/globalfee/ante"
+	"github.com/burnt-labs/xion/x/globalfee/keeper"
 	"github.com/burnt-labs/xion/x/globalfee/types"
 )
 
@@ -25,45 +29,32 @@ const (
 	testStakingDenom = "stake"
 )
 
-func TestNewFeeDecorator(t *testing.T) {
-	// Create a test subspace without key table
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
-
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	)
+// newTestKeeper returns a globalfee keeper on storeKey.
+func newTestKeeper(storeKey *storetypes.KVStoreKey) keeper.Keeper {
+	return keeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), runtime.NewKVStoreService(storeKey), "")
+}
 
+func TestNewFeeDecorator(t *testing.T) {
 	stakingDenomFunc := func(ctx sdk.Context) string { return testStakingDenom }
 
-	// Test with subspace that doesn't have key table - should panic
+	// Test without param source - should panic
 	require.Panics(t, func() {
-		ante.NewFeeDecorator(subspace, stakingDenomFunc)
+		ante.NewFeeDecorator(nil, nil, stakingDenomFunc)
 	})
 
-	// Test with subspace that has key table
-	subspaceWithKeyTable := subspace.WithKeyTable(types.ParamKeyTable())
-	decorator := ante.NewFeeDecorator(subspaceWithKeyTable, stakingDenomFunc)
+	// Test with the globalfee keeper
+	globalfeeKeeper := newTestKeeper(storetypes.NewKVStoreKey(types.StoreKey))
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, globalfeeKeeper, stakingDenomFunc)
 	require.NotNil(t, decorator)
 }
 
 func TestFeeDecoratorMethods(t *testing.T) {
 	// Create test context
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	// Set params with global fees
 	params := types.Params{
@@ -71,11 +62,11 @@ func TestFeeDecoratorMethods(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{"/ibc.core.channel.v1.MsgRecvPacket"},
 		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
 	}
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	stakingDenomFunc := func(ctx sdk.Context) string { return testStakingDenom }
 
-	decorator := ante.NewFeeDecorator(subspace, stakingDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, stakingDenomFunc)
 
 	// Test GetGlobalFee
 	globalFees, err := decorator.GetGlobalFee(ctx.Ctx)
@@ -127,17 +118,11 @@ func TestGetMinGasPrice(t *testing.T) {
 
 func TestGetGlobalFeeEmptyParams(t *testing.T) {
 	// Create test context
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	// Set params with empty global fees (should use default zero fee)
 	params := types.Params{
@@ -145,11 +130,11 @@ func TestGetGlobalFeeEmptyParams(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{},
 		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
 	}
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	stakingDenomFunc := func(ctx sdk.Context) string { return testStakingDenom }
 
-	decorator := ante.NewFeeDecorator(subspace, stakingDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, stakingDenomFunc)
 
 	// Test GetGlobalFee with empty params (should return default zero fee)
 	globalFees, err := decorator.GetGlobalFee(ctx.Ctx)
@@ -160,19 +145,52 @@ func TestGetGlobalFeeEmptyParams(t *testing.T) {
 	require.True(t, globalFees[0].Amount.IsZero())
 }
 
+type mockDynamicFeeSource struct {
+	state types.DynamicFeeState
+	found bool
+}
+
+func (m mockDynamicFeeSource) GetDynamicFeeState(context.Context) (types.DynamicFeeState, bool, error) {
+	return m.state, m.found, nil
+}
+
+func TestGetGlobalFeeDynamic(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
+
+	globalfeeKeeper := newTestKeeper(storeKey)
+
+	params := types.DefaultParams()
+	params.MinimumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(1, 3))}
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
+
+	stakingDenomFunc := func(ctx sdk.Context) string { return testStakingDenom }
+	dynamicPrices := sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(3, 3))}
+
+	// the dynamic prices replace the static ones
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, mockDynamicFeeSource{
+		state: types.DynamicFeeState{GasPrices: dynamicPrices},
+		found: true,
+	}, stakingDenomFunc)
+	globalFees, err := decorator.GetGlobalFee(ctx.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, dynamicPrices, globalFees)
+
+	// without dynamic prices the static ones apply
+	decorator = ante.NewFeeDecorator(globalfeeKeeper, mockDynamicFeeSource{}, stakingDenomFunc)
+	globalFees, err = decorator.GetGlobalFee(ctx.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.MinimumGasPrices, globalFees)
+}
+
 func TestDefaultZeroGlobalFeeError(t *testing.T) {
 	// Create test context
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	// Set params
 	params := types.Params{
@@ -180,14 +198,14 @@ func TestDefaultZeroGlobalFeeError(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{},
 		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
 	}
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	// Test with empty bond denom (should error)
 	emptyBondDenomFunc := func(ctx sdk.Context) string {
 		return ""
 	}
 
-	decorator := ante.NewFeeDecorator(subspace, emptyBondDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, emptyBondDenomFunc)
 
 	// Test DefaultZeroGlobalFee with empty bond denom
 	_, err := decorator.DefaultZeroGlobalFee(ctx.Ctx)
@@ -202,21 +220,15 @@ func TestDefaultZeroGlobalFeeError(t *testing.T) {
 
 func TestContainsOnlyBypassMinFeeMsgsEdgeCases(t *testing.T) {
 	// Create test context
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	stakingDenomFunc := func(ctx sdk.Context) string { return testStakingDenom }
 
-	decorator := ante.NewFeeDecorator(subspace, stakingDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, stakingDenomFunc)
 
 	// Test with empty messages slice
 	emptyMsgs := []sdk.Msg{}
@@ -225,7 +237,7 @@ func TestContainsOnlyBypassMinFeeMsgsEdgeCases(t *testing.T) {
 
 	// Test with default bypass messages types - use actual params that include bypass types
 	params := types.DefaultParams()
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	// Verify default bypass types exist
 	bypassTypes := decorator.GetBypassMsgTypes(ctx.Ctx)
@@ -242,12 +254,12 @@ func TestContainsOnlyBypassMinFeeMsgsEdgeCases(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{"/xion.v1.MsgSend"},
 		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
 	}
-	subspace.SetParamSet(ctx.Ctx, &customParams)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, customParams))
 
 	// Test edge case: ensure that the function correctly identifies bypass vs non-bypass
 	// by checking the behavior with default params
 	defaultParams := types.DefaultParams()
-	subspace.SetParamSet(ctx.Ctx, &defaultParams)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, defaultParams))
 
 	// Verify that bypass types are properly configured (using actual default types)
 	bypassTypes = decorator.GetBypassMsgTypes(ctx.Ctx)
@@ -294,21 +306,15 @@ func (m *mockMsg) XXX_MessageName() string { return m.typeURL }
 
 func TestContainsOnlyBypassMinFeeMsgsWithMessages(t *testing.T) {
 	// Create test context
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	stakingDenomFunc := func(ctx sdk.Context) string { return testStakingDenom }
 
-	decorator := ante.NewFeeDecorator(subspace, stakingDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, stakingDenomFunc)
 
 	// Test with mixed messages - some bypass, some not
 	// Since we can't easily create real protobuf messages that match the bypass types,
@@ -320,7 +326,7 @@ func TestContainsOnlyBypassMinFeeMsgsWithMessages(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{}, // Empty bypass list - no messages will be bypassed
 		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
 	}
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	// Test with messages when bypass list is empty - should return false for any messages
 	nonBypassMsgs := []sdk.Msg{
@@ -344,7 +350,7 @@ func TestContainsOnlyBypassMinFeeMsgsWithMessages(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{"//cosmos.bank.v1beta1.MsgSend"}, // Use the actual format that sdk.MsgTypeURL returns
 		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
 	}
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	// Create mock messages with proper protobuf-style type URLs
 	mixedMsgs := []sdk.Msg{
@@ -360,7 +366,7 @@ func TestContainsOnlyBypassMinFeeMsgsWithMessages(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{"//cosmos.bank.v1beta1.MsgSend", "//cosmos.bank.v1beta1.MsgMultiSend"}, // Use the actual format that sdk.MsgTypeURL returns
 		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
 	}
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	allBypassMsgs := []sdk.Msg{
 		&mockMsg{typeURL: "/cosmos.bank.v1beta1.MsgSend"},      // This is bypass
@@ -428,25 +434,19 @@ func (m mockTx) GetSignaturesV2() ([]signing.SignatureV2, error) { return nil, n
 
 func TestAnteHandle(t *testing.T) {
 	// Create test context
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	// Set params
 	params := types.DefaultParams()
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	stakingDenomFunc := func(ctx sdk.Context) string { return testStakingDenom }
 
-	decorator := ante.NewFeeDecorator(subspace, stakingDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, stakingDenomFunc)
 
 	// Mock next handler
 	nextCalled := false
@@ -538,7 +538,7 @@ func TestAnteHandle(t *testing.T) {
 	emptyBondDenomFunc := func(ctx sdk.Context) string {
 		return ""
 	}
-	errorDecorator := ante.NewFeeDecorator(subspace, emptyBondDenomFunc)
+	errorDecorator := ante.NewFeeDecorator(globalfeeKeeper, nil, emptyBondDenomFunc)
 
 	errorFeeTx := mockFeeTx{
 		gas:   100000,
@@ -555,17 +555,11 @@ func TestAnteHandle(t *testing.T) {
 
 func TestAnteHandle_BypassGasCap(t *testing.T) {
 	// Create test context
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	// Set params with non-empty global fees, bypass types, and a small gas cap
 	params := types.Params{
@@ -573,10 +567,10 @@ func TestAnteHandle_BypassGasCap(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{"/test.Msg"},
 		MaxTotalBypassMinFeeMsgGasUsage: 100,
 	}
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	stakingDenomFunc := func(ctx sdk.Context) string { return testStakingDenom }
-	decorator := ante.NewFeeDecorator(subspace, stakingDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, stakingDenomFunc)
 
 	// Next handler captures the context to inspect MinGasPrices
 	var capturedCtx sdk.Context
@@ -627,26 +621,20 @@ func TestAnteHandle_BypassGasCap(t *testing.T) {
 // and a high gas limit (representing large/expensive txs) requires fees even for bypass types.
 func TestAnteHandle_BypassGasCap_DefaultCapAndLargeGas(t *testing.T) {
 	// Create test context
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	// Start from defaults, then override bypass types to a local test type
 	params := types.DefaultParams()
 	params.BypassMinFeeMsgTypes = []string{"/test.Msg"}
 	// Keep the default cap (1,000,000)
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	stakingDenomFunc := func(ctx sdk.Context) string { return testStakingDenom }
-	decorator := ante.NewFeeDecorator(subspace, stakingDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, stakingDenomFunc)
 
 	// Next handler captures MinGasPrices for assertions
 	var capturedCtx sdk.Context
@@ -698,17 +686,11 @@ func TestAnteHandle_BypassGasCap_DefaultCapAndLargeGas(t *testing.T) {
 // the combination of local min gas prices and global fees (max per denom).
 func TestAnteHandle_BypassOverCap_CombinesLocalAndGlobalFees(t *testing.T) {
 	// Create test context
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	// Configure both global fees and local min gas prices, keep a modest cap
 	params := types.Params{
@@ -716,10 +698,10 @@ func TestAnteHandle_BypassOverCap_CombinesLocalAndGlobalFees(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{"/test.Msg"},
 		MaxTotalBypassMinFeeMsgGasUsage: 10_000,
 	}
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	stakingDenomFunc := func(ctx sdk.Context) string { return testStakingDenom }
-	decorator := ante.NewFeeDecorator(subspace, stakingDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, stakingDenomFunc)
 
 	// Local min gas price higher than global to exercise MaxCoins path
 	localMin := sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(2, 3))} // 0.002
@@ -756,29 +738,23 @@ func TestAnteHandle_BypassOverCap_CombinesLocalAndGlobalFees(t *testing.T) {
 
 func TestGetTxFeeRequired(t *testing.T) {
 	// Create test context
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	stakingDenomFunc := func(ctx sdk.Context) string {
 		return "stake"
 	}
 
-	decorator := ante.NewFeeDecorator(subspace, stakingDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, stakingDenomFunc)
 
 	// Test case 1: Error case - empty bond denom
 	emptyBondDenomFunc := func(ctx sdk.Context) string {
 		return ""
 	}
-	emptyDecorator := ante.NewFeeDecorator(subspace, emptyBondDenomFunc)
+	emptyDecorator := ante.NewFeeDecorator(globalfeeKeeper, nil, emptyBondDenomFunc)
 
 	payer := sdk.AccAddress([]byte("test-payer-address"))
 	feeTx := mockFeeTx{
@@ -798,7 +774,7 @@ func TestGetTxFeeRequired(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{},
 		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
 	}
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	// Set local min gas prices higher than global fees
 	localFees := sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(2, 3))}
@@ -821,6 +797,70 @@ func TestGetTxFeeRequired(t *testing.T) {
 	require.True(t, feeRequired.Equal(globalFees))
 }
 
+func TestGetTxFeeRequiredMsgTypeFees(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
+
+	globalfeeKeeper := newTestKeeper(storeKey)
+
+	params := types.DefaultParams()
+	params.MinimumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(1, 3))}
+	params.MsgTypeFees = []types.MsgTypeFee{
+		{
+			MsgTypeUrl:       sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			MinimumGasPrices: sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(5, 3))},
+			FlatFee:          sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1000))),
+		},
+	}
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
+
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, func(sdk.Context) string { return testStakingDenom })
+
+	// the override raises the gas price and spreads the flat fee over the gas
+	feeTx := mockFeeTx{gas: 100_000, msgs: []sdk.Msg{&banktypes.MsgSend{}}}
+	feeRequired, err := decorator.GetTxFeeRequired(ctx.Ctx, feeTx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(15, 3))}, feeRequired)
+
+	// other messages keep the global fee
+	feeTx.msgs = []sdk.Msg{&banktypes.MsgMultiSend{}}
+	feeRequired, err = decorator.GetTxFeeRequired(ctx.Ctx, feeTx)
+	require.NoError(t, err)
+	require.Equal(t, params.MinimumGasPrices, feeRequired)
+
+	// a flat fee cannot be priced without gas
+	feeTx = mockFeeTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}}
+	_, err = decorator.GetTxFeeRequired(ctx.Ctx, feeTx)
+	require.ErrorContains(t, err, "insufficient fee")
+
+	// the override applies to messages wrapped in MsgExec
+	grantee := sdk.AccAddress("grantee_address_____")
+	execMsg := authz.NewMsgExec(grantee, []sdk.Msg{&banktypes.MsgSend{}})
+	nestedExecMsg := authz.NewMsgExec(grantee, []sdk.Msg{&execMsg})
+	feeTx = mockFeeTx{gas: 100_000, msgs: []sdk.Msg{&nestedExecMsg}}
+	feeRequired, err = decorator.GetTxFeeRequired(ctx.Ctx, feeTx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(15, 3))}, feeRequired)
+
+	// and is passed on by the ante handler
+	_, err = decorator.AnteHandle(ctx.Ctx, feeTx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		require.Equal(t, feeRequired, ctx.MinGasPrices())
+		return ctx, nil
+	})
+	require.NoError(t, err)
+
+	// wrapping beyond the maximum depth is rejected
+	var msg sdk.Msg = &banktypes.MsgSend{}
+	for i := 0; i <= 5; i++ {
+		wrapped := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+		msg = &wrapped
+	}
+	feeTx = mockFeeTx{gas: 100_000, msgs: []sdk.Msg{msg}}
+	_, err = decorator.GetTxFeeRequired(ctx.Ctx, feeTx)
+	require.ErrorContains(t, err, "maximum depth")
+}
+
 func TestFindFunction(t *testing.T) {
 	// Test Find function edge cases to get to 100% coverage
 
@@ -897,17 +937,11 @@ func TestCombinedFeeRequirementEdgeCases(t *testing.T) {
 
 func TestAnteHandle_BypassWithAllowedFeeDenom(t *testing.T) {
 	// Test that bypass messages with fees in allowed denominations pass
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	// Set params with non-zero global fees (so denom validation is enforced)
 	params := types.Params{
@@ -915,10 +949,10 @@ func TestAnteHandle_BypassWithAllowedFeeDenom(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{},
 		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
 	}
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	stakingDenomFunc := func(ctx sdk.Context) string { return testStakingDenom }
-	decorator := ante.NewFeeDecorator(subspace, stakingDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, stakingDenomFunc)
 
 	nextCalled := false
 	nextHandler := func(c sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
@@ -945,17 +979,11 @@ func TestAnteHandle_BypassWithAllowedFeeDenom(t *testing.T) {
 
 func TestAnteHandle_BypassGetGlobalFeeError(t *testing.T) {
 	// Test that when GetGlobalFee fails during bypass fee validation, error is returned
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	// Set params with empty global fees (will use DefaultZeroGlobalFee)
 	params := types.Params{
@@ -963,11 +991,11 @@ func TestAnteHandle_BypassGetGlobalFeeError(t *testing.T) {
 		BypassMinFeeMsgTypes:            []string{},
 		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
 	}
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
 	// Use empty bond denom to make GetGlobalFee fail via DefaultZeroGlobalFee
 	emptyBondDenomFunc := func(ctx sdk.Context) string { return "" }
-	decorator := ante.NewFeeDecorator(subspace, emptyBondDenomFunc)
+	decorator := ante.NewFeeDecorator(globalfeeKeeper, nil, emptyBondDenomFunc)
 
 	nextCalled := false
 	nextHandler := func(c sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
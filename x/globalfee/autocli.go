@@ -0,0 +1,28 @@
+package globalfee
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	globalfeev1 "github.com/burnt-labs/xion/api/xion/globalfee/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+// The query commands are provided by GetQueryCmd.
+func (a AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: globalfeev1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update the globalfee params",
+					Long:           "Submit a governance proposal to update the globalfee params. All the params must be supplied as JSON.",
+					Example:        `update-params-proposal '{"minimum_gas_prices":[{"denom":"uxion","amount":"0.001"}],"bypass_min_fee_msg_types":[],"max_total_bypass_min_fee_msg_gas_usage":"1000000"}' --deposit 10000000uxion`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/burnt-labs/xion/x/globalfee/types"
 )
@@ -19,6 +20,8 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetCmdShowGlobalFeeParams(),
+		GetCmdShowDynamicFee(),
+		GetCmdRequiredFee(),
 	)
 	return queryCmd
 }
@@ -46,3 +49,60 @@ func GetCmdShowGlobalFeeParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdShowDynamicFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dynamic-fee",
+		Short: "Show the dynamic minimum gas prices",
+		Long:  "Show whether the dynamic fee is enabled and the minimum gas prices it set from the gas used by the last block",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DynamicFee(cmd.Context(), &types.QueryDynamicFeeRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdRequiredFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "required-fee [tx-file]",
+		Short: "Show the minimum fees of a transaction",
+		Long:  "Show the minimum gas prices and fees a transaction generated with --generate-only has to pay, including the fees of its message types. Use - to read the transaction from stdin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RequiredFee(cmd.Context(), &types.QueryRequiredFeeRequest{TxBytes: txBytes})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
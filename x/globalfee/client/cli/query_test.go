@@ -48,10 +48,12 @@ func TestGetQueryCmd(t *testing.T) {
 	require.Equal(t, 2, cmd.SuggestionsMinimumDistance)
 	require.NotNil(t, cmd.RunE)
 
-	// Check that it has the params subcommand
+	// Check that it has the params, dynamic-fee and required-fee subcommands
 	subcommands := cmd.Commands()
-	require.Len(t, subcommands, 1)
-	require.Equal(t, "params", subcommands[0].Use)
+	require.Len(t, subcommands, 3)
+	require.Equal(t, "dynamic-fee", subcommands[0].Use)
+	require.Equal(t, "params", subcommands[1].Use)
+	require.Equal(t, "required-fee", subcommands[2].Name())
 }
 
 func TestGetCmdShowGlobalFeeParams(t *testing.T) {
@@ -105,8 +107,10 @@ func TestQueryCmdIntegration(t *testing.T) {
 
 	// Test subcommands
 	subcommands := queryCmd.Commands()
-	require.Len(t, subcommands, 1)
-	require.Equal(t, "params", subcommands[0].Use)
+	require.Len(t, subcommands, 3)
+	require.Equal(t, "dynamic-fee", subcommands[0].Use)
+	require.Equal(t, "params", subcommands[1].Use)
+	require.Equal(t, "required-fee", subcommands[2].Name())
 }
 
 func TestParamsCommandStructure(t *testing.T) {
@@ -221,3 +225,29 @@ func TestQueryCmdRunEValidation(t *testing.T) {
 	err := queryCmd.RunE(queryCmd, []string{})
 	require.NoError(t, err, "ValidateCmd should not error with empty args")
 }
+
+func TestGetCmdShowDynamicFee(t *testing.T) {
+	cmd := cli.GetCmdShowDynamicFee()
+	require.NotNil(t, cmd)
+	require.Equal(t, "dynamic-fee", cmd.Use)
+	require.NotNil(t, cmd.RunE)
+
+	require.NoError(t, cmd.Args(cmd, []string{}))
+	require.Error(t, cmd.Args(cmd, []string{"extra"}))
+
+	require.NotNil(t, cmd.Flags().Lookup(flags.FlagOutput))
+	require.NotNil(t, cmd.Flags().Lookup(flags.FlagNode))
+}
+
+func TestGetCmdRequiredFee(t *testing.T) {
+	cmd := cli.GetCmdRequiredFee()
+	require.NotNil(t, cmd)
+	require.Equal(t, "required-fee", cmd.Name())
+	require.NotNil(t, cmd.RunE)
+
+	require.NoError(t, cmd.Args(cmd, []string{"tx.json"}))
+	require.Error(t, cmd.Args(cmd, []string{}))
+
+	require.NotNil(t, cmd.Flags().Lookup(flags.FlagOutput))
+	require.NotNil(t, cmd.Flags().Lookup(flags.FlagNode))
+}
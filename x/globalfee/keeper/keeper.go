@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/burnt-labs/xion/x/globalfee/types"
+)
+
+type Keeper struct {
+	cdc codec.BinaryCodec
+
+	// state management
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// DynamicFeeState is the dynamic minimum gas prices of the next block,
+	// it is only set while the dynamic pricing is enabled.
+	DynamicFeeState collections.Item[types.DynamicFeeState]
+
+	authority string
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	if authority == "" {
+		authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	}
+
+	k := Keeper{
+		cdc: cdc,
+		Params: collections.NewItem(
+			sb,
+			types.ParamsKey,
+			"params",
+			codec.CollValue[types.Params](cdc),
+		),
+		DynamicFeeState: collections.NewItem(
+			sb,
+			types.DynamicFeeStateKey,
+			"dynamic_fee_state",
+			codec.CollValue[types.DynamicFeeState](cdc),
+		),
+		authority: authority,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the address allowed to update the params.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the globalfee params, the default ones if they are not
+// set.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
+}
+
+// SetParams validates and stores the globalfee params.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, params)
+}
+
+// GetDynamicFeeState returns the dynamic minimum gas prices. found is false
+// if the dynamic pricing is disabled or no block was priced since it was
+// enabled.
+func (k Keeper) GetDynamicFeeState(ctx context.Context) (state types.DynamicFeeState, found bool, err error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.DynamicFeeState{}, false, err
+	}
+	if !params.DynamicFee.Enabled {
+		return types.DynamicFeeState{}, false, nil
+	}
+
+	state, err = k.DynamicFeeState.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return types.DynamicFeeState{}, false, nil
+	case err != nil:
+		return types.DynamicFeeState{}, false, err
+	}
+	return state, true, nil
+}
+
+// UpdateDynamicFee prices the next block from the gas used by the current
+// one. The state is removed while the dynamic pricing is disabled so that
+// enabling it again starts from the minimum gas prices.
+func (k Keeper) UpdateDynamicFee(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.DynamicFee.Enabled {
+		return k.DynamicFeeState.Remove(ctx)
+	}
+
+	current, err := k.DynamicFeeState.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gasUsed := sdkCtx.BlockGasMeter().GasConsumed()
+	return k.DynamicFeeState.Set(ctx, types.DynamicFeeState{
+		GasPrices:    params.DynamicFee.NextGasPrices(params.MinimumGasPrices, current.GasPrices, gasUsed),
+		Height:       sdkCtx.BlockHeight(),
+		BlockGasUsed: gasUsed,
+	})
+}
+
+// InitGenesis initializes the params and the dynamic minimum gas prices from
+// the genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	if len(gs.DynamicFeeState.GasPrices) == 0 {
+		return nil
+	}
+	return k.DynamicFeeState.Set(ctx, gs.DynamicFeeState)
+}
+
+// ExportGenesis returns the params and the dynamic minimum gas prices, the
+// latter are empty if they are not set.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := k.DynamicFeeState.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Params:          params,
+		DynamicFeeState: state,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/burnt-labs/xion/x/globalfee/keeper"
+	"github.com/burnt-labs/xion/x/globalfee/types"
+)
+
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	t.Helper()
+	globalfeeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(globalfeeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(globalfeeKey), "")
+	return k, ctx
+}
+
+// endBlock runs the end blocker of a block that used gasUsed.
+func endBlock(t *testing.T, k keeper.Keeper, ctx sdk.Context, height int64, gasUsed uint64) {
+	t.Helper()
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	gasMeter.ConsumeGas(gasUsed, "test")
+	require.NoError(t, k.UpdateDynamicFee(ctx.WithBlockHeight(height).WithBlockGasMeter(gasMeter)))
+}
+
+func TestUpdateDynamicFee(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	params := types.DefaultParams()
+	params.MinimumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.01"))}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// disabled, the static prices apply
+	endBlock(t, k, ctx, 1, 2_000_000)
+	_, found, err := k.GetDynamicFeeState(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	params.DynamicFee = types.DynamicFeeParams{
+		Enabled:          true,
+		TargetBlockGas:   1_000_000,
+		MaxChangeRate:    types.DefaultDynamicFeeMaxChangeRate,
+		MaximumGasPrices: sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.012"))},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the first block is priced from the floor
+	endBlock(t, k, ctx, 2, 2_000_000)
+	state, found, err := k.GetDynamicFeeState(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(2), state.Height)
+	require.Equal(t, uint64(2_000_000), state.BlockGasUsed)
+	require.Equal(t, "0.011250000000000000uxion", state.GasPrices.String())
+
+	// full blocks raise the prices up to the ceiling
+	endBlock(t, k, ctx, 3, 2_000_000)
+	state, _, err = k.GetDynamicFeeState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "0.012000000000000000uxion", state.GasPrices.String())
+
+	// empty blocks lower them down to the floor
+	for height := int64(4); height < 10; height++ {
+		endBlock(t, k, ctx, height, 0)
+	}
+	state, _, err = k.GetDynamicFeeState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "0.010000000000000000uxion", state.GasPrices.String())
+
+	// disabling removes the state
+	params.DynamicFee.Enabled = false
+	require.NoError(t, k.SetParams(ctx, params))
+	_, found, err = k.GetDynamicFeeState(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+	endBlock(t, k, ctx, 10, 0)
+	has, err := k.DynamicFeeState.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestParams(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	require.Equal(t, authtypes.NewModuleAddress(govtypes.ModuleName).String(), k.GetAuthority())
+
+	// the default params apply until params are set
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+
+	params.MinimumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.01"))}
+	require.NoError(t, k.SetParams(ctx, params))
+	stored, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.MinimumGasPrices, stored.MinimumGasPrices)
+
+	// invalid params are rejected
+	params.BypassMinFeeMsgTypes = []string{""}
+	require.Error(t, k.SetParams(ctx, params))
+}
+
+func TestGenesis(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	// empty dynamic fee state is not stored
+	require.NoError(t, k.InitGenesis(ctx, *types.DefaultGenesisState()))
+	gs, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DynamicFeeState{}, gs.DynamicFeeState)
+	require.Equal(t, types.DefaultParams().BypassMinFeeMsgTypes, gs.Params.BypassMinFeeMsgTypes)
+
+	expected := types.GenesisState{
+		Params: types.DefaultParams(),
+		DynamicFeeState: types.DynamicFeeState{
+			GasPrices:    sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.02"))},
+			Height:       5,
+			BlockGasUsed: 100,
+		},
+	}
+	expected.Params.MinimumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.01"))}
+	require.NoError(t, k.InitGenesis(ctx, expected))
+	gs, err = k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected.DynamicFeeState, gs.DynamicFeeState)
+	require.Equal(t, expected.Params.MinimumGasPrices, gs.Params.MinimumGasPrices)
+
+	// invalid params are rejected
+	expected.Params.BypassMinFeeMsgTypes = []string{""}
+	require.Error(t, k.InitGenesis(ctx, expected))
+}
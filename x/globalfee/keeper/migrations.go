@@ -5,19 +5,32 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	v2 "github.com/burnt-labs/xion/x/globalfee/migrations/v2"
+	v3 "github.com/burnt-labs/xion/x/globalfee/migrations/v3"
+	v4 "github.com/burnt-labs/xion/x/globalfee/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
+	keeper            Keeper
 	globalfeeSubspace paramtypes.Subspace
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator(globalfeeSubspace paramtypes.Subspace) Migrator {
-	return Migrator{globalfeeSubspace: globalfeeSubspace}
+func NewMigrator(keeper Keeper, globalfeeSubspace paramtypes.Subspace) Migrator {
+	return Migrator{keeper: keeper, globalfeeSubspace: globalfeeSubspace}
 }
 
 // Migrate1to2 migrates from version 1 to 2.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.globalfeeSubspace)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.globalfeeSubspace)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.globalfeeSubspace, m.keeper.Params)
+}
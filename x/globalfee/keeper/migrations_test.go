@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -31,7 +32,7 @@ func TestNewMigrator(t *testing.T) {
 		types.ModuleName,
 	).WithKeyTable(types.ParamKeyTable())
 
-	migrator := keeper.NewMigrator(subspace)
+	migrator := keeper.NewMigrator(keeper.Keeper{}, subspace)
 	require.NotNil(t, migrator)
 }
 
@@ -53,9 +54,55 @@ func TestMigrate1to2(t *testing.T) {
 	initialParams := sdk.DecCoins{sdk.NewDecCoin("stake", math.NewInt(1000))}
 	subspace.Set(ctx.Ctx, types.ParamStoreKeyMinGasPrices, &initialParams)
 
-	migrator := keeper.NewMigrator(subspace)
+	migrator := keeper.NewMigrator(keeper.Keeper{}, subspace)
 
 	// Test that the migration function can be called
 	err := migrator.Migrate1to2(ctx.Ctx)
 	require.NoError(t, err)
 }
+
+func TestMigrate2to3(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
+
+	subspace := paramstypes.NewSubspace(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		codec.NewLegacyAmino(),
+		storeKey,
+		tkey,
+		types.ModuleName,
+	).WithKeyTable(types.ParamKeyTable())
+
+	migrator := keeper.NewMigrator(keeper.Keeper{}, subspace)
+	require.NoError(t, migrator.Migrate2to3(ctx.Ctx))
+	require.True(t, subspace.Has(ctx.Ctx, types.ParamStoreKeyDynamicFee))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	globalfeeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{paramstypes.StoreKey: storeKey, types.StoreKey: globalfeeKey},
+		map[string]*storetypes.TransientStoreKey{paramstypes.TStoreKey: tkey},
+		nil,
+	)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	subspace := paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tkey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(globalfeeKey), "")
+
+	params := types.DefaultParams()
+	params.MinimumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(1, 3))}
+	subspace.SetParamSet(ctx, &params)
+
+	migrator := keeper.NewMigrator(k, subspace)
+	require.NoError(t, migrator.Migrate3to4(ctx))
+
+	migrated, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.MinimumGasPrices, migrated.MinimumGasPrices)
+	require.Equal(t, params.BypassMinFeeMsgTypes, migrated.BypassMinFeeMsgTypes)
+}
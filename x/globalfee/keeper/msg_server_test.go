@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/globalfee/keeper"
+	"github.com/burnt-labs/xion/x/globalfee/types"
+)
+
+func TestMsgServerUpdateParams(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.DefaultParams()
+	params.MinimumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.01"))}
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(sdk.AccAddress([]byte("not_the_authority___")).String(), params))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(k.GetAuthority(), params))
+	require.NoError(t, err)
+	stored, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.MinimumGasPrices, stored.MinimumGasPrices)
+
+	// invalid params are rejected
+	params.BypassMinFeeMsgTypes = []string{""}
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(k.GetAuthority(), params))
+	require.Error(t, err)
+}
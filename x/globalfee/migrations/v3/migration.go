@@ -0,0 +1,29 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/burnt-labs/xion/x/globalfee/types"
+)
+
+// MigrateStore performs in-place params migrations of DynamicFee and
+// MsgTypeFees.
+// The migration adds the dynamic-fee param with the dynamic pricing
+// disabled, so the minimum gas prices stay static until governance enables
+// it, and an empty list of msg type fees.
+func MigrateStore(ctx sdk.Context, globalfeeSubspace paramtypes.Subspace) error {
+	if !globalfeeSubspace.HasKeyTable() {
+		globalfeeSubspace = globalfeeSubspace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	if !globalfeeSubspace.Has(ctx, types.ParamStoreKeyDynamicFee) {
+		globalfeeSubspace.Set(ctx, types.ParamStoreKeyDynamicFee, types.DefaultDynamicFeeParams())
+	}
+
+	if !globalfeeSubspace.Has(ctx, types.ParamStoreKeyMsgTypeFees) {
+		globalfeeSubspace.Set(ctx, types.ParamStoreKeyMsgTypeFees, []types.MsgTypeFee{})
+	}
+
+	return nil
+}
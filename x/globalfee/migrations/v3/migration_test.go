@@ -0,0 +1,58 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/burnt-labs/xion/x/globalfee/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
+
+	subspace := paramstypes.NewSubspace(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		codec.NewLegacyAmino(),
+		storeKey,
+		tkey,
+		types.ModuleName,
+	).WithKeyTable(types.ParamKeyTable())
+
+	// version 2 params
+	minGasPrices := sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(1, 3))}
+	subspace.Set(ctx.Ctx, types.ParamStoreKeyMinGasPrices, minGasPrices)
+	subspace.Set(ctx.Ctx, types.ParamStoreKeyBypassMinFeeMsgTypes, types.DefaultBypassMinFeeMsgTypes)
+	subspace.Set(ctx.Ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, types.DefaultmaxTotalBypassMinFeeMsgGasUsage)
+	require.False(t, subspace.Has(ctx.Ctx, types.ParamStoreKeyDynamicFee))
+	require.False(t, subspace.Has(ctx.Ctx, types.ParamStoreKeyMsgTypeFees))
+
+	require.NoError(t, MigrateStore(ctx.Ctx, subspace))
+
+	var params types.Params
+	subspace.GetParamSet(ctx.Ctx, &params)
+	require.Equal(t, minGasPrices, params.MinimumGasPrices)
+	require.False(t, params.DynamicFee.Enabled)
+	require.Equal(t, types.DefaultDynamicFeeMaxChangeRate, params.DynamicFee.MaxChangeRate)
+	require.Empty(t, params.MsgTypeFees)
+	require.NoError(t, params.ValidateBasic())
+
+	// dynamic fee params that are already set are kept
+	params.DynamicFee.TargetBlockGas = 1_000
+	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, MigrateStore(ctx.Ctx, subspace))
+	var dynamicFee types.DynamicFeeParams
+	subspace.Get(ctx.Ctx, types.ParamStoreKeyDynamicFee, &dynamicFee)
+	require.Equal(t, uint64(1_000), dynamicFee.TargetBlockGas)
+}
@@ -0,0 +1,29 @@
+package v4
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/burnt-labs/xion/x/globalfee/types"
+)
+
+// MigrateStore migrates the params from the legacy x/params subspace to the
+// module store. Params missing from the subspace get their default value.
+// The params are copied as is, they were validated when they were set.
+func MigrateStore(ctx sdk.Context, globalfeeSubspace paramtypes.Subspace, params collections.Item[types.Params]) error {
+	if !globalfeeSubspace.HasKeyTable() {
+		globalfeeSubspace = globalfeeSubspace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	// read the params one by one as GetParamSet panics on missing ones
+	migrated := types.DefaultParams()
+	for _, pair := range migrated.ParamSetPairs() {
+		if globalfeeSubspace.Has(ctx, pair.Key) {
+			globalfeeSubspace.Get(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	return params.Set(ctx, migrated)
+}
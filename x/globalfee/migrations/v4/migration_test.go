@@ -0,0 +1,88 @@
+package v4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/burnt-labs/xion/x/globalfee/types"
+)
+
+func setup(t *testing.T) (sdk.Context, paramstypes.Subspace, collections.Item[types.Params]) {
+	t.Helper()
+	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	globalfeeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{paramstypes.StoreKey: storeKey, types.StoreKey: globalfeeKey},
+		map[string]*storetypes.TransientStoreKey{paramstypes.TStoreKey: tkey},
+		nil,
+	)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	subspace := paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tkey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(globalfeeKey))
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	return ctx, subspace, params
+}
+
+func TestMigrateStore(t *testing.T) {
+	ctx, subspace, paramsItem := setup(t)
+
+	// version 3 params
+	expected := types.DefaultParams()
+	expected.MinimumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(1, 3))}
+	expected.MaxTotalBypassMinFeeMsgGasUsage = 2_000_000
+	expected.MsgTypeFees = []types.MsgTypeFee{
+		{
+			MsgTypeUrl: "/cosmwasm.wasm.v1.MsgStoreCode",
+			FlatFee:    sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1000))),
+		},
+	}
+	subspace.SetParamSet(ctx, &expected)
+
+	require.NoError(t, MigrateStore(ctx, subspace, paramsItem))
+
+	params, err := paramsItem.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected.MinimumGasPrices, params.MinimumGasPrices)
+	require.Equal(t, expected.BypassMinFeeMsgTypes, params.BypassMinFeeMsgTypes)
+	require.Equal(t, expected.MaxTotalBypassMinFeeMsgGasUsage, params.MaxTotalBypassMinFeeMsgGasUsage)
+	require.Equal(t, expected.MsgTypeFees, params.MsgTypeFees)
+	require.False(t, params.DynamicFee.Enabled)
+	require.NoError(t, params.ValidateBasic())
+}
+
+func TestMigrateStoreMissingParams(t *testing.T) {
+	ctx, subspace, paramsItem := setup(t)
+
+	minGasPrices := sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(1, 3))}
+	subspace.Set(ctx, types.ParamStoreKeyMinGasPrices, minGasPrices)
+
+	require.NoError(t, MigrateStore(ctx, subspace, paramsItem))
+
+	params, err := paramsItem.Get(ctx)
+	require.NoError(t, err)
+	expected := types.DefaultParams()
+	expected.MinimumGasPrices = minGasPrices
+	require.Equal(t, expected.MinimumGasPrices, params.MinimumGasPrices)
+	require.Equal(t, expected.BypassMinFeeMsgTypes, params.BypassMinFeeMsgTypes)
+	require.Equal(t, expected.MaxTotalBypassMinFeeMsgGasUsage, params.MaxTotalBypassMinFeeMsgGasUsage)
+	require.Equal(t, expected.DynamicFee.MaxChangeRate, params.DynamicFee.MaxChangeRate)
+}
@@ -11,6 +11,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -29,6 +30,7 @@ var (
 	_ module.AppModuleBasic   = AppModuleBasic{}
 	_ module.AppModuleGenesis = AppModule{}
 	_ module.AppModule        = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -42,9 +44,7 @@ func (a AppModuleBasic) Name() string {
 }
 
 func (a AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(&types.GenesisState{
-		Params: types.DefaultParams(),
-	})
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
 func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, _ client.TxEncodingConfig, message json.RawMessage) error {
@@ -56,12 +56,14 @@ func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, _ client.TxEn
 	if err := data.Params.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "params")
 	}
+	if err := types.DecCoins(data.DynamicFeeState.GasPrices).Validate(); err != nil {
+		return errorsmod.Wrap(err, "dynamic fee state")
+	}
 	return nil
 }
 
-func (a AppModuleBasic) RegisterInterfaces(_ codectypes.InterfaceRegistry) {
-	// No interfaces to register for globalfee module
-	_ = noOp
+func (a AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
 }
 
 func (a AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {
@@ -90,14 +92,15 @@ func (a AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
-func (a AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {
-	// No legacy amino codec registration needed for globalfee module
-	_ = noOp
+func (a AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
 }
 
 type AppModule struct {
 	AppModuleBasic
-	globalfeeSubspace paramstypes.Subspace
+	keeper keeper.Keeper
+	// legacySubspace is only used to migrate the params from x/params
+	legacySubspace paramstypes.Subspace
 }
 
 func (a AppModule) IsOnePerModuleType() {
@@ -111,36 +114,53 @@ func (a AppModule) IsAppModule() {
 }
 
 // NewAppModule constructor
-func NewAppModule(globalfeeSubspace paramstypes.Subspace) *AppModule {
-	if !globalfeeSubspace.HasKeyTable() {
-		globalfeeSubspace = globalfeeSubspace.WithKeyTable(types.ParamKeyTable())
+func NewAppModule(legacySubspace paramstypes.Subspace, keeper keeper.Keeper) *AppModule {
+	if !legacySubspace.HasKeyTable() {
+		legacySubspace = legacySubspace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return &AppModule{globalfeeSubspace: globalfeeSubspace}
+	return &AppModule{keeper: keeper, legacySubspace: legacySubspace}
 }
 
 func (a AppModule) InitGenesis(ctx sdk.Context, marshaler codec.JSONCodec, message json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState types.GenesisState
 	marshaler.MustUnmarshalJSON(message, &genesisState)
 
-	a.globalfeeSubspace.SetParamSet(ctx, &genesisState.Params)
+	if err := a.keeper.InitGenesis(ctx, genesisState); err != nil {
+		panic(err)
+	}
 	return nil
 }
 
 func (a AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) json.RawMessage {
-	var genState types.GenesisState
-	a.globalfeeSubspace.GetParamSet(ctx, &genState.Params)
-	return marshaler.MustMarshalJSON(&genState)
+	genState, err := a.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return marshaler.MustMarshalJSON(genState)
 }
 
 func (a AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(a.keeper))
 	// inline creation of GrpcQuerier to avoid potential build tag / symbol resolution issues during linting
-	types.RegisterQueryServer(cfg.QueryServer(), GrpcQuerier{paramSource: a.globalfeeSubspace})
+	types.RegisterQueryServer(cfg.QueryServer(), GrpcQuerier{paramSource: a.keeper, dynamicFeeSource: a.keeper})
 
-	m := keeper.NewMigrator(a.globalfeeSubspace)
+	m := keeper.NewMigrator(a.keeper, a.legacySubspace)
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/globalfee from version 1 to 2: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/globalfee from version 2 to 3: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/globalfee from version 3 to 4: %v", err))
+	}
+}
+
+// EndBlock prices the next block from the gas used by this one when the
+// dynamic fee is enabled.
+func (a AppModule) EndBlock(ctx context.Context) error {
+	return a.keeper.UpdateDynamicFee(ctx)
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the
@@ -148,5 +168,5 @@ func (a AppModule) RegisterServices(cfg module.Configurator) {
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
 func (a AppModule) ConsensusVersion() uint64 {
-	return 2
+	return 4
 }
@@ -12,16 +12,21 @@ import (
 
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkruntime "github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/burnt-labs/xion/x/globalfee"
+	"github.com/burnt-labs/xion/x/globalfee/keeper"
 	"github.com/burnt-labs/xion/x/globalfee/types"
 )
 
@@ -165,7 +170,12 @@ func TestAppModule(t *testing.T) {
 	// Create a test subspace
 	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
 	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
-	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
+	globalfeeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{paramstypes.StoreKey: storeKey, types.StoreKey: globalfeeKey},
+		map[string]*storetypes.TransientStoreKey{paramstypes.TStoreKey: tkey},
+		nil,
+	)
 
 	subspace := paramstypes.NewSubspace(
 		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
@@ -177,11 +187,12 @@ func TestAppModule(t *testing.T) {
 
 	// Test NewAppModule with subspace that has key table (should NOT trigger WithKeyTable path)
 	subspaceWithKeyTable := subspace.WithKeyTable(types.ParamKeyTable())
-	appModule := globalfee.NewAppModule(subspaceWithKeyTable)
+	k := keeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), sdkruntime.NewKVStoreService(globalfeeKey), "")
+	appModule := globalfee.NewAppModule(subspaceWithKeyTable, k)
 	require.NotNil(t, appModule)
 
 	// Test NewAppModule with subspace that doesn't have key table (should trigger WithKeyTable path)
-	appModule2 := globalfee.NewAppModule(subspace)
+	appModule2 := globalfee.NewAppModule(subspace, k)
 	require.NotNil(t, appModule2)
 
 	// Test IsOnePerModuleType (should not panic)
@@ -196,24 +207,35 @@ func TestAppModule(t *testing.T) {
 
 	// Test ConsensusVersion
 	version := appModule.ConsensusVersion()
-	require.Equal(t, uint64(2), version)
+	require.Equal(t, uint64(4), version)
 
 	// Test InitGenesis
 	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 	defaultGenesis := types.DefaultGenesisState()
+	defaultGenesis.DynamicFeeState = types.DynamicFeeState{
+		GasPrices: sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyNewDecWithPrec(2, 3))},
+		Height:    10,
+	}
 	genesisData, err := cdc.MarshalJSON(defaultGenesis)
 	require.NoError(t, err)
 
-	validators := appModule.InitGenesis(ctx.Ctx, cdc, genesisData)
+	validators := appModule.InitGenesis(ctx, cdc, genesisData)
 	require.Nil(t, validators)
 
 	// Test ExportGenesis
-	exportedGenesis := appModule.ExportGenesis(ctx.Ctx, cdc)
+	exportedGenesis := appModule.ExportGenesis(ctx, cdc)
 	require.NotNil(t, exportedGenesis)
 
 	var exportedState types.GenesisState
 	err = cdc.UnmarshalJSON(exportedGenesis, &exportedState)
 	require.NoError(t, err)
+	require.Equal(t, defaultGenesis.DynamicFeeState, exportedState.DynamicFeeState)
+
+	// Test EndBlock, the dynamic fee is disabled by default and its state
+	// is removed
+	require.NoError(t, appModule.EndBlock(ctx))
+	_, err = k.DynamicFeeState.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
 }
 
 func TestNewAppModuleBothPaths(t *testing.T) {
@@ -230,7 +252,7 @@ func TestNewAppModuleBothPaths(t *testing.T) {
 		types.ModuleName,
 	)
 	require.False(t, subspace1.HasKeyTable(), "Test setup: subspace should not have key table initially")
-	appModule1 := globalfee.NewAppModule(subspace1)
+	appModule1 := globalfee.NewAppModule(subspace1, keeper.Keeper{})
 	require.NotNil(t, appModule1)
 
 	// Test path 2: subspace with key table (should NOT call WithKeyTable)
@@ -243,7 +265,7 @@ func TestNewAppModuleBothPaths(t *testing.T) {
 	)
 	subspaceWithKeyTable := subspace2.WithKeyTable(types.ParamKeyTable())
 	require.True(t, subspaceWithKeyTable.HasKeyTable(), "Test setup: subspace should have key table")
-	appModule2 := globalfee.NewAppModule(subspaceWithKeyTable)
+	appModule2 := globalfee.NewAppModule(subspaceWithKeyTable, keeper.Keeper{})
 	require.NotNil(t, appModule2)
 }
 
@@ -260,7 +282,7 @@ func TestAppModuleRegisterServices(t *testing.T) {
 		types.ModuleName,
 	).WithKeyTable(types.ParamKeyTable())
 
-	appModule := globalfee.NewAppModule(subspace)
+	appModule := globalfee.NewAppModule(subspace, keeper.Keeper{})
 
 	// Test that RegisterServices doesn't panic when called
 	// We can't easily test the actual registration without more complex mocking
@@ -284,26 +306,11 @@ func TestAppModuleRegisterServices(t *testing.T) {
 func TestAppModuleBasicNoOpMethods(t *testing.T) {
 	appModuleBasic := globalfee.AppModuleBasic{}
 
-	// Test RegisterInterfaces - should not panic
-	require.NotPanics(t, func() {
-		appModuleBasic.RegisterInterfaces(nil)
-	})
-
 	// Test RegisterRESTRoutes - should not panic
 	require.NotPanics(t, func() {
 		appModuleBasic.RegisterRESTRoutes(client.Context{}, nil)
 	})
 
-	// Test RegisterLegacyAminoCodec - should not panic
-	require.NotPanics(t, func() {
-		appModuleBasic.RegisterLegacyAminoCodec(nil)
-	})
-
-	// Test RegisterInterfaces - should not panic
-	require.NotPanics(t, func() {
-		appModuleBasic.RegisterInterfaces(nil)
-	})
-
 	// Test RegisterRESTRoutes - should not panic
 	require.NotPanics(t, func() {
 		appModuleBasic.RegisterRESTRoutes(client.Context{}, nil)
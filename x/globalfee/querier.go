@@ -3,54 +3,145 @@ package globalfee
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 
 	"github.com/burnt-labs/xion/x/globalfee/types"
 )
 
 var _ types.QueryServer = &GrpcQuerier{}
 
-// ParamSource is a read only subset of paramtypes.Subspace
+// ParamSource is a read only view of the globalfee params
 type ParamSource interface {
-	Get(ctx sdk.Context, key []byte, ptr interface{})
-	Has(ctx sdk.Context, key []byte) bool
+	GetParams(ctx context.Context) (types.Params, error)
+}
+
+// DynamicFeeSource is a read only view of the dynamic minimum gas prices
+type DynamicFeeSource interface {
+	GetDynamicFeeState(ctx context.Context) (state types.DynamicFeeState, found bool, err error)
 }
 
 type GrpcQuerier struct {
-	paramSource ParamSource
+	paramSource      ParamSource
+	dynamicFeeSource DynamicFeeSource
+}
+
+func NewGrpcQuerier(paramSource ParamSource, dynamicFeeSource DynamicFeeSource) GrpcQuerier {
+	return GrpcQuerier{paramSource: paramSource, dynamicFeeSource: dynamicFeeSource}
+}
+
+// Params returns the globalfee params
+func (g GrpcQuerier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	params, err := g.paramSource.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
 }
 
-func NewGrpcQuerier(paramSource ParamSource) GrpcQuerier {
-	return GrpcQuerier{paramSource: paramSource}
+// DynamicFee returns the current dynamic minimum gas prices
+func (g GrpcQuerier) DynamicFee(ctx context.Context, req *types.QueryDynamicFeeRequest) (*types.QueryDynamicFeeResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	params, err := g.paramSource.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, _, err := g.dynamicFeeSource.GetDynamicFeeState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDynamicFeeResponse{
+		Enabled: params.DynamicFee.Enabled,
+		State:   state,
+	}, nil
 }
 
-// MinimumGasPrices return minimum gas prices
-func (g GrpcQuerier) Params(stdCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+// RequiredFee returns the minimum fees of an encoded tx, from the global and
+// dynamic minimum gas prices and the fee overrides of its messages. The local
+// minimum gas prices of the node are not included.
+func (g GrpcQuerier) RequiredFee(ctx context.Context, req *types.QueryRequiredFeeRequest) (*types.QueryRequiredFeeResponse, error) {
 	if req == nil {
 		return nil, fmt.Errorf("invalid request")
 	}
 
-	var minGasPrices sdk.DecCoins
-	var bypassMinFeeMsgTypes []string
-	var maxTotalBypassMinFeeMsgGasUsage uint64
-	ctx := sdk.UnwrapSDKContext(stdCtx)
+	var tx txtypes.Tx
+	if err := tx.Unmarshal(req.TxBytes); err != nil {
+		return nil, fmt.Errorf("invalid tx: %w", err)
+	}
+	if tx.Body == nil || tx.AuthInfo == nil || tx.AuthInfo.Fee == nil {
+		return nil, fmt.Errorf("invalid tx: missing body or fee")
+	}
+
+	gas := tx.AuthInfo.Fee.GasLimit
+
+	params, err := g.paramSource.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// like the ante handler, bypass messages over the bypass gas usage are
+	// rejected rather than priced
+	if onlyBypassMsgs(params, tx.Body.Messages) {
+		if gas > params.MaxTotalBypassMinFeeMsgGasUsage {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee,
+				"bypass messages cannot use more than %d gas, but got %d", params.MaxTotalBypassMinFeeMsgGasUsage, gas)
+		}
+		return &types.QueryRequiredFeeResponse{Bypass: true}, nil
+	}
+
+	msgTypeURLs, err := types.MsgTypeURLs(tx.Body.Messages)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx: %w", err)
+	}
 
-	if g.paramSource.Has(ctx, types.ParamStoreKeyMinGasPrices) {
-		g.paramSource.Get(ctx, types.ParamStoreKeyMinGasPrices, &minGasPrices)
+	minGasPrices := params.MinimumGasPrices
+	state, found, err := g.dynamicFeeSource.GetDynamicFeeState(ctx)
+	if err != nil {
+		return nil, err
 	}
-	if g.paramSource.Has(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes) {
-		g.paramSource.Get(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes, &bypassMinFeeMsgTypes)
+	if found {
+		minGasPrices = state.GasPrices
 	}
-	if g.paramSource.Has(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage) {
-		g.paramSource.Get(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &maxTotalBypassMinFeeMsgGasUsage)
+
+	gasPrices, err := types.TxMinGasPrices(params.MsgTypeFees, minGasPrices, msgTypeURLs, gas)
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.QueryParamsResponse{
-		Params: types.Params{
-			MinimumGasPrices:                minGasPrices,
-			BypassMinFeeMsgTypes:            bypassMinFeeMsgTypes,
-			MaxTotalBypassMinFeeMsgGasUsage: maxTotalBypassMinFeeMsgGasUsage,
-		},
+	gasLimit := math.LegacyNewDecFromInt(math.NewIntFromUint64(gas))
+	fees := make(sdk.Coins, 0, len(gasPrices))
+	for _, price := range gasPrices {
+		fees = append(fees, sdk.NewCoin(price.Denom, price.Amount.Mul(gasLimit).Ceil().RoundInt()))
+	}
+
+	return &types.QueryRequiredFeeResponse{
+		GasPrices: gasPrices,
+		Fees:      sdk.NewCoins(fees...),
 	}, nil
 }
+
+// onlyBypassMsgs returns true if all the messages are bypass messages.
+func onlyBypassMsgs(params types.Params, msgs []*codectypes.Any) bool {
+	for _, msg := range msgs {
+		if !slices.Contains(params.BypassMinFeeMsgTypes, msg.TypeUrl) {
+			return false
+		}
+	}
+	return true
+}
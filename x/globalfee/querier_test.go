@@ -1,57 +1,54 @@
 package globalfee_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/burnt-labs/xion/x/globalfee"
+	"github.com/burnt-labs/xion/x/globalfee/keeper"
 	"github.com/burnt-labs/xion/x/globalfee/types"
 )
 
+// newTestKeeper returns a globalfee keeper on storeKey.
+func newTestKeeper(storeKey *storetypes.KVStoreKey) keeper.Keeper {
+	return keeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), runtime.NewKVStoreService(storeKey), "")
+}
+
 func TestNewGrpcQuerier(t *testing.T) {
-	// Create a test subspace
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
-
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
-
-	querier := globalfee.NewGrpcQuerier(subspace)
+	// Create a test keeper
+	globalfeeKeeper := newTestKeeper(storetypes.NewKVStoreKey(types.StoreKey))
+
+	querier := globalfee.NewGrpcQuerier(globalfeeKeeper, nil)
 	require.NotNil(t, querier)
 }
 
 func TestGrpcQuerierParams(t *testing.T) {
-	// Create a test subspace
-	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
-	tkey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	// Create a test keeper
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
 	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
 
-	subspace := paramstypes.NewSubspace(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		codec.NewLegacyAmino(),
-		storeKey,
-		tkey,
-		types.ModuleName,
-	).WithKeyTable(types.ParamKeyTable())
+	globalfeeKeeper := newTestKeeper(storeKey)
 
 	// Set default params
 	params := types.DefaultParams()
-	subspace.SetParamSet(ctx.Ctx, &params)
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
 
-	querier := globalfee.NewGrpcQuerier(subspace)
+	querier := globalfee.NewGrpcQuerier(globalfeeKeeper, nil)
 
 	// Test Params query
 	req := &types.QueryParamsRequest{}
@@ -70,3 +67,131 @@ func TestGrpcQuerierParams(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, resp)
 }
+
+type mockDynamicFeeSource struct {
+	state types.DynamicFeeState
+	found bool
+}
+
+func (m mockDynamicFeeSource) GetDynamicFeeState(context.Context) (types.DynamicFeeState, bool, error) {
+	return m.state, m.found, nil
+}
+
+func TestGrpcQuerierDynamicFee(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
+
+	globalfeeKeeper := newTestKeeper(storeKey)
+
+	params := types.DefaultParams()
+	params.MinimumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.01"))}
+	params.DynamicFee.Enabled = true
+	params.DynamicFee.TargetBlockGas = 1_000_000
+	params.DynamicFee.MaximumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.1"))}
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
+
+	state := types.DynamicFeeState{
+		GasPrices: sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.02"))},
+		Height:    7,
+	}
+	querier := globalfee.NewGrpcQuerier(globalfeeKeeper, mockDynamicFeeSource{state: state, found: true})
+
+	resp, err := querier.DynamicFee(ctx.Ctx, &types.QueryDynamicFeeRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Enabled)
+	require.Equal(t, state, resp.State)
+
+	paramsResp, err := querier.Params(ctx.Ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params.DynamicFee.TargetBlockGas, paramsResp.Params.DynamicFee.TargetBlockGas)
+
+	_, err = querier.DynamicFee(ctx.Ctx, nil)
+	require.Error(t, err)
+}
+
+func TestGrpcQuerierRequiredFee(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContextWithDB(t, storeKey, tkey)
+
+	globalfeeKeeper := newTestKeeper(storeKey)
+
+	params := types.DefaultParams()
+	params.MinimumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.001"))}
+	params.BypassMinFeeMsgTypes = []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}
+	params.MsgTypeFees = []types.MsgTypeFee{
+		{
+			MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			FlatFee:    sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1000))),
+		},
+	}
+	require.NoError(t, globalfeeKeeper.SetParams(ctx.Ctx, params))
+
+	txBytes := func(gas uint64, msgs ...sdk.Msg) []byte {
+		anys := make([]*codectypes.Any, len(msgs))
+		for i, msg := range msgs {
+			var err error
+			anys[i], err = codectypes.NewAnyWithValue(msg)
+			require.NoError(t, err)
+		}
+		tx := txtypes.Tx{
+			Body:     &txtypes.TxBody{Messages: anys},
+			AuthInfo: &txtypes.AuthInfo{Fee: &txtypes.Fee{GasLimit: gas}},
+		}
+		bz, err := tx.Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+
+	querier := globalfee.NewGrpcQuerier(globalfeeKeeper, mockDynamicFeeSource{})
+
+	// the flat fee is added to the global fee of the gas limit
+	resp, err := querier.RequiredFee(ctx.Ctx, &types.QueryRequiredFeeRequest{
+		TxBytes: txBytes(200_000, &banktypes.MsgSend{}),
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Bypass)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.006"))}, resp.GasPrices)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1200))), resp.Fees)
+
+	// the dynamic prices replace the global ones
+	querier = globalfee.NewGrpcQuerier(globalfeeKeeper, mockDynamicFeeSource{
+		state: types.DynamicFeeState{GasPrices: sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.002"))}},
+		found: true,
+	})
+	resp, err = querier.RequiredFee(ctx.Ctx, &types.QueryRequiredFeeRequest{
+		TxBytes: txBytes(200_000, &banktypes.MsgSend{}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1400))), resp.Fees)
+
+	// bypass messages are free within the bypass gas usage
+	resp, err = querier.RequiredFee(ctx.Ctx, &types.QueryRequiredFeeRequest{
+		TxBytes: txBytes(200_000, &banktypes.MsgMultiSend{}),
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Bypass)
+	require.Empty(t, resp.Fees)
+
+	// like in the ante handler, they are rejected over it
+	_, err = querier.RequiredFee(ctx.Ctx, &types.QueryRequiredFeeRequest{
+		TxBytes: txBytes(params.MaxTotalBypassMinFeeMsgGasUsage+1, &banktypes.MsgMultiSend{}),
+	})
+	require.ErrorContains(t, err, "bypass messages cannot use more than")
+
+	// the overrides of messages wrapped in MsgExec apply
+	execMsg := authz.NewMsgExec(sdk.AccAddress("grantee_address_____"), []sdk.Msg{&banktypes.MsgSend{}})
+	resp, err = querier.RequiredFee(ctx.Ctx, &types.QueryRequiredFeeRequest{
+		TxBytes: txBytes(200_000, &execMsg),
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Bypass)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1400))), resp.Fees)
+
+	_, err = querier.RequiredFee(ctx.Ctx, &types.QueryRequiredFeeRequest{TxBytes: []byte("invalid")})
+	require.Error(t, err)
+
+	_, err = querier.RequiredFee(ctx.Ctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextGasPrices returns the gas prices of the next block from the prices of
+// the current block and the gas it used. Each price moves by up to
+// MaxChangeRate, up when the block used more than the target and down when
+// it used less, and is kept between the floor and the maximum gas prices.
+// Denoms of the floor without a current price start at the floor.
+func (p DynamicFeeParams) NextGasPrices(floor, current sdk.DecCoins, gasUsed uint64) sdk.DecCoins {
+	factor := math.LegacyOneDec()
+	if p.TargetBlockGas > 0 {
+		target := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.TargetBlockGas))
+		used := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed))
+		// deviation from the target, capped at twice the target as blocks
+		// can be larger than that
+		deviation := math.LegacyMinDec(used.Sub(target).Quo(target), math.LegacyOneDec())
+		factor = factor.Add(p.MaxChangeRate.Mul(deviation))
+	}
+
+	next := make(sdk.DecCoins, 0, len(floor))
+	for _, minimum := range floor {
+		price := current.AmountOf(minimum.Denom)
+		if !price.IsPositive() {
+			price = minimum.Amount
+		}
+		price = math.LegacyMaxDec(price.Mul(factor), minimum.Amount)
+		if ceiling := p.MaximumGasPrices.AmountOf(minimum.Denom); ceiling.IsPositive() {
+			price = math.LegacyMinDec(price, ceiling)
+		}
+		next = append(next, sdk.NewDecCoinFromDec(minimum.Denom, price))
+	}
+
+	return next.Sort()
+}
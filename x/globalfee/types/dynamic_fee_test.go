@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNextGasPrices(t *testing.T) {
+	params := dynamicFeeParams(
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.5")),
+		sdk.NewDecCoinFromDec("uxion", math.LegacyNewDec(2)),
+	)
+	floor := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.01")),
+		sdk.NewDecCoinFromDec("uxion", math.LegacyNewDec(1)),
+	)
+	current := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("1.6")))
+
+	tests := map[string]struct {
+		current  sdk.DecCoins
+		gasUsed  uint64
+		expected sdk.DecCoins
+	}{
+		"at the target the prices are kept": {
+			current,
+			1_000_000,
+			sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.01")),
+				sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("1.6")),
+			),
+		},
+		"a full block raises the prices by the max change rate": {
+			current,
+			2_000_000,
+			sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.01125")),
+				sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("1.8")),
+			),
+		},
+		"blocks above twice the target raise the prices by the max change rate": {
+			current,
+			5_000_000,
+			sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.01125")),
+				sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("1.8")),
+			),
+		},
+		"a half full block lowers the prices": {
+			current,
+			500_000,
+			sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.01")),
+				sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("1.5")),
+			),
+		},
+		"prices stop at the floor": {
+			floor,
+			0,
+			floor,
+		},
+		"prices stop at the ceiling": {
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("1.9"))),
+			2_000_000,
+			sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.01125")),
+				sdk.NewDecCoinFromDec("uxion", math.LegacyNewDec(2)),
+			),
+		},
+		"denoms without a floor are dropped": {
+			current.Add(sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(3))),
+			1_000_000,
+			sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.01")),
+				sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("1.6")),
+			),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := params.NextGasPrices(floor, test.current, test.gasUsed)
+			require.Equal(t, test.expected.String(), next.String())
+		})
+	}
+}
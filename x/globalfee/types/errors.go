@@ -0,0 +1,9 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var ErrInvalidAuthority = errorsmod.Register(ModuleName, 1100, "invalid authority")
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -29,6 +31,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Params of this module
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// dynamic_fee_state is the state of the dynamic minimum gas prices
+	DynamicFeeState DynamicFeeState `protobuf:"bytes,2,opt,name=dynamic_fee_state,json=dynamicFeeState,proto3" json:"dynamic_fee_state"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +75,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetDynamicFeeState() DynamicFeeState {
+	if m != nil {
+		return m.DynamicFeeState
+	}
+	return DynamicFeeState{}
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	// minimum_gas_prices stores the minimum gas price(s) for all TX on the chain.
@@ -86,6 +97,12 @@ type Params struct {
 	// allowed for a transaction containing only messages of types in
 	// bypass_min_fee_msg_types to bypass fee charge.
 	MaxTotalBypassMinFeeMsgGasUsage uint64 `protobuf:"varint,3,opt,name=max_total_bypass_min_fee_msg_gas_usage,json=maxTotalBypassMinFeeMsgGasUsage,proto3" json:"max_total_bypass_min_fee_msg_gas_usage,omitempty"`
+	// dynamic_fee configures the adjustment of the minimum gas prices to the
+	// block utilisation.
+	DynamicFee DynamicFeeParams `protobuf:"bytes,4,opt,name=dynamic_fee,json=dynamicFee,proto3" json:"dynamic_fee,omitempty" yaml:"dynamic_fee"`
+	// msg_type_fees overrides the fees of transactions containing messages of
+	// the given types.
+	MsgTypeFees []MsgTypeFee `protobuf:"bytes,5,rep,name=msg_type_fees,json=msgTypeFees,proto3" json:"msg_type_fees,omitempty" yaml:"msg_type_fees"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -142,43 +159,287 @@ func (m *Params) GetMaxTotalBypassMinFeeMsgGasUsage() uint64 {
 	return 0
 }
 
+func (m *Params) GetDynamicFee() DynamicFeeParams {
+	if m != nil {
+		return m.DynamicFee
+	}
+	return DynamicFeeParams{}
+}
+
+func (m *Params) GetMsgTypeFees() []MsgTypeFee {
+	if m != nil {
+		return m.MsgTypeFees
+	}
+	return nil
+}
+
+// MsgTypeFee defines the fees of a message type. A transaction containing the
+// message must pay its fee in one of the denoms of the override.
+type MsgTypeFee struct {
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// minimum_gas_prices raises the minimum gas prices of a transaction
+	// containing the message.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty"`
+	// flat_fee is charged for each message of the type in a transaction, on top
+	// of the gas fee. When multiple coins are defined then they are accepted
+	// alternatively.
+	FlatFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=flat_fee,json=flatFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"flat_fee,omitempty"`
+}
+
+func (m *MsgTypeFee) Reset()         { *m = MsgTypeFee{} }
+func (m *MsgTypeFee) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeFee) ProtoMessage()    {}
+func (*MsgTypeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a27689c4e7986e7d, []int{2}
+}
+func (m *MsgTypeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeFee.Merge(m, src)
+}
+func (m *MsgTypeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeFee proto.InternalMessageInfo
+
+func (m *MsgTypeFee) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeFee) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+func (m *MsgTypeFee) GetFlatFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FlatFee
+	}
+	return nil
+}
+
+// DynamicFeeParams defines the EIP-1559 style pricing of the minimum gas
+// prices. When enabled, the minimum gas prices move each block towards the
+// block gas usage target, minimum_gas_prices is the floor of the prices.
+type DynamicFeeParams struct {
+	// enabled turns the dynamic pricing on, the static minimum_gas_prices apply
+	// otherwise.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// target_block_gas is the gas used per block at which the prices are kept.
+	TargetBlockGas uint64 `protobuf:"varint,2,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// max_change_rate is the largest relative change of the prices in a block,
+	// reached when a block uses none or twice the target gas.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
+	// maximum_gas_prices is the ceiling of the prices, it must have a price for
+	// each of the minimum_gas_prices denoms.
+	MaximumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=maximum_gas_prices,json=maximumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"maximum_gas_prices,omitempty" yaml:"maximum_gas_prices"`
+}
+
+func (m *DynamicFeeParams) Reset()         { *m = DynamicFeeParams{} }
+func (m *DynamicFeeParams) String() string { return proto.CompactTextString(m) }
+func (*DynamicFeeParams) ProtoMessage()    {}
+func (*DynamicFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a27689c4e7986e7d, []int{3}
+}
+func (m *DynamicFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicFeeParams.Merge(m, src)
+}
+func (m *DynamicFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicFeeParams proto.InternalMessageInfo
+
+func (m *DynamicFeeParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *DynamicFeeParams) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *DynamicFeeParams) GetMaximumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MaximumGasPrices
+	}
+	return nil
+}
+
+// DynamicFeeState is the current dynamic minimum gas prices.
+type DynamicFeeState struct {
+	// gas_prices are the minimum gas prices of the next block.
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices"`
+	// height is the block the prices were computed at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// block_gas_used is the gas used by the block at height.
+	BlockGasUsed uint64 `protobuf:"varint,3,opt,name=block_gas_used,json=blockGasUsed,proto3" json:"block_gas_used,omitempty"`
+}
+
+func (m *DynamicFeeState) Reset()         { *m = DynamicFeeState{} }
+func (m *DynamicFeeState) String() string { return proto.CompactTextString(m) }
+func (*DynamicFeeState) ProtoMessage()    {}
+func (*DynamicFeeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a27689c4e7986e7d, []int{4}
+}
+func (m *DynamicFeeState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicFeeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicFeeState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicFeeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicFeeState.Merge(m, src)
+}
+func (m *DynamicFeeState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicFeeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicFeeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicFeeState proto.InternalMessageInfo
+
+func (m *DynamicFeeState) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+func (m *DynamicFeeState) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DynamicFeeState) GetBlockGasUsed() uint64 {
+	if m != nil {
+		return m.BlockGasUsed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "xion.globalfee.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "xion.globalfee.v1.Params")
+	proto.RegisterType((*MsgTypeFee)(nil), "xion.globalfee.v1.MsgTypeFee")
+	proto.RegisterType((*DynamicFeeParams)(nil), "xion.globalfee.v1.DynamicFeeParams")
+	proto.RegisterType((*DynamicFeeState)(nil), "xion.globalfee.v1.DynamicFeeState")
 }
 
 func init() { proto.RegisterFile("xion/globalfee/v1/genesis.proto", fileDescriptor_a27689c4e7986e7d) }
 
 var fileDescriptor_a27689c4e7986e7d = []byte{
-	// 440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4d, 0x6e, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x82, 0x22, 0xe1, 0xb2, 0x28, 0x56, 0x17, 0x6e, 0x55, 0xd9, 0x91, 0x17, 0x28,
-	0x12, 0xed, 0x8c, 0x52, 0x76, 0x2c, 0x0d, 0x6a, 0x84, 0x50, 0x45, 0x15, 0xca, 0x06, 0x16, 0xd6,
-	0x1b, 0x33, 0x1d, 0x46, 0x78, 0x66, 0xac, 0xbc, 0x49, 0x95, 0x2c, 0xb9, 0x01, 0x07, 0xe0, 0x04,
-	0x9c, 0x81, 0x03, 0x74, 0xd9, 0x25, 0xab, 0x80, 0x92, 0x5d, 0x97, 0x9c, 0x00, 0x8d, 0xc7, 0x40,
-	0xab, 0x34, 0x2b, 0x5b, 0x7e, 0xbf, 0xff, 0x87, 0x9f, 0x5e, 0x98, 0xce, 0xa4, 0xd1, 0x54, 0x54,
-	0x86, 0x41, 0x75, 0xce, 0x39, 0xbd, 0x18, 0x52, 0xc1, 0x35, 0x47, 0x89, 0xa4, 0x9e, 0x18, 0x6b,
-	0xa2, 0x47, 0x0e, 0x20, 0xff, 0x00, 0x72, 0x31, 0xdc, 0xdb, 0x11, 0x46, 0x98, 0x66, 0x4a, 0xdd,
-	0x9b, 0x07, 0xf7, 0x92, 0xd2, 0xa0, 0x32, 0x48, 0x19, 0xa0, 0xb3, 0x61, 0xdc, 0xc2, 0x90, 0x96,
-	0x46, 0x6a, 0x3f, 0xcf, 0xde, 0x87, 0x0f, 0x47, 0xde, 0xf9, 0x8d, 0x05, 0xcb, 0xa3, 0x57, 0x61,
-	0xaf, 0x86, 0x09, 0x28, 0x8c, 0x83, 0x7e, 0x30, 0xd8, 0x3a, 0xda, 0x25, 0x6b, 0x49, 0xe4, 0xb4,
-	0x01, 0xf2, 0xf8, 0x72, 0x91, 0x76, 0xae, 0x17, 0xe9, 0xb6, 0x17, 0x1c, 0x18, 0x25, 0x2d, 0x57,
-	0xb5, 0x9d, 0x8f, 0x5b, 0x8b, 0xec, 0x6b, 0x37, 0xec, 0x79, 0x38, 0xfa, 0x1e, 0x84, 0x91, 0x92,
-	0x5a, 0xaa, 0xa9, 0x2a, 0x04, 0x60, 0x51, 0x4f, 0x64, 0xc9, 0x5d, 0x48, 0x77, 0xb0, 0x75, 0xb4,
-	0x4f, 0x7c, 0x4b, 0xe2, 0x5a, 0x92, 0xb6, 0x25, 0x79, 0xc1, 0xcb, 0xe7, 0x46, 0xea, 0xbc, 0x6e,
-	0x73, 0xf6, 0xd7, 0xf5, 0xff, 0x33, 0x7f, 0x2f, 0xd2, 0xdd, 0x39, 0xa8, 0xea, 0x59, 0xb6, 0x4e,
-	0x65, 0xdf, 0x7e, 0xa6, 0x4f, 0x84, 0xb4, 0x1f, 0xa7, 0x8c, 0x94, 0x46, 0xd1, 0x76, 0x25, 0xfe,
-	0x71, 0x88, 0x1f, 0x3e, 0x51, 0x3b, 0xaf, 0x39, 0xfe, 0x0d, 0xc4, 0xf1, 0x76, 0xeb, 0x31, 0x02,
-	0x3c, 0x6d, 0x1c, 0xa2, 0xcf, 0x41, 0x18, 0xb3, 0x79, 0x0d, 0x88, 0x85, 0x92, 0xba, 0x38, 0xe7,
-	0xbc, 0x50, 0x28, 0x8a, 0x46, 0x17, 0xdf, 0xeb, 0x77, 0x07, 0x0f, 0xf2, 0x97, 0xd7, 0x8b, 0x34,
-	0xdb, 0xc4, 0xdc, 0x2a, 0x9a, 0xfa, 0xa2, 0x9b, 0xd8, 0x6c, 0xbc, 0xe3, 0x47, 0x27, 0x52, 0x1f,
-	0x73, 0x7e, 0x82, 0xe2, 0xcc, 0x7d, 0x8e, 0x5e, 0x87, 0x8f, 0x15, 0xcc, 0x0a, 0x6b, 0x2c, 0x54,
-	0xc5, 0x1d, 0x62, 0xf7, 0xc3, 0x53, 0x04, 0xc1, 0xe3, 0x6e, 0x3f, 0x18, 0xdc, 0x1f, 0xa7, 0x0a,
-	0x66, 0x67, 0x0e, 0xce, 0x6f, 0xbb, 0x8d, 0x00, 0xdf, 0x3a, 0x2c, 0x3f, 0xbe, 0x5c, 0x26, 0xc1,
-	0xd5, 0x32, 0x09, 0x7e, 0x2d, 0x93, 0xe0, 0xcb, 0x2a, 0xe9, 0x5c, 0xad, 0x92, 0xce, 0x8f, 0x55,
-	0xd2, 0x79, 0x77, 0x70, 0x63, 0x5b, 0x6c, 0x3a, 0xd1, 0xf6, 0xb0, 0x02, 0x86, 0xb4, 0xb9, 0xca,
-	0xd9, 0x8d, 0xbb, 0x6c, 0xfa, 0xb2, 0x5e, 0x73, 0x4a, 0x4f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xa9, 0x65, 0x13, 0x6c, 0xb6, 0x02, 0x00, 0x00,
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x93, 0x6c, 0x76, 0x33, 0xe9, 0x6e, 0xb3, 0xa3, 0x02, 0xce, 0xb2, 0xc4, 0x91, 0x41,
+	0x28, 0x12, 0x5b, 0x5b, 0x69, 0x0f, 0x48, 0x1c, 0xdd, 0x28, 0x11, 0x82, 0x8a, 0xca, 0x6d, 0x0f,
+	0x70, 0xb1, 0xc6, 0xce, 0xd4, 0xb1, 0xea, 0xb1, 0xa3, 0xcc, 0xa4, 0x72, 0x10, 0x17, 0xbe, 0x01,
+	0x12, 0x1f, 0x80, 0x3b, 0x37, 0x24, 0x8e, 0x5c, 0xb8, 0xf5, 0x84, 0x2a, 0x4e, 0x88, 0x43, 0x40,
+	0xed, 0xad, 0x27, 0xc4, 0x27, 0x40, 0xf3, 0x27, 0x69, 0xdc, 0xa4, 0xa8, 0x55, 0x4f, 0xc9, 0xcc,
+	0xfb, 0xbd, 0xf7, 0x7e, 0xef, 0xbd, 0x9f, 0xdf, 0x00, 0x23, 0x8b, 0xd2, 0xc4, 0x0e, 0xe3, 0xd4,
+	0x47, 0xf1, 0x09, 0xc6, 0xf6, 0x59, 0xc7, 0x0e, 0x71, 0x82, 0x69, 0x44, 0xad, 0xd1, 0x38, 0x65,
+	0x29, 0x7c, 0xc9, 0x01, 0xd6, 0x02, 0x60, 0x9d, 0x75, 0x5e, 0x6d, 0x85, 0x69, 0x98, 0x0a, 0xab,
+	0xcd, 0xff, 0x49, 0xe0, 0xab, 0x46, 0x90, 0x52, 0x92, 0x52, 0x4f, 0x1a, 0xe4, 0x41, 0x99, 0x9a,
+	0xf2, 0x64, 0xfb, 0x88, 0xf2, 0x0c, 0x3e, 0x66, 0xa8, 0x63, 0x07, 0x69, 0x94, 0x48, 0xbb, 0xf9,
+	0x93, 0x06, 0x36, 0xfa, 0x32, 0xeb, 0x21, 0x43, 0x0c, 0xc3, 0xcf, 0x40, 0x65, 0x84, 0xc6, 0x88,
+	0x50, 0x5d, 0x6b, 0x69, 0xed, 0xda, 0x4e, 0xc3, 0x5a, 0x61, 0x61, 0x1d, 0x08, 0x80, 0xa3, 0x9f,
+	0xcf, 0x8c, 0xc2, 0xf5, 0xcc, 0xa8, 0x4b, 0x87, 0x37, 0x29, 0x89, 0x18, 0x26, 0x23, 0x36, 0x75,
+	0x55, 0x08, 0x78, 0x04, 0x5e, 0x0e, 0xa6, 0x09, 0x22, 0x51, 0xe0, 0x9d, 0x60, 0xec, 0x51, 0x9e,
+	0x41, 0x2f, 0x8a, 0xb8, 0xe6, 0x9a, 0xb8, 0x5d, 0x89, 0xed, 0x61, 0x2c, 0xb8, 0x38, 0x65, 0x9e,
+	0xc0, 0xdd, 0x1c, 0xe4, 0xaf, 0xcd, 0xef, 0x9f, 0x80, 0x8a, 0xa4, 0x00, 0x7f, 0xd1, 0x00, 0x24,
+	0x51, 0x12, 0x91, 0x09, 0xf1, 0x42, 0xc4, 0x3b, 0x10, 0x05, 0x98, 0x53, 0x2f, 0xb5, 0x6b, 0x3b,
+	0xaf, 0x2d, 0xd5, 0x0a, 0x5e, 0xbc, 0xa5, 0x8a, 0xb7, 0xba, 0x38, 0xd8, 0x4b, 0xa3, 0xc4, 0x19,
+	0x29, 0xf6, 0xaf, 0x57, 0xfd, 0x6f, 0x2a, 0xf9, 0x77, 0x66, 0x34, 0xa6, 0x88, 0xc4, 0x9f, 0x98,
+	0xab, 0x28, 0xf3, 0xc7, 0xbf, 0x8c, 0x8f, 0xc2, 0x88, 0x0d, 0x27, 0xbe, 0x15, 0xa4, 0x44, 0xf5,
+	0x5d, 0xfd, 0x6c, 0xd3, 0xc1, 0xa9, 0xcd, 0xa6, 0x23, 0x4c, 0xe7, 0x09, 0xa9, 0x5b, 0x57, 0x31,
+	0xfa, 0x88, 0x1e, 0x88, 0x08, 0xf0, 0x5b, 0x0d, 0xe8, 0xfe, 0x74, 0x84, 0x28, 0xf5, 0x48, 0x94,
+	0x88, 0x1e, 0x11, 0x1a, 0x7a, 0xc2, 0x4f, 0x2f, 0xb6, 0x4a, 0xed, 0xaa, 0xf3, 0xe9, 0xf5, 0xcc,
+	0x30, 0xef, 0xc2, 0xe4, 0x88, 0x1a, 0x92, 0xe8, 0x5d, 0x58, 0xd3, 0xdd, 0x92, 0xa6, 0xfd, 0x28,
+	0xe9, 0x61, 0xbc, 0x4f, 0xc3, 0x23, 0x7e, 0x0d, 0xbf, 0x00, 0x1f, 0x12, 0x94, 0x79, 0x2c, 0x65,
+	0x28, 0xf6, 0xd6, 0x38, 0xf3, 0x82, 0x27, 0x14, 0x85, 0x58, 0x2f, 0xb5, 0xb4, 0x76, 0xd9, 0x35,
+	0x08, 0xca, 0x8e, 0x38, 0xd8, 0xc9, 0x47, 0xeb, 0x23, 0x7a, 0xcc, 0x61, 0x70, 0x0a, 0x6a, 0x4b,
+	0x43, 0xd7, 0xcb, 0x62, 0xdc, 0xef, 0xff, 0xef, 0xb8, 0x95, 0xa0, 0x76, 0xd5, 0x48, 0xde, 0x5a,
+	0xf2, 0xcf, 0x95, 0x08, 0x65, 0x89, 0x4b, 0x66, 0xd3, 0x05, 0x37, 0xf2, 0x80, 0x5f, 0x83, 0xe7,
+	0xf3, 0x7a, 0xb9, 0x91, 0xea, 0x4f, 0x84, 0x10, 0xde, 0x5b, 0x93, 0x5c, 0xd5, 0xdf, 0xc3, 0xd8,
+	0xf9, 0x58, 0xa5, 0x7d, 0x27, 0xe7, 0x9b, 0x4b, 0xbc, 0xa5, 0x44, 0xb0, 0x0c, 0x30, 0xdd, 0x1a,
+	0x59, 0x04, 0xa1, 0xe6, 0x6f, 0x45, 0x00, 0x6e, 0x82, 0xc2, 0x16, 0xd8, 0x58, 0xa0, 0x27, 0xe3,
+	0x58, 0x7c, 0x4d, 0x55, 0x17, 0x28, 0x8f, 0xe3, 0x71, 0x0c, 0x7f, 0x58, 0xaf, 0xdd, 0xe2, 0x3d,
+	0xb4, 0x7b, 0x78, 0x1f, 0xed, 0x3e, 0x5e, 0x9e, 0xdf, 0x80, 0x67, 0x27, 0x31, 0x62, 0x62, 0x8c,
+	0x25, 0x41, 0xab, 0xb1, 0x96, 0x96, 0xe0, 0xd4, 0x53, 0x9c, 0xe0, 0xdc, 0x25, 0xc7, 0xa4, 0x7d,
+	0x0f, 0x26, 0x92, 0xc6, 0x53, 0xee, 0xdf, 0xc3, 0xd8, 0xfc, 0xa7, 0x08, 0xea, 0xb7, 0x25, 0x02,
+	0x75, 0xf0, 0x14, 0x27, 0xc8, 0x8f, 0xf1, 0x40, 0x74, 0xf4, 0x99, 0x3b, 0x3f, 0xc2, 0x36, 0xa8,
+	0x33, 0x34, 0x0e, 0x31, 0xf3, 0xfc, 0x38, 0x0d, 0x4e, 0x79, 0x4b, 0xc4, 0xaa, 0x29, 0xbb, 0x2f,
+	0xe4, 0xbd, 0xc3, 0xaf, 0xfb, 0x88, 0xc2, 0x2f, 0xc1, 0x26, 0x57, 0x7c, 0x30, 0x44, 0x49, 0x88,
+	0xbd, 0x31, 0x62, 0x52, 0xda, 0x55, 0xa7, 0xc3, 0x4b, 0xf8, 0x73, 0x66, 0xbc, 0x2b, 0xa9, 0xd1,
+	0xc1, 0xa9, 0x15, 0xa5, 0x36, 0x41, 0x6c, 0x68, 0x7d, 0x8e, 0x43, 0x14, 0x4c, 0xbb, 0x38, 0xf8,
+	0xfd, 0xe7, 0x6d, 0xa0, 0x7a, 0xd0, 0xc5, 0x81, 0xfb, 0x9c, 0xa0, 0x6c, 0x4f, 0x04, 0x72, 0xf9,
+	0xf6, 0x14, 0xfb, 0x08, 0x65, 0xb7, 0x67, 0x5a, 0x7e, 0xd0, 0x3e, 0x5a, 0xf1, 0x5f, 0xbb, 0x8f,
+	0x50, 0xf6, 0xf8, 0x7d, 0x84, 0xb2, 0xdc, 0xc0, 0xcd, 0x5f, 0x35, 0xb0, 0x79, 0x6b, 0x09, 0xc3,
+	0x11, 0x00, 0x0f, 0xdc, 0xac, 0xe2, 0x33, 0x7e, 0x28, 0x99, 0x6a, 0xb8, 0x90, 0xdd, 0xdb, 0xa0,
+	0x32, 0xc4, 0x51, 0x38, 0x64, 0x62, 0x7e, 0x25, 0x57, 0x9d, 0xe0, 0x07, 0xe0, 0xc5, 0x62, 0xb4,
+	0xde, 0x84, 0xe2, 0x81, 0xda, 0x48, 0x1b, 0xbe, 0x9a, 0xec, 0x31, 0xc5, 0x03, 0xa7, 0x77, 0x7e,
+	0xd9, 0xd4, 0x2e, 0x2e, 0x9b, 0xda, 0xdf, 0x97, 0x4d, 0xed, 0xbb, 0xab, 0x66, 0xe1, 0xe2, 0xaa,
+	0x59, 0xf8, 0xe3, 0xaa, 0x59, 0xf8, 0xea, 0xcd, 0x12, 0x1f, 0x7f, 0x32, 0x4e, 0xd8, 0x76, 0x8c,
+	0x7c, 0x6a, 0x8b, 0x67, 0x38, 0x5b, 0x7a, 0x88, 0x05, 0x33, 0xbf, 0x22, 0x1e, 0xc8, 0xdd, 0xff,
+	0x06, 0x00, 0xbd, 0x9f, 0x58, 0xa2, 0xa7, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -201,6 +462,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.DynamicFeeState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -234,6 +505,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgTypeFees) > 0 {
+		for iNdEx := len(m.MsgTypeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.DynamicFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
 		i--
@@ -265,76 +560,322 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTypeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+
+func (m *MsgTypeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTypeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for _, e := range m.MinimumGasPrices {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FlatFee) > 0 {
+		for iNdEx := len(m.FlatFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.BypassMinFeeMsgTypes) > 0 {
-		for _, s := range m.BypassMinFeeMsgTypes {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
-		n += 1 + sovGenesis(uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DynamicFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DynamicFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *DynamicFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaximumGasPrices) > 0 {
+		for iNdEx := len(m.MaximumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaximumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicFeeState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicFeeState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicFeeState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGasUsed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DynamicFeeState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for _, s := range m.BypassMinFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+	}
+	l = m.DynamicFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MsgTypeFees) > 0 {
+		for _, e := range m.MsgTypeFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTypeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FlatFee) > 0 {
+		for _, e := range m.FlatFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DynamicFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBlockGas))
+	}
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MaximumGasPrices) > 0 {
+		for _, e := range m.MaximumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DynamicFeeState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.BlockGasUsed != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockGasUsed))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
 		}
 		fieldNum := int32(wire >> 3)
@@ -379,6 +920,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicFeeState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -514,6 +1088,502 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeFees = append(m.MsgTypeFees, MsgTypeFee{})
+			if err := m.MsgTypeFees[len(m.MsgTypeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFee = append(m.FlatFee, types.Coin{})
+			if err := m.FlatFee[len(m.FlatFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaximumGasPrices = append(m.MaximumGasPrices, types.DecCoin{})
+			if err := m.MaximumGasPrices[len(m.MaximumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicFeeState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicFeeState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicFeeState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasUsed", wireType)
+			}
+			m.BlockGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName is the name of the this module
 	ModuleName = "globalfee"
@@ -8,3 +10,10 @@ const (
 
 	QuerierRoute = ModuleName
 )
+
+var (
+	// DynamicFeeStateKey is the store key of the dynamic minimum gas prices.
+	DynamicFeeStateKey = collections.NewPrefix(0)
+	// ParamsKey is the store key of the module params.
+	ParamsKey = collections.NewPrefix(1)
+)
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// maxMsgExecDepth limits how deeply nested MsgExec messages are unwrapped to
+// find the fee overrides of the messages they execute.
+const maxMsgExecDepth = 5
+
+var msgExecTypeURL = sdk.MsgTypeURL(&authz.MsgExec{})
+
+// MsgTypeURLs returns the type URLs of msgs followed by those of the messages
+// they execute through authz MsgExec, so the overrides of wrapped messages
+// apply as well.
+func MsgTypeURLs(msgs []*codectypes.Any) ([]string, error) {
+	return appendMsgTypeURLs(nil, msgs, 0)
+}
+
+// ExecMsgTypeURLs returns the type URLs of the messages executed by a
+// MsgExec, including the ones of nested MsgExec messages.
+func ExecMsgTypeURLs(msg *authz.MsgExec) ([]string, error) {
+	return appendMsgTypeURLs(nil, msg.Msgs, 1)
+}
+
+func appendMsgTypeURLs(msgTypeURLs []string, msgs []*codectypes.Any, depth int) ([]string, error) {
+	for _, msg := range msgs {
+		if msg == nil {
+			return nil, fmt.Errorf("empty message")
+		}
+		msgTypeURLs = append(msgTypeURLs, msg.TypeUrl)
+		if msg.TypeUrl != msgExecTypeURL {
+			continue
+		}
+
+		if depth >= maxMsgExecDepth {
+			return nil, fmt.Errorf("MsgExec nesting exceeds maximum depth %d", maxMsgExecDepth)
+		}
+		var execMsg authz.MsgExec
+		if err := execMsg.Unmarshal(msg.Value); err != nil {
+			return nil, fmt.Errorf("invalid MsgExec: %w", err)
+		}
+		var err error
+		msgTypeURLs, err = appendMsgTypeURLs(msgTypeURLs, execMsg.Msgs, depth+1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return msgTypeURLs, nil
+}
+
+// TxMinGasPrices returns the minimum gas prices of a tx with messages of
+// msgTypeURLs and a gas limit of gas, from the global gas prices and the fee
+// overrides of the messages. The prices of an override raise the global ones
+// and its flat fee is spread over the gas limit. Only the denoms accepted by
+// the global prices and every override of the tx remain. Empty gas prices
+// accept any denom.
+func TxMinGasPrices(msgTypeFees []MsgTypeFee, gasPrices sdk.DecCoins, msgTypeURLs []string, gas uint64) (sdk.DecCoins, error) {
+	if len(msgTypeFees) == 0 {
+		return gasPrices, nil
+	}
+
+	overrides := make(map[string]MsgTypeFee, len(msgTypeFees))
+	for _, fee := range msgTypeFees {
+		overrides[fee.MsgTypeUrl] = fee
+	}
+
+	prices := gasPrices.Sort()
+	var flatFee sdk.DecCoins
+	hasFlatFee := false
+	for _, msgTypeURL := range msgTypeURLs {
+		override, ok := overrides[msgTypeURL]
+		if !ok {
+			continue
+		}
+		if len(override.MinimumGasPrices) > 0 {
+			prices = combineDenoms(prices, override.MinimumGasPrices, math.LegacyMaxDec)
+			if len(prices) == 0 {
+				return nil, fmt.Errorf("no fee denom is accepted by the gas prices of %s", msgTypeURL)
+			}
+		}
+		if len(override.FlatFee) > 0 {
+			fee := sdk.NewDecCoinsFromCoins(override.FlatFee...)
+			if hasFlatFee {
+				fee = combineDenoms(flatFee, fee, math.LegacyDec.Add)
+			}
+			flatFee, hasFlatFee = fee, true
+			if len(flatFee) == 0 {
+				return nil, fmt.Errorf("no fee denom is accepted by the flat fee of %s", msgTypeURL)
+			}
+		}
+	}
+
+	if !hasFlatFee {
+		return prices, nil
+	}
+	if gas == 0 {
+		return nil, fmt.Errorf("a flat fee requires a gas limit")
+	}
+
+	// price the flat fee per unit of gas in the accepted denoms
+	gasLimit := math.LegacyNewDecFromInt(math.NewIntFromUint64(gas))
+	if len(prices) > 0 {
+		flatFee = combineDenoms(prices, flatFee, func(price, fee math.LegacyDec) math.LegacyDec {
+			return fee
+		})
+		if len(flatFee) == 0 {
+			return nil, fmt.Errorf("no fee denom is accepted by both the gas prices and the flat fees")
+		}
+	}
+	result := make(sdk.DecCoins, 0, len(flatFee))
+	for _, fee := range flatFee {
+		price := prices.AmountOf(fee.Denom).Add(fee.Amount.Quo(gasLimit))
+		result = append(result, sdk.NewDecCoinFromDec(fee.Denom, price))
+	}
+
+	return result.Sort(), nil
+}
+
+// combineDenoms combines the amounts of the denoms in both a and b, empty a
+// accepts any denom.
+func combineDenoms(a, b sdk.DecCoins, combine func(x, y math.LegacyDec) math.LegacyDec) sdk.DecCoins {
+	if len(a) == 0 {
+		return b.Sort()
+	}
+
+	result := sdk.DecCoins{}
+	for _, coin := range b {
+		for _, other := range a {
+			if other.Denom == coin.Denom {
+				result = append(result, sdk.NewDecCoinFromDec(coin.Denom, combine(other.Amount, coin.Amount)))
+				break
+			}
+		}
+	}
+
+	return result.Sort()
+}
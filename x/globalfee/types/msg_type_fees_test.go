@@ -0,0 +1,142 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTxMinGasPrices(t *testing.T) {
+	const (
+		storeCode = "/cosmwasm.wasm.v1.MsgStoreCode"
+		claim     = "/xion.jwk.v1.MsgCreateAudienceClaim"
+		send      = "/cosmos.bank.v1beta1.MsgSend"
+	)
+	msgTypeFees := []MsgTypeFee{
+		{
+			MsgTypeUrl:       storeCode,
+			MinimumGasPrices: sdk.DecCoins{sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.5"))},
+		},
+		{
+			MsgTypeUrl: claim,
+			FlatFee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("uxion", 1000)),
+		},
+		{
+			MsgTypeUrl: "/other.v1.MsgFlat",
+			FlatFee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+		},
+	}
+	global := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.001")),
+		sdk.NewDecCoinFromDec("uxion", math.LegacyMustNewDecFromStr("0.025")),
+	)
+
+	tests := map[string]struct {
+		gasPrices sdk.DecCoins
+		msgs      []string
+		gas       uint64
+		expected  string
+		expectErr bool
+	}{
+		"no override keeps the global prices": {
+			gasPrices: global,
+			msgs:      []string{send},
+			gas:       100_000,
+			expected:  global.String(),
+		},
+		"gas prices raise the global prices in their denoms": {
+			gasPrices: global,
+			msgs:      []string{send, storeCode},
+			gas:       100_000,
+			expected:  "0.500000000000000000uxion",
+		},
+		"flat fees are spread over the gas limit": {
+			gasPrices: global,
+			msgs:      []string{claim, claim},
+			gas:       100_000,
+			expected:  "0.003000000000000000atom,0.045000000000000000uxion",
+		},
+		"gas prices and flat fees combine": {
+			gasPrices: global,
+			msgs:      []string{storeCode, claim},
+			gas:       100_000,
+			expected:  "0.510000000000000000uxion",
+		},
+		"empty global prices accept the override denoms": {
+			gasPrices: sdk.DecCoins{},
+			msgs:      []string{claim},
+			gas:       1_000,
+			expected:  "0.100000000000000000atom,1.000000000000000000uxion",
+		},
+		"flat fees without a common denom fail": {
+			gasPrices: global,
+			msgs:      []string{storeCode, "/other.v1.MsgFlat"},
+			gas:       100_000,
+			expectErr: true,
+		},
+		"flat fees without gas fail": {
+			gasPrices: global,
+			msgs:      []string{claim},
+			gas:       0,
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			prices, err := TxMinGasPrices(msgTypeFees, test.gasPrices, test.msgs, test.gas)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, prices.String())
+		})
+	}
+
+	// without overrides the prices are unchanged
+	prices, err := TxMinGasPrices(nil, global, []string{storeCode}, 1)
+	require.NoError(t, err)
+	require.Equal(t, global, prices)
+}
+
+func TestMsgTypeURLs(t *testing.T) {
+	grantee := sdk.AccAddress("grantee_address_____")
+	wrap := func(msg sdk.Msg) *authz.MsgExec {
+		execMsg := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+		return &execMsg
+	}
+	pack := func(msg sdk.Msg) *codectypes.Any {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		return anyMsg
+	}
+	send := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	exec := sdk.MsgTypeURL(&authz.MsgExec{})
+
+	// wrapped messages follow the messages of the tx
+	msgTypeURLs, err := MsgTypeURLs([]*codectypes.Any{pack(wrap(wrap(&banktypes.MsgSend{}))), pack(&banktypes.MsgMultiSend{})})
+	require.NoError(t, err)
+	require.Equal(t, []string{exec, exec, send, sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}, msgTypeURLs)
+
+	msgTypeURLs, err = ExecMsgTypeURLs(wrap(wrap(&banktypes.MsgSend{})))
+	require.NoError(t, err)
+	require.Equal(t, []string{exec, send}, msgTypeURLs)
+
+	// nesting is limited
+	var msg sdk.Msg = &banktypes.MsgSend{}
+	for i := 0; i < maxMsgExecDepth; i++ {
+		msg = wrap(msg)
+	}
+	_, err = MsgTypeURLs([]*codectypes.Any{pack(msg)})
+	require.NoError(t, err)
+	_, err = MsgTypeURLs([]*codectypes.Any{pack(wrap(msg))})
+	require.ErrorContains(t, err, "maximum depth")
+}
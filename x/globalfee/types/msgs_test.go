@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress([]byte("test_authority_addr_")).String()
+
+	require.NoError(t, NewMsgUpdateParams(authority, DefaultParams()).ValidateBasic())
+
+	err := NewMsgUpdateParams("bad-authority", DefaultParams()).ValidateBasic()
+	require.ErrorContains(t, err, "invalid authority address")
+
+	params := DefaultParams()
+	params.BypassMinFeeMsgTypes = []string{""}
+	err = NewMsgUpdateParams(authority, params).ValidateBasic()
+	require.ErrorContains(t, err, "invalid empty bypass msg type")
+}
+
+func TestMsgUpdateParams_GetSigners(t *testing.T) {
+	authority := sdk.AccAddress([]byte("test_authority_addr_"))
+	signers := NewMsgUpdateParams(authority.String(), DefaultParams()).GetSigners()
+	require.Equal(t, []sdk.AccAddress{authority}, signers)
+}
+
+func TestRegisterLegacyAminoCodec(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	RegisterLegacyAminoCodec(cdc)
+
+	msg := NewMsgUpdateParams(sdk.AccAddress([]byte("test_authority_addr_")).String(), DefaultParams())
+	bz, err := cdc.MarshalJSON(msg)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), ModuleName+"/MsgUpdateParams")
+
+	var decoded MsgUpdateParams
+	require.NoError(t, cdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, msg.Authority, decoded.Authority)
+}
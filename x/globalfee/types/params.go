@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	feegranttypes "cosmossdk.io/x/feegrant"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,10 +18,12 @@ import (
 )
 
 var (
-	// ParamStoreKeyMinGasPrices store key
+	// ParamStoreKeyMinGasPrices store key of the legacy x/params subspace
 	ParamStoreKeyMinGasPrices                    = []byte("MinimumGasPricesParam")
 	ParamStoreKeyBypassMinFeeMsgTypes            = []byte("BypassMinFeeMsgTypes")
 	ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage = []byte("MaxTotalBypassMinFeeMsgGasUsage")
+	ParamStoreKeyDynamicFee                      = []byte("DynamicFee")
+	ParamStoreKeyMsgTypeFees                     = []byte("MsgTypeFees")
 
 	// DefaultMinGasPrices is set at runtime to the staking token with zero amount i.e. "0uxion"
 	// see DefaultZeroGlobalFee method in xion/x/globalfee/ante/fee.go.
@@ -42,6 +45,10 @@ var (
 	// exceed maxTotalBypassMinFeeMsgGasUsage can be accepted with a zero fee.
 	// For details, see gaiafeeante.NewFeeDecorator()
 	DefaultmaxTotalBypassMinFeeMsgGasUsage uint64 = 1_000_000
+
+	// DefaultDynamicFeeMaxChangeRate is the largest change of the dynamic gas
+	// prices in a block, 12.5% as in EIP-1559.
+	DefaultDynamicFeeMaxChangeRate = math.LegacyNewDecWithPrec(125, 3)
 )
 
 // DefaultParams returns default parameters
@@ -50,9 +57,23 @@ func DefaultParams() Params {
 		MinimumGasPrices:                DefaultMinGasPrices,
 		BypassMinFeeMsgTypes:            DefaultBypassMinFeeMsgTypes,
 		MaxTotalBypassMinFeeMsgGasUsage: DefaultmaxTotalBypassMinFeeMsgGasUsage,
+		DynamicFee:                      DefaultDynamicFeeParams(),
+		MsgTypeFees:                     []MsgTypeFee{},
 	}
 }
 
+// DefaultDynamicFeeParams returns the default dynamic fee parameters, the
+// dynamic pricing is disabled.
+func DefaultDynamicFeeParams() DynamicFeeParams {
+	return DynamicFeeParams{
+		Enabled:          false,
+		MaxChangeRate:    DefaultDynamicFeeMaxChangeRate,
+		MaximumGasPrices: sdk.DecCoins{},
+	}
+}
+
+// ParamKeyTable returns the key table of the legacy x/params subspace, it is
+// only used by the store migrations.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
@@ -67,7 +88,80 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
-	return validateMaxTotalBypassMinFeeMsgGasUsage(p.MaxTotalBypassMinFeeMsgGasUsage)
+	if err := validateMaxTotalBypassMinFeeMsgGasUsage(p.MaxTotalBypassMinFeeMsgGasUsage); err != nil {
+		return err
+	}
+
+	if err := validateDynamicFee(p.DynamicFee); err != nil {
+		return err
+	}
+
+	if err := p.validateDynamicFeeBounds(); err != nil {
+		return err
+	}
+
+	if err := validateMsgTypeFees(p.MsgTypeFees); err != nil {
+		return err
+	}
+
+	return p.validateMsgTypeFeeDenoms()
+}
+
+// validateMsgTypeFeeDenoms checks that the fee overrides are not bypassed and
+// that their denoms are accepted by the minimum gas prices.
+func (p Params) validateMsgTypeFeeDenoms() error {
+	denoms := make(map[string]bool, len(p.MinimumGasPrices))
+	for _, price := range p.MinimumGasPrices {
+		denoms[price.Denom] = true
+	}
+
+	for _, fee := range p.MsgTypeFees {
+		for _, bypass := range p.BypassMinFeeMsgTypes {
+			if fee.MsgTypeUrl == bypass {
+				return fmt.Errorf("msg type %s has a fee and bypasses the min fee", fee.MsgTypeUrl)
+			}
+		}
+
+		// empty minimum gas prices accept any denom
+		if len(p.MinimumGasPrices) == 0 {
+			continue
+		}
+		for _, price := range fee.MinimumGasPrices {
+			if !denoms[price.Denom] {
+				return fmt.Errorf("msg type %s gas price denom %s is not a minimum gas price denom", fee.MsgTypeUrl, price.Denom)
+			}
+		}
+		for _, coin := range fee.FlatFee {
+			if !denoms[coin.Denom] {
+				return fmt.Errorf("msg type %s flat fee denom %s is not a minimum gas price denom", fee.MsgTypeUrl, coin.Denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateDynamicFeeBounds checks that the minimum gas prices, the floor of
+// the dynamic prices, are positive and below the ceiling.
+func (p Params) validateDynamicFeeBounds() error {
+	if !p.DynamicFee.Enabled {
+		return nil
+	}
+
+	if len(p.MinimumGasPrices) == 0 {
+		return fmt.Errorf("dynamic fee requires minimum gas prices")
+	}
+	for _, floor := range p.MinimumGasPrices {
+		if !floor.IsPositive() {
+			return fmt.Errorf("dynamic fee requires a positive minimum gas price for %s", floor.Denom)
+		}
+		ceiling := p.DynamicFee.MaximumGasPrices.AmountOf(floor.Denom)
+		if ceiling.LT(floor.Amount) {
+			return fmt.Errorf("maximum gas price %s%s is below the minimum gas price %s", ceiling, floor.Denom, floor)
+		}
+	}
+
+	return nil
 }
 
 // ParamSetPairs returns the parameter set pairs.
@@ -82,6 +176,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(
 			ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &p.MaxTotalBypassMinFeeMsgGasUsage, validateMaxTotalBypassMinFeeMsgGasUsage,
 		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyDynamicFee, &p.DynamicFee, validateDynamicFee,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMsgTypeFees, &p.MsgTypeFees, validateMsgTypeFees,
+		),
 	}
 }
 
@@ -126,6 +226,62 @@ func validateMaxTotalBypassMinFeeMsgGasUsage(i interface{}) error {
 	return nil
 }
 
+func validateDynamicFee(i interface{}) error {
+	v, ok := i.(DynamicFeeParams)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected DynamicFeeParams", i)
+	}
+
+	if err := DecCoins(v.MaximumGasPrices).Validate(); err != nil {
+		return errorsmod.Wrap(err, "maximum gas prices")
+	}
+
+	if !v.Enabled {
+		return nil
+	}
+
+	if v.TargetBlockGas == 0 {
+		return fmt.Errorf("dynamic fee target block gas must be positive")
+	}
+	if v.MaxChangeRate.IsNil() || !v.MaxChangeRate.IsPositive() || v.MaxChangeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("dynamic fee max change rate must be in (0, 1], got %s", v.MaxChangeRate)
+	}
+
+	return nil
+}
+
+// validateMsgTypeFees checks that each msg type has one fee override with
+// valid gas prices or flat fee
+func validateMsgTypeFees(i interface{}) error {
+	msgTypeFees, ok := i.([]MsgTypeFee)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []MsgTypeFee", i)
+	}
+
+	seen := make(map[string]bool, len(msgTypeFees))
+	for _, fee := range msgTypeFees {
+		if !strings.HasPrefix(fee.MsgTypeUrl, sdk.MsgTypeURL(nil)) || len(fee.MsgTypeUrl) == 1 {
+			return fmt.Errorf("invalid fee msg type name %q", fee.MsgTypeUrl)
+		}
+		if seen[fee.MsgTypeUrl] {
+			return fmt.Errorf("duplicate fee msg type %s", fee.MsgTypeUrl)
+		}
+		seen[fee.MsgTypeUrl] = true
+
+		if len(fee.MinimumGasPrices) == 0 && len(fee.FlatFee) == 0 {
+			return fmt.Errorf("msg type %s has neither gas prices nor a flat fee", fee.MsgTypeUrl)
+		}
+		if err := DecCoins(fee.MinimumGasPrices).Validate(); err != nil {
+			return errorsmod.Wrapf(err, "msg type %s gas prices", fee.MsgTypeUrl)
+		}
+		if err := fee.FlatFee.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "msg type %s flat fee", fee.MsgTypeUrl)
+		}
+	}
+
+	return nil
+}
+
 type DecCoins sdk.DecCoins
 
 // Validate checks that the DecCoins are sorted, have nonnegtive amount, with a valid and unique
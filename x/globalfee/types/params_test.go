@@ -158,16 +158,133 @@ func Test_validateMaxTotalBypassMinFeeMsgGasUsage(t *testing.T) {
 	}
 }
 
+func dynamicFeeParams(ceiling ...sdk.DecCoin) DynamicFeeParams {
+	return DynamicFeeParams{
+		Enabled:          true,
+		TargetBlockGas:   1_000_000,
+		MaxChangeRate:    DefaultDynamicFeeMaxChangeRate,
+		MaximumGasPrices: ceiling,
+	}
+}
+
+func Test_validateDynamicFee(t *testing.T) {
+	tests := map[string]struct {
+		params    interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().DynamicFee,
+			false,
+		},
+		"enabled, pass": {
+			dynamicFeeParams(sdk.NewDecCoin("uxion", math.OneInt())),
+			false,
+		},
+		"disabled without target, pass": {
+			DynamicFeeParams{MaxChangeRate: math.LegacyZeroDec()},
+			false,
+		},
+		"zero target, fail": {
+			DynamicFeeParams{Enabled: true, MaxChangeRate: DefaultDynamicFeeMaxChangeRate},
+			true,
+		},
+		"zero max change rate, fail": {
+			DynamicFeeParams{Enabled: true, TargetBlockGas: 1, MaxChangeRate: math.LegacyZeroDec()},
+			true,
+		},
+		"max change rate above one, fail": {
+			DynamicFeeParams{Enabled: true, TargetBlockGas: 1, MaxChangeRate: math.LegacyNewDec(2)},
+			true,
+		},
+		"unsorted maximum gas prices, fail": {
+			dynamicFeeParams(sdk.NewDecCoin("uxion", math.OneInt()), sdk.NewDecCoin("atom", math.OneInt())),
+			true,
+		},
+		"invalid type, fail": {
+			"5",
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateDynamicFee(test.params)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateMsgTypeFees(t *testing.T) {
+	flatFee := sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000))
+	tests := map[string]struct {
+		fees      interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().MsgTypeFees,
+			false,
+		},
+		"gas prices and flat fee, pass": {
+			[]MsgTypeFee{{
+				MsgTypeUrl:       "/cosmwasm.wasm.v1.MsgStoreCode",
+				MinimumGasPrices: sdk.DecCoins{sdk.NewDecCoin("uxion", math.OneInt())},
+				FlatFee:          flatFee,
+			}},
+			false,
+		},
+		"invalid msg type, fail": {
+			[]MsgTypeFee{{MsgTypeUrl: "MsgStoreCode", FlatFee: flatFee}},
+			true,
+		},
+		"duplicate msg type, fail": {
+			[]MsgTypeFee{
+				{MsgTypeUrl: "/cosmwasm.wasm.v1.MsgStoreCode", FlatFee: flatFee},
+				{MsgTypeUrl: "/cosmwasm.wasm.v1.MsgStoreCode", FlatFee: flatFee},
+			},
+			true,
+		},
+		"no fee, fail": {
+			[]MsgTypeFee{{MsgTypeUrl: "/cosmwasm.wasm.v1.MsgStoreCode"}},
+			true,
+		},
+		"zero flat fee, fail": {
+			[]MsgTypeFee{{MsgTypeUrl: "/cosmwasm.wasm.v1.MsgStoreCode", FlatFee: sdk.Coins{sdk.NewInt64Coin("uxion", 0)}}},
+			true,
+		},
+		"invalid type, fail": {
+			"5",
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateMsgTypeFees(test.fees)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestParamSetPairs(t *testing.T) {
 	params := DefaultParams()
 	pairs := params.ParamSetPairs()
-	require.Len(t, pairs, 3)
+	require.Len(t, pairs, 5)
 
 	// Check each param set pair
 	expectedKeys := [][]byte{
 		ParamStoreKeyMinGasPrices,
 		ParamStoreKeyBypassMinFeeMsgTypes,
 		ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage,
+		ParamStoreKeyDynamicFee,
+		ParamStoreKeyMsgTypeFees,
 	}
 
 	for i, pair := range pairs {
@@ -232,6 +349,71 @@ func TestValidateBasic(t *testing.T) {
 			},
 			false,
 		},
+		"dynamic fee, pass": {
+			Params{
+				MinimumGasPrices: sdk.DecCoins{sdk.NewDecCoin("uxion", math.OneInt())},
+				DynamicFee:       dynamicFeeParams(sdk.NewDecCoin("uxion", math.NewInt(10))),
+			},
+			false,
+		},
+		"dynamic fee without minimum gas prices, fail": {
+			Params{
+				MinimumGasPrices: sdk.DecCoins{},
+				DynamicFee:       dynamicFeeParams(sdk.NewDecCoin("uxion", math.NewInt(10))),
+			},
+			true,
+		},
+		"dynamic fee with zero minimum gas price, fail": {
+			Params{
+				MinimumGasPrices: sdk.DecCoins{sdk.NewDecCoin("uxion", math.ZeroInt())},
+				DynamicFee:       dynamicFeeParams(sdk.NewDecCoin("uxion", math.NewInt(10))),
+			},
+			true,
+		},
+		"dynamic fee ceiling below the floor, fail": {
+			Params{
+				MinimumGasPrices: sdk.DecCoins{sdk.NewDecCoin("uxion", math.NewInt(20))},
+				DynamicFee:       dynamicFeeParams(sdk.NewDecCoin("uxion", math.NewInt(10))),
+			},
+			true,
+		},
+		"msg type fee, pass": {
+			Params{
+				MinimumGasPrices: sdk.DecCoins{sdk.NewDecCoin("uxion", math.OneInt())},
+				MsgTypeFees: []MsgTypeFee{{
+					MsgTypeUrl: "/cosmwasm.wasm.v1.MsgStoreCode",
+					FlatFee:    sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000)),
+				}},
+			},
+			false,
+		},
+		"msg type fee of a bypass msg type, fail": {
+			Params{
+				BypassMinFeeMsgTypes: []string{"/cosmwasm.wasm.v1.MsgStoreCode"},
+				MsgTypeFees: []MsgTypeFee{{
+					MsgTypeUrl: "/cosmwasm.wasm.v1.MsgStoreCode",
+					FlatFee:    sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000)),
+				}},
+			},
+			true,
+		},
+		"msg type fee in a denom without minimum gas price, fail": {
+			Params{
+				MinimumGasPrices: sdk.DecCoins{sdk.NewDecCoin("uxion", math.OneInt())},
+				MsgTypeFees: []MsgTypeFee{{
+					MsgTypeUrl:       "/cosmwasm.wasm.v1.MsgStoreCode",
+					MinimumGasPrices: sdk.DecCoins{sdk.NewDecCoin("atom", math.OneInt())},
+				}},
+			},
+			true,
+		},
+		"dynamic fee without a ceiling for a denom, fail": {
+			Params{
+				MinimumGasPrices: sdk.DecCoins{sdk.NewDecCoin("uxion", math.OneInt())},
+				DynamicFee:       dynamicFeeParams(sdk.NewDecCoin("atom", math.NewInt(10))),
+			},
+			true,
+		},
 	}
 
 	for name, test := range tests {
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -114,33 +116,265 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryDynamicFeeRequest is the request type for the Query/DynamicFee RPC
+// method.
+type QueryDynamicFeeRequest struct {
+}
+
+func (m *QueryDynamicFeeRequest) Reset()         { *m = QueryDynamicFeeRequest{} }
+func (m *QueryDynamicFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDynamicFeeRequest) ProtoMessage()    {}
+func (*QueryDynamicFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_203903f37d385498, []int{2}
+}
+func (m *QueryDynamicFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDynamicFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDynamicFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDynamicFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDynamicFeeRequest.Merge(m, src)
+}
+func (m *QueryDynamicFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDynamicFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDynamicFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDynamicFeeRequest proto.InternalMessageInfo
+
+// QueryDynamicFeeResponse is the response type for the Query/DynamicFee RPC
+// method.
+type QueryDynamicFeeResponse struct {
+	// enabled is true if the dynamic pricing is turned on
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// state is the current dynamic minimum gas prices, it is empty until the
+	// first block after the pricing is turned on
+	State DynamicFeeState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+}
+
+func (m *QueryDynamicFeeResponse) Reset()         { *m = QueryDynamicFeeResponse{} }
+func (m *QueryDynamicFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDynamicFeeResponse) ProtoMessage()    {}
+func (*QueryDynamicFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_203903f37d385498, []int{3}
+}
+func (m *QueryDynamicFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDynamicFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDynamicFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDynamicFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDynamicFeeResponse.Merge(m, src)
+}
+func (m *QueryDynamicFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDynamicFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDynamicFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDynamicFeeResponse proto.InternalMessageInfo
+
+func (m *QueryDynamicFeeResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *QueryDynamicFeeResponse) GetState() DynamicFeeState {
+	if m != nil {
+		return m.State
+	}
+	return DynamicFeeState{}
+}
+
+// QueryRequiredFeeRequest is the request type for the Query/RequiredFee RPC
+// method.
+type QueryRequiredFeeRequest struct {
+	// tx_bytes is the encoded transaction
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *QueryRequiredFeeRequest) Reset()         { *m = QueryRequiredFeeRequest{} }
+func (m *QueryRequiredFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequiredFeeRequest) ProtoMessage()    {}
+func (*QueryRequiredFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_203903f37d385498, []int{4}
+}
+func (m *QueryRequiredFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequiredFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequiredFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequiredFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequiredFeeRequest.Merge(m, src)
+}
+func (m *QueryRequiredFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequiredFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequiredFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequiredFeeRequest proto.InternalMessageInfo
+
+func (m *QueryRequiredFeeRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// QueryRequiredFeeResponse is the response type for the Query/RequiredFee RPC
+// method. The local minimum gas prices of the validators are not included.
+type QueryRequiredFeeResponse struct {
+	// gas_prices are the minimum gas prices of the transaction, including the
+	// flat fees of its messages
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices"`
+	// fees are the minimum fees for the gas limit of the transaction, paying
+	// one of them is enough
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// bypass is true if the transaction only contains bypass messages and is
+	// free of fees
+	Bypass bool `protobuf:"varint,3,opt,name=bypass,proto3" json:"bypass,omitempty"`
+}
+
+func (m *QueryRequiredFeeResponse) Reset()         { *m = QueryRequiredFeeResponse{} }
+func (m *QueryRequiredFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRequiredFeeResponse) ProtoMessage()    {}
+func (*QueryRequiredFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_203903f37d385498, []int{5}
+}
+func (m *QueryRequiredFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequiredFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequiredFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequiredFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequiredFeeResponse.Merge(m, src)
+}
+func (m *QueryRequiredFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequiredFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequiredFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequiredFeeResponse proto.InternalMessageInfo
+
+func (m *QueryRequiredFeeResponse) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+func (m *QueryRequiredFeeResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *QueryRequiredFeeResponse) GetBypass() bool {
+	if m != nil {
+		return m.Bypass
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "xion.globalfee.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "xion.globalfee.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryDynamicFeeRequest)(nil), "xion.globalfee.v1.QueryDynamicFeeRequest")
+	proto.RegisterType((*QueryDynamicFeeResponse)(nil), "xion.globalfee.v1.QueryDynamicFeeResponse")
+	proto.RegisterType((*QueryRequiredFeeRequest)(nil), "xion.globalfee.v1.QueryRequiredFeeRequest")
+	proto.RegisterType((*QueryRequiredFeeResponse)(nil), "xion.globalfee.v1.QueryRequiredFeeResponse")
 }
 
 func init() { proto.RegisterFile("xion/globalfee/v1/query.proto", fileDescriptor_203903f37d385498) }
 
 var fileDescriptor_203903f37d385498 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xad, 0xc8, 0xcc, 0xcf,
-	0xd3, 0x4f, 0xcf, 0xc9, 0x4f, 0x4a, 0xcc, 0x49, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x2c,
-	0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x49, 0xeb, 0xc1, 0xa5,
-	0xf5, 0xca, 0x0c, 0xa5, 0xe4, 0x31, 0x75, 0xa4, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0x43, 0xf4,
-	0x48, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x54, 0x54, 0x26, 0x3d, 0x3f,
-	0x3f, 0x3d, 0x27, 0x55, 0x3f, 0xb1, 0x20, 0x53, 0x3f, 0x31, 0x2f, 0x2f, 0xbf, 0x24, 0xb1, 0x24,
-	0x33, 0x3f, 0x0f, 0xaa, 0x47, 0x49, 0x84, 0x4b, 0x28, 0x10, 0x64, 0x6d, 0x40, 0x62, 0x51, 0x62,
-	0x6e, 0x71, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x92, 0x1f, 0x97, 0x30, 0x8a, 0x68, 0x71,
-	0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x39, 0x17, 0x5b, 0x01, 0x58, 0x44, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xdb, 0x48, 0x52, 0x0f, 0xc3, 0x95, 0x7a, 0x10, 0x2d, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33,
-	0x04, 0x41, 0x95, 0x1b, 0x35, 0x33, 0x72, 0xb1, 0x82, 0x0d, 0x14, 0xaa, 0xe2, 0x62, 0x83, 0xa8,
-	0x10, 0x52, 0xc5, 0xa2, 0x19, 0xd3, 0x29, 0x52, 0x6a, 0x84, 0x94, 0x41, 0xdc, 0xa6, 0xa4, 0xd8,
-	0x74, 0xf9, 0xc9, 0x64, 0x26, 0x69, 0x21, 0x49, 0x7d, 0xcc, 0x60, 0x82, 0xb8, 0xc2, 0xc9, 0xed,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x74, 0xd2, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x93, 0x4a, 0x8b, 0xf2, 0x4a, 0x74, 0x73, 0x12, 0x93, 0x8a,
-	0x21, 0x26, 0x55, 0x20, 0x99, 0x55, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x3a, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xec, 0x41, 0xfc, 0xc3, 0x01, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0x59, 0x68, 0x69, 0x1d, 0xbc, 0x38, 0x36, 0x75, 0xc1, 0xba, 0xd4, 0x8d, 0x36, 0x48,
+	0xed, 0x8e, 0x50, 0x13, 0x13, 0x0f, 0x1e, 0xb0, 0xe9, 0xd1, 0x54, 0xbc, 0x79, 0x21, 0xb3, 0xcb,
+	0x63, 0xdd, 0x08, 0x33, 0xdb, 0x9d, 0xa1, 0x01, 0x8f, 0x26, 0x9e, 0xbc, 0x18, 0xfd, 0x0e, 0x1e,
+	0xfc, 0x24, 0x3d, 0x36, 0xf1, 0xe2, 0x49, 0x0d, 0xf8, 0x41, 0xcc, 0xce, 0x0c, 0x82, 0x59, 0x9a,
+	0x72, 0x62, 0x67, 0xde, 0xfb, 0xbf, 0xff, 0x6f, 0xdf, 0x7b, 0x2c, 0xba, 0x33, 0x8a, 0x38, 0x23,
+	0x61, 0x9f, 0xfb, 0xb4, 0xdf, 0x03, 0x20, 0x67, 0x0d, 0x72, 0x3a, 0x84, 0x64, 0xec, 0xc5, 0x09,
+	0x97, 0x1c, 0xdf, 0x48, 0xc3, 0xde, 0xbf, 0xb0, 0x77, 0xd6, 0xa8, 0x54, 0xb3, 0x8a, 0x10, 0x18,
+	0x88, 0x48, 0x68, 0x4d, 0x65, 0x2b, 0xe4, 0x21, 0x57, 0x8f, 0x24, 0x7d, 0x32, 0xb7, 0x3b, 0x21,
+	0xe7, 0x61, 0x1f, 0x08, 0x8d, 0x23, 0x42, 0x19, 0xe3, 0x92, 0xca, 0x88, 0xb3, 0x99, 0xc6, 0x09,
+	0xb8, 0x18, 0x70, 0x41, 0x7c, 0x2a, 0xd2, 0x8a, 0x3e, 0x48, 0xda, 0x20, 0x01, 0x8f, 0x98, 0x8e,
+	0xbb, 0x5b, 0x08, 0xbf, 0x4c, 0xb1, 0x4e, 0x68, 0x42, 0x07, 0xa2, 0x0d, 0xa7, 0x43, 0x10, 0xd2,
+	0x7d, 0x81, 0x6e, 0xfe, 0x77, 0x2b, 0x62, 0xce, 0x04, 0xe0, 0x27, 0xa8, 0x18, 0xab, 0x1b, 0xdb,
+	0xda, 0xb5, 0x6a, 0xa5, 0x66, 0xd9, 0xcb, 0xbc, 0x85, 0xa7, 0x25, 0xad, 0xb5, 0xf3, 0x9f, 0xd5,
+	0x5c, 0xdb, 0xa4, 0xbb, 0x36, 0xda, 0x56, 0xf5, 0x8e, 0xc6, 0x8c, 0x0e, 0xa2, 0xe0, 0x18, 0x60,
+	0xe6, 0x24, 0xd0, 0xad, 0x4c, 0xc4, 0xb8, 0xd9, 0x68, 0x03, 0x18, 0xf5, 0xfb, 0xd0, 0x55, 0x76,
+	0x9b, 0xed, 0xd9, 0x11, 0x3f, 0x43, 0xeb, 0x42, 0x52, 0x09, 0x76, 0x5e, 0x61, 0xb8, 0x4b, 0x30,
+	0xe6, 0xf5, 0x5e, 0xa5, 0x99, 0x86, 0x47, 0xcb, 0xdc, 0xc7, 0xc6, 0x34, 0x85, 0x88, 0x12, 0xe8,
+	0xce, 0x79, 0x70, 0x19, 0x6d, 0xca, 0x51, 0xc7, 0x1f, 0x4b, 0xd0, 0x2f, 0x79, 0xbd, 0xbd, 0x21,
+	0x47, 0xad, 0xf4, 0xe8, 0x7e, 0xc8, 0x23, 0x3b, 0x2b, 0x33, 0xb0, 0x31, 0x42, 0x21, 0x15, 0x9d,
+	0x38, 0x89, 0x02, 0xa5, 0x2c, 0xd4, 0x4a, 0xcd, 0x1d, 0x4f, 0x37, 0xdf, 0x4b, 0x9b, 0xef, 0x99,
+	0xe6, 0x7b, 0x47, 0x10, 0x3c, 0xe7, 0x11, 0x6b, 0x1d, 0xa6, 0x44, 0xdf, 0x7e, 0x55, 0xf7, 0xc3,
+	0x48, 0xbe, 0x19, 0xfa, 0x5e, 0xc0, 0x07, 0xc4, 0x0c, 0x4b, 0xff, 0x1c, 0x88, 0xee, 0x5b, 0x22,
+	0xc7, 0x31, 0x88, 0x99, 0x46, 0xb4, 0xaf, 0x85, 0x54, 0x9c, 0x28, 0x0f, 0xdc, 0x41, 0x6b, 0x3d,
+	0x00, 0x61, 0xe7, 0x95, 0x57, 0x79, 0xa9, 0x97, 0x32, 0x7a, 0x64, 0x8c, 0x6a, 0x2b, 0x18, 0x69,
+	0x17, 0x55, 0x18, 0x6f, 0xa3, 0xa2, 0x3f, 0x8e, 0xa9, 0x10, 0x76, 0x41, 0xb5, 0xdf, 0x9c, 0x9a,
+	0x5f, 0x0b, 0x68, 0x5d, 0xf5, 0x01, 0xbf, 0x43, 0x45, 0x3d, 0x6e, 0x7c, 0x7f, 0xc9, 0x08, 0xb2,
+	0x7b, 0x55, 0xd9, 0xbb, 0x2a, 0x4d, 0x77, 0xd3, 0xbd, 0xfb, 0xfe, 0xfb, 0x9f, 0x2f, 0xf9, 0xdb,
+	0xb8, 0x4c, 0xb2, 0xff, 0x09, 0xbd, 0x52, 0xf8, 0xa3, 0x85, 0xd0, 0x7c, 0xc8, 0xf8, 0xc1, 0x65,
+	0x95, 0x33, 0x2b, 0x57, 0xa9, 0xaf, 0x92, 0x6a, 0x40, 0xf6, 0x14, 0xc8, 0x2e, 0x76, 0x96, 0x80,
+	0x74, 0x75, 0x7a, 0xa7, 0x07, 0x80, 0x3f, 0x5b, 0xa8, 0xb4, 0xb0, 0x16, 0xf8, 0x52, 0x8f, 0xec,
+	0xca, 0x55, 0xf6, 0x57, 0xca, 0x35, 0x40, 0x75, 0x05, 0x74, 0xef, 0xa9, 0x55, 0x77, 0xab, 0x4b,
+	0x98, 0x12, 0x23, 0x49, 0xa1, 0x5a, 0xc7, 0xe7, 0x13, 0xc7, 0xba, 0x98, 0x38, 0xd6, 0xef, 0x89,
+	0x63, 0x7d, 0x9a, 0x3a, 0xb9, 0x8b, 0xa9, 0x93, 0xfb, 0x31, 0x75, 0x72, 0xaf, 0x1f, 0x2e, 0xac,
+	0x82, 0x3f, 0x4c, 0x98, 0x3c, 0xe8, 0x53, 0x5f, 0xe8, 0x7a, 0xa3, 0x85, 0x8a, 0x6a, 0x29, 0xfc,
+	0xa2, 0xfa, 0x54, 0x1c, 0xfe, 0x1d, 0x00, 0xb5, 0xc3, 0x3e, 0x49, 0xd3, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -157,6 +391,11 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of the module
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DynamicFee queries the current dynamic minimum gas prices
+	DynamicFee(ctx context.Context, in *QueryDynamicFeeRequest, opts ...grpc.CallOption) (*QueryDynamicFeeResponse, error)
+	// RequiredFee computes the global minimum fee of a transaction, which does
+	// not need to be signed
+	RequiredFee(ctx context.Context, in *QueryRequiredFeeRequest, opts ...grpc.CallOption) (*QueryRequiredFeeResponse, error)
 }
 
 type queryClient struct {
@@ -176,10 +415,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) DynamicFee(ctx context.Context, in *QueryDynamicFeeRequest, opts ...grpc.CallOption) (*QueryDynamicFeeResponse, error) {
+	out := new(QueryDynamicFeeResponse)
+	err := c.cc.Invoke(ctx, "/xion.globalfee.v1.Query/DynamicFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RequiredFee(ctx context.Context, in *QueryRequiredFeeRequest, opts ...grpc.CallOption) (*QueryRequiredFeeResponse, error) {
+	out := new(QueryRequiredFeeResponse)
+	err := c.cc.Invoke(ctx, "/xion.globalfee.v1.Query/RequiredFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DynamicFee queries the current dynamic minimum gas prices
+	DynamicFee(context.Context, *QueryDynamicFeeRequest) (*QueryDynamicFeeResponse, error)
+	// RequiredFee computes the global minimum fee of a transaction, which does
+	// not need to be signed
+	RequiredFee(context.Context, *QueryRequiredFeeRequest) (*QueryRequiredFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -189,6 +451,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) DynamicFee(ctx context.Context, req *QueryDynamicFeeRequest) (*QueryDynamicFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamicFee not implemented")
+}
+func (*UnimplementedQueryServer) RequiredFee(ctx context.Context, req *QueryRequiredFeeRequest) (*QueryRequiredFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequiredFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -212,6 +480,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DynamicFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDynamicFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DynamicFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.globalfee.v1.Query/DynamicFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DynamicFee(ctx, req.(*QueryDynamicFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RequiredFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequiredFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequiredFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.globalfee.v1.Query/RequiredFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequiredFee(ctx, req.(*QueryRequiredFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "xion.globalfee.v1.Query",
@@ -221,6 +525,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "DynamicFee",
+			Handler:    _Query_DynamicFee_Handler,
+		},
+		{
+			MethodName: "RequiredFee",
+			Handler:    _Query_RequiredFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xion/globalfee/v1/query.proto",
@@ -282,6 +594,163 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDynamicFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDynamicFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequiredFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequiredFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequiredFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequiredFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequiredFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequiredFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bypass {
+		i--
+		if m.Bypass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -313,6 +782,66 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDynamicFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDynamicFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRequiredFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequiredFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Bypass {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -452,6 +981,381 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDynamicFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDynamicFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDynamicFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDynamicFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDynamicFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDynamicFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequiredFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequiredFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequiredFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequiredFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequiredFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequiredFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bypass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bypass = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
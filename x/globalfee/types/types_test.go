@@ -69,7 +69,7 @@ func TestGlobalFeeParams(t *testing.T) {
 	// Test ParamSetPairs
 	pairs := params.ParamSetPairs()
 	require.NotNil(t, pairs)
-	require.Len(t, pairs, 3) // MinimumGasPrices, BypassMinFeeMsgTypes, MaxTotalBypassMinFeeMsgGasUsage
+	require.Len(t, pairs, 5) // MinimumGasPrices, BypassMinFeeMsgTypes, MaxTotalBypassMinFeeMsgGasUsage, DynamicFee, MsgTypeFees
 }
 
 func TestGlobalFeeCodec(t *testing.T) {
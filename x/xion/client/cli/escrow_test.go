@@ -0,0 +1,52 @@
+package cli_test
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/burnt-labs/xion/x/xion/client/cli"
+)
+
+func (s *CLITestSuite) TestEscrowCommands_MetadataAndArgs() {
+	cases := []struct {
+		name       string
+		new        func() *cobra.Command
+		nArgs      int
+		txFlags    bool
+		extraFlags []string
+	}{
+		{"send-escrow", cli.NewSendEscrowCmd, 2, true, []string{"recipient", "secret-hash", "email-hash"}},
+		{"claim-escrow", cli.NewClaimEscrowCmd, 1, true, []string{"destination", "secret", "email-proof"}},
+		{"refund-escrow", cli.NewRefundEscrowCmd, 1, true, nil},
+		{"escrow", cli.CmdEscrow, 1, false, nil},
+		{"escrows-by-sender", cli.CmdEscrowsBySender, 1, false, []string{flags.FlagLimit}},
+		{"escrows-by-recipient", cli.CmdEscrowsByRecipient, 1, false, []string{flags.FlagLimit}},
+	}
+	for _, c := range cases {
+		s.Run(c.name, func() {
+			cmd := c.new()
+			s.Require().Equal(c.name, cmd.Name())
+			s.Require().NotNil(cmd.RunE)
+			if c.txFlags {
+				s.Require().NotNil(cmd.Flag(flags.FlagFrom))
+			} else {
+				s.Require().NotNil(cmd.Flag(flags.FlagHeight))
+			}
+			for _, f := range c.extraFlags {
+				s.Require().NotNil(cmd.Flag(f), f)
+			}
+
+			s.Require().NoError(cmd.Args(cmd, make([]string, c.nArgs)))
+			s.Require().Error(cmd.Args(cmd, make([]string, c.nArgs+1)))
+
+			helpCmd := c.new()
+			helpCmd.SetOut(io.Discard)
+			helpCmd.SetErr(io.Discard)
+			helpCmd.SetArgs([]string{"--help"})
+			s.Require().NoError(helpCmd.Execute())
+		})
+	}
+}
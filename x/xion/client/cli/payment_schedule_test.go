@@ -0,0 +1,53 @@
+package cli_test
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/burnt-labs/xion/x/xion/client/cli"
+)
+
+func (s *CLITestSuite) TestPaymentScheduleCommands_MetadataAndArgs() {
+	cases := []struct {
+		name       string
+		new        func() *cobra.Command
+		nArgs      int
+		txFlags    bool
+		extraFlags []string
+	}{
+		{"create-payment-schedule", cli.NewCreatePaymentScheduleCmd, 3, true, []string{"start-time", "end-time", "max-total"}},
+		{"cancel-payment-schedule", cli.NewCancelPaymentScheduleCmd, 1, true, nil},
+		{"pause-payment-schedule", cli.NewPausePaymentScheduleCmd, 1, true, nil},
+		{"resume-payment-schedule", cli.NewResumePaymentScheduleCmd, 1, true, nil},
+		{"payment-schedule", cli.CmdPaymentSchedule, 1, false, nil},
+		{"payment-schedules-by-sender", cli.CmdPaymentSchedulesBySender, 1, false, []string{flags.FlagLimit}},
+		{"payment-schedules-by-recipient", cli.CmdPaymentSchedulesByRecipient, 1, false, []string{flags.FlagLimit}},
+	}
+	for _, c := range cases {
+		s.Run(c.name, func() {
+			cmd := c.new()
+			s.Require().Equal(c.name, cmd.Name())
+			s.Require().NotNil(cmd.RunE)
+			if c.txFlags {
+				s.Require().NotNil(cmd.Flag(flags.FlagFrom))
+			} else {
+				s.Require().NotNil(cmd.Flag(flags.FlagHeight))
+			}
+			for _, f := range c.extraFlags {
+				s.Require().NotNil(cmd.Flag(f), f)
+			}
+
+			s.Require().NoError(cmd.Args(cmd, make([]string, c.nArgs)))
+			s.Require().Error(cmd.Args(cmd, make([]string, c.nArgs+1)))
+
+			helpCmd := c.new()
+			helpCmd.SetOut(io.Discard)
+			helpCmd.SetErr(io.Discard)
+			helpCmd.SetArgs([]string{"--help"})
+			s.Require().NoError(helpCmd.Execute())
+		})
+	}
+}
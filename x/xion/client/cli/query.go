@@ -23,8 +23,24 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdWebAuthNVerifyRegister())
 	cmd.AddCommand(CmdWebAuthNVerifyAuthenticate())
+	cmd.AddCommand(CmdAttestationTrustAnchors())
+	cmd.AddCommand(CmdWebAuthNCredential())
+	cmd.AddCommand(CmdWebAuthNCredentialsByOwner())
+	cmd.AddCommand(CmdRelyingPartyProfile())
 	cmd.AddCommand(CmdPlatformPercentage())
 	cmd.AddCommand(CmdPlatformMinimum())
+	cmd.AddCommand(CmdPlatformFeeSplit())
+	cmd.AddCommand(CmdPlatformFeeEnforcement())
+	cmd.AddCommand(CmdEmissionsSchedule())
+	cmd.AddCommand(CmdProjectedSupply())
+	cmd.AddCommand(CmdMintTotals())
+	cmd.AddCommand(CmdMintEpochs())
+	cmd.AddCommand(CmdPaymentSchedule())
+	cmd.AddCommand(CmdPaymentSchedulesBySender())
+	cmd.AddCommand(CmdPaymentSchedulesByRecipient())
+	cmd.AddCommand(CmdEscrow())
+	cmd.AddCommand(CmdEscrowsBySender())
+	cmd.AddCommand(CmdEscrowsByRecipient())
 
 	// this line is used by starport scaffolding # 1
 
@@ -64,3 +64,59 @@ func CmdPlatformMinimum() *cobra.Command {
 
 	return cmd
 }
+
+func CmdPlatformFeeSplit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "platform-fee-split",
+		Short: "Get Platform Fee Split",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPlatformFeeSplitRequest{}
+
+			res, err := queryClient.PlatformFeeSplit(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPlatformFeeEnforcement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "platform-fee-enforcement",
+		Short: "Get Platform Fee Enforcement",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPlatformFeeEnforcementRequest{}
+
+			res, err := queryClient.PlatformFeeEnforcement(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -26,6 +26,8 @@ func (s *CLITestSuite) TestPlatformFeeCommands_MetadataFlagsHelpAndErrorPaths()
 	cases := []entry{
 		{"platform-percentage", cli.CmdPlatformPercentage, "platform-percentage", "Get Platform Percentage", []string{"--help"}, []string{}},
 		{"platform-minimum", cli.CmdPlatformMinimum, "platform-minimum", "Get Platform Minimum", []string{"--help"}, []string{}},
+		{"platform-fee-split", cli.CmdPlatformFeeSplit, "platform-fee-split", "Get Platform Fee Split", []string{"--help"}, []string{}},
+		{"platform-fee-enforcement", cli.CmdPlatformFeeEnforcement, "platform-fee-enforcement", "Get Platform Fee Enforcement", []string{"--help"}, []string{}},
 	}
 	for _, c := range cases {
 		s.Run(c.name, func() {
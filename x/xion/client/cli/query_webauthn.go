@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"encoding/base64"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -9,6 +11,15 @@ import (
 	"github.com/burnt-labs/xion/x/xion/types"
 )
 
+const (
+	// FlagRequireAttestation requires a trusted authenticator attestation
+	FlagRequireAttestation = "require-attestation"
+	// FlagRPProfile verifies against a contract's relying party profile
+	FlagRPProfile = "rp-profile"
+)
+
+const rpProfileFlagUsage = "Verify against the relying party profile registered for this contract; the rp argument must be empty"
+
 func CmdWebAuthNVerifyRegister() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "webauthn-register [addr] [challenge] [rp] [data]",
@@ -20,6 +31,16 @@ func CmdWebAuthNVerifyRegister() *cobra.Command {
 			reqRP := args[2]
 			reqData := []byte(args[3])
 
+			requireAttestation, err := cmd.Flags().GetBool(FlagRequireAttestation)
+			if err != nil {
+				return err
+			}
+
+			rpProfile, err := cmd.Flags().GetString(FlagRPProfile)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -28,10 +49,12 @@ func CmdWebAuthNVerifyRegister() *cobra.Command {
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryWebAuthNVerifyRegisterRequest{
-				Addr:      reqAddr,
-				Challenge: reqChallenge,
-				Rp:        reqRP,
-				Data:      reqData,
+				Addr:               reqAddr,
+				Challenge:          reqChallenge,
+				Rp:                 reqRP,
+				Data:               reqData,
+				RequireAttestation: requireAttestation,
+				RpProfile:          rpProfile,
 			}
 
 			res, err := queryClient.WebAuthNVerifyRegister(cmd.Context(), params)
@@ -43,6 +66,8 @@ func CmdWebAuthNVerifyRegister() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagRequireAttestation, false, "Require a packed, TPM or Apple attestation chaining to a governance trust anchor")
+	cmd.Flags().String(FlagRPProfile, "", rpProfileFlagUsage)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -60,6 +85,11 @@ func CmdWebAuthNVerifyAuthenticate() *cobra.Command {
 			reqCredential := []byte(args[3])
 			reqData := []byte(args[4])
 
+			rpProfile, err := cmd.Flags().GetString(FlagRPProfile)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -73,6 +103,7 @@ func CmdWebAuthNVerifyAuthenticate() *cobra.Command {
 				Rp:         reqRP,
 				Credential: reqCredential,
 				Data:       reqData,
+				RpProfile:  rpProfile,
 			}
 
 			res, err := queryClient.WebAuthNVerifyAuthenticate(cmd.Context(), params)
@@ -84,6 +115,131 @@ func CmdWebAuthNVerifyAuthenticate() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagRPProfile, "", rpProfileFlagUsage)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAttestationTrustAnchors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestation-trust-anchors",
+		Short: "Get the trusted WebAuthn authenticator models",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AttestationTrustAnchors(cmd.Context(), &types.QueryAttestationTrustAnchorsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWebAuthNCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webauthn-credential [owner] [credential-id]",
+		Short: "Get the tracked state of a WebAuthn credential, by base64url credential id",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			credentialID, err := base64.RawURLEncoding.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.WebAuthNCredential(cmd.Context(), &types.QueryWebAuthNCredentialRequest{
+				Owner:        args[0],
+				CredentialId: credentialID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWebAuthNCredentialsByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webauthn-credentials [owner]",
+		Short: "List the tracked WebAuthn credentials of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.WebAuthNCredentialsByOwner(cmd.Context(), &types.QueryWebAuthNCredentialsByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdRelyingPartyProfile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rp-profile [contract]",
+		Short: "Get the WebAuthn relying party profile registered for a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RelyingPartyProfile(cmd.Context(), &types.QueryRelyingPartyProfileRequest{Contract: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	signing2 "cosmossdk.io/x/tx/signing"
 
@@ -37,6 +38,7 @@ import (
 
 const (
 	FlagSplit           = "split"
+	FlagShowFee         = "show-fee"
 	signMode            = signing.SignMode_SIGN_MODE_DIRECT
 	flagSalt            = "salt"
 	flagFunds           = "funds"
@@ -88,6 +90,15 @@ func NewTxCmd() *cobra.Command {
 		NewEmitArbitraryDataCmd(),
 		NewUpdateConfigsCmd(),
 		NewUpdateParamsCmd(),
+		NewCreatePaymentScheduleCmd(),
+		NewCancelPaymentScheduleCmd(),
+		NewPausePaymentScheduleCmd(),
+		NewResumePaymentScheduleCmd(),
+		NewSendEscrowCmd(),
+		NewClaimEscrowCmd(),
+		NewRefundEscrowCmd(),
+		NewSetRelyingPartyProfileCmd(),
+		NewRemoveRelyingPartyProfileCmd(),
 	)
 
 	return txCmd
@@ -101,6 +112,8 @@ func NewSendTxCmd() *cobra.Command {
 		Long: `Send funds from one account to another.
 Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
 When using '--dry-run' a key name cannot be used, only a bech32 address.
+Using the '--show-fee' flag, the platform fee and the net amount received are
+queried and printed before the transaction is confirmed.
 `,
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -124,10 +137,15 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 
 			msg := types.NewMsgSend(clientCtx.GetFromAddress(), toAddr, coins)
 
+			if err := showPlatformFee(cmd, clientCtx, msg.FromAddress, []banktypes.Output{banktypes.NewOutput(toAddr, coins)}); err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagShowFee, false, "Query and print the platform fee before confirming the transaction")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -144,6 +162,8 @@ By default, sends the [amount] to each address of the list.
 Using the '--split' flag, the [amount] is split equally between the addresses.
 Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
 When using '--dry-run' a key name cannot be used, only a bech32 address.
+Using the '--show-fee' flag, the platform fee and the net amount received are
+queried and printed before the transaction is confirmed.
 `,
 		Args: cobra.MinimumNArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -198,11 +218,16 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 
 			msg := types.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(clientCtx.FromAddress, amount)}, output)
 
+			if err := showPlatformFee(cmd, clientCtx, clientCtx.FromAddress.String(), output); err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().Bool(FlagSplit, false, "Send the equally split token amount to each address")
+	cmd.Flags().Bool(FlagShowFee, false, "Query and print the platform fee before confirming the transaction")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -784,6 +809,36 @@ func NewUpdateParamsCmd() *cobra.Command {
 	return cmd
 }
 
+// showPlatformFee prints the simulated platform fee of a send when the
+// --show-fee flag is set. Sends that would fail the platform minimums are
+// rejected before they are signed; otherwise the regular tx confirmation
+// prompt follows the printed breakdown.
+func showPlatformFee(cmd *cobra.Command, clientCtx client.Context, from string, outputs []banktypes.Output) error {
+	showFee, err := cmd.Flags().GetBool(FlagShowFee)
+	if err != nil || !showFee {
+		return err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.SimulateSend(cmd.Context(), &types.QuerySimulateSendRequest{
+		FromAddress: from,
+		Outputs:     outputs,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := clientCtx.PrintProto(res); err != nil {
+		return err
+	}
+
+	if !res.MinimumsMet {
+		return errorsmod.Wrap(types.ErrMinimumNotMet, res.FailureReason)
+	}
+
+	return nil
+}
+
 func getSignerOfTx(queryClient authtypes.QueryClient, address sdk.AccAddress) (*aatypes.AbstractAccount, error) {
 	res, err := queryClient.Account(context.Background(), &authtypes.QueryAccountRequest{Address: address.String()})
 	if err != nil {
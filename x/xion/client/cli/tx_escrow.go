@@ -0,0 +1,217 @@
+package cli
+
+import (
+	"encoding/hex"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+const (
+	flagRecipient   = "recipient"
+	flagSecretHash  = "secret-hash"
+	flagEmailHash   = "email-hash"
+	flagSecret      = "secret"
+	flagDestination = "destination"
+	flagEmailProof  = "email-proof"
+)
+
+// NewSendEscrowCmd returns a CLI command handler for creating a MsgSendEscrow transaction.
+func NewSendEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-escrow [amount] [timeout_blocks]",
+		Short: "Lock funds until they are claimed, refunding them after a timeout.",
+		Long: `Lock funds in escrow for a number of blocks. Exactly one of --recipient,
+--secret-hash and --email-hash must be set: the recipient can claim the escrow,
+anyone holding the secp256k1 secret key whose compressed public key has the hex
+SHA-256 hash --secret-hash, or anyone with a DKIM proof for the x/dkim email
+hash --email-hash. The platform fee is taken when the escrow is claimed;
+unclaimed escrows are refunded once they expire.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			timeout, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := cmd.Flags().GetString(flagRecipient)
+			if err != nil {
+				return err
+			}
+
+			secretHashHex, err := cmd.Flags().GetString(flagSecretHash)
+			if err != nil {
+				return err
+			}
+			secretHash, err := hex.DecodeString(secretHashHex)
+			if err != nil {
+				return err
+			}
+
+			emailHash, err := cmd.Flags().GetString(flagEmailHash)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSendEscrow{
+				Sender:        clientCtx.GetFromAddress().String(),
+				Amount:        amount,
+				Recipient:     recipient,
+				SecretHash:    secretHash,
+				TimeoutBlocks: timeout,
+				EmailHash:     emailHash,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRecipient, "", "The only address that can claim the escrow")
+	cmd.Flags().String(flagSecretHash, "", "Hex SHA-256 hash of the compressed public key of the secret key that claims the escrow")
+	cmd.Flags().String(flagEmailHash, "", "The x/dkim email hash whose DKIM proof claims the escrow")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimEscrowCmd returns a CLI command handler for creating a MsgClaimEscrow transaction.
+func NewClaimEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-escrow [id]",
+		Short: "Claim an escrow, less the platform fee.",
+		Long: `Claim an escrow. Escrows with a recipient are claimed by the recipient.
+Escrows with a secret hash are claimed with --secret, the hex secp256k1 secret
+key, which signs "claim escrow <id> to <destination>". Escrows with an email
+hash are claimed with --email-proof, a JSON file holding an EmailClaimProof
+whose tx bytes commit to the same command. The funds go to --destination, or
+to the claimer if it is not set.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			destination, err := cmd.Flags().GetString(flagDestination)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimEscrow{
+				Claimer:     clientCtx.GetFromAddress().String(),
+				Id:          id,
+				Destination: destination,
+			}
+
+			secretHex, err := cmd.Flags().GetString(flagSecret)
+			if err != nil {
+				return err
+			}
+			if secretHex != "" {
+				secretKey, err := hex.DecodeString(secretHex)
+				if err != nil {
+					return err
+				}
+				if destination == "" {
+					destination = msg.Claimer
+				}
+				proof, err := types.NewSecretClaimProof(secretKey, id, destination)
+				if err != nil {
+					return err
+				}
+				msg.SecretProof = &proof
+			}
+
+			proofFile, err := cmd.Flags().GetString(flagEmailProof)
+			if err != nil {
+				return err
+			}
+			if proofFile != "" {
+				bz, err := os.ReadFile(proofFile)
+				if err != nil {
+					return err
+				}
+				var proof types.EmailClaimProof
+				if err := clientCtx.Codec.UnmarshalJSON(bz, &proof); err != nil {
+					return err
+				}
+				msg.EmailProof = &proof
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDestination, "", "The address receiving the funds, the claimer if unset")
+	cmd.Flags().String(flagSecret, "", "Hex secp256k1 secret key that claims the escrow")
+	cmd.Flags().String(flagEmailProof, "", "JSON file with an email proof that claims the escrow")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRefundEscrowCmd returns a CLI command handler for creating a MsgRefundEscrow transaction.
+func NewRefundEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-escrow [id]",
+		Short: "Refund an expired escrow to its sender, or decline an escrow as its recipient.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRefundEscrow{
+				Signer: clientCtx.GetFromAddress().String(),
+				Id:     id,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
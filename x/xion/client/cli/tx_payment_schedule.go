@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+const (
+	flagStartTime = "start-time"
+	flagEndTime   = "end-time"
+	flagMaxTotal  = "max-total"
+)
+
+// NewCreatePaymentScheduleCmd returns a CLI command handler for creating a MsgCreatePaymentSchedule transaction.
+func NewCreatePaymentScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-payment-schedule [recipient] [amount] [period]",
+		Short: "Pay an amount to a recipient once every period.",
+		Long: `Pay an amount to a recipient once every period, e.g. "24h".
+The platform fee is taken from every payment as for 'send'.
+The first payment is made at --start-time (RFC 3339), or in the next block if
+it is not set. Payments stop after --end-time or once --max-total is paid.
+A payment that fails, e.g. for lack of funds, closes the schedule.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			period, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreatePaymentSchedule{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Recipient: args[0],
+				Amount:    amount,
+				Period:    period,
+			}
+
+			if msg.StartTime, err = parseTimeFlag(cmd, flagStartTime); err != nil {
+				return err
+			}
+			if msg.EndTime, err = parseTimeFlag(cmd, flagEndTime); err != nil {
+				return err
+			}
+
+			maxTotal, err := cmd.Flags().GetString(flagMaxTotal)
+			if err != nil {
+				return err
+			}
+			if maxTotal != "" {
+				if msg.MaxTotal, err = sdk.ParseCoinsNormalized(maxTotal); err != nil {
+					return err
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagStartTime, "", "Time of the first payment (RFC 3339)")
+	cmd.Flags().String(flagEndTime, "", "No payment is made after this time (RFC 3339)")
+	cmd.Flags().String(flagMaxTotal, "", "Maximum amount paid over the life of the schedule")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelPaymentScheduleCmd returns a CLI command handler for creating a MsgCancelPaymentSchedule transaction.
+func NewCancelPaymentScheduleCmd() *cobra.Command {
+	return newPaymentScheduleActionCmd("cancel-payment-schedule", "Cancel a payment schedule.",
+		func(sender string, id uint64) sdk.Msg {
+			return &types.MsgCancelPaymentSchedule{Sender: sender, Id: id}
+		})
+}
+
+// NewPausePaymentScheduleCmd returns a CLI command handler for creating a MsgPausePaymentSchedule transaction.
+func NewPausePaymentScheduleCmd() *cobra.Command {
+	return newPaymentScheduleActionCmd("pause-payment-schedule", "Pause a payment schedule.",
+		func(sender string, id uint64) sdk.Msg {
+			return &types.MsgPausePaymentSchedule{Sender: sender, Id: id}
+		})
+}
+
+// NewResumePaymentScheduleCmd returns a CLI command handler for creating a MsgResumePaymentSchedule transaction.
+func NewResumePaymentScheduleCmd() *cobra.Command {
+	return newPaymentScheduleActionCmd("resume-payment-schedule", "Resume a paused payment schedule, skipping missed payments.",
+		func(sender string, id uint64) sdk.Msg {
+			return &types.MsgResumePaymentSchedule{Sender: sender, Id: id}
+		})
+}
+
+func newPaymentScheduleActionCmd(use, short string, newMsg func(sender string, id uint64) sdk.Msg) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [id]",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := newMsg(clientCtx.GetFromAddress().String(), id)
+			if m, ok := msg.(sdk.HasValidateBasic); ok {
+				if err := m.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
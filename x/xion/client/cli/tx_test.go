@@ -1,6 +1,7 @@
 package cli_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -12,6 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/feegrant"
 
@@ -20,9 +23,11 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/burnt-labs/xion/x/xion/client/cli"
+	"github.com/burnt-labs/xion/x/xion/types"
 )
 
 func (s *CLITestSuite) TestSendTxCmd() {
@@ -97,6 +102,94 @@ func (s *CLITestSuite) TestSendTxCmd() {
 	}
 }
 
+func (s *CLITestSuite) TestSendTxCmd_ShowFee() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+	from, to := accounts[0].Address, accounts[1].Address
+
+	args := []string{
+		from.String(), to.String(), "100stake",
+		fmt.Sprintf("--%s=true", cli.FlagShowFee),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", math.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+	}
+
+	testCases := []struct {
+		name      string
+		response  types.QuerySimulateSendResponse
+		expectErr string
+	}{
+		{
+			"minimums met",
+			types.QuerySimulateSendResponse{
+				PlatformPercentage: 100,
+				Outputs: []types.SimulatedOutput{{
+					Address:     to.String(),
+					Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+					PlatformFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+					NetAmount:   sdk.NewCoins(sdk.NewInt64Coin("stake", 99)),
+				}},
+				TotalAmount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				TotalPlatformFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+				MinimumsMet:      true,
+			},
+			"",
+		},
+		{
+			"minimum not met",
+			types.QuerySimulateSendResponse{
+				PlatformPercentage: 100,
+				Outputs: []types.SimulatedOutput{{
+					Address:     to.String(),
+					Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+					PlatformFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+					NetAmount:   sdk.NewCoins(sdk.NewInt64Coin("stake", 99)),
+				}},
+				TotalAmount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				TotalPlatformFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+				FailedDenom:      "stake",
+				RequiredMinimum:  &sdk.Coin{Denom: "stake", Amount: math.NewInt(500)},
+				FailureReason:    "100stake is below the platform minimum of 500stake",
+			},
+			"100stake is below the platform minimum of 500stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bz, err := s.encCfg.Codec.Marshal(&tc.response)
+			s.Require().NoError(err)
+
+			out := &bytes.Buffer{}
+			clientCtx := s.baseCtx.
+				WithClient(clitestutil.NewMockCometRPC(abci.ResponseQuery{Value: bz})).
+				WithOutput(out)
+
+			cmd := cli.NewSendTxCmd()
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			cmd.SetContext(svrcmd.CreateExecuteContext(context.Background()))
+			cmd.SetArgs(args)
+			s.Require().NoError(client.SetCmdClientContextHandler(clientCtx, cmd))
+			err = cmd.Execute()
+
+			// The breakdown is printed before the tx is signed
+			s.Require().Contains(out.String(), `"platform_fee":[{"denom":"stake","amount":"1"}]`)
+			s.Require().Contains(out.String(), `"net_amount":[{"denom":"stake","amount":"99"}]`)
+
+			if tc.expectErr != "" {
+				s.Require().ErrorIs(err, types.ErrMinimumNotMet)
+				s.Require().ErrorContains(err, tc.expectErr)
+				s.Require().NotContains(out.String(), "txhash")
+			} else {
+				s.Require().NoError(err)
+				s.Require().Contains(out.String(), "txhash")
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestMultiSendTxCmd() {
 	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 3)
 
@@ -416,7 +509,7 @@ func (s *CLITestSuite) TestNewTxCmd() {
 
 	// Test that all expected subcommands are added
 	subcommands := cmd.Commands()
-	require.Len(s.T(), subcommands, 8) // Should have 8 subcommands
+	require.Len(s.T(), subcommands, 17) // Should have 17 subcommands
 
 	// Check first few commands exist (order may vary)
 	cmdNames := make([]string, len(subcommands))
@@ -426,6 +519,9 @@ func (s *CLITestSuite) TestNewTxCmd() {
 	require.Contains(s.T(), cmdNames, "send")
 	require.Contains(s.T(), cmdNames, "multi-send")
 	require.Contains(s.T(), cmdNames, "register")
+	require.Contains(s.T(), cmdNames, "create-payment-schedule")
+	require.Contains(s.T(), cmdNames, "send-escrow")
+	require.Contains(s.T(), cmdNames, "set-rp-profile")
 
 	// Test RunE validation
 	err := cmd.RunE(cmd, []string{})
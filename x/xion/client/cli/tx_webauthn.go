@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// NewSetRelyingPartyProfileCmd returns a CLI command handler for creating a
+// MsgSetRelyingPartyProfile transaction.
+func NewSetRelyingPartyProfileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rp-profile [profile-json-file]",
+		Short: "Register or replace the WebAuthn relying party profile of a contract.",
+		Long: `Register or replace the WebAuthn relying party profile of a contract from a
+JSON file, for example:
+
+{
+  "contract": "xion1...",
+  "rp_id": "example.com",
+  "origins": ["https://example.com", "https://app.example.com"],
+  "top_origins": [],
+  "top_origin_policy": "TOP_ORIGIN_POLICY_IGNORE",
+  "related_origin_policy": "RELATED_ORIGIN_POLICY_SAME_SITE"
+}
+
+The sender must be the contract or its admin.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var profile types.RelyingPartyProfile
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &profile); err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetRelyingPartyProfile{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Profile: profile,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveRelyingPartyProfileCmd returns a CLI command handler for creating a
+// MsgRemoveRelyingPartyProfile transaction.
+func NewRemoveRelyingPartyProfileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rp-profile [contract]",
+		Short: "Remove the WebAuthn relying party profile of a contract.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveRelyingPartyProfile{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"github.com/google/uuid"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// GetAttestationTrustAnchor returns the trust anchor for an AAGUID.
+func (k Keeper) GetAttestationTrustAnchor(ctx sdk.Context, aaguid uuid.UUID) (anchor types.AttestationTrustAnchor, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AttestationTrustAnchorKeyPrefix)
+	bz := store.Get(types.AttestationTrustAnchorKey(aaguid))
+	if bz == nil {
+		return anchor, false
+	}
+	k.cdc.MustUnmarshal(bz, &anchor)
+	return anchor, true
+}
+
+// IterateAttestationTrustAnchors calls cb for every trust anchor in AAGUID
+// order until cb returns true.
+func (k Keeper) IterateAttestationTrustAnchors(ctx sdk.Context, cb func(anchor types.AttestationTrustAnchor) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AttestationTrustAnchorKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var anchor types.AttestationTrustAnchor
+		k.cdc.MustUnmarshal(iterator.Value(), &anchor)
+		if cb(anchor) {
+			break
+		}
+	}
+}
+
+// GetAttestationTrustAnchors returns all trust anchors in AAGUID order.
+func (k Keeper) GetAttestationTrustAnchors(ctx sdk.Context) []types.AttestationTrustAnchor {
+	var anchors []types.AttestationTrustAnchor
+	k.IterateAttestationTrustAnchors(ctx, func(anchor types.AttestationTrustAnchor) bool {
+		anchors = append(anchors, anchor)
+		return false
+	})
+	return anchors
+}
+
+// OverwriteAttestationTrustAnchors replaces every stored trust anchor with
+// the given set, which must already be validated.
+func (k Keeper) OverwriteAttestationTrustAnchors(ctx sdk.Context, anchors []types.AttestationTrustAnchor) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AttestationTrustAnchorKeyPrefix)
+
+	var existing [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		existing = append(existing, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range existing {
+		store.Delete(key)
+	}
+
+	for _, anchor := range anchors {
+		aaguid, err := types.ParseAAGUID(anchor.Aaguid)
+		if err != nil {
+			return err
+		}
+		store.Set(types.AttestationTrustAnchorKey(aaguid), k.cdc.MustMarshal(&anchor))
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func setupAttestationTest(t *testing.T) (sdk.Context, types.MsgServer, *Keeper) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	keeper := Keeper{
+		cdc:       moduletestutil.MakeTestEncodingConfig().Codec,
+		storeKey:  key,
+		authority: testAuthorityConst,
+	}
+
+	return testCtx.Ctx.WithBlockTime(time.Now()), NewMsgServerImpl(keeper), &keeper
+}
+
+func testRootCertificate(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Attestation Root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return der
+}
+
+// noneAttestationRegistration builds a registration without an attestation
+// statement for the given authenticator.
+func noneAttestationRegistration(t *testing.T, aaguid uuid.UUID, challenge string) []byte {
+	credKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	credPubKey, err := webauthncbor.Marshal(map[int]interface{}{
+		1:  2,
+		3:  -7,
+		-1: 1,
+		-2: credKey.X.FillBytes(make([]byte, 32)),
+		-3: credKey.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(t, err)
+
+	credID := []byte("attested-cred-id")
+	authData := &bytes.Buffer{}
+	rpIDHash := sha256.Sum256([]byte("example.com"))
+	authData.Write(rpIDHash[:])
+	authData.WriteByte(0x41)
+	authData.Write([]byte{0, 0, 0, 0})
+	authData.Write(aaguid[:])
+	authData.Write([]byte{0x00, byte(len(credID))})
+	authData.Write(credID)
+	authData.Write(credPubKey)
+
+	attObj, err := webauthncbor.Marshal(map[string]interface{}{
+		"fmt":      "none",
+		"authData": authData.Bytes(),
+		"attStmt":  map[string]interface{}{},
+	})
+	require.NoError(t, err)
+
+	clientDataJSON, err := json.Marshal(map[string]string{
+		"type":      "webauthn.create",
+		"challenge": challenge,
+		"origin":    "https://example.com",
+	})
+	require.NoError(t, err)
+
+	body, err := json.Marshal(map[string]interface{}{
+		"id":    base64.RawURLEncoding.EncodeToString(credID),
+		"rawId": base64.RawURLEncoding.EncodeToString(credID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"attestationObject": base64.RawURLEncoding.EncodeToString(attObj),
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientDataJSON),
+		},
+	})
+	require.NoError(t, err)
+	return body
+}
+
+func TestMsgServer_SetAttestationTrustAnchors(t *testing.T) {
+	ctx, server, keeper := setupAttestationTest(t)
+
+	first := types.AttestationTrustAnchor{Aaguid: uuid.New().String(), RootCertificates: [][]byte{testRootCertificate(t)}}
+	second := types.AttestationTrustAnchor{Aaguid: uuid.New().String(), RootCertificates: [][]byte{testRootCertificate(t)}}
+
+	_, err := server.SetAttestationTrustAnchors(ctx, &types.MsgSetAttestationTrustAnchors{Authority: "wrong_authority", Anchors: []types.AttestationTrustAnchor{first}})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = server.SetAttestationTrustAnchors(ctx, &types.MsgSetAttestationTrustAnchors{
+		Authority: testAuthorityConst,
+		Anchors:   []types.AttestationTrustAnchor{{Aaguid: first.Aaguid}},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidTrustAnchor)
+
+	_, err = server.SetAttestationTrustAnchors(ctx, &types.MsgSetAttestationTrustAnchors{Authority: testAuthorityConst, Anchors: []types.AttestationTrustAnchor{first, second}})
+	require.NoError(t, err)
+	require.Len(t, keeper.GetAttestationTrustAnchors(ctx), 2)
+
+	// The set is replaced rather than merged
+	_, err = server.SetAttestationTrustAnchors(ctx, &types.MsgSetAttestationTrustAnchors{Authority: testAuthorityConst, Anchors: []types.AttestationTrustAnchor{second}})
+	require.NoError(t, err)
+
+	res, err := keeper.AttestationTrustAnchors(ctx, &types.QueryAttestationTrustAnchorsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.AttestationTrustAnchor{second}, res.Anchors)
+
+	_, found := keeper.GetAttestationTrustAnchor(ctx, uuid.MustParse(first.Aaguid))
+	require.False(t, found)
+	anchor, found := keeper.GetAttestationTrustAnchor(ctx, uuid.MustParse(second.Aaguid))
+	require.True(t, found)
+	require.Equal(t, second, anchor)
+}
+
+func TestWebAuthNVerifyRegister_RequireAttestation(t *testing.T) {
+	ctx, _, keeper := setupAttestationTest(t)
+	aaguid := uuid.New()
+	challenge := base64.RawURLEncoding.EncodeToString([]byte("attestation-challenge"))
+
+	req := &types.QueryWebAuthNVerifyRegisterRequest{
+		Addr:      "test_addr",
+		Challenge: challenge,
+		Rp:        "https://example.com",
+		Data:      noneAttestationRegistration(t, aaguid, challenge),
+	}
+
+	res, err := keeper.WebAuthNVerifyRegister(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, aaguid.String(), res.Aaguid)
+
+	req.RequireAttestation = true
+	_, err = keeper.WebAuthNVerifyRegister(ctx, req)
+	require.ErrorIs(t, err, types.ErrUntrustedAttestation)
+	require.ErrorContains(t, err, "no trust anchor")
+
+	require.NoError(t, keeper.OverwriteAttestationTrustAnchors(ctx, []types.AttestationTrustAnchor{
+		{Aaguid: aaguid.String(), RootCertificates: [][]byte{testRootCertificate(t)}},
+	}))
+	_, err = keeper.WebAuthNVerifyRegister(ctx, req)
+	require.ErrorIs(t, err, types.ErrUntrustedAttestation)
+	require.ErrorContains(t, err, "not accepted")
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// GetEmissionsSchedule returns the emissions schedule, which is empty until
+// governance sets one.
+func (k Keeper) GetEmissionsSchedule(ctx sdk.Context) types.EmissionsSchedule {
+	bz := ctx.KVStore(k.storeKey).Get(types.EmissionsScheduleKey)
+	if bz == nil {
+		return types.DefaultEmissionsSchedule()
+	}
+	var schedule types.EmissionsSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule
+}
+
+// OverwriteEmissionsSchedule stores the emissions schedule.
+func (k Keeper) OverwriteEmissionsSchedule(ctx sdk.Context, schedule types.EmissionsSchedule) {
+	ctx.KVStore(k.storeKey).Set(types.EmissionsScheduleKey, k.cdc.MustMarshal(&schedule))
+}
+
+// ScheduledEmissionsMintFn mints the block issuance of the emissions schedule
+// stored in xionKeeper, falling back to the staking inflation curve of
+// StakedInflationMintFn while the schedule has no segments. As with the
+// inflation curve, only the part of the issuance not covered by collected
+// fees is minted, never beyond the supply cap, and the schedule's burn
+// fraction of any fees collected beyond the issuance is burned. Either way
+// the amounts minted and burned are added to the mint totals and epochs.
+func ScheduledEmissionsMintFn(feeCollectorName string, ic minttypes.InflationCalculationFn, bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, stakingKeeper types.StakingKeeper, xionKeeper *Keeper) func(ctx sdk.Context, k *mintkeeper.Keeper) error {
+	inflationMint := stakedInflationMint(feeCollectorName, ic, bankKeeper, accountKeeper, stakingKeeper)
+
+	return func(ctx sdk.Context, k *mintkeeper.Keeper) error {
+		var (
+			result mintResult
+			err    error
+		)
+		if schedule := xionKeeper.GetEmissionsSchedule(ctx); schedule.Enabled() {
+			result, err = scheduledMint(ctx, k, schedule, feeCollectorName, bankKeeper, accountKeeper)
+		} else {
+			result, err = inflationMint(ctx, k)
+		}
+		if err != nil {
+			return err
+		}
+
+		xionKeeper.RecordMint(ctx, result.minted, result.burned, result.blocksPerYear)
+		return nil
+	}
+}
+
+func scheduledMint(ctx sdk.Context, k *mintkeeper.Keeper, schedule types.EmissionsSchedule, feeCollectorName string, bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper) (mintResult, error) {
+	defer telemetry.ModuleMeasureSince(minttypes.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	minter, err := k.Minter.Get(ctx)
+	if err != nil {
+		return mintResult{}, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return mintResult{}, err
+	}
+
+	collectedFeeCoin := bankKeeper.GetBalance(ctx, accountKeeper.GetModuleAccount(ctx, feeCollectorName).GetAddress(), params.MintDenom)
+	supply := bankKeeper.GetSupply(ctx, params.MintDenom)
+
+	// keep the minter reporting the scheduled rates
+	annualIssuance := schedule.AnnualIssuance(ctx.BlockHeight())
+	minter.AnnualProvisions = math.LegacyNewDecFromInt(annualIssuance)
+	minter.Inflation = math.LegacyZeroDec()
+	if supply.Amount.IsPositive() {
+		minter.Inflation = minter.AnnualProvisions.QuoInt(supply.Amount)
+	}
+	if err := k.Minter.Set(ctx, minter); err != nil {
+		return mintResult{}, err
+	}
+
+	neededCoin := sdk.NewCoin(params.MintDenom, schedule.BlockIssuance(ctx.BlockHeight(), params.BlocksPerYear))
+	mintedCoin := sdk.NewCoin(params.MintDenom, math.ZeroInt())
+	burnedCoin := sdk.NewCoin(params.MintDenom, math.ZeroInt())
+
+	if collectedFeeCoin.IsLT(neededCoin) {
+		// mint what the collected fees do not cover, up to the supply cap
+		mintedCoin.Amount = schedule.CapIssuance(supply.Amount, neededCoin.Amount.Sub(collectedFeeCoin.Amount))
+		if mintedCoin.IsPositive() {
+			mintedCoins := sdk.NewCoins(mintedCoin)
+			if err := k.MintCoins(ctx, mintedCoins); err != nil {
+				return mintResult{}, err
+			}
+			if err := k.AddCollectedFees(ctx, mintedCoins); err != nil {
+				return mintResult{}, err
+			}
+
+			if mintedCoin.Amount.IsInt64() {
+				defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
+			}
+		}
+	} else {
+		// burn the configured fraction of the fees beyond the issuance
+		burnedCoin.Amount = schedule.BurnAmount(collectedFeeCoin.Amount.Sub(neededCoin.Amount))
+		if burnedCoin.IsPositive() {
+			if err := bankKeeper.BurnCoins(ctx, feeCollectorName, sdk.NewCoins(burnedCoin)); err != nil {
+				return mintResult{}, err
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			minttypes.EventTypeMint,
+			sdk.NewAttribute(minttypes.AttributeKeyInflation, minter.Inflation.String()),
+			sdk.NewAttribute(minttypes.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
+			sdk.NewAttribute(types.AttributeKeyAnnualIssuance, annualIssuance.String()),
+			sdk.NewAttribute(types.AttributeKeySupplyCap, schedule.SupplyCap.String()),
+			sdk.NewAttribute(AttributeKeyNeededAmount, neededCoin.Amount.String()),
+			sdk.NewAttribute(AttributeKeyMintedAmount, mintedCoin.Amount.String()),
+			sdk.NewAttribute(AttributeKeyCollectedAmount, collectedFeeCoin.Amount.String()),
+			sdk.NewAttribute(AttributeKeyBurnedAmount, burnedCoin.Amount.String()),
+		),
+	)
+
+	return mintResult{blocksPerYear: params.BlocksPerYear, minted: mintedCoin.Amount, burned: burnedCoin.Amount}, nil
+}
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+const emissionsDenom = "uxion"
+
+// emissionsBank tracks module balances and the supply of the mint denom.
+type emissionsBank struct {
+	types.BankKeeper
+	balances map[string]math.Int
+	supply   math.Int
+}
+
+func (b *emissionsBank) balance(addr string) math.Int {
+	if amount, ok := b.balances[addr]; ok {
+		return amount
+	}
+	return math.ZeroInt()
+}
+
+func (b *emissionsBank) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.balance(addr.String()))
+}
+
+func (b *emissionsBank) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.supply)
+}
+
+func (b *emissionsBank) MintCoins(_ context.Context, module string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(module).String()
+	b.balances[addr] = b.balance(addr).Add(amt.AmountOf(emissionsDenom))
+	b.supply = b.supply.Add(amt.AmountOf(emissionsDenom))
+	return nil
+}
+
+func (b *emissionsBank) BurnCoins(_ context.Context, module string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(module).String()
+	b.balances[addr] = b.balance(addr).Sub(amt.AmountOf(emissionsDenom))
+	b.supply = b.supply.Sub(amt.AmountOf(emissionsDenom))
+	return nil
+}
+
+func (b *emissionsBank) SendCoinsFromModuleToModule(_ context.Context, from, to string, amt sdk.Coins) error {
+	fromAddr, toAddr := authtypes.NewModuleAddress(from).String(), authtypes.NewModuleAddress(to).String()
+	b.balances[fromAddr] = b.balance(fromAddr).Sub(amt.AmountOf(emissionsDenom))
+	b.balances[toAddr] = b.balance(toAddr).Add(amt.AmountOf(emissionsDenom))
+	return nil
+}
+
+func (b *emissionsBank) SendCoinsFromModuleToAccount(context.Context, string, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+func (b *emissionsBank) feeCollector() math.Int {
+	return b.balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName).String())
+}
+
+type emissionsAccounts struct{}
+
+func (emissionsAccounts) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI { return nil }
+
+func (emissionsAccounts) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (emissionsAccounts) GetModuleAccount(_ context.Context, name string) sdk.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(name)
+}
+
+func (emissionsAccounts) SetModuleAccount(context.Context, sdk.ModuleAccountI) {}
+
+type emissionsStaking struct{}
+
+func (emissionsStaking) StakingTokenSupply(context.Context) (math.Int, error) {
+	return math.NewInt(1_000_000), nil
+}
+
+func (emissionsStaking) TotalBondedTokens(context.Context) (math.Int, error) {
+	return math.NewInt(500_000), nil
+}
+
+func (emissionsStaking) BondedRatio(context.Context) (math.LegacyDec, error) {
+	return math.LegacyNewDecWithPrec(5, 1), nil
+}
+
+func setupEmissionsTest(t *testing.T) (sdk.Context, *Keeper, *mintkeeper.Keeper, *emissionsBank) {
+	keys := storetypes.NewKVStoreKeys(types.StoreKey, minttypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil).WithBlockHeight(1)
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	bank := &emissionsBank{balances: map[string]math.Int{}, supply: math.NewInt(1_000_000)}
+	mk := mintkeeper.NewKeeper(cdc, runtime.NewKVStoreService(keys[minttypes.StoreKey]), emissionsStaking{}, emissionsAccounts{}, bank, authtypes.FeeCollectorName, testAuthorityConst)
+	params := minttypes.DefaultParams()
+	params.MintDenom = emissionsDenom
+	params.BlocksPerYear = 100
+	require.NoError(t, mk.Params.Set(ctx, params))
+	require.NoError(t, mk.Minter.Set(ctx, minttypes.DefaultInitialMinter()))
+
+	k := &Keeper{
+		cdc:           cdc,
+		storeKey:      keys[types.StoreKey],
+		bankKeeper:    bank,
+		mintQuerier:   mintkeeper.NewQueryServerImpl(mk),
+		stakingKeeper: emissionsStaking{},
+		authority:     testAuthorityConst,
+	}
+	return ctx, k, &mk, bank
+}
+
+func testEmissionsSchedule() types.EmissionsSchedule {
+	return types.EmissionsSchedule{
+		Segments: []types.EmissionsSegment{
+			{StartHeight: 1, AnnualIssuance: math.NewInt(1_000_000)},
+			{StartHeight: 11, AnnualIssuance: math.NewInt(500_000)},
+		},
+		SupplyCap:    math.NewInt(1_100_000),
+		BurnFraction: math.LegacyNewDecWithPrec(5, 1),
+	}
+}
+
+func TestMsgServer_SetEmissionsSchedule(t *testing.T) {
+	ctx, k, _, _ := setupEmissionsTest(t)
+	server := NewMsgServerImpl(*k)
+	schedule := testEmissionsSchedule()
+
+	_, err := server.SetEmissionsSchedule(ctx, &types.MsgSetEmissionsSchedule{Authority: "wrong_authority", Schedule: schedule})
+	require.ErrorContains(t, err, "invalid authority")
+
+	invalid := testEmissionsSchedule()
+	invalid.Segments[1].StartHeight = 1
+	_, err = server.SetEmissionsSchedule(ctx, &types.MsgSetEmissionsSchedule{Authority: testAuthorityConst, Schedule: invalid})
+	require.ErrorIs(t, err, types.ErrInvalidEmissionsSchedule)
+
+	require.False(t, k.GetEmissionsSchedule(ctx).Enabled())
+	_, err = server.SetEmissionsSchedule(ctx, &types.MsgSetEmissionsSchedule{Authority: testAuthorityConst, Schedule: schedule})
+	require.NoError(t, err)
+
+	res, err := k.EmissionsSchedule(ctx, &types.QueryEmissionsScheduleRequest{})
+	require.NoError(t, err)
+	require.Equal(t, schedule, res.Schedule)
+}
+
+func TestScheduledEmissionsMintFn(t *testing.T) {
+	ctx, k, mk, bank := setupEmissionsTest(t)
+	k.OverwriteEmissionsSchedule(ctx, testEmissionsSchedule())
+	mintFn := ScheduledEmissionsMintFn(authtypes.FeeCollectorName, minttypes.DefaultInflationCalculationFn, bank, emissionsAccounts{}, emissionsStaking{}, k)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+
+	// Fees cover part of the 10000 block issuance, the rest is minted
+	bank.balances[feeCollector] = math.NewInt(4_000)
+	require.NoError(t, mintFn(ctx, mk))
+	require.Equal(t, math.NewInt(10_000), bank.feeCollector())
+	require.Equal(t, math.NewInt(1_006_000), bank.supply)
+
+	minter, err := mk.Minter.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(1_000_000), minter.AnnualProvisions)
+
+	// Half of the fees beyond the issuance are burned
+	bank.balances[feeCollector] = math.NewInt(20_000)
+	require.NoError(t, mintFn(ctx, mk))
+	require.Equal(t, math.NewInt(15_000), bank.feeCollector())
+	require.Equal(t, math.NewInt(1_001_000), bank.supply)
+
+	// Minting stops at the supply cap
+	bank.balances[feeCollector] = math.ZeroInt()
+	bank.supply = math.NewInt(1_095_000)
+	require.NoError(t, mintFn(ctx, mk))
+	require.Equal(t, math.NewInt(5_000), bank.feeCollector())
+	require.Equal(t, math.NewInt(1_100_000), bank.supply)
+	require.NoError(t, mintFn(ctx, mk))
+	require.Equal(t, math.NewInt(1_100_000), bank.supply)
+}
+
+func TestProjectedSupply(t *testing.T) {
+	ctx, k, _, bank := setupEmissionsTest(t)
+	ctx = ctx.WithBlockHeight(5)
+
+	// Without a schedule the projection follows the inflation curve
+	res, err := k.ProjectedSupply(ctx, &types.QueryProjectedSupplyRequest{Height: 20})
+	require.NoError(t, err)
+	params := minttypes.DefaultParams()
+	params.BlocksPerYear = 100
+	issuance := types.InflationIssuance(minttypes.DefaultInitialMinter(), params, math.LegacyNewDecWithPrec(5, 1), math.NewInt(500_000), 15)
+	require.True(t, issuance.IsPositive())
+	require.Equal(t, sdk.NewCoin(emissionsDenom, issuance), res.Issuance)
+	require.Equal(t, sdk.NewCoin(emissionsDenom, issuance.AddRaw(1_000_000)), res.ProjectedSupply)
+
+	schedule := testEmissionsSchedule()
+	schedule.SupplyCap = math.ZeroInt()
+	k.OverwriteEmissionsSchedule(ctx, schedule)
+
+	_, err = k.ProjectedSupply(ctx, &types.QueryProjectedSupplyRequest{Height: 4})
+	require.Error(t, err)
+
+	// Blocks 6 to 10 issue 10000 each, blocks 11 to 20 issue 5000 each
+	res, err = k.ProjectedSupply(ctx, &types.QueryProjectedSupplyRequest{Height: 20})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), res.CurrentHeight)
+	require.Equal(t, sdk.NewInt64Coin(emissionsDenom, 1_000_000), res.CurrentSupply)
+	require.Equal(t, sdk.NewInt64Coin(emissionsDenom, 100_000), res.Issuance)
+	require.Equal(t, sdk.NewInt64Coin(emissionsDenom, 1_100_000), res.ProjectedSupply)
+
+	bank.supply = math.NewInt(2_000_000)
+	schedule.SupplyCap = math.NewInt(2_050_000)
+	k.OverwriteEmissionsSchedule(ctx, schedule)
+	res, err = k.ProjectedSupply(ctx, &types.QueryProjectedSupplyRequest{Height: 20})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(emissionsDenom, 2_050_000), res.ProjectedSupply)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// escrowAddress returns the module account holding escrowed funds.
+func escrowAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+// GetNextEscrowID returns the id the next created escrow will use.
+func (k Keeper) GetNextEscrowID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextEscrowIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextEscrowID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextEscrowIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetEscrow returns the escrow with the given id.
+func (k Keeper) GetEscrow(ctx sdk.Context, id uint64) (escrow types.Escrow, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowKeyPrefix)
+	bz := store.Get(types.EscrowKey(id))
+	if bz == nil {
+		return escrow, false
+	}
+	k.cdc.MustUnmarshal(bz, &escrow)
+	return escrow, true
+}
+
+// SetEscrow stores an escrow along with its index and expiry queue entries.
+func (k Keeper) SetEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.EscrowKeyPrefix).Set(types.EscrowKey(escrow.Id), k.cdc.MustMarshal(&escrow))
+
+	sender := sdk.MustAccAddressFromBech32(escrow.Sender)
+	prefix.NewStore(store, types.EscrowBySenderKeyPrefix).Set(types.EscrowAccountIndexKey(sender, escrow.Id), []byte{})
+	if escrow.Recipient != "" {
+		recipient := sdk.MustAccAddressFromBech32(escrow.Recipient)
+		prefix.NewStore(store, types.EscrowByRecipientKeyPrefix).Set(types.EscrowAccountIndexKey(recipient, escrow.Id), []byte{})
+	}
+	prefix.NewStore(store, types.EscrowExpiryQueueKeyPrefix).Set(types.EscrowExpiryQueueKey(escrow.ExpirationHeight, escrow.Id), []byte{})
+}
+
+// removeEscrow deletes an escrow along with its index and expiry queue
+// entries.
+func (k Keeper) removeEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.EscrowKeyPrefix).Delete(types.EscrowKey(escrow.Id))
+
+	sender := sdk.MustAccAddressFromBech32(escrow.Sender)
+	prefix.NewStore(store, types.EscrowBySenderKeyPrefix).Delete(types.EscrowAccountIndexKey(sender, escrow.Id))
+	if escrow.Recipient != "" {
+		recipient := sdk.MustAccAddressFromBech32(escrow.Recipient)
+		prefix.NewStore(store, types.EscrowByRecipientKeyPrefix).Delete(types.EscrowAccountIndexKey(recipient, escrow.Id))
+	}
+	prefix.NewStore(store, types.EscrowExpiryQueueKeyPrefix).Delete(types.EscrowExpiryQueueKey(escrow.ExpirationHeight, escrow.Id))
+}
+
+// IterateEscrows calls cb for every escrow in id order until cb returns true.
+func (k Keeper) IterateEscrows(ctx sdk.Context, cb func(escrow types.Escrow) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow types.Escrow
+		k.cdc.MustUnmarshal(iterator.Value(), &escrow)
+		if cb(escrow) {
+			break
+		}
+	}
+}
+
+// expiredEscrowIDs returns the ids of up to limit escrows that expired before
+// height, earliest first.
+func (k Keeper) expiredEscrowIDs(ctx sdk.Context, height int64, limit int) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowExpiryQueueKeyPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height))) //nolint:gosec // heights are positive
+	defer iterator.Close()
+
+	var ids []uint64
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		ids = append(ids, types.ParseEscrowExpiryQueueKey(iterator.Key()))
+	}
+	return ids
+}
+
+// RefundExpiredEscrows returns expired escrows to their senders, up to
+// MaxEscrowRefundsPerBlock. It is called from BeginBlock.
+func (k Keeper) RefundExpiredEscrows(ctx sdk.Context) {
+	for _, id := range k.expiredEscrowIDs(ctx, ctx.BlockHeight(), types.MaxEscrowRefundsPerBlock) {
+		escrow, found := k.GetEscrow(ctx, id)
+		if !found {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.refundEscrow(cacheCtx, escrow, types.EscrowRefundReasonExpired); err != nil {
+			// leave the escrow for a MsgRefundEscrow, but stop retrying it
+			k.Logger(ctx).Error("escrow refund failed", "id", escrow.Id, "error", err)
+			prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowExpiryQueueKeyPrefix).Delete(types.EscrowExpiryQueueKey(escrow.ExpirationHeight, escrow.Id))
+			continue
+		}
+		write()
+	}
+}
+
+// refundEscrow returns an escrow to its sender without a platform fee.
+func (k Keeper) refundEscrow(ctx sdk.Context, escrow types.Escrow, reason string) error {
+	k.removeEscrow(ctx, escrow)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(escrow.Sender), escrow.Amount); err != nil {
+		return err
+	}
+
+	emitEscrowEvent(ctx, types.EventTypeEscrowRefunded, escrow,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	)
+	return nil
+}
+
+func emitEscrowEvent(ctx sdk.Context, eventType string, escrow types.Escrow, attrs ...sdk.Attribute) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(escrow.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, escrow.Sender),
+		}, attrs...)...,
+	))
+}
@@ -0,0 +1,303 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	dkimtypes "github.com/burnt-labs/xion/x/dkim/types"
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// Mock dkim keeper for testing
+type MockDkimKeeper struct {
+	mock.Mock
+}
+
+func (m *MockDkimKeeper) Authenticate(ctx context.Context, req *dkimtypes.QueryAuthenticateRequest) (*dkimtypes.AuthenticateResponse, error) {
+	args := m.Called(ctx, req)
+	if res := args.Get(0); res != nil {
+		return res.(*dkimtypes.AuthenticateResponse), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+var (
+	escrowSender    = sdk.AccAddress("escrow_sender_123456")
+	escrowRecipient = sdk.AccAddress("escrow_recipient_123")
+	escrowAmount    = sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000))
+)
+
+func setupEscrowTest(t *testing.T) (context.Context, types.MsgServer, *Keeper, *MockBankKeeper, *MockDkimKeeper) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeight(100)
+
+	mockBankKeeper := &MockBankKeeper{}
+	mockDkimKeeper := &MockDkimKeeper{}
+
+	keeper := Keeper{
+		cdc:        moduletestutil.MakeTestEncodingConfig().Codec,
+		storeKey:   key,
+		bankKeeper: mockBankKeeper,
+		dkimKeeper: mockDkimKeeper,
+	}
+	require.NoError(t, keeper.OverwritePlatformMinimum(ctx, sdk.NewCoins(sdk.NewInt64Coin("uxion", 100))))
+
+	mockBankKeeper.On("BlockedAddr", mock.Anything).Return(false)
+	mockBankKeeper.On("IsSendEnabledCoins", mock.Anything, mock.Anything).Return(nil)
+	mockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, escrowSender, types.ModuleName, escrowAmount).Return(nil)
+
+	return ctx, NewMsgServerImpl(keeper), &keeper, mockBankKeeper, mockDkimKeeper
+}
+
+func TestSendEscrow_Validation(t *testing.T) {
+	goCtx, server, _, _, _ := setupEscrowTest(t)
+
+	testCases := []struct {
+		name string
+		msg  *types.MsgSendEscrow
+	}{
+		{"no condition", &types.MsgSendEscrow{Sender: escrowSender.String(), Amount: escrowAmount, TimeoutBlocks: 10}},
+		{"both conditions", &types.MsgSendEscrow{Sender: escrowSender.String(), Amount: escrowAmount, TimeoutBlocks: 10, Recipient: escrowRecipient.String(), SecretHash: types.EscrowSecretHash([]byte("s"))}},
+		{"secret and email hash", &types.MsgSendEscrow{Sender: escrowSender.String(), Amount: escrowAmount, TimeoutBlocks: 10, SecretHash: types.EscrowSecretHash([]byte("s")), EmailHash: "12345678901234567890"}},
+		{"short secret hash", &types.MsgSendEscrow{Sender: escrowSender.String(), Amount: escrowAmount, TimeoutBlocks: 10, SecretHash: []byte("short")}},
+		{"zero timeout", &types.MsgSendEscrow{Sender: escrowSender.String(), Amount: escrowAmount, Recipient: escrowRecipient.String()}},
+		{"timeout too long", &types.MsgSendEscrow{Sender: escrowSender.String(), Amount: escrowAmount, Recipient: escrowRecipient.String(), TimeoutBlocks: types.MaxEscrowTimeoutBlocks + 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := server.SendEscrow(goCtx, tc.msg)
+			require.ErrorIs(t, err, types.ErrInvalidEscrow)
+		})
+	}
+
+	_, err := server.SendEscrow(goCtx, &types.MsgSendEscrow{
+		Sender: escrowSender.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uxion", 10)), Recipient: escrowRecipient.String(), TimeoutBlocks: 10,
+	})
+	require.ErrorIs(t, err, types.ErrMinimumNotMet)
+}
+
+func TestClaimEscrow_ByRecipient(t *testing.T) {
+	goCtx, server, keeper, mockBankKeeper, _ := setupEscrowTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	keeper.OverwritePlatformPercentage(ctx, 1000)
+
+	res, err := server.SendEscrow(goCtx, &types.MsgSendEscrow{
+		Sender: escrowSender.String(), Amount: escrowAmount, Recipient: escrowRecipient.String(), TimeoutBlocks: 10,
+	})
+	require.NoError(t, err)
+
+	escrow, found := keeper.GetEscrow(ctx, res.Id)
+	require.True(t, found)
+	require.Equal(t, int64(110), escrow.ExpirationHeight)
+
+	recipientRes, err := keeper.EscrowsByRecipient(goCtx, &types.QueryEscrowsByRecipientRequest{Recipient: escrowRecipient.String()})
+	require.NoError(t, err)
+	require.Len(t, recipientRes.Escrows, 1)
+
+	_, err = server.ClaimEscrow(goCtx, &types.MsgClaimEscrow{Claimer: escrowSender.String(), Id: res.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the platform fee is taken at claim time
+	mockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, escrowAddress(), authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("uxion", 100))).Return(nil).Once()
+	mockBankKeeper.On("SendCoins", mock.Anything, escrowAddress(), escrowRecipient, sdk.NewCoins(sdk.NewInt64Coin("uxion", 900))).Return(nil).Once()
+
+	_, err = server.ClaimEscrow(goCtx, &types.MsgClaimEscrow{Claimer: escrowRecipient.String(), Id: res.Id})
+	require.NoError(t, err)
+	mockBankKeeper.AssertExpectations(t)
+
+	_, found = keeper.GetEscrow(ctx, res.Id)
+	require.False(t, found)
+	require.Empty(t, keeper.expiredEscrowIDs(ctx, 1000, types.MaxEscrowRefundsPerBlock))
+
+	_, err = server.ClaimEscrow(goCtx, &types.MsgClaimEscrow{Claimer: escrowRecipient.String(), Id: res.Id})
+	require.ErrorIs(t, err, types.ErrEscrowNotFound)
+}
+
+func TestClaimEscrow_BySecret(t *testing.T) {
+	goCtx, server, keeper, mockBankKeeper, _ := setupEscrowTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	secretKey := secp256k1.GenPrivKey()
+	res, err := server.SendEscrow(goCtx, &types.MsgSendEscrow{
+		Sender: escrowSender.String(), Amount: escrowAmount, SecretHash: types.EscrowSecretHash(secretKey.PubKey().Bytes()), TimeoutBlocks: 10,
+	})
+	require.NoError(t, err)
+
+	claimer := sdk.AccAddress("escrow_claimer_12345")
+	_, err = server.ClaimEscrow(goCtx, &types.MsgClaimEscrow{Claimer: claimer.String(), Id: res.Id})
+	require.ErrorIs(t, err, types.ErrInvalidEscrowClaim)
+
+	wrongKey, err := types.NewSecretClaimProof(secp256k1.GenPrivKey().Key, res.Id, escrowRecipient.String())
+	require.NoError(t, err)
+	_, err = server.ClaimEscrow(goCtx, &types.MsgClaimEscrow{Claimer: claimer.String(), Id: res.Id, SecretProof: &wrongKey, Destination: escrowRecipient.String()})
+	require.ErrorIs(t, err, types.ErrInvalidEscrowClaim)
+
+	proof, err := types.NewSecretClaimProof(secretKey.Key, res.Id, escrowRecipient.String())
+	require.NoError(t, err)
+
+	// a proof seen in the mempool cannot be redirected to another destination
+	_, err = server.ClaimEscrow(goCtx, &types.MsgClaimEscrow{Claimer: claimer.String(), Id: res.Id, SecretProof: &proof})
+	require.ErrorIs(t, err, types.ErrInvalidEscrowClaim)
+
+	// expired escrows cannot be claimed
+	_, err = server.ClaimEscrow(ctx.WithBlockHeight(111), &types.MsgClaimEscrow{
+		Claimer: claimer.String(), Id: res.Id, SecretProof: &proof, Destination: escrowRecipient.String(),
+	})
+	require.ErrorIs(t, err, types.ErrEscrowExpired)
+
+	mockBankKeeper.On("SendCoins", mock.Anything, escrowAddress(), escrowRecipient, escrowAmount).Return(nil).Once()
+	_, err = server.ClaimEscrow(ctx.WithBlockHeight(110), &types.MsgClaimEscrow{
+		Claimer: claimer.String(), Id: res.Id, SecretProof: &proof, Destination: escrowRecipient.String(),
+	})
+	require.NoError(t, err)
+	mockBankKeeper.AssertExpectations(t)
+
+	_, found := keeper.GetEscrow(ctx, res.Id)
+	require.False(t, found)
+}
+
+func TestClaimEscrow_ByEmailProof(t *testing.T) {
+	goCtx, server, _, mockBankKeeper, mockDkimKeeper := setupEscrowTest(t)
+
+	emailHash := "12345678901234567890"
+	res, err := server.SendEscrow(goCtx, &types.MsgSendEscrow{
+		Sender: escrowSender.String(), Amount: escrowAmount, EmailHash: emailHash, TimeoutBlocks: 10,
+	})
+	require.NoError(t, err)
+
+	proof := &types.EmailClaimProof{EmailHash: emailHash, Proof: []byte("{}"), PublicInputs: []string{emailHash}, AllowedEmailHosts: []string{"example.com"}}
+
+	// the proof must commit to this claim and destination
+	mockDkimKeeper.On("Authenticate", mock.Anything, mock.MatchedBy(func(req *dkimtypes.QueryAuthenticateRequest) bool {
+		return string(req.TxBytes) == string(types.EscrowClaimCommand(res.Id, escrowRecipient.String())) && req.EmailHash == emailHash
+	})).Return(&dkimtypes.AuthenticateResponse{Verified: true}, nil)
+	mockDkimKeeper.On("Authenticate", mock.Anything, mock.Anything).Return(nil, errors.New("tx bytes do not match public inputs"))
+
+	// the email hash is public, so email escrows cannot be claimed without a
+	// DKIM proof
+	_, err = server.ClaimEscrow(goCtx, &types.MsgClaimEscrow{
+		Claimer: escrowSender.String(), Id: res.Id, SecretProof: &types.SecretClaimProof{PubKey: []byte(emailHash)},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidEscrowClaim)
+
+	_, err = server.ClaimEscrow(goCtx, &types.MsgClaimEscrow{
+		Claimer: escrowSender.String(), Id: res.Id, EmailProof: &types.EmailClaimProof{EmailHash: "other"},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidEscrowClaim)
+
+	_, err = server.ClaimEscrow(goCtx, &types.MsgClaimEscrow{Claimer: escrowSender.String(), Id: res.Id, EmailProof: proof})
+	require.ErrorIs(t, err, types.ErrInvalidEscrowClaim)
+
+	mockBankKeeper.On("SendCoins", mock.Anything, escrowAddress(), escrowRecipient, escrowAmount).Return(nil).Once()
+	_, err = server.ClaimEscrow(goCtx, &types.MsgClaimEscrow{Claimer: escrowRecipient.String(), Id: res.Id, EmailProof: proof})
+	require.NoError(t, err)
+	mockBankKeeper.AssertExpectations(t)
+}
+
+func TestRefundEscrow(t *testing.T) {
+	goCtx, server, keeper, mockBankKeeper, _ := setupEscrowTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res, err := server.SendEscrow(goCtx, &types.MsgSendEscrow{
+		Sender: escrowSender.String(), Amount: escrowAmount, Recipient: escrowRecipient.String(), TimeoutBlocks: 10,
+	})
+	require.NoError(t, err)
+
+	_, err = server.RefundEscrow(goCtx, &types.MsgRefundEscrow{Signer: escrowSender.String(), Id: res.Id})
+	require.ErrorIs(t, err, types.ErrEscrowNotExpired)
+
+	_, err = server.RefundEscrow(goCtx, &types.MsgRefundEscrow{Signer: sdk.AccAddress("someone_else_1234567").String(), Id: res.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the recipient can decline before expiry
+	mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, escrowSender, escrowAmount).Return(nil).Once()
+	_, err = server.RefundEscrow(goCtx, &types.MsgRefundEscrow{Signer: escrowRecipient.String(), Id: res.Id})
+	require.NoError(t, err)
+	mockBankKeeper.AssertExpectations(t)
+
+	_, found := keeper.GetEscrow(ctx, res.Id)
+	require.False(t, found)
+}
+
+func TestRefundExpiredEscrows(t *testing.T) {
+	goCtx, server, keeper, mockBankKeeper, _ := setupEscrowTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	first, err := server.SendEscrow(goCtx, &types.MsgSendEscrow{
+		Sender: escrowSender.String(), Amount: escrowAmount, Recipient: escrowRecipient.String(), TimeoutBlocks: 10,
+	})
+	require.NoError(t, err)
+	second, err := server.SendEscrow(goCtx, &types.MsgSendEscrow{
+		Sender: escrowSender.String(), Amount: escrowAmount, Recipient: escrowRecipient.String(), TimeoutBlocks: 20,
+	})
+	require.NoError(t, err)
+
+	// still claimable at the expiration height
+	keeper.RefundExpiredEscrows(ctx.WithBlockHeight(110))
+	_, found := keeper.GetEscrow(ctx, first.Id)
+	require.True(t, found)
+
+	mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, escrowSender, escrowAmount).Return(nil).Once()
+	refundCtx := ctx.WithBlockHeight(111).WithEventManager(sdk.NewEventManager())
+	keeper.RefundExpiredEscrows(refundCtx)
+	mockBankKeeper.AssertExpectations(t)
+
+	_, found = keeper.GetEscrow(ctx, first.Id)
+	require.False(t, found)
+	_, found = keeper.GetEscrow(ctx, second.Id)
+	require.True(t, found)
+
+	var refunded bool
+	for _, event := range refundCtx.EventManager().Events() {
+		if event.Type == types.EventTypeEscrowRefunded {
+			refunded = true
+		}
+	}
+	require.True(t, refunded)
+
+	// the sender can refund an expired escrow that was not refunded yet
+	mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, escrowSender, escrowAmount).Return(nil).Once()
+	_, err = server.RefundEscrow(ctx.WithBlockHeight(121), &types.MsgRefundEscrow{Signer: escrowSender.String(), Id: second.Id})
+	require.NoError(t, err)
+}
+
+func TestEscrowGenesisRoundTrip(t *testing.T) {
+	goCtx, server, keeper, _, _ := setupEscrowTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res, err := server.SendEscrow(goCtx, &types.MsgSendEscrow{
+		Sender: escrowSender.String(), Amount: escrowAmount, SecretHash: types.EscrowSecretHash([]byte("secret")), TimeoutBlocks: 10,
+	})
+	require.NoError(t, err)
+
+	exported := keeper.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Escrows, 1)
+	require.Equal(t, uint64(2), exported.NextEscrowId)
+
+	newCtx, _, newKeeper, _, _ := setupEscrowTest(t)
+	sdkCtx := sdk.UnwrapSDKContext(newCtx)
+	newKeeper.InitGenesis(sdkCtx, exported)
+
+	require.Equal(t, uint64(2), newKeeper.GetNextEscrowID(sdkCtx))
+	require.Equal(t, []uint64{res.Id}, newKeeper.expiredEscrowIDs(sdkCtx, 111, types.MaxEscrowRefundsPerBlock))
+
+	queryRes, err := newKeeper.Escrow(newCtx, &types.QueryEscrowRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, escrowAmount, queryRes.Escrow.Amount)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// IsPlatformFeeExempt returns true if sender is never charged the platform fee
+// on the enforced transfer paths: module accounts and the configured
+// exemption list.
+func (k Keeper) IsPlatformFeeExempt(ctx sdk.Context, sender sdk.AccAddress) (bool, error) {
+	enforcement, err := k.GetPlatformFeeEnforcement(ctx)
+	if err != nil {
+		return false, err
+	}
+	if enforcement.IsExempt(sender) {
+		return true, nil
+	}
+
+	if _, ok := k.accountKeeper.GetAccount(ctx, sender).(sdk.ModuleAccountI); ok {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// ChargePlatformFee moves the platform fee on amount from payer to the fee
+// collector and distributes it according to the configured split. The
+// charged fee is returned so callers can deduct it from what they forward.
+func (k Keeper) ChargePlatformFee(ctx sdk.Context, payer sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
+	percentage := k.GetPlatformPercentage(ctx)
+	if percentage.IsZero() || amount.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	platformCoins := getPlatformCoins(amount, percentage)
+	if platformCoins.IsZero() {
+		return platformCoins, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, platformCoins); err != nil {
+		return nil, err
+	}
+
+	if err := k.distributePlatformFees(ctx, platformCoins); err != nil {
+		return nil, err
+	}
+
+	return platformCoins, nil
+}
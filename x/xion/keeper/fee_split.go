@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// distributePlatformFees routes platform fees that have already been moved to
+// the fee collector according to the configured split. Whatever is not
+// assigned to a destination stays with the fee collector, preserving the
+// previous behavior when no split is configured.
+func (k Keeper) distributePlatformFees(ctx sdk.Context, platformCoins sdk.Coins) error {
+	if platformCoins.IsZero() {
+		return nil
+	}
+
+	split, err := k.GetPlatformFeeSplit(ctx)
+	if err != nil {
+		return err
+	}
+
+	communityPool, burn, treasury, remainder := split.Shares(platformCoins)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	if !communityPool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, feeCollector); err != nil {
+			return err
+		}
+		emitPlatformFeeEvent(ctx, types.PlatformFeeDestinationCommunityPool, "", communityPool)
+	}
+
+	if !burn.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, authtypes.FeeCollectorName, burn); err != nil {
+			return err
+		}
+		emitPlatformFeeEvent(ctx, types.PlatformFeeDestinationBurn, "", burn)
+	}
+
+	if !treasury.IsZero() {
+		treasuryAddr, err := sdk.AccAddressFromBech32(split.TreasuryAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, treasuryAddr, treasury); err != nil {
+			return err
+		}
+		emitPlatformFeeEvent(ctx, types.PlatformFeeDestinationTreasury, split.TreasuryAddress, treasury)
+	}
+
+	if !remainder.IsZero() {
+		emitPlatformFeeEvent(ctx, types.PlatformFeeDestinationFeeCollector, feeCollector.String(), remainder)
+	}
+
+	return nil
+}
+
+func emitPlatformFeeEvent(ctx sdk.Context, destination, recipient string, amount sdk.Coins) {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyDestination, destination),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	}
+	if recipient != "" {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, recipient))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypePlatformFee, attrs...))
+}
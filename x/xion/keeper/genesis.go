@@ -13,6 +13,45 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	if err != nil {
 		panic(err)
 	}
+	if err := k.OverwritePlatformFeeSplit(ctx, genState.PlatformFeeSplit); err != nil {
+		panic(err)
+	}
+	if err := k.OverwritePlatformFeeEnforcement(ctx, genState.PlatformFeeEnforcement); err != nil {
+		panic(err)
+	}
+	for _, schedule := range genState.PaymentSchedules {
+		k.SetPaymentSchedule(ctx, schedule)
+		if !schedule.Paused {
+			k.enqueuePaymentSchedule(ctx, schedule)
+		}
+	}
+	if genState.NextPaymentScheduleId != 0 {
+		k.setNextPaymentScheduleID(ctx, genState.NextPaymentScheduleId)
+	}
+	for _, escrow := range genState.Escrows {
+		k.SetEscrow(ctx, escrow)
+	}
+	if genState.NextEscrowId != 0 {
+		k.setNextEscrowID(ctx, genState.NextEscrowId)
+	}
+	if err := k.OverwriteAttestationTrustAnchors(ctx, genState.AttestationTrustAnchors); err != nil {
+		panic(err)
+	}
+	for _, state := range genState.WebauthnCredentials {
+		k.SetWebAuthNCredential(ctx, state)
+	}
+	for _, profile := range genState.RelyingPartyProfiles {
+		k.SetRelyingPartyProfile(ctx, profile)
+	}
+	if genState.EmissionsSchedule.Enabled() {
+		k.OverwriteEmissionsSchedule(ctx, genState.EmissionsSchedule)
+	}
+	if !genState.MintTotals.Minted.IsNil() {
+		k.SetMintTotals(ctx, genState.MintTotals)
+	}
+	for _, epoch := range genState.MintEpochs {
+		k.SetMintEpoch(ctx, epoch)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -23,6 +62,43 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+	platformFeeSplit, err := k.GetPlatformFeeSplit(ctx)
+	if err != nil {
+		panic(err)
+	}
+	platformFeeEnforcement, err := k.GetPlatformFeeEnforcement(ctx)
+	if err != nil {
+		panic(err)
+	}
 	rv := types.NewGenesisState(platformPercentage, platformMinimums)
+	rv.PlatformFeeSplit = platformFeeSplit
+	rv.PlatformFeeEnforcement = platformFeeEnforcement
+	k.IteratePaymentSchedules(ctx, func(schedule types.PaymentSchedule) bool {
+		rv.PaymentSchedules = append(rv.PaymentSchedules, schedule)
+		return false
+	})
+	rv.NextPaymentScheduleId = k.GetNextPaymentScheduleID(ctx)
+	k.IterateEscrows(ctx, func(escrow types.Escrow) bool {
+		rv.Escrows = append(rv.Escrows, escrow)
+		return false
+	})
+	rv.NextEscrowId = k.GetNextEscrowID(ctx)
+	rv.AttestationTrustAnchors = k.GetAttestationTrustAnchors(ctx)
+	k.IterateWebAuthNCredentials(ctx, func(state types.WebAuthNCredentialState) bool {
+		rv.WebauthnCredentials = append(rv.WebauthnCredentials, state)
+		return false
+	})
+	k.IterateRelyingPartyProfiles(ctx, func(profile types.RelyingPartyProfile) bool {
+		rv.RelyingPartyProfiles = append(rv.RelyingPartyProfiles, profile)
+		return false
+	})
+	rv.EmissionsSchedule = k.GetEmissionsSchedule(ctx)
+	if totals, found := k.GetMintTotals(ctx); found {
+		rv.MintTotals = totals
+	}
+	k.IterateMintEpochs(ctx, func(epoch types.MintEpoch) bool {
+		rv.MintEpochs = append(rv.MintEpochs, epoch)
+		return false
+	})
 	return rv
 }
@@ -7,7 +7,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/url"
 
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
@@ -16,6 +15,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/burnt-labs/xion/x/xion/types"
 )
@@ -40,7 +41,8 @@ func (k Keeper) WebAuthNVerifyRegister(ctx context.Context, request *types.Query
 		}
 	}()
 
-	rp, err := url.Parse(request.Rp)
+	sdkCtx := sdktypes.UnwrapSDKContext(ctx) // NOTE: verify this is the same for X nodes
+	profile, err := k.relyingParty(sdkCtx, request.Rp, request.RpProfile)
 	if err != nil {
 		return nil, err
 	}
@@ -54,8 +56,24 @@ func (k Keeper) WebAuthNVerifyRegister(ctx context.Context, request *types.Query
 		return nil, errorsmod.Wrap(types.ErrNoValidWebAuth, err.Error())
 	}
 
-	sdkCtx := sdktypes.UnwrapSDKContext(ctx) // NOTE: verify this is the same for X nodes
-	credential, err := types.VerifyRegistration(sdkCtx, rp, request.Addr, request.Challenge, data)
+	aaguid, err := types.CredentialAAGUID(data)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNoValidWebAuth, err.Error())
+	}
+
+	if request.RequireAttestation {
+		// The attestation statement must be checked before VerifyRegistration
+		// strips it from the parsed data
+		anchor, found := k.GetAttestationTrustAnchor(sdkCtx, aaguid)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrUntrustedAttestation, "no trust anchor for aaguid %s", aaguid)
+		}
+		if err := types.VerifyAttestation(data, anchor, sdkCtx.BlockTime()); err != nil {
+			return nil, err
+		}
+	}
+
+	credential, err := types.VerifyProfileRegistration(sdkCtx, profile, request.Addr, request.Challenge, data)
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrNoValidWebAuth, err.Error())
 	}
@@ -65,7 +83,7 @@ func (k Keeper) WebAuthNVerifyRegister(ctx context.Context, request *types.Query
 		return nil, err
 	}
 
-	return &types.QueryWebAuthNVerifyRegisterResponse{Credential: credentialBz}, nil
+	return &types.QueryWebAuthNVerifyRegisterResponse{Credential: credentialBz, Aaguid: aaguid.String()}, nil
 }
 
 // WebAuthNVerifyAuthenticate does NOT provide authentication or authorization!
@@ -90,7 +108,7 @@ func (k Keeper) WebAuthNVerifyAuthenticate(ctx context.Context, request *types.Q
 		}
 	}()
 
-	rp, err := url.Parse(request.Rp)
+	profile, err := k.relyingParty(sdkCtx, request.Rp, request.RpProfile)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +128,7 @@ func (k Keeper) WebAuthNVerifyAuthenticate(ctx context.Context, request *types.Q
 		return nil, err
 	}
 
-	_, err = types.VerifyAuthentication(sdkCtx, rp, request.Addr, request.Challenge, &credential, data)
+	_, err = types.VerifyAssertion(sdkCtx, profile, request.Addr, request.Challenge, &credential, data)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +136,12 @@ func (k Keeper) WebAuthNVerifyAuthenticate(ctx context.Context, request *types.Q
 	return &types.QueryWebAuthNVerifyAuthenticateResponse{}, nil
 }
 
+// AttestationTrustAnchors implements types.QueryServer.
+func (k Keeper) AttestationTrustAnchors(ctx context.Context, _ *types.QueryAttestationTrustAnchorsRequest) (*types.QueryAttestationTrustAnchorsResponse, error) {
+	sdkCtx := sdktypes.UnwrapSDKContext(ctx)
+	return &types.QueryAttestationTrustAnchorsResponse{Anchors: k.GetAttestationTrustAnchors(sdkCtx)}, nil
+}
+
 // PlatformPercentage implements types.QueryServer.
 func (k Keeper) PlatformPercentage(ctx context.Context, _ *types.QueryPlatformPercentageRequest) (*types.QueryPlatformPercentageResponse, error) {
 	sdkCtx := sdktypes.UnwrapSDKContext(ctx)
@@ -136,6 +160,91 @@ func (k Keeper) PlatformMinimum(ctx context.Context, _ *types.QueryPlatformMinim
 	return &types.QueryPlatformMinimumResponse{Minimums: coins}, nil
 }
 
+// PlatformFeeSplit implements types.QueryServer.
+func (k Keeper) PlatformFeeSplit(ctx context.Context, _ *types.QueryPlatformFeeSplitRequest) (*types.QueryPlatformFeeSplitResponse, error) {
+	sdkCtx := sdktypes.UnwrapSDKContext(ctx)
+	split, err := k.GetPlatformFeeSplit(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPlatformFeeSplitResponse{Split: split}, nil
+}
+
+// PlatformFeeEnforcement implements types.QueryServer.
+func (k Keeper) PlatformFeeEnforcement(ctx context.Context, _ *types.QueryPlatformFeeEnforcementRequest) (*types.QueryPlatformFeeEnforcementResponse, error) {
+	sdkCtx := sdktypes.UnwrapSDKContext(ctx)
+	enforcement, err := k.GetPlatformFeeEnforcement(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPlatformFeeEnforcementResponse{Enforcement: enforcement}, nil
+}
+
+// SimulateSend implements types.QueryServer. It mirrors the platform fee and
+// minimum handling of Send and MultiSend without moving any funds.
+func (k Keeper) SimulateSend(ctx context.Context, request *types.QuerySimulateSendRequest) (*types.QuerySimulateSendResponse, error) {
+	if request == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	if len(request.Outputs) == 0 {
+		return nil, banktypes.ErrNoOutputs
+	}
+
+	sdkCtx := sdktypes.UnwrapSDKContext(ctx)
+	percentage := k.GetPlatformPercentage(sdkCtx)
+	minimums, err := k.GetPlatformMinimums(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.QuerySimulateSendResponse{
+		PlatformPercentage: percentage.Uint64(),
+		TotalAmount:        sdktypes.NewCoins(),
+		TotalPlatformFee:   sdktypes.NewCoins(),
+	}
+
+	for _, out := range request.Outputs {
+		if _, err := sdktypes.AccAddressFromBech32(out.Address); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid output address %s: %s", out.Address, err)
+		}
+		if !out.Coins.IsValid() || !out.Coins.IsAllPositive() {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, out.Coins.String())
+		}
+
+		platformFee := sdktypes.NewCoins()
+		if !percentage.IsZero() {
+			platformFee = getPlatformCoins(out.Coins, percentage)
+		}
+		netAmount, wentNegative := out.Coins.SafeSub(platformFee...)
+		if wentNegative {
+			return nil, fmt.Errorf("unable to subtract %v from %v", platformFee, out.Coins)
+		}
+
+		response.Outputs = append(response.Outputs, types.SimulatedOutput{
+			Address:     out.Address,
+			Amount:      out.Coins,
+			PlatformFee: platformFee,
+			NetAmount:   netAmount,
+		})
+		response.TotalAmount = response.TotalAmount.Add(out.Coins...)
+		response.TotalPlatformFee = response.TotalPlatformFee.Add(platformFee...)
+	}
+
+	// Send checks the amount and MultiSend checks its single input, both of
+	// which equal the total of the outputs.
+	unmet := findUnmetMinimum(response.TotalAmount, minimums)
+	response.MinimumsMet = unmet == nil
+	if unmet != nil {
+		response.FailedDenom = unmet.denom
+		response.RequiredMinimum = unmet.required
+		response.FailureReason = unmet.reason
+	}
+
+	return response, nil
+}
+
 func validateCredentialCreation(body io.Reader) error {
 	var ccr protocol.CredentialCreationResponse
 
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func (k Keeper) EmissionsSchedule(goCtx context.Context, req *types.QueryEmissionsScheduleRequest) (*types.QueryEmissionsScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryEmissionsScheduleResponse{Schedule: k.GetEmissionsSchedule(sdk.UnwrapSDKContext(goCtx))}, nil
+}
+
+func (k Keeper) ProjectedSupply(goCtx context.Context, req *types.QueryProjectedSupplyRequest) (*types.QueryProjectedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Height < ctx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is before the current height %d", req.Height, ctx.BlockHeight())
+	}
+
+	params, err := k.mintQuerier.Params(goCtx, &minttypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	supply := k.bankKeeper.GetSupply(ctx, params.Params.MintDenom)
+
+	var issuance sdk.Coin
+	if schedule := k.GetEmissionsSchedule(ctx); schedule.Enabled() {
+		issuance = sdk.NewCoin(supply.Denom, schedule.Issuance(supply.Amount, ctx.BlockHeight(), req.Height, params.Params.BlocksPerYear))
+	} else {
+		// without a schedule the mint function follows the inflation curve
+		inflation, err := k.mintQuerier.Inflation(goCtx, &minttypes.QueryInflationRequest{})
+		if err != nil {
+			return nil, err
+		}
+		bondedRatio, err := k.stakingKeeper.BondedRatio(ctx)
+		if err != nil {
+			return nil, err
+		}
+		bondedTokens, err := k.stakingKeeper.TotalBondedTokens(ctx)
+		if err != nil {
+			return nil, err
+		}
+		minter := minttypes.Minter{Inflation: inflation.Inflation}
+		issuance = sdk.NewCoin(supply.Denom, types.InflationIssuance(minter, params.Params, bondedRatio, bondedTokens, req.Height-ctx.BlockHeight()))
+	}
+
+	return &types.QueryProjectedSupplyResponse{
+		CurrentHeight:   ctx.BlockHeight(),
+		CurrentSupply:   supply,
+		Issuance:        issuance,
+		ProjectedSupply: supply.Add(issuance),
+	}, nil
+}
+
+func (k Keeper) MintTotals(goCtx context.Context, req *types.QueryMintTotalsRequest) (*types.QueryMintTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	totals, found := k.GetMintTotals(ctx)
+	if !found {
+		totals = types.NewMintTotals(ctx.BlockHeight())
+	}
+
+	return &types.QueryMintTotalsResponse{Totals: totals}, nil
+}
+
+func (k Keeper) MintEpochs(goCtx context.Context, req *types.QueryMintEpochsRequest) (*types.QueryMintEpochsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Pagination != nil && req.Pagination.Limit > 100 {
+		return nil, status.Error(codes.ResourceExhausted, "requests mint epoch page size >100, too large")
+	}
+
+	// most recent epochs first
+	pageReq := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pageReq = *req.Pagination
+	}
+	pageReq.Reverse = true
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintEpochKeyPrefix)
+
+	var epochs []types.MintEpoch
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var epoch types.MintEpoch
+		if err := k.cdc.Unmarshal(value, &epoch); err != nil {
+			return err
+		}
+		epochs = append(epochs, epoch)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintEpochsResponse{Epochs: epochs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func (k Keeper) Escrow(goCtx context.Context, req *types.QueryEscrowRequest) (*types.QueryEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	escrow, found := k.GetEscrow(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryEscrowResponse{Escrow: escrow}, nil
+}
+
+func (k Keeper) EscrowsBySender(goCtx context.Context, req *types.QueryEscrowsBySenderRequest) (*types.QueryEscrowsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	escrows, pageRes, err := k.paginateEscrows(sdk.UnwrapSDKContext(goCtx), types.EscrowBySenderKeyPrefix, req.Sender, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowsBySenderResponse{Escrows: escrows, Pagination: pageRes}, nil
+}
+
+func (k Keeper) EscrowsByRecipient(goCtx context.Context, req *types.QueryEscrowsByRecipientRequest) (*types.QueryEscrowsByRecipientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	escrows, pageRes, err := k.paginateEscrows(sdk.UnwrapSDKContext(goCtx), types.EscrowByRecipientKeyPrefix, req.Recipient, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowsByRecipientResponse{Escrows: escrows, Pagination: pageRes}, nil
+}
+
+// paginateEscrows pages through the escrows of an account in the sender or
+// recipient index.
+func (k Keeper) paginateEscrows(ctx sdk.Context, indexPrefix []byte, account string, pageReq *query.PageRequest) ([]types.Escrow, *query.PageResponse, error) {
+	if pageReq != nil && pageReq.Limit > 100 {
+		return nil, nil, status.Error(codes.ResourceExhausted, "requests escrow page size >100, too large")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	indexStore := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix), types.EscrowAccountIndexPrefix(addr))
+
+	var escrows []types.Escrow
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key []byte, _ []byte) error {
+		escrow, found := k.GetEscrow(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return status.Errorf(codes.Internal, "escrow %d is indexed but not stored", sdk.BigEndianToUint64(key))
+		}
+		escrows = append(escrows, escrow)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return escrows, pageRes, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func (k Keeper) PaymentSchedule(goCtx context.Context, req *types.QueryPaymentScheduleRequest) (*types.QueryPaymentScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	schedule, found := k.GetPaymentSchedule(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryPaymentScheduleResponse{Schedule: schedule}, nil
+}
+
+func (k Keeper) PaymentSchedulesBySender(goCtx context.Context, req *types.QueryPaymentSchedulesBySenderRequest) (*types.QueryPaymentSchedulesBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	schedules, pageRes, err := k.paginatePaymentSchedules(sdk.UnwrapSDKContext(goCtx), types.PaymentScheduleBySenderKeyPrefix, req.Sender, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPaymentSchedulesBySenderResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PaymentSchedulesByRecipient(goCtx context.Context, req *types.QueryPaymentSchedulesByRecipientRequest) (*types.QueryPaymentSchedulesByRecipientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	schedules, pageRes, err := k.paginatePaymentSchedules(sdk.UnwrapSDKContext(goCtx), types.PaymentScheduleByRecipientKeyPrefix, req.Recipient, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPaymentSchedulesByRecipientResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+// paginatePaymentSchedules pages through the schedules of an account in the
+// sender or recipient index.
+func (k Keeper) paginatePaymentSchedules(ctx sdk.Context, indexPrefix []byte, account string, pageReq *query.PageRequest) ([]types.PaymentSchedule, *query.PageResponse, error) {
+	if pageReq != nil && pageReq.Limit > 100 {
+		return nil, nil, status.Error(codes.ResourceExhausted, "requests payment schedule page size >100, too large")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	indexStore := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix), types.PaymentScheduleAccountIndexPrefix(addr))
+
+	var schedules []types.PaymentSchedule
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key []byte, _ []byte) error {
+		schedule, found := k.GetPaymentSchedule(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return status.Errorf(codes.Internal, "payment schedule %d is indexed but not stored", sdk.BigEndianToUint64(key))
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return schedules, pageRes, nil
+}
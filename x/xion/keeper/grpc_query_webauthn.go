@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func (k Keeper) WebAuthNCredential(goCtx context.Context, req *types.QueryWebAuthNCredentialRequest) (*types.QueryWebAuthNCredentialResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	state, found := k.GetWebAuthNCredential(sdk.UnwrapSDKContext(goCtx), owner, req.CredentialId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryWebAuthNCredentialResponse{State: state}, nil
+}
+
+func (k Keeper) WebAuthNCredentialsByOwner(goCtx context.Context, req *types.QueryWebAuthNCredentialsByOwnerRequest) (*types.QueryWebAuthNCredentialsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Pagination != nil && req.Pagination.Limit > 100 {
+		return nil, status.Error(codes.ResourceExhausted, "requests credential page size >100, too large")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.WebAuthNCredentialKeyPrefix), types.WebAuthNCredentialOwnerPrefix(owner))
+
+	var states []types.WebAuthNCredentialState
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var state types.WebAuthNCredentialState
+		if err := k.cdc.Unmarshal(value, &state); err != nil {
+			return err
+		}
+		states = append(states, state)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWebAuthNCredentialsByOwnerResponse{States: states, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RelyingPartyProfile(goCtx context.Context, req *types.QueryRelyingPartyProfileRequest) (*types.QueryRelyingPartyProfileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	profile, found := k.GetRelyingPartyProfile(sdk.UnwrapSDKContext(goCtx), contract)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryRelyingPartyProfileResponse{Profile: profile}, nil
+}
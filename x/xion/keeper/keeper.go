@@ -22,9 +22,13 @@ type Keeper struct {
 	paramSpace         paramtypes.Subspace
 	bankKeeper         types.BankKeeper
 	accountKeeper      types.AccountKeeper
+	distrKeeper        types.DistributionKeeper
+	dkimKeeper         types.DkimKeeper
 	ContractOpsKeeper  wasmtypes.ContractOpsKeeper
 	ContractViewKeeper wasmtypes.ViewKeeper
 	AAKeeper           types.AbstractAccountKeeper
+	mintQuerier        types.MintQuerier
+	stakingKeeper      types.StakingKeeper
 
 	// the address capable of executing a MsgSetPlatformPercentage message.
 	// Typically, this should be the x/gov module account
@@ -36,9 +40,13 @@ func NewKeeper(cdc codec.BinaryCodec,
 	paramSpace paramtypes.Subspace,
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
+	distrKeeper types.DistributionKeeper,
+	dkimKeeper types.DkimKeeper,
 	wasmOpsKeeper wasmtypes.ContractOpsKeeper,
 	wasmViewKeeper wasmtypes.ViewKeeper,
 	aaKeeper types.AbstractAccountKeeper,
+	mintQuerier types.MintQuerier,
+	stakingKeeper types.StakingKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
@@ -47,9 +55,13 @@ func NewKeeper(cdc codec.BinaryCodec,
 		paramSpace:         paramSpace,
 		bankKeeper:         bankKeeper,
 		accountKeeper:      accountKeeper,
+		distrKeeper:        distrKeeper,
+		dkimKeeper:         dkimKeeper,
 		ContractOpsKeeper:  wasmOpsKeeper,
 		ContractViewKeeper: wasmViewKeeper,
 		AAKeeper:           aaKeeper,
+		mintQuerier:        mintQuerier,
+		stakingKeeper:      stakingKeeper,
 		authority:          authority,
 	}
 }
@@ -90,6 +102,46 @@ func (k Keeper) OverwritePlatformMinimum(ctx sdktypes.Context, coins sdktypes.Co
 	return nil
 }
 
+// Platform Fee Split
+func (k Keeper) GetPlatformFeeSplit(ctx sdktypes.Context) (split types.PlatformFeeSplit, err error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PlatformFeeSplitKey)
+
+	if len(bz) != 0 {
+		err = json.Unmarshal(bz, &split)
+	}
+
+	return split, err
+}
+
+func (k Keeper) OverwritePlatformFeeSplit(ctx sdktypes.Context, split types.PlatformFeeSplit) error {
+	bz, err := json.Marshal(split)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.PlatformFeeSplitKey, bz)
+	return nil
+}
+
+// Platform Fee Enforcement
+func (k Keeper) GetPlatformFeeEnforcement(ctx sdktypes.Context) (enforcement types.PlatformFeeEnforcement, err error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PlatformFeeEnforcementKey)
+
+	if len(bz) != 0 {
+		err = json.Unmarshal(bz, &enforcement)
+	}
+
+	return enforcement, err
+}
+
+func (k Keeper) OverwritePlatformFeeEnforcement(ctx sdktypes.Context, enforcement types.PlatformFeeEnforcement) error {
+	bz, err := json.Marshal(enforcement)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.PlatformFeeEnforcementKey, bz)
+	return nil
+}
+
 // Authority
 
 // GetAuthority returns the x/xion module's authority.
@@ -12,6 +12,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/burnt-labs/xion/x/xion/types"
@@ -165,9 +166,13 @@ func TestNewKeeper(t *testing.T) {
 		paramStore,
 		nil, // bankKeeper
 		nil, // accountKeeper
+		nil, // distrKeeper
+		nil, // dkimKeeper
 		nil, // wasmOpsKeeper
 		nil, // wasmViewKeeper
 		nil, // aaKeeper
+		nil, // mintQuerier
+		nil, // stakingKeeper
 		testAuthority,
 	)
 
@@ -472,3 +477,83 @@ func TestKeeper_InitGenesis_ComplexScenarios(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, maxGenesis.PlatformMinimums.Equal(minimums))
 }
+
+func TestKeeper_SimulateSend_Query(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx
+
+	keeper := Keeper{
+		storeKey: key,
+	}
+
+	keeper.OverwritePlatformPercentage(ctx, 250) // 2.5%
+	require.NoError(t, keeper.OverwritePlatformMinimum(ctx, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(100)))))
+
+	fromAddr := sdk.AccAddress("from_address_12345678").String()
+	toAddr1 := sdk.AccAddress("to_address_123456789").String()
+	toAddr2 := sdk.AccAddress("to_address_987654321").String()
+
+	// single send: ceil(1001 * 250 / 10000) = 26
+	res, err := keeper.SimulateSend(ctx, &types.QuerySimulateSendRequest{
+		FromAddress: fromAddr,
+		Outputs: []banktypes.Output{
+			{Address: toAddr1, Coins: sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1001)))},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(250), res.PlatformPercentage)
+	require.True(t, res.MinimumsMet)
+	require.Len(t, res.Outputs, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(26))), res.Outputs[0].PlatformFee)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(975))), res.Outputs[0].NetAmount)
+
+	// multi send: fees are computed per output and totals are summed
+	res, err = keeper.SimulateSend(ctx, &types.QuerySimulateSendRequest{
+		FromAddress: fromAddr,
+		Outputs: []banktypes.Output{
+			{Address: toAddr1, Coins: sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(60)))},
+			{Address: toAddr2, Coins: sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(60)))},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, res.MinimumsMet)
+	require.Len(t, res.Outputs, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(120))), res.TotalAmount)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(4))), res.TotalPlatformFee)
+
+	// below the configured minimum
+	res, err = keeper.SimulateSend(ctx, &types.QuerySimulateSendRequest{
+		FromAddress: fromAddr,
+		Outputs: []banktypes.Output{
+			{Address: toAddr1, Coins: sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(99)))},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, res.MinimumsMet)
+	require.Equal(t, "uxion", res.FailedDenom)
+	require.NotNil(t, res.RequiredMinimum)
+	require.Equal(t, sdk.NewCoin("uxion", math.NewInt(100)), *res.RequiredMinimum)
+
+	// unlisted denom
+	res, err = keeper.SimulateSend(ctx, &types.QuerySimulateSendRequest{
+		FromAddress: fromAddr,
+		Outputs: []banktypes.Output{
+			{Address: toAddr1, Coins: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1000)))},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, res.MinimumsMet)
+	require.Equal(t, "uatom", res.FailedDenom)
+	require.Nil(t, res.RequiredMinimum)
+	require.Contains(t, res.FailureReason, "no configured platform minimum")
+
+	// invalid requests
+	_, err = keeper.SimulateSend(ctx, &types.QuerySimulateSendRequest{FromAddress: fromAddr})
+	require.ErrorIs(t, err, banktypes.ErrNoOutputs)
+
+	_, err = keeper.SimulateSend(ctx, &types.QuerySimulateSendRequest{
+		Outputs: []banktypes.Output{{Address: "invalid", Coins: sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1)))}},
+	})
+	require.Error(t, err)
+}
@@ -20,18 +20,33 @@ const (
 	AttributeKeyNeededAmount    = "needed_amount"
 )
 
+// mintResult is what the mint function minted and burned in a block.
+type mintResult struct {
+	blocksPerYear uint64
+	minted        math.Int
+	burned        math.Int
+}
+
 func StakedInflationMintFn(feeCollectorName string, ic minttypes.InflationCalculationFn, bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, stakingKeeper types.StakingKeeper) func(ctx sdk.Context, k *mintkeeper.Keeper) error {
+	mint := stakedInflationMint(feeCollectorName, ic, bankKeeper, accountKeeper, stakingKeeper)
 	return func(ctx sdk.Context, k *mintkeeper.Keeper) error {
+		_, err := mint(ctx, k)
+		return err
+	}
+}
+
+func stakedInflationMint(feeCollectorName string, ic minttypes.InflationCalculationFn, bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, stakingKeeper types.StakingKeeper) func(ctx sdk.Context, k *mintkeeper.Keeper) (mintResult, error) {
+	return func(ctx sdk.Context, k *mintkeeper.Keeper) (mintResult, error) {
 		defer telemetry.ModuleMeasureSince(minttypes.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 		// fetch stored minter & params
 		minter, err := k.Minter.Get(ctx)
 		if err != nil {
-			return err
+			return mintResult{}, err
 		}
 		params, err := k.Params.Get(ctx)
 		if err != nil {
-			return err
+			return mintResult{}, err
 		}
 
 		// fetch collected fees
@@ -40,17 +55,17 @@ func StakedInflationMintFn(feeCollectorName string, ic minttypes.InflationCalcul
 		// recalculate inflation rate
 		bondedRatio, err := k.BondedRatio(ctx)
 		if err != nil {
-			return err
+			return mintResult{}, err
 		}
 		minter.Inflation = ic(ctx, minter, params, bondedRatio)
 
 		bondedTokenSupply, err := stakingKeeper.TotalBondedTokens(ctx)
 		if err != nil {
-			return err
+			return mintResult{}, err
 		}
 		minter.AnnualProvisions = minter.NextAnnualProvisions(params, bondedTokenSupply)
 		if err := k.Minter.Set(ctx, minter); err != nil {
-			return err
+			return mintResult{}, err
 		}
 
 		// mint coins, update supply
@@ -66,13 +81,13 @@ func StakedInflationMintFn(feeCollectorName string, ic minttypes.InflationCalcul
 
 			err := k.MintCoins(ctx, mintedCoins)
 			if err != nil {
-				return err
+				return mintResult{}, err
 			}
 
 			// send the minted coins to the fee collector account
 			err = k.AddCollectedFees(ctx, mintedCoins)
 			if err != nil {
-				return err
+				return mintResult{}, err
 			}
 
 			if mintedCoin.Amount.IsInt64() {
@@ -86,7 +101,7 @@ func StakedInflationMintFn(feeCollectorName string, ic minttypes.InflationCalcul
 			burnedCoins := sdk.NewCoins(burnedCoin)
 			err := bankKeeper.BurnCoins(ctx, feeCollectorName, burnedCoins)
 			if err != nil {
-				return err
+				return mintResult{}, err
 			}
 		}
 
@@ -103,6 +118,6 @@ func StakedInflationMintFn(feeCollectorName string, ic minttypes.InflationCalcul
 			),
 		)
 
-		return nil
+		return mintResult{blocksPerYear: params.BlocksPerYear, minted: mintedCoin.Amount, burned: burnedCoin.Amount}, nil
 	}
 }
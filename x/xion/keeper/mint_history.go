@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// GetMintTotals returns the amounts minted and burned by the mint function.
+func (k Keeper) GetMintTotals(ctx sdk.Context) (totals types.MintTotals, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MintTotalsKey)
+	if bz == nil {
+		return totals, false
+	}
+	k.cdc.MustUnmarshal(bz, &totals)
+	return totals, true
+}
+
+// SetMintTotals stores the mint totals.
+func (k Keeper) SetMintTotals(ctx sdk.Context, totals types.MintTotals) {
+	ctx.KVStore(k.storeKey).Set(types.MintTotalsKey, k.cdc.MustMarshal(&totals))
+}
+
+// SetMintEpoch stores a mint epoch.
+func (k Keeper) SetMintEpoch(ctx sdk.Context, epoch types.MintEpoch) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintEpochKeyPrefix)
+	store.Set(types.MintEpochKey(epoch.Number), k.cdc.MustMarshal(&epoch))
+}
+
+// GetLatestMintEpoch returns the most recent mint epoch.
+func (k Keeper) GetLatestMintEpoch(ctx sdk.Context) (epoch types.MintEpoch, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintEpochKeyPrefix)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return epoch, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+	return epoch, true
+}
+
+// IterateMintEpochs calls cb for every kept mint epoch, oldest first, until
+// cb returns true.
+func (k Keeper) IterateMintEpochs(ctx sdk.Context, cb func(epoch types.MintEpoch) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintEpochKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.MintEpoch
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+		if cb(epoch) {
+			break
+		}
+	}
+}
+
+// RecordMint adds the amounts the mint function minted and burned in the
+// current block to the totals and the current epoch. A new epoch starts once
+// the current one spans MintEpochLength blocks, and the oldest epoch beyond
+// MaxMintEpochs is dropped.
+func (k Keeper) RecordMint(ctx sdk.Context, minted, burned math.Int, blocksPerYear uint64) {
+	height := ctx.BlockHeight()
+
+	totals, found := k.GetMintTotals(ctx)
+	if !found {
+		totals = types.NewMintTotals(height)
+	}
+	k.SetMintTotals(ctx, totals.Add(minted, burned))
+
+	epoch, found := k.GetLatestMintEpoch(ctx)
+	switch {
+	case !found:
+		epoch = types.NewMintEpoch(0, height)
+	case height-epoch.StartHeight >= types.MintEpochLength(blocksPerYear):
+		epoch = types.NewMintEpoch(epoch.Number+1, height)
+		if epoch.Number >= types.MaxMintEpochs {
+			store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintEpochKeyPrefix)
+			store.Delete(types.MintEpochKey(epoch.Number - types.MaxMintEpochs))
+		}
+	}
+	k.SetMintEpoch(ctx, epoch.Add(height, minted, burned))
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestRecordMint(t *testing.T) {
+	ctx, k, _, _ := setupEmissionsTest(t)
+
+	// With 365 blocks per year an epoch is a single block
+	for height := int64(1); height <= types.MaxMintEpochs+5; height++ {
+		k.RecordMint(ctx.WithBlockHeight(height), math.NewInt(10), math.NewInt(3), 365)
+	}
+
+	totals, found := k.GetMintTotals(ctx)
+	require.True(t, found)
+	require.Equal(t, int64(1), totals.SinceHeight)
+	require.Equal(t, math.NewInt(10*(types.MaxMintEpochs+5)), totals.Minted)
+	require.Equal(t, math.NewInt(7*(types.MaxMintEpochs+5)), totals.NetIssuance)
+
+	// Only the most recent epochs are kept
+	var epochs []types.MintEpoch
+	k.IterateMintEpochs(ctx, func(epoch types.MintEpoch) bool {
+		epochs = append(epochs, epoch)
+		return false
+	})
+	require.Len(t, epochs, types.MaxMintEpochs)
+	require.Equal(t, uint64(5), epochs[0].Number)
+	require.Equal(t, int64(6), epochs[0].StartHeight)
+	require.Equal(t, math.NewInt(7), epochs[0].NetIssuance)
+
+	// Longer epochs extend the current epoch over several blocks
+	k.RecordMint(ctx.WithBlockHeight(types.MaxMintEpochs+6), math.NewInt(10), math.ZeroInt(), 365*3)
+	k.RecordMint(ctx.WithBlockHeight(types.MaxMintEpochs+7), math.NewInt(10), math.ZeroInt(), 365*3)
+	latest, found := k.GetLatestMintEpoch(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(types.MaxMintEpochs+4), latest.Number)
+	require.Equal(t, int64(types.MaxMintEpochs+5), latest.StartHeight)
+	require.Equal(t, int64(types.MaxMintEpochs+7), latest.EndHeight)
+	require.Equal(t, math.NewInt(30), latest.Minted)
+}
+
+func TestMintHistoryQueries(t *testing.T) {
+	ctx, k, mk, bank := setupEmissionsTest(t)
+	k.OverwriteEmissionsSchedule(ctx, testEmissionsSchedule())
+	mintFn := ScheduledEmissionsMintFn(authtypes.FeeCollectorName, minttypes.DefaultInflationCalculationFn, bank, emissionsAccounts{}, emissionsStaking{}, k)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+
+	res, err := k.MintTotals(ctx, &types.QueryMintTotalsRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Totals.Minted.IsZero())
+
+	// Block 1 mints 6000, block 2 burns 5000
+	bank.balances[feeCollector] = math.NewInt(4_000)
+	require.NoError(t, mintFn(ctx, mk))
+	bank.balances[feeCollector] = math.NewInt(20_000)
+	require.NoError(t, mintFn(ctx.WithBlockHeight(2), mk))
+
+	res, err = k.MintTotals(ctx, &types.QueryMintTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(6_000), res.Totals.Minted)
+	require.Equal(t, math.NewInt(5_000), res.Totals.Burned)
+	require.Equal(t, math.NewInt(1_000), res.Totals.NetIssuance)
+
+	epochs, err := k.MintEpochs(ctx, &types.QueryMintEpochsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, epochs.Epochs, 1)
+	require.Equal(t, uint64(1), epochs.Epochs[0].Number)
+	require.Equal(t, math.NewInt(5_000), epochs.Epochs[0].Burned)
+	require.NotNil(t, epochs.Pagination.NextKey)
+
+	_, err = k.MintEpochs(ctx, &types.QueryMintEpochsRequest{Pagination: &query.PageRequest{Limit: 101}})
+	require.Error(t, err)
+
+	// The history survives a genesis export and import
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.MintEpochs, 2)
+
+	importCtx, imported, _, _ := setupEmissionsTest(t)
+	imported.InitGenesis(importCtx, genesis)
+	require.Equal(t, genesis.MintTotals, imported.ExportGenesis(importCtx).MintTotals)
+	require.Equal(t, genesis.MintEpochs, imported.ExportGenesis(importCtx).MintEpochs)
+}
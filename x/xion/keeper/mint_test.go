@@ -36,6 +36,11 @@ func (m *MockMintBankKeeper) BurnCoins(ctx context.Context, moduleName string, a
 	return args.Error(0)
 }
 
+func (m *MockMintBankKeeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	args := m.Called(ctx, denom)
+	return args.Get(0).(sdk.Coin)
+}
+
 func (m *MockMintBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
 	args := m.Called(ctx, senderModule, recipientAddr, amt)
 	return args.Error(0)
@@ -75,6 +80,11 @@ type MockMintAccountKeeper struct {
 	mock.Mock
 }
 
+func (m *MockMintAccountKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
+	args := m.Called(ctx, addr)
+	return args.Get(0).(sdk.AccountI)
+}
+
 func (m *MockMintAccountKeeper) GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI {
 	args := m.Called(ctx, moduleName)
 	return args.Get(0).(sdk.ModuleAccountI)
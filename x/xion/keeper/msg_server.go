@@ -37,10 +37,6 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
-		return nil, err
-	}
-
 	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
 	if err != nil {
 		return nil, err
@@ -50,8 +46,36 @@ func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSe
 		return nil, err
 	}
 
+	throughCoins, err := k.sendWithPlatformFee(ctx, from, to, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range throughCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "send"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	return &types.MsgSendResponse{}, nil
+}
+
+// sendWithPlatformFee sends amount from one account to another, enforcing the
+// platform minimums and taking the platform fee out of the amount. It returns
+// the coins received by to.
+func (k Keeper) sendWithPlatformFee(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
 	if k.bankKeeper.BlockedAddr(to) {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", to)
 	}
 
 	percentage := k.GetPlatformPercentage(ctx)
@@ -59,43 +83,34 @@ func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSe
 	if err != nil {
 		return nil, err
 	}
-	throughCoins := msg.Amount
+	throughCoins := amount
 	// Enforce per-denom minimums: for any denom that has a configured minimum,
 	// the sent amount for that denom must be >= that minimum.
-	if !meetsConfiguredMinimums(msg.Amount, minimums) {
-		return nil, errorsmod.Wrapf(types.ErrMinimumNotMet, "received %v, needed at least %v", msg.Amount, minimums)
+	if !meetsConfiguredMinimums(amount, minimums) {
+		return nil, errorsmod.Wrapf(types.ErrMinimumNotMet, "received %v, needed at least %v", amount, minimums)
 	}
 
 	if !percentage.IsZero() {
 		// Safe calculation to prevent overflow: use multiplication with bounds checking
 		// For each coin, calculate: (amount * percentage) / 10000
 		// But prevent overflow by checking if amount * percentage would overflow
-		platformCoins := getPlatformCoins(msg.Amount, percentage)
+		platformCoins := getPlatformCoins(amount, percentage)
 		throughCoins = throughCoins.Sub(platformCoins...)
 
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, authtypes.FeeCollectorName, platformCoins); err != nil {
 			return nil, err
 		}
+
+		if err := k.distributePlatformFees(ctx, platformCoins); err != nil {
+			return nil, err
+		}
 	}
 
-	err = k.bankKeeper.SendCoins(ctx, from, to, throughCoins)
-	if err != nil {
+	if err := k.bankKeeper.SendCoins(ctx, from, to, throughCoins); err != nil {
 		return nil, err
 	}
 
-	defer func() {
-		for _, a := range throughCoins {
-			if a.Amount.IsInt64() {
-				telemetry.SetGaugeWithLabels(
-					[]string{"tx", "msg", "send"},
-					float32(a.Amount.Int64()),
-					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
-				)
-			}
-		}
-	}()
-
-	return &types.MsgSendResponse{}, nil
+	return throughCoins, nil
 }
 
 func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*types.MsgMultiSendResponse, error) {
@@ -159,6 +174,10 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 		return nil, err
 	}
 
+	if err := k.distributePlatformFees(ctx, totalPlatformCoins); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgMultiSendResponse{}, nil
 }
 
@@ -168,9 +187,24 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 // If no minimums are configured at all, this returns false to maintain backwards
 // compatibility requiring platform minimums to be explicitly set.
 func meetsConfiguredMinimums(amt sdk.Coins, mins sdk.Coins) bool {
+	return findUnmetMinimum(amt, mins) == nil
+}
+
+// unmetMinimum describes why an amount failed the platform minimum check.
+type unmetMinimum struct {
+	// denom is empty when no minimums are configured at all
+	denom string
+	// required is nil when the denom has no configured minimum
+	required *sdk.Coin
+	reason   string
+}
+
+// findUnmetMinimum returns the first minimum that amt fails, following the
+// rules documented on meetsConfiguredMinimums, or nil if all are met.
+func findUnmetMinimum(amt sdk.Coins, mins sdk.Coins) *unmetMinimum {
 	// Require that platform minimums be explicitly set (backwards compatibility)
 	if len(mins) == 0 {
-		return false
+		return &unmetMinimum{reason: "no platform minimums are configured"}
 	}
 
 	// Build a map for O(1) minimum lookups
@@ -185,13 +219,21 @@ func meetsConfiguredMinimums(amt sdk.Coins, mins sdk.Coins) bool {
 			// Denom has no configured minimum — reject it.
 			// Unconfigured denoms are not permitted when minimums are in use,
 			// preventing bypass by sending only non-listed denominations.
-			return false
+			return &unmetMinimum{
+				denom:  c.Denom,
+				reason: fmt.Sprintf("denom %s has no configured platform minimum", c.Denom),
+			}
 		}
 		if !min.IsZero() && c.Amount.LT(min) {
-			return false
+			required := sdk.NewCoin(c.Denom, min)
+			return &unmetMinimum{
+				denom:    c.Denom,
+				required: &required,
+				reason:   fmt.Sprintf("%s is below the platform minimum of %s", c, required),
+			}
 		}
 	}
-	return true
+	return nil
 }
 
 func (k msgServer) SetPlatformPercentage(goCtx context.Context, msg *types.MsgSetPlatformPercentage) (*types.MsgSetPlatformPercentageResponse, error) {
@@ -220,6 +262,72 @@ func (k msgServer) SetPlatformMinimum(goCtx context.Context, msg *types.MsgSetPl
 	return &types.MsgSetPlatformMinimumResponse{}, err
 }
 
+func (k msgServer) SetPlatformFeeSplit(goCtx context.Context, msg *types.MsgSetPlatformFeeSplit) (*types.MsgSetPlatformFeeSplitResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Split.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.OverwritePlatformFeeSplit(ctx, msg.Split); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPlatformFeeSplitResponse{}, nil
+}
+
+func (k msgServer) SetPlatformFeeEnforcement(goCtx context.Context, msg *types.MsgSetPlatformFeeEnforcement) (*types.MsgSetPlatformFeeEnforcementResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Enforcement.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.OverwritePlatformFeeEnforcement(ctx, msg.Enforcement); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPlatformFeeEnforcementResponse{}, nil
+}
+
+func (k msgServer) SetAttestationTrustAnchors(goCtx context.Context, msg *types.MsgSetAttestationTrustAnchors) (*types.MsgSetAttestationTrustAnchorsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := types.ValidateAttestationTrustAnchors(msg.Anchors); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.OverwriteAttestationTrustAnchors(ctx, msg.Anchors); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAttestationTrustAnchorsResponse{}, nil
+}
+
+func (k msgServer) SetEmissionsSchedule(goCtx context.Context, msg *types.MsgSetEmissionsSchedule) (*types.MsgSetEmissionsScheduleResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Schedule.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.OverwriteEmissionsSchedule(ctx, msg.Schedule)
+
+	return &types.MsgSetEmissionsScheduleResponse{}, nil
+}
+
 func getPlatformCoins(coins sdk.Coins, percentage sdkmath.Int) sdk.Coins {
 	var platformCoins sdk.Coins
 
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dkimtypes "github.com/burnt-labs/xion/x/dkim/types"
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// SendEscrow locks funds in the module account until they are claimed or the
+// escrow expires.
+func (k msgServer) SendEscrow(goCtx context.Context, msg *types.MsgSendEscrow) (*types.MsgSendEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Recipient != "" {
+		recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+		if err != nil {
+			return nil, err
+		}
+		if k.bankKeeper.BlockedAddr(recipient) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Recipient)
+		}
+	}
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	// the platform fee is taken at claim time, reject escrows that could
+	// never be claimed now rather than at the claim
+	minimums, err := k.GetPlatformMinimums(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !meetsConfiguredMinimums(msg.Amount, minimums) {
+		return nil, errorsmod.Wrapf(types.ErrMinimumNotMet, "received %v, needed at least %v", msg.Amount, minimums)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id := k.GetNextEscrowID(ctx)
+	k.setNextEscrowID(ctx, id+1)
+
+	escrow := types.Escrow{
+		Id:               id,
+		Sender:           msg.Sender,
+		Amount:           msg.Amount,
+		Recipient:        msg.Recipient,
+		SecretHash:       msg.SecretHash,
+		EmailHash:        msg.EmailHash,
+		ExpirationHeight: ctx.BlockHeight() + int64(msg.TimeoutBlocks), //nolint:gosec // bounded by MaxEscrowTimeoutBlocks
+	}
+	k.SetEscrow(ctx, escrow)
+
+	emitEscrowEvent(ctx, types.EventTypeEscrowCreated, escrow,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+	)
+
+	return &types.MsgSendEscrowResponse{Id: id}, nil
+}
+
+// ClaimEscrow pays an escrow to the destination, less the platform fee.
+func (k msgServer) ClaimEscrow(goCtx context.Context, msg *types.MsgClaimEscrow) (*types.MsgClaimEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	escrow, found := k.GetEscrow(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEscrowNotFound, "escrow %d", msg.Id)
+	}
+	if ctx.BlockHeight() > escrow.ExpirationHeight {
+		return nil, errorsmod.Wrapf(types.ErrEscrowExpired, "escrow %d expired at height %d", msg.Id, escrow.ExpirationHeight)
+	}
+
+	destination := msg.Destination
+	if destination == "" {
+		destination = msg.Claimer
+	}
+	destinationAddr, err := sdk.AccAddressFromBech32(destination)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case escrow.Recipient != "":
+		if msg.Claimer != escrow.Recipient {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "escrow %d can only be claimed by %s", msg.Id, escrow.Recipient)
+		}
+	case len(escrow.SecretHash) > 0:
+		if msg.SecretProof == nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidEscrowClaim, "escrow %d requires a secret proof", msg.Id)
+		}
+		if !escrow.VerifySecretClaim(destination, *msg.SecretProof) {
+			return nil, errorsmod.Wrap(types.ErrInvalidEscrowClaim, "secret proof is not valid")
+		}
+	case escrow.EmailHash != "":
+		if msg.EmailProof == nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidEscrowClaim, "escrow %d requires an email proof", msg.Id)
+		}
+		if err := k.verifyEmailClaim(ctx, escrow, destination, msg.EmailProof); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidEscrowClaim, "escrow %d has no claim condition", msg.Id)
+	}
+
+	k.removeEscrow(ctx, escrow)
+
+	received, err := k.sendWithPlatformFee(ctx, escrowAddress(), destinationAddr, escrow.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	emitEscrowEvent(ctx, types.EventTypeEscrowClaimed, escrow,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, received.String()),
+		sdk.NewAttribute(types.AttributeKeyDestination, destination),
+	)
+
+	return &types.MsgClaimEscrowResponse{}, nil
+}
+
+// RefundEscrow returns an escrow to its sender, either on request of the
+// sender once it expired or because the recipient declines it.
+func (k msgServer) RefundEscrow(goCtx context.Context, msg *types.MsgRefundEscrow) (*types.MsgRefundEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	escrow, found := k.GetEscrow(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEscrowNotFound, "escrow %d", msg.Id)
+	}
+
+	var reason string
+	switch {
+	case escrow.Recipient != "" && msg.Signer == escrow.Recipient:
+		reason = types.EscrowRefundReasonDeclined
+	case msg.Signer == escrow.Sender:
+		if ctx.BlockHeight() <= escrow.ExpirationHeight {
+			return nil, errorsmod.Wrapf(types.ErrEscrowNotExpired, "escrow %d expires at height %d", msg.Id, escrow.ExpirationHeight)
+		}
+		reason = types.EscrowRefundReasonExpired
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot refund escrow %d", msg.Signer, msg.Id)
+	}
+
+	if err := k.refundEscrow(ctx, escrow, reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundEscrowResponse{}, nil
+}
+
+// verifyEmailClaim checks that a DKIM proof is for the email hash the escrow
+// commits to and that the proof commits to this claim.
+func (k Keeper) verifyEmailClaim(ctx sdk.Context, escrow types.Escrow, destination string, proof *types.EmailClaimProof) error {
+	if k.dkimKeeper == nil {
+		return errorsmod.Wrap(types.ErrInvalidEscrowClaim, "email proofs are not supported")
+	}
+	if proof.EmailHash != escrow.EmailHash {
+		return errorsmod.Wrap(types.ErrInvalidEscrowClaim, "email hash does not match")
+	}
+
+	res, err := k.dkimKeeper.Authenticate(ctx, &dkimtypes.QueryAuthenticateRequest{
+		TxBytes:           types.EscrowClaimCommand(escrow.Id, destination),
+		EmailHash:         proof.EmailHash,
+		Proof:             proof.Proof,
+		PublicInputs:      proof.PublicInputs,
+		AllowedEmailHosts: proof.AllowedEmailHosts,
+	})
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidEscrowClaim, "email proof: %s", err)
+	}
+	if !res.Verified {
+		return errorsmod.Wrap(types.ErrInvalidEscrowClaim, "email proof is not valid")
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// CreatePaymentSchedule creates a recurring payment from the sender. The first
+// payment is made at the start time, or in the next block if none is given.
+func (k msgServer) CreatePaymentSchedule(goCtx context.Context, msg *types.MsgCreatePaymentSchedule) (*types.MsgCreatePaymentScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Recipient)
+	}
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	// reject schedules whose regular payment could never be made, the last
+	// payment capped by max_total is checked when it is executed
+	minimums, err := k.GetPlatformMinimums(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !meetsConfiguredMinimums(msg.Amount, minimums) {
+		return nil, errorsmod.Wrapf(types.ErrMinimumNotMet, "received %v, needed at least %v", msg.Amount, minimums)
+	}
+
+	if k.countPaymentSchedulesBySender(ctx, sender) >= types.MaxPaymentSchedulesPerSender {
+		return nil, errorsmod.Wrapf(types.ErrTooManySchedules, "%s already has %d payment schedules", msg.Sender, types.MaxPaymentSchedulesPerSender)
+	}
+
+	start := ctx.BlockTime()
+	if msg.StartTime != nil {
+		if msg.StartTime.Before(start) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidSchedule, "start time %s is before the current block time %s", msg.StartTime, start)
+		}
+		start = *msg.StartTime
+	}
+	if msg.EndTime != nil && msg.EndTime.Before(start) {
+		return nil, errorsmod.Wrap(types.ErrInvalidSchedule, "end time is before start time")
+	}
+
+	id := k.GetNextPaymentScheduleID(ctx)
+	k.setNextPaymentScheduleID(ctx, id+1)
+
+	schedule := types.PaymentSchedule{
+		Id:              id,
+		Sender:          msg.Sender,
+		Recipient:       msg.Recipient,
+		Amount:          msg.Amount,
+		Period:          msg.Period,
+		StartTime:       start,
+		EndTime:         msg.EndTime,
+		MaxTotal:        msg.MaxTotal,
+		NextPaymentTime: start,
+	}
+	k.SetPaymentSchedule(ctx, schedule)
+	k.enqueuePaymentSchedule(ctx, schedule)
+
+	emitPaymentScheduleEvent(ctx, types.EventTypePaymentScheduleCreated, schedule,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+	)
+
+	return &types.MsgCreatePaymentScheduleResponse{Id: id}, nil
+}
+
+// CancelPaymentSchedule deletes a schedule. Payments already made are not
+// affected.
+func (k msgServer) CancelPaymentSchedule(goCtx context.Context, msg *types.MsgCancelPaymentSchedule) (*types.MsgCancelPaymentScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	schedule, err := k.getOwnedPaymentSchedule(ctx, msg.Sender, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	k.removePaymentSchedule(ctx, schedule)
+	emitPaymentScheduleEvent(ctx, types.EventTypePaymentScheduleClosed, schedule,
+		sdk.NewAttribute(types.AttributeKeyReason, "cancelled"),
+	)
+
+	return &types.MsgCancelPaymentScheduleResponse{}, nil
+}
+
+// PausePaymentSchedule stops a schedule from making payments until it is
+// resumed.
+func (k msgServer) PausePaymentSchedule(goCtx context.Context, msg *types.MsgPausePaymentSchedule) (*types.MsgPausePaymentScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	schedule, err := k.getOwnedPaymentSchedule(ctx, msg.Sender, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Paused {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSchedule, "payment schedule %d is already paused", msg.Id)
+	}
+
+	k.dequeuePaymentSchedule(ctx, schedule)
+	schedule.Paused = true
+	k.SetPaymentSchedule(ctx, schedule)
+	emitPaymentScheduleEvent(ctx, types.EventTypePaymentSchedulePaused, schedule)
+
+	return &types.MsgPausePaymentScheduleResponse{}, nil
+}
+
+// ResumePaymentSchedule restarts a paused schedule. Payments missed while the
+// schedule was paused are skipped, not made up.
+func (k msgServer) ResumePaymentSchedule(goCtx context.Context, msg *types.MsgResumePaymentSchedule) (*types.MsgResumePaymentScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	schedule, err := k.getOwnedPaymentSchedule(ctx, msg.Sender, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !schedule.Paused {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSchedule, "payment schedule %d is not paused", msg.Id)
+	}
+
+	schedule.Paused = false
+	if now := ctx.BlockTime(); schedule.NextPaymentTime.Before(now) {
+		missed := (now.Sub(schedule.NextPaymentTime) + schedule.Period - 1) / schedule.Period
+		schedule.NextPaymentTime = schedule.NextPaymentTime.Add(missed * schedule.Period)
+	}
+
+	if schedule.IsComplete() {
+		k.removePaymentSchedule(ctx, schedule)
+		emitPaymentScheduleEvent(ctx, types.EventTypePaymentScheduleClosed, schedule,
+			sdk.NewAttribute(types.AttributeKeyReason, "completed"),
+		)
+		return &types.MsgResumePaymentScheduleResponse{}, nil
+	}
+
+	k.SetPaymentSchedule(ctx, schedule)
+	k.enqueuePaymentSchedule(ctx, schedule)
+	emitPaymentScheduleEvent(ctx, types.EventTypePaymentScheduleResumed, schedule)
+
+	return &types.MsgResumePaymentScheduleResponse{}, nil
+}
+
+// getOwnedPaymentSchedule returns a schedule if it is paid by sender.
+func (k Keeper) getOwnedPaymentSchedule(ctx sdk.Context, sender string, id uint64) (types.PaymentSchedule, error) {
+	schedule, found := k.GetPaymentSchedule(ctx, id)
+	if !found {
+		return schedule, errorsmod.Wrapf(types.ErrScheduleNotFound, "payment schedule %d", id)
+	}
+	if schedule.Sender != sender {
+		return schedule, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "payment schedule %d is not paid by %s", id, sender)
+	}
+	return schedule, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -67,6 +68,14 @@ type MockAccountKeeper struct {
 	mock.Mock
 }
 
+func (m *MockAccountKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
+	args := m.Called(ctx, addr)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(sdk.AccountI)
+}
+
 func (m *MockAccountKeeper) GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI {
 	// Return a simple mock that implements the basic interface
 	return authtypes.NewEmptyModuleAccount(moduleName, authtypes.Minter, authtypes.Burner)
@@ -82,6 +91,21 @@ func (m *MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt s
 	return args.Error(0)
 }
 
+func (m *MockBankKeeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	args := m.Called(ctx, denom)
+	return args.Get(0).(sdk.Coin)
+}
+
+// Mock distribution keeper for testing
+type MockDistributionKeeper struct {
+	mock.Mock
+}
+
+func (m *MockDistributionKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	args := m.Called(ctx, amount, sender)
+	return args.Error(0)
+}
+
 func setupMsgServerTestWithAuthority(t *testing.T, authority string) (context.Context, types.MsgServer, *Keeper, *MockBankKeeper) { // nolint:unparam (authority variations tested)
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
@@ -769,3 +793,169 @@ func TestGetPlatformCoins_LargeAmounts(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgServer_Send_WithFeeSplit(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx
+
+	mockBankKeeper := &MockBankKeeper{}
+	mockDistrKeeper := &MockDistributionKeeper{}
+	keeper := Keeper{
+		storeKey:    key,
+		bankKeeper:  mockBankKeeper,
+		distrKeeper: mockDistrKeeper,
+	}
+	server := NewMsgServerImpl(keeper)
+
+	fromAddr := sdk.AccAddress("from_address_12345678")
+	toAddr := sdk.AccAddress("to_address_123456789")
+	treasuryAddr := sdk.AccAddress("treasury_address_1234")
+	amount := sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(10000)))
+
+	keeper.OverwritePlatformPercentage(ctx, 1000) // 10%
+	require.NoError(t, keeper.OverwritePlatformMinimum(ctx, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1)))))
+	require.NoError(t, keeper.OverwritePlatformFeeSplit(ctx, types.PlatformFeeSplit{
+		CommunityPoolBps: 2000, // 20%
+		BurnBps:          3000, // 30%
+		TreasuryAddress:  treasuryAddr.String(),
+		TreasuryBps:      4000, // 40%
+	}))
+
+	// 10% of 10000 = 1000 platform fee, split 200/300/400 with 100 left over
+	platformFee := sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1000)))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	mockBankKeeper.On("IsSendEnabledCoins", ctx, mock.Anything).Return(nil)
+	mockBankKeeper.On("BlockedAddr", toAddr).Return(false)
+	mockBankKeeper.On("SendCoinsFromAccountToModule", ctx, fromAddr, authtypes.FeeCollectorName, platformFee).Return(nil)
+	mockDistrKeeper.On("FundCommunityPool", ctx, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(200))), feeCollector).Return(nil)
+	mockBankKeeper.On("BurnCoins", ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(300)))).Return(nil)
+	mockBankKeeper.On("SendCoinsFromModuleToAccount", ctx, authtypes.FeeCollectorName, treasuryAddr, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(400)))).Return(nil)
+	mockBankKeeper.On("SendCoins", ctx, fromAddr, toAddr, amount.Sub(platformFee...)).Return(nil)
+
+	_, err := server.Send(ctx, types.NewMsgSend(fromAddr, toAddr, amount))
+	require.NoError(t, err)
+	mockBankKeeper.AssertExpectations(t)
+	mockDistrKeeper.AssertExpectations(t)
+
+	destinations := map[string]string{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypePlatformFee {
+			continue
+		}
+		dest, ok := event.GetAttribute(types.AttributeKeyDestination)
+		require.True(t, ok)
+		amt, ok := event.GetAttribute(sdk.AttributeKeyAmount)
+		require.True(t, ok)
+		destinations[dest.Value] = amt.Value
+	}
+	require.Equal(t, map[string]string{
+		types.PlatformFeeDestinationCommunityPool: "200uxion",
+		types.PlatformFeeDestinationBurn:          "300uxion",
+		types.PlatformFeeDestinationTreasury:      "400uxion",
+		types.PlatformFeeDestinationFeeCollector:  "100uxion",
+	}, destinations)
+}
+
+func TestMsgServer_SetPlatformFeeSplit(t *testing.T) {
+	goCtx, server, keeper, _ := setupMsgServerTestWithAuthority(t, testAuthorityConst)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	split := types.PlatformFeeSplit{CommunityPoolBps: 5000, BurnBps: 5000}
+
+	_, err := server.SetPlatformFeeSplit(goCtx, &types.MsgSetPlatformFeeSplit{Authority: "wrong_authority", Split: split})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = server.SetPlatformFeeSplit(goCtx, &types.MsgSetPlatformFeeSplit{
+		Authority: testAuthorityConst,
+		Split:     types.PlatformFeeSplit{CommunityPoolBps: 6000, BurnBps: 5000},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidFeeSplit)
+
+	_, err = server.SetPlatformFeeSplit(goCtx, &types.MsgSetPlatformFeeSplit{Authority: testAuthorityConst, Split: split})
+	require.NoError(t, err)
+
+	stored, err := keeper.GetPlatformFeeSplit(ctx)
+	require.NoError(t, err)
+	require.Equal(t, split, stored)
+}
+
+func TestMsgServer_SetPlatformFeeEnforcement(t *testing.T) {
+	goCtx, server, keeper, _ := setupMsgServerTestWithAuthority(t, testAuthorityConst)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	enforcement := types.PlatformFeeEnforcement{
+		IbcTransfers:    true,
+		WasmBankSends:   true,
+		ExemptAddresses: []string{sdk.AccAddress("relayer_address_12345").String()},
+	}
+
+	_, err := server.SetPlatformFeeEnforcement(goCtx, &types.MsgSetPlatformFeeEnforcement{Authority: "wrong_authority", Enforcement: enforcement})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = server.SetPlatformFeeEnforcement(goCtx, &types.MsgSetPlatformFeeEnforcement{
+		Authority:   testAuthorityConst,
+		Enforcement: types.PlatformFeeEnforcement{ExemptAddresses: []string{"invalid"}},
+	})
+	require.Error(t, err)
+
+	_, err = server.SetPlatformFeeEnforcement(goCtx, &types.MsgSetPlatformFeeEnforcement{Authority: testAuthorityConst, Enforcement: enforcement})
+	require.NoError(t, err)
+
+	stored, err := keeper.GetPlatformFeeEnforcement(ctx)
+	require.NoError(t, err)
+	require.Equal(t, enforcement, stored)
+}
+
+func TestKeeper_IsPlatformFeeExempt(t *testing.T) {
+	goCtx, _, keeper, _ := setupMsgServerTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	mockAccountKeeper := keeper.accountKeeper.(*MockAccountKeeper)
+
+	relayer := sdk.AccAddress("relayer_address_12345")
+	user := sdk.AccAddress("user_address_12345678")
+	module := authtypes.NewModuleAddress("some_module")
+
+	// exempt addresses match whatever their case
+	require.NoError(t, keeper.OverwritePlatformFeeEnforcement(ctx, types.PlatformFeeEnforcement{
+		ExemptAddresses: []string{strings.ToUpper(relayer.String())},
+	}))
+
+	mockAccountKeeper.On("GetAccount", ctx, user).Return(authtypes.NewBaseAccountWithAddress(user))
+	mockAccountKeeper.On("GetAccount", ctx, module).Return(authtypes.NewEmptyModuleAccount("some_module"))
+
+	exempt, err := keeper.IsPlatformFeeExempt(ctx, relayer)
+	require.NoError(t, err)
+	require.True(t, exempt)
+
+	exempt, err = keeper.IsPlatformFeeExempt(ctx, module)
+	require.NoError(t, err)
+	require.True(t, exempt)
+
+	exempt, err = keeper.IsPlatformFeeExempt(ctx, user)
+	require.NoError(t, err)
+	require.False(t, exempt)
+}
+
+func TestKeeper_ChargePlatformFee(t *testing.T) {
+	goCtx, _, keeper, mockBankKeeper := setupMsgServerTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payer := sdk.AccAddress("payer_address_1234567")
+	amount := sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1001)))
+
+	// no platform percentage configured
+	fee, err := keeper.ChargePlatformFee(ctx, payer, amount)
+	require.NoError(t, err)
+	require.True(t, fee.IsZero())
+
+	keeper.OverwritePlatformPercentage(ctx, 250)
+	expectedFee := sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(26)))
+	mockBankKeeper.On("SendCoinsFromAccountToModule", ctx, payer, authtypes.FeeCollectorName, expectedFee).Return(nil)
+
+	fee, err = keeper.ChargePlatformFee(ctx, payer, amount)
+	require.NoError(t, err)
+	require.Equal(t, expectedFee, fee)
+	mockBankKeeper.AssertExpectations(t)
+}
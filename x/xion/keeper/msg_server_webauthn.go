@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// VerifyWebAuthNAssertion verifies an assertion like WebAuthNVerifyAuthenticate
+// and additionally checks it against the credential state recorded for the
+// sender, rejecting signature counter regressions and backup eligibility
+// changes. The first assertion of a credential starts from the counter and
+// flags recorded in the credential at registration.
+func (k msgServer) VerifyWebAuthNAssertion(goCtx context.Context, msg *types.MsgVerifyWebAuthNAssertion) (*types.MsgVerifyWebAuthNAssertionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := k.relyingParty(ctx, msg.Rp, msg.RpProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, data, err := verifyAssertionData(ctx, profile, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	state, found := k.GetWebAuthNCredential(ctx, sender, credential.ID)
+	if !found {
+		state = types.WebAuthNCredentialState{
+			Owner:          msg.Sender,
+			CredentialId:   credential.ID,
+			SignCount:      credential.Authenticator.SignCount,
+			BackupEligible: credential.Flags.BackupEligible,
+		}
+		if err := state.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	authData := data.Response.AuthenticatorData
+	if err := state.CheckAssertion(authData); err != nil {
+		return nil, err
+	}
+
+	state.SignCount = authData.Counter
+	state.BackupState = authData.Flags.HasBackupState()
+	state.UserVerified = authData.Flags.HasUserVerified()
+	state.LastUsedHeight = ctx.BlockHeight()
+	state.LastUsedTime = ctx.BlockTime()
+	k.SetWebAuthNCredential(ctx, state)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeWebAuthNAssertionVerified,
+		sdk.NewAttribute(types.AttributeKeyOwner, state.Owner),
+		sdk.NewAttribute(types.AttributeKeyCredentialID, base64.RawURLEncoding.EncodeToString(state.CredentialId)),
+		sdk.NewAttribute(types.AttributeKeySignCount, strconv.FormatUint(uint64(state.SignCount), 10)),
+		sdk.NewAttribute(types.AttributeKeyBackupState, strconv.FormatBool(state.BackupState)),
+	))
+
+	return &types.MsgVerifyWebAuthNAssertionResponse{State: state}, nil
+}
+
+// verifyAssertionData parses and verifies the assertion of msg. Panics of
+// the WebAuthn library on malformed data are returned as errors, running out
+// of gas is not.
+func verifyAssertionData(ctx sdk.Context, profile types.RelyingPartyProfile, msg *types.MsgVerifyWebAuthNAssertion) (credential webauthn.Credential, data *protocol.ParsedCredentialAssertionData, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				panic(r)
+			}
+			err = errorsmod.Wrap(types.ErrNoValidWebAuth, fmt.Sprintf("panic during WebAuthn verification: %v", r))
+		}
+	}()
+
+	if err := validateCredentialRequest(bytes.NewReader(msg.Data)); err != nil {
+		return credential, nil, errorsmod.Wrap(types.ErrNoValidWebAuth, err.Error())
+	}
+
+	data, err = protocol.ParseCredentialRequestResponseBody(bytes.NewReader(msg.Data))
+	if err != nil {
+		return credential, nil, errorsmod.Wrap(types.ErrNoValidWebAuth, err.Error())
+	}
+
+	if err := json.Unmarshal(msg.Credential, &credential); err != nil {
+		return credential, nil, errorsmod.Wrap(types.ErrNoValidWebAuth, err.Error())
+	}
+
+	if _, err := types.VerifyAssertion(ctx, profile, msg.Sender, msg.Challenge, &credential, data); err != nil {
+		return credential, nil, errorsmod.Wrap(types.ErrNoValidWebAuth, err.Error())
+	}
+
+	return credential, data, nil
+}
+
+// SetRelyingPartyProfile registers or replaces a contract's relying party
+// profile. Only the contract itself or its admin may manage the profile.
+func (k msgServer) SetRelyingPartyProfile(goCtx context.Context, msg *types.MsgSetRelyingPartyProfile) (*types.MsgSetRelyingPartyProfileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	contract, err := sdk.AccAddressFromBech32(msg.Profile.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeRelyingPartyProfile(ctx, msg.Sender, contract); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetRelyingPartyProfile(ctx, msg.Profile)
+	return &types.MsgSetRelyingPartyProfileResponse{}, nil
+}
+
+// RemoveRelyingPartyProfile deletes a contract's relying party profile.
+func (k msgServer) RemoveRelyingPartyProfile(goCtx context.Context, msg *types.MsgRemoveRelyingPartyProfile) (*types.MsgRemoveRelyingPartyProfileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeRelyingPartyProfile(ctx, msg.Sender, contract); err != nil {
+		return nil, err
+	}
+	if _, found := k.GetRelyingPartyProfile(ctx, contract); !found {
+		return nil, errorsmod.Wrapf(types.ErrRelyingPartyProfileNotFound, "contract %s", msg.Contract)
+	}
+
+	k.Keeper.RemoveRelyingPartyProfile(ctx, contract)
+	return &types.MsgRemoveRelyingPartyProfileResponse{}, nil
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// GetNextPaymentScheduleID returns the id the next created schedule will use.
+func (k Keeper) GetNextPaymentScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextPaymentScheduleIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextPaymentScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextPaymentScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetPaymentSchedule returns the schedule with the given id.
+func (k Keeper) GetPaymentSchedule(ctx sdk.Context, id uint64) (schedule types.PaymentSchedule, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentScheduleKeyPrefix)
+	bz := store.Get(types.PaymentScheduleKey(id))
+	if bz == nil {
+		return schedule, false
+	}
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// SetPaymentSchedule stores a schedule along with its sender and recipient
+// index entries. Queueing the schedule for execution is left to the caller.
+func (k Keeper) SetPaymentSchedule(ctx sdk.Context, schedule types.PaymentSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.PaymentScheduleKeyPrefix).Set(types.PaymentScheduleKey(schedule.Id), k.cdc.MustMarshal(&schedule))
+
+	sender := sdk.MustAccAddressFromBech32(schedule.Sender)
+	recipient := sdk.MustAccAddressFromBech32(schedule.Recipient)
+	prefix.NewStore(store, types.PaymentScheduleBySenderKeyPrefix).Set(types.PaymentScheduleAccountIndexKey(sender, schedule.Id), []byte{})
+	prefix.NewStore(store, types.PaymentScheduleByRecipientKeyPrefix).Set(types.PaymentScheduleAccountIndexKey(recipient, schedule.Id), []byte{})
+}
+
+// removePaymentSchedule deletes a schedule, its index entries and its queue
+// entry.
+func (k Keeper) removePaymentSchedule(ctx sdk.Context, schedule types.PaymentSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.PaymentScheduleKeyPrefix).Delete(types.PaymentScheduleKey(schedule.Id))
+
+	sender := sdk.MustAccAddressFromBech32(schedule.Sender)
+	recipient := sdk.MustAccAddressFromBech32(schedule.Recipient)
+	prefix.NewStore(store, types.PaymentScheduleBySenderKeyPrefix).Delete(types.PaymentScheduleAccountIndexKey(sender, schedule.Id))
+	prefix.NewStore(store, types.PaymentScheduleByRecipientKeyPrefix).Delete(types.PaymentScheduleAccountIndexKey(recipient, schedule.Id))
+
+	k.dequeuePaymentSchedule(ctx, schedule)
+}
+
+func (k Keeper) enqueuePaymentSchedule(ctx sdk.Context, schedule types.PaymentSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentScheduleQueueKeyPrefix)
+	store.Set(types.PaymentScheduleQueueKey(schedule.NextPaymentTime, schedule.Id), []byte{})
+}
+
+func (k Keeper) dequeuePaymentSchedule(ctx sdk.Context, schedule types.PaymentSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentScheduleQueueKeyPrefix)
+	store.Delete(types.PaymentScheduleQueueKey(schedule.NextPaymentTime, schedule.Id))
+}
+
+// countPaymentSchedulesBySender returns the number of schedules paid by sender.
+func (k Keeper) countPaymentSchedulesBySender(ctx sdk.Context, sender sdk.AccAddress) int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentScheduleBySenderKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PaymentScheduleAccountIndexPrefix(sender))
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// IteratePaymentSchedules calls cb for every schedule in id order until cb
+// returns true.
+func (k Keeper) IteratePaymentSchedules(ctx sdk.Context, cb func(schedule types.PaymentSchedule) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentScheduleKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.PaymentSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// duePaymentScheduleIDs returns the ids of up to limit schedules whose next
+// payment is due at or before now, earliest first, taking at most
+// perSender schedules of each sender. The schedules of a sender beyond that
+// stay queued for later blocks.
+func (k Keeper) duePaymentScheduleIDs(ctx sdk.Context, now time.Time, limit, perSender int) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentScheduleQueueKeyPrefix)
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(now)))
+	defer iterator.Close()
+
+	// at most limit/perSender senders are skipped, each with at most
+	// MaxPaymentSchedulesPerSender schedules, so the scan is bounded
+	var ids []uint64
+	bySender := make(map[string]int)
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		id := types.ParsePaymentScheduleQueueKey(iterator.Key())
+		schedule, found := k.GetPaymentSchedule(ctx, id)
+		if !found {
+			continue
+		}
+		if bySender[schedule.Sender] >= perSender {
+			continue
+		}
+		bySender[schedule.Sender]++
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// ExecuteDuePaymentSchedules makes every payment that is due at the current
+// block time, up to MaxPaymentSchedulesPerBlock and
+// MaxDuePaymentsPerSenderPerBlock per sender. It is called from BeginBlock.
+func (k Keeper) ExecuteDuePaymentSchedules(ctx sdk.Context) {
+	for _, id := range k.duePaymentScheduleIDs(ctx, ctx.BlockTime(), types.MaxPaymentSchedulesPerBlock, types.MaxDuePaymentsPerSenderPerBlock) {
+		schedule, found := k.GetPaymentSchedule(ctx, id)
+		if !found {
+			continue
+		}
+		k.executePaymentSchedule(ctx, schedule)
+	}
+}
+
+// executePaymentSchedule makes the next payment of a schedule. A payment that
+// fails, e.g. because the sender no longer has the funds, closes the schedule.
+func (k Keeper) executePaymentSchedule(ctx sdk.Context, schedule types.PaymentSchedule) {
+	k.dequeuePaymentSchedule(ctx, schedule)
+
+	payment := schedule.NextPayment()
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.sendWithPlatformFee(cacheCtx, sdk.MustAccAddressFromBech32(schedule.Sender), sdk.MustAccAddressFromBech32(schedule.Recipient), payment); err != nil {
+		k.Logger(ctx).Info("payment schedule failed", "id", schedule.Id, "error", err)
+		k.removePaymentSchedule(ctx, schedule)
+		emitPaymentScheduleEvent(ctx, types.EventTypePaymentScheduleFailed, schedule,
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		)
+		return
+	}
+	write()
+
+	schedule.TotalPaid = schedule.TotalPaid.Add(payment...)
+	schedule.NextPaymentTime = schedule.NextPaymentTime.Add(schedule.Period)
+	emitPaymentScheduleEvent(ctx, types.EventTypePaymentScheduleExecuted, schedule,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, payment.String()),
+	)
+
+	if schedule.IsComplete() {
+		k.removePaymentSchedule(ctx, schedule)
+		emitPaymentScheduleEvent(ctx, types.EventTypePaymentScheduleClosed, schedule,
+			sdk.NewAttribute(types.AttributeKeyReason, "completed"),
+		)
+		return
+	}
+
+	k.SetPaymentSchedule(ctx, schedule)
+	k.enqueuePaymentSchedule(ctx, schedule)
+}
+
+func emitPaymentScheduleEvent(ctx sdk.Context, eventType string, schedule types.PaymentSchedule, attrs ...sdk.Attribute) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, schedule.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, schedule.Recipient),
+		}, attrs...)...,
+	))
+}
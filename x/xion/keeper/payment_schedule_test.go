@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+var (
+	scheduleStart     = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	scheduleSender    = sdk.AccAddress("schedule_sender_1234")
+	scheduleRecipient = sdk.AccAddress("schedule_recipient_1")
+)
+
+func setupPaymentScheduleTest(t *testing.T) (context.Context, types.MsgServer, *Keeper, *MockBankKeeper) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockTime(scheduleStart)
+
+	mockBankKeeper := &MockBankKeeper{}
+
+	keeper := Keeper{
+		cdc:        moduletestutil.MakeTestEncodingConfig().Codec,
+		storeKey:   key,
+		bankKeeper: mockBankKeeper,
+	}
+	require.NoError(t, keeper.OverwritePlatformMinimum(ctx, sdk.NewCoins(sdk.NewInt64Coin("uxion", 100))))
+
+	return ctx, NewMsgServerImpl(keeper), &keeper, mockBankKeeper
+}
+
+func createTestPaymentSchedule(t *testing.T, goCtx context.Context, server types.MsgServer, mockBankKeeper *MockBankKeeper, msg *types.MsgCreatePaymentSchedule) uint64 {
+	mockBankKeeper.On("BlockedAddr", scheduleRecipient).Return(false)
+	mockBankKeeper.On("IsSendEnabledCoins", mock.Anything, mock.Anything).Return(nil)
+
+	res, err := server.CreatePaymentSchedule(goCtx, msg)
+	require.NoError(t, err)
+	return res.Id
+}
+
+func newCreatePaymentScheduleMsg(amount int64) *types.MsgCreatePaymentSchedule {
+	return &types.MsgCreatePaymentSchedule{
+		Sender:    scheduleSender.String(),
+		Recipient: scheduleRecipient.String(),
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("uxion", amount)),
+		Period:    time.Hour,
+	}
+}
+
+func TestCreatePaymentSchedule(t *testing.T) {
+	goCtx, server, keeper, mockBankKeeper := setupPaymentScheduleTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id := createTestPaymentSchedule(t, goCtx, server, mockBankKeeper, newCreatePaymentScheduleMsg(1000))
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, uint64(2), keeper.GetNextPaymentScheduleID(ctx))
+
+	schedule, found := keeper.GetPaymentSchedule(ctx, id)
+	require.True(t, found)
+	require.Equal(t, scheduleStart, schedule.StartTime)
+	require.Equal(t, scheduleStart, schedule.NextPaymentTime)
+	require.Equal(t, []uint64{id}, keeper.duePaymentScheduleIDs(ctx, scheduleStart, types.MaxPaymentSchedulesPerBlock, types.MaxDuePaymentsPerSenderPerBlock))
+
+	res, err := keeper.PaymentSchedulesBySender(goCtx, &types.QueryPaymentSchedulesBySenderRequest{Sender: scheduleSender.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Schedules, 1)
+
+	recipientRes, err := keeper.PaymentSchedulesByRecipient(goCtx, &types.QueryPaymentSchedulesByRecipientRequest{Recipient: scheduleRecipient.String()})
+	require.NoError(t, err)
+	require.Len(t, recipientRes.Schedules, 1)
+
+	senderRes, err := keeper.PaymentSchedulesBySender(goCtx, &types.QueryPaymentSchedulesBySenderRequest{Sender: scheduleRecipient.String()})
+	require.NoError(t, err)
+	require.Empty(t, senderRes.Schedules)
+}
+
+func TestCreatePaymentSchedule_Rejections(t *testing.T) {
+	goCtx, server, keeper, mockBankKeeper := setupPaymentScheduleTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	mockBankKeeper.On("BlockedAddr", scheduleRecipient).Return(false)
+	mockBankKeeper.On("IsSendEnabledCoins", mock.Anything, mock.Anything).Return(nil)
+
+	past := scheduleStart.Add(-time.Minute)
+	msg := newCreatePaymentScheduleMsg(1000)
+	msg.StartTime = &past
+	_, err := server.CreatePaymentSchedule(goCtx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+
+	msg = newCreatePaymentScheduleMsg(1000)
+	msg.Period = time.Second
+	_, err = server.CreatePaymentSchedule(goCtx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+
+	require.NoError(t, keeper.OverwritePlatformMinimum(ctx, sdk.NewCoins(sdk.NewInt64Coin("uxion", 2000))))
+	_, err = server.CreatePaymentSchedule(goCtx, newCreatePaymentScheduleMsg(1000))
+	require.ErrorIs(t, err, types.ErrMinimumNotMet)
+	require.NoError(t, keeper.OverwritePlatformMinimum(ctx, sdk.NewCoins(sdk.NewInt64Coin("uxion", 100))))
+
+	for i := 0; i < types.MaxPaymentSchedulesPerSender; i++ {
+		_, err = server.CreatePaymentSchedule(goCtx, newCreatePaymentScheduleMsg(1000))
+		require.NoError(t, err)
+	}
+	_, err = server.CreatePaymentSchedule(goCtx, newCreatePaymentScheduleMsg(1000))
+	require.ErrorIs(t, err, types.ErrTooManySchedules)
+}
+
+func TestExecuteDuePaymentSchedules(t *testing.T) {
+	goCtx, server, keeper, mockBankKeeper := setupPaymentScheduleTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	keeper.OverwritePlatformPercentage(ctx, 1000)
+
+	msg := newCreatePaymentScheduleMsg(1000)
+	msg.MaxTotal = sdk.NewCoins(sdk.NewInt64Coin("uxion", 1500))
+	id := createTestPaymentSchedule(t, goCtx, server, mockBankKeeper, msg)
+
+	mockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, scheduleSender, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("uxion", 100))).Return(nil).Once()
+	mockBankKeeper.On("SendCoins", mock.Anything, scheduleSender, scheduleRecipient, sdk.NewCoins(sdk.NewInt64Coin("uxion", 900))).Return(nil).Once()
+
+	keeper.ExecuteDuePaymentSchedules(ctx)
+
+	schedule, found := keeper.GetPaymentSchedule(ctx, id)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000)), schedule.TotalPaid)
+	require.Equal(t, scheduleStart.Add(time.Hour), schedule.NextPaymentTime)
+
+	// nothing is due until the next period
+	keeper.ExecuteDuePaymentSchedules(ctx)
+	mockBankKeeper.AssertNumberOfCalls(t, "SendCoins", 1)
+
+	// the last payment is capped by max_total and closes the schedule
+	mockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, scheduleSender, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("uxion", 50))).Return(nil).Once()
+	mockBankKeeper.On("SendCoins", mock.Anything, scheduleSender, scheduleRecipient, sdk.NewCoins(sdk.NewInt64Coin("uxion", 450))).Return(nil).Once()
+
+	nextCtx := ctx.WithBlockTime(scheduleStart.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteDuePaymentSchedules(nextCtx)
+
+	_, found = keeper.GetPaymentSchedule(ctx, id)
+	require.False(t, found)
+	require.Empty(t, keeper.duePaymentScheduleIDs(ctx, scheduleStart.Add(24*time.Hour), types.MaxPaymentSchedulesPerBlock, types.MaxDuePaymentsPerSenderPerBlock))
+
+	var eventTypes []string
+	for _, event := range nextCtx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Contains(t, eventTypes, types.EventTypePaymentScheduleExecuted)
+	require.Contains(t, eventTypes, types.EventTypePaymentScheduleClosed)
+	mockBankKeeper.AssertExpectations(t)
+}
+
+func TestExecuteDuePaymentSchedules_PerSenderLimit(t *testing.T) {
+	goCtx, server, keeper, mockBankKeeper := setupPaymentScheduleTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// a sender with many due schedules queued ahead of another sender
+	var senderIDs []uint64
+	for i := 0; i < types.MaxDuePaymentsPerSenderPerBlock+5; i++ {
+		senderIDs = append(senderIDs, createTestPaymentSchedule(t, goCtx, server, mockBankKeeper, newCreatePaymentScheduleMsg(1000)))
+	}
+	msg := newCreatePaymentScheduleMsg(1000)
+	msg.Sender = scheduleRecipient.String()
+	msg.Recipient = scheduleSender.String()
+	mockBankKeeper.On("BlockedAddr", scheduleSender).Return(false)
+	other := createTestPaymentSchedule(t, goCtx, server, mockBankKeeper, msg)
+
+	due := keeper.duePaymentScheduleIDs(ctx, scheduleStart, types.MaxPaymentSchedulesPerBlock, types.MaxDuePaymentsPerSenderPerBlock)
+	require.Equal(t, append(senderIDs[:types.MaxDuePaymentsPerSenderPerBlock:types.MaxDuePaymentsPerSenderPerBlock], other), due)
+
+	// the block limit still applies
+	require.Equal(t, senderIDs[:3], keeper.duePaymentScheduleIDs(ctx, scheduleStart, 3, types.MaxDuePaymentsPerSenderPerBlock))
+}
+
+func TestExecuteDuePaymentSchedules_FailureClosesSchedule(t *testing.T) {
+	goCtx, server, keeper, mockBankKeeper := setupPaymentScheduleTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id := createTestPaymentSchedule(t, goCtx, server, mockBankKeeper, newCreatePaymentScheduleMsg(1000))
+	mockBankKeeper.On("SendCoins", mock.Anything, scheduleSender, scheduleRecipient, mock.Anything).Return(errors.New("insufficient funds"))
+
+	keeper.ExecuteDuePaymentSchedules(ctx)
+
+	_, found := keeper.GetPaymentSchedule(ctx, id)
+	require.False(t, found)
+
+	var failed bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypePaymentScheduleFailed {
+			failed = true
+		}
+	}
+	require.True(t, failed)
+}
+
+func TestPauseResumeCancelPaymentSchedule(t *testing.T) {
+	goCtx, server, keeper, mockBankKeeper := setupPaymentScheduleTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id := createTestPaymentSchedule(t, goCtx, server, mockBankKeeper, newCreatePaymentScheduleMsg(1000))
+
+	_, err := server.PausePaymentSchedule(goCtx, &types.MsgPausePaymentSchedule{Sender: scheduleRecipient.String(), Id: id})
+	require.Error(t, err)
+
+	_, err = server.PausePaymentSchedule(goCtx, &types.MsgPausePaymentSchedule{Sender: scheduleSender.String(), Id: id})
+	require.NoError(t, err)
+	require.Empty(t, keeper.duePaymentScheduleIDs(ctx, scheduleStart, types.MaxPaymentSchedulesPerBlock, types.MaxDuePaymentsPerSenderPerBlock))
+
+	_, err = server.PausePaymentSchedule(goCtx, &types.MsgPausePaymentSchedule{Sender: scheduleSender.String(), Id: id})
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+
+	// missed payments are skipped on resume
+	resumeCtx := ctx.WithBlockTime(scheduleStart.Add(150 * time.Minute))
+	_, err = server.ResumePaymentSchedule(resumeCtx, &types.MsgResumePaymentSchedule{Sender: scheduleSender.String(), Id: id})
+	require.NoError(t, err)
+
+	schedule, found := keeper.GetPaymentSchedule(ctx, id)
+	require.True(t, found)
+	require.False(t, schedule.Paused)
+	require.Equal(t, scheduleStart.Add(3*time.Hour), schedule.NextPaymentTime)
+	require.Empty(t, keeper.duePaymentScheduleIDs(ctx, resumeCtx.BlockTime(), types.MaxPaymentSchedulesPerBlock, types.MaxDuePaymentsPerSenderPerBlock))
+	require.Equal(t, []uint64{id}, keeper.duePaymentScheduleIDs(ctx, schedule.NextPaymentTime, types.MaxPaymentSchedulesPerBlock, types.MaxDuePaymentsPerSenderPerBlock))
+
+	_, err = server.CancelPaymentSchedule(goCtx, &types.MsgCancelPaymentSchedule{Sender: scheduleSender.String(), Id: id})
+	require.NoError(t, err)
+	_, found = keeper.GetPaymentSchedule(ctx, id)
+	require.False(t, found)
+	require.Empty(t, keeper.duePaymentScheduleIDs(ctx, schedule.NextPaymentTime, types.MaxPaymentSchedulesPerBlock, types.MaxDuePaymentsPerSenderPerBlock))
+
+	_, err = server.CancelPaymentSchedule(goCtx, &types.MsgCancelPaymentSchedule{Sender: scheduleSender.String(), Id: id})
+	require.ErrorIs(t, err, types.ErrScheduleNotFound)
+}
+
+func TestPaymentScheduleGenesisRoundTrip(t *testing.T) {
+	goCtx, server, keeper, mockBankKeeper := setupPaymentScheduleTest(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	first := createTestPaymentSchedule(t, goCtx, server, mockBankKeeper, newCreatePaymentScheduleMsg(1000))
+	second := createTestPaymentSchedule(t, goCtx, server, mockBankKeeper, newCreatePaymentScheduleMsg(2000))
+	_, err := server.PausePaymentSchedule(goCtx, &types.MsgPausePaymentSchedule{Sender: scheduleSender.String(), Id: second})
+	require.NoError(t, err)
+
+	exported := keeper.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.PaymentSchedules, 2)
+	require.Equal(t, uint64(3), exported.NextPaymentScheduleId)
+
+	newCtx, _, newKeeper, _ := setupPaymentScheduleTest(t)
+	sdkCtx := sdk.UnwrapSDKContext(newCtx)
+	newKeeper.InitGenesis(sdkCtx, exported)
+
+	require.Equal(t, uint64(3), newKeeper.GetNextPaymentScheduleID(sdkCtx))
+	require.Equal(t, []uint64{first}, newKeeper.duePaymentScheduleIDs(sdkCtx, scheduleStart, types.MaxPaymentSchedulesPerBlock, types.MaxDuePaymentsPerSenderPerBlock))
+
+	res, err := newKeeper.PaymentSchedule(newCtx, &types.QueryPaymentScheduleRequest{Id: second})
+	require.NoError(t, err)
+	require.True(t, res.Schedule.Paused)
+	require.Equal(t, math.NewInt(2000), res.Schedule.Amount.AmountOf("uxion"))
+}
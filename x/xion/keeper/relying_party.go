@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"net/url"
+
+	"cosmossdk.io/store/prefix"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// GetRelyingPartyProfile returns the relying party profile registered for a
+// contract.
+func (k Keeper) GetRelyingPartyProfile(ctx sdk.Context, contract sdk.AccAddress) (profile types.RelyingPartyProfile, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelyingPartyProfileKeyPrefix)
+	bz := store.Get(types.RelyingPartyProfileKey(contract))
+	if bz == nil {
+		return profile, false
+	}
+	k.cdc.MustUnmarshal(bz, &profile)
+	return profile, true
+}
+
+// SetRelyingPartyProfile stores the relying party profile of a contract.
+func (k Keeper) SetRelyingPartyProfile(ctx sdk.Context, profile types.RelyingPartyProfile) {
+	contract := sdk.MustAccAddressFromBech32(profile.Contract)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelyingPartyProfileKeyPrefix)
+	store.Set(types.RelyingPartyProfileKey(contract), k.cdc.MustMarshal(&profile))
+}
+
+// RemoveRelyingPartyProfile deletes the relying party profile of a contract.
+func (k Keeper) RemoveRelyingPartyProfile(ctx sdk.Context, contract sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelyingPartyProfileKeyPrefix)
+	store.Delete(types.RelyingPartyProfileKey(contract))
+}
+
+// IterateRelyingPartyProfiles calls cb for every relying party profile until
+// cb returns true.
+func (k Keeper) IterateRelyingPartyProfiles(ctx sdk.Context, cb func(profile types.RelyingPartyProfile) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelyingPartyProfileKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var profile types.RelyingPartyProfile
+		k.cdc.MustUnmarshal(iterator.Value(), &profile)
+		if cb(profile) {
+			break
+		}
+	}
+}
+
+// relyingParty resolves the relying party of a WebAuthn request, either the
+// profile registered for the rpProfile contract or the single origin rp URL.
+func (k Keeper) relyingParty(ctx sdk.Context, rp, rpProfile string) (types.RelyingPartyProfile, error) {
+	if rpProfile == "" {
+		rpURL, err := url.Parse(rp)
+		if err != nil {
+			return types.RelyingPartyProfile{}, err
+		}
+		return types.RelyingPartyProfileFromURL(rpURL), nil
+	}
+
+	if rp != "" {
+		return types.RelyingPartyProfile{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "rp and rp_profile are mutually exclusive")
+	}
+	contract, err := sdk.AccAddressFromBech32(rpProfile)
+	if err != nil {
+		return types.RelyingPartyProfile{}, err
+	}
+	profile, found := k.GetRelyingPartyProfile(ctx, contract)
+	if !found {
+		return types.RelyingPartyProfile{}, errorsmod.Wrapf(types.ErrRelyingPartyProfileNotFound, "contract %s", rpProfile)
+	}
+	return profile, nil
+}
+
+// authorizeRelyingPartyProfile checks that sender may manage the relying
+// party profile of a contract: the contract itself or its admin.
+func (k Keeper) authorizeRelyingPartyProfile(ctx sdk.Context, sender string, contract sdk.AccAddress) error {
+	if sender == contract.String() {
+		return nil
+	}
+	info := k.ContractViewKeeper.GetContractInfo(ctx, contract)
+	if info == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %s", contract)
+	}
+	if info.Admin == "" || info.Admin != sender {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither contract %s nor its admin", sender, contract)
+	}
+	return nil
+}
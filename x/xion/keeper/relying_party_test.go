@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// adminViewKeeper reports contracts with a fixed admin.
+type adminViewKeeper struct {
+	wasmtypes.ViewKeeper
+	contracts map[string]string
+}
+
+func (v adminViewKeeper) GetContractInfo(_ context.Context, contract sdk.AccAddress) *wasmtypes.ContractInfo {
+	admin, ok := v.contracts[contract.String()]
+	if !ok {
+		return nil
+	}
+	return &wasmtypes.ContractInfo{Admin: admin}
+}
+
+func TestMsgServer_RelyingPartyProfile(t *testing.T) {
+	ctx, _, keeper := setupAttestationTest(t)
+	contract := sdk.AccAddress("rp_contract_address_").String()
+	admin := sdk.AccAddress("rp_contract_admin___").String()
+	other := sdk.AccAddress("someone_else________").String()
+	keeper.ContractViewKeeper = adminViewKeeper{contracts: map[string]string{contract: admin}}
+	server := NewMsgServerImpl(*keeper)
+
+	profile := types.RelyingPartyProfile{
+		Contract: contract,
+		RpId:     "example.com",
+		Origins:  []string{"https://example.com", "https://app.example.com"},
+	}
+
+	_, err := server.SetRelyingPartyProfile(ctx, &types.MsgSetRelyingPartyProfile{Sender: other, Profile: profile})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	unknown := profile
+	unknown.Contract = other
+	_, err = server.SetRelyingPartyProfile(ctx, &types.MsgSetRelyingPartyProfile{Sender: admin, Profile: unknown})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	_, err = server.SetRelyingPartyProfile(ctx, &types.MsgSetRelyingPartyProfile{Sender: admin, Profile: profile})
+	require.NoError(t, err)
+
+	// The contract manages its own profile
+	profile.Origins = append(profile.Origins, "https://wallet.example.com")
+	_, err = server.SetRelyingPartyProfile(ctx, &types.MsgSetRelyingPartyProfile{Sender: contract, Profile: profile})
+	require.NoError(t, err)
+
+	res, err := keeper.RelyingPartyProfile(ctx, &types.QueryRelyingPartyProfileRequest{Contract: contract})
+	require.NoError(t, err)
+	require.Equal(t, profile, res.Profile)
+
+	_, err = server.RemoveRelyingPartyProfile(ctx, &types.MsgRemoveRelyingPartyProfile{Sender: other, Contract: contract})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = server.RemoveRelyingPartyProfile(ctx, &types.MsgRemoveRelyingPartyProfile{Sender: admin, Contract: contract})
+	require.NoError(t, err)
+	_, found := keeper.GetRelyingPartyProfile(ctx, sdk.MustAccAddressFromBech32(contract))
+	require.False(t, found)
+
+	_, err = server.RemoveRelyingPartyProfile(ctx, &types.MsgRemoveRelyingPartyProfile{Sender: admin, Contract: contract})
+	require.ErrorIs(t, err, types.ErrRelyingPartyProfileNotFound)
+}
+
+func TestWebAuthNVerifyAuthenticate_RelyingPartyProfile(t *testing.T) {
+	ctx, _, keeper := setupAttestationTest(t)
+	contract := sdk.AccAddress("rp_contract_address_").String()
+	owner := sdk.AccAddress("abstract_account_1234").String()
+	authenticator := newTestAuthenticator(t)
+	challenge := base64.RawURLEncoding.EncodeToString([]byte("profile-challenge"))
+
+	req := &types.QueryWebAuthNVerifyAuthenticateRequest{
+		Addr:       owner,
+		Challenge:  challenge,
+		Credential: authenticator.credential(t, 0, false),
+		Data:       authenticator.assertFrom(t, "https://app.example.com", challenge, 1, 0),
+		RpProfile:  contract,
+	}
+
+	_, err := keeper.WebAuthNVerifyAuthenticate(ctx, req)
+	require.ErrorIs(t, err, types.ErrRelyingPartyProfileNotFound)
+
+	keeper.SetRelyingPartyProfile(ctx, types.RelyingPartyProfile{
+		Contract: contract,
+		RpId:     "example.com",
+		Origins:  []string{"https://example.com", "https://app.example.com"},
+	})
+	_, err = keeper.WebAuthNVerifyAuthenticate(ctx, req)
+	require.NoError(t, err)
+
+	// A single relying party URL only accepts its own origin
+	req.RpProfile = ""
+	req.Rp = "https://example.com"
+	_, err = keeper.WebAuthNVerifyAuthenticate(ctx, req)
+	require.Error(t, err)
+
+	req.RpProfile = contract
+	_, err = keeper.WebAuthNVerifyAuthenticate(ctx, req)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Assertions tracked by the msg server resolve the profile the same way
+	server := NewMsgServerImpl(*keeper)
+	_, err = server.VerifyWebAuthNAssertion(ctx, &types.MsgVerifyWebAuthNAssertion{
+		Sender:     owner,
+		Challenge:  challenge,
+		Credential: authenticator.credential(t, 0, false),
+		Data:       authenticator.assertFrom(t, "https://app.example.com", challenge, 1, protocol.FlagUserVerified),
+		RpProfile:  contract,
+	})
+	require.NoError(t, err)
+}
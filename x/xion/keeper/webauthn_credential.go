@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// GetWebAuthNCredential returns the tracked state of an owner's credential.
+func (k Keeper) GetWebAuthNCredential(ctx sdk.Context, owner sdk.AccAddress, credentialID []byte) (state types.WebAuthNCredentialState, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WebAuthNCredentialKeyPrefix)
+	bz := store.Get(types.WebAuthNCredentialKey(owner, credentialID))
+	if bz == nil {
+		return state, false
+	}
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, true
+}
+
+// SetWebAuthNCredential stores the tracked state of a credential.
+func (k Keeper) SetWebAuthNCredential(ctx sdk.Context, state types.WebAuthNCredentialState) {
+	owner := sdk.MustAccAddressFromBech32(state.Owner)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WebAuthNCredentialKeyPrefix)
+	store.Set(types.WebAuthNCredentialKey(owner, state.CredentialId), k.cdc.MustMarshal(&state))
+}
+
+// IterateWebAuthNCredentials calls cb for every tracked credential in owner
+// order until cb returns true.
+func (k Keeper) IterateWebAuthNCredentials(ctx sdk.Context, cb func(state types.WebAuthNCredentialState) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WebAuthNCredentialKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var state types.WebAuthNCredentialState
+		k.cdc.MustUnmarshal(iterator.Value(), &state)
+		if cb(state) {
+			break
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+type testAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+}
+
+func newTestAuthenticator(t *testing.T) testAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return testAuthenticator{key: key, credentialID: []byte("tracked-cred-id")}
+}
+
+// credential returns the JSON encoded credential as registered with the given
+// sign count and backup eligibility.
+func (a testAuthenticator) credential(t *testing.T, signCount uint32, backupEligible bool) []byte {
+	publicKey, err := webauthncbor.Marshal(map[int]interface{}{
+		1:  2,
+		3:  -7,
+		-1: 1,
+		-2: a.key.X.FillBytes(make([]byte, 32)),
+		-3: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(t, err)
+
+	bz, err := json.Marshal(webauthn.Credential{
+		ID:            a.credentialID,
+		PublicKey:     publicKey,
+		Authenticator: webauthn.Authenticator{SignCount: signCount},
+		Flags:         webauthn.CredentialFlags{UserPresent: true, BackupEligible: backupEligible},
+	})
+	require.NoError(t, err)
+	return bz
+}
+
+// assert returns an assertion for challenge with the given counter and flags.
+func (a testAuthenticator) assert(t *testing.T, challenge string, counter uint32, flags protocol.AuthenticatorFlags) []byte {
+	return a.assertFrom(t, "https://example.com", challenge, counter, flags)
+}
+
+// assertFrom returns an assertion made on origin for the example.com relying
+// party.
+func (a testAuthenticator) assertFrom(t *testing.T, origin, challenge string, counter uint32, flags protocol.AuthenticatorFlags) []byte {
+	authData := &bytes.Buffer{}
+	rpIDHash := sha256.Sum256([]byte("example.com"))
+	authData.Write(rpIDHash[:])
+	authData.WriteByte(byte(flags | protocol.FlagUserPresent))
+	require.NoError(t, binary.Write(authData, binary.BigEndian, counter))
+
+	clientDataJSON, err := json.Marshal(map[string]string{
+		"type":      "webauthn.get",
+		"challenge": challenge,
+		"origin":    origin,
+	})
+	require.NoError(t, err)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+
+	digest := sha256.Sum256(append(authData.Bytes(), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+
+	body, err := json.Marshal(map[string]interface{}{
+		"id":    base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"authenticatorData": base64.RawURLEncoding.EncodeToString(authData.Bytes()),
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientDataJSON),
+			"signature":         base64.RawURLEncoding.EncodeToString(sig),
+		},
+	})
+	require.NoError(t, err)
+	return body
+}
+
+func TestMsgServer_VerifyWebAuthNAssertion(t *testing.T) {
+	ctx, server, keeper := setupAttestationTest(t)
+	ctx = ctx.WithBlockHeight(10)
+	owner := sdk.AccAddress("abstract_account_1234").String()
+	authenticator := newTestAuthenticator(t)
+	challenge := base64.RawURLEncoding.EncodeToString([]byte("assertion-challenge"))
+
+	verify := func(credential []byte, counter uint32, flags protocol.AuthenticatorFlags) (*types.MsgVerifyWebAuthNAssertionResponse, error) {
+		return server.VerifyWebAuthNAssertion(ctx, &types.MsgVerifyWebAuthNAssertion{
+			Sender:     owner,
+			Challenge:  challenge,
+			Rp:         "https://example.com",
+			Credential: credential,
+			Data:       authenticator.assert(t, challenge, counter, flags),
+		})
+	}
+
+	credential := authenticator.credential(t, 5, true)
+
+	// The first assertion is checked against the registered sign count
+	_, err := verify(credential, 5, protocol.FlagBackupEligible)
+	require.ErrorIs(t, err, types.ErrWebAuthNCloneDetected)
+	_, found := keeper.GetWebAuthNCredential(ctx, sdk.MustAccAddressFromBech32(owner), authenticator.credentialID)
+	require.False(t, found)
+
+	res, err := verify(credential, 6, protocol.FlagBackupEligible|protocol.FlagBackupState|protocol.FlagUserVerified)
+	require.NoError(t, err)
+	require.Equal(t, uint32(6), res.State.SignCount)
+	require.True(t, res.State.BackupEligible)
+	require.True(t, res.State.BackupState)
+	require.True(t, res.State.UserVerified)
+	require.Equal(t, int64(10), res.State.LastUsedHeight)
+
+	// Later assertions are checked against the recorded state, not the stale
+	// registered credential
+	_, err = verify(credential, 6, protocol.FlagBackupEligible)
+	require.ErrorIs(t, err, types.ErrWebAuthNCloneDetected)
+
+	_, err = verify(credential, 7, 0)
+	require.Error(t, err)
+
+	_, err = verify(credential, 9, protocol.FlagBackupEligible)
+	require.NoError(t, err)
+
+	stored, err := keeper.WebAuthNCredential(ctx, &types.QueryWebAuthNCredentialRequest{Owner: owner, CredentialId: authenticator.credentialID})
+	require.NoError(t, err)
+	require.Equal(t, uint32(9), stored.State.SignCount)
+	require.False(t, stored.State.BackupState)
+
+	byOwner, err := keeper.WebAuthNCredentialsByOwner(ctx, &types.QueryWebAuthNCredentialsByOwnerRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Len(t, byOwner.States, 1)
+
+	other, err := keeper.WebAuthNCredentialsByOwner(ctx, &types.QueryWebAuthNCredentialsByOwnerRequest{Owner: sdk.AccAddress("abstract_account_5678").String()})
+	require.NoError(t, err)
+	require.Empty(t, other.States)
+}
+
+func TestMsgServer_VerifyWebAuthNAssertion_ZeroCounter(t *testing.T) {
+	ctx, server, _ := setupAttestationTest(t)
+	owner := sdk.AccAddress("abstract_account_1234").String()
+	authenticator := newTestAuthenticator(t)
+	challenge := base64.RawURLEncoding.EncodeToString([]byte("assertion-challenge"))
+	credential := authenticator.credential(t, 0, false)
+
+	// Authenticators that never increment the counter always report zero
+	for i := 0; i < 2; i++ {
+		_, err := server.VerifyWebAuthNAssertion(ctx, &types.MsgVerifyWebAuthNAssertion{
+			Sender:     owner,
+			Challenge:  challenge,
+			Rp:         "https://example.com",
+			Credential: credential,
+			Data:       authenticator.assert(t, challenge, 0, 0),
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestMsgServer_VerifyWebAuthNAssertion_OutOfGas(t *testing.T) {
+	ctx, server, _ := setupAttestationTest(t)
+	owner := sdk.AccAddress("abstract_account_1234").String()
+	authenticator := newTestAuthenticator(t)
+	challenge := base64.RawURLEncoding.EncodeToString([]byte("assertion-challenge"))
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1))
+
+	// Running out of gas on the credential store aborts the transaction
+	// instead of being reported as an invalid assertion
+	defer func() {
+		_, ok := recover().(storetypes.ErrorOutOfGas)
+		require.True(t, ok)
+	}()
+	_, _ = server.VerifyWebAuthNAssertion(ctx, &types.MsgVerifyWebAuthNAssertion{
+		Sender:     owner,
+		Challenge:  challenge,
+		Rp:         "https://example.com",
+		Credential: authenticator.credential(t, 0, false),
+		Data:       authenticator.assert(t, challenge, 0, 0),
+	})
+	t.Fatal("expected an out of gas panic")
+}
@@ -0,0 +1,15 @@
+package middleware
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// PlatformFeeKeeper is the subset of the x/xion keeper used to enforce the
+// platform fee outside of xion.v1.MsgSend and MsgMultiSend.
+type PlatformFeeKeeper interface {
+	GetPlatformFeeEnforcement(ctx sdk.Context) (types.PlatformFeeEnforcement, error)
+	IsPlatformFeeExempt(ctx sdk.Context, sender sdk.AccAddress) (bool, error)
+	ChargePlatformFee(ctx sdk.Context, payer sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error)
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+var _ ante.FeegrantKeeper = FeegrantKeeper{}
+
+// FeegrantKeeper wraps the feegrant keeper used by the fee deduction ante
+// decorator so the xion fee allowances know which grant they pay for and can
+// report it in their fee_allowance_used event.
+type FeegrantKeeper struct {
+	ante.FeegrantKeeper
+}
+
+// NewFeegrantKeeper wraps k for use in the ante handler.
+func NewFeegrantKeeper(k ante.FeegrantKeeper) FeegrantKeeper {
+	return FeegrantKeeper{FeegrantKeeper: k}
+}
+
+// UseGrantedFees implements ante.FeegrantKeeper.
+func (k FeegrantKeeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	sdkCtx := types.WithFeeGrantParties(sdk.UnwrapSDKContext(ctx), granter, grantee)
+	return k.FeegrantKeeper.UseGrantedFees(sdkCtx, granter, grantee, fee, msgs)
+}
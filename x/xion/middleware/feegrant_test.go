@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// mockFeegrantKeeper accepts fees with a single allowance like the feegrant
+// keeper does.
+type mockFeegrantKeeper struct {
+	allowance feegrant.FeeAllowanceI
+}
+
+func (m mockFeegrantKeeper) UseGrantedFees(ctx context.Context, _, _ sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	_, err := m.allowance.Accept(ctx, fee, msgs)
+	return err
+}
+
+func TestFeegrantKeeper_UseGrantedFees(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	granter := sdk.AccAddress("fee_granter_12345678")
+	grantee := sdk.AccAddress("fee_grantee_12345678")
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uxion", 10))
+
+	allowance, err := types.NewMsgFilterAllowance(&feegrant.BasicAllowance{}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	require.NoError(t, err)
+
+	k := NewFeegrantKeeper(mockFeegrantKeeper{allowance: allowance})
+	require.NoError(t, k.UseGrantedFees(ctx, granter, grantee, fee, []sdk.Msg{&banktypes.MsgSend{}}))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeFeeAllowanceUsed, events[0].Type)
+	attr, ok := events[0].GetAttribute(feegrant.AttributeKeyGranter)
+	require.True(t, ok)
+	require.Equal(t, granter.String(), attr.Value)
+	attr, ok = events[0].GetAttribute(feegrant.AttributeKeyGrantee)
+	require.True(t, ok)
+	require.Equal(t, grantee.String(), attr.Value)
+}
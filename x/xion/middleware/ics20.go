@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+type packetExemptionKey struct{}
+
+// packetExemption is the transfer a packet callback may start without being
+// charged the platform fee.
+type packetExemption struct {
+	// sender is the only sender whose transfers are exempt.
+	sender string
+	// token, if set, is the only token that may be sent.
+	token *sdk.Coin
+}
+
+// withPacketExemption marks ctx as running inside a packet callback that may
+// start the transfers matching exemption.
+func withPacketExemption(ctx sdk.Context, exemption packetExemption) sdk.Context {
+	return ctx.WithValue(packetExemptionKey{}, exemption)
+}
+
+// isExemptPacketTransfer returns true if ctx was marked by
+// withPacketExemption for a transfer of token by sender.
+func isExemptPacketTransfer(ctx sdk.Context, sender string, token sdk.Coin) bool {
+	exemption, ok := ctx.Value(packetExemptionKey{}).(packetExemption)
+	if !ok || exemption.sender != sender {
+		return false
+	}
+	return exemption.token == nil || exemption.token.IsEqual(token)
+}
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware sits at the top of the transfer stack and marks the relayed
+// packet callbacks that start transfers which are not charged the platform
+// fee by ICS4Wrapper:
+//   - on receive, the packet forward middleware forwarding the tokens from
+//     its intermediate receiver, which only it controls, and
+//   - on timeout, the sender of the packet resending the same tokens, such
+//     as a packet forward retry. The original transfer was already charged.
+//
+// Any other transfer started from a callback, e.g. by a contract, is charged.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+}
+
+// NewIBCMiddleware wraps app so its packet callbacks are marked.
+func NewIBCMiddleware(app porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{IBCModule: app}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if data, err := transfertypes.UnmarshalPacketData(packet.Data, transfertypes.V1, ""); err == nil {
+		if forwarder, err := packetforward.GetReceiver(packet.DestinationChannel, data.Sender); err == nil {
+			ctx = withPacketExemption(ctx, packetExemption{sender: forwarder})
+		}
+	}
+	return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if data, err := transfertypes.UnmarshalPacketData(packet.Data, transfertypes.V1, ""); err == nil {
+		if token, err := data.Token.ToCoin(); err == nil {
+			ctx = withPacketExemption(ctx, packetExemption{sender: data.Sender, token: &token})
+		}
+	}
+	return im.IBCModule.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
+}
+
+var _ porttypes.ICS4Wrapper = ICS4Wrapper{}
+
+// ICS4Wrapper charges the platform fee on outgoing ICS-20 transfers when
+// enabled through PlatformFeeEnforcement. The tokens in the packet have
+// already been escrowed or burned by the transfer keeper, so the fee is
+// charged to the sender on top of the transferred amount. The fee is not
+// refunded when the packet times out or is acknowledged with an error.
+type ICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+	keeper PlatformFeeKeeper
+}
+
+// NewICS4Wrapper wraps ics4Wrapper with platform fee enforcement.
+func NewICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, keeper PlatformFeeKeeper) ICS4Wrapper {
+	return ICS4Wrapper{ICS4Wrapper: ics4Wrapper, keeper: keeper}
+}
+
+// SendPacket implements the ICS4Wrapper interface.
+func (w ICS4Wrapper) SendPacket(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	if err := w.chargeTransfer(ctx, data); err != nil {
+		return 0, err
+	}
+
+	return w.ICS4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+func (w ICS4Wrapper) chargeTransfer(ctx sdk.Context, data []byte) error {
+	enforcement, err := w.keeper.GetPlatformFeeEnforcement(ctx)
+	if err != nil {
+		return err
+	}
+	if !enforcement.IbcTransfers {
+		return nil
+	}
+
+	packetData, err := transfertypes.UnmarshalPacketData(data, transfertypes.V1, "")
+	if err != nil {
+		return err
+	}
+
+	coin, err := packetData.Token.ToCoin()
+	if err != nil {
+		return err
+	}
+	if isExemptPacketTransfer(ctx, packetData.Sender, coin) {
+		return nil
+	}
+
+	sender, err := sdk.AccAddressFromBech32(packetData.Sender)
+	if err != nil {
+		return err
+	}
+
+	exempt, err := w.keeper.IsPlatformFeeExempt(ctx, sender)
+	if err != nil || exempt {
+		return err
+	}
+
+	_, err = w.keeper.ChargePlatformFee(ctx, sender, sdk.NewCoins(coin))
+	return err
+}
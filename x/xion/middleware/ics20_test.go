@@ -0,0 +1,215 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+type mockPlatformFeeKeeper struct {
+	enforcement types.PlatformFeeEnforcement
+	exempt      bool
+	charged     map[string]sdk.Coins
+}
+
+func (m *mockPlatformFeeKeeper) GetPlatformFeeEnforcement(sdk.Context) (types.PlatformFeeEnforcement, error) {
+	return m.enforcement, nil
+}
+
+func (m *mockPlatformFeeKeeper) IsPlatformFeeExempt(_ sdk.Context, sender sdk.AccAddress) (bool, error) {
+	return m.exempt || m.enforcement.IsExempt(sender), nil
+}
+
+// ChargePlatformFee charges a flat 10% so tests can assert on exact amounts.
+func (m *mockPlatformFeeKeeper) ChargePlatformFee(_ sdk.Context, payer sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
+	fee := sdk.NewCoins()
+	for _, c := range amount {
+		fee = fee.Add(sdk.NewCoin(c.Denom, c.Amount.QuoRaw(10)))
+	}
+	if m.charged == nil {
+		m.charged = map[string]sdk.Coins{}
+	}
+	m.charged[payer.String()] = m.charged[payer.String()].Add(fee...)
+	return fee, nil
+}
+
+type mockICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+	sent int
+}
+
+func (m *mockICS4Wrapper) SendPacket(sdk.Context, string, string, clienttypes.Height, uint64, []byte) (uint64, error) {
+	m.sent++
+	return uint64(m.sent), nil
+}
+
+// mockIBCModule runs callback in its packet callbacks, like a contract or the
+// packet forward middleware starting a transfer.
+type mockIBCModule struct {
+	porttypes.IBCModule
+	callback func(ctx sdk.Context)
+}
+
+func (m mockIBCModule) OnRecvPacket(ctx sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	m.callback(ctx)
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func (m mockIBCModule) OnTimeoutPacket(ctx sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress) error {
+	m.callback(ctx)
+	return nil
+}
+
+func transferPacketData(t *testing.T, sender sdk.AccAddress) []byte {
+	t.Helper()
+	return transferPacketDataWithAmount(t, sender.String(), "1000")
+}
+
+func transferPacketDataWithAmount(t *testing.T, sender, amount string) []byte {
+	t.Helper()
+	data := transfertypes.NewFungibleTokenPacketData("uxion", amount, sender, "cosmos1receiver", "")
+	return data.GetBytes()
+}
+
+func setupContext(t *testing.T) sdk.Context {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	return testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+}
+
+func TestICS4Wrapper_SendPacket(t *testing.T) {
+	sender := sdk.AccAddress("sender_address_123456")
+	relayer := sdk.AccAddress("relayer_address_12345")
+
+	testCases := []struct {
+		name        string
+		enforcement types.PlatformFeeEnforcement
+		sender      sdk.AccAddress
+		exemption   *packetExemption
+		expectFee   sdk.Coins
+	}{
+		{
+			name:      "disabled",
+			sender:    sender,
+			expectFee: nil,
+		},
+		{
+			name:        "enabled",
+			enforcement: types.PlatformFeeEnforcement{IbcTransfers: true},
+			sender:      sender,
+			expectFee:   sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(100))),
+		},
+		{
+			name:        "exempt sender",
+			enforcement: types.PlatformFeeEnforcement{IbcTransfers: true, ExemptAddresses: []string{relayer.String()}},
+			sender:      relayer,
+			expectFee:   nil,
+		},
+		{
+			name:        "exempt packet transfer",
+			enforcement: types.PlatformFeeEnforcement{IbcTransfers: true},
+			sender:      sender,
+			exemption:   &packetExemption{sender: sender.String()},
+			expectFee:   nil,
+		},
+		{
+			name:        "packet transfer of another sender",
+			enforcement: types.PlatformFeeEnforcement{IbcTransfers: true},
+			sender:      sender,
+			exemption:   &packetExemption{sender: relayer.String()},
+			expectFee:   sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(100))),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := setupContext(t)
+			if tc.exemption != nil {
+				ctx = withPacketExemption(ctx, *tc.exemption)
+			}
+
+			keeper := &mockPlatformFeeKeeper{enforcement: tc.enforcement}
+			inner := &mockICS4Wrapper{}
+			wrapper := NewICS4Wrapper(inner, keeper)
+
+			seq, err := wrapper.SendPacket(ctx, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 1, transferPacketData(t, tc.sender))
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), seq)
+			require.Equal(t, tc.expectFee, keeper.charged[tc.sender.String()])
+		})
+	}
+}
+
+func TestICS4Wrapper_SendPacket_InvalidData(t *testing.T) {
+	ctx := setupContext(t)
+	keeper := &mockPlatformFeeKeeper{enforcement: types.PlatformFeeEnforcement{IbcTransfers: true}}
+	inner := &mockICS4Wrapper{}
+	wrapper := NewICS4Wrapper(inner, keeper)
+
+	_, err := wrapper.SendPacket(ctx, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 1, []byte("not a transfer"))
+	require.Error(t, err)
+	require.Zero(t, inner.sent)
+}
+
+func TestIBCMiddleware_PacketCallbackTransfers(t *testing.T) {
+	originalSender := "cosmos1originalsender"
+	forwarder, err := packetforward.GetReceiver("channel-1", originalSender)
+	require.NoError(t, err)
+	contract := sdk.AccAddress("contract_address_1234")
+
+	keeper := &mockPlatformFeeKeeper{enforcement: types.PlatformFeeEnforcement{IbcTransfers: true}}
+	wrapper := NewICS4Wrapper(&mockICS4Wrapper{}, keeper)
+	var sends [][]byte
+	im := NewIBCMiddleware(mockIBCModule{callback: func(ctx sdk.Context) {
+		for _, data := range sends {
+			_, err := wrapper.SendPacket(ctx, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 1, data)
+			require.NoError(t, err)
+		}
+	}})
+	received := channeltypes.Packet{
+		DestinationChannel: "channel-1",
+		Data:               transferPacketDataWithAmount(t, originalSender, "1000"),
+	}
+
+	// the packet forward middleware forwards from its intermediate receiver
+	// for free, a contract transferring from a receive hook is charged
+	ctx := setupContext(t)
+	sends = [][]byte{transferPacketDataWithAmount(t, forwarder, "1000"), transferPacketData(t, contract)}
+	ack := im.OnRecvPacket(ctx, transfertypes.V1, received, sdk.AccAddress("relayer"))
+	require.True(t, ack.Success())
+	require.Empty(t, keeper.charged[forwarder])
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(100))), keeper.charged[contract.String()])
+
+	// on timeout the sender may resend the same tokens for free, anything
+	// else is charged
+	keeper.charged = nil
+	timedOut := channeltypes.Packet{SourceChannel: "channel-0", Data: transferPacketData(t, contract)}
+	sends = [][]byte{transferPacketData(t, contract)}
+	require.NoError(t, im.OnTimeoutPacket(ctx, transfertypes.V1, timedOut, sdk.AccAddress("relayer")))
+	require.Empty(t, keeper.charged[contract.String()])
+
+	sends = [][]byte{transferPacketDataWithAmount(t, contract.String(), "2000")}
+	require.NoError(t, im.OnTimeoutPacket(ctx, transfertypes.V1, timedOut, sdk.AccAddress("relayer")))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(200))), keeper.charged[contract.String()])
+
+	// outside of callbacks nothing is exempt
+	keeper.charged = nil
+	_, err = wrapper.SendPacket(ctx, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 1, transferPacketDataWithAmount(t, forwarder, "1000"))
+	require.NoError(t, err)
+	require.NotEmpty(t, keeper.charged[forwarder])
+}
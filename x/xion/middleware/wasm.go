@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ wasmkeeper.Messenger = WasmMessenger{}
+
+// WasmMessenger charges the platform fee on CosmWasm BankMsg::Send when
+// enabled through PlatformFeeEnforcement. Like on ICS-20 transfers, the fee
+// is charged to the contract on top of the amount, so the recipient receives
+// the full amount.
+type WasmMessenger struct {
+	wrapped wasmkeeper.Messenger
+	keeper  PlatformFeeKeeper
+}
+
+// NewWasmMessageHandlerDecorator returns a decorator for use with
+// wasmkeeper.WithMessageHandlerDecorator.
+func NewWasmMessageHandlerDecorator(keeper PlatformFeeKeeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return WasmMessenger{wrapped: old, keeper: keeper}
+	}
+}
+
+// DispatchMsg implements the wasmkeeper.Messenger interface.
+func (m WasmMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	if msg.Bank != nil && msg.Bank.Send != nil {
+		if err := m.chargeBankSend(ctx, contractAddr, msg.Bank.Send); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// chargeBankSend charges the contract the platform fee on the amount of send.
+func (m WasmMessenger) chargeBankSend(ctx sdk.Context, contractAddr sdk.AccAddress, send *wasmvmtypes.SendMsg) error {
+	enforcement, err := m.keeper.GetPlatformFeeEnforcement(ctx)
+	if err != nil {
+		return err
+	}
+	if !enforcement.WasmBankSends {
+		return nil
+	}
+
+	exempt, err := m.keeper.IsPlatformFeeExempt(ctx, contractAddr)
+	if err != nil || exempt {
+		return err
+	}
+
+	amount, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(send.Amount)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.keeper.ChargePlatformFee(ctx, contractAddr, amount)
+	return err
+}
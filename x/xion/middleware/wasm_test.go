@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+type mockMessenger struct {
+	dispatched []wasmvmtypes.CosmosMsg
+}
+
+func (m *mockMessenger) DispatchMsg(_ sdk.Context, _ sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	m.dispatched = append(m.dispatched, msg)
+	return nil, nil, nil, nil
+}
+
+func TestWasmMessenger_DispatchMsg(t *testing.T) {
+	contract := sdk.AccAddress("contract_address_1234")
+	send := func(amount uint64) wasmvmtypes.CosmosMsg {
+		return wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+			ToAddress: "cosmos1recipient",
+			Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(amount, "uxion")},
+		}}}
+	}
+
+	testCases := []struct {
+		name        string
+		enforcement types.PlatformFeeEnforcement
+		exempt      bool
+		msg         wasmvmtypes.CosmosMsg
+		expectFee   sdk.Coins
+		expectSent  string
+	}{
+		{
+			name:       "disabled",
+			msg:        send(1000),
+			expectSent: "1000",
+		},
+		{
+			name:        "enabled charges the fee on top of the amount",
+			enforcement: types.PlatformFeeEnforcement{WasmBankSends: true},
+			msg:         send(1000),
+			expectFee:   sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(100))),
+			expectSent:  "1000",
+		},
+		{
+			name:        "exempt contract",
+			enforcement: types.PlatformFeeEnforcement{WasmBankSends: true},
+			exempt:      true,
+			msg:         send(1000),
+			expectSent:  "1000",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := setupContext(t)
+			keeper := &mockPlatformFeeKeeper{enforcement: tc.enforcement, exempt: tc.exempt}
+			inner := &mockMessenger{}
+			messenger := NewWasmMessageHandlerDecorator(keeper)(inner)
+
+			_, _, _, err := messenger.DispatchMsg(ctx, contract, "", tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectFee, keeper.charged[contract.String()])
+			require.Len(t, inner.dispatched, 1)
+			require.Equal(t, tc.expectSent, inner.dispatched[0].Bank.Send.Amount[0].Amount)
+		})
+	}
+}
+
+func TestWasmMessenger_DispatchMsg_NonBankMsg(t *testing.T) {
+	ctx := setupContext(t)
+	keeper := &mockPlatformFeeKeeper{enforcement: types.PlatformFeeEnforcement{WasmBankSends: true}}
+	inner := &mockMessenger{}
+	messenger := NewWasmMessageHandlerDecorator(keeper)(inner)
+
+	msg := wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{
+		Amount: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1000, "uxion")},
+	}}}
+	_, _, _, err := messenger.DispatchMsg(ctx, sdk.AccAddress("contract_address_1234"), "", msg)
+	require.NoError(t, err)
+	require.Empty(t, keeper.charged)
+	require.Equal(t, []wasmvmtypes.CosmosMsg{msg}, inner.dispatched)
+}
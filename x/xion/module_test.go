@@ -86,9 +86,13 @@ func setupTestKeeper(t *testing.T) (keeper.Keeper, sdk.Context, codec.JSONCodec)
 		paramSpace,
 		nil, // bankKeeper not needed for these tests
 		nil, // accountKeeper not needed for these tests
+		nil, // distrKeeper not needed for these tests
+		nil, // dkimKeeper not needed for these tests
 		nil, // wasmOpsKeeper not needed for these tests
 		nil, // wasmViewKeeper not needed for these tests
 		nil, // aaKeeper not needed for these tests
+		nil, // mintQuerier not needed for these tests
+		nil, // stakingKeeper not needed for these tests
 		"cosmos1gov",
 	)
 
@@ -0,0 +1,405 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/google/go-tpm/legacy/tpm2"
+	"github.com/google/uuid"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxAttestationTrustAnchors bounds the number of authenticator models
+	// governance can trust at once.
+	MaxAttestationTrustAnchors = 256
+	// MaxAttestationRootCertificates bounds the root certificates listed for a
+	// single authenticator model.
+	MaxAttestationRootCertificates = 8
+)
+
+// attestedFormats are the attestation statement formats accepted when a
+// registration requires attestation, with the check of their statement
+// against the leaf certificate. They all carry an x5c certificate chain that
+// can be checked against the trust anchors.
+//
+// The statements are not checked by the go-webauthn format handlers, which
+// check certificates against wall clock time or their own root certificates.
+// Registrations are verified in queries contracts can make, so the result
+// must only depend on the block time.
+var attestedFormats = map[protocol.AttestationFormat]func(attObj protocol.AttestationObject, clientDataHash []byte, leaf *x509.Certificate) error{
+	protocol.AttestationFormatPacked: verifyPackedStatement,
+	protocol.AttestationFormatTPM:    verifyTPMStatement,
+	protocol.AttestationFormatApple:  verifyAppleStatement,
+}
+
+var (
+	// oidExtensionSubjectAltName is marked critical on TPM attestation
+	// certificates, which carry the TPM identity as a directory name the x509
+	// package does not handle.
+	oidExtensionSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+	// oidFIDOGenCeAAGUID holds the AAGUID of the authenticator model in packed
+	// attestation certificates.
+	oidFIDOGenCeAAGUID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 45724, 1, 1, 4}
+	// oidTCGKpAIKCertificate is the extended key usage of TPM attestation
+	// identity key certificates.
+	oidTCGKpAIKCertificate = asn1.ObjectIdentifier{2, 23, 133, 8, 3}
+	// oidAppleNonce holds the nonce of Apple anonymous attestations.
+	oidAppleNonce = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 8, 2}
+)
+
+// AttestationTrustAnchorKey returns the store key of the trust anchor for an
+// AAGUID.
+func AttestationTrustAnchorKey(aaguid uuid.UUID) []byte {
+	return aaguid[:]
+}
+
+// ParseAAGUID parses an AAGUID in canonical UUID form.
+func ParseAAGUID(s string) (uuid.UUID, error) {
+	aaguid, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, errorsmod.Wrapf(ErrInvalidTrustAnchor, "invalid aaguid %q: %s", s, err)
+	}
+	if aaguid.String() != s {
+		return uuid.Nil, errorsmod.Wrapf(ErrInvalidTrustAnchor, "aaguid %q is not in canonical form %s", s, aaguid)
+	}
+	return aaguid, nil
+}
+
+// Validate checks that the anchor names a canonical AAGUID and lists at least
+// one parseable root certificate.
+func (a AttestationTrustAnchor) Validate() error {
+	if _, err := ParseAAGUID(a.Aaguid); err != nil {
+		return err
+	}
+	if len(a.RootCertificates) == 0 {
+		return errorsmod.Wrapf(ErrInvalidTrustAnchor, "aaguid %s has no root certificates", a.Aaguid)
+	}
+	if len(a.RootCertificates) > MaxAttestationRootCertificates {
+		return errorsmod.Wrapf(ErrInvalidTrustAnchor, "aaguid %s has %d root certificates, maximum is %d", a.Aaguid, len(a.RootCertificates), MaxAttestationRootCertificates)
+	}
+	for i, der := range a.RootCertificates {
+		if _, err := x509.ParseCertificate(der); err != nil {
+			return errorsmod.Wrapf(ErrInvalidTrustAnchor, "aaguid %s root certificate %d: %s", a.Aaguid, i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateAttestationTrustAnchors validates a complete set of trust anchors,
+// rejecting duplicate AAGUIDs.
+func ValidateAttestationTrustAnchors(anchors []AttestationTrustAnchor) error {
+	if len(anchors) > MaxAttestationTrustAnchors {
+		return errorsmod.Wrapf(ErrInvalidTrustAnchor, "%d trust anchors, maximum is %d", len(anchors), MaxAttestationTrustAnchors)
+	}
+	seen := make(map[string]struct{}, len(anchors))
+	for _, anchor := range anchors {
+		if err := anchor.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[anchor.Aaguid]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate trust anchor aaguid %s", anchor.Aaguid)
+		}
+		seen[anchor.Aaguid] = struct{}{}
+	}
+	return nil
+}
+
+// CredentialAAGUID returns the AAGUID of the authenticator that created a
+// credential.
+func CredentialAAGUID(data *protocol.ParsedCredentialCreationData) (uuid.UUID, error) {
+	aaguid, err := uuid.FromBytes(data.Response.AttestationObject.AuthData.AttData.AAGUID)
+	if err != nil {
+		return uuid.Nil, protocol.ErrInvalidAttestation.WithDetails("Invalid AAGUID")
+	}
+	return aaguid, nil
+}
+
+// VerifyAttestation checks that a registration carries a packed, TPM or Apple
+// attestation statement signed by a certificate chain that is valid at block
+// time and ends in one of the anchor's root certificates. It must run before
+// VerifyRegistration, which strips the attestation statement.
+func VerifyAttestation(data *protocol.ParsedCredentialCreationData, anchor AttestationTrustAnchor, blockTime time.Time) error {
+	aaguid, err := CredentialAAGUID(data)
+	if err != nil {
+		return errorsmod.Wrap(ErrUntrustedAttestation, err.Error())
+	}
+	if aaguid.String() != anchor.Aaguid {
+		return errorsmod.Wrapf(ErrUntrustedAttestation, "authenticator aaguid %s does not match trust anchor %s", aaguid, anchor.Aaguid)
+	}
+
+	attObj := data.Response.AttestationObject
+	format := protocol.AttestationFormat(attObj.Format)
+	verifyStatement, ok := attestedFormats[format]
+	if !ok {
+		return errorsmod.Wrapf(ErrUntrustedAttestation, "attestation format %q is not accepted", attObj.Format)
+	}
+
+	// Self and ECDAA attestations carry no certificate chain to anchor.
+	x5c, _ := attObj.AttStatement["x5c"].([]interface{})
+	if len(x5c) == 0 {
+		return errorsmod.Wrap(ErrUntrustedAttestation, "attestation has no certificate chain")
+	}
+	certs := make([]*x509.Certificate, 0, len(x5c))
+	for _, raw := range x5c {
+		der, ok := raw.([]byte)
+		if !ok {
+			return errorsmod.Wrap(ErrUntrustedAttestation, "malformed attestation certificate")
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return errorsmod.Wrapf(ErrUntrustedAttestation, "malformed attestation certificate: %s", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	leaf := certs[0]
+	clientDataHash := sha256.Sum256(data.Raw.AttestationResponse.ClientDataJSON)
+	if err := verifyStatement(attObj, clientDataHash[:], leaf); err != nil {
+		return errorsmod.Wrapf(ErrUntrustedAttestation, "invalid %s attestation statement: %s", format, err)
+	}
+
+	roots := x509.NewCertPool()
+	for _, der := range anchor.RootCertificates {
+		root, err := x509.ParseCertificate(der)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidTrustAnchor, "aaguid %s: %s", anchor.Aaguid, err)
+		}
+		roots.AddCert(root)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	if format == protocol.AttestationFormatTPM {
+		// The subject alternative name of a TPM certificate has been checked
+		// with the statement, so it must not make the chain verification fail.
+		unhandled := leaf.UnhandledCriticalExtensions[:0:0]
+		for _, oid := range leaf.UnhandledCriticalExtensions {
+			if !oid.Equal(oidExtensionSubjectAltName) {
+				unhandled = append(unhandled, oid)
+			}
+		}
+		leaf.UnhandledCriticalExtensions = unhandled
+	}
+
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   blockTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return errorsmod.Wrapf(ErrUntrustedAttestation, "certificate chain does not match trust anchor %s: %s", anchor.Aaguid, err)
+	}
+
+	return nil
+}
+
+// attToBeSigned returns the data an attestation statement is made over.
+func attToBeSigned(attObj protocol.AttestationObject, clientDataHash []byte) []byte {
+	return append(append([]byte{}, attObj.RawAuthData...), clientDataHash...)
+}
+
+// checkStatementSignature checks sig over signed with the key of cert, using
+// the COSE algorithm alg.
+func checkStatementSignature(cert *x509.Certificate, alg int64, signed, sig []byte) error {
+	sigAlg := webauthncose.SigAlgFromCOSEAlg(webauthncose.COSEAlgorithmIdentifier(alg))
+	if sigAlg == x509.UnknownSignatureAlgorithm {
+		return fmt.Errorf("unsupported algorithm %d", alg)
+	}
+	if err := cert.CheckSignature(sigAlg, signed, sig); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	return nil
+}
+
+// verifyPackedStatement verifies a packed attestation statement, see
+// https://www.w3.org/TR/webauthn/#sctn-packed-attestation.
+func verifyPackedStatement(attObj protocol.AttestationObject, clientDataHash []byte, leaf *x509.Certificate) error {
+	alg, ok := attObj.AttStatement["alg"].(int64)
+	if !ok {
+		return fmt.Errorf("missing alg")
+	}
+	sig, ok := attObj.AttStatement["sig"].([]byte)
+	if !ok {
+		return fmt.Errorf("missing sig")
+	}
+	if err := checkStatementSignature(leaf, alg, attToBeSigned(attObj, clientDataHash), sig); err != nil {
+		return err
+	}
+
+	if leaf.Version != 3 {
+		return fmt.Errorf("certificate version %d is not 3", leaf.Version)
+	}
+	if leaf.IsCA {
+		return fmt.Errorf("certificate is a CA")
+	}
+	for _, ext := range leaf.Extensions {
+		if !ext.Id.Equal(oidFIDOGenCeAAGUID) {
+			continue
+		}
+		if ext.Critical {
+			return fmt.Errorf("aaguid extension is critical")
+		}
+		var aaguid []byte
+		if _, err := asn1.Unmarshal(ext.Value, &aaguid); err != nil {
+			return fmt.Errorf("malformed aaguid extension: %w", err)
+		}
+		if !bytes.Equal(aaguid, attObj.AuthData.AttData.AAGUID) {
+			return fmt.Errorf("certificate aaguid does not match the authenticator")
+		}
+	}
+	return nil
+}
+
+// verifyTPMStatement verifies a TPM attestation statement, see
+// https://www.w3.org/TR/webauthn/#sctn-tpm-attestation.
+func verifyTPMStatement(attObj protocol.AttestationObject, clientDataHash []byte, leaf *x509.Certificate) error {
+	if ver, _ := attObj.AttStatement["ver"].(string); ver != "2.0" {
+		return fmt.Errorf("unsupported TPM version %q", ver)
+	}
+	alg, ok := attObj.AttStatement["alg"].(int64)
+	if !ok {
+		return fmt.Errorf("missing alg")
+	}
+	sig, ok := attObj.AttStatement["sig"].([]byte)
+	if !ok {
+		return fmt.Errorf("missing sig")
+	}
+	certInfoBytes, ok := attObj.AttStatement["certInfo"].([]byte)
+	if !ok {
+		return fmt.Errorf("missing certInfo")
+	}
+	pubAreaBytes, ok := attObj.AttStatement["pubArea"].([]byte)
+	if !ok {
+		return fmt.Errorf("missing pubArea")
+	}
+
+	// the TPM key must be the credential public key
+	pubArea, err := tpm2.DecodePublic(pubAreaBytes)
+	if err != nil {
+		return fmt.Errorf("malformed pubArea: %w", err)
+	}
+	credentialKey, err := webauthncose.ParsePublicKey(attObj.AuthData.AttData.CredentialPublicKey)
+	if err != nil {
+		return fmt.Errorf("malformed credential public key: %w", err)
+	}
+	switch key := credentialKey.(type) {
+	case webauthncose.EC2PublicKeyData:
+		if pubArea.ECCParameters == nil ||
+			pubArea.ECCParameters.CurveID != key.TPMCurveID() ||
+			!bytes.Equal(pubArea.ECCParameters.Point.XRaw, key.XCoord) ||
+			!bytes.Equal(pubArea.ECCParameters.Point.YRaw, key.YCoord) {
+			return fmt.Errorf("pubArea does not match the credential public key")
+		}
+	case webauthncose.RSAPublicKeyData:
+		if len(key.Exponent) < 3 {
+			return fmt.Errorf("malformed credential public key exponent")
+		}
+		exponent := uint32(key.Exponent[0]) + uint32(key.Exponent[1])<<8 + uint32(key.Exponent[2])<<16
+		if pubArea.RSAParameters == nil ||
+			!bytes.Equal(pubArea.RSAParameters.ModulusRaw, key.Modulus) ||
+			pubArea.RSAParameters.Exponent() != exponent {
+			return fmt.Errorf("pubArea does not match the credential public key")
+		}
+	default:
+		return fmt.Errorf("unsupported credential public key")
+	}
+
+	// certInfo must certify pubArea for this registration
+	certInfo, err := tpm2.DecodeAttestationData(certInfoBytes)
+	if err != nil {
+		return fmt.Errorf("malformed certInfo: %w", err)
+	}
+	if certInfo.Type != tpm2.TagAttestCertify || certInfo.AttestedCertifyInfo == nil {
+		return fmt.Errorf("certInfo is not a certification")
+	}
+	hasher := webauthncose.HasherFromCOSEAlg(webauthncose.COSEAlgorithmIdentifier(alg))
+	hasher.Write(attToBeSigned(attObj, clientDataHash))
+	if !bytes.Equal(certInfo.ExtraData, hasher.Sum(nil)) {
+		return fmt.Errorf("certInfo is not made over the registration")
+	}
+	if ok, err := certInfo.AttestedCertifyInfo.Name.MatchesPublic(pubArea); err != nil || !ok {
+		return fmt.Errorf("certInfo does not certify pubArea")
+	}
+	if err := checkStatementSignature(leaf, alg, certInfoBytes, sig); err != nil {
+		return err
+	}
+
+	if leaf.Version != 3 {
+		return fmt.Errorf("certificate version %d is not 3", leaf.Version)
+	}
+	if leaf.IsCA {
+		return fmt.Errorf("certificate is a CA")
+	}
+	if len(leaf.Subject.Names) != 0 {
+		return fmt.Errorf("certificate subject is not empty")
+	}
+	hasSAN := false
+	for _, ext := range leaf.Extensions {
+		if ext.Id.Equal(oidExtensionSubjectAltName) {
+			hasSAN = true
+		}
+	}
+	if !hasSAN {
+		return fmt.Errorf("certificate has no subject alternative name")
+	}
+	hasAIK := false
+	for _, usage := range leaf.UnknownExtKeyUsage {
+		if usage.Equal(oidTCGKpAIKCertificate) {
+			hasAIK = true
+		}
+	}
+	if !hasAIK {
+		return fmt.Errorf("certificate is not an attestation identity key certificate")
+	}
+	return nil
+}
+
+// appleNonce is the extension of Apple anonymous attestation certificates.
+type appleNonce struct {
+	Nonce []byte `asn1:"tag:1,explicit"`
+}
+
+// verifyAppleStatement verifies an Apple anonymous attestation statement, see
+// https://www.w3.org/TR/webauthn/#sctn-apple-anonymous-attestation. Apple's
+// root must be listed in the trust anchor.
+func verifyAppleStatement(attObj protocol.AttestationObject, clientDataHash []byte, leaf *x509.Certificate) error {
+	nonce := sha256.Sum256(attToBeSigned(attObj, clientDataHash))
+	var ext appleNonce
+	for _, certExt := range leaf.Extensions {
+		if certExt.Id.Equal(oidAppleNonce) {
+			if _, err := asn1.Unmarshal(certExt.Value, &ext); err != nil {
+				return fmt.Errorf("malformed nonce extension: %w", err)
+			}
+		}
+	}
+	if !bytes.Equal(ext.Nonce, nonce[:]) {
+		return fmt.Errorf("certificate nonce does not match the registration")
+	}
+
+	credentialKey, err := webauthncose.ParsePublicKey(attObj.AuthData.AttData.CredentialPublicKey)
+	if err != nil {
+		return fmt.Errorf("malformed credential public key: %w", err)
+	}
+	key, ok := credentialKey.(webauthncose.EC2PublicKeyData)
+	leafKey, leafOK := leaf.PublicKey.(*ecdsa.PublicKey)
+	if !ok || !leafOK ||
+		new(big.Int).SetBytes(key.XCoord).Cmp(leafKey.X) != 0 ||
+		new(big.Int).SetBytes(key.YCoord).Cmp(leafKey.Y) != 0 {
+		return fmt.Errorf("certificate key does not match the credential public key")
+	}
+	return nil
+}
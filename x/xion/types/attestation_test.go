@@ -0,0 +1,333 @@
+package types_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/google/go-tpm/legacy/tpm2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+var testAAGUID = uuid.MustParse("cb69481e-8ff7-4039-93ec-0a2729a154a8")
+
+type testAttestationCA struct {
+	certDER []byte
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+}
+
+func newTestAttestationCA(t *testing.T, notBefore, notAfter time.Time) testAttestationCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Attestation Root"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+
+	return testAttestationCA{certDER: certDER, cert: cert, key: key}
+}
+
+// testRegistration is the authenticator data and client data of a
+// registration, before it is attested.
+type testRegistration struct {
+	authData       []byte
+	credKey        *ecdsa.PrivateKey
+	clientDataJSON []byte
+	clientDataHash [32]byte
+}
+
+func newTestRegistration(t *testing.T, aaguid uuid.UUID) testRegistration {
+	credKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	credPubKey, err := webauthncbor.Marshal(map[int]interface{}{
+		1:  2,  // kty: EC2
+		3:  -7, // alg: ES256
+		-1: 1,  // crv: P-256
+		-2: credKey.X.FillBytes(make([]byte, 32)),
+		-3: credKey.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(t, err)
+
+	credID := make([]byte, 16)
+	_, err = rand.Read(credID)
+	require.NoError(t, err)
+
+	authData := &bytes.Buffer{}
+	rpIDHash := sha256.Sum256([]byte("test.example"))
+	authData.Write(rpIDHash[:])
+	authData.WriteByte(0x41)           // flags: user present, attested credential data
+	authData.Write([]byte{0, 0, 0, 0}) // counter
+	authData.Write(aaguid[:])
+	authData.Write([]byte{0x00, byte(len(credID))})
+	authData.Write(credID)
+	authData.Write(credPubKey)
+
+	clientDataJSON, err := json.Marshal(map[string]string{
+		"type":      "webauthn.create",
+		"challenge": "test_challenge_123",
+		"origin":    "https://test.example",
+	})
+	require.NoError(t, err)
+
+	return testRegistration{
+		authData:       authData.Bytes(),
+		credKey:        credKey,
+		clientDataJSON: clientDataJSON,
+		clientDataHash: sha256.Sum256(clientDataJSON),
+	}
+}
+
+// attToBeSigned returns the data the attestation statement is made over.
+func (r testRegistration) attToBeSigned() []byte {
+	return append(append([]byte{}, r.authData...), r.clientDataHash[:]...)
+}
+
+// parse returns the registration attested with attStmt in format.
+func (r testRegistration) parse(t *testing.T, format string, attStmt map[string]interface{}) *protocol.ParsedCredentialCreationData {
+	attObj, err := webauthncbor.Marshal(map[string]interface{}{
+		"fmt":      format,
+		"authData": r.authData,
+		"attStmt":  attStmt,
+	})
+	require.NoError(t, err)
+
+	parsed, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(buildCredentialCreationJSON(attObj, r.clientDataJSON)))
+	require.NoError(t, err)
+	return parsed
+}
+
+// buildAttestedRegistration returns a packed attestation signed by a leaf
+// certificate issued by ca, for a credential created by the aaguid
+// authenticator.
+func buildAttestedRegistration(t *testing.T, ca testAttestationCA, aaguid uuid.UUID, notBefore, notAfter time.Time) *protocol.ParsedCredentialCreationData {
+	attKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject: pkix.Name{
+			Country:            []string{"US"},
+			Organization:       []string{"Test Authenticator"},
+			OrganizationalUnit: []string{"Authenticator Attestation"},
+			CommonName:         "Test Attestation Leaf",
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  false,
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &attKey.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	registration := newTestRegistration(t, aaguid)
+	digest := sha256.Sum256(registration.attToBeSigned())
+	sig, err := ecdsa.SignASN1(rand.Reader, attKey, digest[:])
+	require.NoError(t, err)
+
+	return registration.parse(t, "packed", map[string]interface{}{
+		"alg": -7,
+		"sig": sig,
+		"x5c": []interface{}{leafDER},
+	})
+}
+
+// buildTPMRegistration returns a TPM attestation signed by an attestation
+// identity key certificate issued by ca.
+func buildTPMRegistration(t *testing.T, ca testAttestationCA, aaguid uuid.UUID, notBefore, notAfter time.Time) *protocol.ParsedCredentialCreationData {
+	aikKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	// the TPM identity is a directory name in a critical subject alternative name
+	tpmName, err := asn1.Marshal(pkix.Name{ExtraNames: []pkix.AttributeTypeAndValue{
+		{Type: asn1.ObjectIdentifier{2, 23, 133, 2, 1}, Value: "id:54534700"},
+		{Type: asn1.ObjectIdentifier{2, 23, 133, 2, 2}, Value: "Test TPM"},
+		{Type: asn1.ObjectIdentifier{2, 23, 133, 2, 3}, Value: "id:00010002"},
+	}}.ToRDNSequence())
+	require.NoError(t, err)
+	san, err := asn1.Marshal([]asn1.RawValue{{Class: asn1.ClassContextSpecific, Tag: 4, IsCompound: true, Bytes: tpmName}})
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(3),
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		UnknownExtKeyUsage:    []asn1.ObjectIdentifier{{2, 23, 133, 8, 3}},
+		BasicConstraintsValid: true,
+		ExtraExtensions:       []pkix.Extension{{Id: asn1.ObjectIdentifier{2, 5, 29, 17}, Critical: true, Value: san}},
+	}
+	aikDER, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &aikKey.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	registration := newTestRegistration(t, aaguid)
+	pubArea := tpm2.Public{
+		Type:       tpm2.AlgECC,
+		NameAlg:    tpm2.AlgSHA256,
+		Attributes: tpm2.FlagSign,
+		ECCParameters: &tpm2.ECCParams{
+			Sign:    &tpm2.SigScheme{Alg: tpm2.AlgECDSA, Hash: tpm2.AlgSHA256},
+			CurveID: tpm2.CurveNISTP256,
+			Point: tpm2.ECPoint{
+				XRaw: registration.credKey.X.FillBytes(make([]byte, 32)),
+				YRaw: registration.credKey.Y.FillBytes(make([]byte, 32)),
+			},
+		},
+	}
+	pubAreaBytes, err := pubArea.Encode()
+	require.NoError(t, err)
+	name, err := pubArea.Name()
+	require.NoError(t, err)
+
+	extraData := sha256.Sum256(registration.attToBeSigned())
+	certInfo, err := tpm2.AttestationData{
+		Magic:               0xff544347, // TPM_GENERATED_VALUE
+		Type:                tpm2.TagAttestCertify,
+		QualifiedSigner:     name,
+		ExtraData:           extraData[:],
+		AttestedCertifyInfo: &tpm2.CertifyInfo{Name: name, QualifiedName: name},
+	}.Encode()
+	require.NoError(t, err)
+	digest := sha256.Sum256(certInfo)
+	sig, err := ecdsa.SignASN1(rand.Reader, aikKey, digest[:])
+	require.NoError(t, err)
+
+	return registration.parse(t, "tpm", map[string]interface{}{
+		"ver":      "2.0",
+		"alg":      -7,
+		"sig":      sig,
+		"x5c":      []interface{}{aikDER},
+		"certInfo": certInfo,
+		"pubArea":  pubAreaBytes,
+	})
+}
+
+func TestAttestationTrustAnchorValidate(t *testing.T) {
+	now := time.Now()
+	ca := newTestAttestationCA(t, now.Add(-time.Hour), now.Add(24*time.Hour))
+	valid := types.AttestationTrustAnchor{
+		Aaguid:           testAAGUID.String(),
+		Description:      "Test Authenticator",
+		RootCertificates: [][]byte{ca.certDER},
+	}
+	require.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.Aaguid = "not-a-uuid"
+	require.ErrorIs(t, invalid.Validate(), types.ErrInvalidTrustAnchor)
+
+	invalid = valid
+	invalid.Aaguid = "CB69481E-8FF7-4039-93EC-0A2729A154A8"
+	require.ErrorIs(t, invalid.Validate(), types.ErrInvalidTrustAnchor)
+
+	invalid = valid
+	invalid.RootCertificates = nil
+	require.ErrorIs(t, invalid.Validate(), types.ErrInvalidTrustAnchor)
+
+	invalid = valid
+	invalid.RootCertificates = [][]byte{[]byte("garbage")}
+	require.ErrorIs(t, invalid.Validate(), types.ErrInvalidTrustAnchor)
+
+	require.NoError(t, types.ValidateAttestationTrustAnchors([]types.AttestationTrustAnchor{valid}))
+	require.Error(t, types.ValidateAttestationTrustAnchors([]types.AttestationTrustAnchor{valid, valid}))
+}
+
+func TestVerifyAttestation(t *testing.T) {
+	now := time.Now()
+	ca := newTestAttestationCA(t, now.Add(-time.Hour), now.Add(24*time.Hour))
+	anchor := types.AttestationTrustAnchor{
+		Aaguid:           testAAGUID.String(),
+		RootCertificates: [][]byte{ca.certDER},
+	}
+
+	t.Run("trusted chain", func(t *testing.T) {
+		data := buildAttestedRegistration(t, ca, testAAGUID, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, types.VerifyAttestation(data, anchor, now))
+
+		aaguid, err := types.CredentialAAGUID(data)
+		require.NoError(t, err)
+		require.Equal(t, testAAGUID, aaguid)
+	})
+
+	t.Run("aaguid does not match anchor", func(t *testing.T) {
+		data := buildAttestedRegistration(t, ca, uuid.New(), now.Add(-time.Hour), now.Add(time.Hour))
+		require.ErrorIs(t, types.VerifyAttestation(data, anchor, now), types.ErrUntrustedAttestation)
+	})
+
+	t.Run("chain ends in another root", func(t *testing.T) {
+		other := newTestAttestationCA(t, now.Add(-time.Hour), now.Add(24*time.Hour))
+		data := buildAttestedRegistration(t, other, testAAGUID, now.Add(-time.Hour), now.Add(time.Hour))
+		require.ErrorIs(t, types.VerifyAttestation(data, anchor, now), types.ErrUntrustedAttestation)
+	})
+
+	t.Run("leaf expired at block time", func(t *testing.T) {
+		data := buildAttestedRegistration(t, ca, testAAGUID, now.Add(-time.Hour), now.Add(time.Hour))
+		require.ErrorIs(t, types.VerifyAttestation(data, anchor, now.Add(2*time.Hour)), types.ErrUntrustedAttestation)
+	})
+
+	t.Run("none attestation", func(t *testing.T) {
+		data := buildAttestedRegistration(t, ca, testAAGUID, now.Add(-time.Hour), now.Add(time.Hour))
+		data.Response.AttestationObject.Format = "none"
+		require.ErrorIs(t, types.VerifyAttestation(data, anchor, now), types.ErrUntrustedAttestation)
+	})
+
+	t.Run("self attestation", func(t *testing.T) {
+		data := buildAttestedRegistration(t, ca, testAAGUID, now.Add(-time.Hour), now.Add(time.Hour))
+		delete(data.Response.AttestationObject.AttStatement, "x5c")
+		require.ErrorIs(t, types.VerifyAttestation(data, anchor, now), types.ErrUntrustedAttestation)
+	})
+
+	t.Run("tampered signature", func(t *testing.T) {
+		data := buildAttestedRegistration(t, ca, testAAGUID, now.Add(-time.Hour), now.Add(time.Hour))
+		data.Raw.AttestationResponse.ClientDataJSON = append(data.Raw.AttestationResponse.ClientDataJSON, ' ')
+		require.ErrorIs(t, types.VerifyAttestation(data, anchor, now), types.ErrUntrustedAttestation)
+	})
+
+	t.Run("only block time counts", func(t *testing.T) {
+		// the chain has expired by the wall clock but is valid at block time
+		blockTime := now.Add(-48 * time.Hour)
+		oldCA := newTestAttestationCA(t, blockTime.Add(-time.Hour), blockTime.Add(time.Hour))
+		oldAnchor := types.AttestationTrustAnchor{Aaguid: testAAGUID.String(), RootCertificates: [][]byte{oldCA.certDER}}
+
+		data := buildAttestedRegistration(t, oldCA, testAAGUID, blockTime.Add(-time.Hour), blockTime.Add(time.Hour))
+		require.NoError(t, types.VerifyAttestation(data, oldAnchor, blockTime))
+		require.ErrorIs(t, types.VerifyAttestation(data, oldAnchor, now), types.ErrUntrustedAttestation)
+
+		data = buildTPMRegistration(t, oldCA, testAAGUID, blockTime.Add(-time.Hour), blockTime.Add(time.Hour))
+		require.NoError(t, types.VerifyAttestation(data, oldAnchor, blockTime))
+	})
+
+	t.Run("tpm attestation", func(t *testing.T) {
+		data := buildTPMRegistration(t, ca, testAAGUID, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, types.VerifyAttestation(data, anchor, now))
+
+		data = buildTPMRegistration(t, ca, testAAGUID, now.Add(-time.Hour), now.Add(time.Hour))
+		data.Raw.AttestationResponse.ClientDataJSON = append(data.Raw.AttestationResponse.ClientDataJSON, ' ')
+		require.ErrorIs(t, types.VerifyAttestation(data, anchor, now), types.ErrUntrustedAttestation)
+	})
+}
@@ -21,10 +21,27 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "xion/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgSetPlatformPercentage{}, "xion/MsgSetPlatformPercentage")
 	legacy.RegisterAminoMsg(cdc, &MsgSetPlatformMinimum{}, "xion/MsgSetPlatformMinimum")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPlatformFeeSplit{}, "xion/MsgSetPlatformFeeSplit")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPlatformFeeEnforcement{}, "xion/MsgSetPlatformFeeEnforcement")
+	legacy.RegisterAminoMsg(cdc, &MsgCreatePaymentSchedule{}, "xion/MsgCreatePaymentSchedule")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelPaymentSchedule{}, "xion/MsgCancelPaymentSchedule")
+	legacy.RegisterAminoMsg(cdc, &MsgPausePaymentSchedule{}, "xion/MsgPausePaymentSchedule")
+	legacy.RegisterAminoMsg(cdc, &MsgResumePaymentSchedule{}, "xion/MsgResumePaymentSchedule")
+	legacy.RegisterAminoMsg(cdc, &MsgSendEscrow{}, "xion/MsgSendEscrow")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimEscrow{}, "xion/MsgClaimEscrow")
+	legacy.RegisterAminoMsg(cdc, &MsgRefundEscrow{}, "xion/MsgRefundEscrow")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAttestationTrustAnchors{}, "xion/MsgSetAttestationTrustAnchors")
+	legacy.RegisterAminoMsg(cdc, &MsgVerifyWebAuthNAssertion{}, "xion/MsgVerifyWebAuthNAssertion")
+	legacy.RegisterAminoMsg(cdc, &MsgSetRelyingPartyProfile{}, "xion/MsgSetRelyingPartyProfile")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveRelyingPartyProfile{}, "xion/MsgRemoveRelyingPartyProfile")
+	legacy.RegisterAminoMsg(cdc, &MsgSetEmissionsSchedule{}, "xion/MsgSetEmissionsSchedule")
 	legacy.RegisterAminoMsg(cdc, &xionMintTypes.MsgUpdateParams{}, "xion/x/mint/MsgUpdateParams")
 
 	cdc.RegisterConcrete(&AuthzAllowance{}, "xion/AuthzAllowance", nil)
 	cdc.RegisterConcrete(&ContractsAllowance{}, "xion/ContractsAllowance", nil)
+	cdc.RegisterConcrete(&ContractMethodsAllowance{}, "xion/ContractMethodsAllowance", nil)
+	cdc.RegisterConcrete(&RateLimitedAllowance{}, "xion/RateLimitedAllowance", nil)
+	cdc.RegisterConcrete(&MsgFilterAllowance{}, "xion/MsgFilterAllowance", nil)
 	cdc.RegisterConcrete(&MultiAnyAllowance{}, "xion/MultiAnyAllowance", nil)
 	cdc.RegisterConcrete(xionMintTypes.Params{}, "xion/x/mint/Params", nil)
 }
@@ -35,6 +52,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgMultiSend{},
 		&MsgSetPlatformPercentage{},
 		&MsgSetPlatformMinimum{},
+		&MsgSetPlatformFeeSplit{},
+		&MsgSetPlatformFeeEnforcement{},
+		&MsgCreatePaymentSchedule{},
+		&MsgCancelPaymentSchedule{},
+		&MsgPausePaymentSchedule{},
+		&MsgResumePaymentSchedule{},
+		&MsgSendEscrow{},
+		&MsgClaimEscrow{},
+		&MsgRefundEscrow{},
+		&MsgSetAttestationTrustAnchors{},
+		&MsgVerifyWebAuthNAssertion{},
+		&MsgSetRelyingPartyProfile{},
+		&MsgRemoveRelyingPartyProfile{},
+		&MsgSetEmissionsSchedule{},
 		&xionMintTypes.MsgUpdateParams{},
 	)
 
@@ -43,6 +74,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*feegrant.FeeAllowanceI)(nil),
 		&AuthzAllowance{},
 		&ContractsAllowance{},
+		&ContractMethodsAllowance{},
+		&RateLimitedAllowance{},
+		&MsgFilterAllowance{},
 		&MultiAnyAllowance{},
 	)
 
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// MaxEmissionsSegments bounds the number of segments of an emissions schedule.
+const MaxEmissionsSegments = 64
+
+// Emissions event attributes, alongside the x/mint event attributes.
+const (
+	AttributeKeyAnnualIssuance = "annual_issuance"
+	AttributeKeySupplyCap      = "supply_cap"
+)
+
+// DefaultEmissionsSchedule returns an empty schedule, which keeps the staking
+// inflation curve.
+func DefaultEmissionsSchedule() EmissionsSchedule {
+	return EmissionsSchedule{
+		SupplyCap:    math.ZeroInt(),
+		BurnFraction: math.LegacyOneDec(),
+	}
+}
+
+// Enabled reports whether the schedule replaces the staking inflation curve.
+func (s EmissionsSchedule) Enabled() bool {
+	return len(s.Segments) > 0
+}
+
+// Validate checks that segments start at increasing non-negative heights with
+// non-negative issuance, the supply cap is non-negative and the burn fraction
+// is between zero and one. Both must be set once the schedule has segments.
+func (s EmissionsSchedule) Validate() error {
+	if len(s.Segments) > MaxEmissionsSegments {
+		return errorsmod.Wrapf(ErrInvalidEmissionsSchedule, "%d segments, maximum is %d", len(s.Segments), MaxEmissionsSegments)
+	}
+	for i, segment := range s.Segments {
+		if segment.StartHeight < 0 {
+			return errorsmod.Wrapf(ErrInvalidEmissionsSchedule, "segment %d starts at negative height %d", i, segment.StartHeight)
+		}
+		if i > 0 && segment.StartHeight <= s.Segments[i-1].StartHeight {
+			return errorsmod.Wrapf(ErrInvalidEmissionsSchedule, "segment %d does not start after segment %d", i, i-1)
+		}
+		if segment.AnnualIssuance.IsNil() || segment.AnnualIssuance.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidEmissionsSchedule, "segment %d has invalid annual issuance", i)
+		}
+	}
+	if s.Enabled() && (s.SupplyCap.IsNil() || s.BurnFraction.IsNil()) {
+		return errorsmod.Wrap(ErrInvalidEmissionsSchedule, "supply cap and burn fraction must be set")
+	}
+	if !s.SupplyCap.IsNil() && s.SupplyCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidEmissionsSchedule, "negative supply cap %s", s.SupplyCap)
+	}
+	if !s.BurnFraction.IsNil() && (s.BurnFraction.IsNegative() || s.BurnFraction.GT(math.LegacyOneDec())) {
+		return errorsmod.Wrapf(ErrInvalidEmissionsSchedule, "burn fraction %s is not between 0 and 1", s.BurnFraction)
+	}
+	return nil
+}
+
+// segmentAt returns the index of the segment in effect at height, or -1
+// before the first segment starts.
+func (s EmissionsSchedule) segmentAt(height int64) int {
+	return sort.Search(len(s.Segments), func(i int) bool {
+		return s.Segments[i].StartHeight > height
+	}) - 1
+}
+
+// AnnualIssuance returns the annual issuance in effect at height.
+func (s EmissionsSchedule) AnnualIssuance(height int64) math.Int {
+	i := s.segmentAt(height)
+	if i < 0 {
+		return math.ZeroInt()
+	}
+	return s.Segments[i].AnnualIssuance
+}
+
+// BlockIssuance returns the amount issued by the block at height, rounded
+// down.
+func (s EmissionsSchedule) BlockIssuance(height int64, blocksPerYear uint64) math.Int {
+	if blocksPerYear == 0 {
+		return math.ZeroInt()
+	}
+	return s.AnnualIssuance(height).Quo(math.NewIntFromUint64(blocksPerYear))
+}
+
+// CapIssuance limits an amount to be minted so the supply does not exceed the
+// supply cap.
+func (s EmissionsSchedule) CapIssuance(supply, amount math.Int) math.Int {
+	if s.SupplyCap.IsNil() || s.SupplyCap.IsZero() {
+		return amount
+	}
+	room := s.SupplyCap.Sub(supply)
+	if !room.IsPositive() {
+		return math.ZeroInt()
+	}
+	return math.MinInt(amount, room)
+}
+
+// BurnAmount returns the share of the collected fees beyond the block
+// issuance to burn, rounded down.
+func (s EmissionsSchedule) BurnAmount(excess math.Int) math.Int {
+	if s.BurnFraction.IsNil() {
+		return math.ZeroInt()
+	}
+	return s.BurnFraction.MulInt(excess).TruncateInt()
+}
+
+// Issuance returns the amount issued by the blocks after fromHeight up to and
+// including toHeight, starting from supply and assuming no fees are
+// collected.
+func (s EmissionsSchedule) Issuance(supply math.Int, fromHeight, toHeight int64, blocksPerYear uint64) math.Int {
+	total := math.ZeroInt()
+	if blocksPerYear == 0 {
+		return total
+	}
+	for height := fromHeight + 1; height <= toHeight; {
+		i := s.segmentAt(height)
+		end := toHeight
+		if i+1 < len(s.Segments) && s.Segments[i+1].StartHeight-1 < end {
+			end = s.Segments[i+1].StartHeight - 1
+		}
+		blocks := math.NewInt(end - height + 1)
+		total = total.Add(s.BlockIssuance(height, blocksPerYear).Mul(blocks))
+		height = end + 1
+	}
+	return s.CapIssuance(supply, total)
+}
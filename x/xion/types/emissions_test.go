@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func testSchedule() types.EmissionsSchedule {
+	return types.EmissionsSchedule{
+		Segments: []types.EmissionsSegment{
+			{StartHeight: 100, AnnualIssuance: math.NewInt(1_000)},
+			{StartHeight: 200, AnnualIssuance: math.NewInt(500)},
+			{StartHeight: 300, AnnualIssuance: math.ZeroInt()},
+		},
+		SupplyCap:    math.ZeroInt(),
+		BurnFraction: math.LegacyNewDecWithPrec(25, 2),
+	}
+}
+
+func TestEmissionsScheduleValidate(t *testing.T) {
+	require.NoError(t, types.DefaultEmissionsSchedule().Validate())
+	require.NoError(t, types.EmissionsSchedule{}.Validate())
+	require.NoError(t, testSchedule().Validate())
+
+	for name, mutate := range map[string]func(s *types.EmissionsSchedule){
+		"negative start":     func(s *types.EmissionsSchedule) { s.Segments[0].StartHeight = -1 },
+		"unordered segments": func(s *types.EmissionsSchedule) { s.Segments[1].StartHeight = 100 },
+		"negative issuance":  func(s *types.EmissionsSchedule) { s.Segments[1].AnnualIssuance = math.NewInt(-1) },
+		"unset issuance":     func(s *types.EmissionsSchedule) { s.Segments[1].AnnualIssuance = math.Int{} },
+		"negative cap":       func(s *types.EmissionsSchedule) { s.SupplyCap = math.NewInt(-1) },
+		"unset cap":          func(s *types.EmissionsSchedule) { s.SupplyCap = math.Int{} },
+		"burn above one":     func(s *types.EmissionsSchedule) { s.BurnFraction = math.LegacyNewDecWithPrec(11, 1) },
+		"negative burn":      func(s *types.EmissionsSchedule) { s.BurnFraction = math.LegacyNewDec(-1) },
+		"unset burn":         func(s *types.EmissionsSchedule) { s.BurnFraction = math.LegacyDec{} },
+		"too many segments": func(s *types.EmissionsSchedule) {
+			s.Segments = make([]types.EmissionsSegment, types.MaxEmissionsSegments+1)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			schedule := testSchedule()
+			mutate(&schedule)
+			require.ErrorIs(t, schedule.Validate(), types.ErrInvalidEmissionsSchedule)
+		})
+	}
+}
+
+func TestEmissionsScheduleIssuance(t *testing.T) {
+	schedule := testSchedule()
+
+	require.Equal(t, math.ZeroInt(), schedule.AnnualIssuance(99))
+	require.Equal(t, math.NewInt(1_000), schedule.AnnualIssuance(100))
+	require.Equal(t, math.NewInt(1_000), schedule.AnnualIssuance(199))
+	require.Equal(t, math.NewInt(500), schedule.AnnualIssuance(200))
+	require.Equal(t, math.ZeroInt(), schedule.AnnualIssuance(1_000))
+
+	require.Equal(t, math.NewInt(100), schedule.BlockIssuance(150, 10))
+	require.Equal(t, math.NewInt(33), schedule.BlockIssuance(150, 30))
+	require.Equal(t, math.ZeroInt(), schedule.BlockIssuance(150, 0))
+
+	// Heights 91 to 99 issue nothing, 100 to 199 issue 100 and 200 to 299
+	// issue 50 per block
+	require.Equal(t, math.NewInt(100*100+100*50), schedule.Issuance(math.ZeroInt(), 90, 400, 10))
+	require.Equal(t, math.NewInt(100+50), schedule.Issuance(math.ZeroInt(), 198, 200, 10))
+	require.Equal(t, math.ZeroInt(), schedule.Issuance(math.ZeroInt(), 200, 200, 10))
+
+	schedule.SupplyCap = math.NewInt(1_000)
+	require.Equal(t, math.NewInt(200), schedule.Issuance(math.NewInt(800), 90, 400, 10))
+	require.Equal(t, math.ZeroInt(), schedule.CapIssuance(math.NewInt(1_200), math.NewInt(10)))
+	require.Equal(t, math.NewInt(10), schedule.CapIssuance(math.NewInt(500), math.NewInt(10)))
+
+	require.Equal(t, math.NewInt(25), schedule.BurnAmount(math.NewInt(101)))
+}
@@ -8,10 +8,30 @@ const (
 )
 
 var (
-	ErrNoAllowedContracts  = errorsmod.Register(DefaultCodespace, 2, "no contract addresses specified")
-	ErrNoValidAllowances   = errorsmod.Register(DefaultCodespace, 3, "none of the allowances accepted the msg")
-	ErrInconsistentExpiry  = errorsmod.Register(DefaultCodespace, 4, "multi allowances must all expire together")
-	ErrMinimumNotMet       = errorsmod.Register(DefaultCodespace, 5, "minimum send amount not met")
-	ErrNoValidWebAuth      = errorsmod.Register(DefaultCodespace, 6, "Web auth is not valid")
-	ErrWebAuthDataTooLarge = errorsmod.Register(DefaultCodespace, 7, "WebAuth data exceeds maximum allowed size")
+	ErrNoAllowedContracts          = errorsmod.Register(DefaultCodespace, 2, "no contract addresses specified")
+	ErrNoValidAllowances           = errorsmod.Register(DefaultCodespace, 3, "none of the allowances accepted the msg")
+	ErrInconsistentExpiry          = errorsmod.Register(DefaultCodespace, 4, "multi allowances must all expire together")
+	ErrMinimumNotMet               = errorsmod.Register(DefaultCodespace, 5, "minimum send amount not met")
+	ErrNoValidWebAuth              = errorsmod.Register(DefaultCodespace, 6, "Web auth is not valid")
+	ErrWebAuthDataTooLarge         = errorsmod.Register(DefaultCodespace, 7, "WebAuth data exceeds maximum allowed size")
+	ErrInvalidFeeSplit             = errorsmod.Register(DefaultCodespace, 8, "invalid platform fee split")
+	ErrInvalidSchedule             = errorsmod.Register(DefaultCodespace, 9, "invalid payment schedule")
+	ErrScheduleNotFound            = errorsmod.Register(DefaultCodespace, 10, "payment schedule not found")
+	ErrTooManySchedules            = errorsmod.Register(DefaultCodespace, 11, "too many payment schedules")
+	ErrInvalidEscrow               = errorsmod.Register(DefaultCodespace, 12, "invalid escrow")
+	ErrEscrowNotFound              = errorsmod.Register(DefaultCodespace, 13, "escrow not found")
+	ErrEscrowExpired               = errorsmod.Register(DefaultCodespace, 14, "escrow expired")
+	ErrEscrowNotExpired            = errorsmod.Register(DefaultCodespace, 15, "escrow not expired")
+	ErrInvalidEscrowClaim          = errorsmod.Register(DefaultCodespace, 16, "invalid escrow claim")
+	ErrInvalidContractMethods      = errorsmod.Register(DefaultCodespace, 17, "invalid contract methods")
+	ErrInvalidRateLimit            = errorsmod.Register(DefaultCodespace, 18, "invalid rate limit")
+	ErrRateLimitExceeded           = errorsmod.Register(DefaultCodespace, 19, "fee allowance rate limit exceeded")
+	ErrInvalidMsgFilter            = errorsmod.Register(DefaultCodespace, 20, "invalid message filter")
+	ErrInvalidTrustAnchor          = errorsmod.Register(DefaultCodespace, 21, "invalid attestation trust anchor")
+	ErrUntrustedAttestation        = errorsmod.Register(DefaultCodespace, 22, "attestation is not trusted")
+	ErrWebAuthNCloneDetected       = errorsmod.Register(DefaultCodespace, 23, "webauthn authenticator may be cloned")
+	ErrWebAuthNFlagsChanged        = errorsmod.Register(DefaultCodespace, 24, "webauthn backup eligibility changed")
+	ErrInvalidRelyingPartyProfile  = errorsmod.Register(DefaultCodespace, 25, "invalid relying party profile")
+	ErrRelyingPartyProfileNotFound = errorsmod.Register(DefaultCodespace, 26, "relying party profile not found")
+	ErrInvalidEmissionsSchedule    = errorsmod.Register(DefaultCodespace, 27, "invalid emissions schedule")
 )
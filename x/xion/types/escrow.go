@@ -0,0 +1,145 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// escrow event types and attributes
+const (
+	EventTypeEscrowCreated  = "escrow_created"
+	EventTypeEscrowClaimed  = "escrow_claimed"
+	EventTypeEscrowRefunded = "escrow_refunded"
+
+	AttributeKeyEscrowID = "escrow_id"
+
+	EscrowRefundReasonExpired  = "expired"
+	EscrowRefundReasonDeclined = "declined"
+)
+
+const (
+	// MaxEscrowTimeoutBlocks bounds how long funds can stay in escrow, about a
+	// year of 6 second blocks.
+	MaxEscrowTimeoutBlocks = 5_256_000
+	// MaxEscrowEmailHashSize bounds the size of an escrow email hash.
+	MaxEscrowEmailHashSize = 128
+	// MaxEscrowRefundsPerBlock bounds the expired escrows refunded in a single
+	// BeginBlock; the rest are refunded in later blocks.
+	MaxEscrowRefundsPerBlock = 200
+)
+
+// EscrowKey returns the store key of an escrow.
+func EscrowKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// EscrowAccountIndexKey returns the key of an escrow in the sender or
+// recipient index, relative to the index prefix.
+func EscrowAccountIndexKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(address.MustLengthPrefix(addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// EscrowAccountIndexPrefix returns the prefix of all escrows of an account in
+// the sender or recipient index, relative to the index prefix.
+func EscrowAccountIndexPrefix(addr sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(addr)
+}
+
+// EscrowExpiryQueueKey returns the key of an escrow in the expiry queue,
+// relative to the queue prefix.
+func EscrowExpiryQueueKey(expirationHeight int64, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expirationHeight)), sdk.Uint64ToBigEndian(id)...) //nolint:gosec // heights are positive
+}
+
+// ParseEscrowExpiryQueueKey returns the escrow id of an expiry queue key.
+func ParseEscrowExpiryQueueKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// EscrowSecretHash returns the hash an escrow commits to for the compressed
+// secp256k1 public key of its secret key.
+func EscrowSecretHash(pubKey []byte) []byte {
+	hash := sha256.Sum256(pubKey)
+	return hash[:]
+}
+
+// EscrowClaimCommand returns the bytes a secret proof must sign, and an email
+// proof must commit to as its tx bytes, to claim an escrow. It binds the
+// proof to the destination.
+func EscrowClaimCommand(id uint64, destination string) []byte {
+	return []byte(fmt.Sprintf("claim escrow %d to %s", id, destination))
+}
+
+// Validate performs stateless validation of an escrow.
+func (e Escrow) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Sender); err != nil {
+		return errorsmod.Wrapf(ErrInvalidEscrow, "invalid sender: %s", err)
+	}
+	if e.ExpirationHeight <= 0 {
+		return errorsmod.Wrap(ErrInvalidEscrow, "expiration height must be positive")
+	}
+	return validateEscrowTerms(e.Amount, e.Recipient, e.SecretHash, e.EmailHash)
+}
+
+// VerifySecretClaim returns true if proof claims the escrow for destination.
+// Escrows without a secret hash never match, in particular email escrows,
+// whose email hash is public.
+func (e Escrow) VerifySecretClaim(destination string, proof SecretClaimProof) bool {
+	if len(e.SecretHash) == 0 || len(proof.PubKey) != secp256k1.PubKeySize {
+		return false
+	}
+	if !bytes.Equal(EscrowSecretHash(proof.PubKey), e.SecretHash) {
+		return false
+	}
+	pubKey := &secp256k1.PubKey{Key: proof.PubKey}
+	return pubKey.VerifySignature(EscrowClaimCommand(e.Id, destination), proof.Signature)
+}
+
+// NewSecretClaimProof signs the claim of an escrow to destination with the
+// secp256k1 secret key of the escrow.
+func NewSecretClaimProof(secretKey []byte, id uint64, destination string) (SecretClaimProof, error) {
+	if len(secretKey) != secp256k1.PrivKeySize {
+		return SecretClaimProof{}, errorsmod.Wrapf(ErrInvalidEscrowClaim, "secret key must be %d bytes", secp256k1.PrivKeySize)
+	}
+	privKey := &secp256k1.PrivKey{Key: secretKey}
+	signature, err := privKey.Sign(EscrowClaimCommand(id, destination))
+	if err != nil {
+		return SecretClaimProof{}, err
+	}
+	return SecretClaimProof{PubKey: privKey.PubKey().Bytes(), Signature: signature}, nil
+}
+
+func validateEscrowTerms(amount sdk.Coins, recipient string, secretHash []byte, emailHash string) error {
+	if !amount.IsValid() || !amount.IsAllPositive() {
+		return errorsmod.Wrapf(ErrInvalidEscrow, "invalid amount %s", amount)
+	}
+	conditions := 0
+	for _, set := range []bool{recipient != "", len(secretHash) != 0, emailHash != ""} {
+		if set {
+			conditions++
+		}
+	}
+	if conditions != 1 {
+		return errorsmod.Wrap(ErrInvalidEscrow, "exactly one of recipient, secret hash and email hash must be set")
+	}
+	if recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return errorsmod.Wrapf(ErrInvalidEscrow, "invalid recipient: %s", err)
+		}
+	}
+	if len(secretHash) != 0 && len(secretHash) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidEscrow, "secret hash must be %d bytes", sha256.Size)
+	}
+	if len(emailHash) > MaxEscrowEmailHashSize {
+		return errorsmod.Wrapf(ErrInvalidEscrow, "email hash exceeds %d bytes", MaxEscrowEmailHashSize)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestEscrow_Validate(t *testing.T) {
+	valid := types.Escrow{
+		Id:               1,
+		Sender:           sdk.AccAddress("escrow_sender_123456").String(),
+		Amount:           sdk.NewCoins(sdk.NewInt64Coin("uxion", 100)),
+		Recipient:        sdk.AccAddress("escrow_recipient_123").String(),
+		ExpirationHeight: 10,
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(e *types.Escrow)
+		hasErr bool
+	}{
+		{"valid recipient escrow", func(*types.Escrow) {}, false},
+		{"valid secret escrow", func(e *types.Escrow) {
+			e.Recipient = ""
+			e.SecretHash = types.EscrowSecretHash([]byte("secret"))
+		}, false},
+		{"invalid sender", func(e *types.Escrow) { e.Sender = "bad" }, true},
+		{"empty amount", func(e *types.Escrow) { e.Amount = sdk.NewCoins() }, true},
+		{"no expiration", func(e *types.Escrow) { e.ExpirationHeight = 0 }, true},
+		{"no condition", func(e *types.Escrow) { e.Recipient = "" }, true},
+		{"valid email escrow", func(e *types.Escrow) {
+			e.Recipient = ""
+			e.EmailHash = "12345678901234567890"
+		}, false},
+		{"both conditions", func(e *types.Escrow) { e.SecretHash = types.EscrowSecretHash([]byte("secret")) }, true},
+		{"secret and email conditions", func(e *types.Escrow) {
+			e.Recipient = ""
+			e.SecretHash = types.EscrowSecretHash([]byte("secret"))
+			e.EmailHash = "12345678901234567890"
+		}, true},
+		{"long email hash", func(e *types.Escrow) {
+			e.Recipient = ""
+			e.EmailHash = string(make([]byte, types.MaxEscrowEmailHashSize+1))
+		}, true},
+		{"short secret hash", func(e *types.Escrow) {
+			e.Recipient = ""
+			e.SecretHash = []byte("short")
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			escrow := valid
+			tc.modify(&escrow)
+			err := escrow.Validate()
+			if tc.hasErr {
+				require.ErrorIs(t, err, types.ErrInvalidEscrow)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEscrow_VerifySecretClaim(t *testing.T) {
+	secretKey := secp256k1.GenPrivKey()
+	destination := sdk.AccAddress("escrow_destination_1").String()
+	escrow := types.Escrow{Id: 1, SecretHash: types.EscrowSecretHash(secretKey.PubKey().Bytes())}
+
+	proof, err := types.NewSecretClaimProof(secretKey.Key, escrow.Id, destination)
+	require.NoError(t, err)
+	require.True(t, escrow.VerifySecretClaim(destination, proof))
+
+	// the proof is bound to the escrow and the destination
+	require.False(t, escrow.VerifySecretClaim(sdk.AccAddress("someone_else_1234567").String(), proof))
+	other := escrow
+	other.Id = 2
+	require.False(t, other.VerifySecretClaim(destination, proof))
+
+	// a signature by another key does not match the secret hash
+	wrongKey, err := types.NewSecretClaimProof(secp256k1.GenPrivKey().Key, escrow.Id, destination)
+	require.NoError(t, err)
+	require.False(t, escrow.VerifySecretClaim(destination, wrongKey))
+
+	// escrows without a secret hash never match
+	require.False(t, types.Escrow{Id: 1, EmailHash: "hash"}.VerifySecretClaim(destination, proof))
+
+	_, err = types.NewSecretClaimProof([]byte("short"), escrow.Id, destination)
+	require.ErrorIs(t, err, types.ErrInvalidEscrowClaim)
+}
+
+func TestMsgClaimEscrow_ValidateBasic(t *testing.T) {
+	claimer := sdk.AccAddress("escrow_claimer_12345").String()
+
+	require.NoError(t, types.MsgClaimEscrow{Claimer: claimer, Id: 1, SecretProof: &types.SecretClaimProof{}}.ValidateBasic())
+	require.Error(t, types.MsgClaimEscrow{Claimer: "bad", Id: 1}.ValidateBasic())
+	require.Error(t, types.MsgClaimEscrow{Claimer: claimer, Id: 1, Destination: "bad"}.ValidateBasic())
+	require.ErrorIs(t, types.MsgClaimEscrow{
+		Claimer: claimer, Id: 1, SecretProof: &types.SecretClaimProof{}, EmailProof: &types.EmailClaimProof{},
+	}.ValidateBasic(), types.ErrInvalidEscrowClaim)
+}
+
+func TestEscrowExpiryQueueKey(t *testing.T) {
+	key := types.EscrowExpiryQueueKey(100, 7)
+	require.Equal(t, uint64(7), types.ParseEscrowExpiryQueueKey(key))
+	require.Less(t, string(key), string(types.EscrowExpiryQueueKey(101, 1)))
+}
@@ -9,6 +9,9 @@ import (
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	dkimtypes "github.com/burnt-labs/xion/x/dkim/types"
 )
 
 // BankKeeper defines the contract needed to be fulfilled for banking and supply
@@ -38,12 +41,24 @@ type BankKeeper interface {
 	InputOutputCoins(ctx context.Context, input banktypes.Input, outputs []banktypes.Output) error
 	GetBalance(ctx context.Context, addr sdktypes.AccAddress, denom string) sdktypes.Coin
 	BurnCoins(ctx context.Context, moduleName string, amt sdktypes.Coins) error
+	GetSupply(ctx context.Context, denom string) sdktypes.Coin
 }
 
 type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdktypes.AccAddress) sdktypes.AccountI
 	GetModuleAccount(ctx context.Context, moduleName string) sdktypes.ModuleAccountI
 }
 
+// DistributionKeeper defines the contract needed to fund the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdktypes.Coins, sender sdktypes.AccAddress) error
+}
+
+// DkimKeeper defines the contract needed to verify email proofs.
+type DkimKeeper interface {
+	Authenticate(ctx context.Context, req *dkimtypes.QueryAuthenticateRequest) (*dkimtypes.AuthenticateResponse, error)
+}
+
 type WasmKeeper interface {
 	Migrate(ctx sdktypes.Context, contractAddress, caller sdktypes.AccAddress, newCodeID uint64, msg []byte) ([]byte, error)
 	IterateContractsByCode(ctx sdktypes.Context, codeID uint64, cb func(address sdktypes.AccAddress) bool)
@@ -60,3 +75,10 @@ type StakingKeeper interface {
 	TotalBondedTokens(ctx context.Context) (math.Int, error)
 	BondedRatio(ctx context.Context) (math.LegacyDec, error)
 }
+
+// MintQuerier defines the contract needed to read the x/mint parameters and
+// inflation the emissions are issued with.
+type MintQuerier interface {
+	Params(ctx context.Context, req *minttypes.QueryParamsRequest) (*minttypes.QueryParamsResponse, error)
+	Inflation(ctx context.Context, req *minttypes.QueryInflationRequest) (*minttypes.QueryInflationResponse, error)
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that every exempt address is a valid, unique account
+// address of this chain.
+func (e PlatformFeeEnforcement) Validate() error {
+	seen := make(map[string]struct{}, len(e.ExemptAddresses))
+	for _, addr := range e.ExemptAddresses {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid exempt address %s: %s", addr, err)
+		}
+		// addresses only differing in case are the same account
+		if _, ok := seen[string(accAddr)]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate exempt address %s", addr)
+		}
+		seen[string(accAddr)] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsExempt returns true if addr is in the configured exemption list. Entries
+// are compared by address bytes so their encoding does not matter.
+func (e PlatformFeeEnforcement) IsExempt(addr sdk.AccAddress) bool {
+	for _, exempt := range e.ExemptAddresses {
+		exemptAddr, err := sdk.AccAddressFromBech32(exempt)
+		if err != nil {
+			continue
+		}
+		if exemptAddr.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
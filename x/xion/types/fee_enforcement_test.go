@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestPlatformFeeEnforcement_Validate(t *testing.T) {
+	relayer := sdk.AccAddress("relayer_address_12345")
+	foreign, err := bech32.ConvertAndEncode("osmo", relayer)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		addrs  []string
+		hasErr bool
+	}{
+		{"no exemptions", nil, false},
+		{"valid address", []string{relayer.String()}, false},
+		{"invalid address", []string{"invalid"}, true},
+		{"address with another prefix", []string{foreign}, true},
+		{"duplicate address", []string{relayer.String(), relayer.String()}, true},
+		{"duplicate address in another case", []string{relayer.String(), strings.ToUpper(relayer.String())}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.PlatformFeeEnforcement{ExemptAddresses: tc.addrs}.Validate()
+			if tc.hasErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPlatformFeeEnforcement_IsExempt(t *testing.T) {
+	relayer := sdk.AccAddress("relayer_address_12345")
+	user := sdk.AccAddress("user_address_12345678")
+
+	enforcement := types.PlatformFeeEnforcement{ExemptAddresses: []string{"invalid", strings.ToUpper(relayer.String())}}
+	require.True(t, enforcement.IsExempt(relayer))
+	require.False(t, enforcement.IsExempt(user))
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// platform fee split event types and attributes
+const (
+	EventTypePlatformFee = "platform_fee"
+
+	AttributeKeyDestination = "destination"
+	AttributeKeyRecipient   = "recipient"
+
+	PlatformFeeDestinationCommunityPool = "community_pool"
+	PlatformFeeDestinationBurn          = "burn"
+	PlatformFeeDestinationTreasury      = "treasury"
+	PlatformFeeDestinationFeeCollector  = "fee_collector"
+)
+
+// MaxBasisPoints is the denominator for every basis point share.
+const MaxBasisPoints = 10000
+
+// TotalBps returns the sum of all shares assigned to an explicit destination.
+func (s PlatformFeeSplit) TotalBps() uint64 {
+	return uint64(s.CommunityPoolBps) + uint64(s.BurnBps) + uint64(s.TreasuryBps)
+}
+
+// Validate checks that the shares fit within 100% and that a treasury share
+// always has a recipient.
+func (s PlatformFeeSplit) Validate() error {
+	if s.TotalBps() > MaxBasisPoints {
+		return errorsmod.Wrapf(ErrInvalidFeeSplit, "total split %d exceeds maximum %d (100%%)", s.TotalBps(), MaxBasisPoints)
+	}
+
+	if s.TreasuryAddress == "" {
+		if s.TreasuryBps != 0 {
+			return errorsmod.Wrap(ErrInvalidFeeSplit, "treasury share requires a treasury address")
+		}
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.TreasuryAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeSplit, "invalid treasury address: %s", err)
+	}
+
+	return nil
+}
+
+// Shares divides coins according to the split. Each share is rounded down so
+// the remainder, including any dust, stays with the fee collector.
+func (s PlatformFeeSplit) Shares(coins sdk.Coins) (communityPool, burn, treasury, remainder sdk.Coins) {
+	communityPool = splitShare(coins, s.CommunityPoolBps)
+	burn = splitShare(coins, s.BurnBps)
+	treasury = splitShare(coins, s.TreasuryBps)
+
+	remainder = coins.Sub(communityPool...).Sub(burn...).Sub(treasury...)
+	return communityPool, burn, treasury, remainder
+}
+
+func splitShare(coins sdk.Coins, bps uint32) sdk.Coins {
+	share := sdk.NewCoins()
+	if bps == 0 {
+		return share
+	}
+
+	for _, coin := range coins {
+		amount := coin.Amount.Mul(math.NewIntFromUint64(uint64(bps))).Quo(math.NewInt(MaxBasisPoints))
+		share = share.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return share
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestPlatformFeeSplit_Validate(t *testing.T) {
+	treasury := sdk.AccAddress("treasury_address_1234").String()
+	foreignTreasury, err := bech32.ConvertAndEncode("osmo", []byte("treasury_address_1234"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		split  types.PlatformFeeSplit
+		hasErr bool
+	}{
+		{"empty split", types.PlatformFeeSplit{}, false},
+		{"full split", types.PlatformFeeSplit{CommunityPoolBps: 5000, BurnBps: 2500, TreasuryAddress: treasury, TreasuryBps: 2500}, false},
+		{"exceeds 100%", types.PlatformFeeSplit{CommunityPoolBps: 5000, BurnBps: 5001}, true},
+		{"treasury share without address", types.PlatformFeeSplit{TreasuryBps: 100}, true},
+		{"invalid treasury address", types.PlatformFeeSplit{TreasuryAddress: "not-an-address", TreasuryBps: 100}, true},
+		{"treasury address with another prefix", types.PlatformFeeSplit{TreasuryAddress: foreignTreasury, TreasuryBps: 100}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.split.Validate()
+			if tc.hasErr {
+				require.ErrorIs(t, err, types.ErrInvalidFeeSplit)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPlatformFeeSplit_Shares(t *testing.T) {
+	split := types.PlatformFeeSplit{CommunityPoolBps: 3333, BurnBps: 3333, TreasuryBps: 3333}
+	coins := sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(100)), sdk.NewCoin("uusdc", math.NewInt(7)))
+
+	communityPool, burn, treasury, remainder := split.Shares(coins)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(33)), sdk.NewCoin("uusdc", math.NewInt(2))), communityPool)
+	require.Equal(t, communityPool, burn)
+	require.Equal(t, communityPool, treasury)
+	// rounding dust stays with the fee collector
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uxion", math.NewInt(1)), sdk.NewCoin("uusdc", math.NewInt(1))), remainder)
+	require.Equal(t, coins, communityPool.Add(burn...).Add(treasury...).Add(remainder...))
+
+	communityPool, burn, treasury, remainder = types.PlatformFeeSplit{}.Shares(coins)
+	require.True(t, communityPool.IsZero())
+	require.True(t, burn.IsZero())
+	require.True(t, treasury.IsZero())
+	require.Equal(t, coins, remainder)
+}
@@ -2,6 +2,10 @@ package types
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -9,6 +13,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/feegrant"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -17,6 +22,67 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
+const (
+	EventTypeFeeAllowanceUsed      = "fee_allowance_used"
+	EventTypeMultiAnyAllowanceUsed = "multi_any_allowance_used"
+
+	AttributeKeyAllowanceIndex = "allowance_index"
+	AttributeKeyAllowanceType  = "allowance_type"
+	AttributeKeyStrategy       = "strategy"
+	AttributeKeyRemaining      = "remaining"
+
+	// RemainingUnlimited is the remaining attribute of an allowance whose
+	// spend is not limited.
+	RemainingUnlimited = "unlimited"
+)
+
+type (
+	feeGrantPartiesKey struct{}
+	nestedAllowanceKey struct{}
+)
+
+// feeGrantParties is the granter and grantee of the fee allowance in use.
+type feeGrantParties struct {
+	granter, grantee string
+}
+
+// WithFeeGrantParties records the granter and grantee of the fee allowance
+// about to be used, for the fee_allowance_used event.
+func WithFeeGrantParties(ctx sdk.Context, granter, grantee sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(feeGrantPartiesKey{}, feeGrantParties{granter: granter.String(), grantee: grantee.String()})
+}
+
+// acceptNested calls Accept on the allowance wrapped by another one. Only the
+// outermost allowance emits fee_allowance_used, so each use is reported once.
+func acceptNested(ctx context.Context, allowance feegrant.FeeAllowanceI, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	return allowance.Accept(sdk.UnwrapSDKContext(ctx).WithValue(nestedAllowanceKey{}, true), fee, msgs)
+}
+
+// emitFeeAllowanceUsed reports that allowance paid fee, unless it is wrapped
+// by another allowance. A removed allowance has nothing remaining.
+func emitFeeAllowanceUsed(ctx context.Context, allowance feegrant.FeeAllowanceI, fee sdk.Coins, removed bool) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if nested, _ := sdkCtx.Value(nestedAllowanceKey{}).(bool); nested {
+		return
+	}
+
+	remaining := RemainingUnlimited
+	if spendable, limited := spendLimit(sdkCtx.BlockTime(), allowance); removed || (limited && spendable.Empty()) {
+		remaining = "0"
+	} else if limited {
+		remaining = spendable.String()
+	}
+
+	parties, _ := sdkCtx.Value(feeGrantPartiesKey{}).(feeGrantParties)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(EventTypeFeeAllowanceUsed,
+		sdk.NewAttribute(feegrant.AttributeKeyGranter, parties.granter),
+		sdk.NewAttribute(feegrant.AttributeKeyGrantee, parties.grantee),
+		sdk.NewAttribute(AttributeKeyAllowanceType, sdk.MsgTypeURL(allowance.(proto.Message))),
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(AttributeKeyRemaining, remaining),
+	))
+}
+
 // TODO: Revisit this once we have proper gas fee framework.
 // Tracking issues https://github.com/cosmos/cosmos-sdk/issues/9054, https://github.com/cosmos/cosmos-sdk/discussions/9072
 const (
@@ -26,9 +92,15 @@ const (
 var (
 	_ feegrant.FeeAllowanceI        = (*AuthzAllowance)(nil)
 	_ feegrant.FeeAllowanceI        = (*ContractsAllowance)(nil)
+	_ feegrant.FeeAllowanceI        = (*ContractMethodsAllowance)(nil)
+	_ feegrant.FeeAllowanceI        = (*RateLimitedAllowance)(nil)
+	_ feegrant.FeeAllowanceI        = (*MsgFilterAllowance)(nil)
 	_ feegrant.FeeAllowanceI        = (*MultiAnyAllowance)(nil)
 	_ types.UnpackInterfacesMessage = (*AuthzAllowance)(nil)
 	_ types.UnpackInterfacesMessage = (*ContractsAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*ContractMethodsAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*RateLimitedAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*MsgFilterAllowance)(nil)
 	_ types.UnpackInterfacesMessage = (*MultiAnyAllowance)(nil)
 )
 
@@ -86,13 +158,18 @@ func (a *AuthzAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.M
 		return false, err
 	}
 
-	remove, err := allowance.Accept(ctx, fee, subMsgs)
-	if err == nil && !remove {
+	remove, err := acceptNested(ctx, allowance, fee, subMsgs)
+	if err != nil {
+		return false, err
+	}
+	if !remove {
 		if err = a.SetAllowance(allowance); err != nil {
 			return false, err
 		}
 	}
-	return remove, err
+
+	emitFeeAllowanceUsed(ctx, a, fee, remove)
+	return remove, nil
 }
 
 func (a *AuthzAllowance) allMsgTypesAuthz(ctx context.Context, msgs []sdk.Msg) ([]sdk.Msg, bool) {
@@ -203,13 +280,18 @@ func (a *ContractsAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []s
 		return false, err
 	}
 
-	remove, err := allowance.Accept(ctx, fee, msgs)
-	if err == nil && !remove {
+	remove, err := acceptNested(ctx, allowance, fee, msgs)
+	if err != nil {
+		return false, err
+	}
+	if !remove {
 		if err = a.SetAllowance(allowance); err != nil {
 			return false, err
 		}
 	}
-	return remove, err
+
+	emitFeeAllowanceUsed(ctx, a, fee, remove)
+	return remove, nil
 }
 
 func (a *ContractsAllowance) allowedContractsToMap(ctx sdk.Context) map[string]bool {
@@ -272,6 +354,495 @@ func (a *ContractsAllowance) ExpiresAt() (*time.Time, error) {
 	return allowance.ExpiresAt()
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *ContractMethodsAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+func NewContractMethodsAllowance(allowance feegrant.FeeAllowanceI, contracts []ContractMethods) (*ContractMethodsAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	anyAllowance, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContractMethodsAllowance{
+		Allowance: anyAllowance,
+		Contracts: contracts,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *ContractMethodsAllowance) GetAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets allowed fee allowance.
+func (a *ContractMethodsAllowance) SetAllowance(allowance feegrant.FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+func (a *ContractMethodsAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if err := a.allMsgsAllowedMethods(ctx, msgs); err != nil {
+		return false, err
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := acceptNested(ctx, allowance, fee, msgs)
+	if err != nil {
+		return false, err
+	}
+	if !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+
+	emitFeeAllowanceUsed(ctx, a, fee, remove)
+	return remove, nil
+}
+
+func (a *ContractMethodsAllowance) allowedMethodsToMap(ctx sdk.Context) map[string]ContractMethods {
+	contractsMap := make(map[string]ContractMethods, len(a.Contracts))
+	for _, contract := range a.Contracts {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		contractsMap[contract.ContractAddress] = contract
+	}
+
+	return contractsMap
+}
+
+func (a *ContractMethodsAllowance) allMsgsAllowedMethods(ctx context.Context, msgs []sdk.Msg) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractsMap := a.allowedMethodsToMap(sdkCtx)
+
+	for _, msg := range msgs {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+
+		wasmMsg, ok := msg.(*wasmtypes.MsgExecuteContract)
+		if !ok {
+			return errorsmod.Wrap(feegrant.ErrMessageNotAllowed, "messages are not contract executions")
+		}
+		contract, ok := contractsMap[wasmMsg.Contract]
+		if !ok {
+			return errorsmod.Wrapf(feegrant.ErrMessageNotAllowed, "contract %s is not allowed", wasmMsg.Contract)
+		}
+
+		method, err := ExecuteMsgMethod(wasmMsg.Msg)
+		if err != nil {
+			return errorsmod.Wrap(feegrant.ErrMessageNotAllowed, err.Error())
+		}
+		if !contract.allowsMethod(sdkCtx, method) {
+			return errorsmod.Wrapf(feegrant.ErrMessageNotAllowed, "method %s is not allowed on contract %s", method, wasmMsg.Contract)
+		}
+
+		if len(contract.MaxFunds) > 0 && !wasmMsg.Funds.IsAllLTE(contract.MaxFunds) {
+			return errorsmod.Wrapf(feegrant.ErrMessageNotAllowed, "funds %s exceed %s for contract %s", wasmMsg.Funds, contract.MaxFunds, wasmMsg.Contract)
+		}
+	}
+
+	return nil
+}
+
+// ExecuteMsgMethod returns the entry point of a contract execute msg, the
+// single top-level key of its JSON object.
+func ExecuteMsgMethod(msg []byte) (string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &fields); err != nil {
+		return "", errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "execute msg is not a JSON object: %s", err)
+	}
+	if len(fields) != 1 {
+		return "", errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "execute msg must have exactly one top-level key, has %d", len(fields))
+	}
+
+	for method := range fields {
+		return method, nil
+	}
+	return "", nil
+}
+
+func (c ContractMethods) allowsMethod(ctx sdk.Context, method string) bool {
+	for _, allowed := range c.Methods {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check method")
+		if allowed == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c ContractMethods) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.ContractAddress); err != nil {
+		return err
+	}
+
+	if len(c.Methods) < 1 {
+		return errorsmod.Wrapf(ErrInvalidContractMethods, "must set methods for contract %s", c.ContractAddress)
+	}
+	seen := make(map[string]bool, len(c.Methods))
+	for _, method := range c.Methods {
+		if method == "" {
+			return errorsmod.Wrapf(ErrInvalidContractMethods, "empty method for contract %s", c.ContractAddress)
+		}
+		if seen[method] {
+			return errorsmod.Wrapf(ErrInvalidContractMethods, "duplicate method %s for contract %s", method, c.ContractAddress)
+		}
+		seen[method] = true
+	}
+
+	if !c.MaxFunds.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "max funds %s for contract %s", c.MaxFunds, c.ContractAddress)
+	}
+
+	return nil
+}
+
+func (a *ContractMethodsAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+
+	if len(a.Contracts) < 1 {
+		return errorsmod.Wrap(ErrNoAllowedContracts, "must set contracts for feegrant")
+	}
+
+	seen := make(map[string]bool, len(a.Contracts))
+	for _, contract := range a.Contracts {
+		if err := contract.Validate(); err != nil {
+			return err
+		}
+		if seen[contract.ContractAddress] {
+			return errorsmod.Wrapf(ErrInvalidContractMethods, "duplicate contract %s", contract.ContractAddress)
+		}
+		seen[contract.ContractAddress] = true
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *ContractMethodsAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// maxRateLimitedTxs bounds the transactions per window of a
+// RateLimitedAllowance, as the allowance stores the time of each.
+const maxRateLimitedTxs = 1000
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *RateLimitedAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+func NewRateLimitedAllowance(allowance feegrant.FeeAllowanceI, window time.Duration, maxTxs, maxGasPerTx uint64, maxFeePerTx sdk.Coins) (*RateLimitedAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	anyAllowance, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RateLimitedAllowance{
+		Allowance:   anyAllowance,
+		Window:      window,
+		MaxTxs:      maxTxs,
+		MaxGasPerTx: maxGasPerTx,
+		MaxFeePerTx: maxFeePerTx,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *RateLimitedAllowance) GetAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets allowed fee allowance.
+func (a *RateLimitedAllowance) SetAllowance(allowance feegrant.FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+func (a *RateLimitedAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if len(a.MaxFeePerTx) > 0 && !fee.IsAllLTE(a.MaxFeePerTx) {
+		return false, errorsmod.Wrapf(feegrant.ErrFeeLimitExceeded, "fee %s exceeds %s per tx", fee, a.MaxFeePerTx)
+	}
+
+	// the ante handler meters a tx with its gas limit, except in simulations
+	// which run without one
+	if a.MaxGasPerTx > 0 && sdkCtx.ExecMode() != sdk.ExecModeSimulate {
+		if gasLimit := sdkCtx.GasMeter().Limit(); gasLimit > a.MaxGasPerTx {
+			return false, errorsmod.Wrapf(ErrRateLimitExceeded, "gas limit %d exceeds %d per tx", gasLimit, a.MaxGasPerTx)
+		}
+	}
+
+	a.pruneRecentTxs(sdkCtx)
+	if uint64(len(a.RecentTxs)) >= a.MaxTxs {
+		return false, errorsmod.Wrapf(ErrRateLimitExceeded, "%d txs already paid within %s", len(a.RecentTxs), a.Window)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := acceptNested(ctx, allowance, fee, msgs)
+	if err != nil {
+		return false, err
+	}
+	if !remove {
+		a.RecentTxs = append(a.RecentTxs, sdkCtx.BlockTime())
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+
+	emitFeeAllowanceUsed(ctx, a, fee, remove)
+	return remove, nil
+}
+
+// pruneRecentTxs drops the transactions that fell out of the window ending at
+// the current block time.
+func (a *RateLimitedAllowance) pruneRecentTxs(ctx sdk.Context) {
+	windowStart := ctx.BlockTime().Add(-a.Window)
+
+	i := 0
+	for ; i < len(a.RecentTxs); i++ {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check tx time")
+		if a.RecentTxs[i].After(windowStart) {
+			break
+		}
+	}
+	a.RecentTxs = a.RecentTxs[i:]
+}
+
+func (a *RateLimitedAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+
+	if a.Window <= 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "window must be positive")
+	}
+	if a.MaxTxs < 1 || a.MaxTxs > maxRateLimitedTxs {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max txs must be between 1 and %d", maxRateLimitedTxs)
+	}
+	if !a.MaxFeePerTx.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "max fee per tx %s", a.MaxFeePerTx)
+	}
+	if uint64(len(a.RecentTxs)) > a.MaxTxs {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "%d recent txs exceed max txs %d", len(a.RecentTxs), a.MaxTxs)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *RateLimitedAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// maxMsgExecDepth limits how deeply MsgFilterAllowance unwraps nested
+// MsgExec messages.
+const maxMsgExecDepth = 5
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *MsgFilterAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+func NewMsgFilterAllowance(allowance feegrant.FeeAllowanceI, allowedMsgs []string) (*MsgFilterAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	anyAllowance, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgFilterAllowance{
+		Allowance:       anyAllowance,
+		AllowedMessages: allowedMsgs,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *MsgFilterAllowance) GetAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets allowed fee allowance.
+func (a *MsgFilterAllowance) SetAllowance(allowance feegrant.FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+func (a *MsgFilterAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := a.allMsgsAllowed(sdkCtx, a.allowedMsgsToMap(sdkCtx), msgs, 0); err != nil {
+		return false, err
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := acceptNested(ctx, allowance, fee, msgs)
+	if err != nil {
+		return false, err
+	}
+	if !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+
+	emitFeeAllowanceUsed(ctx, a, fee, remove)
+	return remove, nil
+}
+
+func (a *MsgFilterAllowance) allowedMsgsToMap(ctx sdk.Context) map[string]bool {
+	msgsMap := make(map[string]bool, len(a.AllowedMessages))
+	for _, msg := range a.AllowedMessages {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		msgsMap[msg] = true
+	}
+
+	return msgsMap
+}
+
+// allMsgsAllowed checks that every msg, or every msg executed by a MsgExec, is
+// one of the allowed types.
+func (a *MsgFilterAllowance) allMsgsAllowed(ctx sdk.Context, msgsMap map[string]bool, msgs []sdk.Msg, depth int) error {
+	for _, msg := range msgs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+
+		execMsg, ok := msg.(*authz.MsgExec)
+		if !ok {
+			if !msgsMap[sdk.MsgTypeURL(msg)] {
+				return errorsmod.Wrapf(feegrant.ErrMessageNotAllowed, "message %s is not allowed", sdk.MsgTypeURL(msg))
+			}
+			continue
+		}
+
+		if depth >= maxMsgExecDepth {
+			return errorsmod.Wrap(feegrant.ErrMessageNotAllowed, "MsgExec nesting exceeds maximum depth")
+		}
+		execMsgs, err := execMsg.GetMessages()
+		if err != nil {
+			return errorsmod.Wrap(feegrant.ErrMessageNotAllowed, err.Error())
+		}
+		if err := a.allMsgsAllowed(ctx, msgsMap, execMsgs, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *MsgFilterAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+
+	if len(a.AllowedMessages) < 1 {
+		return errorsmod.Wrap(ErrInvalidMsgFilter, "must set allowed messages for feegrant")
+	}
+
+	execTypeURL := sdk.MsgTypeURL(&authz.MsgExec{})
+	seen := make(map[string]bool, len(a.AllowedMessages))
+	for _, msg := range a.AllowedMessages {
+		if !strings.HasPrefix(msg, "/") {
+			return errorsmod.Wrapf(ErrInvalidMsgFilter, "%q is not a message type url", msg)
+		}
+		if msg == execTypeURL {
+			return errorsmod.Wrapf(ErrInvalidMsgFilter, "%s is always unwrapped and cannot be allowed", msg)
+		}
+		if seen[msg] {
+			return errorsmod.Wrapf(ErrInvalidMsgFilter, "duplicate message %s", msg)
+		}
+		seen[msg] = true
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *MsgFilterAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (a *MultiAnyAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var allowance feegrant.FeeAllowanceI
@@ -325,16 +896,22 @@ func (a *MultiAnyAllowance) SetAllowance(index int, allowance feegrant.FeeAllowa
 }
 
 func (a *MultiAnyAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
-	// accept and charge first allowance that doesn't error
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	order, err := a.selectionOrder(sdkCtx, fee)
+	if err != nil {
+		return false, err
+	}
+
+	// accept and charge first allowance, in strategy order, that doesn't error
 	accepted := false
-	for i := range a.Allowances {
+	for _, i := range order {
 		allowance, err := a.GetAllowance(i)
 		if err != nil {
 			return false, err
 		}
-		sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(gasCostPerIteration, "check allowance")
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "check allowance")
 
-		remove, err := allowance.Accept(ctx, fee, msgs)
+		remove, err := acceptNested(ctx, allowance, fee, msgs)
 		if err != nil {
 			// the allowance errored, try the next
 			continue
@@ -342,6 +919,13 @@ func (a *MultiAnyAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sd
 		// the allowance was accepted
 		accepted = true
 
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(EventTypeMultiAnyAllowanceUsed,
+			sdk.NewAttribute(AttributeKeyAllowanceIndex, strconv.Itoa(i)),
+			sdk.NewAttribute(AttributeKeyAllowanceType, a.Allowances[i].TypeUrl),
+			sdk.NewAttribute(AttributeKeyStrategy, a.Strategy.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		))
+
 		if !remove {
 			// update the allowance state
 			if err = a.SetAllowance(i, allowance); err != nil {
@@ -351,6 +935,14 @@ func (a *MultiAnyAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sd
 			// if the allowance is complete, remove it from the allowed list
 			a.Allowances = append(a.Allowances[:i], a.Allowances[i+1:]...)
 		}
+
+		if a.Strategy == SelectionStrategy_SELECTION_STRATEGY_ROUND_ROBIN && len(a.Allowances) > 0 {
+			// a removed allowance is replaced by the one after it
+			if !remove {
+				i++
+			}
+			a.NextIndex = uint32(i % len(a.Allowances)) //nolint:gosec // bounded by the allowance count
+		}
 		break
 	}
 
@@ -359,10 +951,166 @@ func (a *MultiAnyAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sd
 		return false, errorsmod.Wrapf(ErrNoValidAllowances, "all allowances errored")
 	}
 
+	emitFeeAllowanceUsed(ctx, a, fee, len(a.Allowances) == 0)
+
 	// if all the allowances have been removed, remove this allowance as well
 	return len(a.Allowances) == 0, nil
 }
 
+// selectionOrder returns the indexes of the allowances in the order the
+// strategy tries them.
+func (a *MultiAnyAllowance) selectionOrder(ctx sdk.Context, fee sdk.Coins) ([]int, error) {
+	order := make([]int, len(a.Allowances))
+	switch a.Strategy {
+	case SelectionStrategy_SELECTION_STRATEGY_ROUND_ROBIN:
+		start := int(a.NextIndex)
+		for i := range order {
+			order[i] = (start + i) % len(order)
+		}
+		return order, nil
+
+	case SelectionStrategy_SELECTION_STRATEGY_CHEAPEST_REMAINING:
+		remaining := make([]sdkmath.Int, len(a.Allowances))
+		limited := make([]bool, len(a.Allowances))
+		for i := range a.Allowances {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check allowance")
+			allowance, err := a.GetAllowance(i)
+			if err != nil {
+				return nil, err
+			}
+			order[i] = i
+			var spendable sdk.Coins
+			spendable, limited[i] = spendLimit(ctx.BlockTime(), allowance)
+			remaining[i] = amountInDenoms(spendable, fee)
+		}
+		sort.SliceStable(order, func(x, y int) bool {
+			i, j := order[x], order[y]
+			if limited[i] != limited[j] {
+				return limited[i]
+			}
+			return limited[i] && remaining[i].LT(remaining[j])
+		})
+		return order, nil
+
+	case SelectionStrategy_SELECTION_STRATEGY_SOONEST_EXPIRING:
+		lapses := make([]*time.Time, len(a.Allowances))
+		for i := range a.Allowances {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check allowance")
+			allowance, err := a.GetAllowance(i)
+			if err != nil {
+				return nil, err
+			}
+			order[i] = i
+			lapses[i] = lapseTime(ctx.BlockTime(), allowance)
+		}
+		sort.SliceStable(order, func(x, y int) bool {
+			i, j := order[x], order[y]
+			if lapses[i] == nil || lapses[j] == nil {
+				return lapses[i] != nil && lapses[j] == nil
+			}
+			return lapses[i].Before(*lapses[j])
+		})
+		return order, nil
+
+	default:
+		for i := range order {
+			order[i] = i
+		}
+		return order, nil
+	}
+}
+
+// wrappedAllowance is an allowance that restricts a single inner allowance.
+type wrappedAllowance interface {
+	GetAllowance() (feegrant.FeeAllowanceI, error)
+}
+
+// spendLimit returns what an allowance has left to spend at blockTime, and
+// false if its spend is not limited.
+func spendLimit(blockTime time.Time, allowance feegrant.FeeAllowanceI) (sdk.Coins, bool) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		return a.SpendLimit, !a.SpendLimit.Empty()
+	case *feegrant.PeriodicAllowance:
+		canSpend := a.PeriodCanSpend
+		if !blockTime.Before(a.PeriodReset) {
+			canSpend = a.PeriodSpendLimit
+		}
+		if !a.Basic.SpendLimit.Empty() {
+			canSpend = canSpend.Min(a.Basic.SpendLimit)
+		}
+		return canSpend, true
+	case *MultiAnyAllowance:
+		// what any of the allowances can spend, unless one is unlimited
+		total := sdk.NewCoins()
+		for i := range a.Allowances {
+			inner, err := a.GetAllowance(i)
+			if err != nil {
+				return nil, false
+			}
+			spendable, limited := spendLimit(blockTime, inner)
+			if !limited {
+				return nil, false
+			}
+			total = total.Add(spendable...)
+		}
+		return total, true
+	case wrappedAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, false
+		}
+		return spendLimit(blockTime, inner)
+	default:
+		return nil, false
+	}
+}
+
+// lapseTime returns when the spend an allowance has left lapses, or nil if it
+// never does.
+func lapseTime(blockTime time.Time, allowance feegrant.FeeAllowanceI) *time.Time {
+	switch a := allowance.(type) {
+	case *feegrant.PeriodicAllowance:
+		reset := a.PeriodReset
+		if !blockTime.Before(reset) {
+			reset = blockTime.Add(a.Period)
+		}
+		if a.Basic.Expiration != nil && a.Basic.Expiration.Before(reset) {
+			return a.Basic.Expiration
+		}
+		return &reset
+	case wrappedAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil
+		}
+		return lapseTime(blockTime, inner)
+	default:
+		expiration, err := allowance.ExpiresAt()
+		if err != nil {
+			return nil
+		}
+		return expiration
+	}
+}
+
+// amountInDenoms sums the amounts of coins in the denoms of fee, or of all
+// coins when fee is empty.
+func amountInDenoms(coins, fee sdk.Coins) sdkmath.Int {
+	total := sdkmath.ZeroInt()
+	if fee.Empty() {
+		for _, coin := range coins {
+			total = total.Add(coin.Amount)
+		}
+		return total
+	}
+
+	for _, coin := range fee {
+		total = total.Add(coins.AmountOf(coin.Denom))
+	}
+	return total
+}
+
 // maxMultiAnyAllowanceDepth limits recursive nesting of MultiAnyAllowance to
 // prevent stack overflow during ValidateBasic traversal.
 const maxMultiAnyAllowanceDepth = 5
@@ -378,6 +1126,12 @@ func (a *MultiAnyAllowance) validateBasicDepth(depth int) error {
 	if len(a.Allowances) == 0 {
 		return errorsmod.Wrap(feegrant.ErrNoAllowance, "allowance list should contain at least one")
 	}
+	if _, ok := SelectionStrategy_name[int32(a.Strategy)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown selection strategy %d", a.Strategy)
+	}
+	if int(a.NextIndex) >= len(a.Allowances) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "next index %d is out of range", a.NextIndex)
+	}
 
 	for i := range a.Allowances {
 		allowance, err := a.GetAllowance(i)
@@ -7,18 +7,25 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +33,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SelectionStrategy defines the order in which a MultiAnyAllowance tries its
+// allowances.
+type SelectionStrategy int32
+
+const (
+	// SELECTION_STRATEGY_FIRST_MATCH tries the allowances in list order.
+	SelectionStrategy_SELECTION_STRATEGY_FIRST_MATCH SelectionStrategy = 0
+	// SELECTION_STRATEGY_CHEAPEST_REMAINING tries the allowances with the least
+	// left to spend in the fee denoms first, so nearly exhausted allowances are
+	// used up before larger ones. Unlimited allowances are tried last.
+	SelectionStrategy_SELECTION_STRATEGY_CHEAPEST_REMAINING SelectionStrategy = 1
+	// SELECTION_STRATEGY_SOONEST_EXPIRING tries first the allowances whose
+	// spend lapses soonest: the period reset of periodic allowances, otherwise
+	// the expiration. Allowances that never lapse are tried last.
+	SelectionStrategy_SELECTION_STRATEGY_SOONEST_EXPIRING SelectionStrategy = 2
+	// SELECTION_STRATEGY_ROUND_ROBIN tries the allowances in list order,
+	// starting after the one that paid last.
+	SelectionStrategy_SELECTION_STRATEGY_ROUND_ROBIN SelectionStrategy = 3
+)
+
+var SelectionStrategy_name = map[int32]string{
+	0: "SELECTION_STRATEGY_FIRST_MATCH",
+	1: "SELECTION_STRATEGY_CHEAPEST_REMAINING",
+	2: "SELECTION_STRATEGY_SOONEST_EXPIRING",
+	3: "SELECTION_STRATEGY_ROUND_ROBIN",
+}
+
+var SelectionStrategy_value = map[string]int32{
+	"SELECTION_STRATEGY_FIRST_MATCH":        0,
+	"SELECTION_STRATEGY_CHEAPEST_REMAINING": 1,
+	"SELECTION_STRATEGY_SOONEST_EXPIRING":   2,
+	"SELECTION_STRATEGY_ROUND_ROBIN":        3,
+}
+
+func (x SelectionStrategy) String() string {
+	return proto.EnumName(SelectionStrategy_name, int32(x))
+}
+
+func (SelectionStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_38e1987a87c7c3e9, []int{0}
+}
+
 // AuthzAllowance creates allowance only authz message for a specific grantee
 type AuthzAllowance struct {
 	// allowance can be any of basic and periodic fee allowance.
@@ -108,18 +157,223 @@ func (m *ContractsAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractsAllowance proto.InternalMessageInfo
 
+// ContractMethodsAllowance creates allowance only for specific execute entry
+// points of specific contracts
+type ContractMethodsAllowance struct {
+	// allowance can be any allowance interface type.
+	Allowance *types.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// List of contracts, and their entry points, that this allowance applies to
+	Contracts []ContractMethods `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts"`
+}
+
+func (m *ContractMethodsAllowance) Reset()         { *m = ContractMethodsAllowance{} }
+func (m *ContractMethodsAllowance) String() string { return proto.CompactTextString(m) }
+func (*ContractMethodsAllowance) ProtoMessage()    {}
+func (*ContractMethodsAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38e1987a87c7c3e9, []int{2}
+}
+func (m *ContractMethodsAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractMethodsAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractMethodsAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractMethodsAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMethodsAllowance.Merge(m, src)
+}
+func (m *ContractMethodsAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractMethodsAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMethodsAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMethodsAllowance proto.InternalMessageInfo
+
+// ContractMethods lists the execute entry points of a contract that a
+// ContractMethodsAllowance pays for
+type ContractMethods struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Top-level keys of the execute msg JSON, e.g. "play" for {"play":{}}
+	Methods []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	// Maximum funds attached to a single execute msg; when empty the attached
+	// funds are not limited
+	MaxFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_funds,json=maxFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_funds"`
+}
+
+func (m *ContractMethods) Reset()         { *m = ContractMethods{} }
+func (m *ContractMethods) String() string { return proto.CompactTextString(m) }
+func (*ContractMethods) ProtoMessage()    {}
+func (*ContractMethods) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38e1987a87c7c3e9, []int{3}
+}
+func (m *ContractMethods) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractMethods) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractMethods.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractMethods) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMethods.Merge(m, src)
+}
+func (m *ContractMethods) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractMethods) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMethods.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMethods proto.InternalMessageInfo
+
+func (m *ContractMethods) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractMethods) GetMethods() []string {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+func (m *ContractMethods) GetMaxFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxFunds
+	}
+	return nil
+}
+
+// RateLimitedAllowance creates allowance that limits how many transactions are
+// paid per rolling window, and the gas and fee of each transaction
+type RateLimitedAllowance struct {
+	// allowance can be any allowance interface type.
+	Allowance *types.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// Length of the rolling window
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+	// Maximum number of transactions paid within any window
+	MaxTxs uint64 `protobuf:"varint,3,opt,name=max_txs,json=maxTxs,proto3" json:"max_txs,omitempty"`
+	// Maximum gas limit of a paid transaction; 0 means no limit
+	MaxGasPerTx uint64 `protobuf:"varint,4,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty"`
+	// Maximum fee of a paid transaction; when empty the fee is not limited
+	MaxFeePerTx github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=max_fee_per_tx,json=maxFeePerTx,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee_per_tx"`
+	// Block times of the transactions paid within the current window, oldest
+	// first
+	RecentTxs []time.Time `protobuf:"bytes,6,rep,name=recent_txs,json=recentTxs,proto3,stdtime" json:"recent_txs"`
+}
+
+func (m *RateLimitedAllowance) Reset()         { *m = RateLimitedAllowance{} }
+func (m *RateLimitedAllowance) String() string { return proto.CompactTextString(m) }
+func (*RateLimitedAllowance) ProtoMessage()    {}
+func (*RateLimitedAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38e1987a87c7c3e9, []int{4}
+}
+func (m *RateLimitedAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitedAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitedAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitedAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitedAllowance.Merge(m, src)
+}
+func (m *RateLimitedAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitedAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitedAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitedAllowance proto.InternalMessageInfo
+
+// MsgFilterAllowance creates allowance only for specific message types,
+// including those executed through authz MsgExec
+type MsgFilterAllowance struct {
+	// allowance can be any allowance interface type.
+	Allowance *types.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// Type URLs of the messages that this allowance applies to. MsgExec is
+	// always unwrapped and cannot be listed itself.
+	AllowedMessages []string `protobuf:"bytes,2,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+}
+
+func (m *MsgFilterAllowance) Reset()         { *m = MsgFilterAllowance{} }
+func (m *MsgFilterAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgFilterAllowance) ProtoMessage()    {}
+func (*MsgFilterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38e1987a87c7c3e9, []int{5}
+}
+func (m *MsgFilterAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFilterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFilterAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFilterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFilterAllowance.Merge(m, src)
+}
+func (m *MsgFilterAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFilterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFilterAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFilterAllowance proto.InternalMessageInfo
+
 // MultiAnyAllowance creates an allowance that pays if any of the internal
 // allowances are met
 type MultiAnyAllowance struct {
 	// allowance can be any allowance interface type.
 	Allowances []*types.Any `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+	// The order in which the allowances are tried
+	Strategy SelectionStrategy `protobuf:"varint,2,opt,name=strategy,proto3,enum=xion.v1.SelectionStrategy" json:"strategy,omitempty"`
+	// Index of the allowance the round robin strategy tries first
+	NextIndex uint32 `protobuf:"varint,3,opt,name=next_index,json=nextIndex,proto3" json:"next_index,omitempty"`
 }
 
 func (m *MultiAnyAllowance) Reset()         { *m = MultiAnyAllowance{} }
 func (m *MultiAnyAllowance) String() string { return proto.CompactTextString(m) }
 func (*MultiAnyAllowance) ProtoMessage()    {}
 func (*MultiAnyAllowance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_38e1987a87c7c3e9, []int{2}
+	return fileDescriptor_38e1987a87c7c3e9, []int{6}
 }
 func (m *MultiAnyAllowance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,41 +403,78 @@ func (m *MultiAnyAllowance) XXX_DiscardUnknown() {
 var xxx_messageInfo_MultiAnyAllowance proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("xion.v1.SelectionStrategy", SelectionStrategy_name, SelectionStrategy_value)
 	proto.RegisterType((*AuthzAllowance)(nil), "xion.v1.AuthzAllowance")
 	proto.RegisterType((*ContractsAllowance)(nil), "xion.v1.ContractsAllowance")
+	proto.RegisterType((*ContractMethodsAllowance)(nil), "xion.v1.ContractMethodsAllowance")
+	proto.RegisterType((*ContractMethods)(nil), "xion.v1.ContractMethods")
+	proto.RegisterType((*RateLimitedAllowance)(nil), "xion.v1.RateLimitedAllowance")
+	proto.RegisterType((*MsgFilterAllowance)(nil), "xion.v1.MsgFilterAllowance")
 	proto.RegisterType((*MultiAnyAllowance)(nil), "xion.v1.MultiAnyAllowance")
 }
 
 func init() { proto.RegisterFile("xion/v1/feegrant.proto", fileDescriptor_38e1987a87c7c3e9) }
 
 var fileDescriptor_38e1987a87c7c3e9 = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xab, 0xc8, 0xcc, 0xcf,
-	0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x62, 0x07, 0x89, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83,
-	0xc5, 0xf4, 0x41, 0x2c, 0x88, 0xb4, 0x94, 0x64, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x98,
-	0x97, 0x54, 0x9a, 0xa6, 0x9f, 0x98, 0x57, 0x09, 0x93, 0x4a, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x8e,
-	0x87, 0xe8, 0x81, 0x70, 0xa0, 0x52, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x22,
-	0xa4, 0xf4, 0x95, 0x91, 0x8b, 0xcf, 0xb1, 0xb4, 0x24, 0xa3, 0xca, 0x31, 0x27, 0x27, 0xbf, 0x3c,
-	0x31, 0x2f, 0x39, 0x55, 0x28, 0x96, 0x8b, 0x33, 0x11, 0xc6, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x36, 0x12, 0xd1, 0x83, 0xd8, 0xa7, 0x07, 0xb3, 0x4f, 0xcf, 0x31, 0xaf, 0xd2, 0x49, 0xf3, 0xd4,
-	0x16, 0x5d, 0x55, 0xa8, 0x05, 0x70, 0xe7, 0x97, 0x19, 0x26, 0xa5, 0x96, 0x24, 0x1a, 0xea, 0xb9,
-	0xa5, 0xa6, 0xc2, 0x8d, 0xf4, 0x0c, 0x42, 0x98, 0x28, 0x64, 0xcb, 0xc5, 0x9b, 0x08, 0xb2, 0x30,
-	0x1e, 0xac, 0x3e, 0x35, 0x55, 0x82, 0x49, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d,
-	0x11, 0xa8, 0x61, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1, 0x25, 0x45, 0x99, 0x79, 0xe9,
-	0x41, 0x3c, 0x60, 0xe5, 0xee, 0x10, 0xd5, 0x56, 0xae, 0x1d, 0x0b, 0xe4, 0x19, 0x88, 0xb6, 0xb8,
-	0xeb, 0xf9, 0x06, 0x2d, 0x61, 0x70, 0x10, 0xa3, 0x7a, 0x52, 0xa9, 0x9d, 0x89, 0x4b, 0xc8, 0x39,
-	0x3f, 0xaf, 0xa4, 0x28, 0x31, 0xb9, 0xa4, 0x98, 0x6e, 0x7e, 0x77, 0xe7, 0x12, 0x4a, 0x86, 0x5a,
-	0x1a, 0x9f, 0x08, 0xf1, 0x64, 0x6a, 0xb1, 0x04, 0x93, 0x02, 0x33, 0xde, 0x00, 0x10, 0x84, 0xe9,
-	0x71, 0x84, 0x69, 0xb1, 0xf2, 0x24, 0x39, 0x14, 0xc4, 0xc1, 0xa1, 0x80, 0xe9, 0x65, 0xa5, 0x7d,
-	0x8c, 0x5c, 0x82, 0xbe, 0xa5, 0x39, 0x25, 0x99, 0x8e, 0x79, 0x95, 0x88, 0x80, 0x88, 0xe7, 0xe2,
-	0x82, 0x3b, 0xbb, 0x58, 0x82, 0x51, 0x81, 0x99, 0x1a, 0x21, 0x81, 0x64, 0xa4, 0x95, 0x07, 0xc9,
-	0x3e, 0x80, 0x64, 0x15, 0x0c, 0xa7, 0x3a, 0x39, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c,
-	0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1,
-	0x1c, 0x43, 0x94, 0x7a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x52,
-	0x69, 0x51, 0x5e, 0x89, 0x6e, 0x4e, 0x62, 0x52, 0xb1, 0x3e, 0xd8, 0x9c, 0x0a, 0x08, 0x55, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x91, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x45, 0xed,
-	0x65, 0x24, 0x8e, 0x03, 0x00, 0x00,
+	// 928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0xa5, 0x6d, 0xa6, 0x6c, 0x9b, 0x9a, 0x8a, 0x75, 0x2b, 0x6d, 0x52, 0x65, 0xb5,
+	0xda, 0xb6, 0x52, 0x6d, 0xb5, 0x08, 0x0e, 0x41, 0x1c, 0x9c, 0x6c, 0x92, 0x5a, 0x6c, 0x92, 0xca,
+	0x31, 0x12, 0x20, 0x21, 0x6b, 0x12, 0x4f, 0x5d, 0x8b, 0x78, 0xa6, 0xf2, 0x4c, 0x5a, 0x87, 0x3f,
+	0x00, 0x42, 0x42, 0xda, 0x0b, 0x12, 0x47, 0x8e, 0x88, 0x0b, 0x7b, 0xd8, 0x1f, 0xb1, 0xe2, 0xb4,
+	0xe2, 0xc4, 0x89, 0xa2, 0xf6, 0xb0, 0xe2, 0x07, 0x70, 0xe0, 0x86, 0x66, 0xc6, 0x4e, 0x77, 0x93,
+	0x52, 0x51, 0xc4, 0xe6, 0x52, 0x77, 0xde, 0xfb, 0xde, 0x7b, 0xf3, 0x7d, 0x6f, 0xde, 0x53, 0xc0,
+	0xdb, 0x71, 0x40, 0xb0, 0x71, 0xb2, 0x6b, 0x1c, 0x22, 0xe4, 0x47, 0x10, 0x33, 0xfd, 0x38, 0x22,
+	0x8c, 0xa8, 0xf3, 0xdc, 0xae, 0x9f, 0xec, 0xae, 0xaf, 0xfa, 0xc4, 0x27, 0xc2, 0x66, 0xf0, 0xff,
+	0xa4, 0x7b, 0x7d, 0xcd, 0x27, 0xc4, 0x1f, 0x20, 0x43, 0x9c, 0x7a, 0xc3, 0x43, 0x03, 0xe2, 0x51,
+	0xea, 0xea, 0x13, 0x1a, 0x12, 0xea, 0xca, 0x18, 0x79, 0x48, 0x5c, 0x2b, 0x30, 0x0c, 0x30, 0x31,
+	0xc4, 0xdf, 0xc4, 0x54, 0x94, 0x00, 0xa3, 0x07, 0x29, 0x32, 0x4e, 0x76, 0x7b, 0x88, 0xc1, 0x5d,
+	0xa3, 0x4f, 0x02, 0x9c, 0xfa, 0x27, 0x0b, 0x79, 0xc3, 0x08, 0x32, 0x7e, 0x37, 0xe9, 0x2f, 0x4d,
+	0xfa, 0x59, 0x10, 0x22, 0xca, 0x60, 0x78, 0x2c, 0x01, 0xe5, 0x3f, 0x15, 0xb0, 0x64, 0x0e, 0xd9,
+	0xd1, 0x17, 0xe6, 0x60, 0x40, 0x4e, 0x21, 0xee, 0x23, 0xf5, 0x33, 0x90, 0x87, 0xe9, 0x41, 0x53,
+	0x36, 0x94, 0xcd, 0xc5, 0xbd, 0x55, 0x5d, 0xe6, 0xd1, 0xd3, 0x3c, 0xba, 0x89, 0x47, 0xd5, 0xad,
+	0x9f, 0x9f, 0xee, 0xdc, 0x4f, 0x18, 0x8c, 0xf5, 0x49, 0x2e, 0xa9, 0x37, 0x10, 0x1a, 0xa7, 0xb4,
+	0xec, 0xcb, 0x8c, 0xea, 0x07, 0xe0, 0x36, 0xe4, 0x05, 0x5d, 0x81, 0x47, 0x48, 0xcb, 0x6e, 0x28,
+	0x9b, 0xf9, 0xaa, 0xf6, 0xcb, 0xd3, 0x9d, 0xd5, 0x24, 0x99, 0xe9, 0x79, 0x11, 0xa2, 0xb4, 0xcb,
+	0xa2, 0x00, 0xfb, 0xf6, 0x9b, 0x02, 0xde, 0x94, 0xe8, 0x4a, 0xfd, 0xab, 0xef, 0x4b, 0x99, 0x7f,
+	0x5d, 0xf8, 0xeb, 0x17, 0x4f, 0xb6, 0xdf, 0x12, 0x3d, 0x7c, 0x95, 0x64, 0xf9, 0xcb, 0x2c, 0x50,
+	0x6b, 0x04, 0xb3, 0x08, 0xf6, 0x19, 0x9d, 0x19, 0xf7, 0x26, 0x50, 0xfb, 0x49, 0x51, 0x17, 0x4a,
+	0x92, 0x88, 0x6a, 0xd9, 0x8d, 0xdc, 0xb5, 0x02, 0xac, 0xa4, 0x31, 0x66, 0x1a, 0x52, 0xb1, 0x6e,
+	0xac, 0xc2, 0x1d, 0xa1, 0xc2, 0x34, 0xe5, 0xf2, 0x37, 0x59, 0xa0, 0xa5, 0xe6, 0x16, 0x62, 0x47,
+	0xc4, 0x9b, 0x9d, 0x1e, 0x26, 0xc8, 0xa7, 0xdc, 0xa4, 0x0c, 0x8b, 0x7b, 0x9a, 0x9e, 0x8c, 0x96,
+	0x3e, 0x71, 0xa9, 0x6a, 0xfe, 0xd9, 0x6f, 0xa5, 0xcc, 0x0f, 0x2f, 0x9e, 0x6c, 0x2b, 0xf6, 0x65,
+	0x54, 0xa5, 0x73, 0x63, 0x25, 0xee, 0xbe, 0xa2, 0xc4, 0x24, 0xe5, 0xf2, 0x99, 0x02, 0x96, 0x27,
+	0x9c, 0x6a, 0x0d, 0x14, 0x26, 0xfb, 0x26, 0xd4, 0xb8, 0xae, 0x6b, 0xcb, 0x13, 0x5d, 0x53, 0x35,
+	0x30, 0x1f, 0xca, 0x7c, 0xb2, 0xe3, 0x76, 0x7a, 0x54, 0x43, 0x90, 0x0f, 0x61, 0xec, 0x1e, 0x0e,
+	0xb1, 0x47, 0xb5, 0x9c, 0x90, 0x61, 0x4d, 0x4f, 0x92, 0xf2, 0xc9, 0x1f, 0x73, 0xa9, 0x91, 0x00,
+	0x57, 0xdf, 0xe5, 0x3a, 0xfc, 0x78, 0x56, 0xda, 0xf4, 0x03, 0x76, 0x34, 0xec, 0xe9, 0x7d, 0x12,
+	0x26, 0x7b, 0x24, 0xf9, 0xec, 0x50, 0xef, 0x73, 0x83, 0x8d, 0x8e, 0x11, 0x15, 0x01, 0x54, 0x6a,
+	0xb6, 0x10, 0xc2, 0xb8, 0xc1, 0x2b, 0x94, 0xff, 0xca, 0x81, 0x55, 0x1b, 0x32, 0xf4, 0x28, 0x08,
+	0x03, 0x86, 0xbc, 0x99, 0x75, 0xfb, 0x7d, 0x30, 0x77, 0x1a, 0x60, 0x8f, 0x9c, 0x8a, 0x91, 0xe7,
+	0x1c, 0x27, 0x73, 0x3f, 0x4c, 0xb6, 0x57, 0x75, 0x81, 0x73, 0xfc, 0xee, 0xac, 0xa4, 0xd8, 0x49,
+	0x88, 0x7a, 0x07, 0xcc, 0x73, 0x8d, 0x58, 0xcc, 0x15, 0x52, 0x36, 0x6f, 0xd9, 0x73, 0x21, 0x8c,
+	0x9d, 0x98, 0xaa, 0xf7, 0xc0, 0x12, 0x77, 0xf8, 0x90, 0xba, 0xc7, 0x28, 0x72, 0x59, 0xac, 0xdd,
+	0x12, 0xfe, 0xc5, 0x10, 0xc6, 0x4d, 0x48, 0x0f, 0x50, 0xe4, 0xc4, 0xea, 0x50, 0x82, 0x0e, 0x11,
+	0x4a, 0x41, 0x6f, 0xbc, 0x26, 0x99, 0x79, 0xd9, 0x06, 0x42, 0xb2, 0x6c, 0x0d, 0x80, 0x08, 0xf5,
+	0x11, 0x66, 0xe2, 0xde, 0x73, 0xa2, 0xe4, 0xfa, 0x14, 0x6b, 0x27, 0xdd, 0xc9, 0x92, 0xf6, 0x63,
+	0x4e, 0x3b, 0x2f, 0xe3, 0x9c, 0x98, 0x56, 0x3e, 0xbc, 0xf1, 0x0b, 0x5f, 0x13, 0x2f, 0xfc, 0xaa,
+	0x16, 0x97, 0xff, 0x50, 0x80, 0xda, 0xa2, 0x7e, 0x23, 0x18, 0x30, 0x14, 0xcd, 0xac, 0xf3, 0x5b,
+	0xa0, 0x20, 0x0e, 0xc8, 0x73, 0x43, 0x44, 0x29, 0xf4, 0xd3, 0xad, 0x67, 0x2f, 0x27, 0xf6, 0x56,
+	0x62, 0xfe, 0xcf, 0x9b, 0x6d, 0x9a, 0x54, 0xf9, 0xdb, 0x2c, 0x58, 0x69, 0x0d, 0x07, 0x2c, 0x30,
+	0xf1, 0xe8, 0x92, 0xaa, 0x0b, 0xc0, 0xf8, 0x62, 0x7c, 0x8a, 0x73, 0xff, 0x07, 0xd7, 0x97, 0x52,
+	0xaa, 0xef, 0x81, 0x05, 0xca, 0x22, 0xc8, 0x90, 0x3f, 0x12, 0x0f, 0x7d, 0x69, 0x6f, 0x7d, 0xbc,
+	0xd3, 0xba, 0x68, 0x80, 0xfa, 0xfc, 0x85, 0x77, 0x13, 0x84, 0x3d, 0xc6, 0xaa, 0x77, 0x01, 0xc0,
+	0x28, 0x66, 0x6e, 0x80, 0x3d, 0x14, 0x8b, 0x47, 0x7e, 0xdb, 0xce, 0x73, 0x8b, 0xc5, 0x0d, 0x95,
+	0xfd, 0x1b, 0x0b, 0x23, 0x7f, 0xbc, 0x4c, 0x29, 0xb0, 0xfd, 0x93, 0x02, 0x56, 0xa6, 0x2e, 0xa2,
+	0x96, 0x41, 0xb1, 0x5b, 0x7f, 0x54, 0xaf, 0x39, 0x56, 0xa7, 0xed, 0x76, 0x1d, 0xdb, 0x74, 0xea,
+	0xcd, 0x4f, 0xdc, 0x86, 0x65, 0x77, 0x1d, 0xb7, 0x65, 0x3a, 0xb5, 0xfd, 0x42, 0x46, 0xdd, 0x02,
+	0xf7, 0xaf, 0xc0, 0xd4, 0xf6, 0xeb, 0xe6, 0x41, 0xbd, 0xeb, 0xb8, 0x76, 0xbd, 0x65, 0x5a, 0x6d,
+	0xab, 0xdd, 0x2c, 0x28, 0xea, 0x03, 0x70, 0xef, 0x0a, 0x68, 0xb7, 0xd3, 0x69, 0x73, 0x64, 0xfd,
+	0xe3, 0x03, 0xcb, 0xe6, 0xc0, 0xec, 0x3f, 0xd4, 0xb5, 0x3b, 0x1f, 0xb5, 0x1f, 0xba, 0x76, 0xa7,
+	0x6a, 0xb5, 0x0b, 0xb9, 0xaa, 0xf9, 0xec, 0xbc, 0xa8, 0x3c, 0x3f, 0x2f, 0x2a, 0xbf, 0x9f, 0x17,
+	0x95, 0xc7, 0x17, 0xc5, 0xcc, 0xf3, 0x8b, 0x62, 0xe6, 0xd7, 0x8b, 0x62, 0xe6, 0xd3, 0x07, 0x2f,
+	0x4d, 0x67, 0x6f, 0x18, 0x61, 0xb6, 0x33, 0x80, 0x3d, 0x6a, 0x08, 0xe6, 0xb1, 0xfc, 0x88, 0x11,
+	0xed, 0xcd, 0x89, 0xd6, 0xbe, 0xf3, 0xf7, 0x00, 0x6f, 0x77, 0xd6, 0xeb, 0xd2, 0x09, 0x00, 0x00,
 }
 
 func (m *AuthzAllowance) Marshal() (dAtA []byte, err error) {
@@ -272,7 +563,7 @@ func (m *ContractsAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiAnyAllowance) Marshal() (dAtA []byte, err error) {
+func (m *ContractMethodsAllowance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -282,102 +573,943 @@ func (m *MultiAnyAllowance) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiAnyAllowance) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractMethodsAllowance) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiAnyAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractMethodsAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Allowances) > 0 {
-		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMethods) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMethods) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMethods) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxFunds) > 0 {
+		for iNdEx := len(m.MaxFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Methods[iNdEx])
+			copy(dAtA[i:], m.Methods[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Methods[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitedAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitedAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitedAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecentTxs) > 0 {
+		for iNdEx := len(m.RecentTxs) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecentTxs[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecentTxs[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintFeegrant(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MaxFeePerTx) > 0 {
+		for iNdEx := len(m.MaxFeePerTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFeePerTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxGasPerTx != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxGasPerTx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxTxs != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxTxs))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintFeegrant(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFilterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFilterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFilterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiAnyAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiAnyAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiAnyAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextIndex != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.NextIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Strategy != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeegrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuthzAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.AuthzGrantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractsAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.ContractAddresses) > 0 {
+		for _, s := range m.ContractAddresses {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractMethodsAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractMethods) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.MaxFunds) > 0 {
+		for _, e := range m.MaxFunds {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimitedAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if m.MaxTxs != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxTxs))
+	}
+	if m.MaxGasPerTx != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxGasPerTx))
+	}
+	if len(m.MaxFeePerTx) > 0 {
+		for _, e := range m.MaxFeePerTx {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.RecentTxs) > 0 {
+		for _, e := range m.RecentTxs {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdTime(e)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFilterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiAnyAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Strategy != 0 {
+		n += 1 + sovFeegrant(uint64(m.Strategy))
+	}
+	if m.NextIndex != 0 {
+		n += 1 + sovFeegrant(uint64(m.NextIndex))
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AuthzAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthzAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthzAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthzGrantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthzGrantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractsAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractsAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractsAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMethodsAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMethodsAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMethodsAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, ContractMethods{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMethods) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMethods: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMethods: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeegrant(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *AuthzAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovFeegrant(uint64(l))
-	}
-	l = len(m.AuthzGrantee)
-	if l > 0 {
-		n += 1 + l + sovFeegrant(uint64(l))
-	}
-	return n
-}
-
-func (m *ContractsAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovFeegrant(uint64(l))
-	}
-	if len(m.ContractAddresses) > 0 {
-		for _, s := range m.ContractAddresses {
-			l = len(s)
-			n += 1 + l + sovFeegrant(uint64(l))
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFunds = append(m.MaxFunds, types1.Coin{})
+			if err := m.MaxFunds[len(m.MaxFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MultiAnyAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Allowances) > 0 {
-		for _, e := range m.Allowances {
-			l = e.Size()
-			n += 1 + l + sovFeegrant(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovFeegrant(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeegrant(x uint64) (n int) {
-	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AuthzAllowance) Unmarshal(dAtA []byte) error {
+func (m *RateLimitedAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -400,10 +1532,10 @@ func (m *AuthzAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthzAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitedAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthzAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitedAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -444,9 +1576,9 @@ func (m *AuthzAllowance) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthzGrantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -456,23 +1588,130 @@ func (m *AuthzAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthzGrantee = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxs", wireType)
+			}
+			m.MaxTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerTx", wireType)
+			}
+			m.MaxGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFeePerTx = append(m.MaxFeePerTx, types1.Coin{})
+			if err := m.MaxFeePerTx[len(m.MaxFeePerTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentTxs = append(m.RecentTxs, time.Time{})
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&(m.RecentTxs[len(m.RecentTxs)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -495,7 +1734,7 @@ func (m *AuthzAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractsAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgFilterAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -518,10 +1757,10 @@ func (m *ContractsAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractsAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFilterAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractsAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFilterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -562,7 +1801,7 @@ func (m *ContractsAllowance) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -590,7 +1829,7 @@ func (m *ContractsAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -676,6 +1915,44 @@ func (m *MultiAnyAllowance) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= SelectionStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIndex", wireType)
+			}
+			m.NextIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
@@ -9,6 +9,8 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/feegrant"
@@ -1053,3 +1055,710 @@ func TestMultiAnyAllowance_ExpiresAt(t *testing.T) {
 		require.Contains(t, err.Error(), "failed to get allowance")
 	})
 }
+
+func TestContractMethodsAllowance_Accept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	game := sdk.MustAccAddressFromBech32("cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr")
+	other := sdk.MustAccAddressFromBech32("cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x")
+	contracts := []xiontypes.ContractMethods{
+		{
+			ContractAddress: game.String(),
+			Methods:         []string{"play", "claim_reward"},
+			MaxFunds:        sdk.NewCoins(sdk.NewInt64Coin("uxion", 100)),
+		},
+		{
+			ContractAddress: other.String(),
+			Methods:         []string{"register"},
+		},
+	}
+
+	execute := func(contract sdk.AccAddress, msg string, funds sdk.Coins) sdk.Msg {
+		return &wasmtypes.MsgExecuteContract{Contract: contract.String(), Msg: []byte(msg), Funds: funds}
+	}
+
+	cases := map[string]struct {
+		msgs   []sdk.Msg
+		accept bool
+	}{
+		"allowed method": {
+			msgs:   []sdk.Msg{execute(game, `{"play":{"move":"e4"}}`, nil)},
+			accept: true,
+		},
+		"allowed methods on several contracts": {
+			msgs: []sdk.Msg{
+				execute(game, `{"claim_reward":{}}`, nil),
+				execute(other, `{"register":{}}`, nil),
+			},
+			accept: true,
+		},
+		"funds within cap": {
+			msgs:   []sdk.Msg{execute(game, `{"play":{}}`, sdk.NewCoins(sdk.NewInt64Coin("uxion", 100)))},
+			accept: true,
+		},
+		"funds without cap": {
+			msgs:   []sdk.Msg{execute(other, `{"register":{}}`, sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000)))},
+			accept: true,
+		},
+		"method not allowed": {
+			msgs: []sdk.Msg{execute(game, `{"withdraw":{}}`, nil)},
+		},
+		"method allowed on another contract only": {
+			msgs: []sdk.Msg{execute(game, `{"register":{}}`, nil)},
+		},
+		"one of several msgs not allowed": {
+			msgs: []sdk.Msg{
+				execute(game, `{"play":{}}`, nil),
+				execute(game, `{"transfer_ownership":{"new_owner":"x"}}`, nil),
+			},
+		},
+		"contract not allowed": {
+			msgs: []sdk.Msg{execute(sdk.AccAddress("unknown_contract_1234"), `{"play":{}}`, nil)},
+		},
+		"funds above cap": {
+			msgs: []sdk.Msg{execute(game, `{"play":{}}`, sdk.NewCoins(sdk.NewInt64Coin("uxion", 101)))},
+		},
+		"funds in uncapped denom": {
+			msgs: []sdk.Msg{execute(game, `{"play":{}}`, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1)))},
+		},
+		"several top-level keys": {
+			msgs: []sdk.Msg{execute(game, `{"play":{},"withdraw":{}}`, nil)},
+		},
+		"not a JSON object": {
+			msgs: []sdk.Msg{execute(game, `"play"`, nil)},
+		},
+		"not a contract execution": {
+			msgs: []sdk.Msg{&banktypes.MsgSend{}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := xiontypes.NewContractMethodsAllowance(&feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000)),
+			}, contracts)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			remove, err := allowance.Accept(testCtx.Ctx, sdk.NewCoins(sdk.NewInt64Coin("uxion", 10)), tc.msgs)
+			if !tc.accept {
+				require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, remove)
+
+			// the fee is deducted from the inner allowance
+			inner, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uxion", 990)), inner.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
+
+func TestContractMethodsAllowance_ValidateBasic(t *testing.T) {
+	validAllowance := &feegrant.BasicAllowance{
+		SpendLimit: sdk.Coins{sdk.Coin{Denom: "uxion", Amount: sdkmath.NewInt(100)}},
+	}
+	validAddress := sdk.AccAddress("validcontract123456789012345").String()
+
+	tests := map[string]struct {
+		contracts     []xiontypes.ContractMethods
+		expectError   bool
+		errorContains string
+	}{
+		"valid allowance": {
+			contracts: []xiontypes.ContractMethods{{
+				ContractAddress: validAddress,
+				Methods:         []string{"play"},
+				MaxFunds:        sdk.NewCoins(sdk.NewInt64Coin("uxion", 1)),
+			}},
+		},
+		"no contracts": {
+			contracts:     nil,
+			expectError:   true,
+			errorContains: "must set contracts for feegrant",
+		},
+		"invalid contract address": {
+			contracts:   []xiontypes.ContractMethods{{ContractAddress: "invalid-address", Methods: []string{"play"}}},
+			expectError: true,
+		},
+		"no methods": {
+			contracts:     []xiontypes.ContractMethods{{ContractAddress: validAddress}},
+			expectError:   true,
+			errorContains: "must set methods",
+		},
+		"empty method": {
+			contracts:     []xiontypes.ContractMethods{{ContractAddress: validAddress, Methods: []string{""}}},
+			expectError:   true,
+			errorContains: "empty method",
+		},
+		"duplicate method": {
+			contracts:     []xiontypes.ContractMethods{{ContractAddress: validAddress, Methods: []string{"play", "play"}}},
+			expectError:   true,
+			errorContains: "duplicate method",
+		},
+		"duplicate contract": {
+			contracts: []xiontypes.ContractMethods{
+				{ContractAddress: validAddress, Methods: []string{"play"}},
+				{ContractAddress: validAddress, Methods: []string{"withdraw"}},
+			},
+			expectError:   true,
+			errorContains: "duplicate contract",
+		},
+		"invalid max funds": {
+			contracts: []xiontypes.ContractMethods{{
+				ContractAddress: validAddress,
+				Methods:         []string{"play"},
+				MaxFunds:        sdk.Coins{sdk.Coin{Denom: "uxion", Amount: sdkmath.NewInt(-1)}},
+			}},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := xiontypes.NewContractMethodsAllowance(validAllowance, tc.contracts)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.expectError {
+				require.Error(t, err)
+				if tc.errorContains != "" {
+					require.Contains(t, err.Error(), tc.errorContains)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("nil allowance", func(t *testing.T) {
+		allowance := &xiontypes.ContractMethodsAllowance{
+			Contracts: []xiontypes.ContractMethods{{ContractAddress: validAddress, Methods: []string{"play"}}},
+		}
+		require.ErrorContains(t, allowance.ValidateBasic(), "allowance should not be empty")
+	})
+}
+
+func TestExecuteMsgMethod(t *testing.T) {
+	method, err := xiontypes.ExecuteMsgMethod([]byte(`{"play":{"move":"e4"}}`))
+	require.NoError(t, err)
+	require.Equal(t, "play", method)
+
+	for _, msg := range []string{`{}`, `{"a":{},"b":{}}`, `[]`, `not json`} {
+		_, err := xiontypes.ExecuteMsgMethod([]byte(msg))
+		require.Error(t, err, msg)
+	}
+}
+
+func TestRateLimitedAllowance_Accept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uxion", 10))
+	msgs := []sdk.Msg{&banktypes.MsgSend{}}
+
+	newAllowance := func(t *testing.T) *xiontypes.RateLimitedAllowance {
+		allowance, err := xiontypes.NewRateLimitedAllowance(&feegrant.BasicAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000)),
+		}, time.Hour, 2, 200_000, sdk.NewCoins(sdk.NewInt64Coin("uxion", 50)))
+		require.NoError(t, err)
+		require.NoError(t, allowance.ValidateBasic())
+		return allowance
+	}
+	ctxAt := func(blockTime time.Time, gasLimit uint64) sdk.Context {
+		return testCtx.Ctx.WithBlockTime(blockTime).WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	}
+
+	t.Run("limits txs per rolling window", func(t *testing.T) {
+		allowance := newAllowance(t)
+
+		_, err := allowance.Accept(ctxAt(now, 100_000), fee, msgs)
+		require.NoError(t, err)
+		_, err = allowance.Accept(ctxAt(now.Add(30*time.Minute), 100_000), fee, msgs)
+		require.NoError(t, err)
+		_, err = allowance.Accept(ctxAt(now.Add(59*time.Minute), 100_000), fee, msgs)
+		require.ErrorIs(t, err, xiontypes.ErrRateLimitExceeded)
+
+		// the first tx left the window, the second did not
+		_, err = allowance.Accept(ctxAt(now.Add(time.Hour), 100_000), fee, msgs)
+		require.NoError(t, err)
+		require.Equal(t, []time.Time{now.Add(30 * time.Minute), now.Add(time.Hour)}, allowance.RecentTxs)
+		_, err = allowance.Accept(ctxAt(now.Add(80*time.Minute), 100_000), fee, msgs)
+		require.ErrorIs(t, err, xiontypes.ErrRateLimitExceeded)
+
+		// the inner allowance was charged for the three accepted txs only
+		inner, err := allowance.GetAllowance()
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uxion", 970)), inner.(*feegrant.BasicAllowance).SpendLimit)
+	})
+
+	t.Run("limits fee per tx", func(t *testing.T) {
+		allowance := newAllowance(t)
+		_, err := allowance.Accept(ctxAt(now, 100_000), sdk.NewCoins(sdk.NewInt64Coin("uxion", 51)), msgs)
+		require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+		_, err = allowance.Accept(ctxAt(now, 100_000), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1)), msgs)
+		require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+		require.Empty(t, allowance.RecentTxs)
+	})
+
+	t.Run("limits gas per tx", func(t *testing.T) {
+		allowance := newAllowance(t)
+		_, err := allowance.Accept(ctxAt(now, 200_001), fee, msgs)
+		require.ErrorIs(t, err, xiontypes.ErrRateLimitExceeded)
+
+		// simulations have no gas limit to check
+		_, err = allowance.Accept(ctxAt(now, 200_001).WithExecMode(sdk.ExecModeSimulate), fee, msgs)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejected inner allowance is not counted", func(t *testing.T) {
+		allowance := newAllowance(t)
+		_, err := allowance.Accept(ctxAt(now, 100_000), sdk.NewCoins(sdk.NewInt64Coin("uxion", 50)), msgs)
+		require.NoError(t, err)
+
+		expired := now.Add(-time.Minute)
+		require.NoError(t, allowance.SetAllowance(&feegrant.BasicAllowance{Expiration: &expired}))
+		_, err = allowance.Accept(ctxAt(now, 100_000), fee, msgs)
+		require.Error(t, err)
+		require.Len(t, allowance.RecentTxs, 1)
+	})
+
+	t.Run("removed with the inner allowance", func(t *testing.T) {
+		allowance, err := xiontypes.NewRateLimitedAllowance(&feegrant.BasicAllowance{
+			SpendLimit: fee,
+		}, time.Hour, 2, 0, nil)
+		require.NoError(t, err)
+
+		// no gas or fee limits are set
+		remove, err := allowance.Accept(ctxAt(now, 10_000_000), fee, msgs)
+		require.NoError(t, err)
+		require.True(t, remove)
+	})
+}
+
+func TestRateLimitedAllowance_ValidateBasic(t *testing.T) {
+	validAllowance := &feegrant.BasicAllowance{
+		SpendLimit: sdk.Coins{sdk.Coin{Denom: "uxion", Amount: sdkmath.NewInt(100)}},
+	}
+
+	tests := map[string]struct {
+		modify        func(a *xiontypes.RateLimitedAllowance)
+		expectError   bool
+		errorContains string
+	}{
+		"valid allowance": {
+			modify: func(*xiontypes.RateLimitedAllowance) {},
+		},
+		"nil allowance": {
+			modify:        func(a *xiontypes.RateLimitedAllowance) { a.Allowance = nil },
+			expectError:   true,
+			errorContains: "allowance should not be empty",
+		},
+		"zero window": {
+			modify:        func(a *xiontypes.RateLimitedAllowance) { a.Window = 0 },
+			expectError:   true,
+			errorContains: "window must be positive",
+		},
+		"zero max txs": {
+			modify:        func(a *xiontypes.RateLimitedAllowance) { a.MaxTxs = 0 },
+			expectError:   true,
+			errorContains: "max txs must be between",
+		},
+		"too many max txs": {
+			modify:        func(a *xiontypes.RateLimitedAllowance) { a.MaxTxs = 1001 },
+			expectError:   true,
+			errorContains: "max txs must be between",
+		},
+		"invalid max fee": {
+			modify: func(a *xiontypes.RateLimitedAllowance) {
+				a.MaxFeePerTx = sdk.Coins{sdk.Coin{Denom: "uxion", Amount: sdkmath.NewInt(-1)}}
+			},
+			expectError: true,
+		},
+		"more recent txs than max txs": {
+			modify: func(a *xiontypes.RateLimitedAllowance) {
+				a.RecentTxs = make([]time.Time, a.MaxTxs+1)
+			},
+			expectError:   true,
+			errorContains: "recent txs exceed",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := xiontypes.NewRateLimitedAllowance(validAllowance, time.Hour, 10, 0, nil)
+			require.NoError(t, err)
+			tc.modify(allowance)
+
+			err = allowance.ValidateBasic()
+			if tc.expectError {
+				require.Error(t, err)
+				if tc.errorContains != "" {
+					require.Contains(t, err.Error(), tc.errorContains)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgFilterAllowance_Accept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	grantee := sdk.MustAccAddressFromBech32("cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x")
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uxion", 10))
+
+	sendMsg := &banktypes.MsgSend{}
+	wasmMsg := &wasmtypes.MsgExecuteContract{}
+	otherMsg := &banktypes.MsgMultiSend{}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		execMsg := authz.NewMsgExec(grantee, msgs)
+		return &execMsg
+	}
+	nested := func(depth int, msg sdk.Msg) sdk.Msg {
+		for i := 0; i < depth; i++ {
+			msg = exec(msg)
+		}
+		return msg
+	}
+
+	cases := map[string]struct {
+		msgs   []sdk.Msg
+		accept bool
+	}{
+		"allowed msg":                   {msgs: []sdk.Msg{sendMsg}, accept: true},
+		"mix of allowed msgs":           {msgs: []sdk.Msg{sendMsg, wasmMsg}, accept: true},
+		"allowed msgs in MsgExec":       {msgs: []sdk.Msg{exec(sendMsg, wasmMsg)}, accept: true},
+		"MsgExec next to allowed msg":   {msgs: []sdk.Msg{wasmMsg, exec(sendMsg)}, accept: true},
+		"allowed msg at maximum depth":  {msgs: []sdk.Msg{nested(5, sendMsg)}, accept: true},
+		"msg not allowed":               {msgs: []sdk.Msg{otherMsg}},
+		"one msg not allowed":           {msgs: []sdk.Msg{sendMsg, otherMsg}},
+		"msg not allowed in MsgExec":    {msgs: []sdk.Msg{exec(sendMsg, otherMsg)}},
+		"msg not allowed deep":          {msgs: []sdk.Msg{nested(3, otherMsg)}},
+		"MsgExec nested beyond maximum": {msgs: []sdk.Msg{nested(6, sendMsg)}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := xiontypes.NewMsgFilterAllowance(&feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000)),
+			}, []string{sdk.MsgTypeURL(sendMsg), sdk.MsgTypeURL(wasmMsg)})
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			gasBefore := testCtx.Ctx.GasMeter().GasConsumed()
+			_, err = allowance.Accept(testCtx.Ctx, fee, tc.msgs)
+			require.Greater(t, testCtx.Ctx.GasMeter().GasConsumed(), gasBefore)
+			if !tc.accept {
+				require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+				return
+			}
+			require.NoError(t, err)
+
+			inner, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uxion", 990)), inner.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+
+	t.Run("composes with MultiAnyAllowance", func(t *testing.T) {
+		bankOnly, err := xiontypes.NewMsgFilterAllowance(&feegrant.BasicAllowance{}, []string{sdk.MsgTypeURL(sendMsg)})
+		require.NoError(t, err)
+		wasmOnly, err := xiontypes.NewMsgFilterAllowance(&feegrant.BasicAllowance{}, []string{sdk.MsgTypeURL(wasmMsg)})
+		require.NoError(t, err)
+		multi, err := xiontypes.NewMultiAnyAllowance([]feegrant.FeeAllowanceI{bankOnly, wasmOnly})
+		require.NoError(t, err)
+		require.NoError(t, multi.ValidateBasic())
+
+		_, err = multi.Accept(testCtx.Ctx, fee, []sdk.Msg{exec(wasmMsg)})
+		require.NoError(t, err)
+		_, err = multi.Accept(testCtx.Ctx, fee, []sdk.Msg{otherMsg})
+		require.ErrorIs(t, err, xiontypes.ErrNoValidAllowances)
+	})
+}
+
+func TestMsgFilterAllowance_ValidateBasic(t *testing.T) {
+	validAllowance := &feegrant.BasicAllowance{
+		SpendLimit: sdk.Coins{sdk.Coin{Denom: "uxion", Amount: sdkmath.NewInt(100)}},
+	}
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	tests := map[string]struct {
+		allowedMsgs   []string
+		expectError   bool
+		errorContains string
+	}{
+		"valid allowance": {
+			allowedMsgs: []string{sendTypeURL, sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{})},
+		},
+		"no allowed messages": {
+			expectError:   true,
+			errorContains: "must set allowed messages",
+		},
+		"not a type url": {
+			allowedMsgs:   []string{"cosmos.bank.v1beta1.MsgSend"},
+			expectError:   true,
+			errorContains: "is not a message type url",
+		},
+		"MsgExec": {
+			allowedMsgs:   []string{sdk.MsgTypeURL(&authz.MsgExec{})},
+			expectError:   true,
+			errorContains: "always unwrapped",
+		},
+		"duplicate message": {
+			allowedMsgs:   []string{sendTypeURL, sendTypeURL},
+			expectError:   true,
+			errorContains: "duplicate message",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := xiontypes.NewMsgFilterAllowance(validAllowance, tc.allowedMsgs)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.expectError {
+				require.Error(t, err)
+				if tc.errorContains != "" {
+					require.Contains(t, err.Error(), tc.errorContains)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("nil allowance", func(t *testing.T) {
+		allowance := &xiontypes.MsgFilterAllowance{AllowedMessages: []string{sendTypeURL}}
+		require.ErrorContains(t, allowance.ValidateBasic(), "allowance should not be empty")
+	})
+}
+
+func TestMultiAnyAllowance_SelectionStrategy(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uxion", 10))
+	msgs := []sdk.Msg{&banktypes.MsgSend{}}
+
+	uxion := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uxion", amount)) }
+	periodic := func(canSpend int64, reset time.Time) *feegrant.PeriodicAllowance {
+		return &feegrant.PeriodicAllowance{
+			Period:           24 * time.Hour,
+			PeriodSpendLimit: uxion(1000),
+			PeriodCanSpend:   uxion(canSpend),
+			PeriodReset:      reset,
+		}
+	}
+
+	// usedIndex accepts the fee and returns the index of the allowance that
+	// paid, as reported by the event
+	usedIndex := func(t *testing.T, multi *xiontypes.MultiAnyAllowance) string {
+		ctx := testCtx.Ctx.WithBlockTime(now).WithEventManager(sdk.NewEventManager())
+		_, err := multi.Accept(ctx, fee, msgs)
+		require.NoError(t, err)
+
+		event := findEvent(t, ctx.EventManager().Events(), xiontypes.EventTypeMultiAnyAllowanceUsed)
+		index, ok := event.GetAttribute(xiontypes.AttributeKeyAllowanceIndex)
+		require.True(t, ok)
+		strategy, ok := event.GetAttribute(xiontypes.AttributeKeyStrategy)
+		require.True(t, ok)
+		require.Equal(t, multi.Strategy.String(), strategy.Value)
+		return index.Value
+	}
+	newMulti := func(t *testing.T, strategy xiontypes.SelectionStrategy, allowances ...feegrant.FeeAllowanceI) *xiontypes.MultiAnyAllowance {
+		multi, err := xiontypes.NewMultiAnyAllowance(allowances)
+		require.NoError(t, err)
+		multi.Strategy = strategy
+		require.NoError(t, multi.ValidateBasic())
+		return multi
+	}
+
+	t.Run("first match", func(t *testing.T) {
+		multi := newMulti(t, xiontypes.SelectionStrategy_SELECTION_STRATEGY_FIRST_MATCH,
+			&feegrant.BasicAllowance{SpendLimit: uxion(500)},
+			&feegrant.BasicAllowance{SpendLimit: uxion(20)},
+		)
+		require.Equal(t, "0", usedIndex(t, multi))
+		require.Equal(t, "0", usedIndex(t, multi))
+	})
+
+	t.Run("cheapest remaining", func(t *testing.T) {
+		multi := newMulti(t, xiontypes.SelectionStrategy_SELECTION_STRATEGY_CHEAPEST_REMAINING,
+			&feegrant.BasicAllowance{},
+			&feegrant.BasicAllowance{SpendLimit: uxion(500)},
+			&feegrant.BasicAllowance{SpendLimit: uxion(15)},
+			periodic(100, now.Add(time.Hour)),
+		)
+		// the nearly exhausted allowance pays until it can no longer cover the fee
+		require.Equal(t, "2", usedIndex(t, multi))
+		require.Equal(t, "3", usedIndex(t, multi))
+
+		// unlimited allowances are used last
+		multi = newMulti(t, xiontypes.SelectionStrategy_SELECTION_STRATEGY_CHEAPEST_REMAINING,
+			&feegrant.BasicAllowance{},
+			&feegrant.BasicAllowance{SpendLimit: uxion(500)},
+		)
+		require.Equal(t, "1", usedIndex(t, multi))
+	})
+
+	t.Run("soonest expiring", func(t *testing.T) {
+		multi := newMulti(t, xiontypes.SelectionStrategy_SELECTION_STRATEGY_SOONEST_EXPIRING,
+			&feegrant.BasicAllowance{},
+			periodic(100, now.Add(2*time.Hour)),
+			periodic(100, now.Add(time.Hour)),
+		)
+		require.Equal(t, "2", usedIndex(t, multi))
+	})
+
+	t.Run("round robin", func(t *testing.T) {
+		multi := newMulti(t, xiontypes.SelectionStrategy_SELECTION_STRATEGY_ROUND_ROBIN,
+			&feegrant.BasicAllowance{SpendLimit: uxion(500)},
+			&feegrant.BasicAllowance{SpendLimit: uxion(10)},
+			&feegrant.BasicAllowance{SpendLimit: uxion(500)},
+		)
+		require.Equal(t, "0", usedIndex(t, multi))
+		require.Equal(t, uint32(1), multi.NextIndex)
+
+		// the second allowance is used up and removed, the third takes its index
+		require.Equal(t, "1", usedIndex(t, multi))
+		require.Len(t, multi.Allowances, 2)
+		require.Equal(t, uint32(1), multi.NextIndex)
+
+		require.Equal(t, "1", usedIndex(t, multi))
+		require.Equal(t, uint32(0), multi.NextIndex)
+		require.Equal(t, "0", usedIndex(t, multi))
+	})
+
+	t.Run("skips allowances that error", func(t *testing.T) {
+		multi := newMulti(t, xiontypes.SelectionStrategy_SELECTION_STRATEGY_CHEAPEST_REMAINING,
+			&feegrant.BasicAllowance{SpendLimit: uxion(500)},
+			&feegrant.BasicAllowance{SpendLimit: uxion(5)},
+		)
+		require.Equal(t, "0", usedIndex(t, multi))
+	})
+}
+
+func TestMultiAnyAllowance_ValidateBasic_Strategy(t *testing.T) {
+	multi, err := xiontypes.NewMultiAnyAllowance([]feegrant.FeeAllowanceI{&feegrant.BasicAllowance{}, &feegrant.BasicAllowance{}})
+	require.NoError(t, err)
+	require.NoError(t, multi.ValidateBasic())
+
+	multi.Strategy = xiontypes.SelectionStrategy(42)
+	require.ErrorContains(t, multi.ValidateBasic(), "unknown selection strategy")
+
+	multi.Strategy = xiontypes.SelectionStrategy_SELECTION_STRATEGY_ROUND_ROBIN
+	multi.NextIndex = 2
+	require.ErrorContains(t, multi.ValidateBasic(), "next index 2 is out of range")
+}
+
+// findEvent returns the only event of eventType.
+func findEvent(t *testing.T, events sdk.Events, eventType string) sdk.Event {
+	t.Helper()
+	var found []sdk.Event
+	for _, event := range events {
+		if event.Type == eventType {
+			found = append(found, event)
+		}
+	}
+	require.Len(t, found, 1, "%s events", eventType)
+	return found[0]
+}
+
+func TestFeeAllowanceUsedEvent(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	granter := sdk.AccAddress("fee_granter_12345678")
+	grantee := sdk.AccAddress("fee_grantee_12345678")
+	contract := sdk.AccAddress("fee_contract_1234567")
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uxion", 10))
+	uxion := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uxion", amount)) }
+	sendMsgs := []sdk.Msg{&banktypes.MsgSend{}}
+	wasmMsgs := []sdk.Msg{&wasmtypes.MsgExecuteContract{Contract: contract.String(), Msg: []byte(`{"play":{}}`)}}
+
+	mustAllowance := func(allowance feegrant.FeeAllowanceI, err error) feegrant.FeeAllowanceI {
+		require.NoError(t, err)
+		return allowance
+	}
+	execMsgs := func() []sdk.Msg {
+		execMsg := authz.NewMsgExec(grantee, sendMsgs)
+		return []sdk.Msg{&execMsg}
+	}()
+
+	cases := map[string]struct {
+		allowance feegrant.FeeAllowanceI
+		msgs      []sdk.Msg
+		remaining string
+	}{
+		"authz allowance": {
+			allowance: mustAllowance(xiontypes.NewAuthzAllowance(&feegrant.BasicAllowance{SpendLimit: uxion(100)}, grantee)),
+			msgs:      execMsgs,
+			remaining: "90uxion",
+		},
+		"contracts allowance": {
+			allowance: mustAllowance(xiontypes.NewContractsAllowance(&feegrant.BasicAllowance{}, []sdk.AccAddress{contract})),
+			msgs:      wasmMsgs,
+			remaining: xiontypes.RemainingUnlimited,
+		},
+		"contract methods allowance": {
+			allowance: mustAllowance(xiontypes.NewContractMethodsAllowance(&feegrant.BasicAllowance{SpendLimit: uxion(10)}, []xiontypes.ContractMethods{
+				{ContractAddress: contract.String(), Methods: []string{"play"}},
+			})),
+			msgs:      wasmMsgs,
+			remaining: "0",
+		},
+		"rate limited allowance": {
+			allowance: mustAllowance(xiontypes.NewRateLimitedAllowance(&feegrant.BasicAllowance{SpendLimit: uxion(100)}, time.Hour, 1, 0, nil)),
+			msgs:      sendMsgs,
+			remaining: "90uxion",
+		},
+		"msg filter allowance": {
+			allowance: mustAllowance(xiontypes.NewMsgFilterAllowance(&feegrant.BasicAllowance{SpendLimit: uxion(100)}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})),
+			msgs:      sendMsgs,
+			remaining: "90uxion",
+		},
+		"nested allowances report once": {
+			allowance: mustAllowance(xiontypes.NewMultiAnyAllowance([]feegrant.FeeAllowanceI{
+				mustAllowance(xiontypes.NewMsgFilterAllowance(&feegrant.BasicAllowance{SpendLimit: uxion(100)}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})),
+				&feegrant.BasicAllowance{SpendLimit: uxion(50)},
+			})),
+			msgs:      sendMsgs,
+			remaining: "140uxion",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := xiontypes.WithFeeGrantParties(testCtx.Ctx.WithEventManager(sdk.NewEventManager()), granter, grantee)
+			_, err := tc.allowance.Accept(ctx, fee, tc.msgs)
+			require.NoError(t, err)
+
+			event := findEvent(t, ctx.EventManager().Events(), xiontypes.EventTypeFeeAllowanceUsed)
+			expected := map[string]string{
+				feegrant.AttributeKeyGranter:        granter.String(),
+				feegrant.AttributeKeyGrantee:        grantee.String(),
+				xiontypes.AttributeKeyAllowanceType: sdk.MsgTypeURL(tc.allowance.(proto.Message)),
+				sdk.AttributeKeyFee:                 fee.String(),
+				xiontypes.AttributeKeyRemaining:     tc.remaining,
+			}
+			for key, value := range expected {
+				attr, ok := event.GetAttribute(key)
+				require.True(t, ok, key)
+				require.Equal(t, value, attr.Value, key)
+			}
+		})
+	}
+
+	t.Run("not emitted when the allowance rejects", func(t *testing.T) {
+		allowance := mustAllowance(xiontypes.NewContractsAllowance(&feegrant.BasicAllowance{}, []sdk.AccAddress{contract}))
+		ctx := testCtx.Ctx.WithEventManager(sdk.NewEventManager())
+		_, err := allowance.Accept(ctx, fee, sendMsgs)
+		require.Error(t, err)
+		require.Empty(t, ctx.EventManager().Events())
+	})
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,90 @@ func (gs GenesisState) Validate() error {
 		return errors.New("unable to set platform percentage to greater than 100%")
 	}
 
+	if err := gs.PlatformFeeSplit.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.PlatformFeeEnforcement.Validate(); err != nil {
+		return err
+	}
+
+	seenSchedules := make(map[uint64]struct{}, len(gs.PaymentSchedules))
+	for _, schedule := range gs.PaymentSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenSchedules[schedule.Id]; ok {
+			return fmt.Errorf("duplicate payment schedule id %d", schedule.Id)
+		}
+		if schedule.Id >= gs.NextPaymentScheduleId {
+			return fmt.Errorf("payment schedule id %d is not below the next id %d", schedule.Id, gs.NextPaymentScheduleId)
+		}
+		seenSchedules[schedule.Id] = struct{}{}
+	}
+
+	seenEscrows := make(map[uint64]struct{}, len(gs.Escrows))
+	for _, escrow := range gs.Escrows {
+		if err := escrow.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenEscrows[escrow.Id]; ok {
+			return fmt.Errorf("duplicate escrow id %d", escrow.Id)
+		}
+		if escrow.Id >= gs.NextEscrowId {
+			return fmt.Errorf("escrow id %d is not below the next id %d", escrow.Id, gs.NextEscrowId)
+		}
+		seenEscrows[escrow.Id] = struct{}{}
+	}
+
+	if err := ValidateAttestationTrustAnchors(gs.AttestationTrustAnchors); err != nil {
+		return err
+	}
+
+	seenCredentials := make(map[string]struct{}, len(gs.WebauthnCredentials))
+	for _, state := range gs.WebauthnCredentials {
+		if err := state.Validate(); err != nil {
+			return err
+		}
+		key := state.Owner + "/" + string(state.CredentialId)
+		if _, ok := seenCredentials[key]; ok {
+			return fmt.Errorf("duplicate webauthn credential %x for %s", state.CredentialId, state.Owner)
+		}
+		seenCredentials[key] = struct{}{}
+	}
+
+	seenProfiles := make(map[string]struct{}, len(gs.RelyingPartyProfiles))
+	for _, profile := range gs.RelyingPartyProfiles {
+		if err := profile.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenProfiles[profile.Contract]; ok {
+			return fmt.Errorf("duplicate relying party profile for %s", profile.Contract)
+		}
+		seenProfiles[profile.Contract] = struct{}{}
+	}
+
+	if err := gs.EmissionsSchedule.Validate(); err != nil {
+		return err
+	}
+
+	if !gs.MintTotals.Minted.IsNil() {
+		if err := gs.MintTotals.Validate(); err != nil {
+			return err
+		}
+	}
+	if len(gs.MintEpochs) > MaxMintEpochs {
+		return fmt.Errorf("%d mint epochs, maximum is %d", len(gs.MintEpochs), MaxMintEpochs)
+	}
+	for i, epoch := range gs.MintEpochs {
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+		if i > 0 && epoch.Number <= gs.MintEpochs[i-1].Number {
+			return fmt.Errorf("mint epoch %d is out of order", epoch.Number)
+		}
+	}
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +32,30 @@ type GenesisState struct {
 	PlatformPercentage uint32 `protobuf:"varint,1,opt,name=platform_percentage,json=platformPercentage,proto3" json:"platform_percentage,omitempty"`
 	// Minimum amounts required for platform operations
 	PlatformMinimums github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=platform_minimums,json=platformMinimums,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"platform_minimums,omitempty" yaml:"platform_minimums"`
+	// How collected platform fees are divided between destinations
+	PlatformFeeSplit PlatformFeeSplit `protobuf:"bytes,3,opt,name=platform_fee_split,json=platformFeeSplit,proto3" json:"platform_fee_split,omitempty" yaml:"platform_fee_split"`
+	// Which additional value transfer paths are charged the platform fee
+	PlatformFeeEnforcement PlatformFeeEnforcement `protobuf:"bytes,4,opt,name=platform_fee_enforcement,json=platformFeeEnforcement,proto3" json:"platform_fee_enforcement,omitempty" yaml:"platform_fee_enforcement"`
+	// All registered payment schedules
+	PaymentSchedules []PaymentSchedule `protobuf:"bytes,5,rep,name=payment_schedules,json=paymentSchedules,proto3" json:"payment_schedules,omitempty" yaml:"payment_schedules"`
+	// The id assigned to the next payment schedule
+	NextPaymentScheduleId uint64 `protobuf:"varint,6,opt,name=next_payment_schedule_id,json=nextPaymentScheduleId,proto3" json:"next_payment_schedule_id,omitempty"`
+	// All open escrows
+	Escrows []Escrow `protobuf:"bytes,7,rep,name=escrows,proto3" json:"escrows,omitempty" yaml:"escrows"`
+	// The id assigned to the next escrow
+	NextEscrowId uint64 `protobuf:"varint,8,opt,name=next_escrow_id,json=nextEscrowId,proto3" json:"next_escrow_id,omitempty"`
+	// Trusted authenticator models for attested WebAuthn registrations
+	AttestationTrustAnchors []AttestationTrustAnchor `protobuf:"bytes,9,rep,name=attestation_trust_anchors,json=attestationTrustAnchors,proto3" json:"attestation_trust_anchors,omitempty" yaml:"attestation_trust_anchors"`
+	// Tracked WebAuthn credential states
+	WebauthnCredentials []WebAuthNCredentialState `protobuf:"bytes,10,rep,name=webauthn_credentials,json=webauthnCredentials,proto3" json:"webauthn_credentials,omitempty" yaml:"webauthn_credentials"`
+	// Registered WebAuthn relying party profiles
+	RelyingPartyProfiles []RelyingPartyProfile `protobuf:"bytes,11,rep,name=relying_party_profiles,json=relyingPartyProfiles,proto3" json:"relying_party_profiles,omitempty" yaml:"relying_party_profiles"`
+	// The emissions schedule driving x/mint
+	EmissionsSchedule EmissionsSchedule `protobuf:"bytes,12,opt,name=emissions_schedule,json=emissionsSchedule,proto3" json:"emissions_schedule" yaml:"emissions_schedule"`
+	// The amounts minted and burned by the mint function
+	MintTotals MintTotals `protobuf:"bytes,13,opt,name=mint_totals,json=mintTotals,proto3" json:"mint_totals" yaml:"mint_totals"`
+	// The recent mint epochs
+	MintEpochs []MintEpoch `protobuf:"bytes,14,rep,name=mint_epochs,json=mintEpochs,proto3" json:"mint_epochs,omitempty" yaml:"mint_epochs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,34 +105,305 @@ func (m *GenesisState) GetPlatformMinimums() github_com_cosmos_cosmos_sdk_types.
 	return nil
 }
 
+func (m *GenesisState) GetPlatformFeeSplit() PlatformFeeSplit {
+	if m != nil {
+		return m.PlatformFeeSplit
+	}
+	return PlatformFeeSplit{}
+}
+
+func (m *GenesisState) GetPlatformFeeEnforcement() PlatformFeeEnforcement {
+	if m != nil {
+		return m.PlatformFeeEnforcement
+	}
+	return PlatformFeeEnforcement{}
+}
+
+func (m *GenesisState) GetPaymentSchedules() []PaymentSchedule {
+	if m != nil {
+		return m.PaymentSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPaymentScheduleId() uint64 {
+	if m != nil {
+		return m.NextPaymentScheduleId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEscrows() []Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextEscrowId() uint64 {
+	if m != nil {
+		return m.NextEscrowId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAttestationTrustAnchors() []AttestationTrustAnchor {
+	if m != nil {
+		return m.AttestationTrustAnchors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWebauthnCredentials() []WebAuthNCredentialState {
+	if m != nil {
+		return m.WebauthnCredentials
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRelyingPartyProfiles() []RelyingPartyProfile {
+	if m != nil {
+		return m.RelyingPartyProfiles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEmissionsSchedule() EmissionsSchedule {
+	if m != nil {
+		return m.EmissionsSchedule
+	}
+	return EmissionsSchedule{}
+}
+
+func (m *GenesisState) GetMintTotals() MintTotals {
+	if m != nil {
+		return m.MintTotals
+	}
+	return MintTotals{}
+}
+
+func (m *GenesisState) GetMintEpochs() []MintEpoch {
+	if m != nil {
+		return m.MintEpochs
+	}
+	return nil
+}
+
+// PlatformFeeSplit defines how collected platform fees are routed. Each share
+// is expressed in basis points of the collected fee; whatever is not assigned
+// to a destination remains with the fee collector.
+type PlatformFeeSplit struct {
+	// Share of platform fees sent to the community pool
+	CommunityPoolBps uint32 `protobuf:"varint,1,opt,name=community_pool_bps,json=communityPoolBps,proto3" json:"community_pool_bps,omitempty"`
+	// Share of platform fees that is burned
+	BurnBps uint32 `protobuf:"varint,2,opt,name=burn_bps,json=burnBps,proto3" json:"burn_bps,omitempty"`
+	// The address receiving the treasury share
+	TreasuryAddress string `protobuf:"bytes,3,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+	// Share of platform fees sent to the treasury address
+	TreasuryBps uint32 `protobuf:"varint,4,opt,name=treasury_bps,json=treasuryBps,proto3" json:"treasury_bps,omitempty"`
+}
+
+func (m *PlatformFeeSplit) Reset()         { *m = PlatformFeeSplit{} }
+func (m *PlatformFeeSplit) String() string { return proto.CompactTextString(m) }
+func (*PlatformFeeSplit) ProtoMessage()    {}
+func (*PlatformFeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a0f59b6b1baf029, []int{1}
+}
+func (m *PlatformFeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlatformFeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlatformFeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlatformFeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlatformFeeSplit.Merge(m, src)
+}
+func (m *PlatformFeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlatformFeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlatformFeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlatformFeeSplit proto.InternalMessageInfo
+
+func (m *PlatformFeeSplit) GetCommunityPoolBps() uint32 {
+	if m != nil {
+		return m.CommunityPoolBps
+	}
+	return 0
+}
+
+func (m *PlatformFeeSplit) GetBurnBps() uint32 {
+	if m != nil {
+		return m.BurnBps
+	}
+	return 0
+}
+
+func (m *PlatformFeeSplit) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
+	}
+	return ""
+}
+
+func (m *PlatformFeeSplit) GetTreasuryBps() uint32 {
+	if m != nil {
+		return m.TreasuryBps
+	}
+	return 0
+}
+
+// PlatformFeeEnforcement extends the platform fee beyond xion.v1.MsgSend and
+// MsgMultiSend to other value transfer paths.
+type PlatformFeeEnforcement struct {
+	// Charge the platform fee on outgoing ICS-20 transfers
+	IbcTransfers bool `protobuf:"varint,1,opt,name=ibc_transfers,json=ibcTransfers,proto3" json:"ibc_transfers,omitempty"`
+	// Charge the platform fee on CosmWasm BankMsg::Send
+	WasmBankSends bool `protobuf:"varint,2,opt,name=wasm_bank_sends,json=wasmBankSends,proto3" json:"wasm_bank_sends,omitempty"`
+	// Senders that are never charged on these paths, such as relayers. Module
+	// accounts are always exempt.
+	ExemptAddresses []string `protobuf:"bytes,3,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
+}
+
+func (m *PlatformFeeEnforcement) Reset()         { *m = PlatformFeeEnforcement{} }
+func (m *PlatformFeeEnforcement) String() string { return proto.CompactTextString(m) }
+func (*PlatformFeeEnforcement) ProtoMessage()    {}
+func (*PlatformFeeEnforcement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a0f59b6b1baf029, []int{2}
+}
+func (m *PlatformFeeEnforcement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlatformFeeEnforcement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlatformFeeEnforcement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlatformFeeEnforcement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlatformFeeEnforcement.Merge(m, src)
+}
+func (m *PlatformFeeEnforcement) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlatformFeeEnforcement) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlatformFeeEnforcement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlatformFeeEnforcement proto.InternalMessageInfo
+
+func (m *PlatformFeeEnforcement) GetIbcTransfers() bool {
+	if m != nil {
+		return m.IbcTransfers
+	}
+	return false
+}
+
+func (m *PlatformFeeEnforcement) GetWasmBankSends() bool {
+	if m != nil {
+		return m.WasmBankSends
+	}
+	return false
+}
+
+func (m *PlatformFeeEnforcement) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "xion.v1.GenesisState")
+	proto.RegisterType((*PlatformFeeSplit)(nil), "xion.v1.PlatformFeeSplit")
+	proto.RegisterType((*PlatformFeeEnforcement)(nil), "xion.v1.PlatformFeeEnforcement")
 }
 
 func init() { proto.RegisterFile("xion/v1/genesis.proto", fileDescriptor_9a0f59b6b1baf029) }
 
 var fileDescriptor_9a0f59b6b1baf029 = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xad, 0xc8, 0xcc, 0xcf,
-	0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x62, 0x07, 0x09, 0xeb, 0x95, 0x19, 0x4a, 0xc9, 0x25, 0xe7, 0x17, 0xe7, 0xe6, 0x17,
-	0xeb, 0x27, 0x25, 0x16, 0xa7, 0xea, 0x97, 0x19, 0x26, 0xa5, 0x96, 0x24, 0x1a, 0xea, 0x27, 0xe7,
-	0x67, 0xe6, 0x41, 0x14, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44,
-	0x54, 0xe9, 0x2b, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xc0, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x7d,
-	0x2e, 0xe1, 0x82, 0x9c, 0xc4, 0x92, 0xb4, 0xfc, 0xa2, 0xdc, 0xf8, 0x82, 0xd4, 0xa2, 0xe4, 0xd4,
-	0xbc, 0x92, 0xc4, 0xf4, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xde, 0x20, 0x21, 0x98, 0x54, 0x00,
-	0x5c, 0x46, 0x68, 0x33, 0x23, 0x97, 0x20, 0x5c, 0x47, 0x6e, 0x66, 0x5e, 0x66, 0x6e, 0x69, 0x6e,
-	0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0xa4, 0x1e, 0xc4, 0x51, 0x7a, 0x20, 0x47, 0xe9,
-	0x41, 0x1d, 0xa5, 0xe7, 0x9c, 0x9f, 0x99, 0xe7, 0x94, 0x7d, 0xe2, 0x9e, 0x3c, 0xc3, 0xab, 0x7b,
-	0xf2, 0xd2, 0x18, 0x7a, 0x75, 0xf2, 0x73, 0x33, 0x4b, 0x52, 0x73, 0x0b, 0x4a, 0x2a, 0x3f, 0xdd,
-	0x93, 0x97, 0xa8, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0x50, 0xa4, 0xb4, 0xea, 0xbe, 0xbc, 0x46,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0xf3, 0x10, 0x4a, 0xb7,
-	0x38, 0x25, 0x5b, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x18, 0x6c, 0x57, 0x71, 0x90, 0x00, 0x4c, 0xbf,
-	0x2f, 0x54, 0xbb, 0x93, 0xe3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
-	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xa9,
-	0x23, 0x99, 0x9a, 0x54, 0x5a, 0x94, 0x57, 0xa2, 0x9b, 0x93, 0x98, 0x54, 0xac, 0x0f, 0x0e, 0xfd,
-	0x0a, 0x08, 0x05, 0x36, 0x3a, 0x89, 0x0d, 0x1c, 0x82, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xa0, 0xd2, 0xf5, 0xdb, 0x99, 0x01, 0x00, 0x00,
+	// 1017 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0xed, 0xb2, 0xed, 0x4e, 0x9b, 0xfe, 0x98, 0xfe, 0x58, 0xa7, 0xbb, 0x24, 0x59,
+	0x2f, 0x82, 0x4a, 0x6c, 0x13, 0x95, 0x3d, 0x20, 0x71, 0x41, 0x71, 0x15, 0x50, 0x0f, 0x85, 0xc8,
+	0xa9, 0x40, 0xe2, 0x62, 0x8d, 0x9d, 0x49, 0x32, 0xaa, 0x3d, 0x63, 0x79, 0x26, 0x6d, 0x73, 0x46,
+	0xe2, 0xc0, 0x89, 0x33, 0x12, 0x77, 0xb4, 0xe2, 0x06, 0xff, 0x01, 0x97, 0x3d, 0xae, 0x38, 0x71,
+	0x2a, 0xa8, 0xbd, 0x71, 0xe4, 0x2f, 0x40, 0xf3, 0xc3, 0x4e, 0x9c, 0xb8, 0x9c, 0x12, 0xbf, 0xf7,
+	0x7d, 0xdf, 0xf7, 0x99, 0x37, 0xf6, 0xd8, 0x60, 0xef, 0x9a, 0x30, 0xda, 0xba, 0x3c, 0x6e, 0x0d,
+	0x31, 0xc5, 0x9c, 0xf0, 0x66, 0x92, 0x32, 0xc1, 0xe0, 0x8a, 0x0c, 0x37, 0x2f, 0x8f, 0x0f, 0x6a,
+	0x21, 0xe3, 0x31, 0xe3, 0xad, 0x00, 0x71, 0xdc, 0xba, 0x3c, 0x0e, 0xb0, 0x40, 0xc7, 0xad, 0x90,
+	0x11, 0xaa, 0x85, 0x07, 0xbb, 0x43, 0x36, 0x64, 0xea, 0x6f, 0x4b, 0xfe, 0x33, 0xd1, 0xaa, 0xae,
+	0xf2, 0x75, 0x42, 0x5f, 0x98, 0x54, 0x2d, 0x6b, 0x98, 0xa0, 0x49, 0x8c, 0xa9, 0xf0, 0x79, 0x38,
+	0xc2, 0xfd, 0x71, 0x84, 0x33, 0xc3, 0x2c, 0x8f, 0x79, 0x98, 0xb2, 0x2b, 0x13, 0xdd, 0xcf, 0xa2,
+	0x57, 0x38, 0x40, 0x63, 0x31, 0xca, 0xda, 0x3f, 0xc9, 0xd5, 0x31, 0xe1, 0x9c, 0x30, 0x6a, 0xda,
+	0x38, 0xdf, 0x56, 0xc0, 0xfa, 0xe7, 0x7a, 0x49, 0x3d, 0x81, 0x04, 0x86, 0x2d, 0xb0, 0x93, 0x44,
+	0x48, 0x0c, 0x58, 0x1a, 0xfb, 0x09, 0x4e, 0x43, 0x4c, 0x05, 0x1a, 0x62, 0xdb, 0x6a, 0x58, 0x87,
+	0x15, 0x0f, 0x66, 0xa9, 0x6e, 0x9e, 0x81, 0xbf, 0x5a, 0x60, 0x3b, 0xaf, 0x88, 0x09, 0x25, 0xf1,
+	0x38, 0xe6, 0xf6, 0x83, 0xc6, 0xf2, 0xe1, 0xda, 0x47, 0xd5, 0xa6, 0x59, 0x93, 0x1c, 0x4b, 0xd3,
+	0x8c, 0xa5, 0x79, 0xc2, 0x08, 0x75, 0x2f, 0xde, 0xdc, 0xd4, 0x97, 0xfe, 0xb9, 0xa9, 0x3f, 0x5d,
+	0xa8, 0x7d, 0xc9, 0x62, 0x22, 0x70, 0x9c, 0x88, 0xc9, 0xbf, 0x37, 0x75, 0x7b, 0x82, 0xe2, 0xe8,
+	0x13, 0x67, 0x41, 0xe4, 0xbc, 0xfe, 0xab, 0x7e, 0x38, 0x24, 0x62, 0x34, 0x0e, 0x9a, 0x21, 0x8b,
+	0xcd, 0xec, 0xcc, 0xcf, 0x11, 0xef, 0x5f, 0xb4, 0xc4, 0x24, 0xc1, 0x5c, 0xf5, 0xe2, 0xde, 0x56,
+	0x56, 0x7f, 0x66, 0xca, 0xe1, 0xf7, 0x16, 0xc8, 0x17, 0xe3, 0x0f, 0x30, 0xf6, 0x79, 0x12, 0x11,
+	0x61, 0x2f, 0x37, 0x2c, 0x85, 0x6d, 0xb6, 0xb5, 0xd9, 0x35, 0x92, 0xcf, 0x30, 0xee, 0x49, 0x81,
+	0xdb, 0x36, 0xd8, 0xcf, 0x16, 0x8b, 0x0b, 0xdc, 0xd5, 0x39, 0xee, 0x5c, 0xe5, 0x4c, 0x61, 0x32,
+	0x53, 0xf8, 0xb3, 0x05, 0xec, 0x82, 0x12, 0xd3, 0x01, 0x4b, 0x43, 0x2c, 0xf7, 0xdd, 0x7e, 0xa8,
+	0x90, 0xea, 0x65, 0x48, 0x9d, 0xa9, 0xcc, 0x3d, 0x33, 0x60, 0xce, 0x7d, 0x46, 0x05, 0xbc, 0x7a,
+	0x09, 0xde, 0x8c, 0xd6, 0xf1, 0xf6, 0x93, 0xd2, 0x36, 0xf0, 0x3b, 0xb9, 0xdb, 0x73, 0x77, 0x24,
+	0xb7, 0xdf, 0x51, 0xbb, 0x6d, 0x4f, 0x19, 0xb5, 0xa2, 0x67, 0x04, 0xee, 0xa7, 0xf9, 0x66, 0xcf,
+	0x97, 0x96, 0x6e, 0xf6, 0xbc, 0x48, 0xce, 0xac, 0xe8, 0xc8, 0xe1, 0xc7, 0xc0, 0xa6, 0xf8, 0x5a,
+	0xf8, 0xf3, 0x62, 0x9f, 0xf4, 0xed, 0x47, 0x0d, 0xeb, 0xf0, 0xa1, 0xb7, 0x27, 0xf3, 0x73, 0x24,
+	0xa7, 0x7d, 0xf8, 0x15, 0x58, 0xd1, 0x8f, 0x0c, 0xb7, 0x57, 0x14, 0xf6, 0x66, 0x8e, 0xdd, 0x51,
+	0x71, 0xf7, 0x43, 0x43, 0xbb, 0x6d, 0x74, 0x05, 0xc6, 0x0d, 0xcd, 0x68, 0x52, 0x8e, 0x97, 0x99,
+	0xc1, 0xf7, 0xc0, 0x86, 0x02, 0xd2, 0xd7, 0x12, 0x63, 0x55, 0x61, 0xac, 0xcb, 0xa8, 0x76, 0x3e,
+	0xed, 0xc3, 0x5f, 0x2c, 0x50, 0x45, 0x42, 0x60, 0x2e, 0x90, 0x20, 0x8c, 0xfa, 0x22, 0x1d, 0x73,
+	0xe1, 0x23, 0x1a, 0x8e, 0x58, 0xca, 0xed, 0xc7, 0x8d, 0xe5, 0xc2, 0x5e, 0xb7, 0xa7, 0xca, 0x73,
+	0x29, 0x6c, 0x2b, 0x9d, 0xfb, 0xa5, 0x01, 0x7c, 0x71, 0xaf, 0x53, 0x01, 0xb9, 0xa1, 0x91, 0xef,
+	0x15, 0x3b, 0xde, 0x13, 0x54, 0xda, 0x88, 0xc3, 0x1f, 0x2d, 0xb0, 0x9b, 0x1d, 0x25, 0x7e, 0x98,
+	0xe2, 0x3e, 0xa6, 0x82, 0xa0, 0x88, 0xdb, 0x40, 0x91, 0x36, 0x72, 0xd2, 0xaf, 0x71, 0xd0, 0x1e,
+	0x8b, 0xd1, 0x17, 0x27, 0xb9, 0x46, 0x1d, 0x27, 0x6e, 0xc7, 0xa0, 0xd6, 0xca, 0x5c, 0x0a, 0x94,
+	0x4f, 0x35, 0x65, 0x99, 0xce, 0xf1, 0x76, 0xb2, 0xf0, 0xd4, 0x9f, 0xc3, 0x9f, 0x2c, 0xb0, 0x9f,
+	0xe2, 0x68, 0x42, 0xe8, 0xd0, 0x4f, 0x50, 0x2a, 0x26, 0xf2, 0x1c, 0x1d, 0x10, 0x79, 0x43, 0xae,
+	0x29, 0xbc, 0x67, 0x39, 0x9e, 0xa7, 0x65, 0x5d, 0xa9, 0xea, 0x6a, 0x91, 0x7b, 0x6a, 0xd0, 0x1a,
+	0xe5, 0x1e, 0x05, 0xb8, 0x77, 0x35, 0x5c, 0xb9, 0xd2, 0xf1, 0x76, 0xd3, 0x45, 0x7f, 0x0e, 0x23,
+	0x00, 0xf3, 0xe3, 0x36, 0xbf, 0x3f, 0xed, 0x75, 0xf5, 0x3c, 0x1f, 0x4c, 0x6f, 0xba, 0x4c, 0x92,
+	0x3f, 0x2d, 0xcf, 0x25, 0xd8, 0xf4, 0x0c, 0x59, 0xf4, 0x70, 0xbc, 0x6d, 0x3c, 0x5f, 0x05, 0xbb,
+	0x60, 0x2d, 0x26, 0x54, 0xf8, 0x82, 0x09, 0xb9, 0x41, 0x15, 0xd5, 0x66, 0x27, 0x6f, 0x73, 0x46,
+	0xa8, 0x38, 0x57, 0x29, 0xf7, 0xc0, 0xf8, 0x43, 0xed, 0x3f, 0x53, 0xe5, 0x78, 0x20, 0xce, 0x75,
+	0x70, 0x64, 0x1c, 0x71, 0xc2, 0xc2, 0x11, 0xb7, 0x37, 0xd4, 0x4c, 0x61, 0xc1, 0xb1, 0x23, 0x53,
+	0xee, 0x2b, 0x33, 0xc9, 0xbd, 0x19, 0x79, 0x61, 0x7c, 0xb3, 0x9d, 0x74, 0xda, 0x74, 0xea, 0xe8,
+	0x8b, 0xdf, 0x2d, 0xb0, 0x35, 0x7f, 0xd4, 0xc2, 0x97, 0x00, 0x86, 0x2c, 0x8e, 0xc7, 0x94, 0xc8,
+	0x59, 0x33, 0x16, 0xf9, 0x41, 0xc2, 0xcd, 0x8b, 0x68, 0x2b, 0xcf, 0x74, 0x19, 0x8b, 0xdc, 0x84,
+	0xc3, 0x2a, 0x58, 0x0d, 0xc6, 0x29, 0x55, 0x9a, 0x07, 0x4a, 0xb3, 0x22, 0xaf, 0x65, 0xea, 0x04,
+	0x6c, 0x89, 0x14, 0x23, 0x3e, 0x4e, 0x27, 0x3e, 0xea, 0xf7, 0x53, 0xcc, 0xb9, 0x3a, 0xe8, 0x1f,
+	0xbb, 0xf6, 0x1f, 0xbf, 0x1d, 0xed, 0x9a, 0x57, 0x54, 0x5b, 0x67, 0x7a, 0x22, 0x25, 0x74, 0xe8,
+	0x6d, 0x66, 0x15, 0x26, 0x0c, 0x9f, 0x83, 0xf5, 0xdc, 0x44, 0xf6, 0x78, 0xa8, 0x7a, 0xac, 0x65,
+	0x31, 0x37, 0xe1, 0xce, 0x6b, 0x0b, 0xec, 0x97, 0x9f, 0xce, 0xf0, 0x05, 0xa8, 0x90, 0x20, 0xf4,
+	0x45, 0x8a, 0x28, 0x1f, 0xe0, 0x54, 0x2f, 0x63, 0xd5, 0x5b, 0x27, 0x41, 0x78, 0x9e, 0xc5, 0xe0,
+	0xfb, 0x60, 0xf3, 0x0a, 0xf1, 0xd8, 0x0f, 0x10, 0xbd, 0xf0, 0x39, 0xa6, 0x7d, 0xbd, 0x92, 0x55,
+	0xaf, 0x22, 0xc3, 0x2e, 0xa2, 0x17, 0x3d, 0x19, 0x94, 0xeb, 0xc1, 0xd7, 0x72, 0xb4, 0xd9, 0x6a,
+	0xb0, 0x5c, 0xcf, 0xf2, 0xff, 0xaf, 0x47, 0x57, 0xb4, 0xb3, 0x02, 0xb7, 0xfd, 0xe6, 0xb6, 0x66,
+	0xbd, 0xbd, 0xad, 0x59, 0x7f, 0xdf, 0xd6, 0xac, 0x1f, 0xee, 0x6a, 0x4b, 0x6f, 0xef, 0x6a, 0x4b,
+	0x7f, 0xde, 0xd5, 0x96, 0xbe, 0xf9, 0x60, 0xe6, 0xb5, 0x2a, 0x47, 0x28, 0x8e, 0x22, 0x14, 0xf0,
+	0x96, 0xfa, 0x82, 0xb8, 0xd6, 0x3f, 0xea, 0xdd, 0x1a, 0x3c, 0x52, 0x9f, 0x10, 0xaf, 0xfe, 0x1b,
+	0x00, 0x22, 0xae, 0x3f, 0x75, 0x1c, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -130,6 +426,140 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintEpochs) > 0 {
+		for iNdEx := len(m.MintEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size, err := m.MintTotals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.EmissionsSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.RelyingPartyProfiles) > 0 {
+		for iNdEx := len(m.RelyingPartyProfiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelyingPartyProfiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.WebauthnCredentials) > 0 {
+		for iNdEx := len(m.WebauthnCredentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WebauthnCredentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AttestationTrustAnchors) > 0 {
+		for iNdEx := len(m.AttestationTrustAnchors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestationTrustAnchors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextEscrowId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextEscrowId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextPaymentScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPaymentScheduleId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PaymentSchedules) > 0 {
+		for iNdEx := len(m.PaymentSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.PlatformFeeEnforcement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PlatformFeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.PlatformMinimums) > 0 {
 		for iNdEx := len(m.PlatformMinimums) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -137,57 +567,863 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PlatformPercentage != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PlatformPercentage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlatformFeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlatformFeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlatformFeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TreasuryBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TreasuryBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BurnBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BurnBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CommunityPoolBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CommunityPoolBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlatformFeeEnforcement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlatformFeeEnforcement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlatformFeeEnforcement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WasmBankSends {
+		i--
+		if m.WasmBankSends {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IbcTransfers {
+		i--
+		if m.IbcTransfers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlatformPercentage != 0 {
+		n += 1 + sovGenesis(uint64(m.PlatformPercentage))
+	}
+	if len(m.PlatformMinimums) > 0 {
+		for _, e := range m.PlatformMinimums {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.PlatformFeeSplit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PlatformFeeEnforcement.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PaymentSchedules) > 0 {
+		for _, e := range m.PaymentSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPaymentScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPaymentScheduleId))
+	}
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextEscrowId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextEscrowId))
+	}
+	if len(m.AttestationTrustAnchors) > 0 {
+		for _, e := range m.AttestationTrustAnchors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WebauthnCredentials) > 0 {
+		for _, e := range m.WebauthnCredentials {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelyingPartyProfiles) > 0 {
+		for _, e := range m.RelyingPartyProfiles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.EmissionsSchedule.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MintTotals.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MintEpochs) > 0 {
+		for _, e := range m.MintEpochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlatformFeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommunityPoolBps != 0 {
+		n += 1 + sovGenesis(uint64(m.CommunityPoolBps))
+	}
+	if m.BurnBps != 0 {
+		n += 1 + sovGenesis(uint64(m.BurnBps))
+	}
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TreasuryBps != 0 {
+		n += 1 + sovGenesis(uint64(m.TreasuryBps))
+	}
+	return n
+}
+
+func (m *PlatformFeeEnforcement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcTransfers {
+		n += 2
+	}
+	if m.WasmBankSends {
+		n += 2
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformPercentage", wireType)
+			}
+			m.PlatformPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlatformPercentage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformMinimums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlatformMinimums = append(m.PlatformMinimums, types.Coin{})
+			if err := m.PlatformMinimums[len(m.PlatformMinimums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformFeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PlatformFeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformFeeEnforcement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PlatformFeeEnforcement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentSchedules = append(m.PaymentSchedules, PaymentSchedule{})
+			if err := m.PaymentSchedules[len(m.PaymentSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentScheduleId", wireType)
+			}
+			m.NextPaymentScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPaymentScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEscrowId", wireType)
+			}
+			m.NextEscrowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEscrowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationTrustAnchors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationTrustAnchors = append(m.AttestationTrustAnchors, AttestationTrustAnchor{})
+			if err := m.AttestationTrustAnchors[len(m.AttestationTrustAnchors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebauthnCredentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebauthnCredentials = append(m.WebauthnCredentials, WebAuthNCredentialState{})
+			if err := m.WebauthnCredentials[len(m.WebauthnCredentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelyingPartyProfiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelyingPartyProfiles = append(m.RelyingPartyProfiles, RelyingPartyProfile{})
+			if err := m.RelyingPartyProfiles[len(m.RelyingPartyProfiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionsSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionsSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x12
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintEpochs = append(m.MintEpochs, MintEpoch{})
+			if err := m.MintEpochs[len(m.MintEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.PlatformPercentage != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.PlatformPercentage))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PlatformPercentage != 0 {
-		n += 1 + sovGenesis(uint64(m.PlatformPercentage))
-	}
-	if len(m.PlatformMinimums) > 0 {
-		for _, e := range m.PlatformMinimums {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+func (m *PlatformFeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlatformFeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlatformFeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolBps", wireType)
+			}
+			m.CommunityPoolBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityPoolBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnBps", wireType)
+			}
+			m.BurnBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryBps", wireType)
+			}
+			m.TreasuryBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreasuryBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *PlatformFeeEnforcement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -210,17 +1446,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlatformFeeEnforcement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlatformFeeEnforcement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlatformPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcTransfers", wireType)
 			}
-			m.PlatformPercentage = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -230,16 +1466,37 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PlatformPercentage |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IbcTransfers = bool(v != 0)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmBankSends", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WasmBankSends = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlatformMinimums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -249,25 +1506,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlatformMinimums = append(m.PlatformMinimums, types.Coin{})
-			if err := m.PlatformMinimums[len(m.PlatformMinimums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
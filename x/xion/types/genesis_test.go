@@ -52,6 +52,22 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "mint history",
+			genesisState: types.GenesisState{
+				MintTotals: types.NewMintTotals(1),
+				MintEpochs: []types.MintEpoch{types.NewMintEpoch(0, 1), types.NewMintEpoch(1, 5)},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid genesis state - mint epochs out of order",
+			genesisState: types.GenesisState{
+				MintEpochs: []types.MintEpoch{types.NewMintEpoch(1, 5), types.NewMintEpoch(0, 1)},
+			},
+			expectError: true,
+			errorMsg:    "mint epoch 0 is out of order",
+		},
 	}
 
 	for _, tt := range tests {
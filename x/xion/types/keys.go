@@ -1,8 +1,30 @@
 package types
 
 var (
-	PlatformPercentageKey = []byte{0x00}
-	PlatformMinimumKey    = []byte{0x01}
+	PlatformPercentageKey     = []byte{0x00}
+	PlatformMinimumKey        = []byte{0x01}
+	PlatformFeeSplitKey       = []byte{0x02}
+	PlatformFeeEnforcementKey = []byte{0x03}
+
+	PaymentScheduleKeyPrefix            = []byte{0x10}
+	PaymentScheduleBySenderKeyPrefix    = []byte{0x11}
+	PaymentScheduleByRecipientKeyPrefix = []byte{0x12}
+	PaymentScheduleQueueKeyPrefix       = []byte{0x13}
+	NextPaymentScheduleIDKey            = []byte{0x14}
+
+	EscrowKeyPrefix            = []byte{0x20}
+	EscrowBySenderKeyPrefix    = []byte{0x21}
+	EscrowByRecipientKeyPrefix = []byte{0x22}
+	EscrowExpiryQueueKeyPrefix = []byte{0x23}
+	NextEscrowIDKey            = []byte{0x24}
+
+	AttestationTrustAnchorKeyPrefix = []byte{0x30}
+	WebAuthNCredentialKeyPrefix     = []byte{0x31}
+	RelyingPartyProfileKeyPrefix    = []byte{0x32}
+
+	EmissionsScheduleKey = []byte{0x40}
+	MintTotalsKey        = []byte{0x41}
+	MintEpochKeyPrefix   = []byte{0x42}
 )
 
 const (
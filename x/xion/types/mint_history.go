@@ -0,0 +1,132 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// MaxMintEpochs is the number of most recent mint epochs kept in state.
+const MaxMintEpochs = 90
+
+// MintEpochLength returns the number of blocks in a mint epoch, about a day
+// of blocks at the x/mint expected blocks per year.
+func MintEpochLength(blocksPerYear uint64) int64 {
+	if length := int64(blocksPerYear / 365); length > 0 {
+		return length
+	}
+	return 1
+}
+
+// MintEpochKey returns the store key of a mint epoch, in epoch order.
+func MintEpochKey(number uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, number)
+}
+
+// NewMintTotals returns empty totals starting at height.
+func NewMintTotals(height int64) MintTotals {
+	return MintTotals{
+		SinceHeight: height,
+		Minted:      math.ZeroInt(),
+		Burned:      math.ZeroInt(),
+		NetIssuance: math.ZeroInt(),
+	}
+}
+
+// Add records the amounts minted and burned by a block.
+func (t MintTotals) Add(minted, burned math.Int) MintTotals {
+	t.Minted = t.Minted.Add(minted)
+	t.Burned = t.Burned.Add(burned)
+	t.NetIssuance = t.Minted.Sub(t.Burned)
+	return t
+}
+
+// Validate checks the totals are non-negative and consistent.
+func (t MintTotals) Validate() error {
+	return validateMintAmounts(t.Minted, t.Burned, t.NetIssuance)
+}
+
+// NewMintEpoch returns an empty epoch starting at height.
+func NewMintEpoch(number uint64, height int64) MintEpoch {
+	return MintEpoch{
+		Number:      number,
+		StartHeight: height,
+		EndHeight:   height,
+		Minted:      math.ZeroInt(),
+		Burned:      math.ZeroInt(),
+		NetIssuance: math.ZeroInt(),
+	}
+}
+
+// Add records the amounts minted and burned by the block at height.
+func (e MintEpoch) Add(height int64, minted, burned math.Int) MintEpoch {
+	e.EndHeight = height
+	e.Minted = e.Minted.Add(minted)
+	e.Burned = e.Burned.Add(burned)
+	e.NetIssuance = e.Minted.Sub(e.Burned)
+	return e
+}
+
+// Validate checks the epoch heights are ordered and its amounts are
+// non-negative and consistent.
+func (e MintEpoch) Validate() error {
+	if e.EndHeight < e.StartHeight {
+		return fmt.Errorf("mint epoch %d ends at %d before it starts at %d", e.Number, e.EndHeight, e.StartHeight)
+	}
+	if err := validateMintAmounts(e.Minted, e.Burned, e.NetIssuance); err != nil {
+		return fmt.Errorf("mint epoch %d: %w", e.Number, err)
+	}
+	return nil
+}
+
+func validateMintAmounts(minted, burned, net math.Int) error {
+	if minted.IsNil() || burned.IsNil() || net.IsNil() {
+		return fmt.Errorf("mint amounts must be set")
+	}
+	if minted.IsNegative() || burned.IsNegative() {
+		return fmt.Errorf("negative mint amounts %s minted, %s burned", minted, burned)
+	}
+	if !net.Equal(minted.Sub(burned)) {
+		return fmt.Errorf("net issuance %s is not %s minted less %s burned", net, minted, burned)
+	}
+	return nil
+}
+
+// InflationIssuance returns the amount the staking inflation curve issues
+// over the next blocks, holding the bonded ratio and bonded tokens constant
+// and before the rounding of each block provision. Inflation moves by the
+// step of minter.NextInflationRate every block until it reaches the minimum
+// or maximum inflation, and then stays there.
+func InflationIssuance(minter minttypes.Minter, params minttypes.Params, bondedRatio math.LegacyDec, bondedTokens math.Int, blocks int64) math.Int {
+	if blocks <= 0 || params.BlocksPerYear == 0 {
+		return math.ZeroInt()
+	}
+	n := math.NewInt(blocks)
+
+	next := minter.NextInflationRate(params, bondedRatio)
+	step := next.Sub(minter.Inflation)
+
+	// the sum of the inflation rates of the blocks
+	var rates math.LegacyDec
+	if step.IsZero() {
+		rates = next.MulInt(n)
+	} else {
+		bound := params.InflationMax
+		if step.IsNegative() {
+			bound = params.InflationMin
+		}
+		// blocks before the rate reaches the bound, including the first
+		linear := bound.Sub(next).Quo(step).TruncateInt().AddRaw(1)
+		if linear.GT(n) {
+			linear = n
+		}
+		// linear * next + step * linear * (linear - 1) / 2
+		rates = next.MulInt(linear).Add(step.MulInt(linear.Mul(linear.SubRaw(1))).QuoInt64(2))
+		rates = rates.Add(bound.MulInt(n.Sub(linear)))
+	}
+
+	return rates.MulInt(bondedTokens).QuoInt(math.NewIntFromUint64(params.BlocksPerYear)).TruncateInt()
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestMintEpochLength(t *testing.T) {
+	require.Equal(t, int64(1), types.MintEpochLength(0))
+	require.Equal(t, int64(1), types.MintEpochLength(100))
+	require.Equal(t, int64(17280), types.MintEpochLength(6_307_200))
+}
+
+func TestMintTotalsAndEpochs(t *testing.T) {
+	totals := types.NewMintTotals(5).Add(math.NewInt(100), math.ZeroInt()).Add(math.ZeroInt(), math.NewInt(30))
+	require.NoError(t, totals.Validate())
+	require.Equal(t, int64(5), totals.SinceHeight)
+	require.Equal(t, math.NewInt(70), totals.NetIssuance)
+
+	epoch := types.NewMintEpoch(3, 10).Add(10, math.NewInt(50), math.ZeroInt()).Add(12, math.ZeroInt(), math.NewInt(80))
+	require.NoError(t, epoch.Validate())
+	require.Equal(t, int64(12), epoch.EndHeight)
+	require.Equal(t, math.NewInt(-30), epoch.NetIssuance)
+
+	totals.NetIssuance = math.NewInt(1)
+	require.Error(t, totals.Validate())
+	require.Error(t, types.MintTotals{}.Validate())
+
+	epoch.EndHeight = 9
+	require.Error(t, epoch.Validate())
+}
+
+func TestInflationIssuance(t *testing.T) {
+	params := minttypes.DefaultParams()
+	params.BlocksPerYear = 100
+	bondedTokens := math.NewInt(500_000)
+
+	// the closed form matches applying the inflation curve block by block
+	for _, bondedRatio := range []math.LegacyDec{
+		math.LegacyNewDecWithPrec(5, 1),
+		math.LegacyNewDecWithPrec(67, 2),
+		math.LegacyNewDecWithPrec(9, 1),
+	} {
+		for _, blocks := range []int64{1, 10, 500} {
+			minter := minttypes.DefaultInitialMinter()
+			rates := math.LegacyZeroDec()
+			for i := int64(0); i < blocks; i++ {
+				minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+				rates = rates.Add(minter.Inflation)
+			}
+			expected := rates.MulInt(bondedTokens).QuoInt(math.NewIntFromUint64(params.BlocksPerYear)).TruncateInt()
+
+			got := types.InflationIssuance(minttypes.DefaultInitialMinter(), params, bondedRatio, bondedTokens, blocks)
+			require.Equal(t, expected, got, "bonded ratio %s over %d blocks", bondedRatio, blocks)
+		}
+	}
+
+	require.True(t, types.InflationIssuance(minttypes.DefaultInitialMinter(), params, math.LegacyOneDec(), bondedTokens, 0).IsZero())
+}
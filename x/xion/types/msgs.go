@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil/bech32"
 
@@ -14,10 +15,24 @@ import (
 
 // bank message types
 const (
-	TypeMsgSend                  = "send"
-	TypeMsgMultiSend             = "multisend"
-	TypeMsgSetPlatformPercentage = "setplatformpercentage"
-	TypeMsgSetPlatformMinimum    = "setplatformminimum"
+	TypeMsgSend                       = "send"
+	TypeMsgMultiSend                  = "multisend"
+	TypeMsgSetPlatformPercentage      = "setplatformpercentage"
+	TypeMsgSetPlatformMinimum         = "setplatformminimum"
+	TypeMsgSetPlatformFeeSplit        = "setplatformfeesplit"
+	TypeMsgSetPlatformFeeEnforcement  = "setplatformfeeenforcement"
+	TypeMsgCreatePaymentSchedule      = "createpaymentschedule"
+	TypeMsgCancelPaymentSchedule      = "cancelpaymentschedule"
+	TypeMsgPausePaymentSchedule       = "pausepaymentschedule"
+	TypeMsgResumePaymentSchedule      = "resumepaymentschedule"
+	TypeMsgSendEscrow                 = "sendescrow"
+	TypeMsgClaimEscrow                = "claimescrow"
+	TypeMsgRefundEscrow               = "refundescrow"
+	TypeMsgSetAttestationTrustAnchors = "setattestationtrustanchors"
+	TypeMsgVerifyWebAuthNAssertion    = "verifywebauthnassertion"
+	TypeMsgSetRelyingPartyProfile     = "setrelyingpartyprofile"
+	TypeMsgRemoveRelyingPartyProfile  = "removerelyingpartyprofile"
+	TypeMsgSetEmissionsSchedule       = "setemissionsschedule"
 )
 
 var (
@@ -25,6 +40,20 @@ var (
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgSetPlatformPercentage{}
 	_ sdk.Msg = &MsgSetPlatformMinimum{}
+	_ sdk.Msg = &MsgSetPlatformFeeSplit{}
+	_ sdk.Msg = &MsgSetPlatformFeeEnforcement{}
+	_ sdk.Msg = &MsgCreatePaymentSchedule{}
+	_ sdk.Msg = &MsgCancelPaymentSchedule{}
+	_ sdk.Msg = &MsgPausePaymentSchedule{}
+	_ sdk.Msg = &MsgResumePaymentSchedule{}
+	_ sdk.Msg = &MsgSendEscrow{}
+	_ sdk.Msg = &MsgClaimEscrow{}
+	_ sdk.Msg = &MsgRefundEscrow{}
+	_ sdk.Msg = &MsgSetAttestationTrustAnchors{}
+	_ sdk.Msg = &MsgVerifyWebAuthNAssertion{}
+	_ sdk.Msg = &MsgSetRelyingPartyProfile{}
+	_ sdk.Msg = &MsgRemoveRelyingPartyProfile{}
+	_ sdk.Msg = &MsgSetEmissionsSchedule{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -218,3 +247,455 @@ func (msg MsgSetPlatformMinimum) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{converted}
 }
+
+// NewMsgSetPlatformFeeSplit constructs a message to set the platform fee split.
+func NewMsgSetPlatformFeeSplit(authority sdk.AccAddress, split PlatformFeeSplit) *MsgSetPlatformFeeSplit {
+	return &MsgSetPlatformFeeSplit{Authority: authority.String(), Split: split}
+}
+
+// Route Implements Msg
+func (msg MsgSetPlatformFeeSplit) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetPlatformFeeSplit) Type() string { return TypeMsgSetPlatformFeeSplit }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetPlatformFeeSplit) ValidateBasic() error {
+	// Use bech32.Decode which accepts any valid Bech32 prefix, not just "cosmos"
+	if _, _, err := bech32.Decode(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Split.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetPlatformFeeSplit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetPlatformFeeSplit) GetSigners() []sdk.AccAddress {
+	// Extract the address bytes while preserving the original Bech32 prefix
+	_, addrBytes, err := bech32.Decode(msg.Authority)
+	if err != nil {
+		// Fallback to standard conversion if decode fails
+		addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+		return []sdk.AccAddress{addr}
+	}
+	// Convert from base32 to bytes
+	converted, err := bech32.ConvertBits(addrBytes, 5, 8, false)
+	if err != nil {
+		// Fallback to standard conversion if conversion fails
+		addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+		return []sdk.AccAddress{addr}
+	}
+	return []sdk.AccAddress{converted}
+}
+
+// NewMsgSetPlatformFeeEnforcement constructs a message to set the platform fee enforcement.
+func NewMsgSetPlatformFeeEnforcement(authority sdk.AccAddress, enforcement PlatformFeeEnforcement) *MsgSetPlatformFeeEnforcement {
+	return &MsgSetPlatformFeeEnforcement{Authority: authority.String(), Enforcement: enforcement}
+}
+
+// Route Implements Msg
+func (msg MsgSetPlatformFeeEnforcement) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetPlatformFeeEnforcement) Type() string { return TypeMsgSetPlatformFeeEnforcement }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetPlatformFeeEnforcement) ValidateBasic() error {
+	// Use bech32.Decode which accepts any valid Bech32 prefix, not just "cosmos"
+	if _, _, err := bech32.Decode(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Enforcement.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetPlatformFeeEnforcement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetPlatformFeeEnforcement) GetSigners() []sdk.AccAddress {
+	// Extract the address bytes while preserving the original Bech32 prefix
+	_, addrBytes, err := bech32.Decode(msg.Authority)
+	if err != nil {
+		// Fallback to standard conversion if decode fails
+		addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+		return []sdk.AccAddress{addr}
+	}
+	// Convert from base32 to bytes
+	converted, err := bech32.ConvertBits(addrBytes, 5, 8, false)
+	if err != nil {
+		// Fallback to standard conversion if conversion fails
+		addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+		return []sdk.AccAddress{addr}
+	}
+	return []sdk.AccAddress{converted}
+}
+
+// Route Implements Msg
+func (msg MsgCreatePaymentSchedule) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCreatePaymentSchedule) Type() string { return TypeMsgCreatePaymentSchedule }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreatePaymentSchedule) ValidateBasic() error {
+	if _, _, err := bech32.Decode(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if _, _, err := bech32.Decode(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	var start time.Time
+	if msg.StartTime != nil {
+		start = *msg.StartTime
+	}
+	return validateScheduleTerms(msg.Amount, msg.Period, start, msg.EndTime, msg.MaxTotal)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreatePaymentSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreatePaymentSchedule) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route Implements Msg
+func (msg MsgCancelPaymentSchedule) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCancelPaymentSchedule) Type() string { return TypeMsgCancelPaymentSchedule }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelPaymentSchedule) ValidateBasic() error {
+	return validateScheduleSender(msg.Sender)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelPaymentSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelPaymentSchedule) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route Implements Msg
+func (msg MsgPausePaymentSchedule) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgPausePaymentSchedule) Type() string { return TypeMsgPausePaymentSchedule }
+
+// ValidateBasic Implements Msg.
+func (msg MsgPausePaymentSchedule) ValidateBasic() error {
+	return validateScheduleSender(msg.Sender)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgPausePaymentSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPausePaymentSchedule) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route Implements Msg
+func (msg MsgResumePaymentSchedule) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgResumePaymentSchedule) Type() string { return TypeMsgResumePaymentSchedule }
+
+// ValidateBasic Implements Msg.
+func (msg MsgResumePaymentSchedule) ValidateBasic() error {
+	return validateScheduleSender(msg.Sender)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgResumePaymentSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgResumePaymentSchedule) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func validateScheduleSender(sender string) error {
+	if _, _, err := bech32.Decode(sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	return nil
+}
+
+// Route Implements Msg
+func (msg MsgSendEscrow) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSendEscrow) Type() string { return TypeMsgSendEscrow }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSendEscrow) ValidateBasic() error {
+	if err := validateScheduleSender(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.TimeoutBlocks == 0 || msg.TimeoutBlocks > MaxEscrowTimeoutBlocks {
+		return errorsmod.Wrapf(ErrInvalidEscrow, "timeout must be between 1 and %d blocks", MaxEscrowTimeoutBlocks)
+	}
+
+	return validateEscrowTerms(msg.Amount, msg.Recipient, msg.SecretHash, msg.EmailHash)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSendEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSendEscrow) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route Implements Msg
+func (msg MsgClaimEscrow) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgClaimEscrow) Type() string { return TypeMsgClaimEscrow }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClaimEscrow) ValidateBasic() error {
+	if _, _, err := bech32.Decode(msg.Claimer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid claimer address: %s", err)
+	}
+
+	if msg.Destination != "" {
+		if _, _, err := bech32.Decode(msg.Destination); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+		}
+	}
+
+	if msg.SecretProof != nil && msg.EmailProof != nil {
+		return errorsmod.Wrap(ErrInvalidEscrowClaim, "secret and email proof are mutually exclusive")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClaimEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClaimEscrow) GetSigners() []sdk.AccAddress {
+	claimer, _ := sdk.AccAddressFromBech32(msg.Claimer)
+	return []sdk.AccAddress{claimer}
+}
+
+// Route Implements Msg
+func (msg MsgRefundEscrow) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRefundEscrow) Type() string { return TypeMsgRefundEscrow }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRefundEscrow) ValidateBasic() error {
+	if _, _, err := bech32.Decode(msg.Signer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRefundEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRefundEscrow) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgSetAttestationTrustAnchors constructs a message to replace the
+// attestation trust anchors.
+func NewMsgSetAttestationTrustAnchors(authority sdk.AccAddress, anchors []AttestationTrustAnchor) *MsgSetAttestationTrustAnchors {
+	return &MsgSetAttestationTrustAnchors{Authority: authority.String(), Anchors: anchors}
+}
+
+// Route Implements Msg
+func (msg MsgSetAttestationTrustAnchors) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetAttestationTrustAnchors) Type() string { return TypeMsgSetAttestationTrustAnchors }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetAttestationTrustAnchors) ValidateBasic() error {
+	if _, _, err := bech32.Decode(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateAttestationTrustAnchors(msg.Anchors)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetAttestationTrustAnchors) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetAttestationTrustAnchors) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// Route Implements Msg
+func (msg MsgVerifyWebAuthNAssertion) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgVerifyWebAuthNAssertion) Type() string { return TypeMsgVerifyWebAuthNAssertion }
+
+// ValidateBasic Implements Msg.
+func (msg MsgVerifyWebAuthNAssertion) ValidateBasic() error {
+	if err := validateScheduleSender(msg.Sender); err != nil {
+		return err
+	}
+	if len(msg.Data) > MaxWebAuthDataSize {
+		return errorsmod.Wrapf(ErrWebAuthDataTooLarge, "data size %d exceeds maximum %d bytes", len(msg.Data), MaxWebAuthDataSize)
+	}
+	if len(msg.Credential) > MaxWebAuthDataSize {
+		return errorsmod.Wrapf(ErrWebAuthDataTooLarge, "credential size %d exceeds maximum %d bytes", len(msg.Credential), MaxWebAuthDataSize)
+	}
+	if msg.RpProfile != "" {
+		if msg.Rp != "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "rp and rp_profile are mutually exclusive")
+		}
+		if _, _, err := bech32.Decode(msg.RpProfile); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid rp profile contract address: %s", err)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgVerifyWebAuthNAssertion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgVerifyWebAuthNAssertion) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route Implements Msg
+func (msg MsgSetRelyingPartyProfile) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetRelyingPartyProfile) Type() string { return TypeMsgSetRelyingPartyProfile }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetRelyingPartyProfile) ValidateBasic() error {
+	if err := validateScheduleSender(msg.Sender); err != nil {
+		return err
+	}
+	return msg.Profile.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetRelyingPartyProfile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetRelyingPartyProfile) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route Implements Msg
+func (msg MsgRemoveRelyingPartyProfile) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRemoveRelyingPartyProfile) Type() string { return TypeMsgRemoveRelyingPartyProfile }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRemoveRelyingPartyProfile) ValidateBasic() error {
+	if err := validateScheduleSender(msg.Sender); err != nil {
+		return err
+	}
+	if _, _, err := bech32.Decode(msg.Contract); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRemoveRelyingPartyProfile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRemoveRelyingPartyProfile) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route Implements Msg
+func (msg MsgSetEmissionsSchedule) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetEmissionsSchedule) Type() string { return TypeMsgSetEmissionsSchedule }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetEmissionsSchedule) ValidateBasic() error {
+	// Use bech32.Decode which accepts any valid Bech32 prefix, not just "cosmos"
+	if _, _, err := bech32.Decode(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Schedule.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetEmissionsSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetEmissionsSchedule) GetSigners() []sdk.AccAddress {
+	// Extract the address bytes while preserving the original Bech32 prefix
+	_, addrBytes, err := bech32.Decode(msg.Authority)
+	if err != nil {
+		// Fallback to standard conversion if decode fails
+		addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+		return []sdk.AccAddress{addr}
+	}
+	// Convert from base32 to bytes
+	converted, err := bech32.ConvertBits(addrBytes, 5, 8, false)
+	if err != nil {
+		// Fallback to standard conversion if conversion fails
+		addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+		return []sdk.AccAddress{addr}
+	}
+	return []sdk.AccAddress{converted}
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// payment schedule event types and attributes
+const (
+	EventTypePaymentScheduleCreated  = "payment_schedule_created"
+	EventTypePaymentScheduleExecuted = "payment_schedule_executed"
+	EventTypePaymentScheduleFailed   = "payment_schedule_failed"
+	EventTypePaymentScheduleClosed   = "payment_schedule_closed"
+	EventTypePaymentSchedulePaused   = "payment_schedule_paused"
+	EventTypePaymentScheduleResumed  = "payment_schedule_resumed"
+
+	AttributeKeyScheduleID = "schedule_id"
+	AttributeKeySender     = "sender"
+	AttributeKeyReason     = "reason"
+)
+
+const (
+	// MaxPaymentSchedulesPerSender bounds the schedules an account can pay.
+	MaxPaymentSchedulesPerSender = 100
+	// MaxPaymentSchedulesPerBlock bounds the payments executed in a single
+	// BeginBlock; payments that are still due are executed in later blocks.
+	MaxPaymentSchedulesPerBlock = 200
+	// MaxDuePaymentsPerSenderPerBlock bounds the payments of a single sender
+	// executed in a BeginBlock, so one sender cannot use up the block budget
+	// and delay the schedules of everyone else.
+	MaxDuePaymentsPerSenderPerBlock = 10
+	// MinPaymentSchedulePeriod is the shortest period of a schedule, well
+	// above the block time so a schedule pays at most once per block.
+	MinPaymentSchedulePeriod = time.Minute
+)
+
+// PaymentScheduleKey returns the store key of a schedule.
+func PaymentScheduleKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// PaymentScheduleAccountIndexKey returns the key of a schedule in the sender
+// or recipient index, relative to the index prefix.
+func PaymentScheduleAccountIndexKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(address.MustLengthPrefix(addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// PaymentScheduleAccountIndexPrefix returns the prefix of all schedules of an
+// account in the sender or recipient index, relative to the index prefix.
+func PaymentScheduleAccountIndexPrefix(addr sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(addr)
+}
+
+// PaymentScheduleQueueKey returns the key of a schedule in the execution
+// queue, relative to the queue prefix.
+func PaymentScheduleQueueKey(due time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(due), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ParsePaymentScheduleQueueKey returns the schedule id of a queue key.
+func ParsePaymentScheduleQueueKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// Validate performs stateless validation of a schedule.
+func (s PaymentSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Sender); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "invalid sender: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "invalid recipient: %s", err)
+	}
+	return validateScheduleTerms(s.Amount, s.Period, s.StartTime, s.EndTime, s.MaxTotal)
+}
+
+// IsComplete returns true once the schedule can make no further payment.
+func (s PaymentSchedule) IsComplete() bool {
+	if s.EndTime != nil && s.NextPaymentTime.After(*s.EndTime) {
+		return true
+	}
+	return !s.MaxTotal.IsZero() && s.NextPayment().IsZero()
+}
+
+// NextPayment returns the amount due for the next payment, capped by what
+// remains of the maximum total.
+func (s PaymentSchedule) NextPayment() sdk.Coins {
+	if s.MaxTotal.IsZero() {
+		return s.Amount
+	}
+
+	payment := sdk.NewCoins()
+	for _, coin := range s.Amount {
+		remaining := s.MaxTotal.AmountOf(coin.Denom).Sub(s.TotalPaid.AmountOf(coin.Denom))
+		if remaining.IsPositive() {
+			payment = payment.Add(sdk.NewCoin(coin.Denom, math.MinInt(coin.Amount, remaining)))
+		}
+	}
+	return payment
+}
+
+func validateScheduleTerms(amount sdk.Coins, period time.Duration, start time.Time, end *time.Time, maxTotal sdk.Coins) error {
+	if !amount.IsValid() || !amount.IsAllPositive() {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "invalid amount %s", amount)
+	}
+	if period < MinPaymentSchedulePeriod {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "period %s is shorter than the minimum %s", period, MinPaymentSchedulePeriod)
+	}
+	if end != nil && !start.IsZero() && end.Before(start) {
+		return errorsmod.Wrap(ErrInvalidSchedule, "end time is before start time")
+	}
+	if !maxTotal.IsZero() {
+		if !maxTotal.IsValid() {
+			return errorsmod.Wrapf(ErrInvalidSchedule, "invalid max total %s", maxTotal)
+		}
+		if !maxTotal.DenomsSubsetOf(amount) || !amount.DenomsSubsetOf(maxTotal) {
+			return errorsmod.Wrapf(ErrInvalidSchedule, "max total %s must cover exactly the denoms of %s", maxTotal, amount)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestPaymentSchedule_Validate(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := start.Add(-time.Hour)
+	valid := types.PaymentSchedule{
+		Id:        1,
+		Sender:    sdk.AccAddress("schedule_sender_1234").String(),
+		Recipient: sdk.AccAddress("schedule_recipient_1").String(),
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("uxion", 100)),
+		Period:    time.Hour,
+		StartTime: start,
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(s *types.PaymentSchedule)
+		hasErr bool
+	}{
+		{"valid", func(*types.PaymentSchedule) {}, false},
+		{"invalid sender", func(s *types.PaymentSchedule) { s.Sender = "bad" }, true},
+		{"invalid recipient", func(s *types.PaymentSchedule) { s.Recipient = "bad" }, true},
+		{"empty amount", func(s *types.PaymentSchedule) { s.Amount = sdk.NewCoins() }, true},
+		{"zero period", func(s *types.PaymentSchedule) { s.Period = 0 }, true},
+		{"period below the minimum", func(s *types.PaymentSchedule) { s.Period = types.MinPaymentSchedulePeriod - time.Second }, true},
+		{"minimum period", func(s *types.PaymentSchedule) { s.Period = types.MinPaymentSchedulePeriod }, false},
+		{"end before start", func(s *types.PaymentSchedule) { s.EndTime = &before }, true},
+		{"max total with matching denoms", func(s *types.PaymentSchedule) {
+			s.MaxTotal = sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000))
+		}, false},
+		{"max total with other denom", func(s *types.PaymentSchedule) {
+			s.MaxTotal = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000))
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule := valid
+			tc.modify(&schedule)
+			err := schedule.Validate()
+			if tc.hasErr {
+				require.ErrorIs(t, err, types.ErrInvalidSchedule)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPaymentSchedule_NextPaymentAndIsComplete(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	schedule := types.PaymentSchedule{
+		Amount:          sdk.NewCoins(sdk.NewInt64Coin("uxion", 100)),
+		Period:          time.Hour,
+		NextPaymentTime: start,
+		MaxTotal:        sdk.NewCoins(sdk.NewInt64Coin("uxion", 250)),
+	}
+
+	require.Equal(t, schedule.Amount, schedule.NextPayment())
+	require.False(t, schedule.IsComplete())
+
+	schedule.TotalPaid = sdk.NewCoins(sdk.NewInt64Coin("uxion", 200))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uxion", 50)), schedule.NextPayment())
+
+	schedule.TotalPaid = schedule.MaxTotal
+	require.True(t, schedule.NextPayment().IsZero())
+	require.True(t, schedule.IsComplete())
+
+	schedule.MaxTotal = nil
+	schedule.EndTime = &end
+	require.False(t, schedule.IsComplete())
+	schedule.NextPaymentTime = end.Add(time.Second)
+	require.True(t, schedule.IsComplete())
+}
+
+func TestPaymentScheduleQueueKey(t *testing.T) {
+	due := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	key := types.PaymentScheduleQueueKey(due, 42)
+	require.Equal(t, uint64(42), types.ParsePaymentScheduleQueueKey(key))
+
+	// keys order by due time first
+	later := types.PaymentScheduleQueueKey(due.Add(time.Second), 1)
+	require.Less(t, string(key), string(later))
+}
@@ -8,9 +8,12 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -41,6 +44,11 @@ type QueryWebAuthNVerifyRegisterRequest struct {
 	Rp string `protobuf:"bytes,3,opt,name=rp,proto3" json:"rp,omitempty"`
 	// The registration data
 	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// Require a packed, TPM or Apple attestation chaining to a governance
+	// approved trust anchor for the authenticator's AAGUID
+	RequireAttestation bool `protobuf:"varint,5,opt,name=require_attestation,json=requireAttestation,proto3" json:"require_attestation,omitempty"`
+	// The contract whose relying party profile replaces rp
+	RpProfile string `protobuf:"bytes,6,opt,name=rp_profile,json=rpProfile,proto3" json:"rp_profile,omitempty"`
 }
 
 func (m *QueryWebAuthNVerifyRegisterRequest) Reset()         { *m = QueryWebAuthNVerifyRegisterRequest{} }
@@ -104,11 +112,27 @@ func (m *QueryWebAuthNVerifyRegisterRequest) GetData() []byte {
 	return nil
 }
 
+func (m *QueryWebAuthNVerifyRegisterRequest) GetRequireAttestation() bool {
+	if m != nil {
+		return m.RequireAttestation
+	}
+	return false
+}
+
+func (m *QueryWebAuthNVerifyRegisterRequest) GetRpProfile() string {
+	if m != nil {
+		return m.RpProfile
+	}
+	return ""
+}
+
 // QueryWebAuthNVerifyRegisterResponse is the response type for WebAuthN
 // registration verification
 type QueryWebAuthNVerifyRegisterResponse struct {
 	// The generated credential
 	Credential []byte `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	// The authenticator AAGUID in canonical UUID form
+	Aaguid string `protobuf:"bytes,2,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
 }
 
 func (m *QueryWebAuthNVerifyRegisterResponse) Reset()         { *m = QueryWebAuthNVerifyRegisterResponse{} }
@@ -151,35 +175,34 @@ func (m *QueryWebAuthNVerifyRegisterResponse) GetCredential() []byte {
 	return nil
 }
 
-// QueryWebAuthNVerifyAuthenticateRequest is the request type for WebAuthN
-// authentication verification
-type QueryWebAuthNVerifyAuthenticateRequest struct {
-	// The account address
-	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	// The challenge string for authentication
-	Challenge string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
-	// The relying party identifier
-	Rp string `protobuf:"bytes,3,opt,name=rp,proto3" json:"rp,omitempty"`
-	// The credential to verify
-	Credential []byte `protobuf:"bytes,4,opt,name=credential,proto3" json:"credential,omitempty"`
-	// The authentication data
-	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+func (m *QueryWebAuthNVerifyRegisterResponse) GetAaguid() string {
+	if m != nil {
+		return m.Aaguid
+	}
+	return ""
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) Reset() {
-	*m = QueryWebAuthNVerifyAuthenticateRequest{}
+// QueryWebAuthNCredentialRequest is the request type for querying the tracked
+// state of a WebAuthn credential
+type QueryWebAuthNCredentialRequest struct {
+	// The account that verifies assertions for the credential
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The credential id
+	CredentialId []byte `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
 }
-func (m *QueryWebAuthNVerifyAuthenticateRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryWebAuthNVerifyAuthenticateRequest) ProtoMessage()    {}
-func (*QueryWebAuthNVerifyAuthenticateRequest) Descriptor() ([]byte, []int) {
+
+func (m *QueryWebAuthNCredentialRequest) Reset()         { *m = QueryWebAuthNCredentialRequest{} }
+func (m *QueryWebAuthNCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNCredentialRequest) ProtoMessage()    {}
+func (*QueryWebAuthNCredentialRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2d6eabf4b8b83bc3, []int{2}
 }
-func (m *QueryWebAuthNVerifyAuthenticateRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryWebAuthNCredentialRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWebAuthNVerifyAuthenticateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWebAuthNCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWebAuthNVerifyAuthenticateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWebAuthNCredentialRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -189,72 +212,158 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryWebAuthNVerifyAuthenticateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWebAuthNVerifyAuthenticateRequest.Merge(m, src)
+func (m *QueryWebAuthNCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNCredentialRequest.Merge(m, src)
 }
-func (m *QueryWebAuthNVerifyAuthenticateRequest) XXX_Size() int {
+func (m *QueryWebAuthNCredentialRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWebAuthNVerifyAuthenticateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWebAuthNVerifyAuthenticateRequest.DiscardUnknown(m)
+func (m *QueryWebAuthNCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWebAuthNVerifyAuthenticateRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryWebAuthNCredentialRequest proto.InternalMessageInfo
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) GetAddr() string {
+func (m *QueryWebAuthNCredentialRequest) GetOwner() string {
 	if m != nil {
-		return m.Addr
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) GetChallenge() string {
+func (m *QueryWebAuthNCredentialRequest) GetCredentialId() []byte {
 	if m != nil {
-		return m.Challenge
+		return m.CredentialId
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) GetRp() string {
+// QueryWebAuthNCredentialResponse is the response type for querying the
+// tracked state of a WebAuthn credential
+type QueryWebAuthNCredentialResponse struct {
+	State WebAuthNCredentialState `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+}
+
+func (m *QueryWebAuthNCredentialResponse) Reset()         { *m = QueryWebAuthNCredentialResponse{} }
+func (m *QueryWebAuthNCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNCredentialResponse) ProtoMessage()    {}
+func (*QueryWebAuthNCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{3}
+}
+func (m *QueryWebAuthNCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWebAuthNCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWebAuthNCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWebAuthNCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNCredentialResponse.Merge(m, src)
+}
+func (m *QueryWebAuthNCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWebAuthNCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWebAuthNCredentialResponse proto.InternalMessageInfo
+
+func (m *QueryWebAuthNCredentialResponse) GetState() WebAuthNCredentialState {
 	if m != nil {
-		return m.Rp
+		return m.State
 	}
-	return ""
+	return WebAuthNCredentialState{}
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) GetCredential() []byte {
+// QueryWebAuthNCredentialsByOwnerRequest is the request type for querying the
+// tracked WebAuthn credentials of an account
+type QueryWebAuthNCredentialsByOwnerRequest struct {
+	// The account that verifies assertions for the credentials
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWebAuthNCredentialsByOwnerRequest) Reset() {
+	*m = QueryWebAuthNCredentialsByOwnerRequest{}
+}
+func (m *QueryWebAuthNCredentialsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNCredentialsByOwnerRequest) ProtoMessage()    {}
+func (*QueryWebAuthNCredentialsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{4}
+}
+func (m *QueryWebAuthNCredentialsByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWebAuthNCredentialsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWebAuthNCredentialsByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWebAuthNCredentialsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNCredentialsByOwnerRequest.Merge(m, src)
+}
+func (m *QueryWebAuthNCredentialsByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWebAuthNCredentialsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNCredentialsByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWebAuthNCredentialsByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryWebAuthNCredentialsByOwnerRequest) GetOwner() string {
 	if m != nil {
-		return m.Credential
+		return m.Owner
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) GetData() []byte {
+func (m *QueryWebAuthNCredentialsByOwnerRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Data
+		return m.Pagination
 	}
 	return nil
 }
 
-// QueryWebAuthNVerifyAuthenticateResponse is the response type for WebAuthN
-// authentication verification
-type QueryWebAuthNVerifyAuthenticateResponse struct {
+// QueryWebAuthNCredentialsByOwnerResponse is the response type for querying
+// the tracked WebAuthn credentials of an account
+type QueryWebAuthNCredentialsByOwnerResponse struct {
+	States []WebAuthNCredentialState `protobuf:"bytes,1,rep,name=states,proto3" json:"states"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateResponse) Reset() {
-	*m = QueryWebAuthNVerifyAuthenticateResponse{}
+func (m *QueryWebAuthNCredentialsByOwnerResponse) Reset() {
+	*m = QueryWebAuthNCredentialsByOwnerResponse{}
 }
-func (m *QueryWebAuthNVerifyAuthenticateResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryWebAuthNVerifyAuthenticateResponse) ProtoMessage()    {}
-func (*QueryWebAuthNVerifyAuthenticateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d6eabf4b8b83bc3, []int{3}
+func (m *QueryWebAuthNCredentialsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNCredentialsByOwnerResponse) ProtoMessage()    {}
+func (*QueryWebAuthNCredentialsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{5}
 }
-func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryWebAuthNCredentialsByOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWebAuthNCredentialsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWebAuthNVerifyAuthenticateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWebAuthNCredentialsByOwnerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -264,35 +373,51 @@ func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWebAuthNVerifyAuthenticateResponse.Merge(m, src)
+func (m *QueryWebAuthNCredentialsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNCredentialsByOwnerResponse.Merge(m, src)
 }
-func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_Size() int {
+func (m *QueryWebAuthNCredentialsByOwnerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWebAuthNVerifyAuthenticateResponse.DiscardUnknown(m)
+func (m *QueryWebAuthNCredentialsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNCredentialsByOwnerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWebAuthNVerifyAuthenticateResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryWebAuthNCredentialsByOwnerResponse proto.InternalMessageInfo
 
-// QueryPlatformPercentageRequest is the request type for querying platform
-// percentage
-type QueryPlatformPercentageRequest struct {
+func (m *QueryWebAuthNCredentialsByOwnerResponse) GetStates() []WebAuthNCredentialState {
+	if m != nil {
+		return m.States
+	}
+	return nil
 }
 
-func (m *QueryPlatformPercentageRequest) Reset()         { *m = QueryPlatformPercentageRequest{} }
-func (m *QueryPlatformPercentageRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPlatformPercentageRequest) ProtoMessage()    {}
-func (*QueryPlatformPercentageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d6eabf4b8b83bc3, []int{4}
+func (m *QueryWebAuthNCredentialsByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryPlatformPercentageRequest) XXX_Unmarshal(b []byte) error {
+
+// QueryRelyingPartyProfileRequest is the request type for querying the relying
+// party profile of a contract
+type QueryRelyingPartyProfileRequest struct {
+	// The contract owning the profile
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *QueryRelyingPartyProfileRequest) Reset()         { *m = QueryRelyingPartyProfileRequest{} }
+func (m *QueryRelyingPartyProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelyingPartyProfileRequest) ProtoMessage()    {}
+func (*QueryRelyingPartyProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{6}
+}
+func (m *QueryRelyingPartyProfileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPlatformPercentageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRelyingPartyProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPlatformPercentageRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRelyingPartyProfileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -302,37 +427,43 @@ func (m *QueryPlatformPercentageRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryPlatformPercentageRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPlatformPercentageRequest.Merge(m, src)
+func (m *QueryRelyingPartyProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelyingPartyProfileRequest.Merge(m, src)
 }
-func (m *QueryPlatformPercentageRequest) XXX_Size() int {
+func (m *QueryRelyingPartyProfileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPlatformPercentageRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPlatformPercentageRequest.DiscardUnknown(m)
+func (m *QueryRelyingPartyProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelyingPartyProfileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPlatformPercentageRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRelyingPartyProfileRequest proto.InternalMessageInfo
 
-// QueryPlatformPercentageResponse is the response type for querying platform
-// percentage
-type QueryPlatformPercentageResponse struct {
-	// The platform percentage fee
-	PlatformPercentage uint64 `protobuf:"varint,1,opt,name=platform_percentage,json=platformPercentage,proto3" json:"platform_percentage,omitempty"`
+func (m *QueryRelyingPartyProfileRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
 }
 
-func (m *QueryPlatformPercentageResponse) Reset()         { *m = QueryPlatformPercentageResponse{} }
-func (m *QueryPlatformPercentageResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPlatformPercentageResponse) ProtoMessage()    {}
-func (*QueryPlatformPercentageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d6eabf4b8b83bc3, []int{5}
+// QueryRelyingPartyProfileResponse is the response type for querying the
+// relying party profile of a contract
+type QueryRelyingPartyProfileResponse struct {
+	Profile RelyingPartyProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile"`
 }
-func (m *QueryPlatformPercentageResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryRelyingPartyProfileResponse) Reset()         { *m = QueryRelyingPartyProfileResponse{} }
+func (m *QueryRelyingPartyProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelyingPartyProfileResponse) ProtoMessage()    {}
+func (*QueryRelyingPartyProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{7}
+}
+func (m *QueryRelyingPartyProfileResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPlatformPercentageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRelyingPartyProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPlatformPercentageResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRelyingPartyProfileResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -342,42 +473,42 @@ func (m *QueryPlatformPercentageResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryPlatformPercentageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPlatformPercentageResponse.Merge(m, src)
+func (m *QueryRelyingPartyProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelyingPartyProfileResponse.Merge(m, src)
 }
-func (m *QueryPlatformPercentageResponse) XXX_Size() int {
+func (m *QueryRelyingPartyProfileResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPlatformPercentageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPlatformPercentageResponse.DiscardUnknown(m)
+func (m *QueryRelyingPartyProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelyingPartyProfileResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPlatformPercentageResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRelyingPartyProfileResponse proto.InternalMessageInfo
 
-func (m *QueryPlatformPercentageResponse) GetPlatformPercentage() uint64 {
+func (m *QueryRelyingPartyProfileResponse) GetProfile() RelyingPartyProfile {
 	if m != nil {
-		return m.PlatformPercentage
+		return m.Profile
 	}
-	return 0
+	return RelyingPartyProfile{}
 }
 
-// QueryPlatformMinimumRequest is the request type for querying platform minimum
-// fees
-type QueryPlatformMinimumRequest struct {
+// QueryEmissionsScheduleRequest is the request type for querying the
+// emissions schedule
+type QueryEmissionsScheduleRequest struct {
 }
 
-func (m *QueryPlatformMinimumRequest) Reset()         { *m = QueryPlatformMinimumRequest{} }
-func (m *QueryPlatformMinimumRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPlatformMinimumRequest) ProtoMessage()    {}
-func (*QueryPlatformMinimumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d6eabf4b8b83bc3, []int{6}
+func (m *QueryEmissionsScheduleRequest) Reset()         { *m = QueryEmissionsScheduleRequest{} }
+func (m *QueryEmissionsScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionsScheduleRequest) ProtoMessage()    {}
+func (*QueryEmissionsScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{8}
 }
-func (m *QueryPlatformMinimumRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEmissionsScheduleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPlatformMinimumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEmissionsScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPlatformMinimumRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEmissionsScheduleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -387,37 +518,36 @@ func (m *QueryPlatformMinimumRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPlatformMinimumRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPlatformMinimumRequest.Merge(m, src)
+func (m *QueryEmissionsScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionsScheduleRequest.Merge(m, src)
 }
-func (m *QueryPlatformMinimumRequest) XXX_Size() int {
+func (m *QueryEmissionsScheduleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPlatformMinimumRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPlatformMinimumRequest.DiscardUnknown(m)
+func (m *QueryEmissionsScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionsScheduleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPlatformMinimumRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryEmissionsScheduleRequest proto.InternalMessageInfo
 
-// QueryPlatformMinimumResponse is the response type for querying platform
-// minimum fees
-type QueryPlatformMinimumResponse struct {
-	// The minimum fees required by the platform
-	Minimums github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=minimums,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minimums"`
+// QueryEmissionsScheduleResponse is the response type for querying the
+// emissions schedule
+type QueryEmissionsScheduleResponse struct {
+	Schedule EmissionsSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
 }
 
-func (m *QueryPlatformMinimumResponse) Reset()         { *m = QueryPlatformMinimumResponse{} }
-func (m *QueryPlatformMinimumResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPlatformMinimumResponse) ProtoMessage()    {}
-func (*QueryPlatformMinimumResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d6eabf4b8b83bc3, []int{7}
+func (m *QueryEmissionsScheduleResponse) Reset()         { *m = QueryEmissionsScheduleResponse{} }
+func (m *QueryEmissionsScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionsScheduleResponse) ProtoMessage()    {}
+func (*QueryEmissionsScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{9}
 }
-func (m *QueryPlatformMinimumResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEmissionsScheduleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPlatformMinimumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEmissionsScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPlatformMinimumResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEmissionsScheduleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -427,685 +557,8790 @@ func (m *QueryPlatformMinimumResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPlatformMinimumResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPlatformMinimumResponse.Merge(m, src)
+func (m *QueryEmissionsScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionsScheduleResponse.Merge(m, src)
 }
-func (m *QueryPlatformMinimumResponse) XXX_Size() int {
+func (m *QueryEmissionsScheduleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPlatformMinimumResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPlatformMinimumResponse.DiscardUnknown(m)
+func (m *QueryEmissionsScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionsScheduleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPlatformMinimumResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEmissionsScheduleResponse proto.InternalMessageInfo
 
-func (m *QueryPlatformMinimumResponse) GetMinimums() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryEmissionsScheduleResponse) GetSchedule() EmissionsSchedule {
 	if m != nil {
-		return m.Minimums
+		return m.Schedule
 	}
-	return nil
+	return EmissionsSchedule{}
 }
 
-func init() {
-	proto.RegisterType((*QueryWebAuthNVerifyRegisterRequest)(nil), "xion.v1.QueryWebAuthNVerifyRegisterRequest")
-	proto.RegisterType((*QueryWebAuthNVerifyRegisterResponse)(nil), "xion.v1.QueryWebAuthNVerifyRegisterResponse")
-	proto.RegisterType((*QueryWebAuthNVerifyAuthenticateRequest)(nil), "xion.v1.QueryWebAuthNVerifyAuthenticateRequest")
-	proto.RegisterType((*QueryWebAuthNVerifyAuthenticateResponse)(nil), "xion.v1.QueryWebAuthNVerifyAuthenticateResponse")
-	proto.RegisterType((*QueryPlatformPercentageRequest)(nil), "xion.v1.QueryPlatformPercentageRequest")
-	proto.RegisterType((*QueryPlatformPercentageResponse)(nil), "xion.v1.QueryPlatformPercentageResponse")
-	proto.RegisterType((*QueryPlatformMinimumRequest)(nil), "xion.v1.QueryPlatformMinimumRequest")
-	proto.RegisterType((*QueryPlatformMinimumResponse)(nil), "xion.v1.QueryPlatformMinimumResponse")
+// QueryProjectedSupplyRequest is the request type for projecting the supply
+// at a future height
+type QueryProjectedSupplyRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func init() { proto.RegisterFile("xion/v1/query.proto", fileDescriptor_2d6eabf4b8b83bc3) }
-
-var fileDescriptor_2d6eabf4b8b83bc3 = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0x8d, 0x93, 0x14, 0xe8, 0xa5, 0x02, 0x69, 0x8a, 0x50, 0x30, 0xc5, 0x89, 0xcc, 0x23, 0x41,
-	0x50, 0x4f, 0x53, 0xbe, 0xa0, 0x45, 0x2c, 0x41, 0xc5, 0x0b, 0x90, 0xd8, 0xc0, 0xd8, 0x99, 0x3a,
-	0x23, 0x6c, 0x8f, 0x3b, 0x33, 0x8e, 0x1a, 0x76, 0xac, 0xd8, 0xf2, 0x05, 0x7c, 0x00, 0x5f, 0xd2,
-	0x1d, 0x5d, 0xb2, 0x02, 0x94, 0xfc, 0x08, 0xf2, 0xd8, 0x31, 0x49, 0xeb, 0x34, 0x5d, 0xb0, 0xf2,
-	0xd5, 0x7d, 0x9c, 0x7b, 0x4e, 0xee, 0xc9, 0xc0, 0xe6, 0x31, 0xe3, 0x31, 0x1e, 0xf5, 0xf1, 0x51,
-	0x4a, 0xc5, 0xd8, 0x49, 0x04, 0x57, 0x1c, 0x5d, 0xcd, 0x92, 0xce, 0xa8, 0x6f, 0xde, 0x0a, 0x78,
-	0xc0, 0x75, 0x0e, 0x67, 0x51, 0x5e, 0x36, 0x2d, 0x9f, 0xcb, 0x88, 0x4b, 0xec, 0x11, 0x49, 0xf1,
-	0xa8, 0xef, 0x51, 0x45, 0xfa, 0xd8, 0xe7, 0x2c, 0xce, 0xeb, 0xf6, 0x27, 0xb0, 0x5f, 0x67, 0x68,
-	0x6f, 0xa9, 0xb7, 0x97, 0xaa, 0xe1, 0xab, 0x37, 0x54, 0xb0, 0xc3, 0xb1, 0x4b, 0x03, 0x26, 0x15,
-	0x15, 0x2e, 0x3d, 0x4a, 0xa9, 0x54, 0x08, 0x41, 0x93, 0x0c, 0x06, 0xa2, 0x65, 0x74, 0x8c, 0xde,
-	0xba, 0xab, 0x63, 0xb4, 0x05, 0xeb, 0xfe, 0x90, 0x84, 0x21, 0x8d, 0x03, 0xda, 0xaa, 0xeb, 0xc2,
-	0xbf, 0x04, 0xba, 0x01, 0x75, 0x91, 0xb4, 0x1a, 0x3a, 0x5d, 0x17, 0x49, 0x86, 0x30, 0x20, 0x8a,
-	0xb4, 0x9a, 0x1d, 0xa3, 0xb7, 0xe1, 0xea, 0xd8, 0x7e, 0x01, 0xf7, 0x2f, 0xdc, 0x2d, 0x13, 0x1e,
-	0x4b, 0x8a, 0x2c, 0x00, 0x5f, 0xd0, 0x01, 0x8d, 0x15, 0x23, 0xa1, 0xa6, 0xb0, 0xe1, 0xce, 0x65,
-	0xec, 0x6f, 0x06, 0x3c, 0xaa, 0xc0, 0xc9, 0xc2, 0xac, 0xc3, 0x27, 0x8a, 0xfe, 0x3f, 0x1d, 0x8b,
-	0x64, 0x9a, 0x67, 0xc9, 0x94, 0x3a, 0xd7, 0xe6, 0x74, 0x3e, 0x86, 0xee, 0x4a, 0x7e, 0xb9, 0x56,
-	0xbb, 0x03, 0x96, 0x6e, 0x3d, 0x08, 0x89, 0x3a, 0xe4, 0x22, 0x3a, 0xa0, 0xc2, 0xa7, 0xb1, 0x22,
-	0xc1, 0x4c, 0x82, 0xed, 0x42, 0x7b, 0x69, 0x47, 0xf1, 0x83, 0x61, 0xd8, 0x4c, 0x8a, 0xea, 0xfb,
-	0xa4, 0x2c, 0x6b, 0xd1, 0x4d, 0x17, 0x25, 0xe7, 0x06, 0xed, 0x7b, 0x70, 0x77, 0x01, 0xf3, 0x25,
-	0x8b, 0x59, 0x94, 0x46, 0xb3, 0x95, 0x5f, 0x0c, 0xd8, 0xaa, 0xae, 0x17, 0x0b, 0x03, 0xb8, 0x16,
-	0xe5, 0x29, 0xd9, 0x6a, 0x74, 0x1a, 0xbd, 0xeb, 0xbb, 0x77, 0x9c, 0xdc, 0x77, 0x4e, 0xe6, 0x3b,
-	0xa7, 0xf0, 0x9d, 0xf3, 0x9c, 0xb3, 0x78, 0x7f, 0xe7, 0xe4, 0x57, 0xbb, 0xf6, 0xfd, 0x77, 0xbb,
-	0x17, 0x30, 0x35, 0x4c, 0x3d, 0xc7, 0xe7, 0x11, 0x2e, 0x4c, 0x9a, 0x7f, 0xb6, 0xe5, 0xe0, 0x23,
-	0x56, 0xe3, 0x84, 0x4a, 0x3d, 0x20, 0xdd, 0x12, 0x7c, 0xf7, 0x47, 0x03, 0xd6, 0x34, 0x13, 0x94,
-	0xc2, 0xed, 0x6a, 0xdb, 0xa0, 0x27, 0x4e, 0xf1, 0x8f, 0x70, 0x56, 0x1b, 0xdb, 0x7c, 0x7a, 0xb9,
-	0xe6, 0xe2, 0x3a, 0x35, 0xf4, 0xd9, 0x00, 0x73, 0xf9, 0x19, 0x11, 0xbe, 0x08, 0xae, 0xc2, 0x90,
-	0xe6, 0xce, 0xe5, 0x07, 0x4a, 0x0e, 0x0c, 0xd0, 0xf9, 0xe3, 0xa3, 0xee, 0x22, 0xd2, 0x52, 0x03,
-	0x99, 0xbd, 0xd5, 0x8d, 0xe5, 0xaa, 0x0f, 0x70, 0xf3, 0xcc, 0xcd, 0xd1, 0x83, 0xea, 0xf1, 0x45,
-	0xcb, 0x98, 0x0f, 0x57, 0x74, 0xcd, 0x36, 0xec, 0xef, 0x9d, 0x4c, 0x2c, 0xe3, 0x74, 0x62, 0x19,
-	0x7f, 0x26, 0x96, 0xf1, 0x75, 0x6a, 0xd5, 0x4e, 0xa7, 0x56, 0xed, 0xe7, 0xd4, 0xaa, 0xbd, 0xeb,
-	0xce, 0xf9, 0xc3, 0x4b, 0x45, 0xac, 0xb6, 0x43, 0xe2, 0x49, 0xac, 0xdf, 0xc0, 0xe3, 0xfc, 0xa3,
-	0x4d, 0xe2, 0x5d, 0xd1, 0x2f, 0xd9, 0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x85, 0xd3,
-	0xc5, 0x1f, 0x05, 0x00, 0x00,
+func (m *QueryProjectedSupplyRequest) Reset()         { *m = QueryProjectedSupplyRequest{} }
+func (m *QueryProjectedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedSupplyRequest) ProtoMessage()    {}
+func (*QueryProjectedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{10}
+}
+func (m *QueryProjectedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedSupplyRequest.Merge(m, src)
+}
+func (m *QueryProjectedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedSupplyRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryProjectedSupplyRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryProjectedSupplyRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// WebAuthNVerifyRegister verifies a WebAuthN registration
-	WebAuthNVerifyRegister(ctx context.Context, in *QueryWebAuthNVerifyRegisterRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyRegisterResponse, error)
-	// WebAuthNVerifyAuthenticate verifies a WebAuthN authentication
-	WebAuthNVerifyAuthenticate(ctx context.Context, in *QueryWebAuthNVerifyAuthenticateRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyAuthenticateResponse, error)
-	// PlatformPercentage queries the platform percentage fee
-	PlatformPercentage(ctx context.Context, in *QueryPlatformPercentageRequest, opts ...grpc.CallOption) (*QueryPlatformPercentageResponse, error)
-	// PlatformMinimum queries the platform minimum fees
-	PlatformMinimum(ctx context.Context, in *QueryPlatformMinimumRequest, opts ...grpc.CallOption) (*QueryPlatformMinimumResponse, error)
+// QueryProjectedSupplyResponse is the response type for projecting the supply
+// at a future height. The projection assumes no fees are collected, so every
+// block issues its full amount and nothing is burned. The inflation curve is
+// projected with the current bonded ratio and bonded tokens, before the
+// rounding of each block provision.
+type QueryProjectedSupplyResponse struct {
+	// The current block height
+	CurrentHeight int64 `protobuf:"varint,1,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// The current mint denom supply
+	CurrentSupply types.Coin `protobuf:"bytes,2,opt,name=current_supply,json=currentSupply,proto3" json:"current_supply"`
+	// The amount issued after the current block up to and including height
+	Issuance types.Coin `protobuf:"bytes,3,opt,name=issuance,proto3" json:"issuance"`
+	// The supply at height
+	ProjectedSupply types.Coin `protobuf:"bytes,4,opt,name=projected_supply,json=projectedSupply,proto3" json:"projected_supply"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryProjectedSupplyResponse) Reset()         { *m = QueryProjectedSupplyResponse{} }
+func (m *QueryProjectedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedSupplyResponse) ProtoMessage()    {}
+func (*QueryProjectedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{11}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryProjectedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) WebAuthNVerifyRegister(ctx context.Context, in *QueryWebAuthNVerifyRegisterRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyRegisterResponse, error) {
-	out := new(QueryWebAuthNVerifyRegisterResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNVerifyRegister", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProjectedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryProjectedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedSupplyResponse.Merge(m, src)
+}
+func (m *QueryProjectedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedSupplyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) WebAuthNVerifyAuthenticate(ctx context.Context, in *QueryWebAuthNVerifyAuthenticateRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyAuthenticateResponse, error) {
-	out := new(QueryWebAuthNVerifyAuthenticateResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNVerifyAuthenticate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProjectedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedSupplyResponse) GetCurrentHeight() int64 {
+	if m != nil {
+		return m.CurrentHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) PlatformPercentage(ctx context.Context, in *QueryPlatformPercentageRequest, opts ...grpc.CallOption) (*QueryPlatformPercentageResponse, error) {
-	out := new(QueryPlatformPercentageResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Query/PlatformPercentage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProjectedSupplyResponse) GetCurrentSupply() types.Coin {
+	if m != nil {
+		return m.CurrentSupply
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) PlatformMinimum(ctx context.Context, in *QueryPlatformMinimumRequest, opts ...grpc.CallOption) (*QueryPlatformMinimumResponse, error) {
-	out := new(QueryPlatformMinimumResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Query/PlatformMinimum", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProjectedSupplyResponse) GetIssuance() types.Coin {
+	if m != nil {
+		return m.Issuance
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// WebAuthNVerifyRegister verifies a WebAuthN registration
-	WebAuthNVerifyRegister(context.Context, *QueryWebAuthNVerifyRegisterRequest) (*QueryWebAuthNVerifyRegisterResponse, error)
-	// WebAuthNVerifyAuthenticate verifies a WebAuthN authentication
-	WebAuthNVerifyAuthenticate(context.Context, *QueryWebAuthNVerifyAuthenticateRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error)
-	// PlatformPercentage queries the platform percentage fee
-	PlatformPercentage(context.Context, *QueryPlatformPercentageRequest) (*QueryPlatformPercentageResponse, error)
-	// PlatformMinimum queries the platform minimum fees
-	PlatformMinimum(context.Context, *QueryPlatformMinimumRequest) (*QueryPlatformMinimumResponse, error)
+func (m *QueryProjectedSupplyResponse) GetProjectedSupply() types.Coin {
+	if m != nil {
+		return m.ProjectedSupply
+	}
+	return types.Coin{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryMintTotalsRequest is the request type for querying the mint totals
+type QueryMintTotalsRequest struct {
 }
 
-func (*UnimplementedQueryServer) WebAuthNVerifyRegister(ctx context.Context, req *QueryWebAuthNVerifyRegisterRequest) (*QueryWebAuthNVerifyRegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNVerifyRegister not implemented")
+func (m *QueryMintTotalsRequest) Reset()         { *m = QueryMintTotalsRequest{} }
+func (m *QueryMintTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintTotalsRequest) ProtoMessage()    {}
+func (*QueryMintTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{12}
 }
-func (*UnimplementedQueryServer) WebAuthNVerifyAuthenticate(ctx context.Context, req *QueryWebAuthNVerifyAuthenticateRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNVerifyAuthenticate not implemented")
+func (m *QueryMintTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) PlatformPercentage(ctx context.Context, req *QueryPlatformPercentageRequest) (*QueryPlatformPercentageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlatformPercentage not implemented")
+func (m *QueryMintTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PlatformMinimum(ctx context.Context, req *QueryPlatformMinimumRequest) (*QueryPlatformMinimumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlatformMinimum not implemented")
+func (m *QueryMintTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintTotalsRequest.Merge(m, src)
+}
+func (m *QueryMintTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintTotalsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryMintTotalsRequest proto.InternalMessageInfo
+
+// QueryMintTotalsResponse is the response type for querying the mint totals
+type QueryMintTotalsResponse struct {
+	Totals MintTotals `protobuf:"bytes,1,opt,name=totals,proto3" json:"totals"`
 }
 
-func _Query_WebAuthNVerifyRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWebAuthNVerifyRegisterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WebAuthNVerifyRegister(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Query/WebAuthNVerifyRegister",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WebAuthNVerifyRegister(ctx, req.(*QueryWebAuthNVerifyRegisterRequest))
+func (m *QueryMintTotalsResponse) Reset()         { *m = QueryMintTotalsResponse{} }
+func (m *QueryMintTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintTotalsResponse) ProtoMessage()    {}
+func (*QueryMintTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{13}
+}
+func (m *QueryMintTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMintTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintTotalsResponse.Merge(m, src)
+}
+func (m *QueryMintTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintTotalsResponse.DiscardUnknown(m)
 }
 
-func _Query_WebAuthNVerifyAuthenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWebAuthNVerifyAuthenticateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WebAuthNVerifyAuthenticate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Query/WebAuthNVerifyAuthenticate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WebAuthNVerifyAuthenticate(ctx, req.(*QueryWebAuthNVerifyAuthenticateRequest))
+var xxx_messageInfo_QueryMintTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryMintTotalsResponse) GetTotals() MintTotals {
+	if m != nil {
+		return m.Totals
 	}
-	return interceptor(ctx, in, info, handler)
+	return MintTotals{}
 }
 
-func _Query_PlatformPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlatformPercentageRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PlatformPercentage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Query/PlatformPercentage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PlatformPercentage(ctx, req.(*QueryPlatformPercentageRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryMintEpochsRequest is the request type for querying recent mint epochs
+type QueryMintEpochsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PlatformMinimum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlatformMinimumRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PlatformMinimum(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Query/PlatformMinimum",
+func (m *QueryMintEpochsRequest) Reset()         { *m = QueryMintEpochsRequest{} }
+func (m *QueryMintEpochsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintEpochsRequest) ProtoMessage()    {}
+func (*QueryMintEpochsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{14}
+}
+func (m *QueryMintEpochsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintEpochsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintEpochsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PlatformMinimum(ctx, req.(*QueryPlatformMinimumRequest))
+}
+func (m *QueryMintEpochsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintEpochsRequest.Merge(m, src)
+}
+func (m *QueryMintEpochsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintEpochsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintEpochsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintEpochsRequest proto.InternalMessageInfo
+
+func (m *QueryMintEpochsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "xion.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "WebAuthNVerifyRegister",
-			Handler:    _Query_WebAuthNVerifyRegister_Handler,
-		},
-		{
-			MethodName: "WebAuthNVerifyAuthenticate",
-			Handler:    _Query_WebAuthNVerifyAuthenticate_Handler,
-		},
-		{
-			MethodName: "PlatformPercentage",
-			Handler:    _Query_PlatformPercentage_Handler,
-		},
-		{
-			MethodName: "PlatformMinimum",
-			Handler:    _Query_PlatformMinimum_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "xion/v1/query.proto",
+// QueryMintEpochsResponse is the response type for querying recent mint
+// epochs
+type QueryMintEpochsResponse struct {
+	Epochs     []MintEpoch         `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryWebAuthNVerifyRegisterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintEpochsResponse) Reset()         { *m = QueryMintEpochsResponse{} }
+func (m *QueryMintEpochsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintEpochsResponse) ProtoMessage()    {}
+func (*QueryMintEpochsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{15}
+}
+func (m *QueryMintEpochsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintEpochsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryMintEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintEpochsResponse.Merge(m, src)
+}
+func (m *QueryMintEpochsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintEpochsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWebAuthNVerifyRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryMintEpochsResponse proto.InternalMessageInfo
+
+func (m *QueryMintEpochsResponse) GetEpochs() []MintEpoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
 }
 
-func (m *QueryWebAuthNVerifyRegisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x22
+func (m *QueryMintEpochsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.Rp) > 0 {
-		i -= len(m.Rp)
-		copy(dAtA[i:], m.Rp)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rp)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Challenge) > 0 {
-		i -= len(m.Challenge)
-		copy(dAtA[i:], m.Challenge)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryWebAuthNVerifyRegisterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryAttestationTrustAnchorsRequest is the request type for querying the
+// attestation trust anchors
+type QueryAttestationTrustAnchorsRequest struct {
 }
 
-func (m *QueryWebAuthNVerifyRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAttestationTrustAnchorsRequest) Reset()         { *m = QueryAttestationTrustAnchorsRequest{} }
+func (m *QueryAttestationTrustAnchorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationTrustAnchorsRequest) ProtoMessage()    {}
+func (*QueryAttestationTrustAnchorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{16}
 }
-
-func (m *QueryWebAuthNVerifyRegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Credential) > 0 {
-		i -= len(m.Credential)
-		copy(dAtA[i:], m.Credential)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAttestationTrustAnchorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryWebAuthNVerifyAuthenticateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAttestationTrustAnchorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationTrustAnchorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAttestationTrustAnchorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationTrustAnchorsRequest.Merge(m, src)
+}
+func (m *QueryAttestationTrustAnchorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationTrustAnchorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationTrustAnchorsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAttestationTrustAnchorsRequest proto.InternalMessageInfo
+
+// QueryAttestationTrustAnchorsResponse is the response type for querying the
+// attestation trust anchors
+type QueryAttestationTrustAnchorsResponse struct {
+	// The trusted authenticator models
+	Anchors []AttestationTrustAnchor `protobuf:"bytes,1,rep,name=anchors,proto3" json:"anchors"`
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Credential) > 0 {
-		i -= len(m.Credential)
-		copy(dAtA[i:], m.Credential)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Rp) > 0 {
-		i -= len(m.Rp)
-		copy(dAtA[i:], m.Rp)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rp)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Challenge) > 0 {
-		i -= len(m.Challenge)
-		copy(dAtA[i:], m.Challenge)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAttestationTrustAnchorsResponse) Reset()         { *m = QueryAttestationTrustAnchorsResponse{} }
+func (m *QueryAttestationTrustAnchorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationTrustAnchorsResponse) ProtoMessage()    {}
+func (*QueryAttestationTrustAnchorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{17}
+}
+func (m *QueryAttestationTrustAnchorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationTrustAnchorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationTrustAnchorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAttestationTrustAnchorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationTrustAnchorsResponse.Merge(m, src)
+}
+func (m *QueryAttestationTrustAnchorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationTrustAnchorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationTrustAnchorsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAttestationTrustAnchorsResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationTrustAnchorsResponse) GetAnchors() []AttestationTrustAnchor {
+	if m != nil {
+		return m.Anchors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryWebAuthNVerifyAuthenticateRequest is the request type for WebAuthN
+// authentication verification
+type QueryWebAuthNVerifyAuthenticateRequest struct {
+	// The account address
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The challenge string for authentication
+	Challenge string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// The relying party identifier
+	Rp string `protobuf:"bytes,3,opt,name=rp,proto3" json:"rp,omitempty"`
+	// The credential to verify
+	Credential []byte `protobuf:"bytes,4,opt,name=credential,proto3" json:"credential,omitempty"`
+	// The authentication data
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// The contract whose relying party profile replaces rp
+	RpProfile string `protobuf:"bytes,6,opt,name=rp_profile,json=rpProfile,proto3" json:"rp_profile,omitempty"`
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryWebAuthNVerifyAuthenticateRequest) Reset() {
+	*m = QueryWebAuthNVerifyAuthenticateRequest{}
 }
-
-func (m *QueryPlatformPercentageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWebAuthNVerifyAuthenticateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNVerifyAuthenticateRequest) ProtoMessage()    {}
+func (*QueryWebAuthNVerifyAuthenticateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{18}
+}
+func (m *QueryWebAuthNVerifyAuthenticateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWebAuthNVerifyAuthenticateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWebAuthNVerifyAuthenticateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryPlatformPercentageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWebAuthNVerifyAuthenticateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNVerifyAuthenticateRequest.Merge(m, src)
+}
+func (m *QueryWebAuthNVerifyAuthenticateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWebAuthNVerifyAuthenticateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNVerifyAuthenticateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPlatformPercentageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryWebAuthNVerifyAuthenticateRequest proto.InternalMessageInfo
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
 }
 
-func (m *QueryPlatformPercentageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWebAuthNVerifyAuthenticateRequest) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPlatformPercentageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWebAuthNVerifyAuthenticateRequest) GetRp() string {
+	if m != nil {
+		return m.Rp
+	}
+	return ""
 }
 
-func (m *QueryPlatformPercentageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PlatformPercentage != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PlatformPercentage))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryWebAuthNVerifyAuthenticateRequest) GetCredential() []byte {
+	if m != nil {
+		return m.Credential
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPlatformMinimumRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWebAuthNVerifyAuthenticateRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPlatformMinimumRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWebAuthNVerifyAuthenticateRequest) GetRpProfile() string {
+	if m != nil {
+		return m.RpProfile
+	}
+	return ""
 }
 
-func (m *QueryPlatformMinimumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryWebAuthNVerifyAuthenticateResponse is the response type for WebAuthN
+// authentication verification
+type QueryWebAuthNVerifyAuthenticateResponse struct {
 }
 
-func (m *QueryPlatformMinimumResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryWebAuthNVerifyAuthenticateResponse) Reset() {
+	*m = QueryWebAuthNVerifyAuthenticateResponse{}
 }
-
-func (m *QueryPlatformMinimumResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWebAuthNVerifyAuthenticateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNVerifyAuthenticateResponse) ProtoMessage()    {}
+func (*QueryWebAuthNVerifyAuthenticateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{19}
 }
-
-func (m *QueryPlatformMinimumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Minimums) > 0 {
-		for iNdEx := len(m.Minimums) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Minimums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWebAuthNVerifyAuthenticateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNVerifyAuthenticateResponse.Merge(m, src)
 }
-func (m *QueryWebAuthNVerifyRegisterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Challenge)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Rp)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNVerifyAuthenticateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWebAuthNVerifyRegisterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Credential)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+var xxx_messageInfo_QueryWebAuthNVerifyAuthenticateResponse proto.InternalMessageInfo
+
+// QueryPlatformPercentageRequest is the request type for querying platform
+// percentage
+type QueryPlatformPercentageRequest struct {
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Challenge)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Rp)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Credential)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPlatformPercentageRequest) Reset()         { *m = QueryPlatformPercentageRequest{} }
+func (m *QueryPlatformPercentageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlatformPercentageRequest) ProtoMessage()    {}
+func (*QueryPlatformPercentageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{20}
+}
+func (m *QueryPlatformPercentageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlatformPercentageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlatformPercentageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPlatformPercentageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlatformPercentageRequest.Merge(m, src)
+}
+func (m *QueryPlatformPercentageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlatformPercentageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlatformPercentageRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_QueryPlatformPercentageRequest proto.InternalMessageInfo
+
+// QueryPlatformPercentageResponse is the response type for querying platform
+// percentage
+type QueryPlatformPercentageResponse struct {
+	// The platform percentage fee
+	PlatformPercentage uint64 `protobuf:"varint,1,opt,name=platform_percentage,json=platformPercentage,proto3" json:"platform_percentage,omitempty"`
 }
 
-func (m *QueryPlatformPercentageRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPlatformPercentageResponse) Reset()         { *m = QueryPlatformPercentageResponse{} }
+func (m *QueryPlatformPercentageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlatformPercentageResponse) ProtoMessage()    {}
+func (*QueryPlatformPercentageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{21}
+}
+func (m *QueryPlatformPercentageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlatformPercentageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlatformPercentageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryPlatformPercentageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlatformPercentageResponse.Merge(m, src)
+}
+func (m *QueryPlatformPercentageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlatformPercentageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlatformPercentageResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPlatformPercentageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PlatformPercentage != 0 {
-		n += 1 + sovQuery(uint64(m.PlatformPercentage))
+var xxx_messageInfo_QueryPlatformPercentageResponse proto.InternalMessageInfo
+
+func (m *QueryPlatformPercentageResponse) GetPlatformPercentage() uint64 {
+	if m != nil {
+		return m.PlatformPercentage
 	}
-	return n
+	return 0
 }
 
-func (m *QueryPlatformMinimumRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryPlatformMinimumRequest is the request type for querying platform minimum
+// fees
+type QueryPlatformMinimumRequest struct {
 }
 
-func (m *QueryPlatformMinimumResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Minimums) > 0 {
-		for _, e := range m.Minimums {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPlatformMinimumRequest) Reset()         { *m = QueryPlatformMinimumRequest{} }
+func (m *QueryPlatformMinimumRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlatformMinimumRequest) ProtoMessage()    {}
+func (*QueryPlatformMinimumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{22}
+}
+func (m *QueryPlatformMinimumRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlatformMinimumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlatformMinimumRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlatformMinimumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlatformMinimumRequest.Merge(m, src)
+}
+func (m *QueryPlatformMinimumRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlatformMinimumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlatformMinimumRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlatformMinimumRequest proto.InternalMessageInfo
+
+// QueryPlatformMinimumResponse is the response type for querying platform
+// minimum fees
+type QueryPlatformMinimumResponse struct {
+	// The minimum fees required by the platform
+	Minimums github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=minimums,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minimums"`
+}
+
+func (m *QueryPlatformMinimumResponse) Reset()         { *m = QueryPlatformMinimumResponse{} }
+func (m *QueryPlatformMinimumResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlatformMinimumResponse) ProtoMessage()    {}
+func (*QueryPlatformMinimumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{23}
+}
+func (m *QueryPlatformMinimumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlatformMinimumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlatformMinimumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlatformMinimumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlatformMinimumResponse.Merge(m, src)
+}
+func (m *QueryPlatformMinimumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlatformMinimumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlatformMinimumResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlatformMinimumResponse proto.InternalMessageInfo
+
+func (m *QueryPlatformMinimumResponse) GetMinimums() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minimums
+	}
+	return nil
+}
+
+// QueryPlatformFeeSplitRequest is the request type for querying the platform
+// fee split
+type QueryPlatformFeeSplitRequest struct {
+}
+
+func (m *QueryPlatformFeeSplitRequest) Reset()         { *m = QueryPlatformFeeSplitRequest{} }
+func (m *QueryPlatformFeeSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlatformFeeSplitRequest) ProtoMessage()    {}
+func (*QueryPlatformFeeSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{24}
+}
+func (m *QueryPlatformFeeSplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlatformFeeSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlatformFeeSplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlatformFeeSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlatformFeeSplitRequest.Merge(m, src)
+}
+func (m *QueryPlatformFeeSplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlatformFeeSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlatformFeeSplitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlatformFeeSplitRequest proto.InternalMessageInfo
+
+// QueryPlatformFeeSplitResponse is the response type for querying the platform
+// fee split
+type QueryPlatformFeeSplitResponse struct {
+	// The distribution split applied to collected platform fees
+	Split PlatformFeeSplit `protobuf:"bytes,1,opt,name=split,proto3" json:"split"`
+}
+
+func (m *QueryPlatformFeeSplitResponse) Reset()         { *m = QueryPlatformFeeSplitResponse{} }
+func (m *QueryPlatformFeeSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlatformFeeSplitResponse) ProtoMessage()    {}
+func (*QueryPlatformFeeSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{25}
+}
+func (m *QueryPlatformFeeSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlatformFeeSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlatformFeeSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlatformFeeSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlatformFeeSplitResponse.Merge(m, src)
+}
+func (m *QueryPlatformFeeSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlatformFeeSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlatformFeeSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlatformFeeSplitResponse proto.InternalMessageInfo
+
+func (m *QueryPlatformFeeSplitResponse) GetSplit() PlatformFeeSplit {
+	if m != nil {
+		return m.Split
+	}
+	return PlatformFeeSplit{}
+}
+
+// QueryPlatformFeeEnforcementRequest is the request type for querying the
+// platform fee enforcement
+type QueryPlatformFeeEnforcementRequest struct {
+}
+
+func (m *QueryPlatformFeeEnforcementRequest) Reset()         { *m = QueryPlatformFeeEnforcementRequest{} }
+func (m *QueryPlatformFeeEnforcementRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlatformFeeEnforcementRequest) ProtoMessage()    {}
+func (*QueryPlatformFeeEnforcementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{26}
+}
+func (m *QueryPlatformFeeEnforcementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlatformFeeEnforcementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlatformFeeEnforcementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlatformFeeEnforcementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlatformFeeEnforcementRequest.Merge(m, src)
+}
+func (m *QueryPlatformFeeEnforcementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlatformFeeEnforcementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlatformFeeEnforcementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlatformFeeEnforcementRequest proto.InternalMessageInfo
+
+// QueryPlatformFeeEnforcementResponse is the response type for querying the
+// platform fee enforcement
+type QueryPlatformFeeEnforcementResponse struct {
+	// The platform fee enforcement settings
+	Enforcement PlatformFeeEnforcement `protobuf:"bytes,1,opt,name=enforcement,proto3" json:"enforcement"`
+}
+
+func (m *QueryPlatformFeeEnforcementResponse) Reset()         { *m = QueryPlatformFeeEnforcementResponse{} }
+func (m *QueryPlatformFeeEnforcementResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlatformFeeEnforcementResponse) ProtoMessage()    {}
+func (*QueryPlatformFeeEnforcementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{27}
+}
+func (m *QueryPlatformFeeEnforcementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlatformFeeEnforcementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlatformFeeEnforcementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlatformFeeEnforcementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlatformFeeEnforcementResponse.Merge(m, src)
+}
+func (m *QueryPlatformFeeEnforcementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlatformFeeEnforcementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlatformFeeEnforcementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlatformFeeEnforcementResponse proto.InternalMessageInfo
+
+func (m *QueryPlatformFeeEnforcementResponse) GetEnforcement() PlatformFeeEnforcement {
+	if m != nil {
+		return m.Enforcement
+	}
+	return PlatformFeeEnforcement{}
+}
+
+// QuerySimulateSendRequest is the request type for simulating a send. A single
+// send is expressed as one output; a multi-send as several.
+type QuerySimulateSendRequest struct {
+	// The address sending the coins
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// The recipients and the gross amount sent to each of them
+	Outputs []types1.Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs"`
+}
+
+func (m *QuerySimulateSendRequest) Reset()         { *m = QuerySimulateSendRequest{} }
+func (m *QuerySimulateSendRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSendRequest) ProtoMessage()    {}
+func (*QuerySimulateSendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{28}
+}
+func (m *QuerySimulateSendRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSendRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateSendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSendRequest.Merge(m, src)
+}
+func (m *QuerySimulateSendRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateSendRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateSendRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *QuerySimulateSendRequest) GetOutputs() []types1.Output {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+// SimulatedOutput describes what a single recipient of a send would receive
+type SimulatedOutput struct {
+	// The recipient address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The gross amount sent to the recipient
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// The platform fee deducted from the amount
+	PlatformFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=platform_fee,json=platformFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"platform_fee"`
+	// The amount the recipient actually receives
+	NetAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=net_amount,json=netAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"net_amount"`
+}
+
+func (m *SimulatedOutput) Reset()         { *m = SimulatedOutput{} }
+func (m *SimulatedOutput) String() string { return proto.CompactTextString(m) }
+func (*SimulatedOutput) ProtoMessage()    {}
+func (*SimulatedOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{29}
+}
+func (m *SimulatedOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulatedOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedOutput.Merge(m, src)
+}
+func (m *SimulatedOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedOutput proto.InternalMessageInfo
+
+func (m *SimulatedOutput) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SimulatedOutput) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SimulatedOutput) GetPlatformFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PlatformFee
+	}
+	return nil
+}
+
+func (m *SimulatedOutput) GetNetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NetAmount
+	}
+	return nil
+}
+
+// QuerySimulateSendResponse is the response type for simulating a send
+type QuerySimulateSendResponse struct {
+	// The platform percentage applied, multiplied by 10000
+	PlatformPercentage uint64 `protobuf:"varint,1,opt,name=platform_percentage,json=platformPercentage,proto3" json:"platform_percentage,omitempty"`
+	// The per recipient breakdown
+	Outputs []SimulatedOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs"`
+	// The total amount debited from the sender
+	TotalAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_amount,json=totalAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_amount"`
+	// The total platform fee collected
+	TotalPlatformFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_platform_fee,json=totalPlatformFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_platform_fee"`
+	// Whether the send satisfies the configured platform minimums
+	MinimumsMet bool `protobuf:"varint,5,opt,name=minimums_met,json=minimumsMet,proto3" json:"minimums_met,omitempty"`
+	// The denom that failed the minimum check, if any
+	FailedDenom string `protobuf:"bytes,6,opt,name=failed_denom,json=failedDenom,proto3" json:"failed_denom,omitempty"`
+	// The configured minimum for the failed denom, unset when the denom has no
+	// configured minimum
+	RequiredMinimum *types.Coin `protobuf:"bytes,7,opt,name=required_minimum,json=requiredMinimum,proto3" json:"required_minimum,omitempty"`
+	// A human readable explanation of the failed minimum check
+	FailureReason string `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+}
+
+func (m *QuerySimulateSendResponse) Reset()         { *m = QuerySimulateSendResponse{} }
+func (m *QuerySimulateSendResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSendResponse) ProtoMessage()    {}
+func (*QuerySimulateSendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{30}
+}
+func (m *QuerySimulateSendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateSendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSendResponse.Merge(m, src)
+}
+func (m *QuerySimulateSendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateSendResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateSendResponse) GetPlatformPercentage() uint64 {
+	if m != nil {
+		return m.PlatformPercentage
+	}
+	return 0
+}
+
+func (m *QuerySimulateSendResponse) GetOutputs() []SimulatedOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *QuerySimulateSendResponse) GetTotalAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return nil
+}
+
+func (m *QuerySimulateSendResponse) GetTotalPlatformFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalPlatformFee
+	}
+	return nil
+}
+
+func (m *QuerySimulateSendResponse) GetMinimumsMet() bool {
+	if m != nil {
+		return m.MinimumsMet
+	}
+	return false
+}
+
+func (m *QuerySimulateSendResponse) GetFailedDenom() string {
+	if m != nil {
+		return m.FailedDenom
+	}
+	return ""
+}
+
+func (m *QuerySimulateSendResponse) GetRequiredMinimum() *types.Coin {
+	if m != nil {
+		return m.RequiredMinimum
+	}
+	return nil
+}
+
+func (m *QuerySimulateSendResponse) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+// QueryPaymentScheduleRequest is the request type for querying a payment
+// schedule
+type QueryPaymentScheduleRequest struct {
+	// The id of the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryPaymentScheduleRequest) Reset()         { *m = QueryPaymentScheduleRequest{} }
+func (m *QueryPaymentScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentScheduleRequest) ProtoMessage()    {}
+func (*QueryPaymentScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{31}
+}
+func (m *QueryPaymentScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentScheduleRequest.Merge(m, src)
+}
+func (m *QueryPaymentScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentScheduleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryPaymentScheduleResponse is the response type for querying a payment
+// schedule
+type QueryPaymentScheduleResponse struct {
+	// The payment schedule
+	Schedule PaymentSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *QueryPaymentScheduleResponse) Reset()         { *m = QueryPaymentScheduleResponse{} }
+func (m *QueryPaymentScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentScheduleResponse) ProtoMessage()    {}
+func (*QueryPaymentScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{32}
+}
+func (m *QueryPaymentScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentScheduleResponse.Merge(m, src)
+}
+func (m *QueryPaymentScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentScheduleResponse) GetSchedule() PaymentSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return PaymentSchedule{}
+}
+
+// QueryPaymentSchedulesBySenderRequest is the request type for querying the
+// payment schedules paid by an account
+type QueryPaymentSchedulesBySenderRequest struct {
+	// The address paying the schedules
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymentSchedulesBySenderRequest) Reset()         { *m = QueryPaymentSchedulesBySenderRequest{} }
+func (m *QueryPaymentSchedulesBySenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentSchedulesBySenderRequest) ProtoMessage()    {}
+func (*QueryPaymentSchedulesBySenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{33}
+}
+func (m *QueryPaymentSchedulesBySenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentSchedulesBySenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentSchedulesBySenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentSchedulesBySenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentSchedulesBySenderRequest.Merge(m, src)
+}
+func (m *QueryPaymentSchedulesBySenderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentSchedulesBySenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentSchedulesBySenderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentSchedulesBySenderRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentSchedulesBySenderRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QueryPaymentSchedulesBySenderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPaymentSchedulesBySenderResponse is the response type for querying the
+// payment schedules paid by an account
+type QueryPaymentSchedulesBySenderResponse struct {
+	// The payment schedules
+	Schedules []PaymentSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymentSchedulesBySenderResponse) Reset()         { *m = QueryPaymentSchedulesBySenderResponse{} }
+func (m *QueryPaymentSchedulesBySenderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentSchedulesBySenderResponse) ProtoMessage()    {}
+func (*QueryPaymentSchedulesBySenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{34}
+}
+func (m *QueryPaymentSchedulesBySenderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentSchedulesBySenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentSchedulesBySenderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentSchedulesBySenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentSchedulesBySenderResponse.Merge(m, src)
+}
+func (m *QueryPaymentSchedulesBySenderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentSchedulesBySenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentSchedulesBySenderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentSchedulesBySenderResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentSchedulesBySenderResponse) GetSchedules() []PaymentSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *QueryPaymentSchedulesBySenderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPaymentSchedulesByRecipientRequest is the request type for querying the
+// payment schedules paying an account
+type QueryPaymentSchedulesByRecipientRequest struct {
+	// The address receiving the payments
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymentSchedulesByRecipientRequest) Reset() {
+	*m = QueryPaymentSchedulesByRecipientRequest{}
+}
+func (m *QueryPaymentSchedulesByRecipientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentSchedulesByRecipientRequest) ProtoMessage()    {}
+func (*QueryPaymentSchedulesByRecipientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{35}
+}
+func (m *QueryPaymentSchedulesByRecipientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentSchedulesByRecipientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentSchedulesByRecipientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentSchedulesByRecipientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentSchedulesByRecipientRequest.Merge(m, src)
+}
+func (m *QueryPaymentSchedulesByRecipientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentSchedulesByRecipientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentSchedulesByRecipientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentSchedulesByRecipientRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentSchedulesByRecipientRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueryPaymentSchedulesByRecipientRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPaymentSchedulesByRecipientResponse is the response type for querying
+// the payment schedules paying an account
+type QueryPaymentSchedulesByRecipientResponse struct {
+	// The payment schedules
+	Schedules []PaymentSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymentSchedulesByRecipientResponse) Reset() {
+	*m = QueryPaymentSchedulesByRecipientResponse{}
+}
+func (m *QueryPaymentSchedulesByRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentSchedulesByRecipientResponse) ProtoMessage()    {}
+func (*QueryPaymentSchedulesByRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{36}
+}
+func (m *QueryPaymentSchedulesByRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentSchedulesByRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentSchedulesByRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentSchedulesByRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentSchedulesByRecipientResponse.Merge(m, src)
+}
+func (m *QueryPaymentSchedulesByRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentSchedulesByRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentSchedulesByRecipientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentSchedulesByRecipientResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentSchedulesByRecipientResponse) GetSchedules() []PaymentSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *QueryPaymentSchedulesByRecipientResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEscrowRequest is the request type for querying an escrow
+type QueryEscrowRequest struct {
+	// The id of the escrow
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryEscrowRequest) Reset()         { *m = QueryEscrowRequest{} }
+func (m *QueryEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowRequest) ProtoMessage()    {}
+func (*QueryEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{37}
+}
+func (m *QueryEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowRequest.Merge(m, src)
+}
+func (m *QueryEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryEscrowResponse is the response type for querying an escrow
+type QueryEscrowResponse struct {
+	// The escrow
+	Escrow Escrow `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow"`
+}
+
+func (m *QueryEscrowResponse) Reset()         { *m = QueryEscrowResponse{} }
+func (m *QueryEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowResponse) ProtoMessage()    {}
+func (*QueryEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{38}
+}
+func (m *QueryEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowResponse.Merge(m, src)
+}
+func (m *QueryEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowResponse) GetEscrow() Escrow {
+	if m != nil {
+		return m.Escrow
+	}
+	return Escrow{}
+}
+
+// QueryEscrowsBySenderRequest is the request type for querying the escrows
+// funded by an account
+type QueryEscrowsBySenderRequest struct {
+	// The address that funded the escrows
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEscrowsBySenderRequest) Reset()         { *m = QueryEscrowsBySenderRequest{} }
+func (m *QueryEscrowsBySenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowsBySenderRequest) ProtoMessage()    {}
+func (*QueryEscrowsBySenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{39}
+}
+func (m *QueryEscrowsBySenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowsBySenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowsBySenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowsBySenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowsBySenderRequest.Merge(m, src)
+}
+func (m *QueryEscrowsBySenderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowsBySenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowsBySenderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowsBySenderRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowsBySenderRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QueryEscrowsBySenderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEscrowsBySenderResponse is the response type for querying the escrows
+// funded by an account
+type QueryEscrowsBySenderResponse struct {
+	// The escrows
+	Escrows []Escrow `protobuf:"bytes,1,rep,name=escrows,proto3" json:"escrows"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEscrowsBySenderResponse) Reset()         { *m = QueryEscrowsBySenderResponse{} }
+func (m *QueryEscrowsBySenderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowsBySenderResponse) ProtoMessage()    {}
+func (*QueryEscrowsBySenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{40}
+}
+func (m *QueryEscrowsBySenderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowsBySenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowsBySenderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowsBySenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowsBySenderResponse.Merge(m, src)
+}
+func (m *QueryEscrowsBySenderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowsBySenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowsBySenderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowsBySenderResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowsBySenderResponse) GetEscrows() []Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *QueryEscrowsBySenderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEscrowsByRecipientRequest is the request type for querying the escrows
+// claimable by an account. Escrows claimed with a secret are not included.
+type QueryEscrowsByRecipientRequest struct {
+	// The address that can claim the escrows
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEscrowsByRecipientRequest) Reset()         { *m = QueryEscrowsByRecipientRequest{} }
+func (m *QueryEscrowsByRecipientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowsByRecipientRequest) ProtoMessage()    {}
+func (*QueryEscrowsByRecipientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{41}
+}
+func (m *QueryEscrowsByRecipientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowsByRecipientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowsByRecipientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowsByRecipientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowsByRecipientRequest.Merge(m, src)
+}
+func (m *QueryEscrowsByRecipientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowsByRecipientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowsByRecipientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowsByRecipientRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowsByRecipientRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueryEscrowsByRecipientRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEscrowsByRecipientResponse is the response type for querying the
+// escrows claimable by an account
+type QueryEscrowsByRecipientResponse struct {
+	// The escrows
+	Escrows []Escrow `protobuf:"bytes,1,rep,name=escrows,proto3" json:"escrows"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEscrowsByRecipientResponse) Reset()         { *m = QueryEscrowsByRecipientResponse{} }
+func (m *QueryEscrowsByRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowsByRecipientResponse) ProtoMessage()    {}
+func (*QueryEscrowsByRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{42}
+}
+func (m *QueryEscrowsByRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowsByRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowsByRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowsByRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowsByRecipientResponse.Merge(m, src)
+}
+func (m *QueryEscrowsByRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowsByRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowsByRecipientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowsByRecipientResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowsByRecipientResponse) GetEscrows() []Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *QueryEscrowsByRecipientResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryWebAuthNVerifyRegisterRequest)(nil), "xion.v1.QueryWebAuthNVerifyRegisterRequest")
+	proto.RegisterType((*QueryWebAuthNVerifyRegisterResponse)(nil), "xion.v1.QueryWebAuthNVerifyRegisterResponse")
+	proto.RegisterType((*QueryWebAuthNCredentialRequest)(nil), "xion.v1.QueryWebAuthNCredentialRequest")
+	proto.RegisterType((*QueryWebAuthNCredentialResponse)(nil), "xion.v1.QueryWebAuthNCredentialResponse")
+	proto.RegisterType((*QueryWebAuthNCredentialsByOwnerRequest)(nil), "xion.v1.QueryWebAuthNCredentialsByOwnerRequest")
+	proto.RegisterType((*QueryWebAuthNCredentialsByOwnerResponse)(nil), "xion.v1.QueryWebAuthNCredentialsByOwnerResponse")
+	proto.RegisterType((*QueryRelyingPartyProfileRequest)(nil), "xion.v1.QueryRelyingPartyProfileRequest")
+	proto.RegisterType((*QueryRelyingPartyProfileResponse)(nil), "xion.v1.QueryRelyingPartyProfileResponse")
+	proto.RegisterType((*QueryEmissionsScheduleRequest)(nil), "xion.v1.QueryEmissionsScheduleRequest")
+	proto.RegisterType((*QueryEmissionsScheduleResponse)(nil), "xion.v1.QueryEmissionsScheduleResponse")
+	proto.RegisterType((*QueryProjectedSupplyRequest)(nil), "xion.v1.QueryProjectedSupplyRequest")
+	proto.RegisterType((*QueryProjectedSupplyResponse)(nil), "xion.v1.QueryProjectedSupplyResponse")
+	proto.RegisterType((*QueryMintTotalsRequest)(nil), "xion.v1.QueryMintTotalsRequest")
+	proto.RegisterType((*QueryMintTotalsResponse)(nil), "xion.v1.QueryMintTotalsResponse")
+	proto.RegisterType((*QueryMintEpochsRequest)(nil), "xion.v1.QueryMintEpochsRequest")
+	proto.RegisterType((*QueryMintEpochsResponse)(nil), "xion.v1.QueryMintEpochsResponse")
+	proto.RegisterType((*QueryAttestationTrustAnchorsRequest)(nil), "xion.v1.QueryAttestationTrustAnchorsRequest")
+	proto.RegisterType((*QueryAttestationTrustAnchorsResponse)(nil), "xion.v1.QueryAttestationTrustAnchorsResponse")
+	proto.RegisterType((*QueryWebAuthNVerifyAuthenticateRequest)(nil), "xion.v1.QueryWebAuthNVerifyAuthenticateRequest")
+	proto.RegisterType((*QueryWebAuthNVerifyAuthenticateResponse)(nil), "xion.v1.QueryWebAuthNVerifyAuthenticateResponse")
+	proto.RegisterType((*QueryPlatformPercentageRequest)(nil), "xion.v1.QueryPlatformPercentageRequest")
+	proto.RegisterType((*QueryPlatformPercentageResponse)(nil), "xion.v1.QueryPlatformPercentageResponse")
+	proto.RegisterType((*QueryPlatformMinimumRequest)(nil), "xion.v1.QueryPlatformMinimumRequest")
+	proto.RegisterType((*QueryPlatformMinimumResponse)(nil), "xion.v1.QueryPlatformMinimumResponse")
+	proto.RegisterType((*QueryPlatformFeeSplitRequest)(nil), "xion.v1.QueryPlatformFeeSplitRequest")
+	proto.RegisterType((*QueryPlatformFeeSplitResponse)(nil), "xion.v1.QueryPlatformFeeSplitResponse")
+	proto.RegisterType((*QueryPlatformFeeEnforcementRequest)(nil), "xion.v1.QueryPlatformFeeEnforcementRequest")
+	proto.RegisterType((*QueryPlatformFeeEnforcementResponse)(nil), "xion.v1.QueryPlatformFeeEnforcementResponse")
+	proto.RegisterType((*QuerySimulateSendRequest)(nil), "xion.v1.QuerySimulateSendRequest")
+	proto.RegisterType((*SimulatedOutput)(nil), "xion.v1.SimulatedOutput")
+	proto.RegisterType((*QuerySimulateSendResponse)(nil), "xion.v1.QuerySimulateSendResponse")
+	proto.RegisterType((*QueryPaymentScheduleRequest)(nil), "xion.v1.QueryPaymentScheduleRequest")
+	proto.RegisterType((*QueryPaymentScheduleResponse)(nil), "xion.v1.QueryPaymentScheduleResponse")
+	proto.RegisterType((*QueryPaymentSchedulesBySenderRequest)(nil), "xion.v1.QueryPaymentSchedulesBySenderRequest")
+	proto.RegisterType((*QueryPaymentSchedulesBySenderResponse)(nil), "xion.v1.QueryPaymentSchedulesBySenderResponse")
+	proto.RegisterType((*QueryPaymentSchedulesByRecipientRequest)(nil), "xion.v1.QueryPaymentSchedulesByRecipientRequest")
+	proto.RegisterType((*QueryPaymentSchedulesByRecipientResponse)(nil), "xion.v1.QueryPaymentSchedulesByRecipientResponse")
+	proto.RegisterType((*QueryEscrowRequest)(nil), "xion.v1.QueryEscrowRequest")
+	proto.RegisterType((*QueryEscrowResponse)(nil), "xion.v1.QueryEscrowResponse")
+	proto.RegisterType((*QueryEscrowsBySenderRequest)(nil), "xion.v1.QueryEscrowsBySenderRequest")
+	proto.RegisterType((*QueryEscrowsBySenderResponse)(nil), "xion.v1.QueryEscrowsBySenderResponse")
+	proto.RegisterType((*QueryEscrowsByRecipientRequest)(nil), "xion.v1.QueryEscrowsByRecipientRequest")
+	proto.RegisterType((*QueryEscrowsByRecipientResponse)(nil), "xion.v1.QueryEscrowsByRecipientResponse")
+}
+
+func init() { proto.RegisterFile("xion/v1/query.proto", fileDescriptor_2d6eabf4b8b83bc3) }
+
+var fileDescriptor_2d6eabf4b8b83bc3 = []byte{
+	// 2196 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcf, 0x73, 0x1c, 0x47,
+	0xf5, 0xf7, 0xe8, 0xc7, 0x4a, 0x7e, 0x92, 0x23, 0x7f, 0x5b, 0x8e, 0xbc, 0x1e, 0x49, 0xbb, 0xeb,
+	0xb1, 0x25, 0x6d, 0x1c, 0x6b, 0x47, 0x52, 0xbe, 0x2e, 0x52, 0x09, 0x81, 0x92, 0x63, 0x3b, 0x40,
+	0x61, 0x22, 0x56, 0xa9, 0x50, 0x15, 0x0a, 0xd6, 0xa3, 0xd9, 0xd6, 0x6a, 0xe2, 0xdd, 0x99, 0xf1,
+	0x4c, 0x8f, 0xed, 0x45, 0xa5, 0x03, 0x1c, 0x1c, 0x38, 0x41, 0x15, 0x29, 0xa0, 0x0a, 0x8e, 0x14,
+	0x07, 0x28, 0x52, 0x05, 0x67, 0xb8, 0xa7, 0x38, 0xa5, 0x8a, 0x0b, 0x27, 0xa0, 0x6c, 0x8e, 0xfc,
+	0x11, 0xd4, 0x74, 0xbf, 0x9e, 0xd9, 0x9d, 0x1f, 0x3b, 0x9b, 0x94, 0x4c, 0x38, 0x69, 0xe7, 0xf5,
+	0x7b, 0xfd, 0x3e, 0xef, 0x47, 0x77, 0xbf, 0xd7, 0x2d, 0x58, 0x7c, 0x6c, 0x39, 0xb6, 0xfe, 0x70,
+	0x5b, 0x7f, 0x10, 0x50, 0xaf, 0xdf, 0x70, 0x3d, 0x87, 0x39, 0x64, 0x26, 0x24, 0x36, 0x1e, 0x6e,
+	0xab, 0x17, 0x3a, 0x4e, 0xc7, 0xe1, 0x34, 0x3d, 0xfc, 0x25, 0x86, 0xd5, 0x95, 0x8e, 0xe3, 0x74,
+	0xba, 0x54, 0x37, 0x5c, 0x4b, 0x37, 0x6c, 0xdb, 0x61, 0x06, 0xb3, 0x1c, 0xdb, 0xc7, 0xd1, 0x8a,
+	0xe9, 0xf8, 0x3d, 0xc7, 0xd7, 0x0f, 0x0c, 0x9f, 0xea, 0x0f, 0xb7, 0x0f, 0x28, 0x33, 0xb6, 0x75,
+	0xd3, 0xb1, 0xec, 0xd4, 0xb8, 0x7d, 0x3f, 0x1a, 0x0f, 0x3f, 0x70, 0xfc, 0x45, 0x89, 0xa8, 0x43,
+	0x6d, 0xea, 0x5b, 0xd1, 0xb4, 0x92, 0xec, 0x1a, 0xfd, 0x1e, 0xb5, 0x59, 0xcb, 0x37, 0x8f, 0x68,
+	0x3b, 0xe8, 0x52, 0x1c, 0xbf, 0x20, 0xc7, 0xa9, 0x6f, 0x7a, 0xce, 0x23, 0xa4, 0x2e, 0x49, 0xea,
+	0x23, 0x7a, 0x60, 0x04, 0xec, 0x48, 0x82, 0xb8, 0x18, 0x71, 0xf7, 0x2c, 0xdf, 0x1f, 0x40, 0x7f,
+	0x6d, 0x10, 0x3d, 0xf7, 0x49, 0x84, 0xd1, 0x35, 0x3a, 0x96, 0xcd, 0x4d, 0x15, 0xbc, 0xda, 0x5f,
+	0x14, 0xd0, 0xbe, 0x19, 0xb2, 0x7c, 0x8b, 0x1e, 0xec, 0x06, 0xec, 0xe8, 0x1b, 0xef, 0x52, 0xcf,
+	0x3a, 0xec, 0x37, 0x69, 0xc7, 0xf2, 0x19, 0xf5, 0x9a, 0xf4, 0x41, 0x40, 0x7d, 0x46, 0x08, 0x4c,
+	0x19, 0xed, 0xb6, 0x57, 0x56, 0x6a, 0x4a, 0xfd, 0x6c, 0x93, 0xff, 0x26, 0x2b, 0x70, 0xd6, 0x3c,
+	0x32, 0xba, 0x5d, 0x6a, 0x77, 0x68, 0x79, 0x82, 0x0f, 0xc4, 0x04, 0xf2, 0x02, 0x4c, 0x78, 0x6e,
+	0x79, 0x92, 0x93, 0x27, 0x3c, 0x37, 0x9c, 0xa1, 0x6d, 0x30, 0xa3, 0x3c, 0x55, 0x53, 0xea, 0xf3,
+	0x4d, 0xfe, 0x9b, 0xe8, 0xb0, 0xe8, 0xd1, 0x07, 0x81, 0xe5, 0xd1, 0x96, 0xc1, 0x18, 0xf5, 0x45,
+	0x10, 0xca, 0xd3, 0x35, 0xa5, 0x3e, 0xdb, 0x24, 0x38, 0xb4, 0x1b, 0x8f, 0x90, 0x55, 0x00, 0xcf,
+	0x6d, 0xb9, 0x9e, 0x73, 0x68, 0x75, 0x69, 0xb9, 0x24, 0x74, 0x7a, 0xee, 0x9e, 0x20, 0x68, 0xdf,
+	0x81, 0x2b, 0x23, 0x6d, 0xf1, 0x5d, 0xc7, 0xf6, 0x29, 0xa9, 0x00, 0x98, 0x1e, 0x6d, 0x53, 0x9b,
+	0x59, 0x46, 0x97, 0x9b, 0x34, 0xdf, 0x1c, 0xa0, 0x90, 0x25, 0x28, 0x19, 0x46, 0x27, 0xb0, 0xda,
+	0x68, 0x15, 0x7e, 0x69, 0xdf, 0x86, 0xca, 0xd0, 0xf4, 0x6f, 0x46, 0x22, 0xd2, 0x4d, 0x17, 0x60,
+	0xda, 0x79, 0x64, 0x53, 0xe9, 0x27, 0xf1, 0x41, 0xae, 0xc0, 0xb9, 0x78, 0xf6, 0x16, 0x4e, 0x3b,
+	0xdf, 0x9c, 0x8f, 0x89, 0x5f, 0x6d, 0x6b, 0x2d, 0xa8, 0xe6, 0x4e, 0x8e, 0xb8, 0xbf, 0x08, 0xd3,
+	0xa1, 0x23, 0x28, 0x9f, 0x7d, 0x6e, 0xa7, 0xd6, 0xc0, 0x14, 0x6f, 0xa4, 0x65, 0xf6, 0x43, 0xbe,
+	0x9b, 0x53, 0x1f, 0xff, 0xbd, 0x7a, 0xa6, 0x29, 0x84, 0xb4, 0x27, 0x0a, 0xac, 0xe7, 0x68, 0xf0,
+	0x6f, 0xf6, 0xdf, 0x0e, 0x91, 0x8e, 0x36, 0xe3, 0x0e, 0x40, 0x9c, 0x3e, 0xdc, 0x86, 0xb9, 0x9d,
+	0xf5, 0x86, 0xc8, 0xb5, 0x46, 0x98, 0x6b, 0x0d, 0xb1, 0xfe, 0x30, 0xd7, 0x1a, 0x7b, 0x46, 0x87,
+	0xe2, 0x8c, 0xcd, 0x01, 0x49, 0xed, 0x8f, 0x0a, 0x6c, 0x14, 0x02, 0x41, 0x93, 0xbf, 0x04, 0x25,
+	0x8e, 0xde, 0x2f, 0x2b, 0xb5, 0xc9, 0x4f, 0x61, 0x33, 0x4a, 0x91, 0xb7, 0x32, 0x30, 0x6f, 0x14,
+	0x62, 0x16, 0xca, 0x87, 0x40, 0xbf, 0x81, 0xe1, 0x69, 0xd2, 0x6e, 0xdf, 0xb2, 0x3b, 0x7b, 0x86,
+	0xc7, 0xfa, 0x98, 0x76, 0xd2, 0x6b, 0x2a, 0xcc, 0x9a, 0x8e, 0xcd, 0x3c, 0xc3, 0x64, 0xe8, 0xb8,
+	0xe8, 0x5b, 0xbb, 0x07, 0xb5, 0x7c, 0xf1, 0x28, 0xbc, 0x33, 0x32, 0xb3, 0x45, 0x80, 0x57, 0x22,
+	0x63, 0x33, 0xc4, 0xd0, 0x50, 0x29, 0xa2, 0x55, 0x61, 0x95, 0x6b, 0xb8, 0x2d, 0x37, 0x83, 0x7d,
+	0xdc, 0x5b, 0x10, 0x9e, 0xf6, 0x5d, 0xa8, 0xe4, 0x31, 0x44, 0x00, 0x66, 0xe5, 0x86, 0x84, 0x08,
+	0xd4, 0x08, 0x41, 0x4a, 0x0a, 0xf5, 0x47, 0x12, 0xda, 0x0d, 0x58, 0xe6, 0xf3, 0xef, 0x79, 0xce,
+	0xfb, 0xd4, 0x64, 0xb4, 0xbd, 0x1f, 0xb8, 0x6e, 0xb7, 0x2f, 0xbd, 0xb3, 0x04, 0xa5, 0x23, 0x6a,
+	0x75, 0x8e, 0x84, 0x6f, 0x26, 0x9b, 0xf8, 0xa5, 0xfd, 0x6c, 0x02, 0x56, 0xb2, 0xe5, 0x10, 0xd5,
+	0x1a, 0xbc, 0x60, 0x06, 0x9e, 0x17, 0x6e, 0x97, 0x43, 0x13, 0x9c, 0x43, 0xea, 0x57, 0x38, 0x91,
+	0xdc, 0x89, 0xd9, 0x7c, 0x3e, 0x01, 0x46, 0xfb, 0xd2, 0x50, 0xb4, 0x65, 0x9c, 0xdf, 0x74, 0x2c,
+	0x1b, 0x2d, 0x90, 0xf3, 0x08, 0xb5, 0xe4, 0x75, 0x98, 0xb5, 0x7c, 0x3f, 0x30, 0x6c, 0x93, 0x96,
+	0x27, 0xc7, 0x9b, 0x21, 0x12, 0x20, 0x5f, 0x83, 0xf3, 0xae, 0x34, 0x43, 0xc2, 0x98, 0x1a, 0x6f,
+	0x92, 0x05, 0x77, 0xd8, 0x7e, 0xad, 0x0c, 0x4b, 0xdc, 0x2f, 0x77, 0x2d, 0x9b, 0xbd, 0xe3, 0x30,
+	0xa3, 0xeb, 0xcb, 0x48, 0x7e, 0x1d, 0x2e, 0xa6, 0x46, 0xd0, 0x59, 0xdb, 0x50, 0x62, 0x9c, 0x82,
+	0x01, 0x5c, 0x8c, 0x02, 0x18, 0x33, 0xcb, 0x25, 0x22, 0x18, 0xb5, 0x7b, 0x03, 0x7a, 0x6e, 0xbb,
+	0x8e, 0x79, 0x24, 0xf5, 0x24, 0x16, 0xbc, 0xf2, 0x99, 0x17, 0xfc, 0x87, 0x0a, 0x5c, 0x4c, 0xa9,
+	0x40, 0xc0, 0x5b, 0x50, 0xa2, 0x9c, 0x82, 0x0b, 0x9c, 0x0c, 0x01, 0xe6, 0xcc, 0x12, 0xaf, 0xe0,
+	0x3b, 0xbd, 0x25, 0xbd, 0x86, 0xa7, 0xc5, 0xc0, 0x01, 0xf3, 0x8e, 0x17, 0xf8, 0x6c, 0xd7, 0x36,
+	0x8f, 0x1c, 0x2f, 0xf2, 0x76, 0x07, 0xae, 0x8e, 0x66, 0x43, 0x4b, 0xbe, 0x0c, 0x33, 0x86, 0x20,
+	0xa1, 0x29, 0xd5, 0xc8, 0x94, 0x6c, 0x51, 0xb9, 0x82, 0x51, 0x4a, 0xfb, 0x73, 0x72, 0x83, 0x16,
+	0xc7, 0x57, 0xf8, 0x93, 0xda, 0xcc, 0x32, 0x0d, 0x46, 0x4f, 0xef, 0x38, 0x1e, 0x3e, 0x03, 0xa7,
+	0x52, 0x67, 0xa0, 0x3c, 0xae, 0xa7, 0x07, 0x8e, 0xeb, 0x82, 0xd3, 0xf7, 0x25, 0xd8, 0x28, 0x84,
+	0x2f, 0x7c, 0xa5, 0xd5, 0x70, 0x2f, 0xda, 0xeb, 0x1a, 0xec, 0xd0, 0xf1, 0x7a, 0x7b, 0xd4, 0x33,
+	0xa9, 0xcd, 0xe2, 0xfc, 0xd1, 0x9a, 0x50, 0xcd, 0xe5, 0x40, 0x87, 0xeb, 0xb0, 0xe8, 0xe2, 0x68,
+	0xcb, 0x8d, 0x86, 0xb9, 0x4f, 0xa6, 0x9a, 0xc4, 0x4d, 0x09, 0x6a, 0xab, 0x72, 0x87, 0xc2, 0xa1,
+	0xbb, 0x96, 0x6d, 0xf5, 0x82, 0x9e, 0x54, 0xf9, 0x81, 0x02, 0x2b, 0xd9, 0xe3, 0xa8, 0xb0, 0x03,
+	0xb3, 0x3d, 0x41, 0xf2, 0xcb, 0x93, 0xb5, 0xc9, 0xd1, 0xab, 0x7a, 0x2b, 0x0c, 0xee, 0x6f, 0xff,
+	0x51, 0xad, 0x77, 0x2c, 0x76, 0x14, 0x1c, 0x34, 0x4c, 0xa7, 0xa7, 0x0b, 0x66, 0xfc, 0xb3, 0xe9,
+	0xb7, 0xef, 0xeb, 0xac, 0xef, 0x52, 0x9f, 0x0b, 0xf8, 0xcd, 0x68, 0x72, 0xad, 0x92, 0x00, 0x72,
+	0x87, 0xd2, 0x7d, 0xb7, 0x6b, 0x31, 0x89, 0xf4, 0x5d, 0x58, 0xcd, 0x19, 0x47, 0xa4, 0x37, 0x60,
+	0xda, 0x0f, 0x09, 0xb8, 0x68, 0x2f, 0x45, 0x99, 0x98, 0x94, 0x88, 0x4a, 0x84, 0xf0, 0x43, 0xbb,
+	0x8a, 0xb5, 0xe0, 0x00, 0xd7, 0x6d, 0xfb, 0xd0, 0xf1, 0x4c, 0xda, 0xa3, 0x76, 0xa4, 0xdd, 0x86,
+	0x2b, 0x23, 0xb9, 0x10, 0xc3, 0x5b, 0x30, 0x47, 0x63, 0x32, 0x22, 0xa9, 0x66, 0x21, 0x19, 0x90,
+	0x46, 0x3c, 0x83, 0x92, 0xda, 0xf7, 0xa0, 0xcc, 0xf5, 0xed, 0x5b, 0xbd, 0xa0, 0x6b, 0x30, 0xba,
+	0x4f, 0xed, 0xb6, 0x5c, 0x08, 0x97, 0x61, 0xfe, 0xd0, 0x73, 0x7a, 0xad, 0x70, 0x05, 0x50, 0xdf,
+	0xc7, 0x05, 0x31, 0x17, 0xd2, 0x76, 0x05, 0x89, 0xbc, 0x0e, 0x33, 0x4e, 0xc0, 0xdc, 0x80, 0xf9,
+	0xe5, 0x09, 0x1e, 0xb4, 0xe5, 0x38, 0x68, 0xf6, 0xfd, 0x28, 0x68, 0x6f, 0x73, 0x1e, 0xb9, 0x26,
+	0x51, 0x42, 0xfb, 0xf7, 0x04, 0x2c, 0x48, 0xbd, 0x6d, 0xc1, 0x42, 0xca, 0x30, 0x33, 0xac, 0x4e,
+	0x7e, 0x12, 0x13, 0x4a, 0x46, 0xcf, 0x09, 0x6c, 0x56, 0x9e, 0x38, 0xfd, 0xf4, 0xc0, 0xa9, 0x89,
+	0x0d, 0xf3, 0x51, 0xda, 0x1f, 0x52, 0xfa, 0x3c, 0x32, 0x71, 0xce, 0x8d, 0x83, 0x43, 0xde, 0x07,
+	0xb0, 0x29, 0x6b, 0xa1, 0x61, 0x53, 0xa7, 0xaf, 0xed, 0xac, 0x4d, 0xd9, 0x2e, 0x9f, 0x5d, 0xfb,
+	0xf5, 0x14, 0x5c, 0xca, 0x88, 0xf5, 0x67, 0x5c, 0xf0, 0xe4, 0xd5, 0x64, 0xe8, 0xcb, 0x51, 0xfa,
+	0x25, 0x82, 0x9a, 0x88, 0x7b, 0xe8, 0x64, 0x7e, 0x3c, 0x4a, 0xb3, 0x9f, 0x87, 0x93, 0xb9, 0x02,
+	0x61, 0x38, 0xe9, 0x03, 0x11, 0xfa, 0x86, 0x42, 0xfb, 0x1c, 0x9c, 0x7d, 0x9e, 0xab, 0x19, 0x58,
+	0x7c, 0xe1, 0x12, 0x92, 0x1b, 0x4f, 0xab, 0x47, 0x19, 0x76, 0x5f, 0x73, 0x92, 0x76, 0x97, 0x8a,
+	0x55, 0x66, 0x58, 0x5d, 0xda, 0x6e, 0xb5, 0xa9, 0xed, 0xf4, 0x70, 0xeb, 0x9f, 0x13, 0xb4, 0x5b,
+	0x21, 0x89, 0xdc, 0x82, 0xf3, 0xd8, 0xaf, 0xb5, 0x5b, 0x28, 0x5a, 0x9e, 0x29, 0xa8, 0x7c, 0x9a,
+	0x0b, 0x52, 0x04, 0x77, 0xda, 0xb0, 0xd6, 0x0b, 0x27, 0x0d, 0x3c, 0xda, 0xf2, 0xa8, 0xe1, 0x3b,
+	0x76, 0x79, 0x96, 0xab, 0x3a, 0x87, 0xd4, 0x26, 0x27, 0x6a, 0x9b, 0x72, 0x23, 0x17, 0x6d, 0x74,
+	0xa2, 0xd2, 0x0d, 0xcf, 0x3a, 0xab, 0x8d, 0x69, 0x31, 0x61, 0xb5, 0xb5, 0xf7, 0x60, 0x25, 0x9b,
+	0x1d, 0xf3, 0xea, 0xb5, 0x54, 0xdd, 0x1b, 0xe7, 0x49, 0x42, 0x26, 0x55, 0xf5, 0x3e, 0x51, 0xb0,
+	0x3c, 0x48, 0x30, 0xfa, 0x37, 0xfb, 0x61, 0xee, 0xc6, 0x3d, 0xd5, 0x12, 0x94, 0x7c, 0x4e, 0xc0,
+	0x4d, 0x03, 0xbf, 0x4e, 0xad, 0xab, 0xfa, 0x48, 0x81, 0xb5, 0x02, 0x20, 0x51, 0x99, 0x7f, 0x56,
+	0xc2, 0x97, 0xa5, 0x4a, 0x91, 0xbd, 0xb1, 0xc0, 0xe9, 0x95, 0x5f, 0x3f, 0x96, 0x6d, 0x60, 0x1a,
+	0x70, 0x93, 0x9a, 0x96, 0x6b, 0xc5, 0x47, 0x4e, 0x58, 0xdb, 0x78, 0x92, 0x86, 0xfe, 0x8b, 0x09,
+	0xa7, 0xe6, 0xc2, 0x3f, 0x28, 0x50, 0x2f, 0x46, 0xf4, 0xbf, 0xe5, 0xc5, 0xab, 0x40, 0x44, 0x57,
+	0xc7, 0x6f, 0x8c, 0xf2, 0x56, 0xc0, 0x2d, 0x58, 0x1c, 0xe2, 0x42, 0x1b, 0x36, 0xa1, 0x24, 0x6e,
+	0x9a, 0x30, 0xed, 0x17, 0xe2, 0x76, 0x8f, 0x93, 0xa3, 0xca, 0x9b, 0x7f, 0x69, 0x27, 0xb8, 0xec,
+	0xc4, 0xe0, 0x7f, 0x3d, 0xc3, 0x7f, 0x21, 0xeb, 0xb3, 0x94, 0xfe, 0xe8, 0x7c, 0x98, 0x11, 0x48,
+	0x65, 0x40, 0x72, 0xec, 0x91, 0x5c, 0xa7, 0x17, 0x85, 0x27, 0x8a, 0x6c, 0xae, 0x25, 0xb4, 0xcf,
+	0x29, 0x85, 0x7f, 0xa9, 0x40, 0x35, 0x17, 0xc8, 0xe7, 0xed, 0xa6, 0x9d, 0x67, 0x4b, 0x30, 0xcd,
+	0xd1, 0x91, 0x00, 0x96, 0xb2, 0x2f, 0xe9, 0xc8, 0xcb, 0x11, 0x98, 0xe2, 0x6b, 0x49, 0xf5, 0xfa,
+	0x78, 0xcc, 0xd8, 0x75, 0x9c, 0x21, 0xdf, 0x57, 0x40, 0xcd, 0x6f, 0x4f, 0x88, 0x3e, 0x6a, 0xba,
+	0x8c, 0x3e, 0x4c, 0xdd, 0x1a, 0x5f, 0x20, 0xc2, 0xf0, 0x3b, 0x05, 0x2e, 0xe6, 0xf4, 0x92, 0x24,
+	0x61, 0xcf, 0xe8, 0xce, 0x54, 0xdd, 0x1c, 0x93, 0x1b, 0x55, 0xbf, 0xf2, 0x83, 0xbf, 0xfe, 0xeb,
+	0xa7, 0x13, 0x9b, 0xe4, 0x65, 0x3d, 0x79, 0xa1, 0xac, 0x0f, 0xdc, 0xbe, 0xb6, 0x58, 0x28, 0xdb,
+	0xc2, 0xa6, 0x94, 0xfc, 0x46, 0x01, 0x92, 0xbe, 0x6a, 0x23, 0x1b, 0xd9, 0x86, 0xa7, 0x6e, 0x44,
+	0xd5, 0x7a, 0x31, 0x23, 0xc2, 0x7b, 0x83, 0xc3, 0xfb, 0x02, 0xb9, 0x91, 0x86, 0x17, 0xf7, 0xa5,
+	0xbe, 0x7e, 0xcc, 0x2f, 0x23, 0x4f, 0xf4, 0xe3, 0xa1, 0x2b, 0xd5, 0x13, 0xf2, 0xd1, 0x40, 0x68,
+	0xd3, 0x17, 0x8a, 0x79, 0xa1, 0xcd, 0xbd, 0x03, 0x55, 0xb7, 0xc6, 0x17, 0x40, 0x03, 0x36, 0xb9,
+	0x01, 0x1b, 0x64, 0x6d, 0x2c, 0x03, 0xc8, 0xaf, 0x14, 0x58, 0xcc, 0xb8, 0xd7, 0x23, 0x09, 0x8f,
+	0xe5, 0x5f, 0x38, 0xaa, 0x2f, 0x8d, 0xc1, 0x89, 0xd8, 0xb6, 0x38, 0xb6, 0x6b, 0xa4, 0x9e, 0xc6,
+	0x16, 0xb7, 0xf4, 0xbe, 0x7e, 0x2c, 0x2f, 0x2c, 0x4f, 0x88, 0x05, 0x24, 0xdd, 0x7b, 0x27, 0xe3,
+	0x9e, 0xdb, 0xbf, 0xab, 0xf5, 0x62, 0xc6, 0x68, 0x45, 0xdc, 0x83, 0x85, 0x44, 0xcb, 0x4d, 0xae,
+	0x66, 0x8b, 0x0f, 0x77, 0xec, 0xea, 0x5a, 0x01, 0x57, 0xa4, 0xc1, 0x84, 0xf3, 0xc9, 0xce, 0x97,
+	0xe4, 0x08, 0x27, 0x7a, 0x6d, 0x75, 0xbd, 0x88, 0x2d, 0x52, 0x12, 0xc0, 0x52, 0x76, 0x53, 0x9b,
+	0xdc, 0xd3, 0x46, 0xb6, 0xd7, 0xea, 0xf5, 0xf1, 0x98, 0x23, 0xb5, 0x1f, 0x28, 0xb0, 0x90, 0xa8,
+	0x37, 0x52, 0xee, 0xcb, 0xac, 0x93, 0xd5, 0xb5, 0x02, 0x2e, 0x54, 0xb1, 0xc1, 0x73, 0xe7, 0x32,
+	0xa9, 0xea, 0x79, 0xcf, 0x57, 0xbe, 0x7e, 0x1c, 0x2e, 0xc1, 0xdf, 0x2b, 0x50, 0xce, 0xab, 0x3e,
+	0xc9, 0xe6, 0x48, 0x65, 0xc9, 0x62, 0x42, 0x6d, 0x8c, 0xcb, 0x8e, 0x20, 0x77, 0x38, 0xc8, 0xeb,
+	0xe4, 0xda, 0x08, 0x90, 0xa2, 0x1e, 0xd1, 0x8f, 0xc5, 0xdf, 0x13, 0xf2, 0x27, 0x05, 0x96, 0x47,
+	0x94, 0x7a, 0x64, 0xab, 0x08, 0x43, 0xf2, 0x90, 0x57, 0xb7, 0x3f, 0x85, 0x04, 0x02, 0x7f, 0x95,
+	0x03, 0xdf, 0x21, 0x5b, 0x23, 0x80, 0x47, 0x75, 0x82, 0x7e, 0x1c, 0xfd, 0x3c, 0x21, 0x07, 0x50,
+	0x12, 0xe7, 0x35, 0x59, 0x1e, 0x56, 0x3b, 0x54, 0x0b, 0xaa, 0x2b, 0xd9, 0x83, 0xa8, 0x7e, 0x95,
+	0xab, 0xbf, 0x48, 0x5e, 0xd4, 0x87, 0xdf, 0x1e, 0x31, 0xa4, 0x3f, 0x54, 0x60, 0x21, 0x51, 0x6e,
+	0x25, 0x93, 0x2b, 0xbb, 0x1a, 0x54, 0xd7, 0x0a, 0xb8, 0x50, 0x7f, 0x9d, 0xeb, 0xd7, 0x48, 0x2d,
+	0xa5, 0x3f, 0x19, 0xad, 0x9f, 0x2b, 0x40, 0xd2, 0x55, 0x4d, 0x72, 0x47, 0xca, 0x2d, 0xc0, 0xd4,
+	0x7a, 0x31, 0x23, 0x62, 0x6a, 0x70, 0x4c, 0x75, 0xb2, 0x9e, 0xc2, 0x94, 0x1d, 0x88, 0x1f, 0x29,
+	0xf0, 0x7f, 0xa9, 0xf7, 0x11, 0x92, 0xd8, 0x38, 0xf2, 0xde, 0x65, 0xd4, 0x8d, 0x42, 0x3e, 0x84,
+	0x75, 0x85, 0xc3, 0x5a, 0x25, 0xcb, 0x7a, 0xea, 0xe1, 0x57, 0x97, 0xb9, 0x42, 0x3e, 0x0c, 0x77,
+	0x83, 0xe1, 0x97, 0x84, 0xd4, 0x6e, 0x90, 0xf9, 0x40, 0xa3, 0xae, 0x15, 0x70, 0x21, 0x8a, 0xff,
+	0xe7, 0x28, 0x1a, 0xe4, 0x7a, 0x06, 0x8a, 0xe4, 0xdb, 0x87, 0x7e, 0x2c, 0x5e, 0x6c, 0x4e, 0xc8,
+	0x23, 0x80, 0xf8, 0x01, 0x82, 0x54, 0x87, 0x55, 0xa5, 0x5e, 0x38, 0xd4, 0x5a, 0x3e, 0x03, 0xc2,
+	0x58, 0xe7, 0x30, 0x6a, 0xa4, 0x92, 0x01, 0xa3, 0x67, 0xd9, 0xac, 0x25, 0x5e, 0x37, 0xa4, 0x62,
+	0xf1, 0xea, 0x90, 0xa5, 0x78, 0xe8, 0xc9, 0x43, 0xad, 0xe5, 0x33, 0x8c, 0xab, 0x18, 0x9f, 0x29,
+	0x1e, 0xc3, 0xfc, 0xe0, 0x25, 0x16, 0xb9, 0x3c, 0x3c, 0x73, 0xc6, 0x65, 0xa6, 0xaa, 0x8d, 0x62,
+	0x41, 0xf5, 0x97, 0xb9, 0xfa, 0xe5, 0xd7, 0x94, 0x6b, 0xda, 0x52, 0x84, 0xc0, 0x47, 0xce, 0x56,
+	0xb8, 0x56, 0x6e, 0xee, 0x7e, 0xfc, 0xb4, 0xa2, 0x7c, 0xf2, 0xb4, 0xa2, 0xfc, 0xf3, 0x69, 0x45,
+	0xf9, 0xc9, 0xb3, 0xca, 0x99, 0x4f, 0x9e, 0x55, 0xce, 0xfc, 0xed, 0x59, 0xe5, 0xcc, 0x7b, 0x1b,
+	0x03, 0xd7, 0x44, 0x07, 0x81, 0x67, 0xb3, 0xcd, 0xae, 0x71, 0xe0, 0x8b, 0x69, 0x1e, 0x8b, 0x3f,
+	0xfc, 0xae, 0xe8, 0xa0, 0xc4, 0xff, 0x39, 0xe0, 0x95, 0xff, 0x0c, 0x00, 0xa4, 0xf3, 0x2d, 0xa0,
+	0x5a, 0x21, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// WebAuthNVerifyRegister verifies a WebAuthN registration
+	WebAuthNVerifyRegister(ctx context.Context, in *QueryWebAuthNVerifyRegisterRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyRegisterResponse, error)
+	// WebAuthNVerifyAuthenticate verifies a WebAuthN authentication
+	WebAuthNVerifyAuthenticate(ctx context.Context, in *QueryWebAuthNVerifyAuthenticateRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyAuthenticateResponse, error)
+	// AttestationTrustAnchors queries the trusted authenticator models used for
+	// attested WebAuthn registrations
+	AttestationTrustAnchors(ctx context.Context, in *QueryAttestationTrustAnchorsRequest, opts ...grpc.CallOption) (*QueryAttestationTrustAnchorsResponse, error)
+	// WebAuthNCredential queries the tracked state of a WebAuthn credential
+	WebAuthNCredential(ctx context.Context, in *QueryWebAuthNCredentialRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialResponse, error)
+	// WebAuthNCredentialsByOwner queries the tracked WebAuthn credentials of an
+	// account
+	WebAuthNCredentialsByOwner(ctx context.Context, in *QueryWebAuthNCredentialsByOwnerRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialsByOwnerResponse, error)
+	// RelyingPartyProfile queries the WebAuthn relying party profile of a
+	// contract
+	RelyingPartyProfile(ctx context.Context, in *QueryRelyingPartyProfileRequest, opts ...grpc.CallOption) (*QueryRelyingPartyProfileResponse, error)
+	// PlatformPercentage queries the platform percentage fee
+	PlatformPercentage(ctx context.Context, in *QueryPlatformPercentageRequest, opts ...grpc.CallOption) (*QueryPlatformPercentageResponse, error)
+	// PlatformMinimum queries the platform minimum fees
+	PlatformMinimum(ctx context.Context, in *QueryPlatformMinimumRequest, opts ...grpc.CallOption) (*QueryPlatformMinimumResponse, error)
+	// PlatformFeeSplit queries how collected platform fees are distributed
+	PlatformFeeSplit(ctx context.Context, in *QueryPlatformFeeSplitRequest, opts ...grpc.CallOption) (*QueryPlatformFeeSplitResponse, error)
+	// PlatformFeeEnforcement queries which value transfer paths are charged the
+	// platform fee
+	PlatformFeeEnforcement(ctx context.Context, in *QueryPlatformFeeEnforcementRequest, opts ...grpc.CallOption) (*QueryPlatformFeeEnforcementResponse, error)
+	// PaymentSchedule queries a payment schedule by id
+	PaymentSchedule(ctx context.Context, in *QueryPaymentScheduleRequest, opts ...grpc.CallOption) (*QueryPaymentScheduleResponse, error)
+	// PaymentSchedulesBySender queries the payment schedules paid by an account
+	PaymentSchedulesBySender(ctx context.Context, in *QueryPaymentSchedulesBySenderRequest, opts ...grpc.CallOption) (*QueryPaymentSchedulesBySenderResponse, error)
+	// PaymentSchedulesByRecipient queries the payment schedules paying an
+	// account
+	PaymentSchedulesByRecipient(ctx context.Context, in *QueryPaymentSchedulesByRecipientRequest, opts ...grpc.CallOption) (*QueryPaymentSchedulesByRecipientResponse, error)
+	// Escrow queries an escrow by id
+	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
+	// EscrowsBySender queries the escrows funded by an account
+	EscrowsBySender(ctx context.Context, in *QueryEscrowsBySenderRequest, opts ...grpc.CallOption) (*QueryEscrowsBySenderResponse, error)
+	// EscrowsByRecipient queries the escrows claimable by an account
+	EscrowsByRecipient(ctx context.Context, in *QueryEscrowsByRecipientRequest, opts ...grpc.CallOption) (*QueryEscrowsByRecipientResponse, error)
+	// EmissionsSchedule queries the emissions schedule driving x/mint
+	EmissionsSchedule(ctx context.Context, in *QueryEmissionsScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionsScheduleResponse, error)
+	// ProjectedSupply previews the mint denom supply at a future height under
+	// the emissions schedule, or the staking inflation curve while there is no
+	// schedule
+	ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error)
+	// MintTotals queries the total amounts minted and burned by the mint
+	// function
+	MintTotals(ctx context.Context, in *QueryMintTotalsRequest, opts ...grpc.CallOption) (*QueryMintTotalsResponse, error)
+	// MintEpochs queries the amounts minted and burned in recent epochs, most
+	// recent first
+	MintEpochs(ctx context.Context, in *QueryMintEpochsRequest, opts ...grpc.CallOption) (*QueryMintEpochsResponse, error)
+	// SimulateSend previews the platform fee, the net amounts received and the
+	// platform minimum check for a send or multi-send
+	SimulateSend(ctx context.Context, in *QuerySimulateSendRequest, opts ...grpc.CallOption) (*QuerySimulateSendResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) WebAuthNVerifyRegister(ctx context.Context, in *QueryWebAuthNVerifyRegisterRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyRegisterResponse, error) {
+	out := new(QueryWebAuthNVerifyRegisterResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNVerifyRegister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WebAuthNVerifyAuthenticate(ctx context.Context, in *QueryWebAuthNVerifyAuthenticateRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyAuthenticateResponse, error) {
+	out := new(QueryWebAuthNVerifyAuthenticateResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNVerifyAuthenticate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationTrustAnchors(ctx context.Context, in *QueryAttestationTrustAnchorsRequest, opts ...grpc.CallOption) (*QueryAttestationTrustAnchorsResponse, error) {
+	out := new(QueryAttestationTrustAnchorsResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/AttestationTrustAnchors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WebAuthNCredential(ctx context.Context, in *QueryWebAuthNCredentialRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialResponse, error) {
+	out := new(QueryWebAuthNCredentialResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WebAuthNCredentialsByOwner(ctx context.Context, in *QueryWebAuthNCredentialsByOwnerRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialsByOwnerResponse, error) {
+	out := new(QueryWebAuthNCredentialsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNCredentialsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelyingPartyProfile(ctx context.Context, in *QueryRelyingPartyProfileRequest, opts ...grpc.CallOption) (*QueryRelyingPartyProfileResponse, error) {
+	out := new(QueryRelyingPartyProfileResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/RelyingPartyProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlatformPercentage(ctx context.Context, in *QueryPlatformPercentageRequest, opts ...grpc.CallOption) (*QueryPlatformPercentageResponse, error) {
+	out := new(QueryPlatformPercentageResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/PlatformPercentage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlatformMinimum(ctx context.Context, in *QueryPlatformMinimumRequest, opts ...grpc.CallOption) (*QueryPlatformMinimumResponse, error) {
+	out := new(QueryPlatformMinimumResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/PlatformMinimum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlatformFeeSplit(ctx context.Context, in *QueryPlatformFeeSplitRequest, opts ...grpc.CallOption) (*QueryPlatformFeeSplitResponse, error) {
+	out := new(QueryPlatformFeeSplitResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/PlatformFeeSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlatformFeeEnforcement(ctx context.Context, in *QueryPlatformFeeEnforcementRequest, opts ...grpc.CallOption) (*QueryPlatformFeeEnforcementResponse, error) {
+	out := new(QueryPlatformFeeEnforcementResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/PlatformFeeEnforcement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentSchedule(ctx context.Context, in *QueryPaymentScheduleRequest, opts ...grpc.CallOption) (*QueryPaymentScheduleResponse, error) {
+	out := new(QueryPaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/PaymentSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentSchedulesBySender(ctx context.Context, in *QueryPaymentSchedulesBySenderRequest, opts ...grpc.CallOption) (*QueryPaymentSchedulesBySenderResponse, error) {
+	out := new(QueryPaymentSchedulesBySenderResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/PaymentSchedulesBySender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentSchedulesByRecipient(ctx context.Context, in *QueryPaymentSchedulesByRecipientRequest, opts ...grpc.CallOption) (*QueryPaymentSchedulesByRecipientResponse, error) {
+	out := new(QueryPaymentSchedulesByRecipientResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/PaymentSchedulesByRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
+	out := new(QueryEscrowResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/Escrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowsBySender(ctx context.Context, in *QueryEscrowsBySenderRequest, opts ...grpc.CallOption) (*QueryEscrowsBySenderResponse, error) {
+	out := new(QueryEscrowsBySenderResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/EscrowsBySender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowsByRecipient(ctx context.Context, in *QueryEscrowsByRecipientRequest, opts ...grpc.CallOption) (*QueryEscrowsByRecipientResponse, error) {
+	out := new(QueryEscrowsByRecipientResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/EscrowsByRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionsSchedule(ctx context.Context, in *QueryEmissionsScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionsScheduleResponse, error) {
+	out := new(QueryEmissionsScheduleResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/EmissionsSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error) {
+	out := new(QueryProjectedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/ProjectedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintTotals(ctx context.Context, in *QueryMintTotalsRequest, opts ...grpc.CallOption) (*QueryMintTotalsResponse, error) {
+	out := new(QueryMintTotalsResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/MintTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintEpochs(ctx context.Context, in *QueryMintEpochsRequest, opts ...grpc.CallOption) (*QueryMintEpochsResponse, error) {
+	out := new(QueryMintEpochsResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/MintEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateSend(ctx context.Context, in *QuerySimulateSendRequest, opts ...grpc.CallOption) (*QuerySimulateSendResponse, error) {
+	out := new(QuerySimulateSendResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/SimulateSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// WebAuthNVerifyRegister verifies a WebAuthN registration
+	WebAuthNVerifyRegister(context.Context, *QueryWebAuthNVerifyRegisterRequest) (*QueryWebAuthNVerifyRegisterResponse, error)
+	// WebAuthNVerifyAuthenticate verifies a WebAuthN authentication
+	WebAuthNVerifyAuthenticate(context.Context, *QueryWebAuthNVerifyAuthenticateRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error)
+	// AttestationTrustAnchors queries the trusted authenticator models used for
+	// attested WebAuthn registrations
+	AttestationTrustAnchors(context.Context, *QueryAttestationTrustAnchorsRequest) (*QueryAttestationTrustAnchorsResponse, error)
+	// WebAuthNCredential queries the tracked state of a WebAuthn credential
+	WebAuthNCredential(context.Context, *QueryWebAuthNCredentialRequest) (*QueryWebAuthNCredentialResponse, error)
+	// WebAuthNCredentialsByOwner queries the tracked WebAuthn credentials of an
+	// account
+	WebAuthNCredentialsByOwner(context.Context, *QueryWebAuthNCredentialsByOwnerRequest) (*QueryWebAuthNCredentialsByOwnerResponse, error)
+	// RelyingPartyProfile queries the WebAuthn relying party profile of a
+	// contract
+	RelyingPartyProfile(context.Context, *QueryRelyingPartyProfileRequest) (*QueryRelyingPartyProfileResponse, error)
+	// PlatformPercentage queries the platform percentage fee
+	PlatformPercentage(context.Context, *QueryPlatformPercentageRequest) (*QueryPlatformPercentageResponse, error)
+	// PlatformMinimum queries the platform minimum fees
+	PlatformMinimum(context.Context, *QueryPlatformMinimumRequest) (*QueryPlatformMinimumResponse, error)
+	// PlatformFeeSplit queries how collected platform fees are distributed
+	PlatformFeeSplit(context.Context, *QueryPlatformFeeSplitRequest) (*QueryPlatformFeeSplitResponse, error)
+	// PlatformFeeEnforcement queries which value transfer paths are charged the
+	// platform fee
+	PlatformFeeEnforcement(context.Context, *QueryPlatformFeeEnforcementRequest) (*QueryPlatformFeeEnforcementResponse, error)
+	// PaymentSchedule queries a payment schedule by id
+	PaymentSchedule(context.Context, *QueryPaymentScheduleRequest) (*QueryPaymentScheduleResponse, error)
+	// PaymentSchedulesBySender queries the payment schedules paid by an account
+	PaymentSchedulesBySender(context.Context, *QueryPaymentSchedulesBySenderRequest) (*QueryPaymentSchedulesBySenderResponse, error)
+	// PaymentSchedulesByRecipient queries the payment schedules paying an
+	// account
+	PaymentSchedulesByRecipient(context.Context, *QueryPaymentSchedulesByRecipientRequest) (*QueryPaymentSchedulesByRecipientResponse, error)
+	// Escrow queries an escrow by id
+	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
+	// EscrowsBySender queries the escrows funded by an account
+	EscrowsBySender(context.Context, *QueryEscrowsBySenderRequest) (*QueryEscrowsBySenderResponse, error)
+	// EscrowsByRecipient queries the escrows claimable by an account
+	EscrowsByRecipient(context.Context, *QueryEscrowsByRecipientRequest) (*QueryEscrowsByRecipientResponse, error)
+	// EmissionsSchedule queries the emissions schedule driving x/mint
+	EmissionsSchedule(context.Context, *QueryEmissionsScheduleRequest) (*QueryEmissionsScheduleResponse, error)
+	// ProjectedSupply previews the mint denom supply at a future height under
+	// the emissions schedule, or the staking inflation curve while there is no
+	// schedule
+	ProjectedSupply(context.Context, *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error)
+	// MintTotals queries the total amounts minted and burned by the mint
+	// function
+	MintTotals(context.Context, *QueryMintTotalsRequest) (*QueryMintTotalsResponse, error)
+	// MintEpochs queries the amounts minted and burned in recent epochs, most
+	// recent first
+	MintEpochs(context.Context, *QueryMintEpochsRequest) (*QueryMintEpochsResponse, error)
+	// SimulateSend previews the platform fee, the net amounts received and the
+	// platform minimum check for a send or multi-send
+	SimulateSend(context.Context, *QuerySimulateSendRequest) (*QuerySimulateSendResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) WebAuthNVerifyRegister(ctx context.Context, req *QueryWebAuthNVerifyRegisterRequest) (*QueryWebAuthNVerifyRegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNVerifyRegister not implemented")
+}
+func (*UnimplementedQueryServer) WebAuthNVerifyAuthenticate(ctx context.Context, req *QueryWebAuthNVerifyAuthenticateRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNVerifyAuthenticate not implemented")
+}
+func (*UnimplementedQueryServer) AttestationTrustAnchors(ctx context.Context, req *QueryAttestationTrustAnchorsRequest) (*QueryAttestationTrustAnchorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationTrustAnchors not implemented")
+}
+func (*UnimplementedQueryServer) WebAuthNCredential(ctx context.Context, req *QueryWebAuthNCredentialRequest) (*QueryWebAuthNCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNCredential not implemented")
+}
+func (*UnimplementedQueryServer) WebAuthNCredentialsByOwner(ctx context.Context, req *QueryWebAuthNCredentialsByOwnerRequest) (*QueryWebAuthNCredentialsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNCredentialsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) RelyingPartyProfile(ctx context.Context, req *QueryRelyingPartyProfileRequest) (*QueryRelyingPartyProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelyingPartyProfile not implemented")
+}
+func (*UnimplementedQueryServer) PlatformPercentage(ctx context.Context, req *QueryPlatformPercentageRequest) (*QueryPlatformPercentageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformPercentage not implemented")
+}
+func (*UnimplementedQueryServer) PlatformMinimum(ctx context.Context, req *QueryPlatformMinimumRequest) (*QueryPlatformMinimumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformMinimum not implemented")
+}
+func (*UnimplementedQueryServer) PlatformFeeSplit(ctx context.Context, req *QueryPlatformFeeSplitRequest) (*QueryPlatformFeeSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformFeeSplit not implemented")
+}
+func (*UnimplementedQueryServer) PlatformFeeEnforcement(ctx context.Context, req *QueryPlatformFeeEnforcementRequest) (*QueryPlatformFeeEnforcementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformFeeEnforcement not implemented")
+}
+func (*UnimplementedQueryServer) PaymentSchedule(ctx context.Context, req *QueryPaymentScheduleRequest) (*QueryPaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentSchedule not implemented")
+}
+func (*UnimplementedQueryServer) PaymentSchedulesBySender(ctx context.Context, req *QueryPaymentSchedulesBySenderRequest) (*QueryPaymentSchedulesBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentSchedulesBySender not implemented")
+}
+func (*UnimplementedQueryServer) PaymentSchedulesByRecipient(ctx context.Context, req *QueryPaymentSchedulesByRecipientRequest) (*QueryPaymentSchedulesByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentSchedulesByRecipient not implemented")
+}
+func (*UnimplementedQueryServer) Escrow(ctx context.Context, req *QueryEscrowRequest) (*QueryEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+}
+func (*UnimplementedQueryServer) EscrowsBySender(ctx context.Context, req *QueryEscrowsBySenderRequest) (*QueryEscrowsBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowsBySender not implemented")
+}
+func (*UnimplementedQueryServer) EscrowsByRecipient(ctx context.Context, req *QueryEscrowsByRecipientRequest) (*QueryEscrowsByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowsByRecipient not implemented")
+}
+func (*UnimplementedQueryServer) EmissionsSchedule(ctx context.Context, req *QueryEmissionsScheduleRequest) (*QueryEmissionsScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionsSchedule not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedSupply(ctx context.Context, req *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedSupply not implemented")
+}
+func (*UnimplementedQueryServer) MintTotals(ctx context.Context, req *QueryMintTotalsRequest) (*QueryMintTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintTotals not implemented")
+}
+func (*UnimplementedQueryServer) MintEpochs(ctx context.Context, req *QueryMintEpochsRequest) (*QueryMintEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintEpochs not implemented")
+}
+func (*UnimplementedQueryServer) SimulateSend(ctx context.Context, req *QuerySimulateSendRequest) (*QuerySimulateSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSend not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_WebAuthNVerifyRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNVerifyRegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNVerifyRegister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/WebAuthNVerifyRegister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNVerifyRegister(ctx, req.(*QueryWebAuthNVerifyRegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WebAuthNVerifyAuthenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNVerifyAuthenticateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNVerifyAuthenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/WebAuthNVerifyAuthenticate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNVerifyAuthenticate(ctx, req.(*QueryWebAuthNVerifyAuthenticateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationTrustAnchors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationTrustAnchorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationTrustAnchors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/AttestationTrustAnchors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationTrustAnchors(ctx, req.(*QueryAttestationTrustAnchorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WebAuthNCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/WebAuthNCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNCredential(ctx, req.(*QueryWebAuthNCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WebAuthNCredentialsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNCredentialsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNCredentialsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/WebAuthNCredentialsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNCredentialsByOwner(ctx, req.(*QueryWebAuthNCredentialsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelyingPartyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelyingPartyProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelyingPartyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/RelyingPartyProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelyingPartyProfile(ctx, req.(*QueryRelyingPartyProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlatformPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlatformPercentageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlatformPercentage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/PlatformPercentage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlatformPercentage(ctx, req.(*QueryPlatformPercentageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlatformMinimum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlatformMinimumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlatformMinimum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/PlatformMinimum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlatformMinimum(ctx, req.(*QueryPlatformMinimumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlatformFeeSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlatformFeeSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlatformFeeSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/PlatformFeeSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlatformFeeSplit(ctx, req.(*QueryPlatformFeeSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlatformFeeEnforcement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlatformFeeEnforcementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlatformFeeEnforcement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/PlatformFeeEnforcement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlatformFeeEnforcement(ctx, req.(*QueryPlatformFeeEnforcementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/PaymentSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentSchedule(ctx, req.(*QueryPaymentScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentSchedulesBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentSchedulesBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentSchedulesBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/PaymentSchedulesBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentSchedulesBySender(ctx, req.(*QueryPaymentSchedulesBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentSchedulesByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentSchedulesByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentSchedulesByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/PaymentSchedulesByRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentSchedulesByRecipient(ctx, req.(*QueryPaymentSchedulesByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/Escrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/EscrowsBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowsBySender(ctx, req.(*QueryEscrowsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/EscrowsByRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowsByRecipient(ctx, req.(*QueryEscrowsByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionsSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionsScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionsSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/EmissionsSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionsSchedule(ctx, req.(*QueryEmissionsScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/ProjectedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedSupply(ctx, req.(*QueryProjectedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/MintTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintTotals(ctx, req.(*QueryMintTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/MintEpochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintEpochs(ctx, req.(*QueryMintEpochsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/SimulateSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSend(ctx, req.(*QuerySimulateSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xion.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WebAuthNVerifyRegister",
+			Handler:    _Query_WebAuthNVerifyRegister_Handler,
+		},
+		{
+			MethodName: "WebAuthNVerifyAuthenticate",
+			Handler:    _Query_WebAuthNVerifyAuthenticate_Handler,
+		},
+		{
+			MethodName: "AttestationTrustAnchors",
+			Handler:    _Query_AttestationTrustAnchors_Handler,
+		},
+		{
+			MethodName: "WebAuthNCredential",
+			Handler:    _Query_WebAuthNCredential_Handler,
+		},
+		{
+			MethodName: "WebAuthNCredentialsByOwner",
+			Handler:    _Query_WebAuthNCredentialsByOwner_Handler,
+		},
+		{
+			MethodName: "RelyingPartyProfile",
+			Handler:    _Query_RelyingPartyProfile_Handler,
+		},
+		{
+			MethodName: "PlatformPercentage",
+			Handler:    _Query_PlatformPercentage_Handler,
+		},
+		{
+			MethodName: "PlatformMinimum",
+			Handler:    _Query_PlatformMinimum_Handler,
+		},
+		{
+			MethodName: "PlatformFeeSplit",
+			Handler:    _Query_PlatformFeeSplit_Handler,
+		},
+		{
+			MethodName: "PlatformFeeEnforcement",
+			Handler:    _Query_PlatformFeeEnforcement_Handler,
+		},
+		{
+			MethodName: "PaymentSchedule",
+			Handler:    _Query_PaymentSchedule_Handler,
+		},
+		{
+			MethodName: "PaymentSchedulesBySender",
+			Handler:    _Query_PaymentSchedulesBySender_Handler,
+		},
+		{
+			MethodName: "PaymentSchedulesByRecipient",
+			Handler:    _Query_PaymentSchedulesByRecipient_Handler,
+		},
+		{
+			MethodName: "Escrow",
+			Handler:    _Query_Escrow_Handler,
+		},
+		{
+			MethodName: "EscrowsBySender",
+			Handler:    _Query_EscrowsBySender_Handler,
+		},
+		{
+			MethodName: "EscrowsByRecipient",
+			Handler:    _Query_EscrowsByRecipient_Handler,
+		},
+		{
+			MethodName: "EmissionsSchedule",
+			Handler:    _Query_EmissionsSchedule_Handler,
+		},
+		{
+			MethodName: "ProjectedSupply",
+			Handler:    _Query_ProjectedSupply_Handler,
+		},
+		{
+			MethodName: "MintTotals",
+			Handler:    _Query_MintTotals_Handler,
+		},
+		{
+			MethodName: "MintEpochs",
+			Handler:    _Query_MintEpochs_Handler,
+		},
+		{
+			MethodName: "SimulateSend",
+			Handler:    _Query_SimulateSend_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "xion/v1/query.proto",
+}
+
+func (m *QueryWebAuthNVerifyRegisterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNVerifyRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNVerifyRegisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RpProfile) > 0 {
+		i -= len(m.RpProfile)
+		copy(dAtA[i:], m.RpProfile)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RpProfile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RequireAttestation {
+		i--
+		if m.RequireAttestation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Rp) > 0 {
+		i -= len(m.Rp)
+		copy(dAtA[i:], m.Rp)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNVerifyRegisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNVerifyRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNVerifyRegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aaguid) > 0 {
+		i -= len(m.Aaguid)
+		copy(dAtA[i:], m.Aaguid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Aaguid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Credential) > 0 {
+		i -= len(m.Credential)
+		copy(dAtA[i:], m.Credential)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNCredentialsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNCredentialsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNCredentialsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNCredentialsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNCredentialsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNCredentialsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.States) > 0 {
+		for iNdEx := len(m.States) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.States[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelyingPartyProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelyingPartyProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelyingPartyProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelyingPartyProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelyingPartyProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelyingPartyProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionsScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionsScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionsScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionsScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionsScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionsScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProjectedSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Issuance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CurrentSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CurrentHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Totals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintEpochsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintEpochsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintEpochsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationTrustAnchorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationTrustAnchorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationTrustAnchorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationTrustAnchorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationTrustAnchorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationTrustAnchorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Anchors) > 0 {
+		for iNdEx := len(m.Anchors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Anchors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RpProfile) > 0 {
+		i -= len(m.RpProfile)
+		copy(dAtA[i:], m.RpProfile)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RpProfile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Credential) > 0 {
+		i -= len(m.Credential)
+		copy(dAtA[i:], m.Credential)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Rp) > 0 {
+		i -= len(m.Rp)
+		copy(dAtA[i:], m.Rp)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlatformPercentageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlatformPercentageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlatformPercentageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlatformPercentageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlatformPercentageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlatformPercentageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlatformPercentage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlatformPercentage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlatformMinimumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlatformMinimumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlatformMinimumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlatformMinimumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlatformMinimumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlatformMinimumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minimums) > 0 {
+		for iNdEx := len(m.Minimums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minimums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlatformFeeSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlatformFeeSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlatformFeeSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlatformFeeSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlatformFeeSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlatformFeeSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Split.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlatformFeeEnforcementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlatformFeeEnforcementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlatformFeeEnforcementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlatformFeeEnforcementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlatformFeeEnforcementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlatformFeeEnforcementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Enforcement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAmount) > 0 {
+		for iNdEx := len(m.NetAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PlatformFee) > 0 {
+		for iNdEx := len(m.PlatformFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlatformFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RequiredMinimum != nil {
+		{
+			size, err := m.RequiredMinimum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FailedDenom) > 0 {
+		i -= len(m.FailedDenom)
+		copy(dAtA[i:], m.FailedDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FailedDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinimumsMet {
+		i--
+		if m.MinimumsMet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TotalPlatformFee) > 0 {
+		for iNdEx := len(m.TotalPlatformFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalPlatformFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalAmount) > 0 {
+		for iNdEx := len(m.TotalAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PlatformPercentage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlatformPercentage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentSchedulesBySenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentSchedulesBySenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentSchedulesBySenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentSchedulesBySenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentSchedulesBySenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentSchedulesBySenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentSchedulesByRecipientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentSchedulesByRecipientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentSchedulesByRecipientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentSchedulesByRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentSchedulesByRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentSchedulesByRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowsBySenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowsBySenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowsBySenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowsBySenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowsBySenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowsBySenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowsByRecipientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowsByRecipientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowsByRecipientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowsByRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowsByRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowsByRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryWebAuthNVerifyRegisterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Rp)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RequireAttestation {
+		n += 2
+	}
+	l = len(m.RpProfile)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNVerifyRegisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Credential)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Aaguid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWebAuthNCredentialsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNCredentialsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for _, e := range m.States {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelyingPartyProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelyingPartyProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Profile.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionsScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEmissionsScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryProjectedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentHeight))
+	}
+	l = m.CurrentSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Issuance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMintTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Totals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationTrustAnchorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAttestationTrustAnchorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Anchors) > 0 {
+		for _, e := range m.Anchors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Rp)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Credential)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RpProfile)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPlatformPercentageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPlatformPercentageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlatformPercentage != 0 {
+		n += 1 + sovQuery(uint64(m.PlatformPercentage))
+	}
+	return n
+}
+
+func (m *QueryPlatformMinimumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPlatformMinimumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minimums) > 0 {
+		for _, e := range m.Minimums {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPlatformFeeSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPlatformFeeSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Split.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPlatformFeeEnforcementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPlatformFeeEnforcementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Enforcement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateSendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulatedOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PlatformFee) > 0 {
+		for _, e := range m.PlatformFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NetAmount) > 0 {
+		for _, e := range m.NetAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlatformPercentage != 0 {
+		n += 1 + sovQuery(uint64(m.PlatformPercentage))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalAmount) > 0 {
+		for _, e := range m.TotalAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalPlatformFee) > 0 {
+		for _, e := range m.TotalPlatformFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MinimumsMet {
+		n += 2
+	}
+	l = len(m.FailedDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RequiredMinimum != nil {
+		l = m.RequiredMinimum.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPaymentScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentSchedulesBySenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentSchedulesBySenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentSchedulesByRecipientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentSchedulesByRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Escrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEscrowsBySenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowsBySenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowsByRecipientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowsByRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireAttestation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireAttestation = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpProfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpProfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNVerifyRegisterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credential = append(m.Credential[:0], dAtA[iNdEx:postIndex]...)
+			if m.Credential == nil {
+				m.Credential = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aaguid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aaguid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNCredentialRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialId = append(m.CredentialId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialId == nil {
+				m.CredentialId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNCredentialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNCredentialsByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialsByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNCredentialsByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialsByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.States = append(m.States, WebAuthNCredentialState{})
+			if err := m.States[len(m.States)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelyingPartyProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelyingPartyProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelyingPartyProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelyingPartyProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelyingPartyProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelyingPartyProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionsScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionsScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionsScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionsScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionsScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionsScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Issuance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, MintEpoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationTrustAnchorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationTrustAnchorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationTrustAnchorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationTrustAnchorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationTrustAnchorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationTrustAnchorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anchors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Anchors = append(m.Anchors, AttestationTrustAnchor{})
+			if err := m.Anchors[len(m.Anchors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credential = append(m.Credential[:0], dAtA[iNdEx:postIndex]...)
+			if m.Credential == nil {
+				m.Credential = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpProfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpProfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNVerifyAuthenticateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlatformPercentageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlatformPercentageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlatformPercentageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlatformPercentageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlatformPercentageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlatformPercentageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformPercentage", wireType)
+			}
+			m.PlatformPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlatformPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlatformMinimumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlatformMinimumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlatformMinimumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlatformMinimumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlatformMinimumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlatformMinimumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minimums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minimums = append(m.Minimums, types.Coin{})
+			if err := m.Minimums[len(m.Minimums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlatformFeeSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlatformFeeSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlatformFeeSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlatformFeeSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlatformFeeSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlatformFeeSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Split.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlatformFeeEnforcementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlatformFeeEnforcementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlatformFeeEnforcementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlatformFeeEnforcementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlatformFeeEnforcementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlatformFeeEnforcementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enforcement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Enforcement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateSendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateSendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateSendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, types1.Output{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulatedOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulatedOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulatedOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlatformFee = append(m.PlatformFee, types.Coin{})
+			if err := m.PlatformFee[len(m.PlatformFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAmount = append(m.NetAmount, types.Coin{})
+			if err := m.NetAmount[len(m.NetAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformPercentage", wireType)
+			}
+			m.PlatformPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlatformPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, SimulatedOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAmount = append(m.TotalAmount, types.Coin{})
+			if err := m.TotalAmount[len(m.TotalAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPlatformFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPlatformFee = append(m.TotalPlatformFee, types.Coin{})
+			if err := m.TotalPlatformFee[len(m.TotalPlatformFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumsMet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinimumsMet = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredMinimum == nil {
+				m.RequiredMinimum = &types.Coin{}
+			}
+			if err := m.RequiredMinimum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentSchedulesBySenderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentSchedulesBySenderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentSchedulesBySenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentSchedulesBySenderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentSchedulesBySenderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentSchedulesBySenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, PaymentSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentSchedulesByRecipientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1128,15 +9363,15 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentSchedulesByRecipientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentSchedulesByRecipientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1164,13 +9399,13 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1180,29 +9415,83 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenge = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentSchedulesByRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentSchedulesByRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentSchedulesByRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1212,29 +9501,31 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rp = string(dAtA[iNdEx:postIndex])
+			m.Schedules = append(m.Schedules, PaymentSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1244,24 +9535,26 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1285,7 +9578,7 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWebAuthNVerifyRegisterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1308,17 +9601,17 @@ func (m *QueryWebAuthNVerifyRegisterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1328,26 +9621,11 @@ func (m *QueryWebAuthNVerifyRegisterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Credential = append(m.Credential[:0], dAtA[iNdEx:postIndex]...)
-			if m.Credential == nil {
-				m.Credential = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1369,7 +9647,7 @@ func (m *QueryWebAuthNVerifyRegisterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1392,17 +9670,17 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1412,93 +9690,80 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenge = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowsBySenderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rp = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowsBySenderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowsBySenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1508,31 +9773,29 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credential = append(m.Credential[:0], dAtA[iNdEx:postIndex]...)
-			if m.Credential == nil {
-				m.Credential = []byte{}
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1542,24 +9805,26 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1583,7 +9848,7 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWebAuthNVerifyAuthenticateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowsBySenderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1606,62 +9871,82 @@ func (m *QueryWebAuthNVerifyAuthenticateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowsBySenderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowsBySenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPlatformPercentageRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Escrows = append(m.Escrows, Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlatformPercentageRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlatformPercentageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1683,7 +9968,7 @@ func (m *QueryPlatformPercentageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlatformPercentageResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowsByRecipientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1706,17 +9991,17 @@ func (m *QueryPlatformPercentageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlatformPercentageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowsByRecipientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlatformPercentageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowsByRecipientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlatformPercentage", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			m.PlatformPercentage = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1726,61 +10011,60 @@ func (m *QueryPlatformPercentageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PlatformPercentage |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPlatformMinimumRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlatformMinimumRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlatformMinimumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1802,7 +10086,7 @@ func (m *QueryPlatformMinimumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlatformMinimumResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowsByRecipientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1825,15 +10109,15 @@ func (m *QueryPlatformMinimumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlatformMinimumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowsByRecipientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlatformMinimumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowsByRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minimums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1860,8 +10144,44 @@ func (m *QueryPlatformMinimumResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minimums = append(m.Minimums, types.Coin{})
-			if err := m.Minimums[len(m.Minimums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Escrows = append(m.Escrows, Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
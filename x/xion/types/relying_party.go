@@ -0,0 +1,115 @@
+package types
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxRelyingPartyOrigins bounds the origins and the top origins of a relying
+// party profile.
+const MaxRelyingPartyOrigins = 32
+
+// topOriginModes maps profile policies to go-webauthn verification modes.
+var topOriginModes = map[TopOriginPolicy]protocol.TopOriginVerificationMode{
+	TopOriginPolicy_TOP_ORIGIN_POLICY_IGNORE:   protocol.TopOriginIgnoreVerificationMode,
+	TopOriginPolicy_TOP_ORIGIN_POLICY_EXPLICIT: protocol.TopOriginExplicitVerificationMode,
+	TopOriginPolicy_TOP_ORIGIN_POLICY_IMPLICIT: protocol.TopOriginImplicitVerificationMode,
+	TopOriginPolicy_TOP_ORIGIN_POLICY_AUTO:     protocol.TopOriginAutoVerificationMode,
+}
+
+// RelyingPartyProfileKey returns the store key of a contract's relying party
+// profile.
+func RelyingPartyProfileKey(contract []byte) []byte {
+	return contract
+}
+
+// RelyingPartyProfileFromURL returns the profile equivalent of a single
+// relying party URL: the host is the relying party id and the URL is the only
+// origin, with top origins ignored.
+func RelyingPartyProfileFromURL(rp *url.URL) RelyingPartyProfile {
+	return RelyingPartyProfile{
+		RpId:       rp.Host,
+		Origins:    []string{rp.String()},
+		TopOrigins: []string{rp.String()},
+	}
+}
+
+// Validate checks the profile's contract, relying party id and origins, and
+// under the same site policy that every origin is on the relying party id.
+func (p RelyingPartyProfile) Validate() error {
+	if _, _, err := bech32.Decode(p.Contract); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", err)
+	}
+
+	if p.RpId == "" || strings.ToLower(p.RpId) != p.RpId {
+		return errorsmod.Wrapf(ErrInvalidRelyingPartyProfile, "relying party id %q must be a lower case domain", p.RpId)
+	}
+	if u, err := url.Parse("https://" + p.RpId); err != nil || u.Host != p.RpId || u.Port() != "" {
+		return errorsmod.Wrapf(ErrInvalidRelyingPartyProfile, "relying party id %q must be a bare domain", p.RpId)
+	}
+
+	if _, ok := topOriginModes[p.TopOriginPolicy]; !ok {
+		return errorsmod.Wrapf(ErrInvalidRelyingPartyProfile, "unknown top origin policy %d", p.TopOriginPolicy)
+	}
+	if _, ok := RelatedOriginPolicy_name[int32(p.RelatedOriginPolicy)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidRelyingPartyProfile, "unknown related origin policy %d", p.RelatedOriginPolicy)
+	}
+
+	if len(p.Origins) == 0 {
+		return errorsmod.Wrap(ErrInvalidRelyingPartyProfile, "no origins")
+	}
+	if err := validateOrigins(p.Origins); err != nil {
+		return err
+	}
+	if p.RelatedOriginPolicy == RelatedOriginPolicy_RELATED_ORIGIN_POLICY_SAME_SITE {
+		for _, origin := range p.Origins {
+			u, _ := url.Parse(origin)
+			host := u.Hostname()
+			if host != p.RpId && !strings.HasSuffix(host, "."+p.RpId) {
+				return errorsmod.Wrapf(ErrInvalidRelyingPartyProfile, "origin %s is not on relying party id %s", origin, p.RpId)
+			}
+		}
+	}
+
+	if p.TopOriginPolicy == TopOriginPolicy_TOP_ORIGIN_POLICY_EXPLICIT && len(p.TopOrigins) == 0 {
+		return errorsmod.Wrap(ErrInvalidRelyingPartyProfile, "explicit top origin policy without top origins")
+	}
+	return validateOrigins(p.TopOrigins)
+}
+
+// WebAuthnConfig returns the go-webauthn configuration of the profile.
+func (p RelyingPartyProfile) WebAuthnConfig() *webauthn.Config {
+	return &webauthn.Config{
+		RPID:                        p.RpId,
+		RPDisplayName:               p.RpId,
+		RPOrigins:                   p.Origins,
+		RPTopOrigins:                p.TopOrigins,
+		RPTopOriginVerificationMode: topOriginModes[p.TopOriginPolicy],
+	}
+}
+
+func validateOrigins(origins []string) error {
+	if len(origins) > MaxRelyingPartyOrigins {
+		return errorsmod.Wrapf(ErrInvalidRelyingPartyProfile, "%d origins, maximum is %d", len(origins), MaxRelyingPartyOrigins)
+	}
+	seen := make(map[string]struct{}, len(origins))
+	for _, origin := range origins {
+		u, err := url.Parse(origin)
+		if err != nil || u.Scheme == "" || u.Host == "" || u.Path != "" || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+			return errorsmod.Wrapf(ErrInvalidRelyingPartyProfile, "origin %q must be a scheme and host", origin)
+		}
+		if _, ok := seen[origin]; ok {
+			return errorsmod.Wrapf(ErrInvalidRelyingPartyProfile, "duplicate origin %s", origin)
+		}
+		seen[origin] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestRelyingPartyProfileValidate(t *testing.T) {
+	valid := types.RelyingPartyProfile{
+		Contract: sdk.AccAddress("rp_contract_address_").String(),
+		RpId:     "example.com",
+		Origins:  []string{"https://example.com", "https://app.example.com"},
+	}
+	require.NoError(t, valid.Validate())
+
+	for name, mutate := range map[string]func(p *types.RelyingPartyProfile){
+		"bad contract":       func(p *types.RelyingPartyProfile) { p.Contract = "not-an-address" },
+		"empty rp id":        func(p *types.RelyingPartyProfile) { p.RpId = "" },
+		"upper case rp id":   func(p *types.RelyingPartyProfile) { p.RpId = "Example.com" },
+		"rp id with port":    func(p *types.RelyingPartyProfile) { p.RpId = "example.com:443" },
+		"rp id with path":    func(p *types.RelyingPartyProfile) { p.RpId = "example.com/login" },
+		"no origins":         func(p *types.RelyingPartyProfile) { p.Origins = nil },
+		"origin with path":   func(p *types.RelyingPartyProfile) { p.Origins = []string{"https://example.com/login"} },
+		"duplicate origin":   func(p *types.RelyingPartyProfile) { p.Origins = []string{"https://example.com", "https://example.com"} },
+		"other site origin":  func(p *types.RelyingPartyProfile) { p.Origins = append(p.Origins, "https://example.org") },
+		"suffix site origin": func(p *types.RelyingPartyProfile) { p.Origins = []string{"https://badexample.com"} },
+		"unknown top policy": func(p *types.RelyingPartyProfile) { p.TopOriginPolicy = 42 },
+		"unknown rel policy": func(p *types.RelyingPartyProfile) { p.RelatedOriginPolicy = 42 },
+		"explicit without": func(p *types.RelyingPartyProfile) {
+			p.TopOriginPolicy = types.TopOriginPolicy_TOP_ORIGIN_POLICY_EXPLICIT
+		},
+		"bad top origin":      func(p *types.RelyingPartyProfile) { p.TopOrigins = []string{"example.com"} },
+		"too many top origin": func(p *types.RelyingPartyProfile) { p.TopOrigins = make([]string, types.MaxRelyingPartyOrigins+1) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			profile := valid
+			profile.Origins = append([]string(nil), valid.Origins...)
+			mutate(&profile)
+			require.Error(t, profile.Validate())
+		})
+	}
+
+	// Related origins may live on other sites
+	related := valid
+	related.Origins = append([]string{"https://example.org"}, valid.Origins...)
+	related.RelatedOriginPolicy = types.RelatedOriginPolicy_RELATED_ORIGIN_POLICY_RELATED_ORIGINS
+	require.NoError(t, related.Validate())
+}
+
+func TestRelyingPartyProfileWebAuthnConfig(t *testing.T) {
+	rp, err := url.Parse("https://example.com")
+	require.NoError(t, err)
+
+	config := types.RelyingPartyProfileFromURL(rp).WebAuthnConfig()
+	require.Equal(t, "example.com", config.RPID)
+	require.Equal(t, []string{"https://example.com"}, config.RPOrigins)
+	require.Equal(t, protocol.TopOriginIgnoreVerificationMode, config.RPTopOriginVerificationMode)
+
+	profile := types.RelyingPartyProfile{
+		RpId:            "example.com",
+		Origins:         []string{"https://example.com", "https://app.example.com"},
+		TopOrigins:      []string{"https://portal.example.com"},
+		TopOriginPolicy: types.TopOriginPolicy_TOP_ORIGIN_POLICY_EXPLICIT,
+	}
+	config = profile.WebAuthnConfig()
+	require.Equal(t, profile.Origins, config.RPOrigins)
+	require.Equal(t, profile.TopOrigins, config.RPTopOrigins)
+	require.Equal(t, protocol.TopOriginExplicitVerificationMode, config.RPTopOriginVerificationMode)
+}
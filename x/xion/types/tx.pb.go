@@ -15,18 +15,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -393,676 +398,6939 @@ func (m *MsgSetPlatformMinimumResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetPlatformMinimumResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSend)(nil), "xion.v1.MsgSend")
-	proto.RegisterType((*MsgSendResponse)(nil), "xion.v1.MsgSendResponse")
-	proto.RegisterType((*MsgMultiSend)(nil), "xion.v1.MsgMultiSend")
-	proto.RegisterType((*MsgMultiSendResponse)(nil), "xion.v1.MsgMultiSendResponse")
-	proto.RegisterType((*MsgSetPlatformPercentage)(nil), "xion.v1.MsgSetPlatformPercentage")
-	proto.RegisterType((*MsgSetPlatformPercentageResponse)(nil), "xion.v1.MsgSetPlatformPercentageResponse")
-	proto.RegisterType((*MsgSetPlatformMinimum)(nil), "xion.v1.MsgSetPlatformMinimum")
-	proto.RegisterType((*MsgSetPlatformMinimumResponse)(nil), "xion.v1.MsgSetPlatformMinimumResponse")
+// MsgSetPlatformFeeSplit defines the message for setting the platform fee
+// distribution split
+type MsgSetPlatformFeeSplit struct {
+	// The authority address that can set the platform fee split
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The distribution split applied to collected platform fees
+	Split PlatformFeeSplit `protobuf:"bytes,2,opt,name=split,proto3" json:"split"`
 }
 
-func init() { proto.RegisterFile("xion/v1/tx.proto", fileDescriptor_5076275aa290c9b8) }
-
-var fileDescriptor_5076275aa290c9b8 = []byte{
-	// 660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x53, 0x68, 0xc9, 0xb5, 0x88, 0xd6, 0xb4, 0x90, 0x1a, 0xc5, 0x09, 0x96, 0x80, 0x10,
-	0x29, 0x36, 0x09, 0x02, 0xa4, 0x20, 0x24, 0x12, 0x26, 0x86, 0x88, 0x2a, 0xdd, 0x10, 0x52, 0x64,
-	0x27, 0xae, 0x63, 0x25, 0xbe, 0xb3, 0x7c, 0xe7, 0x28, 0xdd, 0x10, 0x13, 0x62, 0x62, 0x61, 0xef,
-	0x88, 0x98, 0x3a, 0x00, 0xbf, 0x21, 0x63, 0xc5, 0xc4, 0x04, 0x28, 0x19, 0xca, 0xc0, 0x8f, 0x40,
-	0x77, 0x3e, 0x3b, 0x09, 0x71, 0x89, 0x84, 0xc4, 0x62, 0xfb, 0xde, 0xf7, 0xbe, 0xef, 0xde, 0xf7,
-	0xde, 0x93, 0xc1, 0xe6, 0xd0, 0x41, 0x50, 0x1f, 0x94, 0x75, 0x32, 0xd4, 0x3c, 0x1f, 0x11, 0x24,
-	0xad, 0xd1, 0x88, 0x36, 0x28, 0xcb, 0xdb, 0x36, 0xb2, 0x11, 0x8b, 0xe9, 0xf4, 0x2b, 0x84, 0xe5,
-	0xab, 0x6d, 0x84, 0x5d, 0x84, 0x75, 0x17, 0xdb, 0x94, 0xe6, 0x62, 0x9b, 0x03, 0x5b, 0x86, 0xeb,
-	0x40, 0xa4, 0xb3, 0x27, 0x0f, 0xed, 0x86, 0xb9, 0xad, 0x50, 0x24, 0x3c, 0x70, 0x48, 0xe1, 0x32,
-	0xa6, 0x81, 0x2d, 0x7d, 0x50, 0x36, 0x2d, 0x62, 0x94, 0xf5, 0x36, 0x72, 0xe0, 0x02, 0x0e, 0x7b,
-	0x31, 0x4e, 0x0f, 0x21, 0xae, 0xbe, 0x4b, 0x81, 0xb5, 0x06, 0xb6, 0xf7, 0x2d, 0xd8, 0x91, 0x1e,
-	0x82, 0x8d, 0x03, 0x1f, 0xb9, 0x2d, 0xa3, 0xd3, 0xf1, 0x2d, 0x8c, 0x33, 0x62, 0x5e, 0x2c, 0xa4,
-	0xeb, 0x99, 0x2f, 0x1f, 0x4b, 0xdb, 0xfc, 0xce, 0x5a, 0x88, 0xec, 0x13, 0xdf, 0x81, 0x76, 0x73,
-	0x9d, 0x66, 0xf3, 0x90, 0xf4, 0x00, 0x00, 0x82, 0x62, 0x6a, 0x6a, 0x09, 0x35, 0x4d, 0x50, 0x44,
-	0xec, 0x82, 0x55, 0xc3, 0x45, 0x01, 0x24, 0x99, 0x95, 0xfc, 0x4a, 0x61, 0xbd, 0xb2, 0xab, 0x71,
-	0x06, 0xb5, 0xa4, 0xf1, 0x92, 0xb5, 0x27, 0xc8, 0x81, 0xf5, 0x7b, 0xa3, 0x6f, 0x39, 0xe1, 0xc3,
-	0xf7, 0x5c, 0xc1, 0x76, 0x48, 0x37, 0x30, 0xb5, 0x36, 0x72, 0x79, 0x37, 0xf8, 0xab, 0x84, 0x3b,
-	0x3d, 0x9d, 0x1c, 0x7a, 0x16, 0x66, 0x04, 0xfc, 0xfe, 0xf4, 0xb8, 0x28, 0x36, 0xb9, 0x7e, 0xb5,
-	0xf8, 0xfa, 0x28, 0x27, 0xfc, 0x3c, 0xca, 0x09, 0xaf, 0x4e, 0x8f, 0x8b, 0x73, 0x56, 0xdf, 0xd0,
-	0x00, 0x9b, 0x20, 0xef, 0x85, 0xba, 0x05, 0x2e, 0xf1, 0xcf, 0xa6, 0x85, 0x3d, 0x04, 0xb1, 0xa5,
-	0x7e, 0x16, 0xc1, 0x46, 0x03, 0xdb, 0x8d, 0xa0, 0x4f, 0x1c, 0xd6, 0xaf, 0x47, 0x60, 0xd5, 0x81,
-	0x5e, 0x40, 0x68, 0xa7, 0x68, 0xe5, 0xf2, 0xb4, 0x72, 0xd8, 0x8b, 0x2b, 0x7f, 0x4a, 0x53, 0xea,
-	0x69, 0x5a, 0x3a, 0x2f, 0x27, 0x24, 0x49, 0x8f, 0xc1, 0x1a, 0x0a, 0x08, 0xe3, 0xa7, 0x18, 0xff,
-	0x5a, 0x22, 0xff, 0x19, 0xcb, 0x99, 0x15, 0x88, 0x68, 0xd5, 0x1b, 0x91, 0x19, 0x2e, 0x49, 0x6d,
-	0x6c, 0x45, 0x36, 0xe2, 0x3a, 0xd5, 0x2b, 0x60, 0x7b, 0xf6, 0x1c, 0x1b, 0xfa, 0x24, 0x82, 0x0c,
-	0x33, 0x49, 0xf6, 0xfa, 0x06, 0x39, 0x40, 0xbe, 0xbb, 0x67, 0xf9, 0x6d, 0x0b, 0x12, 0xc3, 0xb6,
-	0xa4, 0xfb, 0x20, 0x6d, 0x04, 0xa4, 0x8b, 0x7c, 0x87, 0x1c, 0x2e, 0xdd, 0x84, 0x69, 0xaa, 0xa4,
-	0x83, 0xcb, 0x1e, 0x57, 0x6b, 0x79, 0xb1, 0x1c, 0x5b, 0x88, 0x8b, 0x4d, 0xc9, 0x5b, 0xb8, 0xa8,
-	0x7a, 0x87, 0x1a, 0x98, 0x0a, 0x50, 0x0f, 0xd9, 0xe9, 0x28, 0x12, 0x4a, 0x53, 0x55, 0x90, 0x3f,
-	0x0b, 0x8b, 0xbd, 0xfd, 0x12, 0xc1, 0xce, 0x7c, 0x52, 0xc3, 0x81, 0x8e, 0x1b, 0xb8, 0xff, 0x6c,
-	0xac, 0x0f, 0x2e, 0xb8, 0xa1, 0x04, 0xfe, 0x6f, 0x9b, 0x1a, 0xdf, 0x50, 0x2d, 0x2d, 0x76, 0x45,
-	0x4e, 0xe8, 0x0a, 0x37, 0xa5, 0xe6, 0x40, 0x36, 0x11, 0x88, 0xfa, 0x51, 0x19, 0xa5, 0xc0, 0x4a,
-	0x03, 0xdb, 0x52, 0x05, 0x9c, 0x63, 0xbb, 0xbb, 0xa9, 0xf1, 0xdf, 0x93, 0xc6, 0xd7, 0x5c, 0xce,
-	0xfc, 0x19, 0x89, 0xb8, 0x52, 0x0d, 0xa4, 0xa7, 0x4b, 0xbf, 0x33, 0x9b, 0x16, 0x87, 0xe5, 0x6c,
-	0x62, 0x38, 0x96, 0xb0, 0xc0, 0x4e, 0xf2, 0x9a, 0x5d, 0x9f, 0xbf, 0x35, 0x21, 0x45, 0xbe, 0xbd,
-	0x34, 0x25, 0xbe, 0xe6, 0x05, 0x90, 0x12, 0x26, 0xae, 0x9c, 0x21, 0xc0, 0x71, 0xf9, 0xe6, 0xdf,
-	0xf1, 0x48, 0x5d, 0x3e, 0xff, 0x92, 0x0e, 0xa9, 0x5e, 0x1b, 0x8d, 0x15, 0xf1, 0x64, 0xac, 0x88,
-	0x3f, 0xc6, 0x8a, 0xf8, 0x76, 0xa2, 0x08, 0x27, 0x13, 0x45, 0xf8, 0x3a, 0x51, 0x84, 0xe7, 0xb7,
-	0x66, 0xa6, 0x6d, 0x06, 0x3e, 0x24, 0xa5, 0xbe, 0x61, 0x62, 0x9d, 0xcd, 0x6d, 0x18, 0xbe, 0xd8,
-	0xc8, 0xcd, 0x55, 0xf6, 0xf3, 0xbd, 0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x4a, 0x24, 0x83,
-	0x36, 0x06, 0x00, 0x00,
+func (m *MsgSetPlatformFeeSplit) Reset()         { *m = MsgSetPlatformFeeSplit{} }
+func (m *MsgSetPlatformFeeSplit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPlatformFeeSplit) ProtoMessage()    {}
+func (*MsgSetPlatformFeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{8}
+}
+func (m *MsgSetPlatformFeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPlatformFeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPlatformFeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPlatformFeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPlatformFeeSplit.Merge(m, src)
+}
+func (m *MsgSetPlatformFeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPlatformFeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPlatformFeeSplit.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetPlatformFeeSplit proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Send defines a method for sending coins from one account to another
-	// account.
-	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
-	// MultiSend defines a method for sending coins from some accounts to other
-	// accounts.
-	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
-	// SetPlatformPercentage defines the method for updating the platform
-	// percentage fee
-	SetPlatformPercentage(ctx context.Context, in *MsgSetPlatformPercentage, opts ...grpc.CallOption) (*MsgSetPlatformPercentageResponse, error)
-	// SetPlatformMinimum defines the method for updating the platform
-	// percentage fee
-	SetPlatformMinimum(ctx context.Context, in *MsgSetPlatformMinimum, opts ...grpc.CallOption) (*MsgSetPlatformMinimumResponse, error)
+func (m *MsgSetPlatformFeeSplit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetPlatformFeeSplit) GetSplit() PlatformFeeSplit {
+	if m != nil {
+		return m.Split
+	}
+	return PlatformFeeSplit{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgSetPlatformFeeSplitResponse defines the response for setting the
+// platform fee split
+type MsgSetPlatformFeeSplitResponse struct {
 }
 
-func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error) {
-	out := new(MsgSendResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Msg/Send", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPlatformFeeSplitResponse) Reset()         { *m = MsgSetPlatformFeeSplitResponse{} }
+func (m *MsgSetPlatformFeeSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPlatformFeeSplitResponse) ProtoMessage()    {}
+func (*MsgSetPlatformFeeSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{9}
+}
+func (m *MsgSetPlatformFeeSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPlatformFeeSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPlatformFeeSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetPlatformFeeSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPlatformFeeSplitResponse.Merge(m, src)
+}
+func (m *MsgSetPlatformFeeSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPlatformFeeSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPlatformFeeSplitResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error) {
-	out := new(MsgMultiSendResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Msg/MultiSend", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetPlatformFeeSplitResponse proto.InternalMessageInfo
+
+// MsgSetPlatformFeeEnforcement defines the message for setting which value
+// transfer paths are charged the platform fee
+type MsgSetPlatformFeeEnforcement struct {
+	// The authority address that can set the platform fee enforcement
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The platform fee enforcement settings
+	Enforcement PlatformFeeEnforcement `protobuf:"bytes,2,opt,name=enforcement,proto3" json:"enforcement"`
 }
 
-func (c *msgClient) SetPlatformPercentage(ctx context.Context, in *MsgSetPlatformPercentage, opts ...grpc.CallOption) (*MsgSetPlatformPercentageResponse, error) {
-	out := new(MsgSetPlatformPercentageResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetPlatformPercentage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPlatformFeeEnforcement) Reset()         { *m = MsgSetPlatformFeeEnforcement{} }
+func (m *MsgSetPlatformFeeEnforcement) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPlatformFeeEnforcement) ProtoMessage()    {}
+func (*MsgSetPlatformFeeEnforcement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{10}
+}
+func (m *MsgSetPlatformFeeEnforcement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPlatformFeeEnforcement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPlatformFeeEnforcement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetPlatformFeeEnforcement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPlatformFeeEnforcement.Merge(m, src)
+}
+func (m *MsgSetPlatformFeeEnforcement) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPlatformFeeEnforcement) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPlatformFeeEnforcement.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetPlatformMinimum(ctx context.Context, in *MsgSetPlatformMinimum, opts ...grpc.CallOption) (*MsgSetPlatformMinimumResponse, error) {
-	out := new(MsgSetPlatformMinimumResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetPlatformMinimum", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetPlatformFeeEnforcement proto.InternalMessageInfo
+
+func (m *MsgSetPlatformFeeEnforcement) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Send defines a method for sending coins from one account to another
-	// account.
-	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
-	// MultiSend defines a method for sending coins from some accounts to other
-	// accounts.
-	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
-	// SetPlatformPercentage defines the method for updating the platform
-	// percentage fee
-	SetPlatformPercentage(context.Context, *MsgSetPlatformPercentage) (*MsgSetPlatformPercentageResponse, error)
-	// SetPlatformMinimum defines the method for updating the platform
-	// percentage fee
-	SetPlatformMinimum(context.Context, *MsgSetPlatformMinimum) (*MsgSetPlatformMinimumResponse, error)
+func (m *MsgSetPlatformFeeEnforcement) GetEnforcement() PlatformFeeEnforcement {
+	if m != nil {
+		return m.Enforcement
+	}
+	return PlatformFeeEnforcement{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSetPlatformFeeEnforcementResponse defines the response for setting the
+// platform fee enforcement
+type MsgSetPlatformFeeEnforcementResponse struct {
 }
 
-func (*UnimplementedMsgServer) Send(ctx context.Context, req *MsgSend) (*MsgSendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+func (m *MsgSetPlatformFeeEnforcementResponse) Reset()         { *m = MsgSetPlatformFeeEnforcementResponse{} }
+func (m *MsgSetPlatformFeeEnforcementResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPlatformFeeEnforcementResponse) ProtoMessage()    {}
+func (*MsgSetPlatformFeeEnforcementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{11}
 }
-func (*UnimplementedMsgServer) MultiSend(ctx context.Context, req *MsgMultiSend) (*MsgMultiSendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiSend not implemented")
+func (m *MsgSetPlatformFeeEnforcementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetPlatformPercentage(ctx context.Context, req *MsgSetPlatformPercentage) (*MsgSetPlatformPercentageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformPercentage not implemented")
+func (m *MsgSetPlatformFeeEnforcementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPlatformFeeEnforcementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SetPlatformMinimum(ctx context.Context, req *MsgSetPlatformMinimum) (*MsgSetPlatformMinimumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformMinimum not implemented")
+func (m *MsgSetPlatformFeeEnforcementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPlatformFeeEnforcementResponse.Merge(m, src)
+}
+func (m *MsgSetPlatformFeeEnforcementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPlatformFeeEnforcementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPlatformFeeEnforcementResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetPlatformFeeEnforcementResponse proto.InternalMessageInfo
+
+// MsgCreatePaymentSchedule registers a recurring payment from sender to
+// recipient
+type MsgCreatePaymentSchedule struct {
+	// The address paying each period
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The address receiving each payment
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// The gross amount sent each period
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// The time between payments
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	// The time of the first payment, unset to start at the current block
+	StartTime *time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// No payment is made after this time, unset for no end
+	EndTime *time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// The maximum gross amount paid over the life of the schedule, empty for no
+	// limit
+	MaxTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=max_total,json=maxTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_total"`
 }
 
-func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSend)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Send(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Msg/Send",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Send(ctx, req.(*MsgSend))
+func (m *MsgCreatePaymentSchedule) Reset()         { *m = MsgCreatePaymentSchedule{} }
+func (m *MsgCreatePaymentSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePaymentSchedule) ProtoMessage()    {}
+func (*MsgCreatePaymentSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{12}
+}
+func (m *MsgCreatePaymentSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePaymentSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePaymentSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCreatePaymentSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePaymentSchedule.Merge(m, src)
+}
+func (m *MsgCreatePaymentSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePaymentSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePaymentSchedule.DiscardUnknown(m)
 }
 
-func _Msg_MultiSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMultiSend)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MultiSend(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Msg/MultiSend",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MultiSend(ctx, req.(*MsgMultiSend))
+var xxx_messageInfo_MsgCreatePaymentSchedule proto.InternalMessageInfo
+
+func (m *MsgCreatePaymentSchedule) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetPlatformPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetPlatformPercentage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetPlatformPercentage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Msg/SetPlatformPercentage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetPlatformPercentage(ctx, req.(*MsgSetPlatformPercentage))
+func (m *MsgCreatePaymentSchedule) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetPlatformMinimum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetPlatformMinimum)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetPlatformMinimum(ctx, in)
+func (m *MsgCreatePaymentSchedule) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Msg/SetPlatformMinimum",
+	return nil
+}
+
+func (m *MsgCreatePaymentSchedule) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetPlatformMinimum(ctx, req.(*MsgSetPlatformMinimum))
+	return 0
+}
+
+func (m *MsgCreatePaymentSchedule) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "xion.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Send",
-			Handler:    _Msg_Send_Handler,
-		},
-		{
-			MethodName: "MultiSend",
-			Handler:    _Msg_MultiSend_Handler,
-		},
-		{
-			MethodName: "SetPlatformPercentage",
-			Handler:    _Msg_SetPlatformPercentage_Handler,
-		},
-		{
-			MethodName: "SetPlatformMinimum",
-			Handler:    _Msg_SetPlatformMinimum_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "xion/v1/tx.proto",
+func (m *MsgCreatePaymentSchedule) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
 }
 
-func (m *MsgSend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreatePaymentSchedule) GetMaxTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxTotal
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreatePaymentScheduleResponse defines the Msg/CreatePaymentSchedule
+// response type.
+type MsgCreatePaymentScheduleResponse struct {
+	// The id of the new schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgCreatePaymentScheduleResponse) Reset()         { *m = MsgCreatePaymentScheduleResponse{} }
+func (m *MsgCreatePaymentScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePaymentScheduleResponse) ProtoMessage()    {}
+func (*MsgCreatePaymentScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{13}
+}
+func (m *MsgCreatePaymentScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePaymentScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePaymentScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCreatePaymentScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePaymentScheduleResponse.Merge(m, src)
 }
-
-func (m *MsgSendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreatePaymentScheduleResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreatePaymentScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePaymentScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiSend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreatePaymentScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCreatePaymentScheduleResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgMultiSend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelPaymentSchedule permanently stops a payment schedule
+type MsgCancelPaymentSchedule struct {
+	// The sender of the schedule
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The id of the schedule
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgMultiSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Outputs) > 0 {
-		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgCancelPaymentSchedule) Reset()         { *m = MsgCancelPaymentSchedule{} }
+func (m *MsgCancelPaymentSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPaymentSchedule) ProtoMessage()    {}
+func (*MsgCancelPaymentSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{14}
+}
+func (m *MsgCancelPaymentSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPaymentSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPaymentSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelPaymentSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPaymentSchedule.Merge(m, src)
+}
+func (m *MsgCancelPaymentSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPaymentSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPaymentSchedule.DiscardUnknown(m)
 }
 
-func (m *MsgMultiSendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelPaymentSchedule proto.InternalMessageInfo
+
+func (m *MsgCancelPaymentSchedule) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMultiSendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelPaymentSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgMultiSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCancelPaymentScheduleResponse defines the Msg/CancelPaymentSchedule
+// response type.
+type MsgCancelPaymentScheduleResponse struct {
 }
 
-func (m *MsgSetPlatformPercentage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelPaymentScheduleResponse) Reset()         { *m = MsgCancelPaymentScheduleResponse{} }
+func (m *MsgCancelPaymentScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPaymentScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelPaymentScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{15}
+}
+func (m *MsgCancelPaymentScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPaymentScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPaymentScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelPaymentScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPaymentScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelPaymentScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPaymentScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPaymentScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetPlatformPercentage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelPaymentScheduleResponse proto.InternalMessageInfo
+
+// MsgPausePaymentSchedule stops payments until the schedule is resumed
+type MsgPausePaymentSchedule struct {
+	// The sender of the schedule
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The id of the schedule
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgSetPlatformPercentage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PlatformPercentage != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PlatformPercentage))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgPausePaymentSchedule) Reset()         { *m = MsgPausePaymentSchedule{} }
+func (m *MsgPausePaymentSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgPausePaymentSchedule) ProtoMessage()    {}
+func (*MsgPausePaymentSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{16}
+}
+func (m *MsgPausePaymentSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPausePaymentSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPausePaymentSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgPausePaymentSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPausePaymentSchedule.Merge(m, src)
+}
+func (m *MsgPausePaymentSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPausePaymentSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPausePaymentSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPausePaymentSchedule proto.InternalMessageInfo
+
+func (m *MsgPausePaymentSchedule) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetPlatformPercentageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPausePaymentSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSetPlatformPercentageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgPausePaymentScheduleResponse defines the Msg/PausePaymentSchedule
+// response type.
+type MsgPausePaymentScheduleResponse struct {
 }
 
-func (m *MsgSetPlatformPercentageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPausePaymentScheduleResponse) Reset()         { *m = MsgPausePaymentScheduleResponse{} }
+func (m *MsgPausePaymentScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPausePaymentScheduleResponse) ProtoMessage()    {}
+func (*MsgPausePaymentScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{17}
 }
-
-func (m *MsgSetPlatformMinimum) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPausePaymentScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPausePaymentScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPausePaymentScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgPausePaymentScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPausePaymentScheduleResponse.Merge(m, src)
+}
+func (m *MsgPausePaymentScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPausePaymentScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPausePaymentScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetPlatformMinimum) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgPausePaymentScheduleResponse proto.InternalMessageInfo
+
+// MsgResumePaymentSchedule restarts payments of a paused schedule. Periods
+// missed while paused are skipped.
+type MsgResumePaymentSchedule struct {
+	// The sender of the schedule
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The id of the schedule
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgSetPlatformMinimum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Minimums) > 0 {
-		for iNdEx := len(m.Minimums) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Minimums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgResumePaymentSchedule) Reset()         { *m = MsgResumePaymentSchedule{} }
+func (m *MsgResumePaymentSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgResumePaymentSchedule) ProtoMessage()    {}
+func (*MsgResumePaymentSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{18}
+}
+func (m *MsgResumePaymentSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumePaymentSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumePaymentSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgResumePaymentSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumePaymentSchedule.Merge(m, src)
+}
+func (m *MsgResumePaymentSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumePaymentSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumePaymentSchedule.DiscardUnknown(m)
 }
 
-func (m *MsgSetPlatformMinimumResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResumePaymentSchedule proto.InternalMessageInfo
+
+func (m *MsgResumePaymentSchedule) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetPlatformMinimumResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResumePaymentSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgSetPlatformMinimumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgResumePaymentScheduleResponse defines the Msg/ResumePaymentSchedule
+// response type.
+type MsgResumePaymentScheduleResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgResumePaymentScheduleResponse) Reset()         { *m = MsgResumePaymentScheduleResponse{} }
+func (m *MsgResumePaymentScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumePaymentScheduleResponse) ProtoMessage()    {}
+func (*MsgResumePaymentScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{19}
 }
-func (m *MsgSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgResumePaymentScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumePaymentScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumePaymentScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgResumePaymentScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumePaymentScheduleResponse.Merge(m, src)
+}
+func (m *MsgResumePaymentScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumePaymentScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumePaymentScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgResumePaymentScheduleResponse proto.InternalMessageInfo
+
+// MsgSendEscrow locks funds in escrow. Exactly one of recipient, secret_hash
+// and email_hash must be set.
+type MsgSendEscrow struct {
+	Sender string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// The only address that can claim the escrow
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// The SHA-256 hash of the compressed secp256k1 public key of the secret
+	// key that claims the escrow
+	SecretHash []byte `protobuf:"bytes,4,opt,name=secret_hash,json=secretHash,proto3" json:"secret_hash,omitempty"`
+	// The number of blocks the escrow can be claimed for
+	TimeoutBlocks uint64 `protobuf:"varint,5,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
+	// The x/dkim email hash whose DKIM proof claims the escrow
+	EmailHash string `protobuf:"bytes,6,opt,name=email_hash,json=emailHash,proto3" json:"email_hash,omitempty"`
 }
 
-func (m *MsgMultiSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgSendEscrow) Reset()         { *m = MsgSendEscrow{} }
+func (m *MsgSendEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgSendEscrow) ProtoMessage()    {}
+func (*MsgSendEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{20}
+}
+func (m *MsgSendEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+}
+func (m *MsgSendEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendEscrow.Merge(m, src)
+}
+func (m *MsgSendEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendEscrow proto.InternalMessageInfo
+
+func (m *MsgSendEscrow) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return n
+	return ""
 }
 
-func (m *MsgMultiSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSendEscrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgSetPlatformPercentage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSendEscrow) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSendEscrow) GetSecretHash() []byte {
+	if m != nil {
+		return m.SecretHash
 	}
-	if m.PlatformPercentage != 0 {
-		n += 1 + sovTx(uint64(m.PlatformPercentage))
+	return nil
+}
+
+func (m *MsgSendEscrow) GetTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.TimeoutBlocks
 	}
-	return n
+	return 0
 }
 
-func (m *MsgSetPlatformPercentageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSendEscrow) GetEmailHash() string {
+	if m != nil {
+		return m.EmailHash
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgSetPlatformMinimum) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgSendEscrowResponse returns the id of the new escrow.
+type MsgSendEscrowResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgSendEscrowResponse) Reset()         { *m = MsgSendEscrowResponse{} }
+func (m *MsgSendEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendEscrowResponse) ProtoMessage()    {}
+func (*MsgSendEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{21}
+}
+func (m *MsgSendEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSendEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendEscrowResponse.Merge(m, src)
+}
+func (m *MsgSendEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendEscrowResponse proto.InternalMessageInfo
+
+func (m *MsgSendEscrowResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Minimums) > 0 {
-		for _, e := range m.Minimums {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgClaimEscrow claims an escrow. Escrows with a recipient are claimed by
+// the recipient, escrows with a secret hash with a secret proof and escrows
+// with an email hash with an email proof.
+type MsgClaimEscrow struct {
+	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The address receiving the funds, the claimer if unset
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// A signature of the claim by the secret key the escrow secret hash
+	// commits to
+	SecretProof *SecretClaimProof `protobuf:"bytes,4,opt,name=secret_proof,json=secretProof,proto3" json:"secret_proof,omitempty"`
+	// A DKIM proof for the escrow email hash
+	EmailProof *EmailClaimProof `protobuf:"bytes,5,opt,name=email_proof,json=emailProof,proto3" json:"email_proof,omitempty"`
+}
+
+func (m *MsgClaimEscrow) Reset()         { *m = MsgClaimEscrow{} }
+func (m *MsgClaimEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimEscrow) ProtoMessage()    {}
+func (*MsgClaimEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{22}
+}
+func (m *MsgClaimEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimEscrow.Merge(m, src)
+}
+func (m *MsgClaimEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimEscrow proto.InternalMessageInfo
+
+func (m *MsgClaimEscrow) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *MsgClaimEscrow) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgClaimEscrow) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *MsgClaimEscrow) GetSecretProof() *SecretClaimProof {
+	if m != nil {
+		return m.SecretProof
+	}
+	return nil
+}
+
+func (m *MsgClaimEscrow) GetEmailProof() *EmailClaimProof {
+	if m != nil {
+		return m.EmailProof
+	}
+	return nil
+}
+
+type MsgClaimEscrowResponse struct {
+}
+
+func (m *MsgClaimEscrowResponse) Reset()         { *m = MsgClaimEscrowResponse{} }
+func (m *MsgClaimEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimEscrowResponse) ProtoMessage()    {}
+func (*MsgClaimEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{23}
+}
+func (m *MsgClaimEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimEscrowResponse.Merge(m, src)
+}
+func (m *MsgClaimEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimEscrowResponse proto.InternalMessageInfo
+
+// MsgRefundEscrow returns an escrow to its sender. The sender can refund an
+// expired escrow and the recipient can decline an escrow at any time.
+type MsgRefundEscrow struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRefundEscrow) Reset()         { *m = MsgRefundEscrow{} }
+func (m *MsgRefundEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundEscrow) ProtoMessage()    {}
+func (*MsgRefundEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{24}
+}
+func (m *MsgRefundEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundEscrow.Merge(m, src)
+}
+func (m *MsgRefundEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundEscrow proto.InternalMessageInfo
+
+func (m *MsgRefundEscrow) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgRefundEscrow) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgRefundEscrowResponse struct {
+}
+
+func (m *MsgRefundEscrowResponse) Reset()         { *m = MsgRefundEscrowResponse{} }
+func (m *MsgRefundEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundEscrowResponse) ProtoMessage()    {}
+func (*MsgRefundEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{25}
+}
+func (m *MsgRefundEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundEscrowResponse.Merge(m, src)
+}
+func (m *MsgRefundEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundEscrowResponse proto.InternalMessageInfo
+
+// MsgSetAttestationTrustAnchors defines the message for replacing the trusted
+// authenticator models used for attested WebAuthn registrations
+type MsgSetAttestationTrustAnchors struct {
+	// The authority address that can set the trust anchors
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The complete set of trust anchors; an empty set removes all of them
+	Anchors []AttestationTrustAnchor `protobuf:"bytes,2,rep,name=anchors,proto3" json:"anchors"`
+}
+
+func (m *MsgSetAttestationTrustAnchors) Reset()         { *m = MsgSetAttestationTrustAnchors{} }
+func (m *MsgSetAttestationTrustAnchors) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttestationTrustAnchors) ProtoMessage()    {}
+func (*MsgSetAttestationTrustAnchors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{26}
+}
+func (m *MsgSetAttestationTrustAnchors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttestationTrustAnchors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttestationTrustAnchors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttestationTrustAnchors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttestationTrustAnchors.Merge(m, src)
+}
+func (m *MsgSetAttestationTrustAnchors) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttestationTrustAnchors) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttestationTrustAnchors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttestationTrustAnchors proto.InternalMessageInfo
+
+func (m *MsgSetAttestationTrustAnchors) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetAttestationTrustAnchors) GetAnchors() []AttestationTrustAnchor {
+	if m != nil {
+		return m.Anchors
+	}
+	return nil
+}
+
+// MsgSetAttestationTrustAnchorsResponse defines the response for setting the
+// attestation trust anchors
+type MsgSetAttestationTrustAnchorsResponse struct {
+}
+
+func (m *MsgSetAttestationTrustAnchorsResponse) Reset()         { *m = MsgSetAttestationTrustAnchorsResponse{} }
+func (m *MsgSetAttestationTrustAnchorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttestationTrustAnchorsResponse) ProtoMessage()    {}
+func (*MsgSetAttestationTrustAnchorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{27}
+}
+func (m *MsgSetAttestationTrustAnchorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttestationTrustAnchorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttestationTrustAnchorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttestationTrustAnchorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttestationTrustAnchorsResponse.Merge(m, src)
+}
+func (m *MsgSetAttestationTrustAnchorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttestationTrustAnchorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttestationTrustAnchorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttestationTrustAnchorsResponse proto.InternalMessageInfo
+
+// MsgVerifyWebAuthNAssertion verifies a WebAuthn assertion statefully. The
+// credential state is tracked per sender, so an abstract account contract can
+// delegate clone detection for its own credentials.
+type MsgVerifyWebAuthNAssertion struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The challenge string for authentication
+	Challenge string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// The relying party identifier
+	Rp string `protobuf:"bytes,3,opt,name=rp,proto3" json:"rp,omitempty"`
+	// The JSON encoded credential created at registration
+	Credential []byte `protobuf:"bytes,4,opt,name=credential,proto3" json:"credential,omitempty"`
+	// The authentication data
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// The contract whose relying party profile replaces rp
+	RpProfile string `protobuf:"bytes,6,opt,name=rp_profile,json=rpProfile,proto3" json:"rp_profile,omitempty"`
+}
+
+func (m *MsgVerifyWebAuthNAssertion) Reset()         { *m = MsgVerifyWebAuthNAssertion{} }
+func (m *MsgVerifyWebAuthNAssertion) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyWebAuthNAssertion) ProtoMessage()    {}
+func (*MsgVerifyWebAuthNAssertion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{28}
+}
+func (m *MsgVerifyWebAuthNAssertion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyWebAuthNAssertion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyWebAuthNAssertion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifyWebAuthNAssertion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyWebAuthNAssertion.Merge(m, src)
+}
+func (m *MsgVerifyWebAuthNAssertion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyWebAuthNAssertion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyWebAuthNAssertion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifyWebAuthNAssertion proto.InternalMessageInfo
+
+func (m *MsgVerifyWebAuthNAssertion) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgVerifyWebAuthNAssertion) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+func (m *MsgVerifyWebAuthNAssertion) GetRp() string {
+	if m != nil {
+		return m.Rp
+	}
+	return ""
+}
+
+func (m *MsgVerifyWebAuthNAssertion) GetCredential() []byte {
+	if m != nil {
+		return m.Credential
+	}
+	return nil
+}
+
+func (m *MsgVerifyWebAuthNAssertion) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *MsgVerifyWebAuthNAssertion) GetRpProfile() string {
+	if m != nil {
+		return m.RpProfile
+	}
+	return ""
+}
+
+// MsgVerifyWebAuthNAssertionResponse returns the recorded credential state
+type MsgVerifyWebAuthNAssertionResponse struct {
+	State WebAuthNCredentialState `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+}
+
+func (m *MsgVerifyWebAuthNAssertionResponse) Reset()         { *m = MsgVerifyWebAuthNAssertionResponse{} }
+func (m *MsgVerifyWebAuthNAssertionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyWebAuthNAssertionResponse) ProtoMessage()    {}
+func (*MsgVerifyWebAuthNAssertionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{29}
+}
+func (m *MsgVerifyWebAuthNAssertionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyWebAuthNAssertionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyWebAuthNAssertionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifyWebAuthNAssertionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyWebAuthNAssertionResponse.Merge(m, src)
+}
+func (m *MsgVerifyWebAuthNAssertionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyWebAuthNAssertionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyWebAuthNAssertionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifyWebAuthNAssertionResponse proto.InternalMessageInfo
+
+func (m *MsgVerifyWebAuthNAssertionResponse) GetState() WebAuthNCredentialState {
+	if m != nil {
+		return m.State
+	}
+	return WebAuthNCredentialState{}
+}
+
+// MsgSetRelyingPartyProfile registers or replaces the relying party profile
+// of a contract. The sender must be the contract or its admin.
+type MsgSetRelyingPartyProfile struct {
+	Sender  string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Profile RelyingPartyProfile `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile"`
+}
+
+func (m *MsgSetRelyingPartyProfile) Reset()         { *m = MsgSetRelyingPartyProfile{} }
+func (m *MsgSetRelyingPartyProfile) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRelyingPartyProfile) ProtoMessage()    {}
+func (*MsgSetRelyingPartyProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{30}
+}
+func (m *MsgSetRelyingPartyProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRelyingPartyProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRelyingPartyProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRelyingPartyProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRelyingPartyProfile.Merge(m, src)
+}
+func (m *MsgSetRelyingPartyProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRelyingPartyProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRelyingPartyProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRelyingPartyProfile proto.InternalMessageInfo
+
+func (m *MsgSetRelyingPartyProfile) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetRelyingPartyProfile) GetProfile() RelyingPartyProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return RelyingPartyProfile{}
+}
+
+// MsgSetRelyingPartyProfileResponse defines the Msg/SetRelyingPartyProfile
+// response type.
+type MsgSetRelyingPartyProfileResponse struct {
+}
+
+func (m *MsgSetRelyingPartyProfileResponse) Reset()         { *m = MsgSetRelyingPartyProfileResponse{} }
+func (m *MsgSetRelyingPartyProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRelyingPartyProfileResponse) ProtoMessage()    {}
+func (*MsgSetRelyingPartyProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{31}
+}
+func (m *MsgSetRelyingPartyProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRelyingPartyProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRelyingPartyProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRelyingPartyProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRelyingPartyProfileResponse.Merge(m, src)
+}
+func (m *MsgSetRelyingPartyProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRelyingPartyProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRelyingPartyProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRelyingPartyProfileResponse proto.InternalMessageInfo
+
+// MsgRemoveRelyingPartyProfile removes the relying party profile of a
+// contract. The sender must be the contract or its admin.
+type MsgRemoveRelyingPartyProfile struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *MsgRemoveRelyingPartyProfile) Reset()         { *m = MsgRemoveRelyingPartyProfile{} }
+func (m *MsgRemoveRelyingPartyProfile) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRelyingPartyProfile) ProtoMessage()    {}
+func (*MsgRemoveRelyingPartyProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{32}
+}
+func (m *MsgRemoveRelyingPartyProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRelyingPartyProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRelyingPartyProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRelyingPartyProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRelyingPartyProfile.Merge(m, src)
+}
+func (m *MsgRemoveRelyingPartyProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRelyingPartyProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRelyingPartyProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRelyingPartyProfile proto.InternalMessageInfo
+
+func (m *MsgRemoveRelyingPartyProfile) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRemoveRelyingPartyProfile) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// MsgRemoveRelyingPartyProfileResponse defines the
+// Msg/RemoveRelyingPartyProfile response type.
+type MsgRemoveRelyingPartyProfileResponse struct {
+}
+
+func (m *MsgRemoveRelyingPartyProfileResponse) Reset()         { *m = MsgRemoveRelyingPartyProfileResponse{} }
+func (m *MsgRemoveRelyingPartyProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRelyingPartyProfileResponse) ProtoMessage()    {}
+func (*MsgRemoveRelyingPartyProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{33}
+}
+func (m *MsgRemoveRelyingPartyProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRelyingPartyProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRelyingPartyProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRelyingPartyProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRelyingPartyProfileResponse.Merge(m, src)
+}
+func (m *MsgRemoveRelyingPartyProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRelyingPartyProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRelyingPartyProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRelyingPartyProfileResponse proto.InternalMessageInfo
+
+// MsgSetEmissionsSchedule replaces the emissions schedule. A schedule without
+// segments restores the staking inflation curve.
+type MsgSetEmissionsSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov
+	// unless overwritten).
+	Authority string            `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Schedule  EmissionsSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *MsgSetEmissionsSchedule) Reset()         { *m = MsgSetEmissionsSchedule{} }
+func (m *MsgSetEmissionsSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEmissionsSchedule) ProtoMessage()    {}
+func (*MsgSetEmissionsSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{34}
+}
+func (m *MsgSetEmissionsSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEmissionsSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEmissionsSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEmissionsSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEmissionsSchedule.Merge(m, src)
+}
+func (m *MsgSetEmissionsSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEmissionsSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEmissionsSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEmissionsSchedule proto.InternalMessageInfo
+
+func (m *MsgSetEmissionsSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetEmissionsSchedule) GetSchedule() EmissionsSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return EmissionsSchedule{}
+}
+
+// MsgSetEmissionsScheduleResponse defines the Msg/SetEmissionsSchedule
+// response type.
+type MsgSetEmissionsScheduleResponse struct {
+}
+
+func (m *MsgSetEmissionsScheduleResponse) Reset()         { *m = MsgSetEmissionsScheduleResponse{} }
+func (m *MsgSetEmissionsScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEmissionsScheduleResponse) ProtoMessage()    {}
+func (*MsgSetEmissionsScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{35}
+}
+func (m *MsgSetEmissionsScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEmissionsScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEmissionsScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEmissionsScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEmissionsScheduleResponse.Merge(m, src)
+}
+func (m *MsgSetEmissionsScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEmissionsScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEmissionsScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEmissionsScheduleResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSend)(nil), "xion.v1.MsgSend")
+	proto.RegisterType((*MsgSendResponse)(nil), "xion.v1.MsgSendResponse")
+	proto.RegisterType((*MsgMultiSend)(nil), "xion.v1.MsgMultiSend")
+	proto.RegisterType((*MsgMultiSendResponse)(nil), "xion.v1.MsgMultiSendResponse")
+	proto.RegisterType((*MsgSetPlatformPercentage)(nil), "xion.v1.MsgSetPlatformPercentage")
+	proto.RegisterType((*MsgSetPlatformPercentageResponse)(nil), "xion.v1.MsgSetPlatformPercentageResponse")
+	proto.RegisterType((*MsgSetPlatformMinimum)(nil), "xion.v1.MsgSetPlatformMinimum")
+	proto.RegisterType((*MsgSetPlatformMinimumResponse)(nil), "xion.v1.MsgSetPlatformMinimumResponse")
+	proto.RegisterType((*MsgSetPlatformFeeSplit)(nil), "xion.v1.MsgSetPlatformFeeSplit")
+	proto.RegisterType((*MsgSetPlatformFeeSplitResponse)(nil), "xion.v1.MsgSetPlatformFeeSplitResponse")
+	proto.RegisterType((*MsgSetPlatformFeeEnforcement)(nil), "xion.v1.MsgSetPlatformFeeEnforcement")
+	proto.RegisterType((*MsgSetPlatformFeeEnforcementResponse)(nil), "xion.v1.MsgSetPlatformFeeEnforcementResponse")
+	proto.RegisterType((*MsgCreatePaymentSchedule)(nil), "xion.v1.MsgCreatePaymentSchedule")
+	proto.RegisterType((*MsgCreatePaymentScheduleResponse)(nil), "xion.v1.MsgCreatePaymentScheduleResponse")
+	proto.RegisterType((*MsgCancelPaymentSchedule)(nil), "xion.v1.MsgCancelPaymentSchedule")
+	proto.RegisterType((*MsgCancelPaymentScheduleResponse)(nil), "xion.v1.MsgCancelPaymentScheduleResponse")
+	proto.RegisterType((*MsgPausePaymentSchedule)(nil), "xion.v1.MsgPausePaymentSchedule")
+	proto.RegisterType((*MsgPausePaymentScheduleResponse)(nil), "xion.v1.MsgPausePaymentScheduleResponse")
+	proto.RegisterType((*MsgResumePaymentSchedule)(nil), "xion.v1.MsgResumePaymentSchedule")
+	proto.RegisterType((*MsgResumePaymentScheduleResponse)(nil), "xion.v1.MsgResumePaymentScheduleResponse")
+	proto.RegisterType((*MsgSendEscrow)(nil), "xion.v1.MsgSendEscrow")
+	proto.RegisterType((*MsgSendEscrowResponse)(nil), "xion.v1.MsgSendEscrowResponse")
+	proto.RegisterType((*MsgClaimEscrow)(nil), "xion.v1.MsgClaimEscrow")
+	proto.RegisterType((*MsgClaimEscrowResponse)(nil), "xion.v1.MsgClaimEscrowResponse")
+	proto.RegisterType((*MsgRefundEscrow)(nil), "xion.v1.MsgRefundEscrow")
+	proto.RegisterType((*MsgRefundEscrowResponse)(nil), "xion.v1.MsgRefundEscrowResponse")
+	proto.RegisterType((*MsgSetAttestationTrustAnchors)(nil), "xion.v1.MsgSetAttestationTrustAnchors")
+	proto.RegisterType((*MsgSetAttestationTrustAnchorsResponse)(nil), "xion.v1.MsgSetAttestationTrustAnchorsResponse")
+	proto.RegisterType((*MsgVerifyWebAuthNAssertion)(nil), "xion.v1.MsgVerifyWebAuthNAssertion")
+	proto.RegisterType((*MsgVerifyWebAuthNAssertionResponse)(nil), "xion.v1.MsgVerifyWebAuthNAssertionResponse")
+	proto.RegisterType((*MsgSetRelyingPartyProfile)(nil), "xion.v1.MsgSetRelyingPartyProfile")
+	proto.RegisterType((*MsgSetRelyingPartyProfileResponse)(nil), "xion.v1.MsgSetRelyingPartyProfileResponse")
+	proto.RegisterType((*MsgRemoveRelyingPartyProfile)(nil), "xion.v1.MsgRemoveRelyingPartyProfile")
+	proto.RegisterType((*MsgRemoveRelyingPartyProfileResponse)(nil), "xion.v1.MsgRemoveRelyingPartyProfileResponse")
+	proto.RegisterType((*MsgSetEmissionsSchedule)(nil), "xion.v1.MsgSetEmissionsSchedule")
+	proto.RegisterType((*MsgSetEmissionsScheduleResponse)(nil), "xion.v1.MsgSetEmissionsScheduleResponse")
+}
+
+func init() { proto.RegisterFile("xion/v1/tx.proto", fileDescriptor_5076275aa290c9b8) }
+
+var fileDescriptor_5076275aa290c9b8 = []byte{
+	// 1903 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0xf7, 0xcc, 0x7a, 0x6d, 0xcf, 0xb3, 0xbd, 0x64, 0x7b, 0xfd, 0x31, 0xee, 0x78, 0x67, 0xc6,
+	0x9d, 0x75, 0xd6, 0xbb, 0xe0, 0x99, 0xb5, 0x49, 0x40, 0x4c, 0x82, 0xc8, 0x78, 0x77, 0xf9, 0x12,
+	0x06, 0xab, 0xbd, 0x02, 0x09, 0x21, 0x59, 0x3d, 0x3d, 0xe5, 0x9e, 0x96, 0xbb, 0xbb, 0x86, 0xae,
+	0x6a, 0xc7, 0xbe, 0x21, 0x4e, 0x7c, 0x5c, 0x72, 0x41, 0x42, 0x9c, 0x72, 0x44, 0x5c, 0xc8, 0x21,
+	0x20, 0x21, 0x71, 0xe0, 0x82, 0xc8, 0x31, 0x42, 0x1c, 0x10, 0x07, 0x16, 0xed, 0x1e, 0xc2, 0x01,
+	0xf1, 0x37, 0xa0, 0xfa, 0xe8, 0x9a, 0xee, 0x99, 0x6a, 0x8f, 0x63, 0x92, 0xbd, 0xd8, 0xd3, 0xef,
+	0xfd, 0xde, 0xd7, 0xaf, 0x5e, 0xbf, 0xaa, 0x6a, 0x78, 0xe9, 0xcc, 0xc7, 0x51, 0xeb, 0x74, 0xa7,
+	0x45, 0xcf, 0x9a, 0x83, 0x18, 0x53, 0x6c, 0xcc, 0x32, 0x49, 0xf3, 0x74, 0xc7, 0x5c, 0xf2, 0xb0,
+	0x87, 0xb9, 0xac, 0xc5, 0x7e, 0x09, 0xb5, 0xb9, 0xea, 0x62, 0x12, 0x62, 0xd2, 0x0a, 0x89, 0xc7,
+	0xcc, 0x42, 0xe2, 0x49, 0xc5, 0x4d, 0x27, 0xf4, 0x23, 0xdc, 0xe2, 0x7f, 0xa5, 0x68, 0x4d, 0x60,
+	0x8f, 0x84, 0x13, 0xf1, 0x20, 0x55, 0x35, 0xe9, 0xa6, 0xeb, 0x10, 0xd4, 0x3a, 0xdd, 0xe9, 0x22,
+	0xea, 0xec, 0xb4, 0x5c, 0xec, 0x47, 0x63, 0xfa, 0xe8, 0x44, 0xe9, 0xd9, 0x83, 0xd4, 0x2f, 0xa7,
+	0x79, 0x7b, 0x28, 0x42, 0xc4, 0x4f, 0xdd, 0x2e, 0xa5, 0x62, 0x44, 0xdc, 0x18, 0xbf, 0x2d, 0xa5,
+	0x2b, 0xa9, 0xf4, 0x6d, 0xd4, 0x75, 0x12, 0xda, 0x4f, 0x83, 0xac, 0x2a, 0x74, 0xe8, 0x13, 0xe2,
+	0xe3, 0x48, 0x65, 0xe7, 0x61, 0xec, 0x05, 0xa8, 0xc5, 0x9f, 0xba, 0xc9, 0x71, 0xab, 0x97, 0xc4,
+	0x0e, 0x65, 0xbc, 0x08, 0x7d, 0x7d, 0x54, 0x4f, 0xfd, 0x10, 0x11, 0xea, 0x84, 0x03, 0x01, 0xb0,
+	0x7e, 0x51, 0x86, 0xd9, 0x7d, 0xe2, 0x1d, 0xa2, 0xa8, 0x67, 0xbc, 0x01, 0x0b, 0xc7, 0x31, 0x0e,
+	0x8f, 0x9c, 0x5e, 0x2f, 0x46, 0x84, 0x54, 0x4b, 0x8d, 0xd2, 0x56, 0x65, 0xaf, 0xfa, 0xd7, 0xf7,
+	0xb7, 0x97, 0x24, 0x25, 0x1d, 0xa1, 0x39, 0xa4, 0xb1, 0x1f, 0x79, 0xf6, 0x3c, 0x43, 0x4b, 0x91,
+	0xf1, 0x45, 0x00, 0x8a, 0x95, 0x69, 0x79, 0x82, 0x69, 0x85, 0xe2, 0xd4, 0xb0, 0x0f, 0x33, 0x4e,
+	0x88, 0x93, 0x88, 0x56, 0xaf, 0x35, 0xae, 0x6d, 0xcd, 0xef, 0xae, 0x35, 0xa5, 0x05, 0x63, 0xbc,
+	0x29, 0x19, 0x6d, 0x3e, 0xc4, 0x7e, 0xb4, 0xf7, 0xfa, 0x07, 0xff, 0xac, 0x4f, 0xfd, 0xe6, 0x69,
+	0x7d, 0xcb, 0xf3, 0x69, 0x3f, 0xe9, 0x36, 0x5d, 0x1c, 0xca, 0xc5, 0x92, 0xff, 0xb6, 0x49, 0xef,
+	0xa4, 0x45, 0xcf, 0x07, 0x88, 0x70, 0x03, 0xf2, 0xeb, 0x8f, 0xde, 0xbb, 0x5f, 0xb2, 0xa5, 0xff,
+	0xf6, 0xfd, 0x9f, 0xbc, 0x5b, 0x9f, 0xfa, 0xf7, 0xbb, 0xf5, 0xa9, 0x1f, 0x7f, 0xf4, 0xde, 0xfd,
+	0x5c, 0xa9, 0x3f, 0x63, 0x02, 0xce, 0xb1, 0xe4, 0xc2, 0xba, 0x09, 0x9f, 0x91, 0x3f, 0x6d, 0x44,
+	0x06, 0x38, 0x22, 0xc8, 0xfa, 0x7d, 0x09, 0x16, 0xf6, 0x89, 0xb7, 0x9f, 0x04, 0xd4, 0xe7, 0x7c,
+	0x7d, 0x19, 0x66, 0xfc, 0x68, 0x90, 0x50, 0xc6, 0x14, 0xcb, 0xdc, 0x1c, 0x66, 0x1e, 0x9d, 0xa8,
+	0xcc, 0xbf, 0xc1, 0x20, 0x7b, 0x15, 0x96, 0xba, 0x4c, 0x47, 0x18, 0x19, 0x6f, 0xc1, 0x2c, 0x4e,
+	0x28, 0xb7, 0x2f, 0x73, 0xfb, 0x97, 0xb5, 0xf6, 0xdf, 0x49, 0xe8, 0x88, 0x83, 0xd4, 0xac, 0xbd,
+	0x99, 0x16, 0x23, 0x5d, 0xb2, 0x32, 0x6e, 0xa6, 0x65, 0xa8, 0x3c, 0xad, 0x15, 0x58, 0xca, 0x3e,
+	0xab, 0x82, 0x7e, 0x57, 0x82, 0x2a, 0x2f, 0x92, 0x1e, 0x04, 0x0e, 0x3d, 0xc6, 0x71, 0x78, 0x80,
+	0x62, 0x17, 0x45, 0xd4, 0xf1, 0x90, 0xf1, 0x05, 0xa8, 0xb0, 0x0e, 0xc4, 0xb1, 0x4f, 0xcf, 0x27,
+	0x76, 0xc2, 0x10, 0x6a, 0xb4, 0xe0, 0xd6, 0x40, 0x7a, 0x3b, 0x1a, 0x28, 0x77, 0xbc, 0x21, 0x16,
+	0x6d, 0x63, 0x30, 0x16, 0xa8, 0xfd, 0x80, 0x15, 0x30, 0x74, 0xc0, 0x6a, 0xb8, 0x3d, 0x5c, 0x0a,
+	0x4d, 0x6a, 0x96, 0x05, 0x8d, 0x22, 0x9d, 0xaa, 0xed, 0x3f, 0x25, 0x58, 0xce, 0x83, 0xf6, 0xfd,
+	0xc8, 0x0f, 0x93, 0xf0, 0xca, 0x85, 0x05, 0x30, 0x17, 0x0a, 0x17, 0xe4, 0x53, 0xeb, 0x54, 0x15,
+	0xa1, 0xbd, 0x3d, 0xce, 0x8a, 0xa9, 0x61, 0x45, 0x16, 0x65, 0xd5, 0xe1, 0xb6, 0x56, 0xa1, 0xf8,
+	0xf8, 0x63, 0x09, 0x56, 0xf2, 0x88, 0xaf, 0x22, 0x74, 0x38, 0x08, 0x7c, 0x7a, 0x65, 0x42, 0xda,
+	0x70, 0x9d, 0x30, 0x07, 0x7c, 0x6d, 0x19, 0x1b, 0x72, 0x1e, 0x37, 0x47, 0x23, 0x64, 0x7b, 0x57,
+	0x98, 0xb4, 0x9b, 0xe3, 0xe5, 0xbd, 0xac, 0x29, 0x2f, 0xf5, 0x60, 0x35, 0xa0, 0xa6, 0xd7, 0xa8,
+	0x02, 0xff, 0x51, 0x82, 0xf5, 0x31, 0xc8, 0xe3, 0xe8, 0x18, 0xc7, 0x2e, 0x0a, 0x51, 0x74, 0xf5,
+	0x32, 0xbf, 0x05, 0xf3, 0x68, 0xe8, 0x46, 0x16, 0x5b, 0xd7, 0x15, 0x9b, 0x89, 0x96, 0x2d, 0x39,
+	0x6b, 0xde, 0x7e, 0x6d, 0xbc, 0xf0, 0x0d, 0x7d, 0xe1, 0x19, 0x6f, 0xd6, 0xab, 0x70, 0xe7, 0x22,
+	0xbd, 0x22, 0xe1, 0x2f, 0xd3, 0xfc, 0x8d, 0x7e, 0x18, 0x23, 0x87, 0xa2, 0x03, 0xe7, 0x9c, 0x29,
+	0x0f, 0xdd, 0x3e, 0xea, 0x25, 0x01, 0x32, 0x1e, 0xc0, 0x0c, 0x41, 0x51, 0x0f, 0xc5, 0x13, 0xab,
+	0x97, 0x38, 0x46, 0x59, 0x8c, 0x5c, 0x7f, 0xe0, 0xa7, 0x85, 0x5f, 0x48, 0x99, 0x82, 0xbe, 0xb8,
+	0x91, 0x6e, 0xbc, 0x05, 0x33, 0x03, 0x14, 0xfb, 0xb8, 0x57, 0x9d, 0x96, 0x4d, 0x28, 0x36, 0xbc,
+	0x66, 0xba, 0xe1, 0x35, 0x1f, 0xc9, 0x0d, 0x71, 0x6f, 0x91, 0x45, 0xfa, 0xe5, 0xd3, 0x7a, 0x49,
+	0x7a, 0x10, 0x76, 0xc6, 0x57, 0x00, 0x08, 0x75, 0x62, 0x7a, 0xc4, 0x76, 0xc6, 0xea, 0x75, 0xee,
+	0xc5, 0x1c, 0xf3, 0xf2, 0x24, 0xdd, 0x36, 0xf7, 0xa6, 0xdf, 0x79, 0x5a, 0x2f, 0xd9, 0x15, 0x6e,
+	0xc3, 0xa4, 0xc6, 0x1b, 0x30, 0x87, 0xa2, 0x9e, 0x30, 0x9f, 0xb9, 0xa4, 0xf9, 0x2c, 0x8a, 0x7a,
+	0xdc, 0xb8, 0x0f, 0x95, 0xd0, 0x39, 0x3b, 0xa2, 0x98, 0x3a, 0x41, 0x75, 0x76, 0x12, 0x59, 0x0f,
+	0x3e, 0x2e, 0x59, 0xf6, 0x5c, 0xe8, 0x9c, 0x3d, 0x61, 0xce, 0xc5, 0x40, 0x91, 0x0b, 0x9b, 0x9b,
+	0xb1, 0xda, 0x66, 0xb1, 0x76, 0xa1, 0x51, 0xa4, 0x4b, 0xbb, 0xcd, 0xb8, 0x01, 0x65, 0xbf, 0xc7,
+	0x9b, 0x69, 0xda, 0x2e, 0xfb, 0x3d, 0xeb, 0xe7, 0x62, 0x3f, 0x79, 0xe8, 0x44, 0x2e, 0x0a, 0xfe,
+	0xff, 0xee, 0x13, 0xee, 0xcb, 0xa9, 0xfb, 0x0b, 0x2a, 0xd0, 0x05, 0x94, 0xbb, 0x84, 0x56, 0xa7,
+	0xde, 0x97, 0x9f, 0x96, 0x60, 0x75, 0x9f, 0x78, 0x07, 0x4e, 0x42, 0xd0, 0x27, 0x9f, 0xf0, 0xe7,
+	0x46, 0x12, 0x5e, 0x4f, 0x13, 0xd6, 0xc5, 0xb3, 0x36, 0xa0, 0x5e, 0xa0, 0x52, 0xe9, 0x4a, 0x82,
+	0x6d, 0x44, 0x92, 0x10, 0xbd, 0x40, 0x82, 0xb5, 0x01, 0x25, 0xc1, 0x5a, 0x9d, 0xca, 0xf8, 0xbf,
+	0x65, 0x58, 0x94, 0xe7, 0xa8, 0xc7, 0xfc, 0xa0, 0x7b, 0x85, 0x34, 0x87, 0xd3, 0xa4, 0xfc, 0x29,
+	0x4f, 0x93, 0xdc, 0xbc, 0xbb, 0x76, 0xf9, 0x79, 0x57, 0x87, 0x79, 0x82, 0xdc, 0x18, 0xd1, 0xa3,
+	0xbe, 0x43, 0xfa, 0x7c, 0x14, 0x2d, 0xd8, 0x20, 0x44, 0x5f, 0x77, 0x48, 0xdf, 0xd8, 0x84, 0x1b,
+	0x6c, 0x3e, 0xe0, 0x84, 0x1e, 0x75, 0x03, 0xec, 0x9e, 0x10, 0x3e, 0x68, 0xa6, 0xed, 0x45, 0x29,
+	0xdd, 0xe3, 0x42, 0xe3, 0x36, 0x00, 0x0a, 0x1d, 0x3f, 0x10, 0x6e, 0xd8, 0x30, 0xa9, 0xd8, 0x15,
+	0x2e, 0x61, 0x5e, 0xda, 0xd6, 0xc8, 0xfa, 0x18, 0xd9, 0x13, 0xab, 0xa0, 0xd7, 0xba, 0x0b, 0xcb,
+	0x39, 0x41, 0xe1, 0xcb, 0xfa, 0xdb, 0x32, 0xdc, 0x60, 0xef, 0x47, 0xe0, 0xf8, 0xa1, 0x5c, 0x9a,
+	0x5d, 0x98, 0x75, 0xd9, 0xe3, 0x25, 0xd6, 0x26, 0x05, 0x8e, 0xf6, 0x90, 0xd1, 0x86, 0xf9, 0x1e,
+	0x22, 0xd4, 0x8f, 0xf8, 0xd0, 0x9d, 0x48, 0x62, 0x16, 0x6c, 0xbc, 0x09, 0x0b, 0x92, 0xc6, 0x41,
+	0x8c, 0xf1, 0xb1, 0x1a, 0xe9, 0xe9, 0x56, 0x7b, 0xc8, 0x95, 0x3c, 0xe3, 0x03, 0x06, 0xb0, 0x25,
+	0xeb, 0xfc, 0xc1, 0xf8, 0x12, 0xcc, 0x0b, 0xf2, 0x84, 0xb1, 0x98, 0xe4, 0x55, 0x65, 0xfc, 0x98,
+	0xe9, 0x32, 0xb6, 0x82, 0x69, 0xfe, 0xbb, 0x7d, 0x87, 0x11, 0x9b, 0x96, 0xc4, 0x98, 0xbd, 0xa5,
+	0x46, 0xcb, 0x90, 0x1e, 0xab, 0x0a, 0x2b, 0x79, 0x89, 0xea, 0xf2, 0x73, 0x7e, 0x59, 0xb0, 0xd1,
+	0x71, 0x92, 0x6b, 0x73, 0xdf, 0x8b, 0x2e, 0xd5, 0xe6, 0x1c, 0x37, 0xf6, 0x36, 0xde, 0x11, 0xab,
+	0xcd, 0x95, 0x2c, 0xa7, 0xa5, 0xe1, 0xdb, 0x38, 0x8c, 0x63, 0xad, 0xc1, 0xea, 0x88, 0x48, 0x65,
+	0xf5, 0xb7, 0x52, 0x7a, 0x28, 0xec, 0x50, 0xca, 0xb6, 0x1f, 0x46, 0xf2, 0x93, 0x38, 0x21, 0xb4,
+	0x13, 0xb9, 0x7d, 0x1c, 0x93, 0x2b, 0x1f, 0x89, 0x1e, 0xc1, 0xac, 0x23, 0x5c, 0xc8, 0x57, 0x72,
+	0x78, 0x1c, 0xd2, 0x87, 0xca, 0xdd, 0x5e, 0xa4, 0x69, 0xfb, 0xf5, 0xf1, 0xa3, 0x90, 0x95, 0x39,
+	0x0a, 0x15, 0x24, 0x6d, 0xdd, 0x85, 0xcd, 0x0b, 0x01, 0xaa, 0xfe, 0x5f, 0x95, 0xc1, 0xdc, 0x27,
+	0xde, 0x77, 0x51, 0xec, 0x1f, 0x9f, 0x7f, 0x0f, 0x75, 0x3b, 0x09, 0xed, 0x7f, 0xbb, 0x43, 0x08,
+	0x8a, 0x79, 0xb7, 0x7d, 0xfc, 0x41, 0xb4, 0x0e, 0x15, 0xb7, 0xef, 0x04, 0x01, 0x8a, 0xe4, 0x85,
+	0xa6, 0x62, 0x0f, 0x05, 0x6c, 0xfd, 0xe2, 0x81, 0x68, 0x78, 0xbb, 0x1c, 0x0f, 0x8c, 0x1a, 0x80,
+	0x1b, 0xa3, 0x1e, 0x8a, 0xa8, 0xef, 0x04, 0xe9, 0x4c, 0x18, 0x4a, 0x0c, 0x03, 0xa6, 0x7b, 0x0e,
+	0x75, 0x78, 0xa3, 0x2e, 0xd8, 0xfc, 0x37, 0xbb, 0x44, 0xc7, 0x03, 0xd6, 0xc0, 0xc7, 0x7e, 0x20,
+	0x4e, 0x13, 0x17, 0x4f, 0xa0, 0xc1, 0x81, 0x80, 0xb6, 0x5b, 0x23, 0xa3, 0xa1, 0x9e, 0x12, 0x59,
+	0x50, 0xbd, 0xd5, 0x05, 0xab, 0x58, 0xab, 0x86, 0xc6, 0x9b, 0x70, 0x9d, 0x31, 0x8c, 0x38, 0x45,
+	0xf3, 0xbb, 0x0d, 0xb5, 0xcc, 0xa9, 0xc9, 0x43, 0x55, 0xcf, 0x21, 0xc3, 0xed, 0x4d, 0xb3, 0x75,
+	0xb6, 0x85, 0x91, 0xf5, 0xa7, 0x12, 0xac, 0x89, 0xa5, 0xb2, 0x51, 0x70, 0xee, 0x47, 0xde, 0x81,
+	0x13, 0xd3, 0x73, 0x99, 0xf2, 0x15, 0xf8, 0xef, 0xc0, 0x6c, 0x4a, 0x8d, 0x38, 0x85, 0xaf, 0xab,
+	0x7c, 0x34, 0x01, 0x72, 0x3d, 0x27, 0xed, 0xc4, 0xbd, 0x23, 0xc3, 0x53, 0x2d, 0xd3, 0x70, 0x1a,
+	0x1f, 0xd6, 0x2b, 0xb0, 0x51, 0xa8, 0x54, 0x8d, 0xf6, 0x07, 0x71, 0xf5, 0xb0, 0x51, 0x88, 0x4f,
+	0xd1, 0x27, 0x53, 0xea, 0x6b, 0x30, 0xe7, 0xe2, 0x88, 0xc6, 0x8e, 0x3b, 0xf9, 0xe0, 0xad, 0x90,
+	0xed, 0x9d, 0x91, 0xea, 0x36, 0x86, 0x23, 0xa3, 0x20, 0x35, 0x79, 0xb3, 0x28, 0xd4, 0xab, 0x1a,
+	0xff, 0x2c, 0x4e, 0x4a, 0x87, 0x88, 0x3e, 0x4e, 0x3f, 0x41, 0xa9, 0x93, 0xc7, 0x55, 0xc7, 0x48,
+	0x07, 0xe6, 0x88, 0xf4, 0x21, 0x17, 0xd4, 0xcc, 0x8c, 0xeb, 0x91, 0x28, 0xd9, 0xe5, 0x54, 0x66,
+	0xa2, 0xef, 0xf3, 0x33, 0x64, 0x3d, 0xb3, 0xa4, 0x63, 0x5e, 0xe4, 0x29, 0x4b, 0xa7, 0x4a, 0x4b,
+	0xdd, 0x7d, 0x7f, 0x11, 0xae, 0xed, 0x13, 0xcf, 0xd8, 0x85, 0x69, 0xfe, 0x99, 0xe7, 0x25, 0x95,
+	0x94, 0xdc, 0x59, 0xcd, 0xea, 0xa8, 0x44, 0xbd, 0x30, 0x1d, 0xa8, 0x0c, 0xbf, 0x0f, 0x2d, 0x67,
+	0x61, 0x4a, 0x6c, 0xde, 0xd6, 0x8a, 0x95, 0x0b, 0x04, 0xcb, 0xfa, 0x2f, 0x32, 0x1b, 0xf9, 0xa8,
+	0x1a, 0x88, 0x79, 0x6f, 0x22, 0x44, 0x85, 0xf9, 0x01, 0x18, 0x9a, 0x8f, 0x23, 0xb5, 0x02, 0x07,
+	0x52, 0x6f, 0xbe, 0x7a, 0xb1, 0x5e, 0x79, 0x3f, 0x82, 0x5b, 0xba, 0x4f, 0x0d, 0xf5, 0x02, 0xf3,
+	0x14, 0x60, 0xde, 0x9d, 0x00, 0x50, 0x01, 0x7e, 0x08, 0x6b, 0xc5, 0x57, 0xfd, 0xcd, 0x62, 0x2f,
+	0x19, 0x98, 0xb9, 0x7d, 0x29, 0x58, 0x76, 0x61, 0xf4, 0x17, 0xeb, 0xdc, 0xc2, 0x68, 0x21, 0xe6,
+	0xbd, 0x89, 0x90, 0x5c, 0x18, 0xed, 0x0d, 0x2a, 0x1f, 0x46, 0x07, 0x31, 0xef, 0x4d, 0x84, 0xa8,
+	0x30, 0x5d, 0x58, 0xd2, 0x5e, 0x7b, 0x1a, 0x59, 0x17, 0x3a, 0x84, 0xb9, 0x35, 0x09, 0x91, 0x2d,
+	0x45, 0x7f, 0x57, 0xc9, 0x95, 0xa2, 0x85, 0x98, 0xf7, 0x26, 0x42, 0x54, 0x98, 0x47, 0x00, 0x99,
+	0x0b, 0xc6, 0xca, 0xe8, 0xcb, 0x29, 0xe4, 0x66, 0x4d, 0x2f, 0x57, 0x5e, 0xbe, 0x06, 0xf3, 0xd9,
+	0xc3, 0xf0, 0x6a, 0x8e, 0xca, 0xa1, 0xc2, 0xac, 0x17, 0x28, 0x94, 0xa3, 0x6f, 0xc2, 0x42, 0xee,
+	0x28, 0x58, 0xcd, 0x57, 0x32, 0xd4, 0x98, 0x8d, 0x22, 0x8d, 0xf2, 0x45, 0xc1, 0xbc, 0xe0, 0xfc,
+	0x36, 0xfa, 0x36, 0x16, 0xe0, 0xcc, 0xe6, 0xe5, 0x70, 0x2a, 0xea, 0x09, 0xac, 0x16, 0x9d, 0x9a,
+	0x5e, 0xc9, 0xba, 0x2a, 0x00, 0x99, 0x9f, 0xbd, 0x04, 0x48, 0x05, 0xeb, 0xc3, 0x4a, 0xc1, 0x09,
+	0xc1, 0x1a, 0x49, 0x5b, 0x83, 0x31, 0xef, 0x4f, 0xc6, 0x64, 0x67, 0x46, 0xf1, 0x1e, 0xbd, 0x99,
+	0x5f, 0x8b, 0x02, 0x98, 0xb9, 0x7d, 0x29, 0x58, 0xf6, 0x2d, 0xd3, 0x6e, 0x99, 0x8d, 0x91, 0xb4,
+	0xc7, 0x10, 0xe6, 0xd6, 0x24, 0x44, 0x1a, 0xc3, 0xbc, 0xfe, 0x23, 0xb6, 0x29, 0xee, 0x75, 0x3e,
+	0x78, 0x56, 0x2b, 0x7d, 0xf8, 0xac, 0x56, 0xfa, 0xd7, 0xb3, 0x5a, 0xe9, 0x9d, 0xe7, 0xb5, 0xa9,
+	0x0f, 0x9f, 0xd7, 0xa6, 0xfe, 0xfe, 0xbc, 0x36, 0xf5, 0xfd, 0xbb, 0x99, 0xdb, 0x70, 0x37, 0x89,
+	0x23, 0xba, 0x1d, 0x38, 0x5d, 0xd2, 0xe2, 0xfb, 0xe4, 0x99, 0xf8, 0xc7, 0xaf, 0xc4, 0xdd, 0x19,
+	0xfe, 0xc1, 0xea, 0xf3, 0xff, 0x1b, 0x00, 0xf9, 0x68, 0xcf, 0x57, 0x6c, 0x1b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Send defines a method for sending coins from one account to another
+	// account.
+	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// MultiSend defines a method for sending coins from some accounts to other
+	// accounts.
+	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
+	// SetPlatformPercentage defines the method for updating the platform
+	// percentage fee
+	SetPlatformPercentage(ctx context.Context, in *MsgSetPlatformPercentage, opts ...grpc.CallOption) (*MsgSetPlatformPercentageResponse, error)
+	// SetPlatformMinimum defines the method for updating the platform
+	// percentage fee
+	SetPlatformMinimum(ctx context.Context, in *MsgSetPlatformMinimum, opts ...grpc.CallOption) (*MsgSetPlatformMinimumResponse, error)
+	// SetPlatformFeeSplit defines the method for updating how collected
+	// platform fees are distributed
+	SetPlatformFeeSplit(ctx context.Context, in *MsgSetPlatformFeeSplit, opts ...grpc.CallOption) (*MsgSetPlatformFeeSplitResponse, error)
+	// SetPlatformFeeEnforcement defines the method for updating which value
+	// transfer paths are charged the platform fee
+	SetPlatformFeeEnforcement(ctx context.Context, in *MsgSetPlatformFeeEnforcement, opts ...grpc.CallOption) (*MsgSetPlatformFeeEnforcementResponse, error)
+	// CreatePaymentSchedule registers a recurring payment from the sender
+	CreatePaymentSchedule(ctx context.Context, in *MsgCreatePaymentSchedule, opts ...grpc.CallOption) (*MsgCreatePaymentScheduleResponse, error)
+	// CancelPaymentSchedule permanently stops a payment schedule
+	CancelPaymentSchedule(ctx context.Context, in *MsgCancelPaymentSchedule, opts ...grpc.CallOption) (*MsgCancelPaymentScheduleResponse, error)
+	// PausePaymentSchedule stops payments until the schedule is resumed
+	PausePaymentSchedule(ctx context.Context, in *MsgPausePaymentSchedule, opts ...grpc.CallOption) (*MsgPausePaymentScheduleResponse, error)
+	// ResumePaymentSchedule restarts payments of a paused schedule
+	ResumePaymentSchedule(ctx context.Context, in *MsgResumePaymentSchedule, opts ...grpc.CallOption) (*MsgResumePaymentScheduleResponse, error)
+	// SendEscrow locks funds until they are claimed or the escrow expires.
+	SendEscrow(ctx context.Context, in *MsgSendEscrow, opts ...grpc.CallOption) (*MsgSendEscrowResponse, error)
+	// ClaimEscrow pays an escrow, less the platform fee, to the claimer.
+	ClaimEscrow(ctx context.Context, in *MsgClaimEscrow, opts ...grpc.CallOption) (*MsgClaimEscrowResponse, error)
+	// RefundEscrow returns an escrow to its sender.
+	RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error)
+	// SetAttestationTrustAnchors replaces the trusted authenticator models used
+	// for attested WebAuthn registrations
+	SetAttestationTrustAnchors(ctx context.Context, in *MsgSetAttestationTrustAnchors, opts ...grpc.CallOption) (*MsgSetAttestationTrustAnchorsResponse, error)
+	// VerifyWebAuthNAssertion verifies a WebAuthn assertion and records the
+	// credential's signature counter and backup flags, rejecting assertions
+	// from cloned authenticators
+	VerifyWebAuthNAssertion(ctx context.Context, in *MsgVerifyWebAuthNAssertion, opts ...grpc.CallOption) (*MsgVerifyWebAuthNAssertionResponse, error)
+	// SetRelyingPartyProfile registers or replaces the WebAuthn relying party
+	// profile of a contract
+	SetRelyingPartyProfile(ctx context.Context, in *MsgSetRelyingPartyProfile, opts ...grpc.CallOption) (*MsgSetRelyingPartyProfileResponse, error)
+	// RemoveRelyingPartyProfile removes the WebAuthn relying party profile of a
+	// contract
+	RemoveRelyingPartyProfile(ctx context.Context, in *MsgRemoveRelyingPartyProfile, opts ...grpc.CallOption) (*MsgRemoveRelyingPartyProfileResponse, error)
+	// SetEmissionsSchedule replaces the emissions schedule driving x/mint
+	SetEmissionsSchedule(ctx context.Context, in *MsgSetEmissionsSchedule, opts ...grpc.CallOption) (*MsgSetEmissionsScheduleResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error) {
+	out := new(MsgSendResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/Send", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error) {
+	out := new(MsgMultiSendResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/MultiSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPlatformPercentage(ctx context.Context, in *MsgSetPlatformPercentage, opts ...grpc.CallOption) (*MsgSetPlatformPercentageResponse, error) {
+	out := new(MsgSetPlatformPercentageResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetPlatformPercentage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPlatformMinimum(ctx context.Context, in *MsgSetPlatformMinimum, opts ...grpc.CallOption) (*MsgSetPlatformMinimumResponse, error) {
+	out := new(MsgSetPlatformMinimumResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetPlatformMinimum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPlatformFeeSplit(ctx context.Context, in *MsgSetPlatformFeeSplit, opts ...grpc.CallOption) (*MsgSetPlatformFeeSplitResponse, error) {
+	out := new(MsgSetPlatformFeeSplitResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetPlatformFeeSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPlatformFeeEnforcement(ctx context.Context, in *MsgSetPlatformFeeEnforcement, opts ...grpc.CallOption) (*MsgSetPlatformFeeEnforcementResponse, error) {
+	out := new(MsgSetPlatformFeeEnforcementResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetPlatformFeeEnforcement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePaymentSchedule(ctx context.Context, in *MsgCreatePaymentSchedule, opts ...grpc.CallOption) (*MsgCreatePaymentScheduleResponse, error) {
+	out := new(MsgCreatePaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/CreatePaymentSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPaymentSchedule(ctx context.Context, in *MsgCancelPaymentSchedule, opts ...grpc.CallOption) (*MsgCancelPaymentScheduleResponse, error) {
+	out := new(MsgCancelPaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/CancelPaymentSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PausePaymentSchedule(ctx context.Context, in *MsgPausePaymentSchedule, opts ...grpc.CallOption) (*MsgPausePaymentScheduleResponse, error) {
+	out := new(MsgPausePaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/PausePaymentSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumePaymentSchedule(ctx context.Context, in *MsgResumePaymentSchedule, opts ...grpc.CallOption) (*MsgResumePaymentScheduleResponse, error) {
+	out := new(MsgResumePaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/ResumePaymentSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendEscrow(ctx context.Context, in *MsgSendEscrow, opts ...grpc.CallOption) (*MsgSendEscrowResponse, error) {
+	out := new(MsgSendEscrowResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SendEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimEscrow(ctx context.Context, in *MsgClaimEscrow, opts ...grpc.CallOption) (*MsgClaimEscrowResponse, error) {
+	out := new(MsgClaimEscrowResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/ClaimEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error) {
+	out := new(MsgRefundEscrowResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/RefundEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAttestationTrustAnchors(ctx context.Context, in *MsgSetAttestationTrustAnchors, opts ...grpc.CallOption) (*MsgSetAttestationTrustAnchorsResponse, error) {
+	out := new(MsgSetAttestationTrustAnchorsResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetAttestationTrustAnchors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VerifyWebAuthNAssertion(ctx context.Context, in *MsgVerifyWebAuthNAssertion, opts ...grpc.CallOption) (*MsgVerifyWebAuthNAssertionResponse, error) {
+	out := new(MsgVerifyWebAuthNAssertionResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/VerifyWebAuthNAssertion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRelyingPartyProfile(ctx context.Context, in *MsgSetRelyingPartyProfile, opts ...grpc.CallOption) (*MsgSetRelyingPartyProfileResponse, error) {
+	out := new(MsgSetRelyingPartyProfileResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetRelyingPartyProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRelyingPartyProfile(ctx context.Context, in *MsgRemoveRelyingPartyProfile, opts ...grpc.CallOption) (*MsgRemoveRelyingPartyProfileResponse, error) {
+	out := new(MsgRemoveRelyingPartyProfileResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/RemoveRelyingPartyProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetEmissionsSchedule(ctx context.Context, in *MsgSetEmissionsSchedule, opts ...grpc.CallOption) (*MsgSetEmissionsScheduleResponse, error) {
+	out := new(MsgSetEmissionsScheduleResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetEmissionsSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Send defines a method for sending coins from one account to another
+	// account.
+	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// MultiSend defines a method for sending coins from some accounts to other
+	// accounts.
+	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
+	// SetPlatformPercentage defines the method for updating the platform
+	// percentage fee
+	SetPlatformPercentage(context.Context, *MsgSetPlatformPercentage) (*MsgSetPlatformPercentageResponse, error)
+	// SetPlatformMinimum defines the method for updating the platform
+	// percentage fee
+	SetPlatformMinimum(context.Context, *MsgSetPlatformMinimum) (*MsgSetPlatformMinimumResponse, error)
+	// SetPlatformFeeSplit defines the method for updating how collected
+	// platform fees are distributed
+	SetPlatformFeeSplit(context.Context, *MsgSetPlatformFeeSplit) (*MsgSetPlatformFeeSplitResponse, error)
+	// SetPlatformFeeEnforcement defines the method for updating which value
+	// transfer paths are charged the platform fee
+	SetPlatformFeeEnforcement(context.Context, *MsgSetPlatformFeeEnforcement) (*MsgSetPlatformFeeEnforcementResponse, error)
+	// CreatePaymentSchedule registers a recurring payment from the sender
+	CreatePaymentSchedule(context.Context, *MsgCreatePaymentSchedule) (*MsgCreatePaymentScheduleResponse, error)
+	// CancelPaymentSchedule permanently stops a payment schedule
+	CancelPaymentSchedule(context.Context, *MsgCancelPaymentSchedule) (*MsgCancelPaymentScheduleResponse, error)
+	// PausePaymentSchedule stops payments until the schedule is resumed
+	PausePaymentSchedule(context.Context, *MsgPausePaymentSchedule) (*MsgPausePaymentScheduleResponse, error)
+	// ResumePaymentSchedule restarts payments of a paused schedule
+	ResumePaymentSchedule(context.Context, *MsgResumePaymentSchedule) (*MsgResumePaymentScheduleResponse, error)
+	// SendEscrow locks funds until they are claimed or the escrow expires.
+	SendEscrow(context.Context, *MsgSendEscrow) (*MsgSendEscrowResponse, error)
+	// ClaimEscrow pays an escrow, less the platform fee, to the claimer.
+	ClaimEscrow(context.Context, *MsgClaimEscrow) (*MsgClaimEscrowResponse, error)
+	// RefundEscrow returns an escrow to its sender.
+	RefundEscrow(context.Context, *MsgRefundEscrow) (*MsgRefundEscrowResponse, error)
+	// SetAttestationTrustAnchors replaces the trusted authenticator models used
+	// for attested WebAuthn registrations
+	SetAttestationTrustAnchors(context.Context, *MsgSetAttestationTrustAnchors) (*MsgSetAttestationTrustAnchorsResponse, error)
+	// VerifyWebAuthNAssertion verifies a WebAuthn assertion and records the
+	// credential's signature counter and backup flags, rejecting assertions
+	// from cloned authenticators
+	VerifyWebAuthNAssertion(context.Context, *MsgVerifyWebAuthNAssertion) (*MsgVerifyWebAuthNAssertionResponse, error)
+	// SetRelyingPartyProfile registers or replaces the WebAuthn relying party
+	// profile of a contract
+	SetRelyingPartyProfile(context.Context, *MsgSetRelyingPartyProfile) (*MsgSetRelyingPartyProfileResponse, error)
+	// RemoveRelyingPartyProfile removes the WebAuthn relying party profile of a
+	// contract
+	RemoveRelyingPartyProfile(context.Context, *MsgRemoveRelyingPartyProfile) (*MsgRemoveRelyingPartyProfileResponse, error)
+	// SetEmissionsSchedule replaces the emissions schedule driving x/mint
+	SetEmissionsSchedule(context.Context, *MsgSetEmissionsSchedule) (*MsgSetEmissionsScheduleResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Send(ctx context.Context, req *MsgSend) (*MsgSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+func (*UnimplementedMsgServer) MultiSend(ctx context.Context, req *MsgMultiSend) (*MsgMultiSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSend not implemented")
+}
+func (*UnimplementedMsgServer) SetPlatformPercentage(ctx context.Context, req *MsgSetPlatformPercentage) (*MsgSetPlatformPercentageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformPercentage not implemented")
+}
+func (*UnimplementedMsgServer) SetPlatformMinimum(ctx context.Context, req *MsgSetPlatformMinimum) (*MsgSetPlatformMinimumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformMinimum not implemented")
+}
+func (*UnimplementedMsgServer) SetPlatformFeeSplit(ctx context.Context, req *MsgSetPlatformFeeSplit) (*MsgSetPlatformFeeSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformFeeSplit not implemented")
+}
+func (*UnimplementedMsgServer) SetPlatformFeeEnforcement(ctx context.Context, req *MsgSetPlatformFeeEnforcement) (*MsgSetPlatformFeeEnforcementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformFeeEnforcement not implemented")
+}
+func (*UnimplementedMsgServer) CreatePaymentSchedule(ctx context.Context, req *MsgCreatePaymentSchedule) (*MsgCreatePaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentSchedule not implemented")
+}
+func (*UnimplementedMsgServer) CancelPaymentSchedule(ctx context.Context, req *MsgCancelPaymentSchedule) (*MsgCancelPaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPaymentSchedule not implemented")
+}
+func (*UnimplementedMsgServer) PausePaymentSchedule(ctx context.Context, req *MsgPausePaymentSchedule) (*MsgPausePaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausePaymentSchedule not implemented")
+}
+func (*UnimplementedMsgServer) ResumePaymentSchedule(ctx context.Context, req *MsgResumePaymentSchedule) (*MsgResumePaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePaymentSchedule not implemented")
+}
+func (*UnimplementedMsgServer) SendEscrow(ctx context.Context, req *MsgSendEscrow) (*MsgSendEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEscrow not implemented")
+}
+func (*UnimplementedMsgServer) ClaimEscrow(ctx context.Context, req *MsgClaimEscrow) (*MsgClaimEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimEscrow not implemented")
+}
+func (*UnimplementedMsgServer) RefundEscrow(ctx context.Context, req *MsgRefundEscrow) (*MsgRefundEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundEscrow not implemented")
+}
+func (*UnimplementedMsgServer) SetAttestationTrustAnchors(ctx context.Context, req *MsgSetAttestationTrustAnchors) (*MsgSetAttestationTrustAnchorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttestationTrustAnchors not implemented")
+}
+func (*UnimplementedMsgServer) VerifyWebAuthNAssertion(ctx context.Context, req *MsgVerifyWebAuthNAssertion) (*MsgVerifyWebAuthNAssertionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyWebAuthNAssertion not implemented")
+}
+func (*UnimplementedMsgServer) SetRelyingPartyProfile(ctx context.Context, req *MsgSetRelyingPartyProfile) (*MsgSetRelyingPartyProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRelyingPartyProfile not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRelyingPartyProfile(ctx context.Context, req *MsgRemoveRelyingPartyProfile) (*MsgRemoveRelyingPartyProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRelyingPartyProfile not implemented")
+}
+func (*UnimplementedMsgServer) SetEmissionsSchedule(ctx context.Context, req *MsgSetEmissionsSchedule) (*MsgSetEmissionsScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmissionsSchedule not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/Send",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Send(ctx, req.(*MsgSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/MultiSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSend(ctx, req.(*MsgMultiSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPlatformPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPlatformPercentage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPlatformPercentage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/SetPlatformPercentage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPlatformPercentage(ctx, req.(*MsgSetPlatformPercentage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPlatformMinimum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPlatformMinimum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPlatformMinimum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/SetPlatformMinimum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPlatformMinimum(ctx, req.(*MsgSetPlatformMinimum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPlatformFeeSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPlatformFeeSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPlatformFeeSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/SetPlatformFeeSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPlatformFeeSplit(ctx, req.(*MsgSetPlatformFeeSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPlatformFeeEnforcement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPlatformFeeEnforcement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPlatformFeeEnforcement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/SetPlatformFeeEnforcement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPlatformFeeEnforcement(ctx, req.(*MsgSetPlatformFeeEnforcement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePaymentSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/CreatePaymentSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePaymentSchedule(ctx, req.(*MsgCreatePaymentSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPaymentSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/CancelPaymentSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPaymentSchedule(ctx, req.(*MsgCancelPaymentSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PausePaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPausePaymentSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PausePaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/PausePaymentSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PausePaymentSchedule(ctx, req.(*MsgPausePaymentSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumePaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumePaymentSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumePaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/ResumePaymentSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumePaymentSchedule(ctx, req.(*MsgResumePaymentSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/SendEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendEscrow(ctx, req.(*MsgSendEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/ClaimEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimEscrow(ctx, req.(*MsgClaimEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/RefundEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundEscrow(ctx, req.(*MsgRefundEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAttestationTrustAnchors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttestationTrustAnchors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttestationTrustAnchors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/SetAttestationTrustAnchors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttestationTrustAnchors(ctx, req.(*MsgSetAttestationTrustAnchors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VerifyWebAuthNAssertion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyWebAuthNAssertion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyWebAuthNAssertion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/VerifyWebAuthNAssertion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyWebAuthNAssertion(ctx, req.(*MsgVerifyWebAuthNAssertion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRelyingPartyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRelyingPartyProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRelyingPartyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/SetRelyingPartyProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRelyingPartyProfile(ctx, req.(*MsgSetRelyingPartyProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRelyingPartyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRelyingPartyProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRelyingPartyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/RemoveRelyingPartyProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRelyingPartyProfile(ctx, req.(*MsgRemoveRelyingPartyProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEmissionsSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEmissionsSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEmissionsSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/SetEmissionsSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEmissionsSchedule(ctx, req.(*MsgSetEmissionsSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xion.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Send",
+			Handler:    _Msg_Send_Handler,
+		},
+		{
+			MethodName: "MultiSend",
+			Handler:    _Msg_MultiSend_Handler,
+		},
+		{
+			MethodName: "SetPlatformPercentage",
+			Handler:    _Msg_SetPlatformPercentage_Handler,
+		},
+		{
+			MethodName: "SetPlatformMinimum",
+			Handler:    _Msg_SetPlatformMinimum_Handler,
+		},
+		{
+			MethodName: "SetPlatformFeeSplit",
+			Handler:    _Msg_SetPlatformFeeSplit_Handler,
+		},
+		{
+			MethodName: "SetPlatformFeeEnforcement",
+			Handler:    _Msg_SetPlatformFeeEnforcement_Handler,
+		},
+		{
+			MethodName: "CreatePaymentSchedule",
+			Handler:    _Msg_CreatePaymentSchedule_Handler,
+		},
+		{
+			MethodName: "CancelPaymentSchedule",
+			Handler:    _Msg_CancelPaymentSchedule_Handler,
+		},
+		{
+			MethodName: "PausePaymentSchedule",
+			Handler:    _Msg_PausePaymentSchedule_Handler,
+		},
+		{
+			MethodName: "ResumePaymentSchedule",
+			Handler:    _Msg_ResumePaymentSchedule_Handler,
+		},
+		{
+			MethodName: "SendEscrow",
+			Handler:    _Msg_SendEscrow_Handler,
+		},
+		{
+			MethodName: "ClaimEscrow",
+			Handler:    _Msg_ClaimEscrow_Handler,
+		},
+		{
+			MethodName: "RefundEscrow",
+			Handler:    _Msg_RefundEscrow_Handler,
+		},
+		{
+			MethodName: "SetAttestationTrustAnchors",
+			Handler:    _Msg_SetAttestationTrustAnchors_Handler,
+		},
+		{
+			MethodName: "VerifyWebAuthNAssertion",
+			Handler:    _Msg_VerifyWebAuthNAssertion_Handler,
+		},
+		{
+			MethodName: "SetRelyingPartyProfile",
+			Handler:    _Msg_SetRelyingPartyProfile_Handler,
+		},
+		{
+			MethodName: "RemoveRelyingPartyProfile",
+			Handler:    _Msg_RemoveRelyingPartyProfile_Handler,
+		},
+		{
+			MethodName: "SetEmissionsSchedule",
+			Handler:    _Msg_SetEmissionsSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "xion/v1/tx.proto",
+}
+
+func (m *MsgSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPlatformPercentage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformPercentage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformPercentage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlatformPercentage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlatformPercentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPlatformPercentageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformPercentageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformPercentageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPlatformMinimum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformMinimum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformMinimum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minimums) > 0 {
+		for iNdEx := len(m.Minimums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minimums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPlatformMinimumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformMinimumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformMinimumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPlatformFeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformFeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformFeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Split.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPlatformFeeSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformFeeSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformFeeSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPlatformFeeEnforcement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformFeeEnforcement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformFeeEnforcement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Enforcement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPlatformFeeEnforcementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformFeeEnforcementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformFeeEnforcementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePaymentSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePaymentSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePaymentSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxTotal) > 0 {
+		for iNdEx := len(m.MaxTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EndTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePaymentScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePaymentScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePaymentScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPaymentSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPaymentSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPaymentSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPaymentScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPaymentScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPaymentScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPausePaymentSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPausePaymentSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPausePaymentSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPausePaymentScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPausePaymentScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPausePaymentScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumePaymentSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumePaymentSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumePaymentSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumePaymentScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumePaymentScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumePaymentScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EmailHash) > 0 {
+		i -= len(m.EmailHash)
+		copy(dAtA[i:], m.EmailHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EmailHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TimeoutBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SecretHash) > 0 {
+		i -= len(m.SecretHash)
+		copy(dAtA[i:], m.SecretHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SecretHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EmailProof != nil {
+		{
+			size, err := m.EmailProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SecretProof != nil {
+		{
+			size, err := m.SecretProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAttestationTrustAnchors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttestationTrustAnchors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttestationTrustAnchors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Anchors) > 0 {
+		for iNdEx := len(m.Anchors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Anchors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAttestationTrustAnchorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttestationTrustAnchorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttestationTrustAnchorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyWebAuthNAssertion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyWebAuthNAssertion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyWebAuthNAssertion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RpProfile) > 0 {
+		i -= len(m.RpProfile)
+		copy(dAtA[i:], m.RpProfile)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RpProfile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Credential) > 0 {
+		i -= len(m.Credential)
+		copy(dAtA[i:], m.Credential)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Credential)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Rp) > 0 {
+		i -= len(m.Rp)
+		copy(dAtA[i:], m.Rp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Rp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyWebAuthNAssertionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyWebAuthNAssertionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyWebAuthNAssertionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRelyingPartyProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRelyingPartyProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRelyingPartyProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRelyingPartyProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRelyingPartyProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRelyingPartyProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRelyingPartyProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRelyingPartyProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRelyingPartyProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRelyingPartyProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRelyingPartyProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRelyingPartyProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEmissionsSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEmissionsSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEmissionsSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEmissionsScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEmissionsScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEmissionsScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPlatformPercentage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PlatformPercentage != 0 {
+		n += 1 + sovTx(uint64(m.PlatformPercentage))
+	}
+	return n
+}
+
+func (m *MsgSetPlatformPercentageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPlatformMinimum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Minimums) > 0 {
+		for _, e := range m.Minimums {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetPlatformMinimumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPlatformFeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Split.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPlatformFeeSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPlatformFeeEnforcement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Enforcement.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPlatformFeeEnforcementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePaymentSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MaxTotal) > 0 {
+		for _, e := range m.MaxTotal {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreatePaymentScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelPaymentSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelPaymentScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPausePaymentSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgPausePaymentScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumePaymentSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgResumePaymentScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SecretHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutBlocks != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutBlocks))
+	}
+	l = len(m.EmailHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgClaimEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SecretProof != nil {
+		l = m.SecretProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EmailProof != nil {
+		l = m.EmailProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRefundEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAttestationTrustAnchors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Anchors) > 0 {
+		for _, e := range m.Anchors {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAttestationTrustAnchorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVerifyWebAuthNAssertion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Rp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Credential)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RpProfile)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVerifyWebAuthNAssertionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRelyingPartyProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Profile.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRelyingPartyProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRelyingPartyProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRelyingPartyProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetEmissionsSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetEmissionsScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, types1.Input{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, types1.Output{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPlatformPercentage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformPercentage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformPercentage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformPercentage", wireType)
+			}
+			m.PlatformPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlatformPercentage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPlatformPercentageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformPercentageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformPercentageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPlatformMinimum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformMinimum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformMinimum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minimums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minimums = append(m.Minimums, types.Coin{})
+			if err := m.Minimums[len(m.Minimums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPlatformMinimumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformMinimumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformMinimumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPlatformFeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformFeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformFeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Split.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPlatformFeeSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformFeeSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformFeeSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPlatformFeeEnforcement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformFeeEnforcement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformFeeEnforcement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enforcement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Enforcement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPlatformFeeEnforcementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformFeeEnforcementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformFeeEnforcementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePaymentSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePaymentSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePaymentSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxTotal = append(m.MaxTotal, types.Coin{})
+			if err := m.MaxTotal[len(m.MaxTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePaymentScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePaymentScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePaymentScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPaymentSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPaymentSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPaymentSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPaymentScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPaymentScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPaymentScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPausePaymentSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPausePaymentSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPausePaymentSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPausePaymentScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPausePaymentScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPausePaymentScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumePaymentSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumePaymentSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumePaymentSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumePaymentScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumePaymentScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumePaymentScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretHash = append(m.SecretHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecretHash == nil {
+				m.SecretHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
+			}
+			m.TimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmailHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmailHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretProof == nil {
+				m.SecretProof = &SecretClaimProof{}
+			}
+			if err := m.SecretProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmailProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmailProof == nil {
+				m.EmailProof = &EmailClaimProof{}
+			}
+			if err := m.EmailProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAttestationTrustAnchors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAttestationTrustAnchors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAttestationTrustAnchors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anchors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Anchors = append(m.Anchors, AttestationTrustAnchor{})
+			if err := m.Anchors[len(m.Anchors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgSetPlatformMinimumResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgSetAttestationTrustAnchorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAttestationTrustAnchorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAttestationTrustAnchorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSend) Unmarshal(dAtA []byte) error {
+func (m *MsgVerifyWebAuthNAssertion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1085,15 +7353,15 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVerifyWebAuthNAssertion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVerifyWebAuthNAssertion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1121,11 +7389,11 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1153,13 +7421,13 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Challenge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1169,25 +7437,123 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Rp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credential = append(m.Credential[:0], dAtA[iNdEx:postIndex]...)
+			if m.Credential == nil {
+				m.Credential = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpProfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpProfile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1210,7 +7576,7 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVerifyWebAuthNAssertionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1233,12 +7599,45 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVerifyWebAuthNAssertionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVerifyWebAuthNAssertionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1260,7 +7659,7 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRelyingPartyProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1283,17 +7682,17 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRelyingPartyProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRelyingPartyProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1303,29 +7702,27 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, types1.Input{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1352,8 +7749,7 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, types1.Output{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1378,7 +7774,7 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRelyingPartyProfileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1401,10 +7797,10 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRelyingPartyProfileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRelyingPartyProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1428,7 +7824,7 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPlatformPercentage) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRelyingPartyProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1451,15 +7847,15 @@ func (m *MsgSetPlatformPercentage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPlatformPercentage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRelyingPartyProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPlatformPercentage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRelyingPartyProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1487,13 +7883,13 @@ func (m *MsgSetPlatformPercentage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlatformPercentage", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.PlatformPercentage = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1503,11 +7899,24 @@ func (m *MsgSetPlatformPercentage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PlatformPercentage |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1529,7 +7938,7 @@ func (m *MsgSetPlatformPercentage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPlatformPercentageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRelyingPartyProfileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1552,10 +7961,10 @@ func (m *MsgSetPlatformPercentageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPlatformPercentageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRelyingPartyProfileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPlatformPercentageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRelyingPartyProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1579,7 +7988,7 @@ func (m *MsgSetPlatformPercentageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPlatformMinimum) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEmissionsSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1602,10 +8011,10 @@ func (m *MsgSetPlatformMinimum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPlatformMinimum: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEmissionsSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPlatformMinimum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEmissionsSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1640,9 +8049,9 @@ func (m *MsgSetPlatformMinimum) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minimums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1669,8 +8078,7 @@ func (m *MsgSetPlatformMinimum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minimums = append(m.Minimums, types.Coin{})
-			if err := m.Minimums[len(m.Minimums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1695,7 +8103,7 @@ func (m *MsgSetPlatformMinimum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPlatformMinimumResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEmissionsScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1718,10 +8126,10 @@ func (m *MsgSetPlatformMinimumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPlatformMinimumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEmissionsScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPlatformMinimumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEmissionsScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
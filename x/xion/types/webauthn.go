@@ -49,19 +49,19 @@ func (s SmartContractUser) WebAuthnIcon() string {
 var _ webauthn.User = SmartContractUser{}
 
 func VerifyRegistration(ctx sdktypes.Context, rp *url.URL, contractAddr string, challenge string, credentialCreationData *protocol.ParsedCredentialCreationData) (*webauthn.Credential, error) {
-	config := webauthn.Config{
-		RPID:                        rp.Host,
-		RPDisplayName:               rp.String(),
-		RPOrigins:                   []string{rp.String()},
-		RPTopOrigins:                []string{rp.String()},
-		RPTopOriginVerificationMode: protocol.TopOriginIgnoreVerificationMode,
-		AttestationPreference:       "none",
-		AuthenticatorSelection: protocol.AuthenticatorSelection{
-			UserVerification: protocol.VerificationPreferred,
-			ResidentKey:      protocol.ResidentKeyRequirementPreferred,
-		},
+	return VerifyProfileRegistration(ctx, RelyingPartyProfileFromURL(rp), contractAddr, challenge, credentialCreationData)
+}
+
+// VerifyProfileRegistration verifies a registration for the relying party,
+// origins and top origins of a profile.
+func VerifyProfileRegistration(ctx sdktypes.Context, profile RelyingPartyProfile, contractAddr string, challenge string, credentialCreationData *protocol.ParsedCredentialCreationData) (*webauthn.Credential, error) {
+	config := profile.WebAuthnConfig()
+	config.AttestationPreference = "none"
+	config.AuthenticatorSelection = protocol.AuthenticatorSelection{
+		UserVerification: protocol.VerificationPreferred,
+		ResidentKey:      protocol.ResidentKeyRequirementPreferred,
 	}
-	webAuthn, err := webauthn.New(&config)
+	webAuthn, err := webauthn.New(config)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +71,7 @@ func VerifyRegistration(ctx sdktypes.Context, rp *url.URL, contractAddr string,
 		Challenge:        challenge,
 		UserID:           smartContractUser.WebAuthnID(),
 		UserVerification: protocol.VerificationPreferred,
-		RelyingPartyID:   rp.Host,
+		RelyingPartyID:   profile.RpId,
 		CredParams: []protocol.CredentialParameter{
 			{
 				Type:      protocol.PublicKeyCredentialType,
@@ -96,16 +96,20 @@ func VerifyRegistration(ctx sdktypes.Context, rp *url.URL, contractAddr string,
 }
 
 func VerifyAuthentication(ctx sdktypes.Context, rp *url.URL, contractAddr string, challenge string, credential *webauthn.Credential, credentialAssertionData *protocol.ParsedCredentialAssertionData) (bool, error) {
-	config := webauthn.Config{
-		RPID:                   rp.Host,
-		RPDisplayName:          rp.String(),
-		RPOrigins:              []string{rp.String()},
-		AttestationPreference:  "",
-		AuthenticatorSelection: protocol.AuthenticatorSelection{},
+	if _, err := VerifyAssertion(ctx, RelyingPartyProfileFromURL(rp), contractAddr, challenge, credential, credentialAssertionData); err != nil {
+		return false, err
 	}
-	webAuthn, err := webauthn.New(&config)
+
+	return true, nil
+}
+
+// VerifyAssertion verifies an assertion against the credential for the
+// relying party, origins and top origins of a profile, and returns the
+// credential updated with the assertion's signature counter and flags.
+func VerifyAssertion(_ sdktypes.Context, profile RelyingPartyProfile, contractAddr string, challenge string, credential *webauthn.Credential, credentialAssertionData *protocol.ParsedCredentialAssertionData) (*webauthn.Credential, error) {
+	webAuthn, err := webauthn.New(profile.WebAuthnConfig())
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	smartContractUser := SmartContractUser{
@@ -119,11 +123,7 @@ func VerifyAuthentication(ctx sdktypes.Context, rp *url.URL, contractAddr string
 		AllowedCredentialIDs: [][]byte{credential.ID},
 	}
 
-	if _, err := webAuthn.ValidateLogin(smartContractUser, session, credentialAssertionData); err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return webAuthn.ValidateLogin(smartContractUser, session, credentialAssertionData)
 }
 
 // CreateCredential verifies a parsed response against the user's credentials and session data.
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/go-webauthn/webauthn/protocol"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// webauthn event types and attributes
+const (
+	EventTypeWebAuthNAssertionVerified = "webauthn_assertion_verified"
+
+	AttributeKeyOwner        = "owner"
+	AttributeKeyCredentialID = "credential_id"
+	AttributeKeySignCount    = "sign_count"
+	AttributeKeyBackupState  = "backup_state"
+)
+
+// MaxWebAuthNCredentialIDSize is the largest credential id the WebAuthn
+// specification allows.
+const MaxWebAuthNCredentialIDSize = 1023
+
+// WebAuthNCredentialKey returns the store key of a tracked credential,
+// relative to the credential prefix.
+func WebAuthNCredentialKey(owner sdk.AccAddress, credentialID []byte) []byte {
+	return append(WebAuthNCredentialOwnerPrefix(owner), credentialID...)
+}
+
+// WebAuthNCredentialOwnerPrefix returns the prefix of all tracked credentials
+// of an owner, relative to the credential prefix.
+func WebAuthNCredentialOwnerPrefix(owner sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(owner)
+}
+
+// Validate checks that the state names a valid owner and credential id.
+func (s WebAuthNCredentialState) Validate() error {
+	if _, _, err := bech32.Decode(s.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	return validateCredentialID(s.CredentialId)
+}
+
+// CheckAssertion returns an error when the authenticator data of a new
+// assertion suggests a cloned authenticator: a signature counter that did not
+// increase while either counter is non zero, or a change of backup
+// eligibility, which the specification fixes at credential creation.
+func (s WebAuthNCredentialState) CheckAssertion(authData protocol.AuthenticatorData) error {
+	if authData.Flags.HasBackupEligible() != s.BackupEligible {
+		return errorsmod.Wrapf(ErrWebAuthNFlagsChanged, "backup eligible was %t, assertion has %t", s.BackupEligible, authData.Flags.HasBackupEligible())
+	}
+	if (authData.Counter != 0 || s.SignCount != 0) && authData.Counter <= s.SignCount {
+		return errorsmod.Wrapf(ErrWebAuthNCloneDetected, "sign count %d does not exceed recorded %d", authData.Counter, s.SignCount)
+	}
+	return nil
+}
+
+func validateCredentialID(credentialID []byte) error {
+	if len(credentialID) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty credential id")
+	}
+	if len(credentialID) > MaxWebAuthNCredentialIDSize {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "credential id of %d bytes exceeds %d", len(credentialID), MaxWebAuthNCredentialIDSize)
+	}
+	return nil
+}
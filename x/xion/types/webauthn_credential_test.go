@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestWebAuthNCredentialState_Validate(t *testing.T) {
+	state := types.WebAuthNCredentialState{Owner: sdk.AccAddress("abstract_account_1234").String(), CredentialId: []byte("cred")}
+	require.NoError(t, state.Validate())
+
+	invalid := state
+	invalid.Owner = "invalid"
+	require.Error(t, invalid.Validate())
+
+	invalid = state
+	invalid.CredentialId = nil
+	require.Error(t, invalid.Validate())
+
+	invalid = state
+	invalid.CredentialId = bytes.Repeat([]byte{1}, types.MaxWebAuthNCredentialIDSize+1)
+	require.Error(t, invalid.Validate())
+}
+
+func TestWebAuthNCredentialState_CheckAssertion(t *testing.T) {
+	state := types.WebAuthNCredentialState{SignCount: 3, BackupEligible: true}
+
+	require.NoError(t, state.CheckAssertion(protocol.AuthenticatorData{Counter: 4, Flags: protocol.FlagBackupEligible}))
+	require.ErrorIs(t, state.CheckAssertion(protocol.AuthenticatorData{Counter: 3, Flags: protocol.FlagBackupEligible}), types.ErrWebAuthNCloneDetected)
+	require.ErrorIs(t, state.CheckAssertion(protocol.AuthenticatorData{Counter: 0, Flags: protocol.FlagBackupEligible}), types.ErrWebAuthNCloneDetected)
+	require.ErrorIs(t, state.CheckAssertion(protocol.AuthenticatorData{Counter: 4}), types.ErrWebAuthNFlagsChanged)
+
+	// Authenticators without a counter always report zero
+	require.NoError(t, types.WebAuthNCredentialState{}.CheckAssertion(protocol.AuthenticatorData{}))
+}
+
+func TestWebAuthNCredentialKey(t *testing.T) {
+	owner := sdk.AccAddress("abstract_account_1234")
+	key := types.WebAuthNCredentialKey(owner, []byte("cred"))
+	require.True(t, bytes.HasPrefix(key, types.WebAuthNCredentialOwnerPrefix(owner)))
+	require.NotEqual(t, key, types.WebAuthNCredentialKey(sdk.AccAddress("abstract_account_5678"), []byte("cred")))
+}